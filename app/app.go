@@ -242,6 +242,7 @@ func NewMezo(
 	invCheckPeriod uint,
 	encodingConfig simappparams.EncodingConfig,
 	ethereumSidecarClient bridgeabci.EthereumSidecarClient,
+	sourceChainSidecarClients map[uint32]bridgeabci.EthereumSidecarClient,
 	appOpts servertypes.AppOptions,
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *Mezo {
@@ -422,6 +423,7 @@ func NewMezo(
 		keys[bridgetypes.StoreKey],
 		app.BankKeeper,
 		app.EvmKeeper,
+		&app.OracleKeeper,
 		app.BlockedAddrs(),
 	)
 
@@ -555,7 +557,7 @@ func NewMezo(
 		panic(fmt.Sprintf("failed to initialize oracle client and metrics: %s", err))
 	}
 	// Connect ABCI initialization requires the oracle client/metrics to be setup first.
-	app.setABCIExtensions(ethereumSidecarClient)
+	app.setABCIExtensions(ethereumSidecarClient, sourceChainSidecarClients)
 
 	app.setupUpgradeHandlers()
 
@@ -676,9 +678,13 @@ func (app *Mezo) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 // This function assumes the BridgeKeeper and PoaKeeper are already set in the app.
 func (app *Mezo) setABCIExtensions(
 	ethereumSidecarClient bridgeabci.EthereumSidecarClient,
+	sourceChainSidecarClients map[uint32]bridgeabci.EthereumSidecarClient,
 ) {
 	// Create the bridge ABCI handlers.
-	bridgeVoteExtensionHandler, bridgeProposalHandler, bridgePreBlockHandler := app.bridgeABCIHandlers(ethereumSidecarClient)
+	bridgeVoteExtensionHandler, bridgeProposalHandler, bridgePreBlockHandler := app.bridgeABCIHandlers(
+		ethereumSidecarClient,
+		sourceChainSidecarClients,
+	)
 
 	// Create the Connect ABCI handlers.
 	connectVEHandler, connectProposalHandler, connectPreBlocker := app.connectABCIHandlers()
@@ -712,6 +718,7 @@ func (app *Mezo) setABCIExtensions(
 // This function assumes the BridgeKeeper and PoaKeeper are already set in the app.
 func (app *Mezo) bridgeABCIHandlers(
 	ethereumSidecarClient bridgeabci.EthereumSidecarClient,
+	sourceChainSidecarClients map[uint32]bridgeabci.EthereumSidecarClient,
 ) (
 	*bridgeabci.VoteExtensionHandler,
 	*bridgeabci.ProposalHandler,
@@ -720,6 +727,7 @@ func (app *Mezo) bridgeABCIHandlers(
 	voteExtensionHandler := bridgeabci.NewVoteExtensionHandler(
 		app.Logger(),
 		ethereumSidecarClient,
+		sourceChainSidecarClients,
 		app.BridgeKeeper,
 	)
 
@@ -60,6 +60,7 @@ func TestMezoExport(t *testing.T) {
 		0,
 		encoding.MakeConfig(ModuleBasics),
 		ethsidecar.NewClientMock(),
+		nil,
 		simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome),
 		baseapp.SetChainID(chainID),
 	)
@@ -102,6 +103,7 @@ func TestMezoExport(t *testing.T) {
 		0,
 		encoding.MakeConfig(ModuleBasics),
 		ethsidecar.NewClientMock(),
+		nil,
 		simtestutil.NewAppOptionsWithFlagHome(DefaultNodeHome),
 		baseapp.SetChainID(chainID),
 	)
@@ -16,6 +16,7 @@ import (
 	"github.com/mezo-org/mezod/app/upgrades/v11_0"
 	"github.com/mezo-org/mezod/app/upgrades/v12_0"
 	"github.com/mezo-org/mezod/app/upgrades/v13_0"
+	"github.com/mezo-org/mezod/app/upgrades/v14_0"
 	"github.com/mezo-org/mezod/app/upgrades/v1_0"
 	"github.com/mezo-org/mezod/app/upgrades/v2_0"
 	"github.com/mezo-org/mezod/app/upgrades/v3_0"
@@ -44,6 +45,7 @@ var (
 		v11_0.Upgrade,
 		v12_0.Upgrade,
 		v13_0.Upgrade,
+		v14_0.Upgrade,
 	}
 	Forks = []upgrades.Fork{v0_3.Fork, v0_4.Fork, v0_5.Fork, v0_6.Fork, v0_7.Fork}
 )
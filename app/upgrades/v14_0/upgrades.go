@@ -0,0 +1,157 @@
+//nolint:revive,stylecheck
+package v14_0
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/mezo-org/mezod/app/upgrades"
+	bridgekeeper "github.com/mezo-org/mezod/x/bridge/keeper"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	evmkeeper "github.com/mezo-org/mezod/x/evm/keeper"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *upgrades.Keepers,
+) upgradetypes.UpgradeHandler {
+	return func(
+		ctx context.Context,
+		_ upgradetypes.Plan,
+		fromVM module.VersionMap,
+	) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+		sdkCtx.Logger().Info("running v14.0.0 upgrade handler")
+
+		// Enable the assets bridge precompile v7 methods managing the outflow
+		// policies, per-sender limits, delayed bridge-outs, source chains,
+		// bridge-out fees and the token mapping lifecycle, as well as the
+		// triparty outcome, callback retry and cancellation methods.
+		if err := UpdateAssetsBridgePrecompileVersion(sdkCtx, keepers.EvmKeeper); err != nil {
+			return nil, fmt.Errorf("failed to update assets bridge precompile version: %w", err)
+		}
+
+		if err := SeedERC20Supplies(sdkCtx, keepers.BridgeKeeper); err != nil {
+			return nil, fmt.Errorf("failed to seed ERC20 supplies: %w", err)
+		}
+
+		if err := SetAssetsLockedEventsRetention(sdkCtx, keepers.BridgeKeeper); err != nil {
+			return nil, fmt.Errorf("failed to set AssetsLocked events retention: %w", err)
+		}
+
+		if err := SetTripartyOutcomesRetention(sdkCtx, keepers.BridgeKeeper); err != nil {
+			return nil, fmt.Errorf("failed to set triparty outcomes retention: %w", err)
+		}
+
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+// UpdateAssetsBridgePrecompileVersion bumps the assets bridge precompile to
+// v7. It enables the methods managing rolling outflow windows, the USD outflow
+// limit, per-sender bridge-out limits, the delayed bridge-out queue,
+// additional bridge-in source chains, bridge-out fees and the ERC20 token
+// mapping lifecycle. It also enables the methods exposing triparty bridge
+// request outcomes, retrying failed triparty callbacks and cancelling pending
+// triparty bridge requests.
+func UpdateAssetsBridgePrecompileVersion(ctx sdk.Context, evmKeeper *evmkeeper.Keeper) error {
+	params := evmKeeper.GetParams(ctx)
+
+	ctx.Logger().Info(
+		"begin assets bridge precompile version update",
+		"precompilesVersions",
+		params.PrecompilesVersions,
+	)
+
+	assetsBridgeVersionInfoIndex := slices.IndexFunc(
+		params.PrecompilesVersions,
+		func(versionInfo *evmtypes.PrecompileVersionInfo) bool {
+			return bytes.Equal(
+				evmtypes.HexAddressToBytes(versionInfo.PrecompileAddress),
+				evmtypes.HexAddressToBytes(evmtypes.AssetsBridgePrecompileAddress),
+			)
+		},
+	)
+
+	// We avoid using the constant directly as it will change in the future.
+	params.PrecompilesVersions[assetsBridgeVersionInfoIndex].Version = 7
+
+	if err := evmKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info(
+		"assets bridge precompile version updated",
+		"precompilesVersions",
+		evmKeeper.GetParams(ctx).PrecompilesVersions,
+	)
+
+	return nil
+}
+
+// SeedERC20Supplies starts the tracking of the amounts of mapped ERC20 tokens
+// minted and burnt by the bridge. The tokens were bridged before the tracking
+// existed, so the minted amounts are seeded with the current total supplies.
+func SeedERC20Supplies(ctx sdk.Context, bridgeKeeper bridgekeeper.Keeper) error {
+	ctx.Logger().Info("begin ERC20 supply seeding")
+
+	if err := bridgeKeeper.InitERC20Supplies(ctx); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info(
+		"ERC20 supply seeding done",
+		"erc20Supplies",
+		bridgeKeeper.GetAllERC20Supplies(ctx),
+	)
+
+	return nil
+}
+
+// SetAssetsLockedEventsRetention sets the retention period of the accepted
+// AssetsLocked events. The parameter did not exist before, so it would
+// otherwise read as zero and disable pruning.
+func SetAssetsLockedEventsRetention(ctx sdk.Context, bridgeKeeper bridgekeeper.Keeper) error {
+	params := bridgeKeeper.GetParams(ctx)
+	params.AssetsLockedEventsRetentionBlocks = bridgetypes.DefaultAssetsLockedEventsRetentionBlocks
+
+	if err := bridgeKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info(
+		"AssetsLocked events retention set",
+		"retentionBlocks",
+		params.AssetsLockedEventsRetentionBlocks,
+	)
+
+	return nil
+}
+
+// SetTripartyOutcomesRetention sets the retention period of the triparty
+// bridge request outcome records. The parameter did not exist before, so it
+// would otherwise read as zero and disable pruning.
+func SetTripartyOutcomesRetention(ctx sdk.Context, bridgeKeeper bridgekeeper.Keeper) error {
+	params := bridgeKeeper.GetParams(ctx)
+	params.TripartyOutcomesRetentionBlocks = bridgetypes.DefaultTripartyOutcomesRetentionBlocks
+
+	if err := bridgeKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info(
+		"triparty outcomes retention set",
+		"retentionBlocks",
+		params.TripartyOutcomesRetentionBlocks,
+	)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+//nolint:revive,stylecheck
+package v14_0_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/app"
+	v14_0 "github.com/mezo-org/mezod/app/upgrades/v14_0"
+	"github.com/mezo-org/mezod/crypto/ethsecp256k1"
+	"github.com/mezo-org/mezod/testutil"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupApp(t *testing.T) (*app.Mezo, sdk.Context) {
+	t.Helper()
+
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	mezoApp := app.Setup(false, nil)
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "mezo_31612-1", consAddress, nil, nil,
+	)
+
+	return mezoApp, mezoApp.BaseApp.NewContextLegacy(false, header)
+}
+
+func precompileVersionIndex(
+	t *testing.T,
+	params evmtypes.Params,
+	precompileAddress string,
+) int {
+	t.Helper()
+
+	index := slices.IndexFunc(
+		params.PrecompilesVersions,
+		func(versionInfo *evmtypes.PrecompileVersionInfo) bool {
+			return bytes.Equal(
+				evmtypes.HexAddressToBytes(versionInfo.PrecompileAddress),
+				evmtypes.HexAddressToBytes(precompileAddress),
+			)
+		},
+	)
+	require.NotEqual(t, -1, index)
+
+	return index
+}
+
+func TestUpdateAssetsBridgePrecompileVersion(t *testing.T) {
+	mezoApp, ctx := setupApp(t)
+
+	params := mezoApp.EvmKeeper.GetParams(ctx)
+	index := precompileVersionIndex(t, params, evmtypes.AssetsBridgePrecompileAddress)
+	params.PrecompilesVersions[index].Version = 6
+	require.NoError(t, mezoApp.EvmKeeper.SetParams(ctx, params))
+
+	require.NoError(t, v14_0.UpdateAssetsBridgePrecompileVersion(ctx, mezoApp.EvmKeeper))
+
+	params = mezoApp.EvmKeeper.GetParams(ctx)
+	require.EqualValues(t, 7, params.PrecompilesVersions[index].Version)
+
+	// The other precompile versions must stay untouched.
+	btcIndex := precompileVersionIndex(t, params, evmtypes.BTCTokenPrecompileAddress)
+	require.EqualValues(
+		t,
+		evmtypes.BTCTokenPrecompileLatestVersion,
+		params.PrecompilesVersions[btcIndex].Version,
+	)
+}
+
+func TestSeedERC20Supplies(t *testing.T) {
+	mezoApp, ctx := setupApp(t)
+
+	// The default genesis has no ERC20 token mappings, so there is nothing
+	// to seed.
+	require.NoError(t, v14_0.SeedERC20Supplies(ctx, mezoApp.BridgeKeeper))
+	require.Empty(t, mezoApp.BridgeKeeper.GetAllERC20Supplies(ctx))
+}
+
+func TestSetAssetsLockedEventsRetention(t *testing.T) {
+	mezoApp, ctx := setupApp(t)
+
+	// Existing chains have no value stored for the new parameter.
+	params := mezoApp.BridgeKeeper.GetParams(ctx)
+	params.AssetsLockedEventsRetentionBlocks = 0
+	require.NoError(t, mezoApp.BridgeKeeper.SetParams(ctx, params))
+
+	require.NoError(t, v14_0.SetAssetsLockedEventsRetention(ctx, mezoApp.BridgeKeeper))
+
+	updated := mezoApp.BridgeKeeper.GetParams(ctx)
+	require.Equal(
+		t,
+		bridgetypes.DefaultAssetsLockedEventsRetentionBlocks,
+		updated.AssetsLockedEventsRetentionBlocks,
+	)
+
+	// The other parameters must stay untouched.
+	updated.AssetsLockedEventsRetentionBlocks = 0
+	require.Equal(t, params, updated)
+}
+
+func TestSetTripartyOutcomesRetention(t *testing.T) {
+	mezoApp, ctx := setupApp(t)
+
+	// Existing chains have no value stored for the new parameter.
+	params := mezoApp.BridgeKeeper.GetParams(ctx)
+	params.TripartyOutcomesRetentionBlocks = 0
+	require.NoError(t, mezoApp.BridgeKeeper.SetParams(ctx, params))
+
+	require.NoError(t, v14_0.SetTripartyOutcomesRetention(ctx, mezoApp.BridgeKeeper))
+
+	updated := mezoApp.BridgeKeeper.GetParams(ctx)
+	require.Equal(
+		t,
+		bridgetypes.DefaultTripartyOutcomesRetentionBlocks,
+		updated.TripartyOutcomesRetentionBlocks,
+	)
+
+	// The other parameters must stay untouched.
+	updated.TripartyOutcomesRetentionBlocks = 0
+	require.Equal(t, params, updated)
+}
@@ -0,0 +1,68 @@
+// Package api contains the types of the HTTP API exposed by the bridge worker
+// to the Ethereum sidecars of bridge validators.
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+// SubmitSignaturePath is the path of the endpoint accepting signatures of
+// AssetsUnlocked entries produced by bridge validators for the batch
+// attestation process. The endpoint accepts POST requests with a
+// SubmitSignatureRequest body. Rejected requests are answered with a non-2xx
+// status code and an ErrorResponse body.
+const SubmitSignaturePath = "/v1/signatures"
+
+// AssetsUnlockedEntry is the JSON representation of an AssetsUnlocked entry.
+type AssetsUnlockedEntry struct {
+	UnlockSequenceNumber *big.Int       `json:"unlockSequenceNumber"`
+	Recipient            hexutil.Bytes  `json:"recipient"`
+	Token                common.Address `json:"token"`
+	Amount               *big.Int       `json:"amount"`
+	Chain                uint8          `json:"chain"`
+}
+
+// NewAssetsUnlockedEntry converts the given AssetsUnlocked entry to its JSON
+// representation.
+func NewAssetsUnlockedEntry(
+	entry *portal.MezoBridgeAssetsUnlocked,
+) AssetsUnlockedEntry {
+	return AssetsUnlockedEntry{
+		UnlockSequenceNumber: entry.UnlockSequenceNumber,
+		Recipient:            entry.Recipient,
+		Token:                entry.Token,
+		Amount:               entry.Amount,
+		Chain:                entry.Chain,
+	}
+}
+
+// ToPortal converts the entry to the form used by the MezoBridge contract.
+func (aue AssetsUnlockedEntry) ToPortal() portal.MezoBridgeAssetsUnlocked {
+	return portal.MezoBridgeAssetsUnlocked{
+		UnlockSequenceNumber: aue.UnlockSequenceNumber,
+		Recipient:            aue.Recipient,
+		Token:                aue.Token,
+		Amount:               aue.Amount,
+		Chain:                aue.Chain,
+	}
+}
+
+// SubmitSignatureRequest is the body of a request sent to the
+// SubmitSignaturePath endpoint.
+type SubmitSignatureRequest struct {
+	// Entry is the signed AssetsUnlocked entry.
+	Entry AssetsUnlockedEntry `json:"entry"`
+	// Signature is the 65-byte [R || S || V] signature of the entry's batch
+	// attestation digest made by a bridge validator.
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// ErrorResponse is the body of a response to a rejected request.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,520 @@
+package bridgeworker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/mezo-org/mezod/bridge-worker/api"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+const (
+	// submitSignatureMaxRequestSize is the maximum size of a request body
+	// accepted by the signature intake endpoint.
+	submitSignatureMaxRequestSize = 64 * 1024
+
+	// batchAttestationServerTimeout is the timeout used by the HTTP server of
+	// the signature intake endpoint when reading requests, writing responses
+	// and shutting down.
+	batchAttestationServerTimeout = 10 * time.Second
+
+	// batchAttestationResubmissionBackoff is the time after which an entry
+	// whose attestBridgeOutWithSignatures transaction was submitted but the
+	// unlock is still not confirmed is submitted again.
+	batchAttestationResubmissionBackoff = 5 * time.Minute
+)
+
+var (
+	// errInvalidSignatureRequest is the error returned when the signature
+	// submitted by a bridge validator is malformed.
+	errInvalidSignatureRequest = errors.New("invalid signature request")
+
+	// errEntryMismatch is the error returned when the submitted entry does
+	// not match the AssetsUnlocked event emitted on the Mezo chain.
+	errEntryMismatch = errors.New(
+		"entry does not match AssetsUnlocked event emitted on Mezo",
+	)
+
+	// errUnlockAlreadyConfirmed is the error returned when the unlock of the
+	// submitted entry is already confirmed by the MezoBridge contract.
+	errUnlockAlreadyConfirmed = errors.New("unlock already confirmed")
+
+	// errNotBridgeValidator is the error returned when the signature was not
+	// made by a bridge validator.
+	errNotBridgeValidator = errors.New("signer is not a bridge validator")
+)
+
+// batchAttestationJob collects signatures of AssetsUnlocked entries from the
+// Ethereum sidecars of bridge validators and submits them to the MezoBridge
+// contract with attestBridgeOutWithSignatures once the attestation threshold
+// is reached. This way, an unlock is confirmed with a single transaction
+// instead of one transaction per bridge validator.
+type batchAttestationJob struct {
+	env *environment
+
+	assetsUnlockedEndpoint AssetsUnlockedEndpoint
+
+	// chainID is the Ethereum chain ID signed by bridge validators along with
+	// the AssetsUnlocked entry.
+	chainID *big.Int
+
+	signatures *signatureStore
+
+	listenAddress  string
+	checkFrequency time.Duration
+}
+
+func newBatchAttestationJob(
+	env *environment,
+	assetsUnlockEndpoint string,
+	chainID *big.Int,
+	listenAddress string,
+	checkFrequency time.Duration,
+) *batchAttestationJob {
+	// The messages handled by the bridge-worker contain custom types.
+	// Add codecs so that the messages can be marshaled/unmarshalled.
+	assetsUnlockedGrpcEndpoint, err := NewAssetsUnlockedGrpcEndpoint(
+		assetsUnlockEndpoint,
+		codectypes.NewInterfaceRegistry(),
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create assets unlocked endpoint: %v", err))
+	}
+
+	return &batchAttestationJob{
+		env:                    env,
+		assetsUnlockedEndpoint: assetsUnlockedGrpcEndpoint,
+		chainID:                chainID,
+		signatures:             newSignatureStore(),
+		listenAddress:          listenAddress,
+		checkFrequency:         checkFrequency,
+	}
+}
+
+func (baj *batchAttestationJob) run(ctx context.Context) {
+	runCtx, cancelRunCtx := context.WithCancel(ctx)
+	defer cancelRunCtx()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(api.SubmitSignaturePath, baj.handleSubmitSignature)
+
+	server := &http.Server{
+		Addr:              baj.listenAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: batchAttestationServerTimeout,
+		ReadTimeout:       batchAttestationServerTimeout,
+		WriteTimeout:      batchAttestationServerTimeout,
+	}
+
+	go func() {
+		defer cancelRunCtx()
+
+		baj.env.logger.Info(
+			"starting signature intake endpoint",
+			"listen_address", baj.listenAddress,
+		)
+
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			baj.env.logger.Error(
+				"signature intake endpoint failed",
+				"error", err,
+			)
+		}
+
+		baj.env.logger.Warn("signature intake endpoint stopped")
+	}()
+
+	go func() {
+		defer cancelRunCtx()
+		baj.processCollectedSignatures(runCtx)
+		baj.env.logger.Warn("batch attestation processing loop stopped")
+	}()
+
+	<-runCtx.Done()
+
+	shutdownCtx, cancelShutdownCtx := context.WithTimeout(
+		context.Background(),
+		batchAttestationServerTimeout,
+	)
+	defer cancelShutdownCtx()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		baj.env.logger.Error(
+			"failed to shut down signature intake endpoint",
+			"error", err,
+		)
+	}
+
+	baj.env.logger.Info("batch attestation job stopped")
+}
+
+// handleSubmitSignature handles a signature of an AssetsUnlocked entry
+// submitted by the Ethereum sidecar of a bridge validator.
+func (baj *batchAttestationJob) handleSubmitSignature(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	if r.Method != http.MethodPost {
+		writeErrorResponse(
+			w,
+			http.StatusMethodNotAllowed,
+			fmt.Errorf("method %s not allowed", r.Method),
+		)
+		return
+	}
+
+	var request api.SubmitSignatureRequest
+	err := json.NewDecoder(
+		http.MaxBytesReader(w, r.Body, submitSignatureMaxRequestSize),
+	).Decode(&request)
+	if err != nil {
+		writeErrorResponse(
+			w,
+			http.StatusBadRequest,
+			fmt.Errorf("failed to decode request: [%w]", err),
+		)
+		return
+	}
+
+	err = baj.acceptSignature(r.Context(), request)
+	if err != nil {
+		var status int
+		switch {
+		case errors.Is(err, errInvalidSignatureRequest),
+			errors.Is(err, errEntryMismatch):
+			status = http.StatusBadRequest
+		case errors.Is(err, errNotBridgeValidator):
+			status = http.StatusForbidden
+		case errors.Is(err, errUnlockAlreadyConfirmed):
+			status = http.StatusConflict
+		default:
+			status = http.StatusInternalServerError
+		}
+
+		baj.env.logger.Warn(
+			"rejected submitted signature",
+			"unlock_sequence", request.Entry.UnlockSequenceNumber,
+			"error", err,
+		)
+
+		writeErrorResponse(w, status, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(api.ErrorResponse{Error: err.Error()})
+}
+
+// acceptSignature validates the submitted signature and stores it if it was
+// made by a bridge validator over an AssetsUnlocked entry emitted on the Mezo
+// chain and not yet confirmed by the MezoBridge contract. The endpoint is
+// unauthenticated, so the signer is checked first and the entry is verified
+// against Mezo and Ethereum only for signatures of bridge validators.
+func (baj *batchAttestationJob) acceptSignature(
+	ctx context.Context,
+	request api.SubmitSignatureRequest,
+) error {
+	entry := request.Entry.ToPortal()
+
+	if entry.UnlockSequenceNumber == nil ||
+		entry.UnlockSequenceNumber.Sign() <= 0 ||
+		entry.Amount == nil {
+		return fmt.Errorf(
+			"%w: missing or invalid entry fields",
+			errInvalidSignatureRequest,
+		)
+	}
+
+	if len(request.Signature) != crypto.SignatureLength {
+		return fmt.Errorf(
+			"%w: signature must be %d bytes long, got %d",
+			errInvalidSignatureRequest,
+			crypto.SignatureLength,
+			len(request.Signature),
+		)
+	}
+
+	digest, err := computeBatchAttestationDigest(entry, baj.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to compute attestation digest: [%w]", err)
+	}
+
+	signer, signature, err := recoverSigner(digest, request.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: [%w]", errInvalidSignatureRequest, err)
+	}
+
+	validatorID, err := baj.env.mezoBridgeContract.BridgeValidatorIDs(signer)
+	if err != nil {
+		return fmt.Errorf("failed to get bridge validator ID: [%w]", err)
+	}
+	if validatorID == 0 {
+		return fmt.Errorf("%w: %s", errNotBridgeValidator, signer.Hex())
+	}
+
+	if err := baj.verifyEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	confirmed, err := baj.env.mezoBridgeContract.ConfirmedUnlocks(
+		entry.UnlockSequenceNumber,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get confirmed unlock: [%w]", err)
+	}
+	if confirmed {
+		return errUnlockAlreadyConfirmed
+	}
+
+	count := baj.signatures.add(entry, signer, signature)
+
+	baj.env.logger.Info(
+		"accepted signature from bridge validator",
+		"unlock_sequence", entry.UnlockSequenceNumber.String(),
+		"bridge_validator", signer.Hex(),
+		"collected_signatures", count,
+	)
+
+	return nil
+}
+
+// verifyEntry checks the given entry is identical to the AssetsUnlocked event
+// with the same unlock sequence number emitted on the Mezo chain.
+func (baj *batchAttestationJob) verifyEntry(
+	ctx context.Context,
+	entry portal.MezoBridgeAssetsUnlocked,
+) error {
+	unlockSequence := entry.UnlockSequenceNumber
+
+	assetsUnlockEvents, err := baj.assetsUnlockedEndpoint.GetAssetsUnlockedEvents(
+		ctx,
+		sdkmath.NewIntFromBigInt(new(big.Int).Set(unlockSequence)),
+		sdkmath.NewIntFromBigInt(new(big.Int).Add(
+			new(big.Int).Set(unlockSequence),
+			big.NewInt(1)),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get AssetsUnlock event from Mezo: [%w]",
+			err,
+		)
+	}
+
+	if len(assetsUnlockEvents) != 1 {
+		return fmt.Errorf(
+			"%w: no AssetsUnlocked event with unlock sequence %s",
+			errEntryMismatch,
+			unlockSequence.String(),
+		)
+	}
+
+	event := assetsUnlockEvents[0]
+
+	if !bytes.Equal(event.Recipient, entry.Recipient) ||
+		common.HexToAddress(event.Token) != entry.Token ||
+		event.Amount.BigInt().Cmp(entry.Amount) != 0 ||
+		event.Chain != uint32(entry.Chain) {
+		return fmt.Errorf(
+			"%w: unlock sequence %s",
+			errEntryMismatch,
+			unlockSequence.String(),
+		)
+	}
+
+	return nil
+}
+
+// processCollectedSignatures periodically submits entries whose collected
+// signatures reached the attestation threshold and drops entries whose
+// unlock got confirmed.
+func (baj *batchAttestationJob) processCollectedSignatures(ctx context.Context) {
+	ticker := time.NewTicker(baj.checkFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			baj.submitBatchAttestations()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (baj *batchAttestationJob) submitBatchAttestations() {
+	defer func() {
+		pendingBatchAttestationsGauge.Set(float64(baj.signatures.size()))
+	}()
+
+	pending := baj.signatures.pending()
+	if len(pending) == 0 {
+		return
+	}
+
+	threshold, err := baj.env.mezoBridgeContract.AttestationThreshold()
+	if err != nil {
+		baj.env.logger.Error(
+			"failed to get attestation threshold",
+			"error", err,
+		)
+		return
+	}
+	if threshold.Sign() <= 0 || !threshold.IsUint64() {
+		baj.env.logger.Error(
+			"invalid attestation threshold",
+			"threshold", threshold.String(),
+		)
+		return
+	}
+
+	for _, collected := range pending {
+		unlockSequence := collected.entry.UnlockSequenceNumber
+
+		confirmed, err := baj.env.mezoBridgeContract.ConfirmedUnlocks(
+			unlockSequence,
+		)
+		if err != nil {
+			baj.env.logger.Error(
+				"failed to get confirmed unlock",
+				"unlock_sequence", unlockSequence.String(),
+				"error", err,
+			)
+			continue
+		}
+
+		if confirmed {
+			baj.env.logger.Info(
+				"unlock confirmed; dropping collected signatures",
+				"unlock_sequence", unlockSequence.String(),
+			)
+			baj.signatures.remove(unlockSequence)
+			continue
+		}
+
+		if uint64(len(collected.signatures)) < threshold.Uint64() {
+			continue
+		}
+
+		if !collected.lastSubmission.IsZero() &&
+			time.Since(collected.lastSubmission) < batchAttestationResubmissionBackoff {
+			continue
+		}
+
+		// Submit exactly as many signatures as required. Any subset of the
+		// sorted signatures is still sorted by signer address.
+		signatures := collected.sortedSignatures()[:threshold.Uint64()]
+
+		tx, err := baj.env.mezoBridgeContract.AttestBridgeOutWithSignatures(
+			collected.entry,
+			bytes.Join(signatures, nil),
+		)
+		if err != nil {
+			// Nothing was submitted so the entry is retried with the next
+			// check rather than after the resubmission backoff.
+			baj.env.logger.Error(
+				"failed to submit attestBridgeOutWithSignatures transaction",
+				"unlock_sequence", unlockSequence.String(),
+				"error", err,
+			)
+			continue
+		}
+
+		baj.signatures.markSubmitted(unlockSequence, time.Now())
+
+		baj.env.logger.Info(
+			"submitted attestBridgeOutWithSignatures transaction",
+			"unlock_sequence", unlockSequence.String(),
+			"signatures", len(signatures),
+			"tx_hash", tx.Hash().Hex(),
+		)
+	}
+}
+
+// computeBatchAttestationDigest computes the digest signed by bridge
+// validators for the batch attestation of the given AssetsUnlocked entry.
+// It is the EIP-191 hash of keccak256(abi.encode(chainID, entry)).
+func computeBatchAttestationDigest(
+	entry portal.MezoBridgeAssetsUnlocked,
+	chainID *big.Int,
+) ([]byte, error) {
+	uint256Type, err := abi.NewType("uint256", "uint256", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tupleType, err := abi.NewType("tuple", "tuple", []abi.ArgumentMarshaling{
+		{Name: "unlockSequenceNumber", Type: "uint256"},
+		{Name: "recipient", Type: "bytes"},
+		{Name: "token", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+		{Name: "chain", Type: "uint8"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := (abi.Arguments{{Type: uint256Type}, {Type: tupleType}}).Pack(
+		chainID,
+		entry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts.TextHash(crypto.Keccak256(encoded)), nil
+}
+
+// recoverSigner recovers the address that produced the given [R || S || V]
+// signature of the digest. The recovery identifier V may be either 0/1 or
+// 27/28. The returned signature has V of 27 or 28, as expected by the
+// MezoBridge contract.
+func recoverSigner(
+	digest []byte,
+	signature []byte,
+) (common.Address, []byte, error) {
+	normalized := make([]byte, len(signature))
+	copy(normalized, signature)
+
+	if normalized[crypto.RecoveryIDOffset] >= 27 {
+		normalized[crypto.RecoveryIDOffset] -= 27
+	}
+	if normalized[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, nil, fmt.Errorf(
+			"invalid recovery identifier %d",
+			signature[crypto.RecoveryIDOffset],
+		)
+	}
+
+	publicKey, err := crypto.SigToPub(digest, normalized)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf(
+			"failed to recover public key: [%w]",
+			err,
+		)
+	}
+
+	normalized[crypto.RecoveryIDOffset] += 27
+
+	return crypto.PubkeyToAddress(*publicKey), normalized, nil
+}
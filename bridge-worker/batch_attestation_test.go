@@ -0,0 +1,411 @@
+package bridgeworker
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/mezo-org/mezod/bridge-worker/api"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+)
+
+// testAssetsUnlockedEndpoint is an AssetsUnlockedEndpoint returning the
+// configured events whose unlock sequence is within the requested range.
+type testAssetsUnlockedEndpoint struct {
+	events []bridgetypes.AssetsUnlockedEvent
+	calls  int
+}
+
+func (taue *testAssetsUnlockedEndpoint) GetAssetsUnlockedEvents(
+	_ context.Context,
+	sequenceStart sdkmath.Int,
+	sequenceEnd sdkmath.Int,
+) ([]bridgetypes.AssetsUnlockedEvent, error) {
+	taue.calls++
+
+	var events []bridgetypes.AssetsUnlockedEvent
+	for _, event := range taue.events {
+		if event.UnlockSequence.GTE(sequenceStart) &&
+			event.UnlockSequence.LT(sequenceEnd) {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func testBatchAttestationEntry() portal.MezoBridgeAssetsUnlocked {
+	return portal.MezoBridgeAssetsUnlocked{
+		UnlockSequenceNumber: big.NewInt(42),
+		Recipient:            common.FromHex("0x00140102030405060708090a0b0c0d0e0f1011121314"),
+		Token:                common.HexToAddress("0x517f2982701695D4E52f1ECFBEf3ba31Df470161"),
+		Amount:               big.NewInt(1000000000000000000),
+		Chain:                bitcoinTargetChain,
+	}
+}
+
+func testSignBatchAttestation(
+	t *testing.T,
+	entry portal.MezoBridgeAssetsUnlocked,
+	chainID *big.Int,
+	privateKey *ecdsa.PrivateKey,
+) []byte {
+	t.Helper()
+
+	digest, err := computeBatchAttestationDigest(entry, chainID)
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(digest, privateKey)
+	require.NoError(t, err)
+
+	return signature
+}
+
+func TestComputeBatchAttestationDigest(t *testing.T) {
+	// The expected digest is the one signed by the Ethereum sidecar.
+	digest, err := computeBatchAttestationDigest(
+		testBatchAttestationEntry(),
+		big.NewInt(11155111),
+	)
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		"4e5c3bcb5229112fce4fda7d75e9e02a871edcb8f4322cff651d0805152495db",
+		hex.EncodeToString(digest),
+	)
+}
+
+func TestHandleSubmitSignature(t *testing.T) {
+	chainID := big.NewInt(11155111)
+	entry := testBatchAttestationEntry()
+
+	validatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validatorAddress := crypto.PubkeyToAddress(validatorKey.PublicKey)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	event := bridgetypes.AssetsUnlockedEvent{
+		UnlockSequence: sdkmath.NewIntFromBigInt(entry.UnlockSequenceNumber),
+		Recipient:      entry.Recipient,
+		Token:          entry.Token.Hex(),
+		Amount:         sdkmath.NewIntFromBigInt(entry.Amount),
+		Chain:          uint32(entry.Chain),
+	}
+
+	signature := testSignBatchAttestation(t, entry, chainID, validatorKey)
+
+	ethereumSignature := bytes.Clone(signature)
+	ethereumSignature[crypto.RecoveryIDOffset] += 27
+
+	otherAmountEntry := testBatchAttestationEntry()
+	otherAmountEntry.Amount = big.NewInt(1)
+
+	tests := map[string]struct {
+		entry              portal.MezoBridgeAssetsUnlocked
+		signature          []byte
+		confirmed          bool
+		expectedStatusCode int
+		expectedSignatures int
+		// number of AssetsUnlocked event queries made to Mezo
+		expectedMezoCalls int
+		// number of confirmed unlock queries made to Ethereum
+		expectedConfirmedCalls int
+	}{
+		"valid signature": {
+			entry:                  entry,
+			signature:              signature,
+			expectedStatusCode:     http.StatusAccepted,
+			expectedSignatures:     1,
+			expectedMezoCalls:      1,
+			expectedConfirmedCalls: 1,
+		},
+		"valid signature with ethereum recovery identifier": {
+			entry:                  entry,
+			signature:              ethereumSignature,
+			expectedStatusCode:     http.StatusAccepted,
+			expectedSignatures:     1,
+			expectedMezoCalls:      1,
+			expectedConfirmedCalls: 1,
+		},
+		"signature of invalid length": {
+			entry:              entry,
+			signature:          signature[:64],
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"entry not matching mezo event": {
+			entry:              otherAmountEntry,
+			signature:          testSignBatchAttestation(t, otherAmountEntry, chainID, validatorKey),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMezoCalls:  1,
+		},
+		"unlock already confirmed": {
+			entry:                  entry,
+			signature:              signature,
+			confirmed:              true,
+			expectedStatusCode:     http.StatusConflict,
+			expectedMezoCalls:      1,
+			expectedConfirmedCalls: 1,
+		},
+		"signer not a bridge validator": {
+			// The entry is not verified for signers that are not bridge
+			// validators.
+			entry:              entry,
+			signature:          testSignBatchAttestation(t, entry, chainID, otherKey),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockMezoBridgeContract := NewMockMezoBridgeContract(ctrl)
+			mockMezoBridgeContract.EXPECT().
+				ConfirmedUnlocks(gomock.Any()).
+				Return(test.confirmed, nil).
+				Times(test.expectedConfirmedCalls)
+			mockMezoBridgeContract.EXPECT().
+				BridgeValidatorIDs(gomock.Any()).
+				DoAndReturn(func(address common.Address) (uint8, error) {
+					if address == validatorAddress {
+						return 1, nil
+					}
+					return 0, nil
+				}).
+				AnyTimes()
+
+			assetsUnlockedEndpoint := &testAssetsUnlockedEndpoint{
+				events: []bridgetypes.AssetsUnlockedEvent{event},
+			}
+
+			baj := &batchAttestationJob{
+				env: &environment{
+					logger:             log.NewNopLogger(),
+					mezoBridgeContract: mockMezoBridgeContract,
+				},
+				assetsUnlockedEndpoint: assetsUnlockedEndpoint,
+				chainID:                chainID,
+				signatures:             newSignatureStore(),
+			}
+
+			body, err := json.Marshal(api.SubmitSignatureRequest{
+				Entry:     api.NewAssetsUnlockedEntry(&test.entry),
+				Signature: test.signature,
+			})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			baj.handleSubmitSignature(
+				recorder,
+				httptest.NewRequest(
+					http.MethodPost,
+					api.SubmitSignaturePath,
+					bytes.NewReader(body),
+				),
+			)
+
+			require.Equal(t, test.expectedStatusCode, recorder.Code)
+			require.Equal(t, test.expectedSignatures, baj.signatures.size())
+			require.Equal(t, test.expectedMezoCalls, assetsUnlockedEndpoint.calls)
+
+			if test.expectedSignatures > 0 {
+				pending := baj.signatures.pending()
+				require.Len(t, pending, 1)
+				// Signatures are stored with V of 27 or 28.
+				require.Equal(
+					t,
+					ethereumSignature,
+					pending[0].signatures[validatorAddress],
+				)
+			}
+		})
+	}
+}
+
+func TestSubmitBatchAttestations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	chainID := big.NewInt(11155111)
+	entry := testBatchAttestationEntry()
+
+	confirmedEntry := testBatchAttestationEntry()
+	confirmedEntry.UnlockSequenceNumber = big.NewInt(43)
+
+	store := newSignatureStore()
+
+	var signers []common.Address
+	signatures := map[common.Address][]byte{}
+	for i := 0; i < 3; i++ {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		signer := crypto.PubkeyToAddress(privateKey.PublicKey)
+		signature := testSignBatchAttestation(t, entry, chainID, privateKey)
+
+		signers = append(signers, signer)
+		signatures[signer] = signature
+
+		store.add(entry, signer, signature)
+	}
+	store.add(confirmedEntry, signers[0], signatures[signers[0]])
+
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i][:], signers[j][:]) < 0
+	})
+
+	// The threshold is 2 so only the signatures of the two lowest signer
+	// addresses are expected.
+	expectedSignatures := append(
+		bytes.Clone(signatures[signers[0]]),
+		signatures[signers[1]]...,
+	)
+
+	mockMezoBridgeContract := NewMockMezoBridgeContract(ctrl)
+	mockMezoBridgeContract.EXPECT().
+		AttestationThreshold().
+		Return(big.NewInt(2), nil).
+		Times(2)
+	mockMezoBridgeContract.EXPECT().
+		ConfirmedUnlocks(entry.UnlockSequenceNumber).
+		Return(false, nil).
+		Times(2)
+	mockMezoBridgeContract.EXPECT().
+		ConfirmedUnlocks(confirmedEntry.UnlockSequenceNumber).
+		Return(true, nil).
+		Times(1)
+	mockMezoBridgeContract.EXPECT().
+		AttestBridgeOutWithSignatures(entry, expectedSignatures).
+		Return(types.NewTx(&types.DynamicFeeTx{}), nil).
+		Times(1)
+
+	baj := &batchAttestationJob{
+		env: &environment{
+			logger:             log.NewNopLogger(),
+			mezoBridgeContract: mockMezoBridgeContract,
+		},
+		chainID:    chainID,
+		signatures: store,
+	}
+
+	baj.submitBatchAttestations()
+
+	pending := store.pending()
+	require.Len(t, pending, 1, "confirmed entry should be dropped")
+	require.Equal(t, entry.UnlockSequenceNumber, pending[0].entry.UnlockSequenceNumber)
+	require.WithinDuration(t, time.Now(), pending[0].lastSubmission, time.Minute)
+
+	// The entry was just submitted so it must not be submitted again before
+	// the resubmission backoff elapses.
+	baj.submitBatchAttestations()
+}
+
+func TestSubmitBatchAttestations_SubmissionFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	chainID := big.NewInt(11155111)
+	entry := testBatchAttestationEntry()
+
+	store := newSignatureStore()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	store.add(
+		entry,
+		crypto.PubkeyToAddress(privateKey.PublicKey),
+		testSignBatchAttestation(t, entry, chainID, privateKey),
+	)
+
+	mockMezoBridgeContract := NewMockMezoBridgeContract(ctrl)
+	mockMezoBridgeContract.EXPECT().
+		AttestationThreshold().
+		Return(big.NewInt(1), nil).
+		Times(2)
+	mockMezoBridgeContract.EXPECT().
+		ConfirmedUnlocks(entry.UnlockSequenceNumber).
+		Return(false, nil).
+		Times(2)
+	gomock.InOrder(
+		mockMezoBridgeContract.EXPECT().
+			AttestBridgeOutWithSignatures(entry, gomock.Any()).
+			Return(nil, fmt.Errorf("connection refused")),
+		mockMezoBridgeContract.EXPECT().
+			AttestBridgeOutWithSignatures(entry, gomock.Any()).
+			Return(types.NewTx(&types.DynamicFeeTx{}), nil),
+	)
+
+	baj := &batchAttestationJob{
+		env: &environment{
+			logger:             log.NewNopLogger(),
+			mezoBridgeContract: mockMezoBridgeContract,
+		},
+		chainID:    chainID,
+		signatures: store,
+	}
+
+	// The failed submission is not recorded so the entry is submitted again
+	// with the next check instead of waiting for the resubmission backoff.
+	baj.submitBatchAttestations()
+	require.True(t, store.pending()[0].lastSubmission.IsZero())
+
+	baj.submitBatchAttestations()
+	require.WithinDuration(t, time.Now(), store.pending()[0].lastSubmission, time.Minute)
+}
+
+func TestSignatureStore(t *testing.T) {
+	store := newSignatureStore()
+
+	entries := []portal.MezoBridgeAssetsUnlocked{}
+	for _, unlockSequence := range []int64{3, 1, 2} {
+		entry := testBatchAttestationEntry()
+		entry.UnlockSequenceNumber = big.NewInt(unlockSequence)
+		entries = append(entries, entry)
+	}
+
+	signer := common.HexToAddress("0x01")
+	for _, entry := range entries {
+		require.Equal(t, 1, store.add(entry, signer, []byte{0x01}))
+	}
+
+	// A signature of the same signer replaces the previous one.
+	require.Equal(t, 1, store.add(entries[0], signer, []byte{0x02}))
+	require.Equal(
+		t,
+		2,
+		store.add(entries[0], common.HexToAddress("0x02"), []byte{0x03}),
+	)
+
+	pending := store.pending()
+	require.Len(t, pending, 3)
+	for i, collected := range pending {
+		require.Equal(
+			t,
+			fmt.Sprintf("%d", i+1),
+			collected.entry.UnlockSequenceNumber.String(),
+		)
+	}
+	require.Equal(t, [][]byte{{0x02}, {0x03}}, pending[2].sortedSignatures())
+
+	store.remove(big.NewInt(3))
+	require.Equal(t, 2, store.size())
+}
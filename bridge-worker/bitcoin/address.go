@@ -0,0 +1,116 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+)
+
+// EncodeAddress encodes the given output script as a Bitcoin address of the
+// given network. Supported script types are P2PKH, P2WPKH, P2SH, P2WSH and
+// P2TR.
+func EncodeAddress(script Script, network Network) (string, error) {
+	params, err := networkParams(network)
+	if err != nil {
+		return "", err
+	}
+
+	var address btcutil.Address
+
+	switch scriptType := GetScriptType(script); scriptType {
+	case P2PKHScript:
+		publicKeyHash, _ := ExtractPublicKeyHash(script)
+		address, err = btcutil.NewAddressPubKeyHash(publicKeyHash[:], params)
+	case P2WPKHScript:
+		publicKeyHash, _ := ExtractPublicKeyHash(script)
+		address, err = btcutil.NewAddressWitnessPubKeyHash(
+			publicKeyHash[:],
+			params,
+		)
+	case P2SHScript:
+		scriptHash, _ := ExtractScriptHash(script)
+		address, err = btcutil.NewAddressScriptHashFromHash(scriptHash, params)
+	case P2WSHScript:
+		scriptHash, _ := ExtractScriptHash(script)
+		address, err = btcutil.NewAddressWitnessScriptHash(scriptHash, params)
+	case P2TRScript:
+		outputKey, _ := ExtractTaprootOutputKey(script)
+		address, err = btcutil.NewAddressTaproot(outputKey[:], params)
+	default:
+		return "", fmt.Errorf(
+			"cannot encode address for script of type [%s]",
+			scriptType,
+		)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot encode address: [%v]", err)
+	}
+
+	return address.EncodeAddress(), nil
+}
+
+// DecodeAddress decodes the given Bitcoin address of the given network into
+// the output script paying to it. Supported address types are P2PKH, P2WPKH,
+// P2SH, P2WSH and P2TR.
+func DecodeAddress(address string, network Network) (Script, error) {
+	params, err := networkParams(network)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := btcutil.DecodeAddress(address, params)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot decode address [%s]: [%v]",
+			address,
+			err,
+		)
+	}
+
+	// DecodeAddress does not verify the human-readable part of segwit
+	// addresses against the given network.
+	if !decoded.IsForNet(params) {
+		return nil, fmt.Errorf(
+			"address [%s] is not for network [%s]",
+			address,
+			network,
+		)
+	}
+
+	switch a := decoded.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return PayToPublicKeyHash(*a.Hash160())
+	case *btcutil.AddressWitnessPubKeyHash:
+		return PayToWitnessPublicKeyHash(*a.Hash160())
+	case *btcutil.AddressScriptHash:
+		return PayToScriptHash(*a.Hash160())
+	case *btcutil.AddressWitnessScriptHash:
+		var witnessScriptHash [32]byte
+		copy(witnessScriptHash[:], a.WitnessProgram())
+		return PayToWitnessScriptHash(witnessScriptHash)
+	case *btcutil.AddressTaproot:
+		var outputKey [32]byte
+		copy(outputKey[:], a.WitnessProgram())
+		return PayToTaproot(outputKey)
+	default:
+		return nil, fmt.Errorf(
+			"unsupported type of address [%s]",
+			address,
+		)
+	}
+}
+
+// networkParams returns the chain parameters of the given network.
+func networkParams(network Network) (*chaincfg.Params, error) {
+	switch network {
+	case Mainnet:
+		return &chaincfg.MainNetParams, nil
+	case Testnet:
+		return &chaincfg.TestNet3Params, nil
+	case Regtest:
+		return &chaincfg.RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("unsupported network [%s]", network)
+	}
+}
@@ -0,0 +1,104 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeAddress(t *testing.T) {
+	tests := map[string]struct {
+		network   Network
+		address   string
+		scriptHex string
+	}{
+		"P2PKH mainnet": {
+			network:   Mainnet,
+			address:   "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			scriptHex: "76a91462e907b15cbf27d5425399ebf6f0fb50ebb88f1888ac",
+		},
+		"P2WPKH mainnet": {
+			network:   Mainnet,
+			address:   "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			scriptHex: "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		"P2WPKH regtest": {
+			network:   Regtest,
+			address:   "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+			scriptHex: "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		"P2SH mainnet": {
+			network:   Mainnet,
+			address:   "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+			scriptHex: "a914b472a266d0bd89c13706a4132ccfb16f7c3b9fcb87",
+		},
+		"P2WSH testnet": {
+			network:   Testnet,
+			address:   "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7",
+			scriptHex: "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+		},
+		"P2TR mainnet": {
+			network:   Mainnet,
+			address:   "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+			scriptHex: "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			script, err := DecodeAddress(test.address, test.network)
+			require.NoError(t, err)
+			require.Equal(t, test.scriptHex, hex.EncodeToString(script))
+
+			address, err := EncodeAddress(script, test.network)
+			require.NoError(t, err)
+			require.Equal(t, test.address, address)
+		})
+	}
+}
+
+func TestDecodeAddress_Errors(t *testing.T) {
+	tests := map[string]struct {
+		network       Network
+		address       string
+		expectedError string
+	}{
+		"unknown network": {
+			network:       Unknown,
+			address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			expectedError: "unsupported network [unknown]",
+		},
+		"malformed address": {
+			network:       Mainnet,
+			address:       "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+			expectedError: "cannot decode address",
+		},
+		"address of another network": {
+			network:       Testnet,
+			address:       "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			expectedError: "cannot decode address",
+		},
+		"segwit address of another network": {
+			network:       Mainnet,
+			address:       "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7",
+			expectedError: "is not for network [mainnet]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			_, err := DecodeAddress(test.address, test.network)
+			require.ErrorContains(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestEncodeAddress_NonStandardScript(t *testing.T) {
+	_, err := EncodeAddress(Script{0x6a, 0x01, 0x01}, Mainnet)
+	require.ErrorContains(
+		t,
+		err,
+		"cannot encode address for script of type [non-standard]",
+	)
+}
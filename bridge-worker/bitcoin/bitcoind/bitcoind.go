@@ -0,0 +1,844 @@
+package bitcoind
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+	"golang.org/x/exp/slices"
+
+	"github.com/keep-network/keep-common/pkg/wrappers"
+	"github.com/mezo-org/mezod/bridge-worker/bitcoin"
+)
+
+// listTransactionsPageSize is the number of wallet transactions fetched with
+// a single listtransactions request.
+const listTransactionsPageSize = 1000
+
+// Connection is a handle for interactions with Bitcoin Core JSON-RPC server.
+//
+// Bitcoin Core does not maintain an address index so the history of the
+// given script is obtained from the configured descriptor wallet. A script is
+// imported into the wallet, under a label being the hex-encoded script, the
+// first time its history is requested. The import rescans the chain from the
+// configured rescan start so the node must not be pruned below it. Fetching
+// transactions not belonging to the wallet requires the node to run with the
+// transaction index enabled (-txindex).
+type Connection struct {
+	logger     log.Logger
+	parentCtx  context.Context
+	config     Config
+	httpClient *http.Client
+	requestID  atomic.Uint64
+
+	// trackedScripts holds labels of scripts known to be imported into
+	// the wallet.
+	trackedScripts      map[string]bool
+	trackedScriptsMutex sync.Mutex
+
+	// importMutex serializes script imports so a script is not imported
+	// twice by concurrent requests. It is held across the import, unlike
+	// trackedScriptsMutex, so requests for tracked scripts do not wait for
+	// the rescan.
+	importMutex sync.Mutex
+}
+
+// Connect initializes handle with provided Config.
+func Connect(
+	parentCtx context.Context,
+	config Config,
+	logger log.Logger,
+) (bitcoin.Chain, error) {
+	c := &Connection{
+		logger:         logger,
+		parentCtx:      parentCtx,
+		config:         config,
+		httpClient:     &http.Client{},
+		trackedScripts: make(map[string]bool),
+	}
+
+	if err := c.verifyServer(); err != nil {
+		return nil, fmt.Errorf("failed to verify bitcoind server: [%w]", err)
+	}
+
+	return c, nil
+}
+
+// GetTransaction gets the transaction with the given transaction hash.
+// If the transaction with the given hash was not found on the chain,
+// this function returns an error.
+func (c *Connection) GetTransaction(
+	transactionHash bitcoin.Hash,
+) (*bitcoin.Transaction, error) {
+	txID := transactionHash.Hex(bitcoin.ReversedByteOrder)
+
+	rawTransaction, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (string, error) {
+			var tx string
+			err := c.call(ctx, "", "getrawtransaction", []any{txID}, &tx)
+			if err != nil {
+				if isTxNotFoundErr(err) {
+					// The transaction was not found on the chain. There is
+					// no point in retrying the request and losing time.
+					return "", nil
+				}
+
+				return "", err
+			}
+
+			return tx, nil
+		},
+		"getrawtransaction",
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get raw transaction with ID [%s]: [%w]",
+			txID,
+			err,
+		)
+	}
+	if len(rawTransaction) == 0 {
+		return nil, fmt.Errorf(
+			"failed to get raw transaction with ID [%s]: [%v]",
+			txID,
+			fmt.Errorf("not found"),
+		)
+	}
+
+	transactionBytes, err := hex.DecodeString(rawTransaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode a hex string: [%w]", err)
+	}
+
+	result := &bitcoin.Transaction{}
+	if err := result.Deserialize(transactionBytes); err != nil {
+		return nil, fmt.Errorf("failed to convert transaction: [%w]", err)
+	}
+
+	return result, nil
+}
+
+// GetTxHashesForPublicKeyHash gets hashes of confirmed transactions that pays
+// the given public key hash using either a P2PKH or P2WPKH script. The returned
+// transactions hashes are ordered by block height in the ascending order, i.e.
+// the latest transaction hash is at the end of the list. The returned list does
+// not contain unconfirmed transactions hashes living in the mempool at the
+// moment of request.
+func (c *Connection) GetTxHashesForPublicKeyHash(
+	publicKeyHash [20]byte,
+) ([]bitcoin.Hash, error) {
+	scripts, err := publicKeyHashScripts(publicKeyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := c.getReceivingTransactions(scripts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get history for public key hash [0x%x]: [%w]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	return convertWalletTransactions(confirmedTransactions(transactions))
+}
+
+// GetTxHashesForScript gets hashes of confirmed transactions that pay the
+// given output script (P2PKH, P2WPKH, P2SH, P2WSH, P2TR, etc.). The returned
+// transactions hashes are ordered by block height in the ascending order,
+// i.e. the latest transaction hash is at the end of the list. The returned
+// list does not contain unconfirmed transactions hashes living in the
+// mempool at the moment of request.
+func (c *Connection) GetTxHashesForScript(
+	script bitcoin.Script,
+) ([]bitcoin.Hash, error) {
+	transactions, err := c.getReceivingTransactions(script)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get history for script [0x%x]: [%w]",
+			script,
+			err,
+		)
+	}
+
+	return convertWalletTransactions(confirmedTransactions(transactions))
+}
+
+// GetMempoolTxHashesForPublicKeyHash gets hashes of unconfirmed
+// transactions living in the mempool that pay the given public key hash
+// using either a P2PKH or P2WPKH script. The returned list does not
+// contain confirmed transactions hashes.
+func (c *Connection) GetMempoolTxHashesForPublicKeyHash(
+	publicKeyHash [20]byte,
+) ([]bitcoin.Hash, error) {
+	scripts, err := publicKeyHashScripts(publicKeyHash)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := c.getReceivingTransactions(scripts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get history for public key hash [0x%x]: [%w]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	// Transactions living in the mempool have zero confirmations. Negative
+	// confirmations denote transactions conflicting with the chain.
+	items := make([]*walletTransaction, 0)
+	for _, transaction := range transactions {
+		if transaction.Confirmations == 0 {
+			items = append(items, transaction)
+		}
+	}
+
+	return convertWalletTransactions(items)
+}
+
+// GetTransactionConfirmations gets the number of confirmations for the
+// transaction with the given transaction hash. If the transaction with the
+// given hash was not found on the chain, this function returns an error.
+// A transaction living in the mempool has zero confirmations.
+func (c *Connection) GetTransactionConfirmations(
+	transactionHash bitcoin.Hash,
+) (uint, error) {
+	txID := transactionHash.Hex(bitcoin.ReversedByteOrder)
+
+	transaction, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*verboseTransaction, error) {
+			result := &verboseTransaction{}
+			err := c.call(ctx, "", "getrawtransaction", []any{txID, true}, result)
+			if err != nil {
+				if isTxNotFoundErr(err) {
+					// The transaction was not found on the chain. There is
+					// no point in retrying the request and losing time.
+					return nil, nil
+				}
+
+				return nil, err
+			}
+
+			return result, nil
+		},
+		"getrawtransaction",
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to get transaction with ID [%s]: [%w]",
+			txID,
+			err,
+		)
+	}
+	if transaction == nil {
+		return 0, fmt.Errorf(
+			"failed to get transaction with ID [%s]: [%v]",
+			txID,
+			fmt.Errorf("not found"),
+		)
+	}
+
+	// The confirmations field is not set for transactions living in the
+	// mempool so they have zero confirmations.
+	return transaction.Confirmations, nil
+}
+
+// GetLatestBlockHeight gets the height of the latest block (tip). If the
+// latest block was not determined, this function returns an error.
+func (c *Connection) GetLatestBlockHeight() (uint, error) {
+	blockHeight, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (uint, error) {
+			var result uint
+			err := c.call(ctx, "", "getblockcount", []any{}, &result)
+			return result, err
+		},
+		"getblockcount",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the blocks tip height: [%w]", err)
+	}
+
+	return blockHeight, nil
+}
+
+// GetBlockHeader gets the block header for the given block height. If the
+// block with the given height was not found on the chain, this function
+// returns an error.
+func (c *Connection) GetBlockHeader(
+	blockHeight uint,
+) (*bitcoin.BlockHeader, error) {
+	rawBlockHeader, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (string, error) {
+			var blockHash string
+			err := c.call(ctx, "", "getblockhash", []any{blockHeight}, &blockHash)
+			if err != nil {
+				return "", fmt.Errorf("failed to get block hash: [%w]", err)
+			}
+
+			var result string
+			err = c.call(ctx, "", "getblockheader", []any{blockHash, false}, &result)
+			return result, err
+		},
+		"getblockheader",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header: [%w]", err)
+	}
+
+	blockHeaderBytes, err := hex.DecodeString(rawBlockHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode a hex string: [%w]", err)
+	}
+
+	if len(blockHeaderBytes) != bitcoin.BlockHeaderByteLength {
+		return nil, fmt.Errorf(
+			"wrong block header length: [%d]",
+			len(blockHeaderBytes),
+		)
+	}
+
+	blockHeader := &bitcoin.BlockHeader{}
+	blockHeader.Deserialize([bitcoin.BlockHeaderByteLength]byte(blockHeaderBytes))
+
+	return blockHeader, nil
+}
+
+// EstimateSatPerVByteFee returns the estimated sat/vbyte fee for a
+// transaction to be confirmed within the given number of blocks.
+func (c *Connection) EstimateSatPerVByteFee(blocks uint32) (int64, error) {
+	estimate, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*feeEstimate, error) {
+			result := &feeEstimate{}
+			err := c.call(ctx, "", "estimatesmartfee", []any{blocks}, result)
+			return result, err
+		},
+		"estimatesmartfee",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get fee: [%w]", err)
+	}
+
+	// The fee rate is not set if the node does not have enough information
+	// to make an estimate.
+	if estimate.FeeRate == nil {
+		return 0, fmt.Errorf(
+			"cannot estimate fee for confirmation in [%v] blocks; "+
+				"server returned errors [%s]",
+			blocks,
+			strings.Join(estimate.Errors, ", "),
+		)
+	}
+
+	// The fee rate is expressed in BTC/kvB. Convert it to sat/vbyte.
+	satPerVByteFee := math.Ceil(*estimate.FeeRate * 1e5)
+
+	return int64(satPerVByteFee), nil
+}
+
+// BroadcastTransaction broadcasts the given transaction over the
+// network of the Bitcoin chain nodes. If the broadcast action could not be
+// done, this function returns an error. This function does not give any
+// guarantees regarding transaction mining. The transaction may be mined or
+// rejected eventually.
+func (c *Connection) BroadcastTransaction(
+	transaction *bitcoin.Transaction,
+) error {
+	rawTx := hex.EncodeToString(transaction.Serialize())
+
+	txID, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (string, error) {
+			var result string
+			err := c.call(ctx, "", "sendrawtransaction", []any{rawTx}, &result)
+			return result, err
+		},
+		"sendrawtransaction",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast the transaction: [%w]", err)
+	}
+
+	c.logger.Info(
+		"transaction broadcast successful",
+		"tx_id", txID,
+	)
+
+	return nil
+}
+
+// getReceivingTransactions returns wallet entries of transactions that pay
+// any of the given scripts, both confirmed and unconfirmed. A single
+// transaction may pay the scripts with multiple outputs but is returned only
+// once.
+func (c *Connection) getReceivingTransactions(
+	scripts ...bitcoin.Script,
+) ([]*walletTransaction, error) {
+	if err := c.trackScripts(scripts...); err != nil {
+		return nil, fmt.Errorf("cannot track scripts: [%w]", err)
+	}
+
+	receiving := make([]*walletTransaction, 0)
+	seen := make(map[string]bool)
+	for _, script := range scripts {
+		label := scriptLabel(script)
+
+		transactions := make([]*walletTransaction, 0)
+		for skip := 0; ; skip += listTransactionsPageSize {
+			page, err := requestWithRetry(
+				c,
+				func(ctx context.Context) ([]*walletTransaction, error) {
+					var result []*walletTransaction
+					err := c.call(
+						ctx,
+						c.config.Wallet,
+						"listtransactions",
+						[]any{label, listTransactionsPageSize, skip, true},
+						&result,
+					)
+					return result, err
+				},
+				"listtransactions",
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"cannot list transactions for script [0x%x]: [%w]",
+					script,
+					err,
+				)
+			}
+
+			transactions = append(transactions, page...)
+
+			if len(page) < listTransactionsPageSize {
+				break
+			}
+		}
+
+		// Each output paying the script is reported as a separate wallet
+		// entry. Entries of other categories (e.g. `send`) denote
+		// transactions spending from the script.
+		for _, transaction := range transactions {
+			if transaction.Category != "receive" || seen[transaction.TxID] {
+				continue
+			}
+
+			seen[transaction.TxID] = true
+			receiving = append(receiving, transaction)
+		}
+	}
+
+	return receiving, nil
+}
+
+// confirmedTransactions returns the given wallet transactions that are
+// confirmed, ordered by block height in the ascending order. Unconfirmed
+// transactions as well as transactions conflicting with the chain have
+// non-positive confirmations and are skipped.
+func confirmedTransactions(
+	transactions []*walletTransaction,
+) []*walletTransaction {
+	confirmed := make([]*walletTransaction, 0)
+	for _, transaction := range transactions {
+		if transaction.Confirmations > 0 {
+			confirmed = append(confirmed, transaction)
+		}
+	}
+
+	sort.SliceStable(
+		confirmed,
+		func(i, j int) bool {
+			return confirmed[i].BlockHeight < confirmed[j].BlockHeight
+		},
+	)
+
+	return confirmed
+}
+
+// convertWalletTransactions returns hashes of the given wallet transactions.
+func convertWalletTransactions(
+	transactions []*walletTransaction,
+) ([]bitcoin.Hash, error) {
+	txHashes := make([]bitcoin.Hash, len(transactions))
+	for i, transaction := range transactions {
+		txHash, err := bitcoin.NewHashFromString(
+			transaction.TxID,
+			bitcoin.ReversedByteOrder,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cannot parse hash [%s]: [%v]",
+				transaction.TxID,
+				err,
+			)
+		}
+
+		txHashes[i] = txHash
+	}
+
+	return txHashes, nil
+}
+
+// trackScripts makes sure the given scripts are imported into the wallet.
+// Scripts not imported yet are imported along with a rescan of the chain
+// from the configured rescan start so the wallet knows their history.
+func (c *Connection) trackScripts(scripts ...bitcoin.Script) error {
+	untracked := c.untrackedScripts(scripts)
+	if len(untracked) == 0 {
+		return nil
+	}
+
+	c.importMutex.Lock()
+	defer c.importMutex.Unlock()
+
+	// The scripts may have been imported by a concurrent request while
+	// waiting for the import mutex.
+	untracked = c.untrackedScripts(untracked)
+	if len(untracked) == 0 {
+		return nil
+	}
+
+	labels, err := requestWithRetry(
+		c,
+		func(ctx context.Context) ([]string, error) {
+			var result []string
+			err := c.call(ctx, c.config.Wallet, "listlabels", []any{}, &result)
+			return result, err
+		},
+		"listlabels",
+	)
+	if err != nil {
+		return fmt.Errorf("cannot get wallet labels: [%w]", err)
+	}
+
+	requests := make([]*importDescriptorRequest, 0)
+	for _, script := range untracked {
+		label := scriptLabel(script)
+
+		if slices.Contains(labels, label) {
+			c.markScriptsTracked(label)
+			continue
+		}
+
+		descriptor, err := c.descriptorWithChecksum(
+			fmt.Sprintf("raw(%s)", hex.EncodeToString(script)),
+		)
+		if err != nil {
+			return err
+		}
+
+		requests = append(requests, &importDescriptorRequest{
+			Descriptor: descriptor,
+			// Scan the chain from the rescan start to get the history
+			// since the bridge deployment.
+			Timestamp: c.config.RescanStartTimestamp,
+			Label:     label,
+		})
+	}
+
+	if len(requests) == 0 {
+		return nil
+	}
+
+	c.logger.Info(
+		"importing scripts into the bitcoind wallet; "+
+			"this rescans the chain and may take a while",
+		"scripts", len(requests),
+		"wallet", c.config.Wallet,
+		"rescan_start_timestamp", c.config.RescanStartTimestamp,
+	)
+
+	startTime := time.Now()
+
+	// The import is not retried as the rescan may take long and a retried
+	// import would start the rescan from scratch.
+	importCtx, importCancel := context.WithTimeout(
+		c.parentCtx,
+		c.config.ImportTimeout,
+	)
+	defer importCancel()
+
+	var results []*importDescriptorResult
+	err = c.call(
+		importCtx,
+		c.config.Wallet,
+		"importdescriptors",
+		[]any{requests},
+		&results,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot import descriptors: [%w]", err)
+	}
+
+	for i, result := range results {
+		if !result.Success {
+			return fmt.Errorf(
+				"cannot import descriptor [%s]: [%v]",
+				requests[i].Descriptor,
+				result.Error,
+			)
+		}
+	}
+
+	c.logger.Info(
+		"imported scripts into the bitcoind wallet",
+		"scripts", len(requests),
+		"wallet", c.config.Wallet,
+		"import_duration", time.Since(startTime),
+	)
+
+	for _, request := range requests {
+		c.markScriptsTracked(request.Label)
+	}
+
+	return nil
+}
+
+// untrackedScripts returns the given scripts that are not known to be
+// imported into the wallet.
+func (c *Connection) untrackedScripts(
+	scripts []bitcoin.Script,
+) []bitcoin.Script {
+	c.trackedScriptsMutex.Lock()
+	defer c.trackedScriptsMutex.Unlock()
+
+	untracked := make([]bitcoin.Script, 0)
+	for _, script := range scripts {
+		if !c.trackedScripts[scriptLabel(script)] {
+			untracked = append(untracked, script)
+		}
+	}
+
+	return untracked
+}
+
+// markScriptsTracked records scripts with the given labels as imported into
+// the wallet.
+func (c *Connection) markScriptsTracked(labels ...string) {
+	c.trackedScriptsMutex.Lock()
+	defer c.trackedScriptsMutex.Unlock()
+
+	for _, label := range labels {
+		c.trackedScripts[label] = true
+	}
+}
+
+// descriptorWithChecksum returns the given output descriptor with the
+// checksum appended, as required by the descriptor import.
+func (c *Connection) descriptorWithChecksum(descriptor string) (string, error) {
+	info, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*descriptorInfo, error) {
+			result := &descriptorInfo{}
+			err := c.call(ctx, "", "getdescriptorinfo", []any{descriptor}, result)
+			return result, err
+		},
+		"getdescriptorinfo",
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"cannot get info of descriptor [%s]: [%w]",
+			descriptor,
+			err,
+		)
+	}
+
+	return fmt.Sprintf("%s#%s", descriptor, info.Checksum), nil
+}
+
+// publicKeyHashScripts returns the P2PKH and P2WPKH scripts of the given
+// public key hash.
+func publicKeyHashScripts(publicKeyHash [20]byte) ([]bitcoin.Script, error) {
+	p2pkh, err := bitcoin.PayToPublicKeyHash(publicKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot build P2PKH for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	p2wpkh, err := bitcoin.PayToWitnessPublicKeyHash(publicKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot build P2WPKH for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	return []bitcoin.Script{p2pkh, p2wpkh}, nil
+}
+
+// scriptLabel returns the wallet label the given script is imported under.
+func scriptLabel(script bitcoin.Script) string {
+	return hex.EncodeToString(script)
+}
+
+func isTxNotFoundErr(err error) bool {
+	var rpcErr *rpcError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.Code == rpcErrorInvalidAddressOrKey
+}
+
+func (c *Connection) verifyServer() error {
+	networkInfo, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*networkInfo, error) {
+			result := &networkInfo{}
+			err := c.call(ctx, "", "getnetworkinfo", []any{}, result)
+			return result, err
+		},
+		"getnetworkinfo",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get network info: [%w]", err)
+	}
+
+	blockchainInfo, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*blockchainInfo, error) {
+			result := &blockchainInfo{}
+			err := c.call(ctx, "", "getblockchaininfo", []any{}, result)
+			return result, err
+		},
+		"getblockchaininfo",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get blockchain info: [%w]", err)
+	}
+
+	c.logger.Info(
+		"connected to bitcoind server",
+		"version", networkInfo.Version,
+		"subversion", networkInfo.Subversion,
+		"chain", blockchainInfo.Chain,
+		"blocks", blockchainInfo.Blocks,
+	)
+
+	if blockchainInfo.Pruned {
+		c.logger.Warn(
+			"bitcoind server is pruned; the history of newly tracked " +
+				"public key hashes may be incomplete",
+		)
+	}
+
+	indexInfo, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (map[string]*indexInfo, error) {
+			result := make(map[string]*indexInfo)
+			err := c.call(ctx, "", "getindexinfo", []any{}, &result)
+			return result, err
+		},
+		"getindexinfo",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get index info: [%w]", err)
+	}
+
+	if _, ok := indexInfo["txindex"]; !ok {
+		c.logger.Warn(
+			"bitcoind server runs without the transaction index; only " +
+				"wallet and mempool transactions can be fetched",
+		)
+	}
+
+	walletInfo, err := requestWithRetry(
+		c,
+		func(ctx context.Context) (*walletInfo, error) {
+			result := &walletInfo{}
+			err := c.call(ctx, c.config.Wallet, "getwalletinfo", []any{}, result)
+			return result, err
+		},
+		"getwalletinfo",
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get info of wallet [%s]: [%w]",
+			c.config.Wallet,
+			err,
+		)
+	}
+
+	if !walletInfo.Descriptors {
+		return fmt.Errorf(
+			"wallet [%s] is not a descriptor wallet",
+			c.config.Wallet,
+		)
+	}
+
+	if walletInfo.PrivateKeysEnabled {
+		c.logger.Warn(
+			"bitcoind wallet has private keys enabled; a watch-only "+
+				"wallet is recommended",
+			"wallet", c.config.Wallet,
+		)
+	}
+
+	return nil
+}
+
+func requestWithRetry[K interface{}](
+	c *Connection,
+	requestFn func(ctx context.Context) (K, error),
+	requestName string,
+) (K, error) {
+	startTime := time.Now()
+
+	c.logger.Debug(
+		"starting request to bitcoind server",
+		"request_name", requestName,
+	)
+
+	var result K
+
+	err := wrappers.DoWithDefaultRetry(
+		c.parentCtx,
+		c.config.RequestRetryTimeout,
+		func(ctx context.Context) error {
+			requestCtx, requestCancel := context.WithTimeout(ctx, c.config.RequestTimeout)
+			defer requestCancel()
+
+			r, err := requestFn(requestCtx)
+			if err != nil {
+				return fmt.Errorf("request failed: [%w]", err)
+			}
+
+			result = r
+			return nil
+		})
+
+	solveRequestOutcome := func(err error) string {
+		if err != nil {
+			return fmt.Sprintf("error: [%v]", err)
+		}
+		return "success"
+	}
+
+	c.logger.Debug(
+		"request to bitcoind server completed",
+		"request_name", requestName,
+		"outcome", solveRequestOutcome(err),
+		"request_duration", time.Since(startTime),
+	)
+
+	return result, err
+}
@@ -0,0 +1,731 @@
+package bitcoind
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mezo-org/mezod/bridge-worker/bitcoin"
+)
+
+const (
+	testUser     = "user"
+	testPassword = "password"
+	testWallet   = "bridge"
+
+	testRescanStartTimestamp = 1700000000
+)
+
+type rpcHandler func(params []json.RawMessage) (any, *rpcError)
+
+// mockServer is a Bitcoin Core JSON-RPC server serving the configured
+// handlers. Handlers of wallet calls are keyed by the wallet path and the
+// method, e.g. `/wallet/bridge:getwalletinfo`.
+type mockServer struct {
+	t        *testing.T
+	handlers map[string]rpcHandler
+}
+
+func newMockServer(t *testing.T, handlers map[string]rpcHandler) *httptest.Server {
+	ms := &mockServer{
+		t:        t,
+		handlers: handlers,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(ms.serveHTTP))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func (ms *mockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok || user != testUser || password != testPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var request struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	require.NoError(ms.t, json.NewDecoder(r.Body).Decode(&request))
+
+	call := request.Method
+	if r.URL.Path != "/" {
+		call = r.URL.Path + ":" + call
+	}
+
+	response := map[string]any{"id": request.ID}
+
+	handler, ok := ms.handlers[call]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		response["error"] = &rpcError{Code: -32601, Message: "Method not found"}
+	} else {
+		result, rpcErr := handler(request.Params)
+		if rpcErr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			response["error"] = rpcErr
+		} else {
+			response["result"] = result
+		}
+	}
+
+	require.NoError(ms.t, json.NewEncoder(w).Encode(response))
+}
+
+// verifyServerHandlers returns handlers of calls made upon connection.
+func verifyServerHandlers(descriptors bool) map[string]rpcHandler {
+	return map[string]rpcHandler{
+		"getnetworkinfo": func([]json.RawMessage) (any, *rpcError) {
+			return &networkInfo{Version: 270000, Subversion: "/Satoshi:27.0.0/"}, nil
+		},
+		"getblockchaininfo": func([]json.RawMessage) (any, *rpcError) {
+			return &blockchainInfo{Chain: "regtest", Blocks: 101}, nil
+		},
+		"getindexinfo": func([]json.RawMessage) (any, *rpcError) {
+			return map[string]*indexInfo{"txindex": {Synced: true, BestBlockHeight: 101}}, nil
+		},
+		"/wallet/" + testWallet + ":getwalletinfo": func([]json.RawMessage) (any, *rpcError) {
+			return &walletInfo{WalletName: testWallet, Descriptors: descriptors}, nil
+		},
+	}
+}
+
+func connect(t *testing.T, url string, user string) (*Connection, error) {
+	chain, err := Connect(
+		context.Background(),
+		Config{
+			URL:                  url,
+			User:                 user,
+			Password:             testPassword,
+			Wallet:               testWallet,
+			RequestTimeout:       time.Second,
+			RequestRetryTimeout:  time.Second,
+			ImportTimeout:        time.Second,
+			RescanStartTimestamp: testRescanStartTimestamp,
+		},
+		log.NewNopLogger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chain.(*Connection), nil
+}
+
+func TestConnect(t *testing.T) {
+	tests := map[string]struct {
+		user        string
+		descriptors bool
+		expectedErr string
+	}{
+		"descriptor wallet": {
+			user:        testUser,
+			descriptors: true,
+		},
+		"legacy wallet": {
+			user:        testUser,
+			descriptors: false,
+			expectedErr: "wallet [bridge] is not a descriptor wallet",
+		},
+		"invalid credentials": {
+			user:        "other",
+			descriptors: true,
+			expectedErr: "unexpected response with status [401]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			server := newMockServer(t, verifyServerHandlers(test.descriptors))
+
+			_, err := connect(t, server.URL, test.user)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetTransaction(t *testing.T) {
+	transaction := &bitcoin.Transaction{
+		Version: 1,
+		Inputs: []*bitcoin.TransactionInput{
+			{
+				Outpoint: &bitcoin.TransactionOutpoint{
+					TransactionHash: bitcoin.Hash{0x01},
+					OutputIndex:     1,
+				},
+				SignatureScript: []byte{},
+				Witness:         [][]byte{{0x02, 0x03}, {0x04}},
+				Sequence:        0xffffffff,
+			},
+		},
+		Outputs: []*bitcoin.TransactionOutput{
+			{
+				Value:           100000,
+				PublicKeyScript: []byte{0x00, 0x14, 0x05},
+			},
+		},
+		Locktime: 0,
+	}
+	txID := transaction.Hash().Hex(bitcoin.ReversedByteOrder)
+
+	handlers := verifyServerHandlers(true)
+	handlers["getrawtransaction"] = func(params []json.RawMessage) (any, *rpcError) {
+		var requestedTxID string
+		require.NoError(t, json.Unmarshal(params[0], &requestedTxID))
+
+		if requestedTxID != txID {
+			return nil, &rpcError{
+				Code:    rpcErrorInvalidAddressOrKey,
+				Message: "No such mempool or blockchain transaction.",
+			}
+		}
+
+		return hex.EncodeToString(transaction.Serialize()), nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	result, err := connection.GetTransaction(transaction.Hash())
+	require.NoError(t, err)
+	require.Equal(t, transaction, result)
+
+	_, err = connection.GetTransaction(bitcoin.Hash{0xff})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestGetTxHashesForPublicKeyHash(t *testing.T) {
+	publicKeyHash := [20]byte{0x8d, 0xb5, 0x0e, 0xb5, 0x20}
+
+	p2pkh, err := bitcoin.PayToPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+	p2wpkh, err := bitcoin.PayToWitnessPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+
+	p2pkhLabel := hex.EncodeToString(p2pkh)
+	p2wpkhLabel := hex.EncodeToString(p2wpkh)
+
+	txID := func(b byte) string {
+		return bitcoin.Hash{b}.Hex(bitcoin.ReversedByteOrder)
+	}
+
+	walletTransactions := map[string][]*walletTransaction{
+		p2pkhLabel: {
+			{TxID: txID(0x03), Category: "receive", Confirmations: 1, BlockHeight: 300},
+			{TxID: txID(0x01), Category: "receive", Confirmations: 3, BlockHeight: 100},
+			// Another output of the same transaction.
+			{TxID: txID(0x01), Category: "receive", Confirmations: 3, BlockHeight: 100},
+			// Transaction spending from the script.
+			{TxID: txID(0x04), Category: "send", Confirmations: 2, BlockHeight: 200},
+			// Unconfirmed transaction.
+			{TxID: txID(0x05), Category: "receive", Confirmations: 0},
+		},
+		p2wpkhLabel: {
+			{TxID: txID(0x02), Category: "receive", Confirmations: 2, BlockHeight: 200},
+			// Transaction paying both scripts.
+			{TxID: txID(0x03), Category: "receive", Confirmations: 1, BlockHeight: 300},
+			// Transaction conflicting with the chain.
+			{TxID: txID(0x06), Category: "receive", Confirmations: -1},
+		},
+	}
+
+	expectedTxHashes := []bitcoin.Hash{{0x01}, {0x02}, {0x03}}
+
+	tests := map[string]struct {
+		labels          []string
+		expectedImports []*importDescriptorRequest
+	}{
+		"scripts not imported": {
+			labels: []string{},
+			expectedImports: []*importDescriptorRequest{
+				{
+					Descriptor: "raw(" + p2pkhLabel + ")#checksum",
+					Timestamp:  testRescanStartTimestamp,
+					Label:      p2pkhLabel,
+				},
+				{
+					Descriptor: "raw(" + p2wpkhLabel + ")#checksum",
+					Timestamp:  testRescanStartTimestamp,
+					Label:      p2wpkhLabel,
+				},
+			},
+		},
+		"P2PKH script already imported": {
+			labels: []string{"", p2pkhLabel},
+			expectedImports: []*importDescriptorRequest{
+				{
+					Descriptor: "raw(" + p2wpkhLabel + ")#checksum",
+					Timestamp:  testRescanStartTimestamp,
+					Label:      p2wpkhLabel,
+				},
+			},
+		},
+		"scripts already imported": {
+			labels:          []string{"", p2pkhLabel, p2wpkhLabel},
+			expectedImports: nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var imports atomic.Int32
+
+			handlers := verifyServerHandlers(true)
+			handlers["/wallet/"+testWallet+":listlabels"] = func([]json.RawMessage) (any, *rpcError) {
+				return test.labels, nil
+			}
+			handlers["getdescriptorinfo"] = func(params []json.RawMessage) (any, *rpcError) {
+				var descriptor string
+				require.NoError(t, json.Unmarshal(params[0], &descriptor))
+				return &descriptorInfo{Descriptor: descriptor, Checksum: "checksum"}, nil
+			}
+			handlers["/wallet/"+testWallet+":importdescriptors"] = func(params []json.RawMessage) (any, *rpcError) {
+				var requests []*importDescriptorRequest
+				require.NoError(t, json.Unmarshal(params[0], &requests))
+				require.Equal(t, test.expectedImports, requests)
+
+				imports.Add(1)
+
+				results := make([]*importDescriptorResult, len(requests))
+				for i := range results {
+					results[i] = &importDescriptorResult{Success: true}
+				}
+				return results, nil
+			}
+			handlers["/wallet/"+testWallet+":listtransactions"] = func(params []json.RawMessage) (any, *rpcError) {
+				var requestedLabel string
+				require.NoError(t, json.Unmarshal(params[0], &requestedLabel))
+				require.Contains(t, walletTransactions, requestedLabel)
+				return walletTransactions[requestedLabel], nil
+			}
+
+			server := newMockServer(t, handlers)
+
+			connection, err := connect(t, server.URL, testUser)
+			require.NoError(t, err)
+
+			txHashes, err := connection.GetTxHashesForPublicKeyHash(publicKeyHash)
+			require.NoError(t, err)
+			require.Equal(t, expectedTxHashes, txHashes)
+
+			// The scripts are tracked so they must not be imported again.
+			txHashes, err = connection.GetTxHashesForPublicKeyHash(publicKeyHash)
+			require.NoError(t, err)
+			require.Equal(t, expectedTxHashes, txHashes)
+
+			expectedImports := 0
+			if len(test.expectedImports) > 0 {
+				expectedImports = 1
+			}
+			require.Equal(t, expectedImports, int(imports.Load()))
+		})
+	}
+}
+
+func TestGetTxHashesForPublicKeyHash_ImportFailed(t *testing.T) {
+	handlers := verifyServerHandlers(true)
+	handlers["/wallet/"+testWallet+":listlabels"] = func([]json.RawMessage) (any, *rpcError) {
+		return []string{}, nil
+	}
+	handlers["getdescriptorinfo"] = func(params []json.RawMessage) (any, *rpcError) {
+		return &descriptorInfo{Checksum: "checksum"}, nil
+	}
+	handlers["/wallet/"+testWallet+":importdescriptors"] = func([]json.RawMessage) (any, *rpcError) {
+		return []*importDescriptorResult{
+			{Success: true},
+			{Success: false, Error: &rpcError{Code: -4, Message: "Rescan failed"}},
+		}, nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	_, err = connection.GetTxHashesForPublicKeyHash([20]byte{0x01})
+	require.ErrorContains(t, err, "Rescan failed")
+}
+
+func TestGetTxHashesForScript(t *testing.T) {
+	script, err := bitcoin.PayToTaproot([32]byte{0x79, 0xbe, 0x66, 0x7e})
+	require.NoError(t, err)
+	label := hex.EncodeToString(script)
+
+	txID := func(b byte) string {
+		return bitcoin.Hash{b}.Hex(bitcoin.ReversedByteOrder)
+	}
+
+	var imports atomic.Int32
+
+	handlers := verifyServerHandlers(true)
+	handlers["/wallet/"+testWallet+":listlabels"] = func([]json.RawMessage) (any, *rpcError) {
+		return []string{}, nil
+	}
+	handlers["getdescriptorinfo"] = func(params []json.RawMessage) (any, *rpcError) {
+		var descriptor string
+		require.NoError(t, json.Unmarshal(params[0], &descriptor))
+		return &descriptorInfo{Descriptor: descriptor, Checksum: "checksum"}, nil
+	}
+	handlers["/wallet/"+testWallet+":importdescriptors"] = func(params []json.RawMessage) (any, *rpcError) {
+		var requests []*importDescriptorRequest
+		require.NoError(t, json.Unmarshal(params[0], &requests))
+		require.Equal(
+			t,
+			[]*importDescriptorRequest{
+				{
+					Descriptor: "raw(" + label + ")#checksum",
+					Timestamp:  testRescanStartTimestamp,
+					Label:      label,
+				},
+			},
+			requests,
+		)
+
+		imports.Add(1)
+		return []*importDescriptorResult{{Success: true}}, nil
+	}
+	handlers["/wallet/"+testWallet+":listtransactions"] = func(params []json.RawMessage) (any, *rpcError) {
+		var requestedLabel string
+		require.NoError(t, json.Unmarshal(params[0], &requestedLabel))
+		require.Equal(t, label, requestedLabel)
+		return []*walletTransaction{
+			{TxID: txID(0x02), Category: "receive", Confirmations: 1, BlockHeight: 200},
+			{TxID: txID(0x01), Category: "receive", Confirmations: 2, BlockHeight: 100},
+			{TxID: txID(0x03), Category: "receive", Confirmations: 0},
+		}, nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	txHashes, err := connection.GetTxHashesForScript(script)
+	require.NoError(t, err)
+	require.Equal(t, []bitcoin.Hash{{0x01}, {0x02}}, txHashes)
+	require.Equal(t, 1, int(imports.Load()))
+}
+
+func TestGetTxHashesForScript_ConcurrentImport(t *testing.T) {
+	trackedScript, err := bitcoin.PayToTaproot([32]byte{0x01})
+	require.NoError(t, err)
+	importedScript, err := bitcoin.PayToTaproot([32]byte{0x02})
+	require.NoError(t, err)
+
+	var imports atomic.Int32
+	importStarted := make(chan struct{}, 1)
+	importReleased := make(chan struct{})
+
+	handlers := verifyServerHandlers(true)
+	handlers["/wallet/"+testWallet+":listlabels"] = func([]json.RawMessage) (any, *rpcError) {
+		return []string{hex.EncodeToString(trackedScript)}, nil
+	}
+	handlers["getdescriptorinfo"] = func(params []json.RawMessage) (any, *rpcError) {
+		var descriptor string
+		require.NoError(t, json.Unmarshal(params[0], &descriptor))
+		return &descriptorInfo{Descriptor: descriptor, Checksum: "checksum"}, nil
+	}
+	handlers["/wallet/"+testWallet+":importdescriptors"] = func([]json.RawMessage) (any, *rpcError) {
+		imports.Add(1)
+		importStarted <- struct{}{}
+		<-importReleased
+		return []*importDescriptorResult{{Success: true}}, nil
+	}
+	handlers["/wallet/"+testWallet+":listtransactions"] = func([]json.RawMessage) (any, *rpcError) {
+		return []*walletTransaction{}, nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	// The script is already imported into the wallet so it is tracked
+	// without an import.
+	_, err = connection.GetTxHashesForScript(trackedScript)
+	require.NoError(t, err)
+
+	importErrs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := connection.GetTxHashesForScript(importedScript)
+			importErrs <- err
+		}()
+	}
+
+	<-importStarted
+
+	// The history of the tracked script is available while the other
+	// script is being imported.
+	_, err = connection.GetTxHashesForScript(trackedScript)
+	require.NoError(t, err)
+
+	close(importReleased)
+
+	require.NoError(t, <-importErrs)
+	require.NoError(t, <-importErrs)
+
+	// Concurrent requests import the script only once.
+	require.Equal(t, 1, int(imports.Load()))
+}
+
+func TestGetMempoolTxHashesForPublicKeyHash(t *testing.T) {
+	publicKeyHash := [20]byte{0x8d, 0xb5, 0x0e, 0xb5, 0x20}
+
+	p2pkh, err := bitcoin.PayToPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+	p2wpkh, err := bitcoin.PayToWitnessPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+
+	p2pkhLabel := hex.EncodeToString(p2pkh)
+	p2wpkhLabel := hex.EncodeToString(p2wpkh)
+
+	txID := func(b byte) string {
+		return bitcoin.Hash{b}.Hex(bitcoin.ReversedByteOrder)
+	}
+
+	walletTransactions := map[string][]*walletTransaction{
+		p2pkhLabel: {
+			{TxID: txID(0x01), Category: "receive", Confirmations: 3, BlockHeight: 100},
+			{TxID: txID(0x02), Category: "receive", Confirmations: 0},
+			// Another output of the same transaction.
+			{TxID: txID(0x02), Category: "receive", Confirmations: 0},
+			{TxID: txID(0x03), Category: "send", Confirmations: 0},
+		},
+		p2wpkhLabel: {
+			{TxID: txID(0x04), Category: "receive", Confirmations: -1},
+			{TxID: txID(0x05), Category: "receive", Confirmations: 0},
+			// Transaction paying both scripts.
+			{TxID: txID(0x02), Category: "receive", Confirmations: 0},
+		},
+	}
+
+	handlers := verifyServerHandlers(true)
+	handlers["/wallet/"+testWallet+":listlabels"] = func([]json.RawMessage) (any, *rpcError) {
+		return []string{p2pkhLabel, p2wpkhLabel}, nil
+	}
+	handlers["/wallet/"+testWallet+":listtransactions"] = func(params []json.RawMessage) (any, *rpcError) {
+		var requestedLabel string
+		require.NoError(t, json.Unmarshal(params[0], &requestedLabel))
+		require.Contains(t, walletTransactions, requestedLabel)
+		return walletTransactions[requestedLabel], nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	txHashes, err := connection.GetMempoolTxHashesForPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+	require.Equal(t, []bitcoin.Hash{{0x02}, {0x05}}, txHashes)
+}
+
+func TestGetTransactionConfirmations(t *testing.T) {
+	confirmedTxID := bitcoin.Hash{0x01}.Hex(bitcoin.ReversedByteOrder)
+	mempoolTxID := bitcoin.Hash{0x02}.Hex(bitcoin.ReversedByteOrder)
+
+	handlers := verifyServerHandlers(true)
+	handlers["getrawtransaction"] = func(params []json.RawMessage) (any, *rpcError) {
+		var requestedTxID string
+		require.NoError(t, json.Unmarshal(params[0], &requestedTxID))
+
+		var verbose bool
+		require.NoError(t, json.Unmarshal(params[1], &verbose))
+		require.True(t, verbose)
+
+		switch requestedTxID {
+		case confirmedTxID:
+			return map[string]any{"txid": requestedTxID, "confirmations": 6}, nil
+		case mempoolTxID:
+			return map[string]any{"txid": requestedTxID}, nil
+		default:
+			return nil, &rpcError{
+				Code:    rpcErrorInvalidAddressOrKey,
+				Message: "No such mempool or blockchain transaction.",
+			}
+		}
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	confirmations, err := connection.GetTransactionConfirmations(bitcoin.Hash{0x01})
+	require.NoError(t, err)
+	require.Equal(t, uint(6), confirmations)
+
+	confirmations, err = connection.GetTransactionConfirmations(bitcoin.Hash{0x02})
+	require.NoError(t, err)
+	require.Equal(t, uint(0), confirmations)
+
+	_, err = connection.GetTransactionConfirmations(bitcoin.Hash{0x03})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestGetBlockHeader(t *testing.T) {
+	// Header of the Bitcoin mainnet genesis block.
+	genesisBlockHash := "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+	genesisBlockHeader := "0100000000000000000000000000000000000000000000000000000000000000" +
+		"000000003ba3edfd7a7b12b27ac72c3e67768f617fc81bc3888a51323a9fb8aa" +
+		"4b1e5e4a29ab5f49ffff001d1dac2b7c"
+
+	handlers := verifyServerHandlers(true)
+	handlers["getblockcount"] = func([]json.RawMessage) (any, *rpcError) {
+		return 101, nil
+	}
+	handlers["getblockhash"] = func(params []json.RawMessage) (any, *rpcError) {
+		var blockHeight uint
+		require.NoError(t, json.Unmarshal(params[0], &blockHeight))
+
+		if blockHeight != 0 {
+			return nil, &rpcError{Code: -8, Message: "Block height out of range"}
+		}
+
+		return genesisBlockHash, nil
+	}
+	handlers["getblockheader"] = func(params []json.RawMessage) (any, *rpcError) {
+		var blockHash string
+		require.NoError(t, json.Unmarshal(params[0], &blockHash))
+		require.Equal(t, genesisBlockHash, blockHash)
+
+		var verbose bool
+		require.NoError(t, json.Unmarshal(params[1], &verbose))
+		require.False(t, verbose)
+
+		return genesisBlockHeader, nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	blockHeight, err := connection.GetLatestBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint(101), blockHeight)
+
+	blockHeader, err := connection.GetBlockHeader(0)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), blockHeader.Version)
+	require.Equal(t, bitcoin.Hash{}, blockHeader.PreviousBlockHeaderHash)
+	require.Equal(t, uint32(1231006505), blockHeader.Time)
+	require.Equal(t, uint32(0x1d00ffff), blockHeader.Bits)
+	require.Equal(t, uint32(2083236893), blockHeader.Nonce)
+	require.Equal(
+		t,
+		genesisBlockHash,
+		blockHeader.Hash().Hex(bitcoin.ReversedByteOrder),
+	)
+
+	serialized := blockHeader.Serialize()
+	require.Equal(t, genesisBlockHeader, hex.EncodeToString(serialized[:]))
+
+	_, err = connection.GetBlockHeader(1)
+	require.ErrorContains(t, err, "failed to get block hash")
+}
+
+func TestEstimateSatPerVByteFee(t *testing.T) {
+	tests := map[string]struct {
+		result      map[string]any
+		expectedFee int64
+		expectedErr string
+	}{
+		"fee rate estimated": {
+			result:      map[string]any{"feerate": 0.00012345, "blocks": 6},
+			expectedFee: 13,
+		},
+		"fee rate not estimated": {
+			result: map[string]any{
+				"errors": []string{"Insufficient data or no feerate found"},
+				"blocks": 6,
+			},
+			expectedErr: "Insufficient data or no feerate found",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			handlers := verifyServerHandlers(true)
+			handlers["estimatesmartfee"] = func(params []json.RawMessage) (any, *rpcError) {
+				var blocks uint32
+				require.NoError(t, json.Unmarshal(params[0], &blocks))
+				require.Equal(t, uint32(6), blocks)
+
+				return test.result, nil
+			}
+
+			server := newMockServer(t, handlers)
+
+			connection, err := connect(t, server.URL, testUser)
+			require.NoError(t, err)
+
+			fee, err := connection.EstimateSatPerVByteFee(6)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expectedFee, fee)
+			}
+		})
+	}
+}
+
+func TestBroadcastTransaction(t *testing.T) {
+	transaction := &bitcoin.Transaction{
+		Version: 2,
+		Inputs: []*bitcoin.TransactionInput{
+			{
+				Outpoint: &bitcoin.TransactionOutpoint{
+					TransactionHash: bitcoin.Hash{0x01},
+					OutputIndex:     0,
+				},
+				Sequence: 0xffffffff,
+			},
+		},
+		Outputs: []*bitcoin.TransactionOutput{
+			{
+				Value:           50000,
+				PublicKeyScript: []byte{0x00, 0x14, 0x05},
+			},
+		},
+	}
+
+	var broadcast atomic.Value
+
+	handlers := verifyServerHandlers(true)
+	handlers["sendrawtransaction"] = func(params []json.RawMessage) (any, *rpcError) {
+		var rawTx string
+		require.NoError(t, json.Unmarshal(params[0], &rawTx))
+		broadcast.Store(rawTx)
+
+		return transaction.Hash().Hex(bitcoin.ReversedByteOrder), nil
+	}
+
+	server := newMockServer(t, handlers)
+
+	connection, err := connect(t, server.URL, testUser)
+	require.NoError(t, err)
+
+	require.NoError(t, connection.BroadcastTransaction(transaction))
+	require.Equal(t, hex.EncodeToString(transaction.Serialize()), broadcast.Load())
+}
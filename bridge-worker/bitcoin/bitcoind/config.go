@@ -0,0 +1,44 @@
+package bitcoind
+
+import "time"
+
+const (
+	// DefaultRequestTimeout is a default timeout used for a single attempt of
+	// Bitcoin Core JSON-RPC request.
+	DefaultRequestTimeout = 30 * time.Second
+	// DefaultRequestRetryTimeout is a default timeout used for Bitcoin Core
+	// JSON-RPC request retries.
+	DefaultRequestRetryTimeout = 2 * time.Minute
+	// DefaultImportTimeout is a default timeout used for importing a wallet
+	// descriptor. The import rescans the chain so it takes much longer than
+	// a regular request.
+	DefaultImportTimeout = 1 * time.Hour
+)
+
+// Config holds configurable properties.
+type Config struct {
+	// URL to the Bitcoin Core JSON-RPC server in format:
+	// `scheme://hostname:port`.
+	URL string `json:"url"`
+	// User used to authenticate JSON-RPC requests.
+	User string `json:"user"`
+	// Password used to authenticate JSON-RPC requests.
+	Password string `json:"password"`
+	// Wallet is the name of the descriptor wallet used to track the history
+	// of Bitcoin scripts. The wallet must be loaded and should have private
+	// keys disabled.
+	Wallet string `json:"wallet"`
+	// Timeout for a single attempt of JSON-RPC request.
+	RequestTimeout time.Duration `json:"request_timeout"`
+	// Timeout for JSON-RPC request retries.
+	RequestRetryTimeout time.Duration `json:"request_retry_timeout"`
+	// Timeout for importing a wallet descriptor along with the rescan of
+	// the chain.
+	ImportTimeout time.Duration `json:"import_timeout"`
+	// RescanStartTimestamp is the UNIX timestamp, in seconds, of the block
+	// from which the chain is rescanned when a script is imported into the
+	// wallet. It should be set to a time before the bridge deployment, so
+	// no history of bridge scripts is missed, and as late as possible to
+	// keep rescans short. Zero rescans the chain from the genesis block.
+	RescanStartTimestamp int64 `json:"rescan_start_timestamp"`
+}
@@ -0,0 +1,176 @@
+package bitcoind
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// rpcErrorInvalidAddressOrKey is the Bitcoin Core RPC error code returned,
+// among others, when the requested transaction does not exist.
+const rpcErrorInvalidAddressOrKey = -5
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+	ID     uint64          `json:"id"`
+}
+
+// rpcError is an error returned by the Bitcoin Core JSON-RPC server.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("rpc error [%d]: [%s]", e.Code, e.Message)
+}
+
+type networkInfo struct {
+	Version    int    `json:"version"`
+	Subversion string `json:"subversion"`
+}
+
+type blockchainInfo struct {
+	Chain  string `json:"chain"`
+	Blocks int64  `json:"blocks"`
+	Pruned bool   `json:"pruned"`
+}
+
+type indexInfo struct {
+	Synced          bool  `json:"synced"`
+	BestBlockHeight int64 `json:"best_block_height"`
+}
+
+type walletInfo struct {
+	WalletName         string `json:"walletname"`
+	Descriptors        bool   `json:"descriptors"`
+	PrivateKeysEnabled bool   `json:"private_keys_enabled"`
+}
+
+type descriptorInfo struct {
+	Descriptor string `json:"descriptor"`
+	Checksum   string `json:"checksum"`
+}
+
+type importDescriptorRequest struct {
+	Descriptor string `json:"desc"`
+	Timestamp  int64  `json:"timestamp"`
+	Label      string `json:"label"`
+}
+
+type importDescriptorResult struct {
+	Success bool      `json:"success"`
+	Error   *rpcError `json:"error,omitempty"`
+}
+
+type verboseTransaction struct {
+	TxID string `json:"txid"`
+	// Confirmations is not set for transactions living in the mempool.
+	Confirmations uint `json:"confirmations"`
+}
+
+type feeEstimate struct {
+	// FeeRate is expressed in BTC/kvB. It is not set if the estimate
+	// could not be made.
+	FeeRate *float64 `json:"feerate"`
+	Errors  []string `json:"errors"`
+}
+
+type walletTransaction struct {
+	TxID          string `json:"txid"`
+	Category      string `json:"category"`
+	Confirmations int64  `json:"confirmations"`
+	BlockHeight   int64  `json:"blockheight"`
+}
+
+// call executes the given JSON-RPC method and unmarshals its result into the
+// given result. If the wallet is not empty, the method is executed against
+// that wallet.
+func (c *Connection) call(
+	ctx context.Context,
+	wallet string,
+	method string,
+	params []any,
+	result any,
+) error {
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "1.0",
+		ID:      c.requestID.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: [%w]", err)
+	}
+
+	endpoint := c.config.URL
+	if wallet != "" {
+		endpoint = strings.TrimSuffix(endpoint, "/") +
+			"/wallet/" + url.PathEscape(wallet)
+	}
+
+	request, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		endpoint,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create request: [%w]", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if c.config.User != "" || c.config.Password != "" {
+		request.SetBasicAuth(c.config.User, c.config.Password)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send request: [%w]", err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: [%w]", err)
+	}
+
+	// Bitcoin Core replies with a JSON-RPC response for both successful and
+	// failed calls, regardless of the HTTP status. Authentication failures
+	// are the exception and come with an empty body.
+	var rpcResponse rpcResponse
+	if err := json.Unmarshal(responseBody, &rpcResponse); err != nil {
+		return fmt.Errorf(
+			"unexpected response with status [%d]: [%s]",
+			response.StatusCode,
+			strings.TrimSpace(string(responseBody)),
+		)
+	}
+
+	if rpcResponse.Error != nil {
+		return rpcResponse.Error
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(rpcResponse.Result, result); err != nil {
+		return fmt.Errorf("failed to unmarshal result: [%w]", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package bitcoin
+
+import (
+	"encoding/binary"
+)
+
+// BlockHeaderByteLength is the byte length of a serialized block header.
+const BlockHeaderByteLength = 80
+
+// BlockHeader represents the header of a Bitcoin block. For reference, see:
+// https://developer.bitcoin.org/reference/block_chain.html#block-headers
+type BlockHeader struct {
+	// Version is the block version number that indicates which set of block
+	// validation rules to follow.
+	Version int32
+	// PreviousBlockHeaderHash is the hash of the previous block's header.
+	PreviousBlockHeaderHash Hash
+	// MerkleRootHash is a hash derived from the hashes of all transactions
+	// included in this block.
+	MerkleRootHash Hash
+	// Time is a Unix epoch time when the miner started hashing the header.
+	Time uint32
+	// Bits determines the target threshold this block's header hash must be
+	// less than or equal to.
+	Bits uint32
+	// Nonce is an arbitrary number miners change to modify the header hash
+	// in order to produce a hash less than or equal to the target threshold.
+	Nonce uint32
+}
+
+// Serialize serializes the block header to a byte array using the block
+// header serialization format:
+// [Version][PreviousBlockHeaderHash][MerkleRootHash][Time][Bits][Nonce].
+func (bh *BlockHeader) Serialize() [BlockHeaderByteLength]byte {
+	var result [BlockHeaderByteLength]byte
+
+	// #nosec G115
+	binary.LittleEndian.PutUint32(result[0:4], uint32(bh.Version))
+	copy(result[4:36], bh.PreviousBlockHeaderHash[:])
+	copy(result[36:68], bh.MerkleRootHash[:])
+	binary.LittleEndian.PutUint32(result[68:72], bh.Time)
+	binary.LittleEndian.PutUint32(result[72:76], bh.Bits)
+	binary.LittleEndian.PutUint32(result[76:80], bh.Nonce)
+
+	return result
+}
+
+// Deserialize deserializes a byte array to a BlockHeader using the block
+// header serialization format:
+// [Version][PreviousBlockHeaderHash][MerkleRootHash][Time][Bits][Nonce].
+func (bh *BlockHeader) Deserialize(rawBlockHeader [BlockHeaderByteLength]byte) {
+	// #nosec G115
+	bh.Version = int32(binary.LittleEndian.Uint32(rawBlockHeader[0:4]))
+	copy(bh.PreviousBlockHeaderHash[:], rawBlockHeader[4:36])
+	copy(bh.MerkleRootHash[:], rawBlockHeader[36:68])
+	bh.Time = binary.LittleEndian.Uint32(rawBlockHeader[68:72])
+	bh.Bits = binary.LittleEndian.Uint32(rawBlockHeader[72:76])
+	bh.Nonce = binary.LittleEndian.Uint32(rawBlockHeader[76:80])
+}
+
+// Hash calculates the block header's hash as the double SHA-256 of the
+// block header serialization format.
+func (bh *BlockHeader) Hash() Hash {
+	serialized := bh.Serialize()
+	return ComputeHash(serialized[:])
+}
@@ -17,4 +17,48 @@ type Chain interface {
 	GetTxHashesForPublicKeyHash(
 		publicKeyHash [20]byte,
 	) ([]Hash, error)
+
+	// GetTxHashesForScript gets hashes of confirmed transactions that pay the
+	// given output script of any type (P2PKH, P2WPKH, P2SH, P2WSH, P2TR). The
+	// returned transactions hashes are ordered by block height in the
+	// ascending order, i.e. the latest transaction hash is at the end of the
+	// list. The returned list does not contain unconfirmed transactions
+	// hashes living in the mempool at the moment of request.
+	GetTxHashesForScript(
+		script Script,
+	) ([]Hash, error)
+
+	// GetMempoolTxHashesForPublicKeyHash gets hashes of unconfirmed
+	// transactions living in the mempool that pay the given public key hash
+	// using either a P2PKH or P2WPKH script. The returned list does not
+	// contain confirmed transactions hashes.
+	GetMempoolTxHashesForPublicKeyHash(
+		publicKeyHash [20]byte,
+	) ([]Hash, error)
+
+	// GetTransactionConfirmations gets the number of confirmations for the
+	// transaction with the given transaction hash. If the transaction with the
+	// given hash was not found on the chain, this function returns an error.
+	// A transaction living in the mempool has zero confirmations.
+	GetTransactionConfirmations(transactionHash Hash) (uint, error)
+
+	// GetLatestBlockHeight gets the height of the latest block (tip). If the
+	// latest block was not determined, this function returns an error.
+	GetLatestBlockHeight() (uint, error)
+
+	// GetBlockHeader gets the block header for the given block height. If the
+	// block with the given height was not found on the chain, this function
+	// returns an error.
+	GetBlockHeader(blockHeight uint) (*BlockHeader, error)
+
+	// EstimateSatPerVByteFee returns the estimated sat/vbyte fee for a
+	// transaction to be confirmed within the given number of blocks.
+	EstimateSatPerVByteFee(blocks uint32) (int64, error)
+
+	// BroadcastTransaction broadcasts the given transaction over the
+	// network of the Bitcoin chain nodes. If the broadcast action could not be
+	// done, this function returns an error. This function does not give any
+	// guarantees regarding transaction mining. The transaction may be mined or
+	// rejected eventually.
+	BroadcastTransaction(transaction *Transaction) error
 }
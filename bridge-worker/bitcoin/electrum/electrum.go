@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -170,6 +171,353 @@ func (c *Connection) GetTxHashesForPublicKeyHash(
 	return txHashes, nil
 }
 
+// GetTxHashesForScript gets hashes of confirmed transactions that pay the
+// given output script of any type (P2PKH, P2WPKH, P2SH, P2WSH, P2TR). The
+// returned transactions hashes are ordered by block height in the ascending
+// order, i.e. the latest transaction hash is at the end of the list. The
+// returned list does not contain unconfirmed transactions hashes living in
+// the mempool at the moment of request.
+func (c *Connection) GetTxHashesForScript(
+	script bitcoin.Script,
+) ([]bitcoin.Hash, error) {
+	items, err := c.getConfirmedScriptHistory(script)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]bitcoin.Hash, len(items))
+	for i, item := range items {
+		txHashes[i] = item.txHash
+	}
+
+	return txHashes, nil
+}
+
+// GetMempoolTxHashesForPublicKeyHash gets hashes of unconfirmed
+// transactions living in the mempool that pay the given public key hash
+// using either a P2PKH or P2WPKH script. The returned list does not
+// contain confirmed transactions hashes.
+func (c *Connection) GetMempoolTxHashesForPublicKeyHash(
+	publicKeyHash [20]byte,
+) ([]bitcoin.Hash, error) {
+	p2pkh, err := bitcoin.PayToPublicKeyHash(publicKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot build P2PKH for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	p2wpkh, err := bitcoin.PayToWitnessPublicKeyHash(publicKeyHash)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot build P2WPKH for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	p2pkhTxHashes, err := c.getScriptMempool(p2pkh)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get P2PKH mempool items for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	p2wpkhTxHashes, err := c.getScriptMempool(p2wpkh)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get P2WPKH mempool items for public key hash [0x%x]: [%v]",
+			publicKeyHash,
+			err,
+		)
+	}
+
+	return append(p2pkhTxHashes, p2wpkhTxHashes...), nil
+}
+
+// GetTransactionConfirmations gets the number of confirmations for the
+// transaction with the given transaction hash. If the transaction with the
+// given hash was not found on the chain, this function returns an error.
+// A transaction living in the mempool has zero confirmations.
+func (c *Connection) GetTransactionConfirmations(
+	transactionHash bitcoin.Hash,
+) (uint, error) {
+	txID := transactionHash.Hex(bitcoin.ReversedByteOrder)
+
+	rawTransaction, err := requestWithRetry(
+		c,
+		func(ctx context.Context, client *electrum.Client) (string, error) {
+			// We cannot use `GetTransaction` to get the the transaction details
+			// as Esplora/Electrs doesn't support verbose transactions.
+			// See: https://github.com/Blockstream/electrs/pull/36
+			return client.GetRawTransaction(ctx, txID)
+		},
+		"GetRawTransaction",
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to get raw transaction with ID [%s]: [%w]",
+			txID,
+			err,
+		)
+	}
+
+	tx, err := decodeTransaction(rawTransaction)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to decode the transaction [%s]: [%w]",
+			rawTransaction,
+			err,
+		)
+	}
+
+	// As a workaround for the problem described in
+	// https://github.com/Blockstream/electrs/pull/36 we need to calculate the
+	// number of confirmations based on the latest block height and block
+	// height of the transaction. Electrum protocol doesn't expose a function
+	// to get the transaction's block height (other than the `GetTransaction`
+	// that is unsupported by Esplora/Electrs). To get the block height of
+	// the transaction we query the history of transactions for the output
+	// script hashes, as the history contains the transaction's block height.
+
+	// Initialize txBlockHeight with minimum int32 value to identify a problem
+	// when a block height was not found in a history of any of the script
+	// hashes.
+	txBlockHeight := int32(math.MinInt32)
+txOutLoop:
+	for _, txOut := range tx.TxOut {
+		scriptHash := sha256.Sum256(txOut.PkScript)
+		reversedScriptHash := bitcoin.Reverse(scriptHash[:])
+		reversedScriptHashString := hex.EncodeToString(reversedScriptHash)
+
+		scriptHashHistory, err := requestWithRetry(
+			c,
+			func(
+				ctx context.Context,
+				client *electrum.Client,
+			) ([]*electrum.GetMempoolResult, error) {
+				return client.GetHistory(ctx, reversedScriptHashString)
+			},
+			"GetHistory",
+		)
+		if err != nil {
+			// Don't return an error, but continue to the next TxOut entry.
+			c.logger.Error(
+				"failed to get history for script hash",
+				"tx_id", txID,
+				"script_hash", reversedScriptHashString,
+				"error", err,
+			)
+			continue txOutLoop
+		}
+
+		for _, transaction := range scriptHashHistory {
+			if transaction.Hash == txID {
+				txBlockHeight = transaction.Height
+				break txOutLoop
+			}
+		}
+	}
+
+	// If the height is still the initial value, the transaction was not
+	// found in the history.
+	if txBlockHeight == math.MinInt32 {
+		return 0, fmt.Errorf(
+			"failed to find the transaction block height in script hashes' histories",
+		)
+	}
+
+	// A transaction living in the mempool has height of either 0 or -1.
+	if txBlockHeight <= 0 {
+		return 0, nil
+	}
+
+	latestBlockHeight, err := c.GetLatestBlockHeight()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the latest block height: [%w]", err)
+	}
+
+	// #nosec G115
+	if latestBlockHeight < uint(txBlockHeight) {
+		return 0, fmt.Errorf(
+			"latest block height [%d] is lower than transaction block height [%d]",
+			latestBlockHeight,
+			txBlockHeight,
+		)
+	}
+
+	// #nosec G115
+	return latestBlockHeight - uint(txBlockHeight) + 1, nil
+}
+
+// GetLatestBlockHeight gets the height of the latest block (tip). If the
+// latest block was not determined, this function returns an error.
+func (c *Connection) GetLatestBlockHeight() (uint, error) {
+	blockHeight, err := requestWithRetry(
+		c,
+		func(ctx context.Context, client *electrum.Client) (int32, error) {
+			tip, err := client.SubscribeHeadersSingle(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("failed to get the blocks tip height: [%w]", err)
+			}
+
+			return tip.Height, nil
+		},
+		"SubscribeHeadersSingle",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to subscribe for headers: [%w]", err)
+	}
+
+	if blockHeight <= 0 {
+		return 0, fmt.Errorf("unexpected blocks tip height: [%d]", blockHeight)
+	}
+
+	// #nosec G115
+	return uint(blockHeight), nil
+}
+
+// GetBlockHeader gets the block header for the given block height. If the
+// block with the given height was not found on the chain, this function
+// returns an error.
+func (c *Connection) GetBlockHeader(
+	blockHeight uint,
+) (*bitcoin.BlockHeader, error) {
+	getBlockHeaderResult, err := requestWithRetry(
+		c,
+		func(
+			ctx context.Context,
+			client *electrum.Client,
+		) (*electrum.GetBlockHeaderResult, error) {
+			// #nosec G115
+			return client.GetBlockHeader(ctx, uint32(blockHeight), 0)
+		},
+		"GetBlockHeader",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block header: [%w]", err)
+	}
+
+	blockHeader, err := convertBlockHeader(getBlockHeaderResult.Header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert block header: [%w]", err)
+	}
+
+	return blockHeader, nil
+}
+
+// EstimateSatPerVByteFee returns the estimated sat/vbyte fee for a
+// transaction to be confirmed within the given number of blocks.
+func (c *Connection) EstimateSatPerVByteFee(blocks uint32) (int64, error) {
+	// According to Electrum protocol docs, the returned fee is BTC/KB.
+	btcPerKbFee, err := requestWithRetry(
+		c,
+		func(ctx context.Context, client *electrum.Client) (float32, error) {
+			return client.GetFee(ctx, blocks)
+		},
+		"GetFee",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get fee: [%w]", err)
+	}
+
+	// According to Electrum protocol docs, if the daemon does not have
+	// enough information to make an estimate, the integer -1 is returned.
+	if btcPerKbFee < 0 {
+		return 0, fmt.Errorf(
+			"cannot estimate fee for confirmation in [%v] blocks; "+
+				"server returned [%v]",
+			blocks,
+			btcPerKbFee,
+		)
+	}
+
+	// Convert BTC/KB to sat/vbyte.
+	satPerVByteFee := math.Ceil(float64(btcPerKbFee) * 1e5)
+
+	return int64(satPerVByteFee), nil
+}
+
+// BroadcastTransaction broadcasts the given transaction over the
+// network of the Bitcoin chain nodes. If the broadcast action could not be
+// done, this function returns an error. This function does not give any
+// guarantees regarding transaction mining. The transaction may be mined or
+// rejected eventually.
+func (c *Connection) BroadcastTransaction(
+	transaction *bitcoin.Transaction,
+) error {
+	rawTx := hex.EncodeToString(transaction.Serialize())
+
+	response, err := requestWithRetry(
+		c,
+		func(ctx context.Context, client *electrum.Client) (string, error) {
+			return client.BroadcastTransaction(ctx, rawTx)
+		},
+		"BroadcastTransaction",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast the transaction: [%w]", err)
+	}
+
+	c.logger.Info(
+		"transaction broadcast successful",
+		"tx_id", response,
+	)
+
+	return nil
+}
+
+// getScriptMempool returns hashes of unconfirmed transactions living in the
+// mempool for the given script (P2PKH, P2WPKH, P2SH, P2WSH, etc.).
+func (c *Connection) getScriptMempool(
+	script []byte,
+) ([]bitcoin.Hash, error) {
+	scriptHash := sha256.Sum256(script)
+	reversedScriptHash := bitcoin.Reverse(scriptHash[:])
+	reversedScriptHashString := hex.EncodeToString(reversedScriptHash)
+
+	items, err := requestWithRetry(
+		c,
+		func(
+			ctx context.Context,
+			client *electrum.Client,
+		) ([]*electrum.GetMempoolResult, error) {
+			return client.GetMempool(ctx, reversedScriptHashString)
+		},
+		"GetMempool",
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get mempool for script [0x%x]: [%v]",
+			script,
+			err,
+		)
+	}
+
+	txHashes := make([]bitcoin.Hash, 0, len(items))
+	for _, item := range items {
+		txHash, err := bitcoin.NewHashFromString(
+			item.Hash,
+			bitcoin.ReversedByteOrder,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cannot parse hash [%s]: [%v]",
+				item.Hash,
+				err,
+			)
+		}
+
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes, nil
+}
+
 // getConfirmedScriptHistory returns a history of confirmed transactions for
 // the given script (P2PKH, P2WPKH, P2SH, P2WSH, etc.). The returned list
 // is sorted by the block height in the ascending order, i.e. the latest
@@ -65,3 +65,24 @@ func decodeTransaction(rawTx string) (*wire.MsgTx, error) {
 
 	return &t, nil
 }
+
+// convertBlockHeader transforms a block header provided in the hexadecimal
+// serialized string to the format expected by the bitcoin.Chain interface.
+func convertBlockHeader(rawBlockHeader string) (*bitcoin.BlockHeader, error) {
+	blockHeaderBytes, err := hex.DecodeString(rawBlockHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode a hex string: [%w]", err)
+	}
+
+	if len(blockHeaderBytes) != bitcoin.BlockHeaderByteLength {
+		return nil, fmt.Errorf(
+			"wrong block header length: [%d]",
+			len(blockHeaderBytes),
+		)
+	}
+
+	blockHeader := &bitcoin.BlockHeader{}
+	blockHeader.Deserialize([bitcoin.BlockHeaderByteLength]byte(blockHeaderBytes))
+
+	return blockHeader, nil
+}
@@ -10,6 +10,36 @@ import (
 // byte-length of the script
 type Script []byte
 
+// ScriptType represents the possible types of Script.
+type ScriptType uint8
+
+// Script types enumeration.
+const (
+	NonStandardScript ScriptType = iota
+	P2PKHScript
+	P2WPKHScript
+	P2SHScript
+	P2WSHScript
+	P2TRScript
+)
+
+func (st ScriptType) String() string {
+	switch st {
+	case P2PKHScript:
+		return "p2pkh"
+	case P2WPKHScript:
+		return "p2wpkh"
+	case P2SHScript:
+		return "p2sh"
+	case P2WSHScript:
+		return "p2wsh"
+	case P2TRScript:
+		return "p2tr"
+	default:
+		return "non-standard"
+	}
+}
+
 // NewScriptFromVarLenData construct a Script instance based on the provided
 // variable length data prepended with a CompactSizeUint.
 func NewScriptFromVarLenData(varLenData []byte) (Script, error) {
@@ -54,6 +84,129 @@ func PayToWitnessPublicKeyHash(publicKeyHash [20]byte) (Script, error) {
 		Script()
 }
 
+// PayToScriptHash constructs a P2SH script for the provided 20-byte script
+// hash. The function assumes the provided script hash is valid.
+func PayToScriptHash(scriptHash [20]byte) (Script, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(scriptHash[:]).
+		AddOp(txscript.OP_EQUAL).
+		Script()
+}
+
+// PayToWitnessScriptHash constructs a P2WSH script for the provided 32-byte
+// witness script hash. The function assumes the provided script hash is
+// valid.
+func PayToWitnessScriptHash(witnessScriptHash [32]byte) (Script, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(witnessScriptHash[:]).
+		Script()
+}
+
+// PayToTaproot constructs a P2TR script for the provided 32-byte x-only
+// taproot output key. The function assumes the provided output key is valid.
+func PayToTaproot(outputKey [32]byte) (Script, error) {
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_1).
+		AddData(outputKey[:]).
+		Script()
+}
+
+// GetScriptType gets the ScriptType of the given Script.
+func GetScriptType(script Script) ScriptType {
+	switch {
+	case len(script) == 25 &&
+		script[0] == txscript.OP_DUP &&
+		script[1] == txscript.OP_HASH160 &&
+		script[2] == txscript.OP_DATA_20 &&
+		script[23] == txscript.OP_EQUALVERIFY &&
+		script[24] == txscript.OP_CHECKSIG:
+		return P2PKHScript
+	case len(script) == 22 &&
+		script[0] == txscript.OP_0 &&
+		script[1] == txscript.OP_DATA_20:
+		return P2WPKHScript
+	case len(script) == 23 &&
+		script[0] == txscript.OP_HASH160 &&
+		script[1] == txscript.OP_DATA_20 &&
+		script[22] == txscript.OP_EQUAL:
+		return P2SHScript
+	case len(script) == 34 &&
+		script[0] == txscript.OP_0 &&
+		script[1] == txscript.OP_DATA_32:
+		return P2WSHScript
+	case len(script) == 34 &&
+		script[0] == txscript.OP_1 &&
+		script[1] == txscript.OP_DATA_32:
+		return P2TRScript
+	default:
+		return NonStandardScript
+	}
+}
+
+// ExtractPublicKeyHash extracts the 20-byte public key hash from the given
+// P2PKH or P2WPKH script.
+func ExtractPublicKeyHash(script Script) ([20]byte, error) {
+	var publicKeyHash [20]byte
+
+	switch scriptType := GetScriptType(script); scriptType {
+	case P2PKHScript:
+		// The P2PKH public key hash is preceded by the OP_DUP, OP_HASH160
+		// and OP_DATA_20 bytes.
+		copy(publicKeyHash[:], script[3:23])
+	case P2WPKHScript:
+		// The P2WPKH public key hash is preceded by the OP_0 and OP_DATA_20
+		// bytes.
+		copy(publicKeyHash[:], script[2:])
+	default:
+		return [20]byte{}, fmt.Errorf(
+			"cannot extract public key hash from script of type [%s]",
+			scriptType,
+		)
+	}
+
+	return publicKeyHash, nil
+}
+
+// ExtractScriptHash extracts the script hash from the given P2SH or P2WSH
+// script. The returned hash is 20-byte long for P2SH and 32-byte long for
+// P2WSH.
+func ExtractScriptHash(script Script) ([]byte, error) {
+	switch scriptType := GetScriptType(script); scriptType {
+	case P2SHScript:
+		// The P2SH script hash is preceded by the OP_HASH160 and OP_DATA_20
+		// bytes.
+		return append([]byte{}, script[2:22]...), nil
+	case P2WSHScript:
+		// The P2WSH script hash is preceded by the OP_0 and OP_DATA_32
+		// bytes.
+		return append([]byte{}, script[2:]...), nil
+	default:
+		return nil, fmt.Errorf(
+			"cannot extract script hash from script of type [%s]",
+			scriptType,
+		)
+	}
+}
+
+// ExtractTaprootOutputKey extracts the 32-byte x-only taproot output key from
+// the given P2TR script.
+func ExtractTaprootOutputKey(script Script) ([32]byte, error) {
+	if scriptType := GetScriptType(script); scriptType != P2TRScript {
+		return [32]byte{}, fmt.Errorf(
+			"cannot extract taproot output key from script of type [%s]",
+			scriptType,
+		)
+	}
+
+	var outputKey [32]byte
+	// The P2TR output key is preceded by the OP_1 and OP_DATA_32 bytes.
+	copy(outputKey[:], script[2:])
+
+	return outputKey, nil
+}
+
 // ToVarLenData converts the Script to a byte array prepended with a
 // CompactSizeUint holding the script's byte length.
 func (s Script) ToVarLenData() ([]byte, error) {
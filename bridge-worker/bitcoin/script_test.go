@@ -0,0 +1,118 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScripts(t *testing.T) {
+	publicKeyHash := [20]byte(decodeHex(t, "8db50eb52063ea9d98b3eac91489a90f738986f6"))
+	scriptHash := [20]byte(decodeHex(t, "86a303cdd2e2eab1d1679f1a813835dc5a1b6532"))
+	witnessScriptHash := [32]byte(decodeHex(t, "1863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262"))
+	outputKey := [32]byte(decodeHex(t, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+
+	p2pkh, err := PayToPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+	p2wpkh, err := PayToWitnessPublicKeyHash(publicKeyHash)
+	require.NoError(t, err)
+	p2sh, err := PayToScriptHash(scriptHash)
+	require.NoError(t, err)
+	p2wsh, err := PayToWitnessScriptHash(witnessScriptHash)
+	require.NoError(t, err)
+	p2tr, err := PayToTaproot(outputKey)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		script       Script
+		expectedHex  string
+		expectedType ScriptType
+		expectedData []byte
+	}{
+		"P2PKH": {
+			script:       p2pkh,
+			expectedHex:  "76a9148db50eb52063ea9d98b3eac91489a90f738986f688ac",
+			expectedType: P2PKHScript,
+			expectedData: publicKeyHash[:],
+		},
+		"P2WPKH": {
+			script:       p2wpkh,
+			expectedHex:  "00148db50eb52063ea9d98b3eac91489a90f738986f6",
+			expectedType: P2WPKHScript,
+			expectedData: publicKeyHash[:],
+		},
+		"P2SH": {
+			script:       p2sh,
+			expectedHex:  "a91486a303cdd2e2eab1d1679f1a813835dc5a1b653287",
+			expectedType: P2SHScript,
+			expectedData: scriptHash[:],
+		},
+		"P2WSH": {
+			script:       p2wsh,
+			expectedHex:  "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+			expectedType: P2WSHScript,
+			expectedData: witnessScriptHash[:],
+		},
+		"P2TR": {
+			script:       p2tr,
+			expectedHex:  "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			expectedType: P2TRScript,
+			expectedData: outputKey[:],
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			require.Equal(t, test.expectedHex, hex.EncodeToString(test.script))
+			require.Equal(t, test.expectedType, GetScriptType(test.script))
+
+			var data []byte
+			switch test.expectedType {
+			case P2PKHScript, P2WPKHScript:
+				publicKeyHash, err := ExtractPublicKeyHash(test.script)
+				require.NoError(t, err)
+				data = publicKeyHash[:]
+			case P2SHScript, P2WSHScript:
+				data, err = ExtractScriptHash(test.script)
+				require.NoError(t, err)
+			case P2TRScript:
+				outputKey, err := ExtractTaprootOutputKey(test.script)
+				require.NoError(t, err)
+				data = outputKey[:]
+			}
+			require.Equal(t, test.expectedData, data)
+		})
+	}
+}
+
+func TestGetScriptType_NonStandard(t *testing.T) {
+	tests := map[string]string{
+		"empty script":                    "",
+		"P2PK":                            "2102" + "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" + "ac",
+		"OP_RETURN":                       "6a0401020304",
+		"truncated P2PKH":                 "76a9148db50eb52063ea9d98b3eac91489a90f738986f688",
+		"witness v1 with 20-byte program": "51148db50eb52063ea9d98b3eac91489a90f738986f6",
+	}
+
+	for testName, scriptHex := range tests {
+		t.Run(testName, func(t *testing.T) {
+			script := Script(decodeHex(t, scriptHex))
+
+			require.Equal(t, NonStandardScript, GetScriptType(script))
+
+			_, err := ExtractPublicKeyHash(script)
+			require.ErrorContains(t, err, "non-standard")
+			_, err = ExtractScriptHash(script)
+			require.ErrorContains(t, err, "non-standard")
+			_, err = ExtractTaprootOutputKey(script)
+			require.ErrorContains(t, err, "non-standard")
+		})
+	}
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
@@ -2,6 +2,7 @@ package bitcoin
 
 import (
 	"bytes"
+	"fmt"
 )
 
 // TransactionSerializationFormat represents the Bitcoin transaction
@@ -91,6 +92,20 @@ func (t *Transaction) Serialize(
 	}
 }
 
+// Deserialize deserializes the given byte array to the transaction. The
+// byte array can be in either Standard or Witness serialization format.
+func (t *Transaction) Deserialize(data []byte) error {
+	internal := newInternalTransaction()
+
+	if err := internal.Deserialize(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("failed to deserialize transaction: [%w]", err)
+	}
+
+	*t = *internal.toTransaction()
+
+	return nil
+}
+
 // TransactionInput represents a Bitcoin transaction input. For reference, see:
 // https://developer.bitcoin.org/reference/transactions.html#txin-a-transaction-input-non-coinbase
 type TransactionInput struct {
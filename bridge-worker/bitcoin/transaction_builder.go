@@ -44,3 +44,33 @@ func (it *internalTransaction) fromTransaction(transaction *Transaction) {
 
 	it.LockTime = transaction.Locktime
 }
+
+func (it *internalTransaction) toTransaction() *Transaction {
+	result := &Transaction{
+		Version:  it.Version,
+		Inputs:   make([]*TransactionInput, len(it.TxIn)),
+		Outputs:  make([]*TransactionOutput, len(it.TxOut)),
+		Locktime: it.LockTime,
+	}
+
+	for i, input := range it.TxIn {
+		result.Inputs[i] = &TransactionInput{
+			Outpoint: &TransactionOutpoint{
+				TransactionHash: Hash(input.PreviousOutPoint.Hash),
+				OutputIndex:     input.PreviousOutPoint.Index,
+			},
+			SignatureScript: input.SignatureScript,
+			Witness:         input.Witness,
+			Sequence:        input.Sequence,
+		}
+	}
+
+	for i, output := range it.TxOut {
+		result.Outputs[i] = &TransactionOutput{
+			Value:           output.Value,
+			PublicKeyScript: output.PkScript,
+		}
+	}
+
+	return result
+}
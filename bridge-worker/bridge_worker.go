@@ -5,10 +5,13 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"net/http"
 
 	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
 
 	"github.com/mezo-org/mezod/bridge-worker/bitcoin"
+	"github.com/mezo-org/mezod/bridge-worker/bitcoin/bitcoind"
 	"github.com/mezo-org/mezod/bridge-worker/bitcoin/electrum"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -48,6 +51,13 @@ type MezoBridgeContract interface {
 		walletPubKeyHash [20]byte,
 		mainUtxo portal.BitcoinTxUTXO,
 	) (*types.Transaction, error)
+	ConfirmedUnlocks(unlockSequenceNumber *big.Int) (bool, error)
+	BridgeValidatorIDs(address common.Address) (uint8, error)
+	AttestationThreshold() (*big.Int, error)
+	AttestBridgeOutWithSignatures(
+		entry portal.MezoBridgeAssetsUnlocked,
+		signatures []byte,
+	) (*types.Transaction, error)
 }
 
 // TbtcBridgeContract represents a handle to the tBTC Bridge smart contract.
@@ -145,21 +155,34 @@ func RunBridgeWorker(
 
 	tbtcBridgeContract := ethereum.NewTbtcBridgeContract(tbtcBridgeContractBindings)
 
-	logger.Info(
-		"connecting to electrum node",
-		"electrum_url", cfg.Bitcoin.Electrum.URL,
-		"network", cfg.Bitcoin.Network.String(),
-	)
+	btcChain, err := connectBitcoinChain(ctx, cfg.Bitcoin, logger)
+	if err != nil {
+		panic(fmt.Sprintf("could not connect to Bitcoin chain: %v", err))
+	}
 
-	btcChain, err := electrum.Connect(
-		ctx,
-		cfg.Bitcoin.Electrum,
-		logger.With(log.ModuleKey, "electrum"),
+	// Keep a separate database per Ethereum network so the persisted state
+	// is never mixed up between networks.
+	db, err := dbm.NewDB(
+		fmt.Sprintf("bridge-worker-%s", ethereumNetwork),
+		dbm.GoLevelDBBackend,
+		cfg.DataDir,
 	)
 	if err != nil {
-		panic(fmt.Sprintf("could not connect to Electrum chain: %v", err))
+		panic(fmt.Sprintf("failed to open the bridge worker database: %v", err))
 	}
 
+	store := newStore(db)
+	defer func() {
+		if err := store.close(); err != nil {
+			logger.Error("failed to close the bridge worker database", "error", err)
+		}
+	}()
+
+	logger.Info("bridge worker database opened", "data_dir", cfg.DataDir)
+
+	// Expose the lifecycle of BTC withdrawals along with Prometheus metrics.
+	http.Handle(btcWithdrawalsPath, newBTCWithdrawalsHandler(logger, store))
+
 	env := &environment{
 		logger:             logger,
 		mezoBridgeContract: mezoBridgeContract,
@@ -173,11 +196,30 @@ func RunBridgeWorker(
 	jobs := []bridgeWorkerJob{
 		newBTCWithdrawalJob(
 			env,
+			store,
 			cfg.Mezo.AssetsUnlockEndpoint,
 			cfg.Job.BTCWithdrawal.QueueCheckFrequency,
 		),
 	}
 
+	if cfg.Job.BatchAttestation.ListenAddress != "" {
+		jobs = append(
+			jobs,
+			newBatchAttestationJob(
+				env,
+				cfg.Mezo.AssetsUnlockEndpoint,
+				chain.ChainID(),
+				cfg.Job.BatchAttestation.ListenAddress,
+				cfg.Job.BatchAttestation.CheckFrequency,
+			),
+		)
+	} else {
+		logger.Info(
+			"batch attestation job disabled; signature intake endpoint " +
+				"listen address not set",
+		)
+	}
+
 	for _, job := range jobs {
 		go func(j bridgeWorkerJob) {
 			defer cancelCtx()
@@ -235,3 +277,40 @@ func initializeTbtcBridgeContract(
 
 	return bridgeContract, nil
 }
+
+// Connect to the Bitcoin chain using the configured backend.
+func connectBitcoinChain(
+	ctx context.Context,
+	cfg BitcoinConfig,
+	logger log.Logger,
+) (bitcoin.Chain, error) {
+	switch cfg.Backend {
+	case BitcoinBackendElectrum:
+		logger.Info(
+			"connecting to electrum node",
+			"electrum_url", cfg.Electrum.URL,
+			"network", cfg.Network.String(),
+		)
+
+		return electrum.Connect(
+			ctx,
+			cfg.Electrum,
+			logger.With(log.ModuleKey, "electrum"),
+		)
+	case BitcoinBackendBitcoind:
+		logger.Info(
+			"connecting to bitcoind node",
+			"bitcoind_url", cfg.Bitcoind.URL,
+			"wallet", cfg.Bitcoind.Wallet,
+			"network", cfg.Network.String(),
+		)
+
+		return bitcoind.Connect(
+			ctx,
+			cfg.Bitcoind,
+			logger.With(log.ModuleKey, "bitcoind"),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported bitcoin backend: %s", cfg.Backend)
+	}
+}
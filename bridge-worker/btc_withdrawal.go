@@ -45,6 +45,14 @@ const (
 	// of live wallets.
 	liveWalletsUpdatePeriod = 1 * time.Hour
 
+	// finishedBTCWithdrawalsRetention is the time the lifecycle records of
+	// completed and skipped BTC withdrawals are kept for before being pruned.
+	finishedBTCWithdrawalsRetention = 30 * 24 * time.Hour
+
+	// finishedBTCWithdrawalsPruningPeriod defines how frequently we should
+	// prune the lifecycle records of finished BTC withdrawals.
+	finishedBTCWithdrawalsPruningPeriod = 1 * time.Hour
+
 	// walletStateLive represents Live wallet state from the tBTC Bridge contract.
 	walletStateLive = uint8(1)
 )
@@ -64,6 +72,7 @@ type AssetsUnlockedEndpoint interface {
 
 func newBTCWithdrawalJob(
 	env *environment,
+	store *store,
 	assetsUnlockEndpoint string,
 	queueCheckFrequency time.Duration,
 ) *btcWithdrawalJob {
@@ -118,8 +127,9 @@ func newBTCWithdrawalJob(
 		panic(fmt.Sprintf("failed to get tBTC token: %v", err))
 	}
 
-	return &btcWithdrawalJob{
+	bwj := &btcWithdrawalJob{
 		env:                                   env,
+		store:                                 store,
 		assetsUnlockedEndpoint:                assetsUnlockedGrpcEndpoint,
 		liveWalletsReady:                      make(chan struct{}),
 		btcWithdrawalQueue:                    []portal.MezoBridgeAssetsUnlockConfirmed{},
@@ -128,11 +138,21 @@ func newBTCWithdrawalJob(
 		redemptionDustThresholdErc20Precision: redemptionDustThresholdErc20Precision,
 		tbtcToken:                             tbtcToken,
 	}
+
+	if err := bwj.restoreState(); err != nil {
+		panic(fmt.Sprintf("failed to restore BTC withdrawal job state: %v", err))
+	}
+
+	return bwj
 }
 
 type btcWithdrawalJob struct {
 	env *environment
 
+	// store persists the job state between restarts. If nil, the state is
+	// kept in memory only.
+	store *store
+
 	assetsUnlockedEndpoint AssetsUnlockedEndpoint
 
 	// Channel used to indicate whether the initial fetching of live wallets
@@ -216,11 +236,68 @@ func (bwj *btcWithdrawalJob) run(ctx context.Context) {
 		bwj.env.logger.Warn("BTC withdrawal finality checks loop stopped")
 	}()
 
+	if bwj.store != nil {
+		go func() {
+			defer cancelRunCtx()
+			bwj.pruneFinishedBTCWithdrawals(runCtx)
+			bwj.env.logger.Warn("finished BTC withdrawals pruning loop stopped")
+		}()
+	}
+
 	<-runCtx.Done()
 	bwj.env.logger.Info("BTC withdrawal job stopped")
 }
 
 func (bwj *btcWithdrawalJob) observeLiveWallets(ctx context.Context) error {
+	if bwj.liveWalletsLastProcessedBlock > 0 {
+		// Live wallets were restored from the store. Just refresh them
+		// instead of searching the entire history again.
+		bwj.env.logger.Info(
+			"refreshing restored live wallets",
+			"last_processed_block", bwj.liveWalletsLastProcessedBlock,
+		)
+
+		err := bwj.updateLiveWallets(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update restored live wallets: [%w]", err)
+		}
+	} else {
+		err := bwj.searchLiveWallets(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Signal that initial fetching of wallets ready.
+	close(bwj.liveWalletsReady)
+
+	// Start a ticker to periodically update the wallets.
+	ticker := time.NewTicker(liveWalletsUpdatePeriod)
+	defer ticker.Stop()
+	tickerChan := ticker.C
+
+	for {
+		select {
+		case <-ctx.Done(): // Handle context cancellation
+			bwj.env.logger.Warn(
+				"stopping live wallet observation due to context cancellation",
+			)
+			return nil
+		case <-tickerChan:
+			err := bwj.updateLiveWallets(ctx)
+			if err != nil {
+				bwj.env.logger.Error(
+					"failed to update live wallets",
+					"error", err,
+				)
+			}
+		}
+	}
+}
+
+// searchLiveWallets searches the entire history of the tBTC Bridge for live
+// wallets.
+func (bwj *btcWithdrawalJob) searchLiveWallets(ctx context.Context) error {
 	finalizedBlock, err := bwj.env.chain.FinalizedBlock(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get finalized block: [%w]", err)
@@ -266,31 +343,9 @@ func (bwj *btcWithdrawalJob) observeLiveWallets(ctx context.Context) error {
 	bwj.liveWallets = liveWallets
 	bwj.liveWalletsMutex.Unlock()
 
-	// Signal that initial fetching of wallets ready.
-	close(bwj.liveWalletsReady)
-
-	// Start a ticker to periodically update the wallets.
-	ticker := time.NewTicker(liveWalletsUpdatePeriod)
-	defer ticker.Stop()
-	tickerChan := ticker.C
+	bwj.persistLiveWallets(liveWallets)
 
-	for {
-		select {
-		case <-ctx.Done(): // Handle context cancellation
-			bwj.env.logger.Warn(
-				"stopping live wallet observation due to context cancellation",
-			)
-			return nil
-		case <-tickerChan:
-			err := bwj.updateLiveWallets(ctx)
-			if err != nil {
-				bwj.env.logger.Error(
-					"failed to update live wallets",
-					"error", err,
-				)
-			}
-		}
-	}
+	return nil
 }
 
 func (bwj *btcWithdrawalJob) updateLiveWallets(ctx context.Context) error {
@@ -365,6 +420,8 @@ func (bwj *btcWithdrawalJob) updateLiveWallets(ctx context.Context) error {
 	bwj.liveWallets = updatedLiveWallets
 	bwj.liveWalletsMutex.Unlock()
 
+	bwj.persistLiveWallets(updatedLiveWallets)
+
 	return nil
 }
 
@@ -383,6 +440,56 @@ func (wfc *btcWithdrawalFinalityCheck) key() string {
 // observeBTCWithdrawals monitors AssetsUnlockConfirmed events, filters
 // events representing pending BTC withdrawals and puts them into a queue.
 func (bwj *btcWithdrawalJob) observeBTCWithdrawals(ctx context.Context) error {
+	if bwj.btcWithdrawalLastProcessedBlock > 0 {
+		// The search was already done up to the restored block. Just search
+		// for events emitted since then instead of looking back again.
+		bwj.env.logger.Info(
+			"resuming search for pending BTC withdrawals",
+			"last_processed_block", bwj.btcWithdrawalLastProcessedBlock,
+		)
+
+		err := bwj.processNewAssetsUnlockConfirmedEvents(ctx)
+		if err != nil {
+			bwj.env.logger.Error(
+				"failed to process AssetsUnlockConfirmed events emitted "+
+					"since the last processed block",
+				"error", err,
+			)
+		}
+	} else {
+		err := bwj.searchBTCWithdrawals(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Start a ticker to process the new events periodically.
+	ticker := time.NewTicker(assetsUnlockConfirmedProcessingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done(): // Handle context cancellation
+			bwj.env.logger.Warn(
+				"stopping BTC withdrawals routine due to context cancellation",
+			)
+			return nil
+		case <-ticker.C:
+			// Process incoming AssetsUnlockedConfirmed events
+			err := bwj.processNewAssetsUnlockConfirmedEvents(ctx)
+			if err != nil {
+				bwj.env.logger.Error(
+					"failed to process newly emitted AssetsUnlockConfirmed events",
+					"error", err,
+				)
+			}
+		}
+	}
+}
+
+// searchBTCWithdrawals searches the recent AssetsUnlockConfirmed events for
+// pending BTC withdrawals and puts them into a queue.
+func (bwj *btcWithdrawalJob) searchBTCWithdrawals(ctx context.Context) error {
 	// Use the current block rather than finalized block to speed up event
 	// processing. Event processing should handle the effects of a possible
 	// reorg (e.g. an event being duplicated in a queue), although there is a
@@ -430,6 +537,7 @@ func (bwj *btcWithdrawalJob) observeBTCWithdrawals(ctx context.Context) error {
 	}
 
 	bwj.btcWithdrawalLastProcessedBlock = currentBlock
+	bwj.persistBTCWithdrawalLastProcessedBlock()
 
 	bwj.env.logger.Info(
 		"initial search for pending BTC withdrawals done",
@@ -437,28 +545,7 @@ func (bwj *btcWithdrawalJob) observeBTCWithdrawals(ctx context.Context) error {
 		"pending_btc_withdrawals", pendingBTCWithdrawalCount,
 	)
 
-	// Start a ticker to process the new events periodically.
-	ticker := time.NewTicker(assetsUnlockConfirmedProcessingPeriod)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done(): // Handle context cancellation
-			bwj.env.logger.Warn(
-				"stopping BTC withdrawals routine due to context cancellation",
-			)
-			return nil
-		case <-ticker.C:
-			// Process incoming AssetsUnlockedConfirmed events
-			err := bwj.processNewAssetsUnlockConfirmedEvents(ctx)
-			if err != nil {
-				bwj.env.logger.Error(
-					"failed to process newly emitted AssetsUnlockConfirmed events",
-					"error", err,
-				)
-			}
-		}
-	}
+	return nil
 }
 
 // enqueueBTCWithdrawal puts AssetsUnlockConfirmed events representing BTC
@@ -471,6 +558,8 @@ func (bwj *btcWithdrawalJob) enqueueBTCWithdrawal(
 
 	bwj.btcWithdrawalQueue = append(bwj.btcWithdrawalQueue, *event)
 
+	bwj.persistQueuedBTCWithdrawal(event)
+
 	bwj.env.logger.Debug(
 		"enqueued BTC withdrawal",
 		"unlock_sequence", event.UnlockSequenceNumber.String(),
@@ -627,6 +716,7 @@ func (bwj *btcWithdrawalJob) processNewAssetsUnlockConfirmedEvents(ctx context.C
 		}
 
 		bwj.btcWithdrawalLastProcessedBlock = currentBlock
+		bwj.persistBTCWithdrawalLastProcessedBlock()
 	}
 
 	bwj.env.logger.Info(
@@ -695,6 +785,7 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalQueue(ctx context.Context) {
 					btcWithdrawalLogger.Info(
 						"BTC withdrawal no longer pending; skipping",
 					)
+					bwj.finishBTCWithdrawal(event, btcWithdrawalStatusSkipped)
 					continue
 				}
 
@@ -758,6 +849,7 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalQueue(ctx context.Context) {
 						btcWithdrawalProcessLogger.Info(
 							"BTC withdrawal no longer pending; skipping",
 						)
+						bwj.finishBTCWithdrawal(event, btcWithdrawalStatusSkipped)
 						withdrawingSuccessful = true
 						break
 					}
@@ -781,7 +873,11 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalQueue(ctx context.Context) {
 					}
 
 					// schedule finality check
-					bwj.queueBTCWithdrawalFinalityCheck(event)
+					bwj.queueBTCWithdrawalFinalityCheck(
+						event,
+						walletPublicKeyHash,
+						tx.Hash(),
+					)
 
 					withdrawingSuccessful = true
 
@@ -813,6 +909,8 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalQueue(ctx context.Context) {
 // and puts it into a queue.
 func (bwj *btcWithdrawalJob) queueBTCWithdrawalFinalityCheck(
 	event *portal.MezoBridgeAssetsUnlockConfirmed,
+	walletPublicKeyHash [20]byte,
+	txHash common.Hash,
 ) {
 	bwj.btcWithdrawalFinalityChecksMutex.Lock()
 	defer bwj.btcWithdrawalFinalityChecksMutex.Unlock()
@@ -829,6 +927,8 @@ func (bwj *btcWithdrawalJob) queueBTCWithdrawalFinalityCheck(
 
 	bwj.btcWithdrawalFinalityChecks[check.key()] = check
 
+	bwj.persistSubmittedBTCWithdrawal(check, walletPublicKeyHash, txHash)
+
 	bwj.env.logger.Info(
 		"queued BTC withdrawal finality check",
 		"unlock_sequence", event.UnlockSequenceNumber.String(),
@@ -873,6 +973,8 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalFinalityChecks(ctx context.Cont
 						"scheduled_at_height", check.scheduledAtHeight.String(),
 						"current_finalized_block", currentFinalizedBlock.String(),
 					)
+
+					bwj.persistBTCWithdrawalFinalityCheck(check)
 				}
 
 				// Then, see if check's scheduled height fell into a finalized epoch
@@ -911,6 +1013,7 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalFinalityChecks(ctx context.Cont
 					checkLogger.Info(
 						"BTC withdrawal confirmed during finality check",
 					)
+					bwj.finishBTCWithdrawal(check.event, btcWithdrawalStatusCompleted)
 				} else {
 					// If the withdrawal is still pending, we need to re-queue it
 					// so the withdrawal loop can pick it up again.
@@ -925,6 +1028,8 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalFinalityChecks(ctx context.Cont
 				bwj.btcWithdrawalFinalityChecksMutex.Lock()
 				delete(bwj.btcWithdrawalFinalityChecks, check.key())
 				bwj.btcWithdrawalFinalityChecksMutex.Unlock()
+
+				bwj.deletePersistedBTCWithdrawalFinalityCheck(check)
 			}
 		case <-ctx.Done():
 			bwj.env.logger.Warn(
@@ -936,6 +1041,247 @@ func (bwj *btcWithdrawalJob) processBTCWithdrawalFinalityChecks(ctx context.Cont
 	}
 }
 
+// restoreState restores the job state persisted before the last restart:
+// the live wallets, the BTC withdrawal queue, the BTC withdrawal finality
+// checks and the blocks the searches for events were done up to.
+func (bwj *btcWithdrawalJob) restoreState() error {
+	if bwj.store == nil {
+		return nil
+	}
+
+	liveWallets, liveWalletsLastProcessedBlock, err := bwj.store.liveWallets()
+	if err != nil {
+		return err
+	}
+
+	btcWithdrawalLastProcessedBlock, err := bwj.store.btcWithdrawalLastProcessedBlock()
+	if err != nil {
+		return err
+	}
+
+	queue, err := bwj.store.queuedBTCWithdrawals()
+	if err != nil {
+		return err
+	}
+
+	checks, err := bwj.store.btcWithdrawalFinalityChecks()
+	if err != nil {
+		return err
+	}
+
+	queued := make(map[string]bool)
+	for _, event := range queue {
+		queued[event.UnlockSequenceNumber.String()] = true
+	}
+
+	bwj.liveWalletsMutex.Lock()
+	bwj.liveWallets = liveWallets
+	bwj.liveWalletsMutex.Unlock()
+
+	bwj.liveWalletsLastProcessedBlock = liveWalletsLastProcessedBlock
+	bwj.btcWithdrawalLastProcessedBlock = btcWithdrawalLastProcessedBlock
+
+	bwj.btcWithdrawalMutex.Lock()
+	bwj.btcWithdrawalQueue = append(bwj.btcWithdrawalQueue, queue...)
+	bwj.btcWithdrawalMutex.Unlock()
+
+	pendingBTCWithdrawalsGauge.Add(float64(len(queue)))
+
+	bwj.btcWithdrawalFinalityChecksMutex.Lock()
+	for _, check := range checks {
+		// A BTC withdrawal is put back into the queue before its finality
+		// check is removed. If the bridge worker stopped in between, the
+		// queue holds the latest state so the check is dropped.
+		if queued[check.key()] {
+			bwj.deletePersistedBTCWithdrawalFinalityCheck(check)
+			continue
+		}
+
+		bwj.btcWithdrawalFinalityChecks[check.key()] = check
+	}
+	bwj.btcWithdrawalFinalityChecksMutex.Unlock()
+
+	bwj.env.logger.Info(
+		"restored persisted BTC withdrawal job state",
+		"live_wallets", len(liveWallets),
+		"live_wallets_last_processed_block", liveWalletsLastProcessedBlock,
+		"btc_withdrawal_last_processed_block", btcWithdrawalLastProcessedBlock,
+		"queued_btc_withdrawals", len(queue),
+		"btc_withdrawal_finality_checks", len(checks),
+	)
+
+	return nil
+}
+
+// persistLiveWallets persists the given live wallets along with the last
+// block processed when searching for them. A failure is not critical as the
+// live wallets are searched for again after a restart.
+func (bwj *btcWithdrawalJob) persistLiveWallets(liveWallets [][20]byte) {
+	if bwj.store == nil {
+		return
+	}
+
+	err := bwj.store.saveLiveWallets(liveWallets, bwj.liveWalletsLastProcessedBlock)
+	if err != nil {
+		bwj.env.logger.Error(
+			"failed to persist live wallets",
+			"error", err,
+		)
+	}
+}
+
+// persistBTCWithdrawalLastProcessedBlock persists the last block processed
+// when searching for AssetsUnlockConfirmed events. A failure is not critical
+// as the events are searched for again after a restart.
+func (bwj *btcWithdrawalJob) persistBTCWithdrawalLastProcessedBlock() {
+	if bwj.store == nil {
+		return
+	}
+
+	err := bwj.store.saveBTCWithdrawalLastProcessedBlock(
+		bwj.btcWithdrawalLastProcessedBlock,
+	)
+	if err != nil {
+		bwj.env.logger.Error(
+			"failed to persist last processed block of BTC withdrawals search",
+			"error", err,
+		)
+	}
+}
+
+// persistQueuedBTCWithdrawal persists the BTC withdrawal put into the queue.
+func (bwj *btcWithdrawalJob) persistQueuedBTCWithdrawal(
+	event *portal.MezoBridgeAssetsUnlockConfirmed,
+) {
+	if bwj.store == nil {
+		return
+	}
+
+	if err := bwj.store.saveQueuedBTCWithdrawal(event); err != nil {
+		bwj.env.logger.Error(
+			"failed to persist queued BTC withdrawal",
+			"unlock_sequence", event.UnlockSequenceNumber.String(),
+			"error", err,
+		)
+	}
+}
+
+// persistSubmittedBTCWithdrawal persists the finality check of a BTC
+// withdrawal whose withdrawBTC transaction was submitted, so the BTC
+// withdrawal is not re-attempted after a restart.
+func (bwj *btcWithdrawalJob) persistSubmittedBTCWithdrawal(
+	check *btcWithdrawalFinalityCheck,
+	walletPublicKeyHash [20]byte,
+	txHash common.Hash,
+) {
+	if bwj.store == nil {
+		return
+	}
+
+	err := bwj.store.saveSubmittedBTCWithdrawal(check, walletPublicKeyHash, txHash)
+	if err != nil {
+		bwj.env.logger.Error(
+			"failed to persist submitted BTC withdrawal",
+			"unlock_sequence", check.key(),
+			"tx_hash", txHash.Hex(),
+			"error", err,
+		)
+	}
+}
+
+// persistBTCWithdrawalFinalityCheck persists the BTC withdrawal finality
+// check once it gets scheduled.
+func (bwj *btcWithdrawalJob) persistBTCWithdrawalFinalityCheck(
+	check *btcWithdrawalFinalityCheck,
+) {
+	if bwj.store == nil {
+		return
+	}
+
+	if err := bwj.store.saveBTCWithdrawalFinalityCheck(check); err != nil {
+		bwj.env.logger.Error(
+			"failed to persist BTC withdrawal finality check",
+			"unlock_sequence", check.key(),
+			"error", err,
+		)
+	}
+}
+
+// deletePersistedBTCWithdrawalFinalityCheck removes the persisted BTC
+// withdrawal finality check once it is executed.
+func (bwj *btcWithdrawalJob) deletePersistedBTCWithdrawalFinalityCheck(
+	check *btcWithdrawalFinalityCheck,
+) {
+	if bwj.store == nil {
+		return
+	}
+
+	err := bwj.store.deleteBTCWithdrawalFinalityCheck(check.event.UnlockSequenceNumber)
+	if err != nil {
+		bwj.env.logger.Error(
+			"failed to delete persisted BTC withdrawal finality check",
+			"unlock_sequence", check.key(),
+			"error", err,
+		)
+	}
+}
+
+// finishBTCWithdrawal removes the persisted state of the BTC withdrawal that
+// is no longer pending and records its final status.
+func (bwj *btcWithdrawalJob) finishBTCWithdrawal(
+	event *portal.MezoBridgeAssetsUnlockConfirmed,
+	status btcWithdrawalStatus,
+) {
+	if bwj.store == nil {
+		return
+	}
+
+	err := bwj.store.finishBTCWithdrawal(event.UnlockSequenceNumber, status)
+	if err != nil {
+		bwj.env.logger.Error(
+			"failed to persist finished BTC withdrawal",
+			"unlock_sequence", event.UnlockSequenceNumber.String(),
+			"status", status,
+			"error", err,
+		)
+	}
+}
+
+// pruneFinishedBTCWithdrawals periodically removes the persisted lifecycle
+// records of BTC withdrawals finished more than
+// finishedBTCWithdrawalsRetention ago, so the store does not grow forever.
+func (bwj *btcWithdrawalJob) pruneFinishedBTCWithdrawals(ctx context.Context) {
+	ticker := time.NewTicker(finishedBTCWithdrawalsPruningPeriod)
+	defer ticker.Stop()
+
+	for {
+		pruned, err := bwj.store.pruneFinishedBTCWithdrawals(
+			time.Now().UTC().Add(-finishedBTCWithdrawalsRetention),
+		)
+		if err != nil {
+			bwj.env.logger.Error(
+				"failed to prune finished BTC withdrawals",
+				"error", err,
+			)
+		} else if pruned > 0 {
+			bwj.env.logger.Info(
+				"pruned finished BTC withdrawals",
+				"count", pruned,
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			bwj.env.logger.Warn(
+				"stopping finished BTC withdrawals pruning due to context " +
+					"cancellation",
+			)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // prepareBTCWithdrawal selects the redeeming wallet and prepares arguments
 // needed to execute withdrawBTC: AssetsUnlock entry, wallet public key hash and
 // wallet main UTXO.
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 
 	"github.com/mezo-org/mezod/bridge-worker/bitcoin"
+	"github.com/mezo-org/mezod/bridge-worker/bitcoin/bitcoind"
 	"github.com/mezo-org/mezod/bridge-worker/bitcoin/electrum"
 )
 
@@ -17,6 +18,16 @@ const (
 	DefaultEthereumRequestsPerMinute = 600
 
 	DefaultBTCWithdrawalQueueCheckFrequency = 1 * time.Minute
+
+	DefaultBatchAttestationCheckFrequency = 15 * time.Second
+
+	DefaultDataDir = "data"
+)
+
+// Supported Bitcoin chain backends.
+const (
+	BitcoinBackendElectrum = "electrum"
+	BitcoinBackendBitcoind = "bitcoind"
 )
 
 type ConfigProperties struct {
@@ -30,14 +41,25 @@ type ConfigProperties struct {
 	EthereumAccountKeyFile         string
 	EthereumAccountKeyFilePassword string
 
-	BitcoinNetwork     string
-	BitcoinElectrumURL string
+	BitcoinNetwork                      string
+	BitcoinBackend                      string
+	BitcoinElectrumURL                  string
+	BitcoinBitcoindURL                  string
+	BitcoinBitcoindUser                 string
+	BitcoinBitcoindPassword             string
+	BitcoinBitcoindWallet               string
+	BitcoinBitcoindRescanStartTimestamp int64
 
 	MezoAssetsUnlockEndpoint string
 
 	JobBTCWithdrawalQueueCheckFrequency time.Duration
 
+	JobBatchAttestationListenAddress  string
+	JobBatchAttestationCheckFrequency time.Duration
+
 	PrometheusPort uint
+
+	DataDir string
 }
 
 type Config struct {
@@ -45,11 +67,14 @@ type Config struct {
 	Bitcoin  BitcoinConfig
 	Mezo     MezoConfig
 	Job      JobConfig
+	DataDir  string // directory of the database persisting the job state
 }
 
 type BitcoinConfig struct {
 	Network  bitcoin.Network // "mainnet" | "testnet" | "regtest"
+	Backend  string          // "electrum" | "bitcoind"
 	Electrum electrum.Config
+	Bitcoind bitcoind.Config
 }
 
 type EthereumConfig struct {
@@ -70,13 +95,19 @@ type MezoConfig struct {
 }
 
 type JobConfig struct {
-	BTCWithdrawal BTCWithdrawalConfig
+	BTCWithdrawal    BTCWithdrawalConfig
+	BatchAttestation BatchAttestationConfig
 }
 
 type BTCWithdrawalConfig struct {
 	QueueCheckFrequency time.Duration
 }
 
+type BatchAttestationConfig struct {
+	ListenAddress  string        // e.g. "0.0.0.0:8080"; job disabled if empty
+	CheckFrequency time.Duration // e.g. 15s
+}
+
 func (c *Config) applyDefaults() {
 	// Ethereum
 	if c.Ethereum.BatchSize == 0 {
@@ -102,6 +133,28 @@ func (c *Config) applyDefaults() {
 	if c.Bitcoin.Electrum.KeepAliveInterval == 0 {
 		c.Bitcoin.Electrum.KeepAliveInterval = electrum.DefaultKeepAliveInterval
 	}
+	if c.Bitcoin.Backend == "" {
+		c.Bitcoin.Backend = BitcoinBackendElectrum
+	}
+	if c.Bitcoin.Bitcoind.RequestTimeout == 0 {
+		c.Bitcoin.Bitcoind.RequestTimeout = bitcoind.DefaultRequestTimeout
+	}
+	if c.Bitcoin.Bitcoind.RequestRetryTimeout == 0 {
+		c.Bitcoin.Bitcoind.RequestRetryTimeout = bitcoind.DefaultRequestRetryTimeout
+	}
+	if c.Bitcoin.Bitcoind.ImportTimeout == 0 {
+		c.Bitcoin.Bitcoind.ImportTimeout = bitcoind.DefaultImportTimeout
+	}
+
+	// Storage
+	if c.DataDir == "" {
+		c.DataDir = DefaultDataDir
+	}
+
+	// Job
+	if c.Job.BatchAttestation.CheckFrequency == 0 {
+		c.Job.BatchAttestation.CheckFrequency = DefaultBatchAttestationCheckFrequency
+	}
 }
 
 func (c *Config) validate() error {
@@ -129,8 +182,20 @@ func (c *Config) validate() error {
 	if c.Bitcoin.Network == bitcoin.Unknown {
 		return fmt.Errorf("bitcoin network is required")
 	}
-	if c.Bitcoin.Electrum.URL == "" {
-		return fmt.Errorf("bitcoin electrum URL is required")
+	switch c.Bitcoin.Backend {
+	case BitcoinBackendElectrum:
+		if c.Bitcoin.Electrum.URL == "" {
+			return fmt.Errorf("bitcoin electrum URL is required")
+		}
+	case BitcoinBackendBitcoind:
+		if c.Bitcoin.Bitcoind.URL == "" {
+			return fmt.Errorf("bitcoin bitcoind URL is required")
+		}
+		if c.Bitcoin.Bitcoind.Wallet == "" {
+			return fmt.Errorf("bitcoin bitcoind wallet is required")
+		}
+	default:
+		return fmt.Errorf("unsupported bitcoin backend: %s", c.Bitcoin.Backend)
 	}
 
 	// Mezo
@@ -181,9 +246,17 @@ func FromProperties(properties ConfigProperties) (*Config, error) {
 
 	cfg.Bitcoin = BitcoinConfig{
 		Network: bitcoinNetwork,
+		Backend: properties.BitcoinBackend,
 		Electrum: electrum.Config{
 			URL: properties.BitcoinElectrumURL,
 		},
+		Bitcoind: bitcoind.Config{
+			URL:                  properties.BitcoinBitcoindURL,
+			User:                 properties.BitcoinBitcoindUser,
+			Password:             properties.BitcoinBitcoindPassword,
+			Wallet:               properties.BitcoinBitcoindWallet,
+			RescanStartTimestamp: properties.BitcoinBitcoindRescanStartTimestamp,
+		},
 	}
 
 	cfg.Mezo = MezoConfig{
@@ -194,8 +267,14 @@ func FromProperties(properties ConfigProperties) (*Config, error) {
 		BTCWithdrawal: BTCWithdrawalConfig{
 			QueueCheckFrequency: properties.JobBTCWithdrawalQueueCheckFrequency,
 		},
+		BatchAttestation: BatchAttestationConfig{
+			ListenAddress:  properties.JobBatchAttestationListenAddress,
+			CheckFrequency: properties.JobBatchAttestationCheckFrequency,
+		},
 	}
 
+	cfg.DataDir = properties.DataDir
+
 	cfg.applyDefaults()
 
 	if err := cfg.validate(); err != nil {
@@ -57,6 +57,25 @@ func (r *MezoBridgeContract) WithdrawBTC(
 	return r.delegate.WithdrawBTC(entry, walletPubKeyHash, mainUtxo)
 }
 
+func (r *MezoBridgeContract) ConfirmedUnlocks(unlockSequenceNumber *big.Int) (bool, error) {
+	return r.delegate.ConfirmedUnlocks(unlockSequenceNumber)
+}
+
+func (r *MezoBridgeContract) BridgeValidatorIDs(address common.Address) (uint8, error) {
+	return r.delegate.BridgeValidatorIDs(address)
+}
+
+func (r *MezoBridgeContract) AttestationThreshold() (*big.Int, error) {
+	return r.delegate.AttestationThreshold()
+}
+
+func (r *MezoBridgeContract) AttestBridgeOutWithSignatures(
+	entry portal.MezoBridgeAssetsUnlocked,
+	signatures []byte,
+) (*types.Transaction, error) {
+	return r.delegate.AttestBridgeOutWithSignatures(entry, signatures)
+}
+
 func NewTbtcBridgeContract(
 	delegate *tbtc.Bridge,
 ) *TbtcBridgeContract {
@@ -40,6 +40,80 @@ func (m *MockBitcoinChain) EXPECT() *MockBitcoinChainMockRecorder {
 	return m.recorder
 }
 
+// BroadcastTransaction mocks base method.
+func (m *MockBitcoinChain) BroadcastTransaction(transaction *bitcoin.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BroadcastTransaction", transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BroadcastTransaction indicates an expected call of BroadcastTransaction.
+func (mr *MockBitcoinChainMockRecorder) BroadcastTransaction(transaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BroadcastTransaction", reflect.TypeOf((*MockBitcoinChain)(nil).BroadcastTransaction), transaction)
+}
+
+// EstimateSatPerVByteFee mocks base method.
+func (m *MockBitcoinChain) EstimateSatPerVByteFee(blocks uint32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateSatPerVByteFee", blocks)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateSatPerVByteFee indicates an expected call of EstimateSatPerVByteFee.
+func (mr *MockBitcoinChainMockRecorder) EstimateSatPerVByteFee(blocks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateSatPerVByteFee", reflect.TypeOf((*MockBitcoinChain)(nil).EstimateSatPerVByteFee), blocks)
+}
+
+// GetBlockHeader mocks base method.
+func (m *MockBitcoinChain) GetBlockHeader(blockHeight uint) (*bitcoin.BlockHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockHeader", blockHeight)
+	ret0, _ := ret[0].(*bitcoin.BlockHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockHeader indicates an expected call of GetBlockHeader.
+func (mr *MockBitcoinChainMockRecorder) GetBlockHeader(blockHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockHeader", reflect.TypeOf((*MockBitcoinChain)(nil).GetBlockHeader), blockHeight)
+}
+
+// GetLatestBlockHeight mocks base method.
+func (m *MockBitcoinChain) GetLatestBlockHeight() (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestBlockHeight")
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestBlockHeight indicates an expected call of GetLatestBlockHeight.
+func (mr *MockBitcoinChainMockRecorder) GetLatestBlockHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestBlockHeight", reflect.TypeOf((*MockBitcoinChain)(nil).GetLatestBlockHeight))
+}
+
+// GetMempoolTxHashesForPublicKeyHash mocks base method.
+func (m *MockBitcoinChain) GetMempoolTxHashesForPublicKeyHash(publicKeyHash [20]byte) ([]bitcoin.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMempoolTxHashesForPublicKeyHash", publicKeyHash)
+	ret0, _ := ret[0].([]bitcoin.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMempoolTxHashesForPublicKeyHash indicates an expected call of GetMempoolTxHashesForPublicKeyHash.
+func (mr *MockBitcoinChainMockRecorder) GetMempoolTxHashesForPublicKeyHash(publicKeyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMempoolTxHashesForPublicKeyHash", reflect.TypeOf((*MockBitcoinChain)(nil).GetMempoolTxHashesForPublicKeyHash), publicKeyHash)
+}
+
 // GetTransaction mocks base method.
 func (m *MockBitcoinChain) GetTransaction(transactionHash bitcoin.Hash) (*bitcoin.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +129,21 @@ func (mr *MockBitcoinChainMockRecorder) GetTransaction(transactionHash any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockBitcoinChain)(nil).GetTransaction), transactionHash)
 }
 
+// GetTransactionConfirmations mocks base method.
+func (m *MockBitcoinChain) GetTransactionConfirmations(transactionHash bitcoin.Hash) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionConfirmations", transactionHash)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionConfirmations indicates an expected call of GetTransactionConfirmations.
+func (mr *MockBitcoinChainMockRecorder) GetTransactionConfirmations(transactionHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionConfirmations", reflect.TypeOf((*MockBitcoinChain)(nil).GetTransactionConfirmations), transactionHash)
+}
+
 // GetTxHashesForPublicKeyHash mocks base method.
 func (m *MockBitcoinChain) GetTxHashesForPublicKeyHash(publicKeyHash [20]byte) ([]bitcoin.Hash, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +158,18 @@ func (mr *MockBitcoinChainMockRecorder) GetTxHashesForPublicKeyHash(publicKeyHas
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxHashesForPublicKeyHash", reflect.TypeOf((*MockBitcoinChain)(nil).GetTxHashesForPublicKeyHash), publicKeyHash)
 }
+
+// GetTxHashesForScript mocks base method.
+func (m *MockBitcoinChain) GetTxHashesForScript(script bitcoin.Script) ([]bitcoin.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxHashesForScript", script)
+	ret0, _ := ret[0].([]bitcoin.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxHashesForScript indicates an expected call of GetTxHashesForScript.
+func (mr *MockBitcoinChainMockRecorder) GetTxHashesForScript(script any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxHashesForScript", reflect.TypeOf((*MockBitcoinChain)(nil).GetTxHashesForScript), script)
+}
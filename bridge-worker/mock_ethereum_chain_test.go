@@ -113,6 +113,66 @@ func (m *MockMezoBridgeContract) EXPECT() *MockMezoBridgeContractMockRecorder {
 	return m.recorder
 }
 
+// AttestBridgeOutWithSignatures mocks base method.
+func (m *MockMezoBridgeContract) AttestBridgeOutWithSignatures(entry portal.MezoBridgeAssetsUnlocked, signatures []byte) (*types.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttestBridgeOutWithSignatures", entry, signatures)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestBridgeOutWithSignatures indicates an expected call of AttestBridgeOutWithSignatures.
+func (mr *MockMezoBridgeContractMockRecorder) AttestBridgeOutWithSignatures(entry, signatures any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestBridgeOutWithSignatures", reflect.TypeOf((*MockMezoBridgeContract)(nil).AttestBridgeOutWithSignatures), entry, signatures)
+}
+
+// AttestationThreshold mocks base method.
+func (m *MockMezoBridgeContract) AttestationThreshold() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttestationThreshold")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestationThreshold indicates an expected call of AttestationThreshold.
+func (mr *MockMezoBridgeContractMockRecorder) AttestationThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationThreshold", reflect.TypeOf((*MockMezoBridgeContract)(nil).AttestationThreshold))
+}
+
+// BridgeValidatorIDs mocks base method.
+func (m *MockMezoBridgeContract) BridgeValidatorIDs(address common.Address) (uint8, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BridgeValidatorIDs", address)
+	ret0, _ := ret[0].(uint8)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BridgeValidatorIDs indicates an expected call of BridgeValidatorIDs.
+func (mr *MockMezoBridgeContractMockRecorder) BridgeValidatorIDs(address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BridgeValidatorIDs", reflect.TypeOf((*MockMezoBridgeContract)(nil).BridgeValidatorIDs), address)
+}
+
+// ConfirmedUnlocks mocks base method.
+func (m *MockMezoBridgeContract) ConfirmedUnlocks(unlockSequenceNumber *big.Int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmedUnlocks", unlockSequenceNumber)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmedUnlocks indicates an expected call of ConfirmedUnlocks.
+func (mr *MockMezoBridgeContractMockRecorder) ConfirmedUnlocks(unlockSequenceNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmedUnlocks", reflect.TypeOf((*MockMezoBridgeContract)(nil).ConfirmedUnlocks), unlockSequenceNumber)
+}
+
 // PastAssetsUnlockConfirmedEvents mocks base method.
 func (m *MockMezoBridgeContract) PastAssetsUnlockConfirmedEvents(startBlock uint64, endBlock *uint64, unlockSequenceNumber []*big.Int, recipient [][]byte, token []common.Address) ([]*portal.MezoBridgeAssetsUnlockConfirmed, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,13 @@ var pendingBTCWithdrawalsGauge = promauto.NewGauge(
 	},
 )
 
+var pendingBatchAttestationsGauge = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "pending_batch_attestations",
+		Help: "the number of AssetsUnlocked entries with signatures collected for batch attestation",
+	},
+)
+
 func startPrometheus(port uint) {
 	log.Printf("starting prometheus")
 
@@ -0,0 +1,145 @@
+package bridgeworker
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+// collectedSignatures groups the signatures of an AssetsUnlocked entry
+// collected from bridge validators.
+type collectedSignatures struct {
+	entry portal.MezoBridgeAssetsUnlocked
+	// signatures maps the address of a bridge validator to its signature.
+	signatures map[common.Address][]byte
+	// lastSubmission is the time of the last attestBridgeOutWithSignatures
+	// submission for the entry; zero if the entry was never submitted.
+	lastSubmission time.Time
+}
+
+// sortedSignatures returns the collected signatures ordered by the
+// addresses of their signers, in ascending order, as expected by the
+// MezoBridge contract.
+func (cs *collectedSignatures) sortedSignatures() [][]byte {
+	signers := make([]common.Address, 0, len(cs.signatures))
+	for signer := range cs.signatures {
+		signers = append(signers, signer)
+	}
+
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i][:], signers[j][:]) < 0
+	})
+
+	signatures := make([][]byte, len(signers))
+	for i, signer := range signers {
+		signatures[i] = cs.signatures[signer]
+	}
+
+	return signatures
+}
+
+// signatureStore keeps the signatures collected from bridge validators,
+// grouped by the unlock sequence number of the signed AssetsUnlocked entry.
+type signatureStore struct {
+	mutex   sync.Mutex
+	entries map[string]*collectedSignatures
+}
+
+func newSignatureStore() *signatureStore {
+	return &signatureStore{
+		entries: make(map[string]*collectedSignatures),
+	}
+}
+
+// add stores the signature of the given entry made by the given signer and
+// returns the number of signatures collected for the entry so far. A
+// signature previously stored for the same signer is replaced.
+func (ss *signatureStore) add(
+	entry portal.MezoBridgeAssetsUnlocked,
+	signer common.Address,
+	signature []byte,
+) int {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	key := entry.UnlockSequenceNumber.String()
+
+	collected, ok := ss.entries[key]
+	if !ok {
+		collected = &collectedSignatures{
+			entry:      entry,
+			signatures: make(map[common.Address][]byte),
+		}
+		ss.entries[key] = collected
+	}
+
+	collected.signatures[signer] = signature
+
+	return len(collected.signatures)
+}
+
+// pending returns copies of all entries with collected signatures, ordered
+// by unlock sequence number.
+func (ss *signatureStore) pending() []collectedSignatures {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	pending := make([]collectedSignatures, 0, len(ss.entries))
+	for _, collected := range ss.entries {
+		signatures := make(map[common.Address][]byte, len(collected.signatures))
+		for signer, signature := range collected.signatures {
+			signatures[signer] = signature
+		}
+
+		pending = append(pending, collectedSignatures{
+			entry:          collected.entry,
+			signatures:     signatures,
+			lastSubmission: collected.lastSubmission,
+		})
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].entry.UnlockSequenceNumber.Cmp(
+			pending[j].entry.UnlockSequenceNumber,
+		) < 0
+	})
+
+	return pending
+}
+
+// markSubmitted records the time of an attestBridgeOutWithSignatures
+// submission for the entry with the given unlock sequence number.
+func (ss *signatureStore) markSubmitted(
+	unlockSequenceNumber *big.Int,
+	submittedAt time.Time,
+) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	if collected, ok := ss.entries[unlockSequenceNumber.String()]; ok {
+		collected.lastSubmission = submittedAt
+	}
+}
+
+// remove drops the signatures of the entry with the given unlock sequence
+// number.
+func (ss *signatureStore) remove(unlockSequenceNumber *big.Int) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	delete(ss.entries, unlockSequenceNumber.String())
+}
+
+// size returns the number of entries with collected signatures.
+func (ss *signatureStore) size() int {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	return len(ss.entries)
+}
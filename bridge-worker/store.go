@@ -0,0 +1,611 @@
+package bridgeworker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+const (
+	// keyPrefixQueuedBTCWithdrawal is the prefix of keys holding
+	// AssetsUnlockConfirmed events waiting in the BTC withdrawal queue,
+	// keyed by their unlock sequence.
+	keyPrefixQueuedBTCWithdrawal = byte(0x01)
+
+	// keyPrefixBTCWithdrawalFinalityCheck is the prefix of keys holding BTC
+	// withdrawal finality checks, keyed by their unlock sequence.
+	keyPrefixBTCWithdrawalFinalityCheck = byte(0x02)
+
+	// keyPrefixBTCWithdrawal is the prefix of keys holding the lifecycle
+	// records of BTC withdrawals, keyed by their unlock sequence.
+	keyPrefixBTCWithdrawal = byte(0x03)
+
+	// keyLiveWallets is the key holding the live wallets along with the last
+	// Ethereum block processed when searching for them.
+	keyLiveWallets = byte(0x10)
+
+	// keyBTCWithdrawalLastProcessedBlock is the key holding the last Ethereum
+	// block processed when searching for AssetsUnlockConfirmed events.
+	keyBTCWithdrawalLastProcessedBlock = byte(0x11)
+)
+
+const (
+	// btcWithdrawalsPath is the path of the HTTP endpoint reporting the
+	// lifecycle records of BTC withdrawals.
+	btcWithdrawalsPath = "/btc-withdrawals"
+
+	// defaultBTCWithdrawalsLimit is the number of lifecycle records reported
+	// by the BTC withdrawals endpoint if the request does not set a limit.
+	defaultBTCWithdrawalsLimit = 100
+
+	// maxBTCWithdrawalsLimit is the maximum number of lifecycle records
+	// reported by the BTC withdrawals endpoint in a single response.
+	maxBTCWithdrawalsLimit = 1000
+)
+
+// btcWithdrawalStatus is the status of a BTC withdrawal in its lifecycle.
+type btcWithdrawalStatus string
+
+const (
+	// btcWithdrawalStatusQueued means the BTC withdrawal waits in the queue
+	// for a withdrawBTC transaction to be submitted.
+	btcWithdrawalStatusQueued btcWithdrawalStatus = "queued"
+
+	// btcWithdrawalStatusSubmitted means a withdrawBTC transaction was
+	// submitted and the BTC withdrawal waits for its finality check.
+	btcWithdrawalStatusSubmitted btcWithdrawalStatus = "submitted"
+
+	// btcWithdrawalStatusCompleted means the finality check confirmed the
+	// BTC withdrawal is no longer pending.
+	btcWithdrawalStatusCompleted btcWithdrawalStatus = "completed"
+
+	// btcWithdrawalStatusSkipped means the BTC withdrawal was no longer
+	// pending when taken from the queue, so no transaction was submitted.
+	btcWithdrawalStatusSkipped btcWithdrawalStatus = "skipped"
+)
+
+// btcWithdrawal is the lifecycle record of a BTC withdrawal.
+type btcWithdrawal struct {
+	UnlockSequence      *big.Int            `json:"unlockSequence"`
+	Status              btcWithdrawalStatus `json:"status"`
+	WalletPublicKeyHash string              `json:"walletPublicKeyHash,omitempty"`
+	// TxHashes are the hashes of all withdrawBTC transactions submitted for
+	// the BTC withdrawal, in submission order.
+	TxHashes  []common.Hash `json:"txHashes,omitempty"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// storedAssetsUnlockConfirmed is the persisted form of an
+// AssetsUnlockConfirmed event. The raw Ethereum log is not persisted.
+type storedAssetsUnlockConfirmed struct {
+	UnlockSequenceNumber *big.Int       `json:"unlockSequenceNumber"`
+	Recipient            common.Hash    `json:"recipient"`
+	Token                common.Address `json:"token"`
+	Amount               *big.Int       `json:"amount"`
+	Chain                uint8          `json:"chain"`
+}
+
+// storedBTCWithdrawalFinalityCheck is the persisted form of a BTC withdrawal
+// finality check.
+type storedBTCWithdrawalFinalityCheck struct {
+	Event             storedAssetsUnlockConfirmed `json:"event"`
+	ScheduledAtHeight *big.Int                    `json:"scheduledAtHeight,omitempty"`
+}
+
+// store persists the state of the BTC withdrawal job so the bridge worker
+// resumes where it stopped after a restart instead of re-scanning the
+// Ethereum history and re-attempting in-flight BTC withdrawals. It holds the
+// BTC withdrawal queue, the BTC withdrawal finality checks, the live wallets,
+// the Ethereum blocks the searches for events were done up to and the
+// lifecycle records of BTC withdrawals.
+type store struct {
+	db dbm.DB
+
+	// btcWithdrawalsMutex guards read-modify-write updates of BTC withdrawal
+	// lifecycle records.
+	btcWithdrawalsMutex sync.Mutex
+}
+
+func newStore(db dbm.DB) *store {
+	return &store{db: db}
+}
+
+// close closes the underlying database.
+func (s *store) close() error {
+	return s.db.Close()
+}
+
+// sequenceKey returns the key of an entry with the given prefix and sequence.
+// The sequence is left-padded to 32 bytes so the lexicographic order of keys
+// is the numeric order of sequences.
+func sequenceKey(prefix byte, sequence *big.Int) []byte {
+	return append([]byte{prefix}, common.LeftPadBytes(sequence.Bytes(), 32)...)
+}
+
+// prefixEnd returns the exclusive upper bound of keys with the given prefix.
+func prefixEnd(prefix byte) []byte {
+	return []byte{prefix + 1}
+}
+
+// liveWallets returns the stored live wallets along with the last Ethereum
+// block processed when searching for them. The returned block is zero if no
+// live wallets were stored yet.
+func (s *store) liveWallets() ([][20]byte, uint64, error) {
+	bz, err := s.db.Get([]byte{keyLiveWallets})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get live wallets: [%w]", err)
+	}
+
+	if len(bz) == 0 {
+		return nil, 0, nil
+	}
+
+	if len(bz) < 8 || (len(bz)-8)%20 != 0 {
+		return nil, 0, fmt.Errorf("invalid live wallets length: %d", len(bz))
+	}
+
+	wallets := make([][20]byte, 0, (len(bz)-8)/20)
+	for i := 8; i < len(bz); i += 20 {
+		var wallet [20]byte
+		copy(wallet[:], bz[i:i+20])
+		wallets = append(wallets, wallet)
+	}
+
+	return wallets, sdk.BigEndianToUint64(bz[:8]), nil
+}
+
+// saveLiveWallets stores the live wallets along with the last Ethereum block
+// processed when searching for them. The value is the 8-byte big-endian
+// block followed by the 20-byte wallet public key hashes.
+func (s *store) saveLiveWallets(wallets [][20]byte, lastProcessedBlock uint64) error {
+	bz := sdk.Uint64ToBigEndian(lastProcessedBlock)
+	for _, wallet := range wallets {
+		bz = append(bz, wallet[:]...)
+	}
+
+	if err := s.db.SetSync([]byte{keyLiveWallets}, bz); err != nil {
+		return fmt.Errorf("failed to store live wallets: [%w]", err)
+	}
+
+	return nil
+}
+
+// btcWithdrawalLastProcessedBlock returns the last Ethereum block processed
+// when searching for AssetsUnlockConfirmed events. It returns zero if no
+// block was processed yet.
+func (s *store) btcWithdrawalLastProcessedBlock() (uint64, error) {
+	bz, err := s.db.Get([]byte{keyBTCWithdrawalLastProcessedBlock})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last processed block: [%w]", err)
+	}
+
+	if len(bz) == 0 {
+		return 0, nil
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// saveBTCWithdrawalLastProcessedBlock stores the last Ethereum block
+// processed when searching for AssetsUnlockConfirmed events.
+func (s *store) saveBTCWithdrawalLastProcessedBlock(block uint64) error {
+	err := s.db.SetSync(
+		[]byte{keyBTCWithdrawalLastProcessedBlock},
+		sdk.Uint64ToBigEndian(block),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store last processed block: [%w]", err)
+	}
+
+	return nil
+}
+
+// queuedBTCWithdrawals returns the AssetsUnlockConfirmed events waiting in
+// the BTC withdrawal queue in ascending order of their unlock sequences.
+func (s *store) queuedBTCWithdrawals() ([]portal.MezoBridgeAssetsUnlockConfirmed, error) {
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixQueuedBTCWithdrawal},
+		prefixEnd(keyPrefixQueuedBTCWithdrawal),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate queued BTC withdrawals: [%w]", err)
+	}
+	defer iterator.Close()
+
+	events := make([]portal.MezoBridgeAssetsUnlockConfirmed, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var stored storedAssetsUnlockConfirmed
+		if err := json.Unmarshal(iterator.Value(), &stored); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal queued BTC withdrawal: [%w]", err)
+		}
+
+		events = append(events, stored.toEvent())
+	}
+
+	return events, iterator.Error()
+}
+
+// saveQueuedBTCWithdrawal stores the given AssetsUnlockConfirmed event as
+// waiting in the BTC withdrawal queue and atomically marks the BTC
+// withdrawal as queued.
+func (s *store) saveQueuedBTCWithdrawal(
+	event *portal.MezoBridgeAssetsUnlockConfirmed,
+) error {
+	bz, err := json.Marshal(newStoredAssetsUnlockConfirmed(event))
+	if err != nil {
+		return fmt.Errorf("failed to marshal queued BTC withdrawal: [%w]", err)
+	}
+
+	return s.updateBTCWithdrawal(
+		event.UnlockSequenceNumber,
+		func(batch dbm.Batch, withdrawal *btcWithdrawal) error {
+			withdrawal.Status = btcWithdrawalStatusQueued
+
+			err := batch.Set(
+				sequenceKey(keyPrefixQueuedBTCWithdrawal, event.UnlockSequenceNumber),
+				bz,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to store queued BTC withdrawal: [%w]", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// btcWithdrawalFinalityChecks returns all stored BTC withdrawal finality
+// checks in ascending order of their unlock sequences.
+func (s *store) btcWithdrawalFinalityChecks() ([]*btcWithdrawalFinalityCheck, error) {
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixBTCWithdrawalFinalityCheck},
+		prefixEnd(keyPrefixBTCWithdrawalFinalityCheck),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate BTC withdrawal finality checks: [%w]", err)
+	}
+	defer iterator.Close()
+
+	checks := make([]*btcWithdrawalFinalityCheck, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var stored storedBTCWithdrawalFinalityCheck
+		if err := json.Unmarshal(iterator.Value(), &stored); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal BTC withdrawal finality check: [%w]", err)
+		}
+
+		event := stored.Event.toEvent()
+		checks = append(checks, &btcWithdrawalFinalityCheck{
+			event:             &event,
+			scheduledAtHeight: stored.ScheduledAtHeight,
+		})
+	}
+
+	return checks, iterator.Error()
+}
+
+// saveBTCWithdrawalFinalityCheck stores the given BTC withdrawal finality
+// check.
+func (s *store) saveBTCWithdrawalFinalityCheck(check *btcWithdrawalFinalityCheck) error {
+	bz, err := marshalBTCWithdrawalFinalityCheck(check)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.SetSync(
+		sequenceKey(keyPrefixBTCWithdrawalFinalityCheck, check.event.UnlockSequenceNumber),
+		bz,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store BTC withdrawal finality check: [%w]", err)
+	}
+
+	return nil
+}
+
+// saveSubmittedBTCWithdrawal stores the finality check of a BTC withdrawal
+// whose withdrawBTC transaction was submitted. The BTC withdrawal is no
+// longer queued so it is atomically removed from the queue and marked as
+// submitted along with the transaction hash and the redeeming wallet.
+func (s *store) saveSubmittedBTCWithdrawal(
+	check *btcWithdrawalFinalityCheck,
+	walletPublicKeyHash [20]byte,
+	txHash common.Hash,
+) error {
+	bz, err := marshalBTCWithdrawalFinalityCheck(check)
+	if err != nil {
+		return err
+	}
+
+	unlockSequence := check.event.UnlockSequenceNumber
+
+	return s.updateBTCWithdrawal(
+		unlockSequence,
+		func(batch dbm.Batch, withdrawal *btcWithdrawal) error {
+			withdrawal.Status = btcWithdrawalStatusSubmitted
+			withdrawal.WalletPublicKeyHash = common.Bytes2Hex(walletPublicKeyHash[:])
+			withdrawal.TxHashes = append(withdrawal.TxHashes, txHash)
+
+			err := batch.Delete(sequenceKey(keyPrefixQueuedBTCWithdrawal, unlockSequence))
+			if err != nil {
+				return fmt.Errorf("failed to delete queued BTC withdrawal: [%w]", err)
+			}
+
+			err = batch.Set(sequenceKey(keyPrefixBTCWithdrawalFinalityCheck, unlockSequence), bz)
+			if err != nil {
+				return fmt.Errorf("failed to store BTC withdrawal finality check: [%w]", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// deleteBTCWithdrawalFinalityCheck removes the BTC withdrawal finality
+// check with the given unlock sequence.
+func (s *store) deleteBTCWithdrawalFinalityCheck(unlockSequence *big.Int) error {
+	return s.db.DeleteSync(
+		sequenceKey(keyPrefixBTCWithdrawalFinalityCheck, unlockSequence),
+	)
+}
+
+// finishBTCWithdrawal removes the BTC withdrawal with the given unlock
+// sequence from the queue and the finality checks and atomically sets its
+// final status.
+func (s *store) finishBTCWithdrawal(
+	unlockSequence *big.Int,
+	status btcWithdrawalStatus,
+) error {
+	return s.updateBTCWithdrawal(
+		unlockSequence,
+		func(batch dbm.Batch, withdrawal *btcWithdrawal) error {
+			withdrawal.Status = status
+
+			err := batch.Delete(sequenceKey(keyPrefixQueuedBTCWithdrawal, unlockSequence))
+			if err != nil {
+				return fmt.Errorf("failed to delete queued BTC withdrawal: [%w]", err)
+			}
+
+			err = batch.Delete(sequenceKey(keyPrefixBTCWithdrawalFinalityCheck, unlockSequence))
+			if err != nil {
+				return fmt.Errorf("failed to delete BTC withdrawal finality check: [%w]", err)
+			}
+
+			return nil
+		},
+	)
+}
+
+// btcWithdrawals returns at most limit lifecycle records of BTC withdrawals
+// in ascending order of their unlock sequences. If after is not nil, only
+// records with unlock sequences greater than after are returned.
+func (s *store) btcWithdrawals(after *big.Int, limit int) ([]*btcWithdrawal, error) {
+	start := []byte{keyPrefixBTCWithdrawal}
+	if after != nil {
+		start = sequenceKey(keyPrefixBTCWithdrawal, new(big.Int).Add(after, big.NewInt(1)))
+	}
+
+	iterator, err := s.db.Iterator(start, prefixEnd(keyPrefixBTCWithdrawal))
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate BTC withdrawals: [%w]", err)
+	}
+	defer iterator.Close()
+
+	withdrawals := make([]*btcWithdrawal, 0)
+	for ; iterator.Valid() && len(withdrawals) < limit; iterator.Next() {
+		withdrawal := &btcWithdrawal{}
+		if err := json.Unmarshal(iterator.Value(), withdrawal); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal BTC withdrawal: [%w]", err)
+		}
+
+		withdrawals = append(withdrawals, withdrawal)
+	}
+
+	return withdrawals, iterator.Error()
+}
+
+// pruneFinishedBTCWithdrawals removes the lifecycle records of completed and
+// skipped BTC withdrawals that were finished before the given time. Returns
+// the number of removed records.
+func (s *store) pruneFinishedBTCWithdrawals(finishedBefore time.Time) (int, error) {
+	s.btcWithdrawalsMutex.Lock()
+	defer s.btcWithdrawalsMutex.Unlock()
+
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixBTCWithdrawal},
+		prefixEnd(keyPrefixBTCWithdrawal),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to iterate BTC withdrawals: [%w]", err)
+	}
+
+	// Collect the keys first as the database must not be written while
+	// being iterated.
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		withdrawal := &btcWithdrawal{}
+		if err := json.Unmarshal(iterator.Value(), withdrawal); err != nil {
+			iterator.Close()
+			return 0, fmt.Errorf("failed to unmarshal BTC withdrawal: [%w]", err)
+		}
+
+		finished := withdrawal.Status == btcWithdrawalStatusCompleted ||
+			withdrawal.Status == btcWithdrawalStatusSkipped
+		if finished && withdrawal.UpdatedAt.Before(finishedBefore) {
+			keys = append(keys, append([]byte{}, iterator.Key()...))
+		}
+	}
+
+	err = iterator.Error()
+	iterator.Close()
+	if err != nil {
+		return 0, fmt.Errorf("failed to iterate BTC withdrawals: [%w]", err)
+	}
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return 0, fmt.Errorf("failed to delete BTC withdrawal: [%w]", err)
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return 0, fmt.Errorf("failed to write pruned BTC withdrawals: [%w]", err)
+	}
+
+	return len(keys), nil
+}
+
+// updateBTCWithdrawal applies the given update to the lifecycle record of the
+// BTC withdrawal with the given unlock sequence, creating the record if it
+// does not exist yet. The updated record is written atomically with the
+// entries set by the update in the given batch.
+func (s *store) updateBTCWithdrawal(
+	unlockSequence *big.Int,
+	update func(batch dbm.Batch, withdrawal *btcWithdrawal) error,
+) error {
+	s.btcWithdrawalsMutex.Lock()
+	defer s.btcWithdrawalsMutex.Unlock()
+
+	key := sequenceKey(keyPrefixBTCWithdrawal, unlockSequence)
+
+	bz, err := s.db.Get(key)
+	if err != nil {
+		return fmt.Errorf("failed to get BTC withdrawal: [%w]", err)
+	}
+
+	now := time.Now().UTC()
+
+	withdrawal := &btcWithdrawal{
+		UnlockSequence: unlockSequence,
+		CreatedAt:      now,
+	}
+	if len(bz) > 0 {
+		if err := json.Unmarshal(bz, withdrawal); err != nil {
+			return fmt.Errorf("failed to unmarshal BTC withdrawal: [%w]", err)
+		}
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := update(batch, withdrawal); err != nil {
+		return err
+	}
+
+	withdrawal.UpdatedAt = now
+
+	bz, err = json.Marshal(withdrawal)
+	if err != nil {
+		return fmt.Errorf("failed to marshal BTC withdrawal: [%w]", err)
+	}
+
+	if err := batch.Set(key, bz); err != nil {
+		return fmt.Errorf("failed to store BTC withdrawal: [%w]", err)
+	}
+
+	return batch.WriteSync()
+}
+
+func newStoredAssetsUnlockConfirmed(
+	event *portal.MezoBridgeAssetsUnlockConfirmed,
+) storedAssetsUnlockConfirmed {
+	return storedAssetsUnlockConfirmed{
+		UnlockSequenceNumber: event.UnlockSequenceNumber,
+		Recipient:            event.Recipient,
+		Token:                event.Token,
+		Amount:               event.Amount,
+		Chain:                event.Chain,
+	}
+}
+
+func (sauc storedAssetsUnlockConfirmed) toEvent() portal.MezoBridgeAssetsUnlockConfirmed {
+	return portal.MezoBridgeAssetsUnlockConfirmed{
+		UnlockSequenceNumber: sauc.UnlockSequenceNumber,
+		Recipient:            sauc.Recipient,
+		Token:                sauc.Token,
+		Amount:               sauc.Amount,
+		Chain:                sauc.Chain,
+	}
+}
+
+func marshalBTCWithdrawalFinalityCheck(check *btcWithdrawalFinalityCheck) ([]byte, error) {
+	bz, err := json.Marshal(storedBTCWithdrawalFinalityCheck{
+		Event:             newStoredAssetsUnlockConfirmed(check.event),
+		ScheduledAtHeight: check.scheduledAtHeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal BTC withdrawal finality check: [%w]", err)
+	}
+
+	return bz, nil
+}
+
+// newBTCWithdrawalsHandler returns an HTTP handler reporting the lifecycle
+// records of BTC withdrawals as a JSON array, in ascending order of their
+// unlock sequences. The optional `after` query parameter returns only records
+// with unlock sequences greater than the given one and the optional `limit`
+// query parameter caps the number of returned records, up to
+// maxBTCWithdrawalsLimit. Clients page through all records by passing the
+// last unlock sequence of a response as `after` of the next request.
+func newBTCWithdrawalsHandler(logger log.Logger, store *store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var after *big.Int
+		if value := query.Get("after"); value != "" {
+			var ok bool
+			after, ok = new(big.Int).SetString(value, 10)
+			if !ok || after.Sign() < 0 {
+				http.Error(w, "invalid after parameter", http.StatusBadRequest)
+				return
+			}
+		}
+
+		limit := defaultBTCWithdrawalsLimit
+		if value := query.Get("limit"); value != "" {
+			var err error
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 || limit > maxBTCWithdrawalsLimit {
+				http.Error(
+					w,
+					fmt.Sprintf(
+						"limit parameter must be between 1 and %d",
+						maxBTCWithdrawalsLimit,
+					),
+					http.StatusBadRequest,
+				)
+				return
+			}
+		}
+
+		withdrawals, err := store.btcWithdrawals(after, limit)
+		if err != nil {
+			logger.Error("failed to get BTC withdrawals", "error", err)
+			http.Error(w, "failed to get BTC withdrawals", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(withdrawals); err != nil {
+			logger.Error("failed to encode BTC withdrawals", "error", err)
+		}
+	})
+}
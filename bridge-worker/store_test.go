@@ -0,0 +1,361 @@
+package bridgeworker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+func testAssetsUnlockConfirmed(
+	unlockSequence int64,
+) portal.MezoBridgeAssetsUnlockConfirmed {
+	return portal.MezoBridgeAssetsUnlockConfirmed{
+		UnlockSequenceNumber: big.NewInt(unlockSequence),
+		Recipient:            common.HexToHash("0x0A219c03938FBC93aA23cAd65f7c480f52665C2a"),
+		Token:                common.HexToAddress("0x3A128b915bee3645396d43Fe7A13A59a66C427d6"),
+		Amount:               big.NewInt(unlockSequence * 1000000),
+		Chain:                bitcoinTargetChain,
+	}
+}
+
+func TestStore_Cursors(t *testing.T) {
+	store := newStore(dbm.NewMemDB())
+
+	liveWallets, liveWalletsLastProcessedBlock, err := store.liveWallets()
+	require.NoError(t, err)
+	require.Empty(t, liveWallets)
+	require.Zero(t, liveWalletsLastProcessedBlock)
+
+	lastProcessedBlock, err := store.btcWithdrawalLastProcessedBlock()
+	require.NoError(t, err)
+	require.Zero(t, lastProcessedBlock)
+
+	expectedLiveWallets := [][20]byte{
+		{0x01, 0x02},
+		{0x03, 0x04},
+	}
+
+	require.NoError(t, store.saveLiveWallets(expectedLiveWallets, 100))
+	require.NoError(t, store.saveBTCWithdrawalLastProcessedBlock(200))
+
+	liveWallets, liveWalletsLastProcessedBlock, err = store.liveWallets()
+	require.NoError(t, err)
+	require.Equal(t, expectedLiveWallets, liveWallets)
+	require.Equal(t, uint64(100), liveWalletsLastProcessedBlock)
+
+	lastProcessedBlock, err = store.btcWithdrawalLastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), lastProcessedBlock)
+}
+
+func TestStore_BTCWithdrawalLifecycle(t *testing.T) {
+	store := newStore(dbm.NewMemDB())
+
+	event1 := testAssetsUnlockConfirmed(1)
+	event2 := testAssetsUnlockConfirmed(2)
+	event3 := testAssetsUnlockConfirmed(300)
+
+	// Save out of order to make sure the queue is ordered by unlock sequence.
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event3))
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event1))
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event2))
+
+	queue, err := store.queuedBTCWithdrawals()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]portal.MezoBridgeAssetsUnlockConfirmed{event1, event2, event3},
+		queue,
+	)
+
+	walletPublicKeyHash := [20]byte{0xaa, 0xbb}
+	txHash1 := common.HexToHash("0x01")
+	txHash2 := common.HexToHash("0x02")
+
+	require.NoError(
+		t,
+		store.saveSubmittedBTCWithdrawal(
+			&btcWithdrawalFinalityCheck{event: &event1},
+			walletPublicKeyHash,
+			txHash1,
+		),
+	)
+
+	// The BTC withdrawal is resubmitted after its finality check put it
+	// back into the queue.
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event1))
+	require.NoError(t, store.deleteBTCWithdrawalFinalityCheck(event1.UnlockSequenceNumber))
+	require.NoError(
+		t,
+		store.saveSubmittedBTCWithdrawal(
+			&btcWithdrawalFinalityCheck{event: &event1},
+			walletPublicKeyHash,
+			txHash2,
+		),
+	)
+	require.NoError(
+		t,
+		store.saveBTCWithdrawalFinalityCheck(
+			&btcWithdrawalFinalityCheck{
+				event:             &event1,
+				scheduledAtHeight: big.NewInt(1000),
+			},
+		),
+	)
+
+	require.NoError(
+		t,
+		store.finishBTCWithdrawal(event2.UnlockSequenceNumber, btcWithdrawalStatusSkipped),
+	)
+
+	queue, err = store.queuedBTCWithdrawals()
+	require.NoError(t, err)
+	require.Equal(t, []portal.MezoBridgeAssetsUnlockConfirmed{event3}, queue)
+
+	checks, err := store.btcWithdrawalFinalityChecks()
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	require.Equal(t, event1, *checks[0].event)
+	require.Equal(t, big.NewInt(1000), checks[0].scheduledAtHeight)
+
+	withdrawals, err := store.btcWithdrawals(nil, maxBTCWithdrawalsLimit)
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 3)
+
+	require.Equal(t, event1.UnlockSequenceNumber, withdrawals[0].UnlockSequence)
+	require.Equal(t, btcWithdrawalStatusSubmitted, withdrawals[0].Status)
+	require.Equal(
+		t,
+		common.Bytes2Hex(walletPublicKeyHash[:]),
+		withdrawals[0].WalletPublicKeyHash,
+	)
+	require.Equal(t, []common.Hash{txHash1, txHash2}, withdrawals[0].TxHashes)
+	require.False(t, withdrawals[0].UpdatedAt.Before(withdrawals[0].CreatedAt))
+
+	require.Equal(t, event2.UnlockSequenceNumber, withdrawals[1].UnlockSequence)
+	require.Equal(t, btcWithdrawalStatusSkipped, withdrawals[1].Status)
+	require.Empty(t, withdrawals[1].TxHashes)
+
+	require.Equal(t, event3.UnlockSequenceNumber, withdrawals[2].UnlockSequence)
+	require.Equal(t, btcWithdrawalStatusQueued, withdrawals[2].Status)
+
+	require.NoError(
+		t,
+		store.finishBTCWithdrawal(event1.UnlockSequenceNumber, btcWithdrawalStatusCompleted),
+	)
+
+	checks, err = store.btcWithdrawalFinalityChecks()
+	require.NoError(t, err)
+	require.Empty(t, checks)
+
+	withdrawals, err = store.btcWithdrawals(nil, maxBTCWithdrawalsLimit)
+	require.NoError(t, err)
+	require.Equal(t, btcWithdrawalStatusCompleted, withdrawals[0].Status)
+	require.Equal(t, []common.Hash{txHash1, txHash2}, withdrawals[0].TxHashes)
+}
+
+func TestStore_PruneFinishedBTCWithdrawals(t *testing.T) {
+	store := newStore(dbm.NewMemDB())
+
+	event1 := testAssetsUnlockConfirmed(1)
+	event2 := testAssetsUnlockConfirmed(2)
+	event3 := testAssetsUnlockConfirmed(3)
+	event4 := testAssetsUnlockConfirmed(4)
+
+	for _, event := range []*portal.MezoBridgeAssetsUnlockConfirmed{
+		&event1, &event2, &event3, &event4,
+	} {
+		require.NoError(t, store.saveQueuedBTCWithdrawal(event))
+	}
+
+	require.NoError(
+		t,
+		store.finishBTCWithdrawal(event1.UnlockSequenceNumber, btcWithdrawalStatusCompleted),
+	)
+	require.NoError(
+		t,
+		store.finishBTCWithdrawal(event3.UnlockSequenceNumber, btcWithdrawalStatusSkipped),
+	)
+
+	// Records finished after the cutoff are retained.
+	pruned, err := store.pruneFinishedBTCWithdrawals(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	withdrawals, err := store.btcWithdrawals(nil, maxBTCWithdrawalsLimit)
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 4)
+
+	// Records finished before the cutoff are pruned while records of
+	// in-flight BTC withdrawals are retained regardless of their age.
+	pruned, err = store.pruneFinishedBTCWithdrawals(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+
+	withdrawals, err = store.btcWithdrawals(nil, maxBTCWithdrawalsLimit)
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 2)
+	require.Equal(t, event2.UnlockSequenceNumber, withdrawals[0].UnlockSequence)
+	require.Equal(t, btcWithdrawalStatusQueued, withdrawals[0].Status)
+	require.Equal(t, event4.UnlockSequenceNumber, withdrawals[1].UnlockSequence)
+	require.Equal(t, btcWithdrawalStatusQueued, withdrawals[1].Status)
+
+	queue, err := store.queuedBTCWithdrawals()
+	require.NoError(t, err)
+	require.Equal(t, []portal.MezoBridgeAssetsUnlockConfirmed{event2, event4}, queue)
+}
+
+func TestRestoreState(t *testing.T) {
+	store := newStore(dbm.NewMemDB())
+
+	event1 := testAssetsUnlockConfirmed(1)
+	event2 := testAssetsUnlockConfirmed(2)
+	event3 := testAssetsUnlockConfirmed(3)
+
+	liveWallets := [][20]byte{{0x01}}
+	require.NoError(t, store.saveLiveWallets(liveWallets, 100))
+	require.NoError(t, store.saveBTCWithdrawalLastProcessedBlock(200))
+
+	// The BTC withdrawal with unlock sequence 1 was submitted and waits for
+	// its finality check.
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event1))
+	require.NoError(
+		t,
+		store.saveSubmittedBTCWithdrawal(
+			&btcWithdrawalFinalityCheck{event: &event1},
+			[20]byte{0x01},
+			common.HexToHash("0x01"),
+		),
+	)
+
+	// The BTC withdrawal with unlock sequence 2 was put back into the queue
+	// by its finality check but the bridge worker stopped before the check
+	// was removed.
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event2))
+	require.NoError(
+		t,
+		store.saveSubmittedBTCWithdrawal(
+			&btcWithdrawalFinalityCheck{event: &event2},
+			[20]byte{0x01},
+			common.HexToHash("0x02"),
+		),
+	)
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event2))
+
+	// The BTC withdrawal with unlock sequence 3 waits in the queue.
+	require.NoError(t, store.saveQueuedBTCWithdrawal(&event3))
+
+	bwj := &btcWithdrawalJob{
+		env: &environment{
+			logger: log.NewNopLogger(),
+		},
+		store:                       store,
+		btcWithdrawalQueue:          []portal.MezoBridgeAssetsUnlockConfirmed{},
+		btcWithdrawalFinalityChecks: map[string]*btcWithdrawalFinalityCheck{},
+	}
+
+	require.NoError(t, bwj.restoreState())
+
+	require.Equal(t, liveWallets, bwj.liveWallets)
+	require.Equal(t, uint64(100), bwj.liveWalletsLastProcessedBlock)
+	require.Equal(t, uint64(200), bwj.btcWithdrawalLastProcessedBlock)
+	require.Equal(
+		t,
+		[]portal.MezoBridgeAssetsUnlockConfirmed{event2, event3},
+		bwj.btcWithdrawalQueue,
+	)
+	require.Len(t, bwj.btcWithdrawalFinalityChecks, 1)
+	require.Contains(t, bwj.btcWithdrawalFinalityChecks, "1")
+
+	// The stale finality check must be removed from the store as well.
+	checks, err := store.btcWithdrawalFinalityChecks()
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	require.Equal(t, event1, *checks[0].event)
+}
+
+func TestBTCWithdrawalsHandler(t *testing.T) {
+	store := newStore(dbm.NewMemDB())
+
+	for i := int64(1); i <= 5; i++ {
+		event := testAssetsUnlockConfirmed(i)
+		require.NoError(t, store.saveQueuedBTCWithdrawal(&event))
+	}
+
+	serve := func(target string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		newBTCWithdrawalsHandler(log.NewNopLogger(), store).ServeHTTP(
+			recorder,
+			httptest.NewRequest(http.MethodGet, target, nil),
+		)
+		return recorder
+	}
+
+	decode := func(recorder *httptest.ResponseRecorder) []int64 {
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+		var withdrawals []btcWithdrawal
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&withdrawals))
+
+		sequences := make([]int64, 0, len(withdrawals))
+		for _, withdrawal := range withdrawals {
+			require.Equal(t, btcWithdrawalStatusQueued, withdrawal.Status)
+			sequences = append(sequences, withdrawal.UnlockSequence.Int64())
+		}
+		return sequences
+	}
+
+	t.Run("all records within the default limit", func(t *testing.T) {
+		require.Equal(t, []int64{1, 2, 3, 4, 5}, decode(serve(btcWithdrawalsPath)))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		require.Equal(
+			t,
+			[]int64{1, 2},
+			decode(serve(btcWithdrawalsPath+"?limit=2")),
+		)
+	})
+
+	t.Run("after and limit", func(t *testing.T) {
+		require.Equal(
+			t,
+			[]int64{3, 4},
+			decode(serve(btcWithdrawalsPath+"?after=2&limit=2")),
+		)
+		require.Equal(
+			t,
+			[]int64{},
+			decode(serve(btcWithdrawalsPath+"?after=5")),
+		)
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, query := range []string{
+			"?after=abc",
+			"?after=-1",
+			"?limit=0",
+			"?limit=abc",
+			fmt.Sprintf("?limit=%d", maxBTCWithdrawalsLimit+1),
+		} {
+			require.Equal(
+				t,
+				http.StatusBadRequest,
+				serve(btcWithdrawalsPath+query).Code,
+				query,
+			)
+		}
+	})
+}
@@ -16,14 +16,24 @@ const (
 	flagEthereumRequestsPerMinute = "ethereum.requests-per-minute"
 	flagEthereumAccountKeyFile    = "ethereum.account.key-file"
 
-	flagBitcoinNetwork     = "bitcoin.network"
-	flagBitcoinElectrumURL = "bitcoin.electrum.url"
+	flagBitcoinNetwork                      = "bitcoin.network"
+	flagBitcoinBackend                      = "bitcoin.backend"
+	flagBitcoinElectrumURL                  = "bitcoin.electrum.url"
+	flagBitcoinBitcoindURL                  = "bitcoin.bitcoind.url"
+	flagBitcoinBitcoindUser                 = "bitcoin.bitcoind.user"
+	flagBitcoinBitcoindWallet               = "bitcoin.bitcoind.wallet"
+	flagBitcoinBitcoindRescanStartTimestamp = "bitcoin.bitcoind.rescan-start-timestamp"
 
 	flagMezoAssetsUnlockEndpoint = "mezo.assets-unlock-endpoint"
 
 	flagJobBTCWithdrawalQueueCheckFrequency = "job.btc-withdrawal.queue-check-frequency"
 
+	flagJobBatchAttestationListenAddress  = "job.batch-attestation.listen-address"
+	flagJobBatchAttestationCheckFrequency = "job.batch-attestation.check-frequency"
+
 	flagPrometheusPort = "prometheus-port"
+
+	flagDataDir = "data-dir"
 )
 
 // Flags default values
@@ -34,9 +44,15 @@ const (
 	flagEthereumBatchSizeDefault         = bridgeworker.DefaultEthereumBatchSize
 	flagEthereumRequestsPerMinuteDefault = bridgeworker.DefaultEthereumRequestsPerMinute
 
+	flagBitcoinBackendDefault = bridgeworker.BitcoinBackendElectrum
+
 	flagJobBTCWithdrawalQueueCheckFrequencyDefault = bridgeworker.DefaultBTCWithdrawalQueueCheckFrequency
 
+	flagJobBatchAttestationCheckFrequencyDefault = bridgeworker.DefaultBatchAttestationCheckFrequency
+
 	flagPrometheusPortDefault = 2112
+
+	flagDataDirDefault = bridgeworker.DefaultDataDir
 )
 
 func newFlagSet() *flag.FlagSet {
@@ -90,10 +106,43 @@ func newFlagSet() *flag.FlagSet {
 		"Bitcoin network (must be mainnet, testnet, or regtest)",
 	)
 
+	fs.String(
+		flagBitcoinBackend,
+		flagBitcoinBackendDefault,
+		"Bitcoin chain backend (must be electrum or bitcoind)",
+	)
+
 	fs.String(
 		flagBitcoinElectrumURL,
 		"",
-		"Bitcoin Electrum URL",
+		"Bitcoin Electrum URL (required for the electrum backend)",
+	)
+
+	fs.String(
+		flagBitcoinBitcoindURL,
+		"",
+		"Bitcoin Core JSON-RPC URL (required for the bitcoind backend; e.g. http://localhost:8332)",
+	)
+
+	fs.String(
+		flagBitcoinBitcoindUser,
+		"",
+		"Bitcoin Core JSON-RPC user",
+	)
+
+	fs.String(
+		flagBitcoinBitcoindWallet,
+		"",
+		"Bitcoin Core descriptor wallet tracking the history of wallet scripts "+
+			"(required for the bitcoind backend; should have private keys disabled)",
+	)
+
+	fs.Int64(
+		flagBitcoinBitcoindRescanStartTimestamp,
+		0,
+		"UNIX timestamp from which Bitcoin Core rescans the chain when importing "+
+			"a wallet script (should predate the bridge deployment; 0 rescans "+
+			"from the genesis block)",
 	)
 
 	fs.String(
@@ -108,11 +157,33 @@ func newFlagSet() *flag.FlagSet {
 		"Frequency of the queue check made by the BTC withdrawal job",
 	)
 
+	fs.String(
+		flagJobBatchAttestationListenAddress,
+		"",
+		"Listen address of the endpoint collecting AssetsUnlocked signatures "+
+			"from bridge validators (e.g. 0.0.0.0:8080); if empty, the batch "+
+			"attestation job is disabled",
+	)
+
+	fs.Duration(
+		flagJobBatchAttestationCheckFrequency,
+		flagJobBatchAttestationCheckFrequencyDefault,
+		"Frequency of the collected signatures check made by the batch attestation job",
+	)
+
 	fs.Uint(
 		flagPrometheusPort,
 		flagPrometheusPortDefault,
 		"Port to expose Prometheus metrics on",
 	)
 
+	fs.String(
+		flagDataDir,
+		flagDataDirDefault,
+		"Directory of the database persisting the BTC withdrawal job state "+
+			"(queue, finality checks, live wallets and processed blocks) "+
+			"between restarts",
+	)
+
 	return fs
 }
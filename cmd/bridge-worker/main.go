@@ -10,7 +10,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const EthereumAccountKeyFilePasswordEnv = "ETHEREUM_ACCOUNT_KEY_FILE_PASSWORD"
+const (
+	EthereumAccountKeyFilePasswordEnv = "ETHEREUM_ACCOUNT_KEY_FILE_PASSWORD"
+	BitcoinBitcoindPasswordEnv        = "BITCOIN_BITCOIND_PASSWORD"
+)
 
 func newRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -103,13 +106,61 @@ func parseFlags(cmd *cobra.Command) (bridgeworker.ConfigProperties, error) {
 		return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin network is required")
 	}
 
+	bitcoinBackend, err := cmd.Flags().GetString(flagBitcoinBackend)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin backend: [%w]", err)
+	}
+
 	bitcoinElectrumURL, err := cmd.Flags().GetString(flagBitcoinElectrumURL)
 	if err != nil {
 		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin electrum URL: [%w]", err)
 	}
-	_, err = url.ParseRequestURI(bitcoinElectrumURL)
+
+	bitcoinBitcoindURL, err := cmd.Flags().GetString(flagBitcoinBitcoindURL)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin bitcoind URL: [%w]", err)
+	}
+
+	bitcoinBitcoindUser, err := cmd.Flags().GetString(flagBitcoinBitcoindUser)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin bitcoind user: [%w]", err)
+	}
+
+	bitcoinBitcoindWallet, err := cmd.Flags().GetString(flagBitcoinBitcoindWallet)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin bitcoind wallet: [%w]", err)
+	}
+
+	bitcoinBitcoindRescanStartTimestamp, err := cmd.Flags().GetInt64(flagBitcoinBitcoindRescanStartTimestamp)
 	if err != nil {
-		return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin electrum URL is not valid: [%w]", err)
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get bitcoin bitcoind rescan start timestamp: [%w]", err)
+	}
+	if bitcoinBitcoindRescanStartTimestamp < 0 {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin bitcoind rescan start timestamp must not be negative")
+	}
+
+	bitcoinBitcoindPassword := os.Getenv(BitcoinBitcoindPasswordEnv)
+
+	switch bitcoinBackend {
+	case bridgeworker.BitcoinBackendElectrum:
+		_, err = url.ParseRequestURI(bitcoinElectrumURL)
+		if err != nil {
+			return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin electrum URL is not valid: [%w]", err)
+		}
+	case bridgeworker.BitcoinBackendBitcoind:
+		_, err = url.ParseRequestURI(bitcoinBitcoindURL)
+		if err != nil {
+			return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin bitcoind URL is not valid: [%w]", err)
+		}
+		if len(bitcoinBitcoindWallet) == 0 {
+			return bridgeworker.ConfigProperties{}, fmt.Errorf("bitcoin bitcoind wallet is required")
+		}
+	default:
+		return bridgeworker.ConfigProperties{}, fmt.Errorf(
+			"bitcoin backend must be %s or %s",
+			bridgeworker.BitcoinBackendElectrum,
+			bridgeworker.BitcoinBackendBitcoind,
+		)
 	}
 
 	mezoAssetsUnlockEndpoint, err := cmd.Flags().GetString(flagMezoAssetsUnlockEndpoint)
@@ -129,6 +180,19 @@ func parseFlags(cmd *cobra.Command) (bridgeworker.ConfigProperties, error) {
 		return bridgeworker.ConfigProperties{}, fmt.Errorf("BTC withdrawal job queue check frequency must be greater than 0")
 	}
 
+	jobBatchAttestationListenAddress, err := cmd.Flags().GetString(flagJobBatchAttestationListenAddress)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get batch attestation job listen address: [%w]", err)
+	}
+
+	jobBatchAttestationCheckFrequency, err := cmd.Flags().GetDuration(flagJobBatchAttestationCheckFrequency)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get batch attestation job check frequency: [%w]", err)
+	}
+	if jobBatchAttestationCheckFrequency == 0 {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("batch attestation job check frequency must be greater than 0")
+	}
+
 	prometheusPort, err := cmd.Flags().GetUint(flagPrometheusPort)
 	if err != nil {
 		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get prometheus port: [%w]", err)
@@ -137,6 +201,14 @@ func parseFlags(cmd *cobra.Command) (bridgeworker.ConfigProperties, error) {
 		return bridgeworker.ConfigProperties{}, fmt.Errorf("prometheus port must be greater than 0")
 	}
 
+	dataDir, err := cmd.Flags().GetString(flagDataDir)
+	if err != nil {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("failed to get data directory: [%w]", err)
+	}
+	if len(dataDir) == 0 {
+		return bridgeworker.ConfigProperties{}, fmt.Errorf("data directory is required")
+	}
+
 	return bridgeworker.ConfigProperties{
 		LogLevel:                            logLevel,
 		LogFormatJSON:                       logFormatJSON,
@@ -147,10 +219,19 @@ func parseFlags(cmd *cobra.Command) (bridgeworker.ConfigProperties, error) {
 		EthereumAccountKeyFile:              ethereumAccountKeyFile,
 		EthereumAccountKeyFilePassword:      ethereumAccountKeyFilePassword,
 		BitcoinNetwork:                      bitcoinNetwork,
+		BitcoinBackend:                      bitcoinBackend,
 		BitcoinElectrumURL:                  bitcoinElectrumURL,
+		BitcoinBitcoindURL:                  bitcoinBitcoindURL,
+		BitcoinBitcoindUser:                 bitcoinBitcoindUser,
+		BitcoinBitcoindPassword:             bitcoinBitcoindPassword,
+		BitcoinBitcoindWallet:               bitcoinBitcoindWallet,
+		BitcoinBitcoindRescanStartTimestamp: bitcoinBitcoindRescanStartTimestamp,
 		MezoAssetsUnlockEndpoint:            mezoAssetsUnlockEndpoint,
 		JobBTCWithdrawalQueueCheckFrequency: jobBTCWithdrawalQueueCheckFrequency,
+		JobBatchAttestationListenAddress:    jobBatchAttestationListenAddress,
+		JobBatchAttestationCheckFrequency:   jobBatchAttestationCheckFrequency,
 		PrometheusPort:                      prometheusPort,
+		DataDir:                             dataDir,
 	}, nil
 }
 
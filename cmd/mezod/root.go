@@ -65,6 +65,7 @@ import (
 	mezoserver "github.com/mezo-org/mezod/server"
 	servercfg "github.com/mezo-org/mezod/server/config"
 	srvflags "github.com/mezo-org/mezod/server/flags"
+	bridgeabci "github.com/mezo-org/mezod/x/bridge/abci"
 
 	"github.com/mezo-org/mezod/app"
 	cmdcfg "github.com/mezo-org/mezod/cmd/config"
@@ -278,6 +279,31 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		panic(err)
 	}
 
+	sourceChainServerAddresses, err := servercfg.ParseSourceChainServerAddresses(
+		cast.ToStringSlice(appOpts.Get(srvflags.EthereumSidecarSourceChainServerAddresses)),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	sourceChainSidecarClients := make(
+		map[uint32]bridgeabci.EthereumSidecarClient,
+		len(sourceChainServerAddresses),
+	)
+	for chain, serverAddress := range sourceChainServerAddresses {
+		sourceChainSidecarClient, err := ethsidecar.NewClient(
+			logger,
+			serverAddress,
+			cast.ToDuration(appOpts.Get(srvflags.EthereumSidecarRequestTimeout)),
+			a.encCfg.InterfaceRegistry,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		sourceChainSidecarClients[chain] = sourceChainSidecarClient
+	}
+
 	mezoApp := app.NewMezo(
 		logger,
 		db,
@@ -288,6 +314,7 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cast.ToUint(appOpts.Get(sdkserver.FlagInvCheckPeriod)),
 		a.encCfg,
 		ethereumSidecarClient,
+		sourceChainSidecarClients,
 		appOpts,
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(sdkserver.FlagMinGasPrices))),
@@ -354,6 +381,7 @@ func (a appCreator) appExport(
 			uint(1),
 			a.encCfg,
 			ethsidecar.NewClientMock(),
+			nil,
 			appOpts,
 			baseapp.SetChainID(chainID),
 		)
@@ -372,6 +400,7 @@ func (a appCreator) appExport(
 			uint(1),
 			a.encCfg,
 			ethsidecar.NewClientMock(),
+			nil,
 			appOpts,
 			baseapp.SetChainID(chainID),
 		)
@@ -42,6 +42,10 @@ type (
 	MezoBridgeAssetsUnlockConfirmed = ethereumabi.MezoBridgeAssetsUnlockConfirmed
 	MezoBridgeAssetsUnlocked        = ethereumabi.MezoBridgeAssetsUnlocked
 	BitcoinTxUTXO                   = ethereumabi.BitcoinTxUTXO
+	MezoBridgeTransactor            = ethereumabi.MezoBridgeTransactor
 )
 
-var NewMezoBridge = ethereumcontract.NewMezoBridge
+var (
+	NewMezoBridge           = ethereumcontract.NewMezoBridge
+	NewMezoBridgeTransactor = ethereumabi.NewMezoBridgeTransactor
+)
@@ -9,9 +9,14 @@ import (
 	"strings"
 	"sync"
 
+	goethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/google/uuid"
 	"github.com/ipfs/go-log"
 
@@ -49,10 +54,16 @@ type BaseChain struct {
 	transactionMutex *sync.Mutex
 
 	finalizedBlockFn func(ctx context.Context) (*big.Int, error)
+	blockHashFn      func(ctx context.Context, number *big.Int) (common.Hash, error)
+	headersByRangeFn func(ctx context.Context, startBlock, endBlock uint64) ([]*types.Header, error)
+	blockReceiptsFn  func(ctx context.Context, blockHash common.Hash) (types.Receipts, error)
+	feeHistoryFn     func(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*goethereum.FeeHistory, error)
+	nonceAtFn        func(ctx context.Context, account common.Address) (uint64, error)
 }
 
 type block struct {
 	Number string `json:"number"`
+	Hash   string `json:"hash"`
 }
 
 // Connect creates Ethereum chain handle.
@@ -170,6 +181,134 @@ func newBaseChain(
 		return finalizedBlock, nil
 	}
 
+	// The block hash is read from the raw block returned by the provider
+	// rather than computed from the decoded header, so it does not depend on
+	// the header fields known to the client.
+	blockHashFn := func(ctx context.Context, number *big.Int) (common.Hash, error) {
+		var b *block
+		err := client.Client().CallContext(
+			ctx,
+			&b,
+			"eth_getBlockByNumber",
+			hexutil.EncodeBig(number),
+			false,
+		)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf(
+				"failed to get block %s: %v",
+				number.String(),
+				err,
+			)
+		}
+
+		if b == nil || b.Hash == "" {
+			return common.Hash{}, fmt.Errorf("block %s not found", number.String())
+		}
+
+		return common.HexToHash(b.Hash), nil
+	}
+
+	// Headers are requested in a single batch to limit the number of round
+	// trips to the provider.
+	headersByRangeFn := func(
+		ctx context.Context,
+		startBlock uint64,
+		endBlock uint64,
+	) ([]*types.Header, error) {
+		if startBlock > endBlock {
+			return nil, fmt.Errorf(
+				"invalid block range [%d, %d]",
+				startBlock,
+				endBlock,
+			)
+		}
+
+		headers := make([]*types.Header, endBlock-startBlock+1)
+		requests := make([]rpc.BatchElem, len(headers))
+		for i := range requests {
+			requests[i] = rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args: []any{
+					hexutil.EncodeUint64(startBlock + uint64(i)),
+					false,
+				},
+				Result: &headers[i],
+			}
+		}
+
+		if err := client.Client().BatchCallContext(ctx, requests); err != nil {
+			return nil, fmt.Errorf(
+				"failed to get headers of blocks [%d, %d]: %v",
+				startBlock,
+				endBlock,
+				err,
+			)
+		}
+
+		for i, request := range requests {
+			number := startBlock + uint64(i)
+
+			if request.Error != nil {
+				return nil, fmt.Errorf(
+					"failed to get header of block %d: %v",
+					number,
+					request.Error,
+				)
+			}
+
+			if headers[i] == nil {
+				return nil, fmt.Errorf("block %d not found", number)
+			}
+		}
+
+		return headers, nil
+	}
+
+	blockReceiptsFn := func(
+		ctx context.Context,
+		blockHash common.Hash,
+	) (types.Receipts, error) {
+		receipts, err := client.BlockReceipts(
+			ctx,
+			rpc.BlockNumberOrHashWithHash(blockHash, true),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get receipts of block %s: %v",
+				blockHash.Hex(),
+				err,
+			)
+		}
+
+		return receipts, nil
+	}
+
+	feeHistoryFn := func(
+		ctx context.Context,
+		blockCount uint64,
+		rewardPercentiles []float64,
+	) (*goethereum.FeeHistory, error) {
+		feeHistory, err := client.FeeHistory(ctx, blockCount, nil, rewardPercentiles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get fee history: %v", err)
+		}
+
+		return feeHistory, nil
+	}
+
+	nonceAtFn := func(ctx context.Context, account common.Address) (uint64, error) {
+		nonce, err := client.NonceAt(ctx, account, nil)
+		if err != nil {
+			return 0, fmt.Errorf(
+				"failed to get nonce of account %s: %v",
+				account.Hex(),
+				err,
+			)
+		}
+
+		return nonce, nil
+	}
+
 	return &BaseChain{
 		key:              key,
 		client:           clientWithAddons,
@@ -179,6 +318,11 @@ func newBaseChain(
 		miningWaiter:     miningWaiter,
 		transactionMutex: transactionMutex,
 		finalizedBlockFn: finalizedBlockFn,
+		blockHashFn:      blockHashFn,
+		headersByRangeFn: headersByRangeFn,
+		blockReceiptsFn:  blockReceiptsFn,
+		feeHistoryFn:     feeHistoryFn,
+		nonceAtFn:        nonceAtFn,
 	}, nil
 }
 
@@ -214,6 +358,46 @@ func (bc *BaseChain) FinalizedBlock(ctx context.Context) (*big.Int, error) {
 	return bc.finalizedBlockFn(ctx)
 }
 
+// BlockHash returns the hash of the block with the given number.
+func (bc *BaseChain) BlockHash(ctx context.Context, number *big.Int) (common.Hash, error) {
+	return bc.blockHashFn(ctx, number)
+}
+
+// HeadersByRange returns the headers of blocks with numbers in the given
+// inclusive range, in ascending order.
+func (bc *BaseChain) HeadersByRange(
+	ctx context.Context,
+	startBlock uint64,
+	endBlock uint64,
+) ([]*types.Header, error) {
+	return bc.headersByRangeFn(ctx, startBlock, endBlock)
+}
+
+// BlockReceipts returns the receipts of all transactions of the block with
+// the given hash.
+func (bc *BaseChain) BlockReceipts(
+	ctx context.Context,
+	blockHash common.Hash,
+) (types.Receipts, error) {
+	return bc.blockReceiptsFn(ctx, blockHash)
+}
+
+// FeeHistory returns the base fees and the priority fees at the given
+// percentiles of the given number of the latest blocks.
+func (bc *BaseChain) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	rewardPercentiles []float64,
+) (*goethereum.FeeHistory, error) {
+	return bc.feeHistoryFn(ctx, blockCount, rewardPercentiles)
+}
+
+// NonceAt returns the nonce of the given account at the latest block, so
+// excluding transactions that are still pending.
+func (bc *BaseChain) NonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return bc.nonceAtFn(ctx, account)
+}
+
 func (bc *BaseChain) CurrentBlock() (uint64, error) {
 	return bc.blockCounter.CurrentBlock()
 }
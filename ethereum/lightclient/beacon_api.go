@@ -0,0 +1,172 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// requestTimeout is the timeout of a single request to the beacon node.
+const requestTimeout = 30 * time.Second
+
+// supportedVersions are the beacon chain forks whose light client data are
+// supported. Earlier forks use a different execution payload header layout.
+var supportedVersions = []string{"deneb", "electra", "fulu"}
+
+// versionedResponse is a beacon node API response with light client data of
+// a given beacon chain fork.
+type versionedResponse[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+func (vr *versionedResponse[T]) validate() error {
+	if !slices.Contains(supportedVersions, vr.Version) {
+		return fmt.Errorf("unsupported light client data version: %q", vr.Version)
+	}
+
+	return nil
+}
+
+// beaconNodeAPI is the BeaconAPI implementation using the standard REST API
+// of a beacon node.
+type beaconNodeAPI struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewBeaconNodeAPI creates a BeaconAPI served by the REST API of the beacon
+// node with the given URL.
+func NewBeaconNodeAPI(baseURL string) (BeaconAPI, error) {
+	if _, err := url.ParseRequestURI(baseURL); err != nil {
+		return nil, fmt.Errorf("invalid beacon node URL: [%w]", err)
+	}
+
+	return &beaconNodeAPI{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+func (bna *beaconNodeAPI) Bootstrap(
+	ctx context.Context,
+	blockRoot common.Hash,
+) (*LightClientBootstrap, error) {
+	var response versionedResponse[*LightClientBootstrap]
+	err := bna.get(
+		ctx,
+		"/eth/v1/beacon/light_client/bootstrap/"+blockRoot.Hex(),
+		nil,
+		&response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.validate(); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+func (bna *beaconNodeAPI) Updates(
+	ctx context.Context,
+	startPeriod uint64,
+	count uint64,
+) ([]*LightClientUpdate, error) {
+	var responses []versionedResponse[*LightClientUpdate]
+	err := bna.get(
+		ctx,
+		"/eth/v1/beacon/light_client/updates",
+		url.Values{
+			"start_period": {strconv.FormatUint(startPeriod, 10)},
+			"count":        {strconv.FormatUint(count, 10)},
+		},
+		&responses,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]*LightClientUpdate, len(responses))
+	for i, response := range responses {
+		if err := response.validate(); err != nil {
+			return nil, err
+		}
+
+		updates[i] = response.Data
+	}
+
+	return updates, nil
+}
+
+func (bna *beaconNodeAPI) FinalityUpdate(ctx context.Context) (*LightClientUpdate, error) {
+	var response versionedResponse[*LightClientUpdate]
+	err := bna.get(
+		ctx,
+		"/eth/v1/beacon/light_client/finality_update",
+		nil,
+		&response,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.validate(); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// get performs a GET request to the given path of the beacon node API and
+// decodes the JSON response into the given result.
+func (bna *beaconNodeAPI) get(
+	ctx context.Context,
+	path string,
+	query url.Values,
+	result any,
+) error {
+	requestURL := bna.baseURL + path
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: [%w]", err)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := bna.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: [%w]", path, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf(
+			"request to %s failed with status %d: %s",
+			path,
+			response.StatusCode,
+			strings.TrimSpace(string(body)),
+		)
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response of %s: [%w]", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/params"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// secondsPerSlot is the duration of a beacon chain slot.
+	secondsPerSlot = 12
+
+	// maxRequestUpdates is the maximum number of light client updates that
+	// can be requested from a beacon node at once.
+	maxRequestUpdates = 128
+)
+
+// ErrNotBootstrapped is returned when the light client is used before it is
+// bootstrapped.
+var ErrNotBootstrapped = errors.New("light client is not bootstrapped")
+
+// BeaconAPI is the source of light client data. The data is not trusted and
+// is verified by the light client.
+type BeaconAPI interface {
+	// Bootstrap returns the light client bootstrap at the beacon chain block
+	// with the given root.
+	Bootstrap(ctx context.Context, blockRoot common.Hash) (*LightClientBootstrap, error)
+
+	// Updates returns the best light client updates of the given number of
+	// consecutive sync committee periods, starting from the given one.
+	Updates(ctx context.Context, startPeriod uint64, count uint64) ([]*LightClientUpdate, error)
+
+	// FinalityUpdate returns the light client update of the latest finalized
+	// beacon chain block.
+	FinalityUpdate(ctx context.Context) (*LightClientUpdate, error)
+}
+
+// ChainConfig returns the beacon chain configuration of the given Ethereum
+// network.
+func ChainConfig(network string) (*params.ChainConfig, error) {
+	switch network {
+	case "mainnet":
+		return withFork(
+			params.MainnetLightConfig,
+			"FULU",
+			411392,
+			[]byte{6, 0, 0, 0},
+		), nil
+	case "sepolia":
+		return withFork(
+			params.SepoliaLightConfig,
+			"FULU",
+			272640,
+			[]byte{144, 0, 0, 117},
+		), nil
+	default:
+		return nil, fmt.Errorf(
+			"light client is not supported on %s Ethereum network",
+			network,
+		)
+	}
+}
+
+// withFork returns a copy of the given beacon chain configuration with the
+// given fork added, unless the configuration already contains it.
+func withFork(
+	base *params.ChainConfig,
+	name string,
+	epoch uint64,
+	version []byte,
+) *params.ChainConfig {
+	config := *base
+	config.Forks = slices.Clone(base.Forks)
+
+	if slices.ContainsFunc(config.Forks, func(fork *params.Fork) bool {
+		return fork.Name == name
+	}) {
+		return &config
+	}
+
+	return config.AddFork(name, epoch, version)
+}
+
+// Client is a beacon chain light client. It follows the beacon chain with the
+// sync protocol, starting from a trusted block, and keeps track of the latest
+// finalized beacon chain block along with its execution payload header. All
+// data received from the beacon node is verified against the signatures of
+// the sync committee.
+type Client struct {
+	config *params.ChainConfig
+	api    BeaconAPI
+	now    func() time.Time
+
+	mutex sync.RWMutex
+	store *lightClientStore
+}
+
+// NewClient creates a new light client of the beacon chain with the given
+// configuration. The client must be bootstrapped before it is used.
+func NewClient(config *params.ChainConfig, api BeaconAPI) *Client {
+	return &Client{
+		config: config,
+		api:    api,
+		now:    time.Now,
+	}
+}
+
+// Bootstrap initializes the light client from the beacon chain block with
+// the given trusted root. The block should be a finalized checkpoint block.
+func (c *Client) Bootstrap(ctx context.Context, trustedBlockRoot common.Hash) error {
+	bootstrap, err := c.api.Bootstrap(ctx, trustedBlockRoot)
+	if err != nil {
+		return fmt.Errorf("failed to get light client bootstrap: [%w]", err)
+	}
+
+	store, err := newLightClientStore(c.config, trustedBlockRoot, bootstrap)
+	if err != nil {
+		return fmt.Errorf("invalid light client bootstrap: [%w]", err)
+	}
+
+	c.mutex.Lock()
+	c.store = store
+	c.mutex.Unlock()
+
+	return nil
+}
+
+// Sync advances the light client to the latest finalized beacon chain block.
+// Sync committees of the periods passed since the last sync are processed
+// first so the latest finality update can be verified. Updates older than
+// the state of the light client are skipped.
+func (c *Client) Sync(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.store == nil {
+		return ErrNotBootstrapped
+	}
+
+	storePeriod := periodAtSlot(c.store.finalizedHeader.Beacon.Slot)
+	currentPeriod := periodAtSlot(c.currentSlot())
+
+	if storePeriod < currentPeriod || c.store.nextSyncCommittee == nil {
+		count := min(currentPeriod-storePeriod+1, maxRequestUpdates)
+
+		updates, err := c.api.Updates(ctx, storePeriod, count)
+		if err != nil {
+			return fmt.Errorf("failed to get light client updates: [%w]", err)
+		}
+
+		for _, update := range updates {
+			err := c.store.processUpdate(update)
+			if err != nil && !errors.Is(err, errIrrelevantUpdate) {
+				return fmt.Errorf(
+					"failed to process light client update signed at slot %d: [%w]",
+					update.SignatureSlot,
+					err,
+				)
+			}
+		}
+	}
+
+	finalityUpdate, err := c.api.FinalityUpdate(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get light client finality update: [%w]", err)
+	}
+
+	err = c.store.processUpdate(finalityUpdate)
+	if err != nil && !errors.Is(err, errIrrelevantUpdate) {
+		return fmt.Errorf("failed to process light client finality update: [%w]", err)
+	}
+
+	return nil
+}
+
+// FinalizedHeader returns the latest finalized beacon chain block header
+// known to the light client.
+func (c *Client) FinalizedHeader() (*LightClientHeader, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if c.store == nil {
+		return nil, ErrNotBootstrapped
+	}
+
+	header := *c.store.finalizedHeader
+	return &header, nil
+}
+
+// currentSlot returns the beacon chain slot at the current time.
+func (c *Client) currentSlot() uint64 {
+	now := uint64(c.now().Unix()) //nolint:gosec
+	if now < c.config.GenesisTime {
+		return 0
+	}
+
+	return (now - c.config.GenesisTime) / secondsPerSlot
+}
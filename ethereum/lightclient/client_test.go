@@ -0,0 +1,360 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainConfig(t *testing.T) {
+	tests := map[string]struct {
+		network      string
+		expectedFork string
+		expectedErr  bool
+	}{
+		"mainnet": {
+			network:      "mainnet",
+			expectedFork: "FULU",
+		},
+		"sepolia": {
+			network:      "sepolia",
+			expectedFork: "FULU",
+		},
+		"unsupported network": {
+			network:     "holesky",
+			expectedErr: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config, err := ChainConfig(test.network)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// Far future epoch to get the latest fork.
+			require.Equal(
+				t,
+				test.expectedFork,
+				config.ForkAtEpoch(1<<40).Name,
+			)
+		})
+	}
+}
+
+func TestClient_Bootstrap(t *testing.T) {
+	client := newFixtureClient(t)
+
+	_, err := client.FinalizedHeader()
+	require.ErrorIs(t, err, ErrNotBootstrapped)
+	require.ErrorIs(t, client.Sync(context.Background()), ErrNotBootstrapped)
+
+	trustedBlockRoot := readTrustedBlockRoot(t)
+
+	err = client.Bootstrap(context.Background(), trustedBlockRoot)
+	require.NoError(t, err)
+
+	header, err := client.FinalizedHeader()
+	require.NoError(t, err)
+	require.Equal(t, trustedBlockRoot, header.Beacon.HashTreeRoot())
+}
+
+func TestNewLightClientStore(t *testing.T) {
+	tests := map[string]struct {
+		tamper        func(bootstrap *LightClientBootstrap) common.Hash
+		expectedError string
+	}{
+		"valid bootstrap": {
+			tamper: func(*LightClientBootstrap) common.Hash {
+				return common.Hash{}
+			},
+		},
+		"untrusted block root": {
+			tamper: func(*LightClientBootstrap) common.Hash {
+				return common.HexToHash("0x01")
+			},
+			expectedError: "does not match the trusted block root",
+		},
+		"tampered sync committee": {
+			tamper: func(bootstrap *LightClientBootstrap) common.Hash {
+				pubkeys := bootstrap.CurrentSyncCommittee.Pubkeys
+				pubkeys[0], pubkeys[1] = pubkeys[len(pubkeys)-1], pubkeys[0]
+				return common.Hash{}
+			},
+			expectedError: "invalid current sync committee branch",
+		},
+		"tampered execution payload header": {
+			tamper: func(bootstrap *LightClientBootstrap) common.Hash {
+				bootstrap.Header.Execution.BlockNumber++
+				return common.Hash{}
+			},
+			expectedError: "invalid execution branch",
+		},
+		"truncated sync committee": {
+			tamper: func(bootstrap *LightClientBootstrap) common.Hash {
+				bootstrap.CurrentSyncCommittee.Pubkeys =
+					bootstrap.CurrentSyncCommittee.Pubkeys[1:]
+				return common.Hash{}
+			},
+			expectedError: "sync committee has 511 public keys",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var response versionedResponse[*LightClientBootstrap]
+			readFixture(t, fixtureBootstrap, &response)
+			bootstrap := response.Data
+
+			trustedBlockRoot := readTrustedBlockRoot(t)
+			if root := test.tamper(bootstrap); root != (common.Hash{}) {
+				trustedBlockRoot = root
+			}
+
+			_, err := newLightClientStore(testChainConfig, trustedBlockRoot, bootstrap)
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLightClientStore_ProcessUpdate(t *testing.T) {
+	// newStore returns a store bootstrapped from the fixtures with the
+	// given number of sync committee updates applied.
+	newStore := func(t *testing.T, updates int) *lightClientStore {
+		var response versionedResponse[*LightClientBootstrap]
+		readFixture(t, fixtureBootstrap, &response)
+
+		store, err := newLightClientStore(
+			testChainConfig,
+			readTrustedBlockRoot(t),
+			response.Data,
+		)
+		require.NoError(t, err)
+
+		var responses []versionedResponse[*LightClientUpdate]
+		readFixture(t, fixtureUpdates, &responses)
+		for _, response := range responses[:updates] {
+			require.NoError(t, store.processUpdate(response.Data))
+		}
+
+		return store
+	}
+
+	readUpdates := func(t *testing.T) []*LightClientUpdate {
+		var responses []versionedResponse[*LightClientUpdate]
+		readFixture(t, fixtureUpdates, &responses)
+
+		updates := make([]*LightClientUpdate, len(responses))
+		for i, response := range responses {
+			updates[i] = response.Data
+		}
+		return updates
+	}
+
+	tests := map[string]struct {
+		appliedUpdates          int
+		update                  func(t *testing.T) *LightClientUpdate
+		expectedError           string
+		expectedErrorIs         error
+		expectedFinalizedSlot   uint64
+		expectedNextCommittee   bool
+		expectedCommitteeChange bool
+	}{
+		"next sync committee update": {
+			appliedUpdates: 0,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdates(t)[0]
+			},
+			expectedFinalizedSlot: 82048,
+			expectedNextCommittee: true,
+		},
+		"sync committee rotation": {
+			appliedUpdates: 1,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdates(t)[1]
+			},
+			expectedFinalizedSlot:   90176,
+			expectedNextCommittee:   true,
+			expectedCommitteeChange: true,
+		},
+		"finality update": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdateFixture(t, fixtureFinalityUpdate)
+			},
+			expectedFinalizedSlot: 90304,
+			expectedNextCommittee: true,
+		},
+		"update of an unknown sync committee period": {
+			appliedUpdates: 0,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdates(t)[1]
+			},
+			expectedError: "update signed in period 11 cannot be verified in period 10",
+		},
+		"outdated update": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdates(t)[0]
+			},
+			expectedErrorIs: errIrrelevantUpdate,
+		},
+		"insufficient participation": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				return readUpdateFixture(t, fixtureLowParticipationUpdate)
+			},
+			expectedErrorIs: errInsufficientParticipation,
+		},
+		"no participation": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				clear(update.SyncAggregate.SyncCommitteeBits)
+				return update
+			},
+			expectedErrorIs: errInsufficientParticipation,
+		},
+		"signature of another block": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				update.SyncAggregate = readUpdates(t)[1].SyncAggregate
+				return update
+			},
+			expectedError: "sync committee signature verification failed",
+		},
+		"signature of other participants": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				update.SyncAggregate.SyncCommitteeBits[0] ^= 1
+				update.SyncAggregate.SyncCommitteeBits[63] ^= 1 << 7
+				return update
+			},
+			expectedError: "sync committee signature verification failed",
+		},
+		"tampered finalized header": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				update.FinalizedHeader.Beacon.Slot++
+				return update
+			},
+			expectedError: "invalid finality branch",
+		},
+		"tampered finalized execution payload header": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				update.FinalizedHeader.Execution.ReceiptsRoot = common.HexToHash("0x01")
+				return update
+			},
+			expectedError: "invalid finalized header",
+		},
+		"tampered next sync committee": {
+			appliedUpdates: 0,
+			update: func(t *testing.T) *LightClientUpdate {
+				updates := readUpdates(t)
+				update := updates[0]
+				update.NextSyncCommittee = updates[1].NextSyncCommittee
+				return update
+			},
+			expectedError: "invalid next sync committee branch",
+		},
+		"invalid slots": {
+			appliedUpdates: 2,
+			update: func(t *testing.T) *LightClientUpdate {
+				update := readUpdateFixture(t, fixtureFinalityUpdate)
+				update.SignatureSlot = update.AttestedHeader.Beacon.Slot
+				return update
+			},
+			expectedError: "invalid slots of the update",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			store := newStore(t, test.appliedUpdates)
+			committee := store.currentSyncCommittee
+
+			err := store.processUpdate(test.update(t))
+			if test.expectedErrorIs != nil {
+				require.ErrorIs(t, err, test.expectedErrorIs)
+				return
+			}
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(
+				t,
+				test.expectedFinalizedSlot,
+				store.finalizedHeader.Beacon.Slot,
+			)
+			require.Equal(
+				t,
+				test.expectedNextCommittee,
+				store.nextSyncCommittee != nil,
+			)
+			require.Equal(
+				t,
+				test.expectedCommitteeChange,
+				committee != store.currentSyncCommittee,
+			)
+		})
+	}
+}
+
+func TestLightClientStore_ProcessUpdate_Replayed(t *testing.T) {
+	client := newFixtureClient(t)
+	require.NoError(t, client.Bootstrap(context.Background(), readTrustedBlockRoot(t)))
+	require.NoError(t, client.Sync(context.Background()))
+
+	finalizedHeader := client.store.finalizedHeader
+	nextSyncCommittee := client.store.nextSyncCommittee
+
+	// A valid update that was already applied does not change the state.
+	err := client.store.processUpdate(readUpdateFixture(t, fixtureFinalityUpdate))
+	require.NoError(t, err)
+	require.Equal(t, finalizedHeader, client.store.finalizedHeader)
+	require.Equal(t, nextSyncCommittee, client.store.nextSyncCommittee)
+}
+
+func TestClient_Sync(t *testing.T) {
+	client := newFixtureClient(t)
+
+	err := client.Bootstrap(context.Background(), readTrustedBlockRoot(t))
+	require.NoError(t, err)
+
+	err = client.Sync(context.Background())
+	require.NoError(t, err)
+
+	header, err := client.FinalizedHeader()
+	require.NoError(t, err)
+	require.Equal(t, uint64(90304), header.Beacon.Slot)
+	require.Equal(
+		t,
+		uint64(fixtureFinalizedExecutionBlock),
+		header.Execution.BlockNumber,
+	)
+
+	// Syncing again with no new data keeps the state.
+	err = client.Sync(context.Background())
+	require.NoError(t, err)
+
+	header, err = client.FinalizedHeader()
+	require.NoError(t, err)
+	require.Equal(t, uint64(90304), header.Beacon.Slot)
+}
@@ -0,0 +1,436 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/trie/trienode"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+const (
+	// headersBatchSize is the maximum number of execution block headers
+	// requested from the execution chain at once.
+	headersBatchSize = 100
+
+	// maxVerifiedHeaders is the maximum number of verified execution block
+	// headers kept by the log verifier. It covers a bit more than a month of
+	// Ethereum blocks.
+	maxVerifiedHeaders = 1 << 18
+)
+
+// ExecutionChain is the source of execution layer data verified by the log
+// verifier. The data is not trusted.
+type ExecutionChain interface {
+	// HeadersByRange returns the headers of execution blocks with numbers
+	// in the given inclusive range, in ascending order.
+	HeadersByRange(ctx context.Context, startBlock uint64, endBlock uint64) ([]*types.Header, error)
+
+	// BlockReceipts returns the receipts of all transactions of the execution
+	// block with the given hash.
+	BlockReceipts(ctx context.Context, blockHash common.Hash) (types.Receipts, error)
+}
+
+// ReceiptProof is the Merkle-Patricia proof of a transaction receipt against
+// the receipts root of an execution block.
+type ReceiptProof []hexutil.Bytes
+
+// receiptKey returns the key of the receipt of the transaction with the
+// given index in the receipts trie.
+func receiptKey(txIndex uint) []byte {
+	return rlp.AppendUint64(nil, uint64(txIndex))
+}
+
+// NewReceiptProof builds the proof of the receipt of the transaction with
+// the given index against the receipts trie of the given block receipts.
+func NewReceiptProof(receipts types.Receipts, txIndex uint) (ReceiptProof, error) {
+	if txIndex >= uint(len(receipts)) {
+		return nil, fmt.Errorf(
+			"transaction index %d out of range of %d receipts",
+			txIndex,
+			len(receipts),
+		)
+	}
+
+	receiptsTrie := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+
+	var buffer bytes.Buffer
+	for i := range receipts {
+		buffer.Reset()
+		receipts.EncodeIndex(i, &buffer)
+
+		err := receiptsTrie.Update(receiptKey(uint(i)), common.CopyBytes(buffer.Bytes()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build receipts trie: [%w]", err)
+		}
+	}
+
+	var nodes trienode.ProofList
+	if err := receiptsTrie.Prove(receiptKey(txIndex), &nodes); err != nil {
+		return nil, fmt.Errorf("failed to prove receipt: [%w]", err)
+	}
+
+	proof := make(ReceiptProof, len(nodes))
+	for i, node := range nodes {
+		proof[i] = hexutil.Bytes(node)
+	}
+
+	return proof, nil
+}
+
+// VerifyReceiptProof verifies the proof of the receipt of the transaction
+// with the given index against the given receipts root and returns the
+// proven receipt.
+func VerifyReceiptProof(
+	receiptsRoot common.Hash,
+	txIndex uint,
+	proof ReceiptProof,
+) (*types.Receipt, error) {
+	nodes := make(trienode.ProofList, len(proof))
+	for i, node := range proof {
+		nodes[i] = rlp.RawValue(node)
+	}
+
+	value, err := trie.VerifyProof(receiptsRoot, receiptKey(txIndex), nodes.Set())
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt proof: [%w]", err)
+	}
+
+	if len(value) == 0 {
+		return nil, fmt.Errorf("receipt of transaction %d does not exist", txIndex)
+	}
+
+	receipt := new(types.Receipt)
+	if err := receipt.UnmarshalBinary(value); err != nil {
+		return nil, fmt.Errorf("failed to decode proven receipt: [%w]", err)
+	}
+
+	return receipt, nil
+}
+
+// verifiedHeader is the part of an execution block header verified to be an
+// ancestor of an execution block finalized by the light client.
+type verifiedHeader struct {
+	hash         common.Hash
+	parentHash   common.Hash
+	receiptsRoot common.Hash
+}
+
+// LogVerifier verifies logs returned by an untrusted execution chain against
+// the execution blocks finalized by the light client. A log is verified by
+// proving its receipt against the receipts root of its block, whose header is
+// linked to the latest finalized execution block by the chain of parent
+// hashes.
+type LogVerifier struct {
+	client *Client
+	chain  ExecutionChain
+
+	mutex sync.Mutex
+	// headers is a contiguous chain of verified execution block headers,
+	// ordered by block number and starting at the block with number
+	// firstHeader.
+	headers     []verifiedHeader
+	firstHeader uint64
+}
+
+// NewLogVerifier creates a new verifier of logs returned by the given
+// execution chain against the blocks finalized by the given light client.
+func NewLogVerifier(client *Client, chain ExecutionChain) *LogVerifier {
+	return &LogVerifier{
+		client: client,
+		chain:  chain,
+	}
+}
+
+// VerifyLogs verifies that the given logs were emitted by successful
+// transactions included in execution blocks finalized by the light client.
+func (lv *LogVerifier) VerifyLogs(ctx context.Context, logs []types.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	lv.mutex.Lock()
+	defer lv.mutex.Unlock()
+
+	finalizedHeader, err := lv.client.FinalizedHeader()
+	if err != nil {
+		return err
+	}
+
+	if err := lv.extendToFinalized(ctx, &finalizedHeader.Execution); err != nil {
+		return fmt.Errorf("failed to verify finalized execution block: [%w]", err)
+	}
+
+	receiptsByBlock := make(map[common.Hash]types.Receipts)
+
+	for _, log := range logs {
+		if err := lv.verifyLog(ctx, log, receiptsByBlock); err != nil {
+			return fmt.Errorf(
+				"failed to verify log %d of block %d: [%w]",
+				log.Index,
+				log.BlockNumber,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// verifyLog verifies the given log. Receipts of blocks are fetched once and
+// cached in the given map.
+func (lv *LogVerifier) verifyLog(
+	ctx context.Context,
+	log types.Log,
+	receiptsByBlock map[common.Hash]types.Receipts,
+) error {
+	if log.Removed {
+		return fmt.Errorf("log was removed due to chain reorganization")
+	}
+
+	header, err := lv.header(ctx, log.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	if header.hash != log.BlockHash {
+		return fmt.Errorf(
+			"log block hash %s does not match the verified block hash %s",
+			log.BlockHash.Hex(),
+			header.hash.Hex(),
+		)
+	}
+
+	receipts, ok := receiptsByBlock[log.BlockHash]
+	if !ok {
+		receipts, err = lv.chain.BlockReceipts(ctx, log.BlockHash)
+		if err != nil {
+			return fmt.Errorf("failed to get block receipts: [%w]", err)
+		}
+
+		receiptsByBlock[log.BlockHash] = receipts
+	}
+
+	proof, err := NewReceiptProof(receipts, log.TxIndex)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := VerifyReceiptProof(header.receiptsRoot, log.TxIndex, proof)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %d failed", log.TxIndex)
+	}
+
+	for _, receiptLog := range receipt.Logs {
+		if receiptLog.Address == log.Address &&
+			slices.Equal(receiptLog.Topics, log.Topics) &&
+			bytes.Equal(receiptLog.Data, log.Data) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("log not found in the receipt of transaction %d", log.TxIndex)
+}
+
+// extendToFinalized extends the chain of verified headers up to the given
+// finalized execution block.
+func (lv *LogVerifier) extendToFinalized(
+	ctx context.Context,
+	finalized *ExecutionPayloadHeader,
+) error {
+	finalizedHeader := verifiedHeader{
+		hash:         finalized.BlockHash,
+		parentHash:   finalized.ParentHash,
+		receiptsRoot: finalized.ReceiptsRoot,
+	}
+
+	lastHeader := lv.firstHeader + uint64(len(lv.headers)) - 1
+
+	// The finalized block is trusted on its own so the chain of verified
+	// headers can start over from it if the verified headers are too old to
+	// be worth linking to it.
+	if len(lv.headers) == 0 ||
+		(finalized.BlockNumber > lastHeader &&
+			finalized.BlockNumber-lastHeader > maxVerifiedHeaders) {
+		lv.headers = []verifiedHeader{finalizedHeader}
+		lv.firstHeader = finalized.BlockNumber
+		return nil
+	}
+
+	if finalized.BlockNumber <= lastHeader {
+		if finalized.BlockNumber < lv.firstHeader ||
+			lv.headers[finalized.BlockNumber-lv.firstHeader].hash != finalized.BlockHash {
+			return fmt.Errorf(
+				"finalized block %d is not in the chain of verified blocks",
+				finalized.BlockNumber,
+			)
+		}
+
+		return nil
+	}
+
+	// Verify the headers between the last verified header and the finalized
+	// block, going back from the finalized block.
+	headers, err := lv.fetchLinkedHeaders(
+		ctx,
+		lastHeader+1,
+		finalized.BlockNumber-1,
+		finalizedHeader.parentHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	linkHash := finalizedHeader.parentHash
+	if len(headers) > 0 {
+		linkHash = headers[0].parentHash
+	}
+
+	if linkHash != lv.headers[len(lv.headers)-1].hash {
+		return fmt.Errorf(
+			"finalized block %d does not descend from verified block %d",
+			finalized.BlockNumber,
+			lastHeader,
+		)
+	}
+
+	lv.headers = append(lv.headers, headers...)
+	lv.headers = append(lv.headers, finalizedHeader)
+
+	if len(lv.headers) > maxVerifiedHeaders {
+		trim := len(lv.headers) - maxVerifiedHeaders
+		lv.headers = slices.Clone(lv.headers[trim:])
+		lv.firstHeader += uint64(trim)
+	}
+
+	return nil
+}
+
+// header returns the verified header of the execution block with the given
+// number, extending the chain of verified headers back to it if needed.
+func (lv *LogVerifier) header(ctx context.Context, number uint64) (*verifiedHeader, error) {
+	lastHeader := lv.firstHeader + uint64(len(lv.headers)) - 1
+
+	if number > lastHeader {
+		return nil, fmt.Errorf(
+			"block %d is not finalized by the light client; latest "+
+				"finalized block is %d",
+			number,
+			lastHeader,
+		)
+	}
+
+	if number < lv.firstHeader {
+		if lv.firstHeader-number+uint64(len(lv.headers)) > maxVerifiedHeaders {
+			return nil, fmt.Errorf(
+				"block %d is too old to be verified; oldest verifiable block is %d",
+				number,
+				lastHeader+1-maxVerifiedHeaders,
+			)
+		}
+
+		headers, err := lv.fetchLinkedHeaders(
+			ctx,
+			number,
+			lv.firstHeader-1,
+			lv.headers[0].parentHash,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		lv.headers = append(headers, lv.headers...)
+		lv.firstHeader = number
+	}
+
+	return &lv.headers[number-lv.firstHeader], nil
+}
+
+// fetchLinkedHeaders fetches headers of execution blocks with numbers in the
+// given inclusive range and verifies they form a chain whose last block has
+// the given hash. Returns no headers if the range is empty.
+func (lv *LogVerifier) fetchLinkedHeaders(
+	ctx context.Context,
+	startBlock uint64,
+	endBlock uint64,
+	endHash common.Hash,
+) ([]verifiedHeader, error) {
+	if startBlock > endBlock {
+		return nil, nil
+	}
+
+	headers := make([]verifiedHeader, endBlock-startBlock+1)
+	expectedHash := endHash
+
+	// Fetch the headers in batches going back from the end of the range so
+	// each batch is verified against the hash linking it to the next one.
+	for batchEnd := endBlock; ; {
+		batchStart := startBlock
+		if batchEnd-startBlock >= headersBatchSize {
+			batchStart = batchEnd - headersBatchSize + 1
+		}
+
+		batch, err := lv.chain.HeadersByRange(ctx, batchStart, batchEnd)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get headers of blocks [%d, %d]: [%w]",
+				batchStart,
+				batchEnd,
+				err,
+			)
+		}
+
+		if uint64(len(batch)) != batchEnd-batchStart+1 {
+			return nil, fmt.Errorf(
+				"got %d headers of blocks [%d, %d]",
+				len(batch),
+				batchStart,
+				batchEnd,
+			)
+		}
+
+		for i := len(batch) - 1; i >= 0; i-- {
+			number := batchStart + uint64(i)
+			header := batch[i]
+
+			if header.Number == nil || header.Number.Uint64() != number {
+				return nil, fmt.Errorf("got header of a wrong block instead of %d", number)
+			}
+
+			if hash := header.Hash(); hash != expectedHash {
+				return nil, fmt.Errorf(
+					"hash %s of block %d does not match the expected hash %s",
+					hash.Hex(),
+					number,
+					expectedHash.Hex(),
+				)
+			}
+
+			headers[number-startBlock] = verifiedHeader{
+				hash:         expectedHash,
+				parentHash:   header.ParentHash,
+				receiptsRoot: header.ReceiptHash,
+			}
+			expectedHash = header.ParentHash
+		}
+
+		if batchStart == startBlock {
+			return headers, nil
+		}
+
+		batchEnd = batchStart - 1
+	}
+}
@@ -0,0 +1,185 @@
+package lightclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptProof(t *testing.T) {
+	chain := newFixtureExecutionChain(t)
+	header := chain.headers[1002]
+	receipts := chain.receipts[header.Hash()]
+
+	for txIndex := range receipts {
+		proof, err := NewReceiptProof(receipts, uint(txIndex))
+		require.NoError(t, err)
+
+		receipt, err := VerifyReceiptProof(header.ReceiptHash, uint(txIndex), proof)
+		require.NoError(t, err)
+		require.Equal(t, receipts[txIndex].Status, receipt.Status)
+		require.Len(t, receipt.Logs, len(receipts[txIndex].Logs))
+		for i, log := range receipt.Logs {
+			require.Equal(t, receipts[txIndex].Logs[i].Address, log.Address)
+			require.Equal(t, receipts[txIndex].Logs[i].Topics, log.Topics)
+			require.Equal(t, receipts[txIndex].Logs[i].Data, log.Data)
+		}
+	}
+
+	proof, err := NewReceiptProof(receipts, 1)
+	require.NoError(t, err)
+
+	_, err = VerifyReceiptProof(chain.headers[1001].ReceiptHash, 1, proof)
+	require.Error(t, err)
+
+	_, err = NewReceiptProof(receipts, uint(len(receipts)))
+	require.ErrorContains(t, err, "out of range")
+}
+
+func TestLogVerifier_VerifyLogs(t *testing.T) {
+	tests := map[string]struct {
+		// logs returns the logs to verify, based on the recorded ones.
+		logs func(t *testing.T, chain *fixtureExecutionChain) []types.Log
+		// tamper modifies the execution chain data before verification.
+		tamper        func(chain *fixtureExecutionChain)
+		expectedError string
+	}{
+		"no logs": {
+			logs: func(*testing.T, *fixtureExecutionChain) []types.Log {
+				return nil
+			},
+		},
+		"genuine logs": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				return []types.Log{
+					chain.fixtureLog(t, 1002, 1),
+					chain.fixtureLog(t, 1002, 2),
+					chain.fixtureLog(t, 1004, 0),
+				}
+			},
+		},
+		"tampered log data": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1002, 1)
+				log.Data = common.BigToHash(big.NewInt(1000000)).Bytes()
+				return []types.Log{log}
+			},
+			expectedError: "log not found in the receipt of transaction 1",
+		},
+		"tampered log topics": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1002, 1)
+				log.Topics = []common.Hash{log.Topics[0], common.BigToHash(big.NewInt(3))}
+				return []types.Log{log}
+			},
+			expectedError: "log not found in the receipt of transaction 1",
+		},
+		"tampered log address": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1002, 1)
+				log.Address = common.HexToAddress("0x01")
+				return []types.Log{log}
+			},
+			expectedError: "log not found in the receipt of transaction 1",
+		},
+		"wrong block hash": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1002, 1)
+				log.BlockHash = common.HexToHash("0x01")
+				return []types.Log{log}
+			},
+			expectedError: "does not match the verified block hash",
+		},
+		"unfinalized block": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1004, 0)
+				log.BlockNumber = 1005
+				return []types.Log{log}
+			},
+			expectedError: "block 1005 is not finalized by the light client",
+		},
+		"removed log": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1002, 1)
+				log.Removed = true
+				return []types.Log{log}
+			},
+			expectedError: "log was removed due to chain reorganization",
+		},
+		"failed transaction": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				log := chain.fixtureLog(t, 1003, 0)
+				log.TxIndex = 1
+				return []types.Log{log}
+			},
+			expectedError: "transaction 1 failed",
+		},
+		"tampered block header": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				return []types.Log{chain.fixtureLog(t, 1002, 1)}
+			},
+			tamper: func(chain *fixtureExecutionChain) {
+				chain.headers[1003].GasUsed++
+			},
+			expectedError: "does not match the expected hash",
+		},
+		"tampered receipts": {
+			logs: func(t *testing.T, chain *fixtureExecutionChain) []types.Log {
+				return []types.Log{chain.fixtureLog(t, 1002, 1)}
+			},
+			tamper: func(chain *fixtureExecutionChain) {
+				receipts := chain.receipts[chain.headers[1002].Hash()]
+				receipts[1].Logs[0].Data = common.BigToHash(big.NewInt(1000000)).Bytes()
+			},
+			expectedError: "invalid receipt proof",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			client := newFixtureClient(t)
+			require.NoError(t, client.Bootstrap(context.Background(), readTrustedBlockRoot(t)))
+			require.NoError(t, client.Sync(context.Background()))
+
+			chain := newFixtureExecutionChain(t)
+			logs := test.logs(t, chain)
+
+			if test.tamper != nil {
+				test.tamper(chain)
+			}
+
+			err := NewLogVerifier(client, chain).VerifyLogs(context.Background(), logs)
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLogVerifier_VerifyLogs_NotBootstrapped(t *testing.T) {
+	chain := newFixtureExecutionChain(t)
+	verifier := NewLogVerifier(newFixtureClient(t), chain)
+
+	err := verifier.VerifyLogs(
+		context.Background(),
+		[]types.Log{chain.fixtureLog(t, 1002, 1)},
+	)
+	require.ErrorIs(t, err, ErrNotBootstrapped)
+}
+
+func TestExecutionPayloadHeader_HashTreeRoot(t *testing.T) {
+	header := readUpdateFixture(t, fixtureFinalityUpdate).FinalizedHeader.Execution
+
+	_, err := header.HashTreeRoot()
+	require.NoError(t, err)
+
+	header.ExtraData = make([]byte, 33)
+	_, err = header.HashTreeRoot()
+	require.ErrorContains(t, err, "extra data")
+}
@@ -0,0 +1,681 @@
+package lightclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+	"github.com/ethereum/go-ethereum/beacon/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// The fixtures in the testdata directory follow the format of responses of
+// the beacon node light client API and the execution node JSON-RPC API. They
+// describe a beacon chain whose sync committees consist of deterministic test
+// keys, so they can be regenerated with:
+//
+//	go test ./ethereum/lightclient -run TestGenerateFixtures -update-fixtures
+var updateFixtures = flag.Bool("update-fixtures", false, "regenerate test fixtures")
+
+const (
+	fixtureBootstrap                    = "bootstrap.json"
+	fixtureUpdates                      = "updates.json"
+	fixtureFinalityUpdate               = "finality_update.json"
+	fixtureLowParticipationUpdate       = "finality_update_low_participation.json"
+	fixtureExecutionHeaders             = "execution_headers.json"
+	fixtureExecutionReceipts            = "execution_receipts.json"
+	fixtureTrustedBlockRoot             = "trusted_block_root.json"
+	fixtureFinalizedExecutionBlock      = 1004
+	fixtureFirstExecutionBlock          = 1000
+	fixtureSyncCommitteeKeys            = 16
+	fixtureParticipants                 = 500
+	fixtureLowParticipationParticipants = 300
+)
+
+var (
+	// testChainConfig is the configuration of the beacon chain described by
+	// the fixtures. All forks up to Electra are active since genesis.
+	testChainConfig = (&params.ChainConfig{
+		GenesisValidatorsRoot: common.HexToHash("0x6d657a6f2d6c69676874636c69656e742d746573742d67656e65736973000001"),
+		GenesisTime:           1700000000,
+	}).
+		AddFork("GENESIS", 0, []byte{0, 0, 0, 0x10}).
+		AddFork("ALTAIR", 0, []byte{1, 0, 0, 0x10}).
+		AddFork("BELLATRIX", 0, []byte{2, 0, 0, 0x10}).
+		AddFork("CAPELLA", 0, []byte{3, 0, 0, 0x10}).
+		AddFork("DENEB", 0, []byte{4, 0, 0, 0x10}).
+		AddFork("ELECTRA", 0, []byte{5, 0, 0, 0x10})
+
+	// testBridgeAddress is the address of the contract emitting the logs
+	// described by the fixtures.
+	testBridgeAddress = common.HexToAddress("0xF6680EA3b480cA2b72D96ea13cCAF2cFd8e6908c")
+
+	// testCurrentSlot is the slot of the beacon chain at the time the
+	// fixtures were recorded.
+	testCurrentSlot = uint64(90400)
+)
+
+// fixtureBeaconAPI is the BeaconAPI serving the recorded fixtures.
+type fixtureBeaconAPI struct {
+	t *testing.T
+}
+
+func (fba *fixtureBeaconAPI) Bootstrap(
+	_ context.Context,
+	blockRoot common.Hash,
+) (*LightClientBootstrap, error) {
+	var response versionedResponse[*LightClientBootstrap]
+	readFixture(fba.t, fixtureBootstrap, &response)
+
+	if response.Data.Header.Beacon.HashTreeRoot() != blockRoot {
+		return nil, fmt.Errorf("bootstrap not found")
+	}
+
+	return response.Data, response.validate()
+}
+
+func (fba *fixtureBeaconAPI) Updates(
+	_ context.Context,
+	startPeriod uint64,
+	count uint64,
+) ([]*LightClientUpdate, error) {
+	var responses []versionedResponse[*LightClientUpdate]
+	readFixture(fba.t, fixtureUpdates, &responses)
+
+	updates := make([]*LightClientUpdate, 0)
+	for _, response := range responses {
+		period := periodAtSlot(response.Data.AttestedHeader.Beacon.Slot)
+		if period >= startPeriod && period < startPeriod+count {
+			updates = append(updates, response.Data)
+		}
+	}
+
+	return updates, nil
+}
+
+func (fba *fixtureBeaconAPI) FinalityUpdate(_ context.Context) (*LightClientUpdate, error) {
+	return readUpdateFixture(fba.t, fixtureFinalityUpdate), nil
+}
+
+// fixtureExecutionChain is the ExecutionChain serving the recorded fixtures.
+// Headers and receipts can be tampered with before they are served.
+type fixtureExecutionChain struct {
+	headers  map[uint64]*types.Header
+	receipts map[common.Hash]types.Receipts
+}
+
+func newFixtureExecutionChain(t *testing.T) *fixtureExecutionChain {
+	var headers []*types.Header
+	readFixture(t, fixtureExecutionHeaders, &headers)
+
+	receipts := make(map[common.Hash]types.Receipts)
+	readFixture(t, fixtureExecutionReceipts, &receipts)
+
+	chain := &fixtureExecutionChain{
+		headers:  make(map[uint64]*types.Header),
+		receipts: receipts,
+	}
+	for _, header := range headers {
+		chain.headers[header.Number.Uint64()] = header
+	}
+
+	return chain
+}
+
+func (fec *fixtureExecutionChain) HeadersByRange(
+	_ context.Context,
+	startBlock uint64,
+	endBlock uint64,
+) ([]*types.Header, error) {
+	headers := make([]*types.Header, 0)
+	for number := startBlock; number <= endBlock; number++ {
+		header, ok := fec.headers[number]
+		if !ok {
+			return nil, fmt.Errorf("block %d not found", number)
+		}
+		headers = append(headers, header)
+	}
+
+	return headers, nil
+}
+
+func (fec *fixtureExecutionChain) BlockReceipts(
+	_ context.Context,
+	blockHash common.Hash,
+) (types.Receipts, error) {
+	receipts, ok := fec.receipts[blockHash]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	return receipts, nil
+}
+
+// fixtureLog returns the log with the given index in the block with the given
+// number, as recorded in the fixtures.
+func (fec *fixtureExecutionChain) fixtureLog(t *testing.T, blockNumber uint64, index uint) types.Log {
+	receipts := fec.receipts[fec.headers[blockNumber].Hash()]
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			if log.Index == index {
+				return *log
+			}
+		}
+	}
+
+	t.Fatalf("log %d of block %d not found", index, blockNumber)
+	return types.Log{}
+}
+
+func readFixture(t *testing.T, name string, result any) {
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, result))
+}
+
+func readUpdateFixture(t *testing.T, name string) *LightClientUpdate {
+	var response versionedResponse[*LightClientUpdate]
+	readFixture(t, name, &response)
+	require.NoError(t, response.validate())
+	return response.Data
+}
+
+func readTrustedBlockRoot(t *testing.T) common.Hash {
+	var root common.Hash
+	readFixture(t, fixtureTrustedBlockRoot, &root)
+	return root
+}
+
+// newFixtureClient returns a light client serving the recorded fixtures at
+// the time they were recorded.
+func newFixtureClient(t *testing.T) *Client {
+	client := NewClient(testChainConfig, &fixtureBeaconAPI{t: t})
+	client.now = fixtureNow
+
+	return client
+}
+
+func fixtureNow() time.Time {
+	return time.Unix(int64(testChainConfig.GenesisTime+testCurrentSlot*secondsPerSlot), 0) //nolint:gosec
+}
+
+// TestGenerateFixtures regenerates the fixtures in the testdata directory.
+// It is skipped unless the -update-fixtures flag is set.
+func TestGenerateFixtures(t *testing.T) {
+	if !*updateFixtures {
+		t.Skip("fixtures are regenerated only with the -update-fixtures flag")
+	}
+
+	execution := generateExecutionChain(t)
+
+	committees := map[uint64]*testSyncCommittee{
+		10: newTestSyncCommittee(10),
+		11: newTestSyncCommittee(11),
+		12: newTestSyncCommittee(12),
+	}
+
+	// The bootstrap header is the first checkpoint block of period 10.
+	bootstrapHeader, bootstrapState := newTestHeader(81984, 900, nil, map[uint64]common.Hash{
+		params.StateIndexSyncCommitteeElectra: committees[10].committee.HashTreeRoot(),
+	})
+	bootstrap := &LightClientBootstrap{
+		Header:                     bootstrapHeader,
+		CurrentSyncCommittee:       *committees[10].committee,
+		CurrentSyncCommitteeBranch: bootstrapState[params.StateIndexSyncCommitteeElectra],
+	}
+
+	// The first update is signed in period 10 and carries the sync committee
+	// of period 11.
+	update1 := newTestUpdate(
+		82048, 910,
+		82112, 920,
+		82113,
+		nil,
+		committees[11],
+		committees[10],
+		fixtureParticipants,
+	)
+
+	// The second update is signed in period 11 and carries the sync
+	// committee of period 12.
+	update2 := newTestUpdate(
+		90176, 990,
+		90240, 995,
+		90241,
+		nil,
+		committees[12],
+		committees[11],
+		fixtureParticipants,
+	)
+
+	// The finality update finalizes the last execution block of the
+	// recorded execution chain.
+	finalityUpdate := newTestUpdate(
+		90304, fixtureFinalizedExecutionBlock,
+		90368, fixtureFinalizedExecutionBlock+10,
+		90369,
+		execution.finalizedHeader,
+		nil,
+		committees[11],
+		fixtureParticipants,
+	)
+
+	lowParticipationUpdate := newTestUpdate(
+		90336, fixtureFinalizedExecutionBlock+5,
+		90380, fixtureFinalizedExecutionBlock+15,
+		90381,
+		nil,
+		nil,
+		committees[11],
+		fixtureLowParticipationParticipants,
+	)
+
+	writeFixture(t, fixtureTrustedBlockRoot, bootstrapHeader.Beacon.HashTreeRoot())
+	writeFixture(t, fixtureBootstrap, versionedResponse[*LightClientBootstrap]{
+		Version: "electra",
+		Data:    bootstrap,
+	})
+	writeFixture(t, fixtureUpdates, []versionedResponse[*LightClientUpdate]{
+		{Version: "electra", Data: update1},
+		{Version: "electra", Data: update2},
+	})
+	writeFixture(t, fixtureFinalityUpdate, versionedResponse[*LightClientUpdate]{
+		Version: "electra",
+		Data:    finalityUpdate,
+	})
+	writeFixture(t, fixtureLowParticipationUpdate, versionedResponse[*LightClientUpdate]{
+		Version: "electra",
+		Data:    lowParticipationUpdate,
+	})
+	writeFixture(t, fixtureExecutionHeaders, execution.headers)
+	writeFixture(t, fixtureExecutionReceipts, execution.receipts)
+}
+
+func writeFixture(t *testing.T, name string, value any) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join("testdata", name), append(data, '\n'), 0o600))
+}
+
+// fixtureHash returns a deterministic hash derived from the given labels.
+func fixtureHash(labels ...any) common.Hash {
+	return sha256.Sum256([]byte(fmt.Sprint(labels...)))
+}
+
+// testExecutionChain is a chain of execution blocks with receipts.
+type testExecutionChain struct {
+	headers         []*types.Header
+	receipts        map[common.Hash]types.Receipts
+	finalizedHeader *types.Header
+}
+
+// generateExecutionChain generates a chain of execution blocks. Block 1002
+// contains a transaction emitting a log of the test bridge and an unrelated
+// one, and block 1003 contains a failed transaction.
+func generateExecutionChain(t *testing.T) *testExecutionChain {
+	chain := &testExecutionChain{
+		receipts: make(map[common.Hash]types.Receipts),
+	}
+
+	parentHash := fixtureHash("execution parent")
+
+	for number := uint64(fixtureFirstExecutionBlock); number <= fixtureFinalizedExecutionBlock; number++ {
+		receipts := types.Receipts{
+			newTestReceipt(
+				types.ReceiptStatusSuccessful,
+				&types.Log{
+					Address: common.HexToAddress("0x0000000000000000000000000000000000001234"),
+					Topics:  []common.Hash{fixtureHash("unrelated event", number)},
+					Data:    fixtureHash("unrelated data", number).Bytes(),
+				},
+			),
+		}
+
+		switch number {
+		case 1002:
+			receipts = append(receipts, newTestReceipt(
+				types.ReceiptStatusSuccessful,
+				&types.Log{
+					Address: testBridgeAddress,
+					Topics: []common.Hash{
+						fixtureHash("AssetsLocked"),
+						common.BigToHash(big.NewInt(1)),
+					},
+					Data: common.BigToHash(big.NewInt(1000)).Bytes(),
+				},
+				&types.Log{
+					Address: testBridgeAddress,
+					Topics: []common.Hash{
+						fixtureHash("AssetsLocked"),
+						common.BigToHash(big.NewInt(2)),
+					},
+					Data: common.BigToHash(big.NewInt(2000)).Bytes(),
+				},
+			))
+		case 1003:
+			receipts = append(receipts, newTestReceipt(types.ReceiptStatusFailed))
+		}
+
+		header := &types.Header{
+			ParentHash:       parentHash,
+			UncleHash:        types.EmptyUncleHash,
+			Coinbase:         common.HexToAddress("0x4838B106FCe9647Bdf1E7877BF73cE8B0BAD5f97"),
+			Root:             fixtureHash("state", number),
+			TxHash:           fixtureHash("transactions", number),
+			ReceiptHash:      types.DeriveSha(receipts, trie.NewStackTrie(nil)),
+			Bloom:            types.MergeBloom(receipts),
+			Difficulty:       big.NewInt(0),
+			Number:           new(big.Int).SetUint64(number),
+			GasLimit:         36000000,
+			GasUsed:          receipts[len(receipts)-1].CumulativeGasUsed,
+			Time:             testChainConfig.GenesisTime + number*secondsPerSlot,
+			Extra:            []byte("mezo"),
+			MixDigest:        fixtureHash("randao", number),
+			BaseFee:          big.NewInt(1000000000),
+			WithdrawalsHash:  &types.EmptyWithdrawalsHash,
+			BlobGasUsed:      new(uint64),
+			ExcessBlobGas:    new(uint64),
+			ParentBeaconRoot: ptr(fixtureHash("parent beacon root", number)),
+			RequestsHash:     &types.EmptyRequestsHash,
+		}
+
+		blockHash := header.Hash()
+		logIndex := uint(0)
+		for txIndex, receipt := range receipts {
+			receipt.TxHash = fixtureHash("transaction", number, txIndex)
+			receipt.BlockHash = blockHash
+			receipt.BlockNumber = header.Number
+			receipt.TransactionIndex = uint(txIndex)
+
+			for _, log := range receipt.Logs {
+				log.BlockNumber = number
+				log.BlockHash = blockHash
+				log.TxHash = receipt.TxHash
+				log.TxIndex = uint(txIndex)
+				log.Index = logIndex
+				logIndex++
+			}
+		}
+
+		chain.headers = append(chain.headers, header)
+		chain.receipts[blockHash] = receipts
+		parentHash = blockHash
+	}
+
+	chain.finalizedHeader = chain.headers[len(chain.headers)-1]
+
+	require.NotEmpty(t, chain.receipts)
+
+	return chain
+}
+
+func newTestReceipt(status uint64, logs ...*types.Log) *types.Receipt {
+	receipt := &types.Receipt{
+		Type:              types.DynamicFeeTxType,
+		Status:            status,
+		CumulativeGasUsed: 21000 + uint64(len(logs))*10000,
+		GasUsed:           21000 + uint64(len(logs))*10000,
+		EffectiveGasPrice: big.NewInt(1000000000),
+		Logs:              append([]*types.Log{}, logs...),
+	}
+	receipt.Bloom = types.CreateBloom(receipt)
+
+	return receipt
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// testSyncCommittee is a sync committee of deterministic test keys. Members
+// of the committee are assigned the test keys in a round-robin fashion.
+type testSyncCommittee struct {
+	committee  *SyncCommittee
+	secretKeys []*blst.SecretKey
+}
+
+func newTestSyncCommittee(period uint64) *testSyncCommittee {
+	secretKeys := make([]*blst.SecretKey, fixtureSyncCommitteeKeys)
+	pubkeys := make([]*blst.P1Affine, fixtureSyncCommitteeKeys)
+	for i := range secretKeys {
+		ikm := fixtureHash("sync committee key", period, i)
+		secretKeys[i] = blst.KeyGen(ikm[:])
+		pubkeys[i] = new(blst.P1Affine).From(secretKeys[i])
+	}
+
+	committee := &SyncCommittee{}
+	members := make([]*blst.P1Affine, params.SyncCommitteeSize)
+	for i := range members {
+		members[i] = pubkeys[i%fixtureSyncCommitteeKeys]
+		committee.Pubkeys = append(committee.Pubkeys, members[i].Compress())
+	}
+
+	aggregate := new(blst.P1Aggregate)
+	aggregate.Aggregate(members, false)
+	committee.AggregatePubkey = aggregate.ToAffine().Compress()
+
+	return &testSyncCommittee{
+		committee:  committee,
+		secretKeys: secretKeys,
+	}
+}
+
+// sign returns the sync aggregate of the given number of first members of
+// the committee signing the given beacon block header at the given slot.
+func (tsc *testSyncCommittee) sign(
+	header *BeaconBlockHeader,
+	signatureSlot uint64,
+	participants int,
+) SyncAggregate {
+	signingRoot, err := testChainConfig.Forks.SigningRoot(
+		epochAtSlot(signatureSlot-1),
+		header.HashTreeRoot(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	signatures := make([]*blst.P2Affine, len(tsc.secretKeys))
+	for i, secretKey := range tsc.secretKeys {
+		signatures[i] = new(blst.P2Affine).Sign(secretKey, signingRoot[:], signatureDST)
+	}
+
+	bits := make([]byte, params.SyncCommitteeBitmaskSize)
+	aggregate := new(blst.P2Aggregate)
+	for i := 0; i < participants; i++ {
+		bits[i/8] |= 1 << (i % 8)
+		aggregate.Add(signatures[i%len(signatures)], false)
+	}
+
+	return SyncAggregate{
+		SyncCommitteeBits:      bits,
+		SyncCommitteeSignature: aggregate.ToAffine().Compress(),
+	}
+}
+
+// newTestHeader returns a light client header at the given slot with an
+// execution payload of the given block, or of the given header if set. The
+// state of the header commits to the given leaves whose proofs are returned.
+func newTestHeader(
+	slot uint64,
+	blockNumber uint64,
+	executionHeader *types.Header,
+	stateLeaves map[uint64]common.Hash,
+) (LightClientHeader, map[uint64][]common.Hash) {
+	execution := ExecutionPayloadHeader{
+		ParentHash:       fixtureHash("execution parent", blockNumber),
+		FeeRecipient:     common.HexToAddress("0x4838B106FCe9647Bdf1E7877BF73cE8B0BAD5f97"),
+		StateRoot:        fixtureHash("execution state", blockNumber),
+		ReceiptsRoot:     fixtureHash("execution receipts", blockNumber),
+		PrevRandao:       fixtureHash("randao", blockNumber),
+		BlockNumber:      blockNumber,
+		GasLimit:         36000000,
+		GasUsed:          21000,
+		Timestamp:        testChainConfig.GenesisTime + slot*secondsPerSlot,
+		ExtraData:        []byte("mezo"),
+		BaseFeePerGas:    uint256.NewInt(1000000000),
+		BlockHash:        fixtureHash("execution block", blockNumber),
+		TransactionsRoot: fixtureHash("execution transactions", blockNumber),
+		WithdrawalsRoot:  fixtureHash("execution withdrawals", blockNumber),
+	}
+
+	if executionHeader != nil {
+		execution = ExecutionPayloadHeader{
+			ParentHash:       executionHeader.ParentHash,
+			FeeRecipient:     executionHeader.Coinbase,
+			StateRoot:        executionHeader.Root,
+			ReceiptsRoot:     executionHeader.ReceiptHash,
+			LogsBloom:        executionHeader.Bloom,
+			PrevRandao:       executionHeader.MixDigest,
+			BlockNumber:      executionHeader.Number.Uint64(),
+			GasLimit:         executionHeader.GasLimit,
+			GasUsed:          executionHeader.GasUsed,
+			Timestamp:        executionHeader.Time,
+			ExtraData:        executionHeader.Extra,
+			BaseFeePerGas:    uint256.MustFromBig(executionHeader.BaseFee),
+			BlockHash:        executionHeader.Hash(),
+			TransactionsRoot: fixtureHash("execution transactions", blockNumber),
+			WithdrawalsRoot:  fixtureHash("execution withdrawals", blockNumber),
+			BlobGasUsed:      *executionHeader.BlobGasUsed,
+			ExcessBlobGas:    *executionHeader.ExcessBlobGas,
+		}
+	}
+
+	executionRoot, err := execution.HashTreeRoot()
+	if err != nil {
+		panic(err)
+	}
+
+	bodyRoot, bodyProofs := buildTestTree(
+		fmt.Sprint("body", slot),
+		map[uint64]common.Hash{params.BodyIndexExecPayload: executionRoot},
+	)
+	stateRoot, stateProofs := buildTestTree(fmt.Sprint("state", slot), stateLeaves)
+
+	return LightClientHeader{
+		Beacon: BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: slot % 1000,
+			ParentRoot:    fixtureHash("beacon parent", slot),
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		Execution:       execution,
+		ExecutionBranch: bodyProofs[params.BodyIndexExecPayload],
+	}, stateProofs
+}
+
+// newTestUpdate returns a light client update of the given finalized and
+// attested slots and execution blocks, signed at the given slot by the given
+// number of members of the given sync committee. The update carries the
+// given next sync committee if set.
+func newTestUpdate(
+	finalizedSlot uint64,
+	finalizedBlockNumber uint64,
+	attestedSlot uint64,
+	attestedBlockNumber uint64,
+	signatureSlot uint64,
+	finalizedExecutionHeader *types.Header,
+	nextSyncCommittee *testSyncCommittee,
+	signingSyncCommittee *testSyncCommittee,
+	participants int,
+) *LightClientUpdate {
+	finalizedHeader, _ := newTestHeader(
+		finalizedSlot,
+		finalizedBlockNumber,
+		finalizedExecutionHeader,
+		map[uint64]common.Hash{},
+	)
+
+	stateLeaves := map[uint64]common.Hash{
+		params.StateIndexFinalBlockElectra: finalizedHeader.Beacon.HashTreeRoot(),
+	}
+	if nextSyncCommittee != nil {
+		stateLeaves[params.StateIndexNextSyncCommitteeElectra] =
+			nextSyncCommittee.committee.HashTreeRoot()
+	}
+
+	attestedHeader, stateProofs := newTestHeader(
+		attestedSlot,
+		attestedBlockNumber,
+		nil,
+		stateLeaves,
+	)
+
+	update := &LightClientUpdate{
+		AttestedHeader:  attestedHeader,
+		FinalizedHeader: finalizedHeader,
+		FinalityBranch:  stateProofs[params.StateIndexFinalBlockElectra],
+		SyncAggregate: signingSyncCommittee.sign(
+			&attestedHeader.Beacon,
+			signatureSlot,
+			participants,
+		),
+		SignatureSlot: signatureSlot,
+	}
+
+	if nextSyncCommittee != nil {
+		update.NextSyncCommittee = nextSyncCommittee.committee
+		update.NextSyncCommitteeBranch = stateProofs[params.StateIndexNextSyncCommitteeElectra]
+	}
+
+	return update
+}
+
+// buildTestTree builds a binary Merkle tree committing to the given leaves
+// at the given generalized indices. Subtrees without leaves are replaced by
+// deterministic nodes derived from the given label. Returns the root of the
+// tree and the proofs of the leaves.
+func buildTestTree(
+	label string,
+	leaves map[uint64]common.Hash,
+) (common.Hash, map[uint64][]common.Hash) {
+	ancestors := make(map[uint64]bool)
+	for index := range leaves {
+		for ancestor := index >> 1; ancestor > 0; ancestor >>= 1 {
+			ancestors[ancestor] = true
+		}
+	}
+
+	var node func(index uint64) common.Hash
+	node = func(index uint64) common.Hash {
+		if leaf, ok := leaves[index]; ok {
+			return leaf
+		}
+
+		if !ancestors[index] {
+			var indexBytes [8]byte
+			binary.BigEndian.PutUint64(indexBytes[:], index)
+			return fixtureHash(label, hexutil.Encode(indexBytes[:]))
+		}
+
+		return common.Hash(hashPair(
+			merkle.Value(node(2*index)),
+			merkle.Value(node(2*index+1)),
+		))
+	}
+
+	proofs := make(map[uint64][]common.Hash)
+	for index := range leaves {
+		for sibling := index; sibling > 1; sibling >>= 1 {
+			proofs[index] = append(proofs[index], node(sibling^1))
+		}
+	}
+
+	return node(1), proofs
+}
@@ -0,0 +1,85 @@
+package lightclient
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+)
+
+// maxMerkleDepth is the maximum depth of SSZ Merkle trees hashed by the
+// light client.
+const maxMerkleDepth = 10
+
+// zeroHashes holds the roots of SSZ Merkle trees consisting of zero chunks,
+// indexed by the tree depth.
+var zeroHashes = func() [maxMerkleDepth + 1]merkle.Value {
+	var hashes [maxMerkleDepth + 1]merkle.Value
+	for depth := 1; depth <= maxMerkleDepth; depth++ {
+		hashes[depth] = hashPair(hashes[depth-1], hashes[depth-1])
+	}
+	return hashes
+}()
+
+// hashPair returns the SHA-256 hash of the concatenation of two chunks.
+func hashPair(left, right merkle.Value) merkle.Value {
+	hasher := sha256.New()
+	hasher.Write(left[:])
+	hasher.Write(right[:])
+
+	var hash merkle.Value
+	hasher.Sum(hash[:0])
+	return hash
+}
+
+// merkleize returns the root of the SSZ Merkle tree of the given chunks,
+// padded with zero chunks up to the given limit of chunks. The limit is
+// rounded up to the next power of two.
+func merkleize(chunks []merkle.Value, limit int) merkle.Value {
+	depth := 0
+	for 1<<depth < limit {
+		depth++
+	}
+
+	if len(chunks) == 0 {
+		return zeroHashes[depth]
+	}
+
+	layer := append([]merkle.Value{}, chunks...)
+	for d := 0; d < depth; d++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[d])
+		}
+
+		next := make([]merkle.Value, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+
+	return layer[0]
+}
+
+// mixInLength returns the root of an SSZ list with the given root of its
+// elements and length.
+func mixInLength(root merkle.Value, length uint64) merkle.Value {
+	return hashPair(root, uint64Chunk(length))
+}
+
+// uint64Chunk returns the SSZ chunk of the given uint64 value.
+func uint64Chunk(value uint64) merkle.Value {
+	var chunk merkle.Value
+	binary.LittleEndian.PutUint64(chunk[:8], value)
+	return chunk
+}
+
+// bytesChunks packs the given bytes into SSZ chunks, right-padding the last
+// chunk with zeros.
+func bytesChunks(bytes []byte) []merkle.Value {
+	chunks := make([]merkle.Value, (len(bytes)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], bytes[i*32:])
+	}
+	return chunks
+}
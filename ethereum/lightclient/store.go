@@ -0,0 +1,318 @@
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/beacon/params"
+	"github.com/ethereum/go-ethereum/common"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// signatureDST is the domain separation tag of BLS signatures on the beacon
+// chain.
+var signatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+var (
+	// errInsufficientParticipation is returned when the light client update
+	// is not signed by a supermajority of the sync committee.
+	errInsufficientParticipation = errors.New(
+		"light client update not signed by a supermajority of the sync committee",
+	)
+
+	// errNextSyncCommitteeUnknown is returned when the light client update
+	// requires the next sync committee that is not known yet.
+	errNextSyncCommitteeUnknown = errors.New("next sync committee is not known")
+
+	// errIrrelevantUpdate is returned when the light client update carries
+	// no information newer than the state of the light client.
+	errIrrelevantUpdate = errors.New("light client update is not relevant")
+)
+
+// epochAtSlot returns the epoch of the given slot.
+func epochAtSlot(slot uint64) uint64 {
+	return slot / params.EpochLength
+}
+
+// periodAtSlot returns the sync committee period of the given slot.
+func periodAtSlot(slot uint64) uint64 {
+	return slot / params.SyncPeriodLength
+}
+
+// verifiedSyncCommittee is a sync committee whose membership was proven
+// against a trusted beacon chain state, along with its decoded public keys.
+type verifiedSyncCommittee struct {
+	root    common.Hash
+	pubkeys []*blst.P1Affine
+}
+
+func newVerifiedSyncCommittee(committee *SyncCommittee) (*verifiedSyncCommittee, error) {
+	if err := committee.validate(); err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([]*blst.P1Affine, len(committee.Pubkeys))
+	for i, pubkey := range committee.Pubkeys {
+		pubkeys[i] = new(blst.P1Affine).Uncompress(pubkey)
+		if pubkeys[i] == nil {
+			return nil, fmt.Errorf("invalid public key %d", i)
+		}
+	}
+
+	return &verifiedSyncCommittee{
+		root:    committee.HashTreeRoot(),
+		pubkeys: pubkeys,
+	}, nil
+}
+
+// lightClientStore is the state of the light client following the beacon
+// chain with the sync protocol. It holds the latest finalized header and the
+// sync committees of its period and the next one. The next sync committee
+// is nil if it is not known yet.
+type lightClientStore struct {
+	config *params.ChainConfig
+
+	finalizedHeader      *LightClientHeader
+	currentSyncCommittee *verifiedSyncCommittee
+	nextSyncCommittee    *verifiedSyncCommittee
+}
+
+// newLightClientStore initializes the light client state from the given
+// bootstrap. The header of the bootstrap must be the beacon chain block with
+// the given trusted root.
+func newLightClientStore(
+	config *params.ChainConfig,
+	trustedBlockRoot common.Hash,
+	bootstrap *LightClientBootstrap,
+) (*lightClientStore, error) {
+	if err := bootstrap.Header.verify(); err != nil {
+		return nil, fmt.Errorf("invalid bootstrap header: [%w]", err)
+	}
+
+	if root := bootstrap.Header.Beacon.HashTreeRoot(); root != trustedBlockRoot {
+		return nil, fmt.Errorf(
+			"bootstrap header root %s does not match the trusted block root %s",
+			root.Hex(),
+			trustedBlockRoot.Hex(),
+		)
+	}
+
+	committee, err := newVerifiedSyncCommittee(&bootstrap.CurrentSyncCommittee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current sync committee: [%w]", err)
+	}
+
+	err = verifyBranch(
+		bootstrap.Header.Beacon.StateRoot,
+		params.StateIndexSyncCommittee(forkName(config, bootstrap.Header.Beacon.Slot)),
+		bootstrap.CurrentSyncCommitteeBranch,
+		committee.root,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current sync committee branch: [%w]", err)
+	}
+
+	return &lightClientStore{
+		config:               config,
+		finalizedHeader:      &bootstrap.Header,
+		currentSyncCommittee: committee,
+	}, nil
+}
+
+// forkName returns the lower-case name of the beacon chain fork active at
+// the given slot.
+func forkName(config *params.ChainConfig, slot uint64) string {
+	return strings.ToLower(config.ForkAtEpoch(epochAtSlot(slot)).Name)
+}
+
+// processUpdate validates the given light client update and applies it to
+// the state. Valid updates that do not advance the state are ignored.
+func (lcs *lightClientStore) processUpdate(update *LightClientUpdate) error {
+	nextSyncCommittee, err := lcs.validateUpdate(update)
+	if err != nil {
+		return err
+	}
+
+	if update.SyncAggregate.participants()*3 < params.SyncCommitteeSize*2 {
+		return errInsufficientParticipation
+	}
+
+	storePeriod := periodAtSlot(lcs.finalizedHeader.Beacon.Slot)
+	finalizedPeriod := periodAtSlot(update.FinalizedHeader.Beacon.Slot)
+	attestedPeriod := periodAtSlot(update.AttestedHeader.Beacon.Slot)
+
+	hasFinalizedNextSyncCommittee := lcs.nextSyncCommittee == nil &&
+		nextSyncCommittee != nil &&
+		finalizedPeriod == attestedPeriod
+
+	if update.FinalizedHeader.Beacon.Slot <= lcs.finalizedHeader.Beacon.Slot &&
+		!hasFinalizedNextSyncCommittee {
+		return nil
+	}
+
+	if lcs.nextSyncCommittee == nil {
+		if finalizedPeriod != storePeriod {
+			return errNextSyncCommitteeUnknown
+		}
+
+		lcs.nextSyncCommittee = nextSyncCommittee
+	} else if finalizedPeriod == storePeriod+1 {
+		lcs.currentSyncCommittee = lcs.nextSyncCommittee
+		lcs.nextSyncCommittee = nextSyncCommittee
+	}
+
+	if update.FinalizedHeader.Beacon.Slot > lcs.finalizedHeader.Beacon.Slot {
+		lcs.finalizedHeader = &update.FinalizedHeader
+	}
+
+	return nil
+}
+
+// validateUpdate validates the given light client update against the state.
+// It returns the next sync committee carried by the update, or nil if the
+// update does not carry it.
+func (lcs *lightClientStore) validateUpdate(
+	update *LightClientUpdate,
+) (*verifiedSyncCommittee, error) {
+	if err := update.SyncAggregate.validate(); err != nil {
+		return nil, fmt.Errorf("invalid sync aggregate: [%w]", err)
+	}
+
+	if update.SyncAggregate.participants() == 0 {
+		return nil, errInsufficientParticipation
+	}
+
+	if err := update.AttestedHeader.verify(); err != nil {
+		return nil, fmt.Errorf("invalid attested header: [%w]", err)
+	}
+
+	if err := update.FinalizedHeader.verify(); err != nil {
+		return nil, fmt.Errorf("invalid finalized header: [%w]", err)
+	}
+
+	attestedSlot := update.AttestedHeader.Beacon.Slot
+	finalizedSlot := update.FinalizedHeader.Beacon.Slot
+
+	if update.SignatureSlot <= attestedSlot || attestedSlot < finalizedSlot {
+		return nil, fmt.Errorf(
+			"invalid slots of the update; signature slot: %d, attested "+
+				"slot: %d, finalized slot: %d",
+			update.SignatureSlot,
+			attestedSlot,
+			finalizedSlot,
+		)
+	}
+
+	storePeriod := periodAtSlot(lcs.finalizedHeader.Beacon.Slot)
+	signaturePeriod := periodAtSlot(update.SignatureSlot)
+	attestedPeriod := periodAtSlot(attestedSlot)
+
+	hasNextSyncCommittee := update.NextSyncCommittee != nil
+
+	if attestedSlot <= lcs.finalizedHeader.Beacon.Slot &&
+		!(lcs.nextSyncCommittee == nil && hasNextSyncCommittee &&
+			attestedPeriod == storePeriod) {
+		return nil, fmt.Errorf(
+			"%w; attested at slot %d",
+			errIrrelevantUpdate,
+			attestedSlot,
+		)
+	}
+
+	if signaturePeriod != storePeriod &&
+		(lcs.nextSyncCommittee == nil || signaturePeriod != storePeriod+1) {
+		return nil, fmt.Errorf(
+			"update signed in period %d cannot be verified in period %d",
+			signaturePeriod,
+			storePeriod,
+		)
+	}
+
+	fork := forkName(lcs.config, attestedSlot)
+
+	err := verifyBranch(
+		update.AttestedHeader.Beacon.StateRoot,
+		params.StateIndexFinalBlock(fork),
+		update.FinalityBranch,
+		update.FinalizedHeader.Beacon.HashTreeRoot(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid finality branch: [%w]", err)
+	}
+
+	var nextSyncCommittee *verifiedSyncCommittee
+	if hasNextSyncCommittee {
+		nextSyncCommittee, err = newVerifiedSyncCommittee(update.NextSyncCommittee)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next sync committee: [%w]", err)
+		}
+
+		if attestedPeriod == storePeriod &&
+			lcs.nextSyncCommittee != nil &&
+			lcs.nextSyncCommittee.root != nextSyncCommittee.root {
+			return nil, fmt.Errorf(
+				"next sync committee does not match the known one",
+			)
+		}
+
+		err = verifyBranch(
+			update.AttestedHeader.Beacon.StateRoot,
+			params.StateIndexNextSyncCommittee(fork),
+			update.NextSyncCommitteeBranch,
+			nextSyncCommittee.root,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next sync committee branch: [%w]", err)
+		}
+	}
+
+	committee := lcs.currentSyncCommittee
+	if signaturePeriod != storePeriod {
+		committee = lcs.nextSyncCommittee
+	}
+
+	if err := lcs.verifySignature(update, committee); err != nil {
+		return nil, err
+	}
+
+	return nextSyncCommittee, nil
+}
+
+// verifySignature verifies the aggregate signature of the attested header of
+// the given update made by the participating members of the given sync
+// committee.
+func (lcs *lightClientStore) verifySignature(
+	update *LightClientUpdate,
+	committee *verifiedSyncCommittee,
+) error {
+	pubkeys := make([]*blst.P1Affine, 0, params.SyncCommitteeSize)
+	for i, pubkey := range committee.pubkeys {
+		if update.SyncAggregate.participated(i) {
+			pubkeys = append(pubkeys, pubkey)
+		}
+	}
+
+	// The signature is made with the fork version active at the slot
+	// preceding the signature slot.
+	forkVersionSlot := max(update.SignatureSlot, 1) - 1
+
+	signingRoot, err := lcs.config.Forks.SigningRoot(
+		epochAtSlot(forkVersionSlot),
+		update.AttestedHeader.Beacon.HashTreeRoot(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to compute signing root: [%w]", err)
+	}
+
+	signature := new(blst.P2Affine).Uncompress(update.SyncAggregate.SyncCommitteeSignature)
+	if signature == nil {
+		return fmt.Errorf("invalid sync committee signature")
+	}
+
+	if !signature.FastAggregateVerify(true, pubkeys, signingRoot[:], signatureDST) {
+		return fmt.Errorf("sync committee signature verification failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package lightclient
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/beacon/merkle"
+	"github.com/ethereum/go-ethereum/beacon/params"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// maxExtraDataBytes is the maximum length of the extra data of an execution
+// payload.
+const maxExtraDataBytes = 32
+
+// BeaconBlockHeader is the header of a beacon chain block.
+type BeaconBlockHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Hash `json:"parent_root"`
+	StateRoot     common.Hash `json:"state_root"`
+	BodyRoot      common.Hash `json:"body_root"`
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the header, i.e. the root
+// of the beacon chain block.
+func (bbh *BeaconBlockHeader) HashTreeRoot() common.Hash {
+	return common.Hash(merkleize(
+		[]merkle.Value{
+			uint64Chunk(bbh.Slot),
+			uint64Chunk(bbh.ProposerIndex),
+			merkle.Value(bbh.ParentRoot),
+			merkle.Value(bbh.StateRoot),
+			merkle.Value(bbh.BodyRoot),
+		},
+		8,
+	))
+}
+
+// ExecutionPayloadHeader is the header of the execution payload of a beacon
+// chain block, as defined since the Deneb fork.
+type ExecutionPayloadHeader struct {
+	ParentHash       common.Hash    `json:"parent_hash"`
+	FeeRecipient     common.Address `json:"fee_recipient"`
+	StateRoot        common.Hash    `json:"state_root"`
+	ReceiptsRoot     common.Hash    `json:"receipts_root"`
+	LogsBloom        types.Bloom    `json:"logs_bloom"`
+	PrevRandao       common.Hash    `json:"prev_randao"`
+	BlockNumber      uint64         `json:"block_number,string"`
+	GasLimit         uint64         `json:"gas_limit,string"`
+	GasUsed          uint64         `json:"gas_used,string"`
+	Timestamp        uint64         `json:"timestamp,string"`
+	ExtraData        hexutil.Bytes  `json:"extra_data"`
+	BaseFeePerGas    *uint256.Int   `json:"base_fee_per_gas"`
+	BlockHash        common.Hash    `json:"block_hash"`
+	TransactionsRoot common.Hash    `json:"transactions_root"`
+	WithdrawalsRoot  common.Hash    `json:"withdrawals_root"`
+	BlobGasUsed      uint64         `json:"blob_gas_used,string"`
+	ExcessBlobGas    uint64         `json:"excess_blob_gas,string"`
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the execution payload
+// header.
+func (eph *ExecutionPayloadHeader) HashTreeRoot() (common.Hash, error) {
+	if len(eph.ExtraData) > maxExtraDataBytes {
+		return common.Hash{}, fmt.Errorf(
+			"extra data length %d exceeds the maximum of %d bytes",
+			len(eph.ExtraData),
+			maxExtraDataBytes,
+		)
+	}
+
+	if eph.BaseFeePerGas == nil {
+		return common.Hash{}, fmt.Errorf("base fee per gas is missing")
+	}
+
+	var baseFeePerGas merkle.Value
+	baseFeeBytes := eph.BaseFeePerGas.Bytes32()
+	for i := range baseFeeBytes {
+		// SSZ encodes uint256 in the little-endian order.
+		baseFeePerGas[i] = baseFeeBytes[len(baseFeeBytes)-1-i]
+	}
+
+	var feeRecipient merkle.Value
+	copy(feeRecipient[:], eph.FeeRecipient.Bytes())
+
+	return common.Hash(merkleize(
+		[]merkle.Value{
+			merkle.Value(eph.ParentHash),
+			feeRecipient,
+			merkle.Value(eph.StateRoot),
+			merkle.Value(eph.ReceiptsRoot),
+			merkleize(bytesChunks(eph.LogsBloom.Bytes()), types.BloomByteLength/32),
+			merkle.Value(eph.PrevRandao),
+			uint64Chunk(eph.BlockNumber),
+			uint64Chunk(eph.GasLimit),
+			uint64Chunk(eph.GasUsed),
+			uint64Chunk(eph.Timestamp),
+			mixInLength(
+				merkleize(bytesChunks(eph.ExtraData), maxExtraDataBytes/32),
+				uint64(len(eph.ExtraData)),
+			),
+			baseFeePerGas,
+			merkle.Value(eph.BlockHash),
+			merkle.Value(eph.TransactionsRoot),
+			merkle.Value(eph.WithdrawalsRoot),
+			uint64Chunk(eph.BlobGasUsed),
+			uint64Chunk(eph.ExcessBlobGas),
+		},
+		32,
+	)), nil
+}
+
+// LightClientHeader is a beacon chain block header along with the header of
+// its execution payload and the Merkle proof of the execution payload header
+// against the beacon block body.
+type LightClientHeader struct {
+	Beacon          BeaconBlockHeader      `json:"beacon"`
+	Execution       ExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []common.Hash          `json:"execution_branch"`
+}
+
+// verify checks that the execution payload header is the one committed to
+// in the beacon block body.
+func (lch *LightClientHeader) verify() error {
+	executionRoot, err := lch.Execution.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("invalid execution payload header: [%w]", err)
+	}
+
+	err = verifyBranch(
+		lch.Beacon.BodyRoot,
+		params.BodyIndexExecPayload,
+		lch.ExecutionBranch,
+		executionRoot,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid execution branch: [%w]", err)
+	}
+
+	return nil
+}
+
+// SyncCommittee is the committee of validators signing beacon chain blocks
+// during a sync committee period.
+type SyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+// validate checks the size of the sync committee and its keys.
+func (sc *SyncCommittee) validate() error {
+	if len(sc.Pubkeys) != params.SyncCommitteeSize {
+		return fmt.Errorf(
+			"sync committee has %d public keys; expected %d",
+			len(sc.Pubkeys),
+			params.SyncCommitteeSize,
+		)
+	}
+
+	for i, pubkey := range sc.Pubkeys {
+		if len(pubkey) != params.BLSPubkeySize {
+			return fmt.Errorf("invalid length of public key %d: %d", i, len(pubkey))
+		}
+	}
+
+	if len(sc.AggregatePubkey) != params.BLSPubkeySize {
+		return fmt.Errorf(
+			"invalid length of aggregate public key: %d",
+			len(sc.AggregatePubkey),
+		)
+	}
+
+	return nil
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee. The
+// committee must be valid.
+func (sc *SyncCommittee) HashTreeRoot() common.Hash {
+	pubkeyRoots := make([]merkle.Value, len(sc.Pubkeys))
+	for i, pubkey := range sc.Pubkeys {
+		pubkeyRoots[i] = merkleize(bytesChunks(pubkey), 2)
+	}
+
+	return common.Hash(hashPair(
+		merkleize(pubkeyRoots, params.SyncCommitteeSize),
+		merkleize(bytesChunks(sc.AggregatePubkey), 2),
+	))
+}
+
+// SyncAggregate is the aggregate signature of the sync committee members
+// that signed a beacon chain block.
+type SyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+// validate checks the size of the participation bits and the signature.
+func (sa *SyncAggregate) validate() error {
+	if len(sa.SyncCommitteeBits) != params.SyncCommitteeBitmaskSize {
+		return fmt.Errorf(
+			"invalid length of sync committee bits: %d",
+			len(sa.SyncCommitteeBits),
+		)
+	}
+
+	if len(sa.SyncCommitteeSignature) != params.BLSSignatureSize {
+		return fmt.Errorf(
+			"invalid length of sync committee signature: %d",
+			len(sa.SyncCommitteeSignature),
+		)
+	}
+
+	return nil
+}
+
+// participated returns true if the sync committee member with the given
+// index signed the block.
+func (sa *SyncAggregate) participated(index int) bool {
+	return sa.SyncCommitteeBits[index/8]&(1<<(index%8)) != 0
+}
+
+// participants returns the number of sync committee members that signed the
+// block.
+func (sa *SyncAggregate) participants() int {
+	count := 0
+	for i := 0; i < params.SyncCommitteeSize; i++ {
+		if sa.participated(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// LightClientBootstrap is the initial state of the light client at a trusted
+// beacon chain block.
+type LightClientBootstrap struct {
+	Header                     LightClientHeader `json:"header"`
+	CurrentSyncCommittee       SyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []common.Hash     `json:"current_sync_committee_branch"`
+}
+
+// LightClientUpdate is a beacon chain header attested by the sync committee
+// along with the finalized header and, optionally, the next sync committee
+// proven against the attested header. Finality updates served by beacon
+// nodes are light client updates without the next sync committee.
+type LightClientUpdate struct {
+	AttestedHeader          LightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee    `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []common.Hash     `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         LightClientHeader `json:"finalized_header"`
+	FinalityBranch          []common.Hash     `json:"finality_branch"`
+	SyncAggregate           SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64            `json:"signature_slot,string"`
+}
+
+// verifyBranch verifies the Merkle proof of the given leaf at the given
+// generalized index against the given root.
+func verifyBranch(
+	root common.Hash,
+	index uint64,
+	branch []common.Hash,
+	leaf common.Hash,
+) error {
+	values := make(merkle.Values, len(branch))
+	for i, node := range branch {
+		values[i] = merkle.Value(node)
+	}
+
+	return merkle.VerifyProof(root, index, values, merkle.Value(leaf))
+}
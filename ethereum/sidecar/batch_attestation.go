@@ -2,10 +2,10 @@ package sidecar
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"cosmossdk.io/log"
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	ethconnect "github.com/mezo-org/mezod/ethereum"
 	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
 )
 
 var (
@@ -32,22 +33,27 @@ type BridgeWorker interface {
 
 type batchAttestation struct {
 	logger         log.Logger
-	privateKey     *ecdsa.PrivateKey
+	signer         Signer
 	bridgeWorker   BridgeWorker
 	bridgeContract ethconnect.BridgeContract
 	chainID        *big.Int
+
+	// Outcome of the last attempt to send a signature to the bridge worker.
+	bridgeWorkerMutex     sync.Mutex
+	bridgeWorkerContacted bool
+	bridgeWorkerLastErr   error
 }
 
 func newBatchAttestation(
 	logger log.Logger,
-	privateKey *ecdsa.PrivateKey,
+	signer Signer,
 	bridgeWorker BridgeWorker,
 	bridgeContract ethconnect.BridgeContract,
 	chainID *big.Int,
 ) *batchAttestation {
 	return &batchAttestation{
 		logger:         logger,
-		privateKey:     privateKey,
+		signer:         signer,
 		bridgeWorker:   bridgeWorker,
 		bridgeContract: bridgeContract,
 		chainID:        chainID,
@@ -96,11 +102,11 @@ func (ba *batchAttestation) sendPayload(
 	ctx context.Context,
 	attestation *portal.MezoBridgeAssetsUnlocked,
 ) error {
-	signature, err := ba.signPayload(attestation)
+	signature, err := ba.signPayload(ctx, attestation)
 	if err != nil {
-		// we panic here, there's no reason other than a bug or misconfiguration
-		// for not being able to sign the payload here, so let just exit early
-		panic(fmt.Sprintf("unable to sign batch attestation payload: %v", err))
+		// a remote signer may be temporarily unavailable so we let the
+		// caller fall back to the individual attestation process
+		return fmt.Errorf("unable to sign batch attestation payload: %w", err)
 	}
 
 	// we operate this in a loop just to handle retries in case
@@ -115,6 +121,7 @@ func (ba *batchAttestation) sendPayload(
 		select {
 		case <-retryTicker.C:
 			err := ba.bridgeWorker.SendSignature(attestation, signature)
+			ba.recordBridgeWorkerResult(err)
 			if err != nil {
 				ba.logger.Warn(
 					"failed to send attestation signature to the bridge worker",
@@ -130,6 +137,37 @@ func (ba *batchAttestation) sendPayload(
 	}
 }
 
+func (ba *batchAttestation) recordBridgeWorkerResult(err error) {
+	ba.bridgeWorkerMutex.Lock()
+	defer ba.bridgeWorkerMutex.Unlock()
+
+	ba.bridgeWorkerContacted = true
+	ba.bridgeWorkerLastErr = err
+}
+
+// bridgeWorkerStatus returns the reachability of the bridge worker based on
+// the last attempt to send a signature to it. The bridge worker is not
+// considered reachable until the first successful attempt.
+func (ba *batchAttestation) bridgeWorkerStatus() pb.BridgeWorkerStatus {
+	ba.bridgeWorkerMutex.Lock()
+	defer ba.bridgeWorkerMutex.Unlock()
+
+	status := pb.BridgeWorkerStatus{
+		Configured: ba.bridgeWorker != nil,
+	}
+
+	if !status.Configured {
+		return status
+	}
+
+	status.Reachable = ba.bridgeWorkerContacted && ba.bridgeWorkerLastErr == nil
+	if ba.bridgeWorkerLastErr != nil {
+		status.LastError = ba.bridgeWorkerLastErr.Error()
+	}
+
+	return status
+}
+
 func attestationDigestHash(attestation *portal.MezoBridgeAssetsUnlocked, chainID *big.Int) ([]byte, error) {
 	abiEncoded, err := abiEncodeAttestationWithChainID(attestation, chainID)
 	if err != nil {
@@ -141,13 +179,16 @@ func attestationDigestHash(attestation *portal.MezoBridgeAssetsUnlocked, chainID
 	return accounts.TextHash(digest), nil
 }
 
-func (ba *batchAttestation) signPayload(attestation *portal.MezoBridgeAssetsUnlocked) (string, error) {
+func (ba *batchAttestation) signPayload(
+	ctx context.Context,
+	attestation *portal.MezoBridgeAssetsUnlocked,
+) (string, error) {
 	digestHash, err := attestationDigestHash(attestation, ba.chainID)
 	if err != nil {
 		return "", err
 	}
 
-	signature, err := crypto.Sign(digestHash, ba.privateKey)
+	signature, err := ba.signer.SignDigest(ctx, digestHash)
 	if err != nil {
 		return "", err
 	}
@@ -42,7 +42,7 @@ func newTestBatchAttestation(t *testing.T) *testBatchAttestation {
 
 	ba := newBatchAttestation(
 		log.NewNopLogger(),
-		privateKey,
+		NewLocalSigner(privateKey),
 		mockBridgeWorker,
 		mockBridgeContract,
 		big.NewInt(1),
@@ -222,6 +222,30 @@ func TestBatchAttestation_TryAttest(t *testing.T) {
 	}
 }
 
+func TestBatchAttestation_bridgeWorkerStatus(t *testing.T) {
+	tba := newTestBatchAttestation(t)
+
+	status := tba.bridgeWorkerStatus()
+	assert.True(t, status.Configured)
+	assert.False(t, status.Reachable)
+	assert.Empty(t, status.LastError)
+
+	tba.recordBridgeWorkerResult(errors.New("connection refused"))
+
+	status = tba.bridgeWorkerStatus()
+	assert.False(t, status.Reachable)
+	assert.Equal(t, "connection refused", status.LastError)
+
+	tba.recordBridgeWorkerResult(nil)
+
+	status = tba.bridgeWorkerStatus()
+	assert.True(t, status.Reachable)
+	assert.Empty(t, status.LastError)
+
+	notConfigured := newBatchAttestation(log.NewNopLogger(), nil, nil, nil, nil)
+	assert.False(t, notConfigured.bridgeWorkerStatus().Configured)
+}
+
 func TestAttestationDigestHash(t *testing.T) {
 	attestation := &portal.MezoBridgeAssetsUnlocked{
 		UnlockSequenceNumber: big.NewInt(10),
@@ -0,0 +1,96 @@
+package sidecar
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/mezo-org/mezod/bridge-worker/api"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+// bridgeWorkerRequestTimeout is the timeout of a single request sent to the
+// bridge worker.
+const bridgeWorkerRequestTimeout = 10 * time.Second
+
+// BridgeWorkerClient is a BridgeWorker sending attestation signatures to the
+// signature intake endpoint of the bridge worker over HTTP.
+type BridgeWorkerClient struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewBridgeWorkerClient creates a client of the bridge worker available at
+// the given base URL (e.g. http://127.0.0.1:8080).
+func NewBridgeWorkerClient(baseURL string) (*BridgeWorkerClient, error) {
+	parsedURL, err := url.ParseRequestURI(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bridge worker URL: %w", err)
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf(
+			"unsupported bridge worker URL scheme: %s",
+			parsedURL.Scheme,
+		)
+	}
+
+	return &BridgeWorkerClient{
+		endpoint:   strings.TrimSuffix(baseURL, "/") + api.SubmitSignaturePath,
+		httpClient: &http.Client{Timeout: bridgeWorkerRequestTimeout},
+	}, nil
+}
+
+// SendSignature sends the hex-encoded signature of the given attestation to
+// the bridge worker. An error is returned if the bridge worker rejects it.
+func (bwc *BridgeWorkerClient) SendSignature(
+	attestation *portal.MezoBridgeAssetsUnlocked,
+	signature string,
+) error {
+	signatureBytes, err := hexutil.Decode(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	body, err := json.Marshal(api.SubmitSignatureRequest{
+		Entry:     api.NewAssetsUnlockedEntry(attestation),
+		Signature: signatureBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	response, err := bwc.httpClient.Post(
+		bwc.endpoint,
+		"application/json",
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	}
+
+	var errorResponse api.ErrorResponse
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+	if err := json.Unmarshal(responseBody, &errorResponse); err != nil ||
+		errorResponse.Error == "" {
+		errorResponse.Error = strings.TrimSpace(string(responseBody))
+	}
+
+	return fmt.Errorf(
+		"bridge worker rejected signature with status %d: %s",
+		response.StatusCode,
+		errorResponse.Error,
+	)
+}
@@ -0,0 +1,87 @@
+package sidecar
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mezo-org/mezod/bridge-worker/api"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+func TestBridgeWorkerClient_SendSignature(t *testing.T) {
+	attestation := &portal.MezoBridgeAssetsUnlocked{
+		UnlockSequenceNumber: big.NewInt(42),
+		Recipient:            common.FromHex("0x00140102030405060708090a0b0c0d0e0f1011121314"),
+		Token:                common.HexToAddress("0x517f2982701695D4E52f1ECFBEf3ba31Df470161"),
+		Amount:               big.NewInt(1000000000000000000),
+		Chain:                1,
+	}
+	signature := hexutil.Encode(make([]byte, 65))
+
+	tests := map[string]struct {
+		statusCode  int
+		response    any
+		expectedErr string
+	}{
+		"accepted": {
+			statusCode: http.StatusAccepted,
+		},
+		"rejected with error response": {
+			statusCode:  http.StatusForbidden,
+			response:    api.ErrorResponse{Error: "signer is not a bridge validator"},
+			expectedErr: "bridge worker rejected signature with status 403: signer is not a bridge validator",
+		},
+		"rejected without error response": {
+			statusCode:  http.StatusBadGateway,
+			expectedErr: "bridge worker rejected signature with status 502",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			var received api.SubmitSignatureRequest
+
+			server := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, http.MethodPost, r.Method)
+					require.Equal(t, api.SubmitSignaturePath, r.URL.Path)
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+					w.WriteHeader(test.statusCode)
+					if test.response != nil {
+						require.NoError(t, json.NewEncoder(w).Encode(test.response))
+					}
+				},
+			))
+			t.Cleanup(server.Close)
+
+			client, err := NewBridgeWorkerClient(server.URL + "/")
+			require.NoError(t, err)
+
+			err = client.SendSignature(attestation, signature)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, *attestation, received.Entry.ToPortal())
+			require.Equal(t, signature, received.Signature.String())
+		})
+	}
+}
+
+func TestNewBridgeWorkerClient_InvalidURL(t *testing.T) {
+	_, err := NewBridgeWorkerClient("127.0.0.1:8080")
+	require.Error(t, err)
+
+	_, err = NewBridgeWorkerClient("grpc://127.0.0.1:8080")
+	require.ErrorContains(t, err, "unsupported bridge worker URL scheme: grpc")
+}
@@ -1,10 +1,12 @@
 package cli
 
 import (
-	"crypto/ecdsa"
 	"fmt"
+	"math/big"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 
 	"google.golang.org/grpc/encoding"
 
@@ -22,6 +24,8 @@ import (
 func NewEthereumSidecarCmd() *cobra.Command {
 	defaultServerAddress := "0.0.0.0:7500"
 	defaultServerEthereumNodeAddress := "ws://127.0.0.1:8546"
+	defaultServerAdditionalNodeAddrs := []string{}
+	defaultServerEthereumQuorum := uint(0) // majority of nodes
 	defaultServerEthereumNetwork := ethconfig.Sepolia
 	defaultServerBatchSize := uint64(1000)
 	// Default requests per minute. A 'minute' unit was chosen so that a
@@ -34,6 +38,19 @@ func NewEthereumSidecarCmd() *cobra.Command {
 	// this example.
 	defaultServerRequestsPerMinute := uint64(600) // 10 requests per second
 	defaultServerAssetsUnlockedEndpoint := "127.0.0.1:9090"
+	defaultServerDataDir := ""
+	defaultServerMetricsAddress := ""
+	defaultServerBeaconNodeAddress := ""
+	defaultServerBeaconCheckpoint := ""
+	defaultServerHealthAddress := ""
+	defaultServerRemoteSignerAddress := ""
+	defaultServerRemoteSignerKeyID := ""
+	defaultServerRemoteSignerTLSCAFile := ""
+	defaultServerMaxFeeCap := uint64(500)    // gwei
+	defaultServerMaxPriorityFee := uint64(5) // gwei
+	defaultServerPriorityFeePercentile := 50.0
+	defaultServerReplacementBlocks := uint64(5)
+	defaultServerBridgeWorkerURL := ""
 	defaultKeyringBackend := flags.DefaultKeyringBackend
 	defaultKeyringDir := ""
 	defaultKeyName := ""
@@ -50,15 +67,32 @@ func NewEthereumSidecarCmd() *cobra.Command {
 		NewFlagSetEthereumSidecar(
 			defaultServerAddress,
 			defaultServerEthereumNodeAddress,
+			defaultServerAdditionalNodeAddrs,
+			defaultServerEthereumQuorum,
 			defaultServerEthereumNetwork.String(),
 			defaultServerBatchSize,
 			defaultServerRequestsPerMinute,
 			defaultServerAssetsUnlockedEndpoint,
+			defaultServerDataDir,
+			defaultServerMetricsAddress,
+			defaultServerBeaconNodeAddress,
+			defaultServerBeaconCheckpoint,
+			defaultServerHealthAddress,
+			defaultServerRemoteSignerAddress,
+			defaultServerRemoteSignerKeyID,
+			defaultServerRemoteSignerTLSCAFile,
+			defaultServerMaxFeeCap,
+			defaultServerMaxPriorityFee,
+			defaultServerPriorityFeePercentile,
+			defaultServerReplacementBlocks,
+			defaultServerBridgeWorkerURL,
 			defaultKeyringBackend,
 			defaultKeyringDir,
 			defaultKeyName,
 		))
 
+	cmd.AddCommand(NewEthereumSidecarStatusCmd())
+
 	return cmd
 }
 
@@ -70,10 +104,25 @@ func runEthereumSidecar(cmd *cobra.Command, _ []string) error {
 
 	grpcAddress, _ := cmd.Flags().GetString(FlagServerAddress)
 	ethNodeAddress, _ := cmd.Flags().GetString(FlagServerEthereumNodeAddress)
+	additionalEthNodeAddresses, _ := cmd.Flags().GetStringSlice(FlagServerAdditionalNodeAddrs)
+	ethQuorum, _ := cmd.Flags().GetUint(FlagServerEthereumQuorum)
 	network, _ := cmd.Flags().GetString(FlagServerNetwork)
 	batchSize, _ := cmd.Flags().GetUint64(FlagServerBatchSize)
 	requestsPerMinute, _ := cmd.Flags().GetUint64(FlagServerRequestsPerMinute)
 	assetsUnlockedEndpoint, _ := cmd.Flags().GetString(FlagServerAssetsUnlockedEndpoint)
+	dataDir, _ := cmd.Flags().GetString(FlagServerDataDir)
+	metricsAddress, _ := cmd.Flags().GetString(FlagServerMetricsAddress)
+	beaconNodeAddress, _ := cmd.Flags().GetString(FlagServerBeaconNodeAddress)
+	beaconCheckpoint, _ := cmd.Flags().GetString(FlagServerBeaconCheckpoint)
+	healthAddress, _ := cmd.Flags().GetString(FlagServerHealthAddress)
+	remoteSignerAddress, _ := cmd.Flags().GetString(FlagServerRemoteSignerAddress)
+	remoteSignerKeyID, _ := cmd.Flags().GetString(FlagServerRemoteSignerKeyID)
+	remoteSignerTLSCAFile, _ := cmd.Flags().GetString(FlagServerRemoteSignerTLSCAFile)
+	maxFeeCap, _ := cmd.Flags().GetUint64(FlagServerMaxFeeCap)
+	maxPriorityFee, _ := cmd.Flags().GetUint64(FlagServerMaxPriorityFee)
+	priorityFeePercentile, _ := cmd.Flags().GetFloat64(FlagServerPriorityFeePercentile)
+	replacementBlocks, _ := cmd.Flags().GetUint64(FlagServerReplacementBlocks)
+	bridgeWorkerURL, _ := cmd.Flags().GetString(FlagServerBridgeWorkerURL)
 	keyName, _ := cmd.Flags().GetString(FlagKeyName)
 
 	clientCtx, err := client.GetClientQueryContext(cmd)
@@ -81,16 +130,42 @@ func runEthereumSidecar(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if dataDir == "" {
+		dataDir = filepath.Join(clientCtx.HomeDir, "data")
+	}
+
 	// The messages handled by the server contain custom types. Add codecs so
 	// that the messages can be marshaled/unmarshalled.
 	encoding.RegisterCodec(
 		codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec(),
 	)
 
-	// Extract private key from keyring if key name is provided
-	var privateKey *ecdsa.PrivateKey
-	if keyName != "" {
-		privateKey, err = clientkeys.ExtractPrivateKey(cmd, keyName)
+	var signer sidecar.Signer
+	switch {
+	case remoteSignerAddress != "":
+		// Use the remote signer so the key never sits on the sidecar host.
+		remoteSigner, err := sidecar.NewRemoteSigner(
+			cmd.Context(),
+			remoteSignerAddress,
+			remoteSignerKeyID,
+			remoteSignerTLSCAFile,
+			clientCtx.InterfaceRegistry,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to connect to remote signer: %w", err)
+		}
+		defer remoteSigner.Close()
+
+		logger.Info(
+			"successfully connected to remote signer",
+			"keyID", remoteSignerKeyID,
+			"address", remoteSigner.Address().Hex(),
+		)
+
+		signer = remoteSigner
+	case keyName != "":
+		// Extract private key from keyring if key name is provided
+		privateKey, err := clientkeys.ExtractPrivateKey(cmd, keyName)
 		if err != nil {
 			return fmt.Errorf("failed to extract private key: %w", err)
 		}
@@ -99,26 +174,52 @@ func runEthereumSidecar(cmd *cobra.Command, _ []string) error {
 			"keyName", keyName,
 			"address", crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
 		)
-	} else {
-		// If the key name is not provided, generate a random key. This key
-		// won't be used by the sidecar for signing but is needed to be present.
-		privateKey, err = crypto.GenerateKey()
+
+		signer = sidecar.NewLocalSigner(privateKey)
+	default:
+		// If neither the remote signer nor the key name is provided, generate
+		// a random key. This key won't be used by the sidecar for signing but
+		// is needed to be present.
+		privateKey, err := crypto.GenerateKey()
 		if err != nil {
 			return fmt.Errorf("failed to generate private key: %w", err)
 		}
+
+		signer = sidecar.NewLocalSigner(privateKey)
 	}
 
 	sidecar.RunServer(
 		logger,
 		grpcAddress,
 		ethNodeAddress,
+		additionalEthNodeAddresses,
+		ethQuorum,
 		network,
 		batchSize,
 		requestsPerMinute,
 		assetsUnlockedEndpoint,
 		clientCtx.InterfaceRegistry,
-		privateKey,
+		signer,
+		dataDir,
+		metricsAddress,
+		beaconNodeAddress,
+		beaconCheckpoint,
+		healthAddress,
+		sidecar.FeePolicy{
+			MaxFeeCap:             gweiToWei(maxFeeCap),
+			MaxPriorityFee:        gweiToWei(maxPriorityFee),
+			PriorityFeePercentile: priorityFeePercentile,
+			ReplacementBlocks:     replacementBlocks,
+		},
+		bridgeWorkerURL,
 	)
 
 	return nil
 }
+
+func gweiToWei(gwei uint64) *big.Int {
+	return new(big.Int).Mul(
+		new(big.Int).SetUint64(gwei),
+		big.NewInt(params.GWei),
+	)
+}
@@ -9,10 +9,25 @@ import (
 const (
 	FlagServerAddress                = "ethereum-sidecar.server.address"
 	FlagServerEthereumNodeAddress    = "ethereum-sidecar.server.ethereum-node-address"
+	FlagServerAdditionalNodeAddrs    = "ethereum-sidecar.server.additional-ethereum-node-addresses"
+	FlagServerEthereumQuorum         = "ethereum-sidecar.server.ethereum-quorum"
 	FlagServerNetwork                = "ethereum-sidecar.server.network"
 	FlagServerBatchSize              = "ethereum-sidecar.server.batch-size"
 	FlagServerRequestsPerMinute      = "ethereum-sidecar.server.requests-per-minute"
 	FlagServerAssetsUnlockedEndpoint = "ethereum-sidecar.server.assets-unlocked-endpoint"
+	FlagServerDataDir                = "ethereum-sidecar.server.data-dir"
+	FlagServerMetricsAddress         = "ethereum-sidecar.server.metrics-address"
+	FlagServerBeaconNodeAddress      = "ethereum-sidecar.server.beacon-node-address"
+	FlagServerBeaconCheckpoint       = "ethereum-sidecar.server.beacon-checkpoint"
+	FlagServerHealthAddress          = "ethereum-sidecar.server.health-address"
+	FlagServerRemoteSignerAddress    = "ethereum-sidecar.server.remote-signer-address"
+	FlagServerRemoteSignerKeyID      = "ethereum-sidecar.server.remote-signer-key-id"
+	FlagServerRemoteSignerTLSCAFile  = "ethereum-sidecar.server.remote-signer-tls-ca-file"
+	FlagServerMaxFeeCap              = "ethereum-sidecar.server.max-fee-cap-gwei"
+	FlagServerMaxPriorityFee         = "ethereum-sidecar.server.max-priority-fee-gwei"
+	FlagServerPriorityFeePercentile  = "ethereum-sidecar.server.priority-fee-percentile"
+	FlagServerReplacementBlocks      = "ethereum-sidecar.server.replacement-blocks"
+	FlagServerBridgeWorkerURL        = "ethereum-sidecar.server.bridge-worker-url"
 	FlagKeyringBackend               = "keyring-backend"
 	FlagKeyringDir                   = "keyring-dir"
 	FlagKeyName                      = "key-name"
@@ -20,11 +35,26 @@ const (
 
 func NewFlagSetEthereumSidecar(
 	defaultServerAddress,
-	defaultServerEthereumNodeAddress,
+	defaultServerEthereumNodeAddress string,
+	defaultServerAdditionalNodeAddrs []string,
+	defaultServerEthereumQuorum uint,
 	defaultServerNetwork string,
 	defaultServerBatchSize uint64,
 	defaultServerRequestsPerMinute uint64,
 	defaultServerAssetsUnlockedEndpoint string,
+	defaultServerDataDir string,
+	defaultServerMetricsAddress string,
+	defaultServerBeaconNodeAddress string,
+	defaultServerBeaconCheckpoint string,
+	defaultServerHealthAddress string,
+	defaultServerRemoteSignerAddress string,
+	defaultServerRemoteSignerKeyID string,
+	defaultServerRemoteSignerTLSCAFile string,
+	defaultServerMaxFeeCap uint64,
+	defaultServerMaxPriorityFee uint64,
+	defaultServerPriorityFeePercentile float64,
+	defaultServerReplacementBlocks uint64,
+	defaultServerBridgeWorkerURL string,
 	defaultKeyringBackend,
 	defaultKeyringDir,
 	defaultKeyName string,
@@ -47,6 +77,21 @@ func NewFlagSetEthereumSidecar(
 			defaultServerEthereumNodeAddress,
 		),
 	)
+	fs.StringSlice(
+		FlagServerAdditionalNodeAddrs,
+		defaultServerAdditionalNodeAddrs,
+		"Comma-separated addresses of additional Ethereum nodes queried "+
+			"along with the main node; finalized blocks, AssetsLocked events "+
+			"and unlock confirmations are accepted only if enough nodes "+
+			"return identical results",
+	)
+	fs.Uint(
+		FlagServerEthereumQuorum,
+		defaultServerEthereumQuorum,
+		"The number of Ethereum nodes, including the main one, that must "+
+			"return identical results for them to be accepted; "+
+			"if 0, a majority of nodes is required",
+	)
 	fs.String(
 		FlagServerNetwork,
 		defaultServerNetwork,
@@ -76,6 +121,109 @@ func NewFlagSetEthereumSidecar(
 			"events emitted on the Mezo chain",
 	)
 
+	fs.String(
+		FlagServerDataDir,
+		defaultServerDataDir,
+		"Directory of the sidecar database persisting fetched events and "+
+			"attestation state between restarts; if omitted, the 'data' "+
+			"directory within the 'home' directory will be used",
+	)
+
+	fs.String(
+		FlagServerMetricsAddress,
+		defaultServerMetricsAddress,
+		"The listen address of the Prometheus metrics endpoint exposing "+
+			"Ethereum node health (e.g. 0.0.0.0:7501); if omitted, metrics "+
+			"are not exposed",
+	)
+
+	fs.String(
+		FlagServerBeaconNodeAddress,
+		defaultServerBeaconNodeAddress,
+		"The beacon node REST API address (e.g. http://127.0.0.1:5052) used "+
+			"by the Ethereum light client to verify finalized blocks and "+
+			"AssetsLocked events returned by the Ethereum nodes; if omitted, "+
+			"the light client is disabled and the Ethereum nodes are trusted",
+	)
+
+	fs.String(
+		FlagServerBeaconCheckpoint,
+		defaultServerBeaconCheckpoint,
+		"The root of a trusted finalized beacon chain block the Ethereum "+
+			"light client is bootstrapped from; used only if no checkpoint "+
+			"was persisted by a previous run or the persisted one is no "+
+			"longer served by the beacon node",
+	)
+
+	fs.String(
+		FlagServerHealthAddress,
+		defaultServerHealthAddress,
+		"The listen address of the HTTP endpoint serving the /healthz "+
+			"liveness and /readyz readiness probes (e.g. 0.0.0.0:7502); "+
+			"if omitted, the probes are not exposed",
+	)
+
+	fs.String(
+		FlagServerRemoteSignerAddress,
+		defaultServerRemoteSignerAddress,
+		"The gRPC address of the remote signer service holding the bridge "+
+			"validator key (e.g. 127.0.0.1:7510); if set, attestations are "+
+			"signed by the remote signer and the key is not extracted from "+
+			"the keyring",
+	)
+
+	fs.String(
+		FlagServerRemoteSignerKeyID,
+		defaultServerRemoteSignerKeyID,
+		"The identifier of the bridge validator key in the remote signer service",
+	)
+
+	fs.String(
+		FlagServerRemoteSignerTLSCAFile,
+		defaultServerRemoteSignerTLSCAFile,
+		"The CA certificate file used to verify the remote signer service "+
+			"certificate; if omitted, the connection is not encrypted",
+	)
+
+	fs.Uint64(
+		FlagServerMaxFeeCap,
+		defaultServerMaxFeeCap,
+		"The maximum fee per gas in gwei, including the base fee, paid by "+
+			"attestation transactions; transactions not mined in time are "+
+			"never replaced with ones paying more",
+	)
+
+	fs.Uint64(
+		FlagServerMaxPriorityFee,
+		defaultServerMaxPriorityFee,
+		"The maximum priority fee per gas in gwei of new attestation transactions",
+	)
+
+	fs.Float64(
+		FlagServerPriorityFeePercentile,
+		defaultServerPriorityFeePercentile,
+		"The percentile of priority fees paid in the latest Ethereum blocks "+
+			"(according to eth_feeHistory) used as the priority fee of new "+
+			"attestation transactions",
+	)
+
+	fs.Uint64(
+		FlagServerReplacementBlocks,
+		defaultServerReplacementBlocks,
+		"The number of Ethereum blocks after which an attestation "+
+			"transaction that was not mined is replaced with one paying "+
+			"higher fees",
+	)
+
+	fs.String(
+		FlagServerBridgeWorkerURL,
+		defaultServerBridgeWorkerURL,
+		"The URL of the bridge worker signature intake endpoint (e.g. "+
+			"http://127.0.0.1:8080); if set, bridge validator signatures are "+
+			"sent to the bridge worker for batch attestation before falling "+
+			"back to individual attestation transactions",
+	)
+
 	fs.String(
 		FlagKeyringBackend,
 		defaultKeyringBackend,
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/mezo-org/mezod/ethereum/sidecar"
+	"github.com/mezo-org/mezod/server/config"
+	srvflags "github.com/mezo-org/mezod/server/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewEthereumSidecarStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Queries the status of a running Ethereum sidecar",
+		Long: "Queries the internal state of a running Ethereum sidecar: the " +
+			"last finalized Ethereum block, the cached AssetsLocked events, " +
+			"the AssetsUnlocked attestation queue and the bridge worker " +
+			"reachability",
+		Args: cobra.NoArgs,
+		RunE: runEthereumSidecarStatus,
+	}
+
+	cmd.Flags().String(
+		srvflags.EthereumSidecarServerAddress,
+		config.DefaultEthereumSidecarServerAddress,
+		"Address of the Ethereum sidecar server",
+	)
+	cmd.Flags().Duration(
+		srvflags.EthereumSidecarRequestTimeout,
+		config.DefaultEthereumSidecarRequestTimeout,
+		"Timeout for requests to the Ethereum sidecar server",
+	)
+	cmd.Flags().StringP(
+		flags.FlagOutput,
+		"o",
+		flags.OutputFormatText,
+		"Output format (text|json)",
+	)
+
+	return cmd
+}
+
+func runEthereumSidecarStatus(cmd *cobra.Command, _ []string) error {
+	serverAddress, _ := cmd.Flags().GetString(srvflags.EthereumSidecarServerAddress)
+	requestTimeout, _ := cmd.Flags().GetDuration(srvflags.EthereumSidecarRequestTimeout)
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	sidecarClient, err := sidecar.NewClient(
+		log.NewNopLogger(),
+		serverAddress,
+		requestTimeout,
+		clientCtx.InterfaceRegistry,
+	)
+	if err != nil {
+		return err
+	}
+	defer sidecarClient.Close()
+
+	status, err := sidecarClient.Status(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to query Ethereum sidecar status: %w", err)
+	}
+
+	return clientCtx.PrintProto(status)
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -32,12 +34,43 @@ var (
 	)
 )
 
+// subscriptionRetryDelay is the delay before the AssetsLocked events
+// subscription is re-established after a failure.
+var subscriptionRetryDelay = 5 * time.Second
+
 // Client connects to the Ethereum sidecar server and queries for the
 // `AssetsLocked` events.
+//
+// On the first query, the client subscribes to the `AssetsLocked` events
+// stream of the server. Once the subscription is established, the events
+// pushed by the server are kept in a local buffer and queries falling within
+// the buffered range are answered from it, without a round trip to the
+// server. Other queries, and all queries while the subscription is down,
+// fall back to the unary `AssetsLockedEvents` call.
 type Client struct {
+	logger         log.Logger
 	mutex          sync.Mutex
 	requestTimeout time.Duration
 	connection     *grpc.ClientConn
+
+	ctx       context.Context
+	cancelCtx context.CancelFunc
+
+	bufferMutex sync.Mutex
+	// subscribed is true once the subscription routine is started.
+	subscribed bool
+	// subscriptionStart is the sequence the subscription is (re)started
+	// from. It follows the sequence start of the latest query.
+	subscriptionStart sdkmath.Int
+	// bufferLive is true while the subscription is established. The buffer
+	// holds all events known to the server with the sequence not lower than
+	// bufferStart.
+	bufferLive  bool
+	bufferStart sdkmath.Int
+	buffer      []bridgetypes.AssetsLockedEvent
+	// lastStreamed is the sequence of the last event received from the
+	// subscription, including events already dropped from the buffer.
+	lastStreamed sdkmath.Int
 }
 
 func NewClient(
@@ -62,9 +95,14 @@ func NewClient(
 		)
 	}
 
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
 	c := &Client{
+		logger:         logger,
 		requestTimeout: requestTimeout,
 		connection:     connection,
+		ctx:            ctx,
+		cancelCtx:      cancelCtx,
 	}
 
 	go func() {
@@ -76,7 +114,7 @@ func NewClient(
 		)
 		defer cancel()
 
-		_, err := c.GetAssetsLockedEvents(
+		_, err := c.fetchAssetsLockedEvents(
 			ctxWithTimeout,
 			sdkmath.NewInt(1),
 			sdkmath.NewInt(2),
@@ -109,6 +147,20 @@ func (c *Client) Version(ctx context.Context) (string, error) {
 	return resp.Version, nil
 }
 
+// Status returns the internal state of the Ethereum sidecar server.
+func (c *Client) Status(ctx context.Context) (*pb.StatusResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+
+	sidecarClient := pb.NewEthereumSidecarClient(c.connection)
+	resp, err := sidecarClient.Status(ctx, &pb.StatusRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Status, %w", err)
+	}
+
+	return resp, nil
+}
+
 // GetAssetsLockedEvents returns confirmed AssetsLockedEvents with
 // the sequence number falling within the half-open range, denoted by
 // sequenceStart (included) and sequenceEnd (excluded). Nil can be
@@ -118,6 +170,30 @@ func (c *Client) GetAssetsLockedEvents(
 	ctx context.Context,
 	sequenceStart sdkmath.Int,
 	sequenceEnd sdkmath.Int,
+) ([]bridgetypes.AssetsLockedEvent, error) {
+	events, ok := c.bufferedAssetsLockedEvents(sequenceStart, sequenceEnd)
+	if !ok {
+		var err error
+		events, err = c.fetchAssetsLockedEvents(ctx, sequenceStart, sequenceEnd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := validateAssetsLockedEvents(sequenceStart, sequenceEnd, events)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// fetchAssetsLockedEvents fetches the AssetsLocked events from the server
+// using the unary AssetsLockedEvents call.
+func (c *Client) fetchAssetsLockedEvents(
+	ctx context.Context,
+	sequenceStart sdkmath.Int,
+	sequenceEnd sdkmath.Int,
 ) ([]bridgetypes.AssetsLockedEvent, error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -142,12 +218,178 @@ func (c *Client) GetAssetsLockedEvents(
 		events[i] = *event
 	}
 
-	err = validateAssetsLockedEvents(sequenceStart, sequenceEnd, events)
+	return events, nil
+}
+
+// bufferedAssetsLockedEvents returns the buffered AssetsLocked events falling
+// within the given range. The second return value is false if the range is
+// not covered by the buffer, in which case the events must be fetched from
+// the server. The subscription filling the buffer is started on the first
+// call and buffered events preceding the sequence start are dropped as
+// queries are expected to move forward.
+func (c *Client) bufferedAssetsLockedEvents(
+	sequenceStart sdkmath.Int,
+	sequenceEnd sdkmath.Int,
+) ([]bridgetypes.AssetsLockedEvent, bool) {
+	if sequenceStart.IsNil() {
+		return nil, false
+	}
+
+	c.bufferMutex.Lock()
+	defer c.bufferMutex.Unlock()
+
+	c.subscriptionStart = sequenceStart
+
+	if !c.subscribed {
+		c.subscribed = true
+		go c.subscribeAssetsLockedEvents()
+		return nil, false
+	}
+
+	if !c.bufferLive || sequenceStart.LT(c.bufferStart) {
+		return nil, false
+	}
+
+	// Drop the events preceding the sequence start.
+	first := 0
+	for first < len(c.buffer) && c.buffer[first].Sequence.LT(sequenceStart) {
+		first++
+	}
+	c.buffer = c.buffer[first:]
+	c.bufferStart = sequenceStart
+
+	events := []bridgetypes.AssetsLockedEvent{}
+	for _, event := range c.buffer {
+		if !sequenceEnd.IsNil() && event.Sequence.GTE(sequenceEnd) {
+			break
+		}
+		events = append(events, event)
+	}
+
+	return events, true
+}
+
+// subscribeAssetsLockedEvents maintains the subscription to the AssetsLocked
+// events stream of the server until the client is closed. The subscription
+// is re-established after failures, starting from the sequence start of the
+// latest query. The routine stops if the server does not support the
+// subscription, so the client keeps using the unary call only.
+func (c *Client) subscribeAssetsLockedEvents() {
+	for {
+		c.bufferMutex.Lock()
+		sequenceStart := c.subscriptionStart
+		c.bufferMutex.Unlock()
+
+		err := c.receiveAssetsLockedEvents(sequenceStart)
+
+		c.bufferMutex.Lock()
+		c.bufferLive = false
+		c.buffer = nil
+		c.bufferMutex.Unlock()
+
+		if c.ctx.Err() != nil {
+			return
+		}
+
+		if status.Code(err) == codes.Unimplemented {
+			c.logger.Info(
+				"ethereum sidecar does not support AssetsLocked events " +
+					"subscription; falling back to polling",
+			)
+			return
+		}
+
+		c.logger.Warn(
+			"ethereum sidecar AssetsLocked events subscription failed; "+
+				"falling back to polling until it is re-established",
+			"err", err,
+		)
+
+		select {
+		case <-time.After(subscriptionRetryDelay):
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// receiveAssetsLockedEvents subscribes to the AssetsLocked events stream
+// starting from the given sequence and buffers the received events until
+// the stream fails.
+func (c *Client) receiveAssetsLockedEvents(sequenceStart sdkmath.Int) error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	sidecarClient := pb.NewEthereumSidecarClient(c.connection)
+
+	stream, err := sidecarClient.SubscribeAssetsLockedEvents(
+		ctx,
+		&pb.SubscribeAssetsLockedEventsRequest{SequenceStart: sequenceStart},
+	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return events, nil
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		events := make([]bridgetypes.AssetsLockedEvent, len(response.Events))
+		for i, event := range response.Events {
+			events[i] = *event
+		}
+
+		if err := c.bufferAssetsLockedEvents(sequenceStart, events); err != nil {
+			return err
+		}
+	}
+}
+
+// bufferAssetsLockedEvents appends the events received from the subscription
+// started from the given sequence to the buffer. The first received message
+// makes the buffer live.
+func (c *Client) bufferAssetsLockedEvents(
+	sequenceStart sdkmath.Int,
+	events []bridgetypes.AssetsLockedEvent,
+) error {
+	c.bufferMutex.Lock()
+	defer c.bufferMutex.Unlock()
+
+	if !c.bufferLive {
+		c.bufferLive = true
+		c.bufferStart = sequenceStart
+		c.buffer = nil
+		c.lastStreamed = sdkmath.Int{}
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	// The streamed events must follow the buffered ones without gaps.
+	if !bridgetypes.AssetsLockedEvents(events).IsValid() ||
+		events[0].Sequence.LT(sequenceStart) {
+		return ErrInvalidEventsSequence
+	}
+
+	if !c.lastStreamed.IsNil() &&
+		!c.lastStreamed.Add(sdkmath.OneInt()).Equal(events[0].Sequence) {
+		return ErrInvalidEventsSequence
+	}
+
+	c.lastStreamed = events[len(events)-1].Sequence
+
+	// Skip the events preceding the buffer start; they may have been
+	// dropped by a query moving the buffer start forward.
+	for len(events) > 0 && events[0].Sequence.LT(c.bufferStart) {
+		events = events[1:]
+	}
+
+	c.buffer = append(c.buffer, events...)
+
+	return nil
 }
 
 // validateAssetsLockedEvents validates the AssetsLocked events returned from
@@ -174,6 +416,8 @@ func validateAssetsLockedEvents(
 }
 
 func (c *Client) Close() error {
+	c.cancelCtx()
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	return c.connection.Close()
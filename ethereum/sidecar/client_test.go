@@ -1,13 +1,24 @@
 package sidecar
 
 import (
+	"context"
+	"net"
+	"sync"
 	"testing"
+	"time"
 
+	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cmdcfg "github.com/mezo-org/mezod/cmd/config"
 
+	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
 	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
 	"github.com/stretchr/testify/require"
 )
@@ -198,3 +209,244 @@ func TestClient_ValidateAssetsLockedEvents(t *testing.T) {
 		})
 	}
 }
+
+// testSidecarServer is a fake Ethereum sidecar server serving AssetsLocked
+// events through both the unary call and the subscription stream.
+type testSidecarServer struct {
+	pb.UnimplementedEthereumSidecarServer
+
+	streamUnsupported bool
+
+	mutex      sync.Mutex
+	events     []bridgetypes.AssetsLockedEvent
+	unaryCalls int
+	pushed     chan []bridgetypes.AssetsLockedEvent
+}
+
+func newTestSidecarServer(t *testing.T, events int) *testSidecarServer {
+	server := &testSidecarServer{
+		pushed: make(chan []bridgetypes.AssetsLockedEvent),
+	}
+
+	server.push(t, events)
+
+	return server
+}
+
+// push adds the given number of new events to the server without notifying
+// the subscribers.
+func (tss *testSidecarServer) push(t *testing.T, count int) []bridgetypes.AssetsLockedEvent {
+	t.Helper()
+
+	tss.mutex.Lock()
+	defer tss.mutex.Unlock()
+
+	events := make([]bridgetypes.AssetsLockedEvent, count)
+	for i := range events {
+		events[i] = bridgetypes.AssetsLockedEvent{
+			Sequence:  sdkmath.NewInt(int64(len(tss.events) + i + 1)),
+			Recipient: "mezo1pd4u0j77ydrsrv8z8m9854rsmg3jh45kjqwg54",
+			Amount:    sdkmath.NewInt(1000000),
+			Token:     token,
+		}
+	}
+
+	tss.events = append(tss.events, events...)
+
+	return events
+}
+
+func (tss *testSidecarServer) unaryCallsCount() int {
+	tss.mutex.Lock()
+	defer tss.mutex.Unlock()
+
+	return tss.unaryCalls
+}
+
+func (tss *testSidecarServer) eventsFrom(start, end sdkmath.Int) []*bridgetypes.AssetsLockedEvent {
+	tss.mutex.Lock()
+	defer tss.mutex.Unlock()
+
+	events := []*bridgetypes.AssetsLockedEvent{}
+	for _, event := range tss.events {
+		if (start.IsNil() || event.Sequence.GTE(start)) &&
+			(end.IsNil() || event.Sequence.LT(end)) {
+			events = append(events, &event)
+		}
+	}
+
+	return events
+}
+
+func (tss *testSidecarServer) AssetsLockedEvents(
+	_ context.Context,
+	req *pb.AssetsLockedEventsRequest,
+) (*pb.AssetsLockedEventsResponse, error) {
+	tss.mutex.Lock()
+	tss.unaryCalls++
+	tss.mutex.Unlock()
+
+	return &pb.AssetsLockedEventsResponse{
+		Events: tss.eventsFrom(req.SequenceStart, req.SequenceEnd),
+	}, nil
+}
+
+func (tss *testSidecarServer) SubscribeAssetsLockedEvents(
+	req *pb.SubscribeAssetsLockedEventsRequest,
+	stream pb.EthereumSidecar_SubscribeAssetsLockedEventsServer,
+) error {
+	if tss.streamUnsupported {
+		return status.Error(codes.Unimplemented, "not implemented")
+	}
+
+	err := stream.Send(&pb.SubscribeAssetsLockedEventsResponse{
+		Events: tss.eventsFrom(req.SequenceStart, sdkmath.Int{}),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case events := <-tss.pushed:
+			response := &pb.SubscribeAssetsLockedEventsResponse{}
+			for _, event := range events {
+				response.Events = append(response.Events, &event)
+			}
+
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// startTestSidecarServer serves the given fake server on a local port and
+// returns a client connected to it.
+func startTestSidecarServer(t *testing.T, server *testSidecarServer) *Client {
+	t.Helper()
+
+	config := sdk.GetConfig()
+	cmdcfg.SetBech32Prefixes(config)
+
+	registry := codectypes.NewInterfaceRegistry()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(
+		grpc.ForceServerCodec(codec.NewProtoCodec(registry).GRPCCodec()),
+	)
+	pb.RegisterEthereumSidecarServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := NewClient(
+		log.NewNopLogger(),
+		listener.Addr().String(),
+		time.Second,
+		registry,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func TestClient_GetAssetsLockedEvents_Subscription(t *testing.T) {
+	server := newTestSidecarServer(t, 3)
+	client := startTestSidecarServer(t, server)
+
+	// Wait for the connection test of the client.
+	require.Eventually(t, func() bool {
+		return server.unaryCallsCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The first query is answered by the unary call and starts the
+	// subscription.
+	events, err := client.GetAssetsLockedEvents(
+		context.Background(),
+		sdkmath.NewInt(1),
+		sdkmath.NewInt(11),
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, 2, server.unaryCallsCount())
+
+	require.Eventually(t, func() bool {
+		client.bufferMutex.Lock()
+		defer client.bufferMutex.Unlock()
+		return client.bufferLive
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Newly finalized events are pushed to the client.
+	server.pushed <- server.push(t, 2)
+
+	require.Eventually(t, func() bool {
+		events, err := client.GetAssetsLockedEvents(
+			context.Background(),
+			sdkmath.NewInt(2),
+			sdkmath.NewInt(11),
+		)
+		return err == nil && len(events) == 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	events, err = client.GetAssetsLockedEvents(
+		context.Background(),
+		sdkmath.NewInt(3),
+		sdkmath.NewInt(5),
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, int64(3), events[0].Sequence.Int64())
+	require.Equal(t, int64(4), events[1].Sequence.Int64())
+
+	// Buffered queries do not reach the server.
+	require.Equal(t, 2, server.unaryCallsCount())
+
+	// Events dropped from the buffer are fetched with the unary call.
+	events, err = client.GetAssetsLockedEvents(
+		context.Background(),
+		sdkmath.NewInt(1),
+		sdkmath.NewInt(11),
+	)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	require.Equal(t, 3, server.unaryCallsCount())
+}
+
+func TestClient_GetAssetsLockedEvents_SubscriptionUnsupported(t *testing.T) {
+	server := newTestSidecarServer(t, 3)
+	server.streamUnsupported = true
+	client := startTestSidecarServer(t, server)
+
+	require.Eventually(t, func() bool {
+		return server.unaryCallsCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		events, err := client.GetAssetsLockedEvents(
+			context.Background(),
+			sdkmath.NewInt(1),
+			sdkmath.NewInt(11),
+		)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// All queries fall back to the unary call.
+	require.Equal(t, 4, server.unaryCallsCount())
+
+	client.bufferMutex.Lock()
+	defer client.bufferMutex.Unlock()
+	require.False(t, client.bufferLive)
+}
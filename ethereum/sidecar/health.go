@@ -0,0 +1,56 @@
+package sidecar
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// startHealthServer serves the liveness and readiness probes of the sidecar
+// on the given address until the server fails. The liveness probe is served
+// at /healthz and always succeeds while the process is up. The readiness
+// probe is served at /readyz and succeeds once the given function reports
+// the sidecar is ready.
+func startHealthServer(logger log.Logger, address string, ready func() bool) error {
+	mux := http.NewServeMux()
+	mux.Handle("/healthz", livenessHandler())
+	mux.Handle("/readyz", readinessHandler(ready))
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger.Info("health server started", "address", address)
+
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("health server failed: [%w]", err)
+	}
+
+	return nil
+}
+
+func livenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+func readinessHandler(ready func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("not ready"))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ready"))
+	})
+}
@@ -0,0 +1,47 @@
+package sidecar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	livenessHandler().ServeHTTP(
+		recorder,
+		httptest.NewRequest(http.MethodGet, "/healthz", nil),
+	)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestReadinessHandler(t *testing.T) {
+	tests := map[string]struct {
+		ready        bool
+		expectedCode int
+	}{
+		"ready": {
+			ready:        true,
+			expectedCode: http.StatusOK,
+		},
+		"not ready": {
+			ready:        false,
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			readinessHandler(func() bool { return test.ready }).ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodGet, "/readyz", nil),
+			)
+
+			require.Equal(t, test.expectedCode, recorder.Code)
+		})
+	}
+}
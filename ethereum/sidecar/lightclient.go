@@ -0,0 +1,264 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	ethconnect "github.com/mezo-org/mezod/ethereum"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+	"github.com/mezo-org/mezod/ethereum/lightclient"
+)
+
+// ethereumLightClient is the Ethereum light client following the beacon
+// chain finality.
+type ethereumLightClient interface {
+	Sync(ctx context.Context) error
+	FinalizedHeader() (*lightclient.LightClientHeader, error)
+}
+
+// logVerifier verifies logs returned by Ethereum providers.
+type logVerifier interface {
+	VerifyLogs(ctx context.Context, logs []types.Log) error
+}
+
+// newEthereumLightClient creates an Ethereum light client of the given
+// network following the beacon chain through the beacon node with the given
+// URL. The light client is bootstrapped from the checkpoint persisted in the
+// store, if any, or from the given trusted checkpoint otherwise. The given
+// checkpoint is also used if the beacon node cannot serve the bootstrap of
+// the persisted one anymore.
+func newEthereumLightClient(
+	ctx context.Context,
+	logger log.Logger,
+	network string,
+	beaconNodeURL string,
+	trustedCheckpoint string,
+	store *store,
+) (*lightclient.Client, error) {
+	config, err := lightclient.ChainConfig(network)
+	if err != nil {
+		return nil, err
+	}
+
+	beaconAPI, err := lightclient.NewBeaconNodeAPI(beaconNodeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := lightclient.NewClient(config, beaconAPI)
+
+	var persistedCheckpoint *common.Hash
+	if store != nil {
+		persistedCheckpoint, err = store.lightClientCheckpoint()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if persistedCheckpoint != nil {
+		err := client.Bootstrap(ctx, *persistedCheckpoint)
+		if err == nil {
+			logger.Info(
+				"Ethereum light client bootstrapped from persisted checkpoint",
+				"checkpoint", persistedCheckpoint.Hex(),
+			)
+			return client, nil
+		}
+
+		logger.Warn(
+			"failed to bootstrap Ethereum light client from persisted "+
+				"checkpoint; falling back to the configured checkpoint",
+			"checkpoint", persistedCheckpoint.Hex(),
+			"err", err,
+		)
+	}
+
+	if trustedCheckpoint == "" {
+		return nil, fmt.Errorf(
+			"trusted beacon chain checkpoint is required to bootstrap the " +
+				"Ethereum light client",
+		)
+	}
+
+	checkpointBytes, err := hexutil.Decode(trustedCheckpoint)
+	if err != nil || len(checkpointBytes) != common.HashLength {
+		return nil, fmt.Errorf(
+			"invalid trusted beacon chain checkpoint: %s",
+			trustedCheckpoint,
+		)
+	}
+
+	checkpoint := common.BytesToHash(checkpointBytes)
+	if err := client.Bootstrap(ctx, checkpoint); err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"Ethereum light client bootstrapped from configured checkpoint",
+		"checkpoint", checkpoint.Hex(),
+	)
+
+	return client, nil
+}
+
+// lightClientChain is an Ethereum chain handle whose finalized block is
+// verified by the Ethereum light client. Other operations are delegated to
+// the underlying chain handle.
+type lightClientChain struct {
+	ethconnect.Chain
+
+	logger      log.Logger
+	lightClient ethereumLightClient
+	store       *store
+
+	checkpointMutex sync.Mutex
+	checkpoint      common.Hash
+}
+
+func newLightClientChain(
+	logger log.Logger,
+	chain ethconnect.Chain,
+	lightClient ethereumLightClient,
+	store *store,
+) *lightClientChain {
+	return &lightClientChain{
+		Chain:       chain,
+		logger:      logger,
+		lightClient: lightClient,
+		store:       store,
+	}
+}
+
+// FinalizedBlock returns the latest execution block finalized according to
+// the light client. The block is capped at the finalized block of the
+// underlying chain handle so logs of all returned blocks are available from
+// the Ethereum providers. The root of the finalized beacon chain block is
+// persisted as the checkpoint to bootstrap the light client from after a
+// restart.
+func (lcc *lightClientChain) FinalizedBlock(ctx context.Context) (*big.Int, error) {
+	if err := lcc.lightClient.Sync(ctx); err != nil {
+		return nil, fmt.Errorf("failed to sync Ethereum light client: [%w]", err)
+	}
+
+	header, err := lcc.lightClient.FinalizedHeader()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get Ethereum light client finalized header: [%w]",
+			err,
+		)
+	}
+
+	if err := lcc.persistCheckpoint(header.Beacon.HashTreeRoot()); err != nil {
+		// Failing to persist the checkpoint only means the light client
+		// may bootstrap from an older checkpoint after a restart.
+		lcc.logger.Warn(
+			"failed to persist Ethereum light client checkpoint",
+			"err", err,
+		)
+	}
+
+	verifiedBlock := new(big.Int).SetUint64(header.Execution.BlockNumber)
+
+	providerBlock, err := lcc.Chain.FinalizedBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if providerBlock.Cmp(verifiedBlock) < 0 {
+		return providerBlock, nil
+	}
+
+	return verifiedBlock, nil
+}
+
+// persistCheckpoint stores the given checkpoint unless it is already stored.
+func (lcc *lightClientChain) persistCheckpoint(checkpoint common.Hash) error {
+	if lcc.store == nil {
+		return nil
+	}
+
+	lcc.checkpointMutex.Lock()
+	defer lcc.checkpointMutex.Unlock()
+
+	if lcc.checkpoint == checkpoint {
+		return nil
+	}
+
+	if err := lcc.store.saveLightClientCheckpoint(checkpoint); err != nil {
+		return err
+	}
+
+	lcc.checkpoint = checkpoint
+
+	return nil
+}
+
+// lightClientBridgeContract is a MezoBridge contract handle whose AssetsLocked
+// events are verified against the Ethereum blocks finalized by the light
+// client. Other operations are delegated to the underlying contract handle.
+type lightClientBridgeContract struct {
+	ethconnect.BridgeContract
+
+	address  common.Address
+	verifier logVerifier
+}
+
+func newLightClientBridgeContract(
+	bridgeContract ethconnect.BridgeContract,
+	address common.Address,
+	verifier logVerifier,
+) *lightClientBridgeContract {
+	return &lightClientBridgeContract{
+		BridgeContract: bridgeContract,
+		address:        address,
+		verifier:       verifier,
+	}
+}
+
+func (lcbc *lightClientBridgeContract) PastAssetsLockedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	sequenceNumber []*big.Int,
+	recipient []common.Address,
+	token []common.Address,
+) ([]*portal.MezoBridgeAssetsLocked, error) {
+	events, err := lcbc.BridgeContract.PastAssetsLockedEvents(
+		startBlock,
+		endBlock,
+		sequenceNumber,
+		recipient,
+		token,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]types.Log, 0, len(events))
+	for _, event := range events {
+		if event.Raw.Address != lcbc.address {
+			return nil, fmt.Errorf(
+				"AssetsLocked event %s emitted by unexpected contract %s",
+				event.SequenceNumber.String(),
+				event.Raw.Address.Hex(),
+			)
+		}
+
+		logs = append(logs, event.Raw)
+	}
+
+	err = lcbc.verifier.VerifyLogs(context.Background(), logs)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to verify AssetsLocked events with Ethereum light client: [%w]",
+			err,
+		)
+	}
+
+	return events, nil
+}
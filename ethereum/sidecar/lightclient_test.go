@@ -0,0 +1,197 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+	"github.com/mezo-org/mezod/ethereum/lightclient"
+)
+
+// testLightClient is a fake Ethereum light client.
+type testLightClient struct {
+	finalizedHeader *lightclient.LightClientHeader
+	err             error
+}
+
+func (tlc *testLightClient) Sync(_ context.Context) error {
+	return tlc.err
+}
+
+func (tlc *testLightClient) FinalizedHeader() (*lightclient.LightClientHeader, error) {
+	return tlc.finalizedHeader, nil
+}
+
+func newTestLightClient(slot uint64, blockNumber uint64) *testLightClient {
+	return &testLightClient{
+		finalizedHeader: &lightclient.LightClientHeader{
+			Beacon: lightclient.BeaconBlockHeader{
+				Slot: slot,
+			},
+			Execution: lightclient.ExecutionPayloadHeader{
+				BlockNumber: blockNumber,
+			},
+		},
+	}
+}
+
+// testLogVerifier is a fake log verifier recording the verified logs.
+type testLogVerifier struct {
+	verifiedLogs []types.Log
+	err          error
+}
+
+func (tlv *testLogVerifier) VerifyLogs(_ context.Context, logs []types.Log) error {
+	if tlv.err != nil {
+		return tlv.err
+	}
+
+	tlv.verifiedLogs = append(tlv.verifiedLogs, logs...)
+	return nil
+}
+
+func TestLightClientChain_FinalizedBlock(t *testing.T) {
+	tests := map[string]struct {
+		lightClient            *testLightClient
+		providerFinalizedBlock *big.Int
+		expectedFinalizedBlock *big.Int
+		expectedErr            string
+	}{
+		"provider ahead of light client": {
+			lightClient:            newTestLightClient(1000, 100),
+			providerFinalizedBlock: big.NewInt(110),
+			expectedFinalizedBlock: big.NewInt(100),
+		},
+		"provider behind light client": {
+			lightClient:            newTestLightClient(1000, 100),
+			providerFinalizedBlock: big.NewInt(90),
+			expectedFinalizedBlock: big.NewInt(90),
+		},
+		"light client sync failure": {
+			lightClient: &testLightClient{
+				err: fmt.Errorf("sync committee signature verification failed"),
+			},
+			providerFinalizedBlock: big.NewInt(110),
+			expectedErr:            "failed to sync Ethereum light client",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			providerChain := newLocalChain()
+			providerChain.setFinalizedBlock(test.providerFinalizedBlock)
+
+			store := newTestStore()
+
+			chain := newLightClientChain(
+				log.NewNopLogger(),
+				providerChain,
+				test.lightClient,
+				store,
+			)
+
+			finalizedBlock, err := chain.FinalizedBlock(context.Background())
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				checkpoint, err := store.lightClientCheckpoint()
+				require.NoError(t, err)
+				require.Nil(t, checkpoint)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedFinalizedBlock, finalizedBlock)
+
+			// The finalized beacon chain block is persisted as the
+			// checkpoint of the light client.
+			checkpoint, err := store.lightClientCheckpoint()
+			require.NoError(t, err)
+			require.NotNil(t, checkpoint)
+			require.Equal(
+				t,
+				test.lightClient.finalizedHeader.Beacon.HashTreeRoot(),
+				*checkpoint,
+			)
+		})
+	}
+}
+
+func TestLightClientBridgeContract_PastAssetsLockedEvents(t *testing.T) {
+	bridgeAddress := common.HexToAddress("0xF6680EA3b480cA2b72D96ea13cCAF2cFd8e6908c")
+
+	newEvent := func(sequence int64, address common.Address) *portal.MezoBridgeAssetsLocked {
+		event := testQuorumAssetsLockedEvent(sequence, common.HexToHash("0x01"))
+		event.Raw.Address = address
+		return event
+	}
+
+	tests := map[string]struct {
+		events         []*portal.MezoBridgeAssetsLocked
+		verifierErr    error
+		expectedEvents []*portal.MezoBridgeAssetsLocked
+		expectedErr    string
+	}{
+		"verified events": {
+			events: []*portal.MezoBridgeAssetsLocked{
+				newEvent(1, bridgeAddress),
+				newEvent(2, bridgeAddress),
+			},
+			expectedEvents: []*portal.MezoBridgeAssetsLocked{
+				newEvent(1, bridgeAddress),
+				newEvent(2, bridgeAddress),
+			},
+		},
+		"no events": {
+			events:         []*portal.MezoBridgeAssetsLocked{},
+			expectedEvents: []*portal.MezoBridgeAssetsLocked{},
+		},
+		"event of another contract": {
+			events: []*portal.MezoBridgeAssetsLocked{
+				newEvent(1, bridgeAddress),
+				newEvent(2, common.HexToAddress("0x01")),
+			},
+			expectedErr: "AssetsLocked event 2 emitted by unexpected contract",
+		},
+		"verification failure": {
+			events: []*portal.MezoBridgeAssetsLocked{
+				newEvent(1, bridgeAddress),
+			},
+			verifierErr: fmt.Errorf("log not found in the receipt of transaction 0"),
+			expectedErr: "failed to verify AssetsLocked events with Ethereum light client",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			verifier := &testLogVerifier{err: test.verifierErr}
+
+			contract := newLightClientBridgeContract(
+				&quorumProviderBridgeContract{assetsLockedEvents: test.events},
+				bridgeAddress,
+				verifier,
+			)
+
+			events, err := contract.PastAssetsLockedEvents(0, nil, nil, nil, nil)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedEvents, events)
+
+			// All returned events must have been verified.
+			require.Len(t, verifier.verifiedLogs, len(test.expectedEvents))
+			for i, event := range test.expectedEvents {
+				require.Equal(t, event.Raw, verifier.verifiedLogs[i])
+			}
+		})
+	}
+}
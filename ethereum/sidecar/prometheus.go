@@ -0,0 +1,99 @@
+package sidecar
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	providerRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ethereum_sidecar_provider_requests_total",
+			Help: "the number of quorum read requests sent to an Ethereum provider, by result",
+		},
+		[]string{"provider", "operation", "result"},
+	)
+
+	providerDisagreementsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ethereum_sidecar_provider_disagreements_total",
+			Help: "the number of quorum reads in which an Ethereum provider returned a result different from the quorum",
+		},
+		[]string{"provider", "operation"},
+	)
+
+	providerHealthyGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ethereum_sidecar_provider_healthy",
+			Help: "1 if the last quorum read request sent to an Ethereum provider succeeded and agreed with the quorum, 0 otherwise",
+		},
+		[]string{"provider"},
+	)
+
+	quorumFailuresCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ethereum_sidecar_quorum_failures_total",
+			Help: "the number of quorum reads for which not enough Ethereum providers agreed on the result",
+		},
+		[]string{"operation"},
+	)
+
+	attestationGasUsedHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "ethereum_sidecar_attestation_gas_used",
+			Help:    "the gas used by mined attestation transactions",
+			Buckets: prometheus.ExponentialBuckets(25_000, 2, 8),
+		},
+	)
+
+	attestationFeeHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "ethereum_sidecar_attestation_fee_gwei",
+			Help:    "the fee in gwei paid by mined attestation transactions",
+			Buckets: prometheus.ExponentialBuckets(10_000, 4, 10),
+		},
+	)
+
+	transactionReplacementsCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ethereum_sidecar_transaction_replacements_total",
+			Help: "the number of attestation transactions replaced with higher fees because they were not mined in time",
+		},
+	)
+
+	nonceGapFillsCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "ethereum_sidecar_nonce_gap_fills_total",
+			Help: "the number of transactions sent to fill nonce gaps blocking attestation transactions",
+		},
+	)
+)
+
+// startPrometheus serves the sidecar metrics on the given address until the
+// server fails.
+func startPrometheus(logger log.Logger, address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger.Info("prometheus metrics server started", "address", address)
+
+	err := server.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("prometheus metrics server failed: [%w]", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethconnect "github.com/mezo-org/mezod/ethereum"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+// errQuorumNotReached is the error reported when not enough Ethereum
+// providers agree on the result of a read.
+var errQuorumNotReached = fmt.Errorf("ethereum providers quorum not reached")
+
+// ethereumProviderChain is the part of the Ethereum chain handle of a single
+// provider used by quorum reads.
+type ethereumProviderChain interface {
+	FinalizedBlock(ctx context.Context) (*big.Int, error)
+	BlockHash(ctx context.Context, number *big.Int) (common.Hash, error)
+}
+
+// ethereumProvider is a single Ethereum RPC provider taking part in quorum
+// reads.
+type ethereumProvider struct {
+	name           string
+	chain          ethereumProviderChain
+	bridgeContract ethconnect.BridgeContract
+}
+
+// providerName returns the name identifying the provider with the given index
+// and URL in logs and metrics. Only the host of the URL is used as the rest of
+// it often embeds an API key.
+func providerName(index int, providerURL string) string {
+	host := "unknown"
+	if parsedURL, err := url.Parse(providerURL); err == nil && parsedURL.Host != "" {
+		host = parsedURL.Host
+	}
+
+	return fmt.Sprintf("%d-%s", index, host)
+}
+
+// ethereumQuorum reads data from multiple Ethereum providers and accepts
+// a result only if at least `threshold` providers returned it. Providers are
+// queried concurrently so a failing or lagging provider is transparently
+// failed over as long as enough of the remaining providers agree.
+type ethereumQuorum struct {
+	logger    log.Logger
+	providers []*ethereumProvider
+	threshold int
+}
+
+func newEthereumQuorum(
+	logger log.Logger,
+	providers []*ethereumProvider,
+	threshold int,
+) (*ethereumQuorum, error) {
+	if threshold < 1 || threshold > len(providers) {
+		return nil, fmt.Errorf(
+			"quorum threshold must be between 1 and the number of "+
+				"providers (%d); got %d",
+			len(providers),
+			threshold,
+		)
+	}
+
+	for _, provider := range providers {
+		providerHealthyGauge.WithLabelValues(provider.name).Set(1)
+	}
+
+	return &ethereumQuorum{
+		logger:    logger,
+		providers: providers,
+		threshold: threshold,
+	}, nil
+}
+
+// providerResult is the result of a read performed against a single provider.
+type providerResult[T any] struct {
+	provider *ethereumProvider
+	value    T
+	err      error
+}
+
+// callProviders performs the read against all providers concurrently and
+// returns the successful results in the order of providers. Failures are
+// logged and recorded in the provider metrics.
+func callProviders[T any](
+	q *ethereumQuorum,
+	operation string,
+	call func(provider *ethereumProvider) (T, error),
+) []providerResult[T] {
+	results := make([]providerResult[T], len(q.providers))
+
+	var wg sync.WaitGroup
+	for i, provider := range q.providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := call(provider)
+			results[i] = providerResult[T]{provider, value, err}
+		}()
+	}
+	wg.Wait()
+
+	successful := make([]providerResult[T], 0, len(results))
+	for _, result := range results {
+		if result.err != nil {
+			providerRequestsCounter.WithLabelValues(
+				result.provider.name,
+				operation,
+				"failure",
+			).Inc()
+			providerHealthyGauge.WithLabelValues(result.provider.name).Set(0)
+
+			q.logger.Warn(
+				"Ethereum provider read failed",
+				"provider", result.provider.name,
+				"operation", operation,
+				"err", result.err,
+			)
+
+			continue
+		}
+
+		providerRequestsCounter.WithLabelValues(
+			result.provider.name,
+			operation,
+			"success",
+		).Inc()
+
+		successful = append(successful, result)
+	}
+
+	return successful
+}
+
+// quorumRead performs the read against all providers and returns the value
+// returned by at least `threshold` of them. Values are considered identical
+// if their keys are equal. If several values reach the threshold, the one
+// returned by the most providers wins.
+func quorumRead[T any](
+	q *ethereumQuorum,
+	operation string,
+	call func(provider *ethereumProvider) (T, error),
+	key func(value T) string,
+) (T, error) {
+	results := callProviders(q, operation, call)
+
+	// Group results by the key of their values. Keep the order of first
+	// occurrence so ties resolve deterministically to the group containing
+	// the first provider.
+	type resultGroup struct {
+		results []providerResult[T]
+	}
+
+	groups := make([]*resultGroup, 0)
+	groupsByKey := make(map[string]*resultGroup)
+	for _, result := range results {
+		k := key(result.value)
+
+		group, ok := groupsByKey[k]
+		if !ok {
+			group = &resultGroup{}
+			groupsByKey[k] = group
+			groups = append(groups, group)
+		}
+
+		group.results = append(group.results, result)
+	}
+
+	var best *resultGroup
+	for _, group := range groups {
+		if best == nil || len(group.results) > len(best.results) {
+			best = group
+		}
+	}
+
+	if best == nil || len(best.results) < q.threshold {
+		quorumFailuresCounter.WithLabelValues(operation).Inc()
+
+		agreed := 0
+		if best != nil {
+			agreed = len(best.results)
+		}
+
+		var zero T
+		return zero, fmt.Errorf(
+			"%w for %s; %d of %d providers agreed, %d required",
+			errQuorumNotReached,
+			operation,
+			agreed,
+			len(q.providers),
+			q.threshold,
+		)
+	}
+
+	for _, group := range groups {
+		for _, result := range group.results {
+			if group == best {
+				providerHealthyGauge.WithLabelValues(result.provider.name).Set(1)
+				continue
+			}
+
+			providerDisagreementsCounter.WithLabelValues(
+				result.provider.name,
+				operation,
+			).Inc()
+			providerHealthyGauge.WithLabelValues(result.provider.name).Set(0)
+
+			q.logger.Warn(
+				"Ethereum provider disagreed with the quorum",
+				"provider", result.provider.name,
+				"operation", operation,
+			)
+		}
+	}
+
+	return best.results[0].value, nil
+}
+
+// finalizedBlock returns the highest block considered finalized by at least
+// `threshold` providers, given they agree on the hash of that block. Providers
+// lagging behind do not prevent reaching the quorum as long as enough other
+// providers are ahead of them.
+func (q *ethereumQuorum) finalizedBlock(ctx context.Context) (*big.Int, error) {
+	results := callProviders(
+		q,
+		"finalized_block",
+		func(provider *ethereumProvider) (*big.Int, error) {
+			return provider.chain.FinalizedBlock(ctx)
+		},
+	)
+
+	if len(results) < q.threshold {
+		quorumFailuresCounter.WithLabelValues("finalized_block").Inc()
+
+		return nil, fmt.Errorf(
+			"%w for finalized_block; %d of %d providers responded, %d required",
+			errQuorumNotReached,
+			len(results),
+			len(q.providers),
+			q.threshold,
+		)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].value.Cmp(results[j].value) > 0
+	})
+
+	finalizedBlock := results[q.threshold-1].value
+
+	// There is nothing to compare the block against if a single provider
+	// is enough.
+	if q.threshold == 1 {
+		return finalizedBlock, nil
+	}
+
+	_, err := quorumRead(
+		q,
+		"block_hash",
+		func(provider *ethereumProvider) (common.Hash, error) {
+			return provider.chain.BlockHash(ctx, finalizedBlock)
+		},
+		func(hash common.Hash) string {
+			return hash.Hex()
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to agree on the hash of finalized block %s: [%w]",
+			finalizedBlock.String(),
+			err,
+		)
+	}
+
+	return finalizedBlock, nil
+}
+
+// assetsLockedEventsKey returns the key identifying the given AssetsLocked
+// events in quorum reads. Along with the event data, the key covers the
+// block hash and position of the logs so providers must agree on the
+// chain the events were emitted on.
+func assetsLockedEventsKey(events []*portal.MezoBridgeAssetsLocked) string {
+	eventKeys := make([]string, 0, len(events))
+	for _, event := range events {
+		eventKeys = append(eventKeys, fmt.Sprintf(
+			"%s/%s/%s/%s/%d/%s/%s/%d",
+			event.SequenceNumber.String(),
+			event.Recipient.Hex(),
+			event.Token.Hex(),
+			event.Amount.String(),
+			event.Raw.BlockNumber,
+			event.Raw.BlockHash.Hex(),
+			event.Raw.TxHash.Hex(),
+			event.Raw.Index,
+		))
+	}
+
+	sort.Strings(eventKeys)
+
+	return strings.Join(eventKeys, ";")
+}
+
+// quorumChain is an Ethereum chain handle reading the finalized block from
+// multiple providers. Other operations are delegated to the chain handle of
+// the primary provider.
+type quorumChain struct {
+	ethconnect.Chain
+
+	quorum *ethereumQuorum
+}
+
+func newQuorumChain(primary ethconnect.Chain, quorum *ethereumQuorum) *quorumChain {
+	return &quorumChain{
+		Chain:  primary,
+		quorum: quorum,
+	}
+}
+
+func (qc *quorumChain) FinalizedBlock(ctx context.Context) (*big.Int, error) {
+	return qc.quorum.finalizedBlock(ctx)
+}
+
+// quorumBridgeContract is a MezoBridge contract handle reading AssetsLocked
+// events and unlock confirmations from multiple providers. Other operations,
+// including transaction submission, are delegated to the contract handle of
+// the primary provider.
+type quorumBridgeContract struct {
+	ethconnect.BridgeContract
+
+	quorum *ethereumQuorum
+}
+
+func newQuorumBridgeContract(
+	primary ethconnect.BridgeContract,
+	quorum *ethereumQuorum,
+) *quorumBridgeContract {
+	return &quorumBridgeContract{
+		BridgeContract: primary,
+		quorum:         quorum,
+	}
+}
+
+func (qbc *quorumBridgeContract) PastAssetsLockedEvents(
+	startBlock uint64,
+	endBlock *uint64,
+	sequenceNumber []*big.Int,
+	recipient []common.Address,
+	token []common.Address,
+) ([]*portal.MezoBridgeAssetsLocked, error) {
+	return quorumRead(
+		qbc.quorum,
+		"past_assets_locked_events",
+		func(provider *ethereumProvider) ([]*portal.MezoBridgeAssetsLocked, error) {
+			return provider.bridgeContract.PastAssetsLockedEvents(
+				startBlock,
+				endBlock,
+				sequenceNumber,
+				recipient,
+				token,
+			)
+		},
+		assetsLockedEventsKey,
+	)
+}
+
+func (qbc *quorumBridgeContract) ConfirmedUnlocks(sequenceNumber *big.Int) (bool, error) {
+	return quorumRead(
+		qbc.quorum,
+		"confirmed_unlocks",
+		func(provider *ethereumProvider) (bool, error) {
+			return provider.bridgeContract.ConfirmedUnlocks(sequenceNumber)
+		},
+		strconv.FormatBool,
+	)
+}
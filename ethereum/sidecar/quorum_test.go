@@ -0,0 +1,379 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	ethconnect "github.com/mezo-org/mezod/ethereum"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+// quorumProviderChain is a fake Ethereum chain handle of a single provider.
+type quorumProviderChain struct {
+	finalizedBlock *big.Int
+	blockHashes    map[uint64]common.Hash
+	err            error
+}
+
+func (qpc *quorumProviderChain) FinalizedBlock(_ context.Context) (*big.Int, error) {
+	if qpc.err != nil {
+		return nil, qpc.err
+	}
+
+	return qpc.finalizedBlock, nil
+}
+
+func (qpc *quorumProviderChain) BlockHash(
+	_ context.Context,
+	number *big.Int,
+) (common.Hash, error) {
+	if qpc.err != nil {
+		return common.Hash{}, qpc.err
+	}
+
+	hash, ok := qpc.blockHashes[number.Uint64()]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("block not found")
+	}
+
+	return hash, nil
+}
+
+// quorumProviderBridgeContract is a fake MezoBridge contract handle of
+// a single provider. Methods not used by quorum reads panic.
+type quorumProviderBridgeContract struct {
+	ethconnect.BridgeContract
+
+	assetsLockedEvents []*portal.MezoBridgeAssetsLocked
+	confirmedUnlocks   bool
+	err                error
+}
+
+func (qpbc *quorumProviderBridgeContract) PastAssetsLockedEvents(
+	_ uint64,
+	_ *uint64,
+	_ []*big.Int,
+	_ []common.Address,
+	_ []common.Address,
+) ([]*portal.MezoBridgeAssetsLocked, error) {
+	if qpbc.err != nil {
+		return nil, qpbc.err
+	}
+
+	return qpbc.assetsLockedEvents, nil
+}
+
+func (qpbc *quorumProviderBridgeContract) ConfirmedUnlocks(_ *big.Int) (bool, error) {
+	if qpbc.err != nil {
+		return false, qpbc.err
+	}
+
+	return qpbc.confirmedUnlocks, nil
+}
+
+func newTestQuorum(
+	t *testing.T,
+	threshold int,
+	chains []*quorumProviderChain,
+	contracts []*quorumProviderBridgeContract,
+) *ethereumQuorum {
+	providers := make([]*ethereumProvider, 0)
+	for i := range chains {
+		providers = append(providers, &ethereumProvider{
+			name:           fmt.Sprintf("provider-%d", i),
+			chain:          chains[i],
+			bridgeContract: contracts[i],
+		})
+	}
+
+	quorum, err := newEthereumQuorum(log.NewNopLogger(), providers, threshold)
+	require.NoError(t, err)
+
+	return quorum
+}
+
+func testQuorumAssetsLockedEvent(
+	sequence int64,
+	blockHash common.Hash,
+) *portal.MezoBridgeAssetsLocked {
+	return &portal.MezoBridgeAssetsLocked{
+		SequenceNumber: big.NewInt(sequence),
+		Recipient:      common.HexToAddress("0x0A219c03938FBC93aA23cAd65f7c480f52665C2a"),
+		Token:          common.HexToAddress("0x3A128b915bee3645396d43Fe7A13A59a66C427d6"),
+		Amount:         big.NewInt(sequence * 1000),
+		Raw: types.Log{
+			BlockNumber: 100,
+			BlockHash:   blockHash,
+			TxHash:      common.BigToHash(big.NewInt(sequence)),
+			Index:       uint(sequence), //nolint:gosec
+		},
+	}
+}
+
+func TestNewEthereumQuorum(t *testing.T) {
+	providers := []*ethereumProvider{{name: "provider-0"}, {name: "provider-1"}}
+
+	_, err := newEthereumQuorum(log.NewNopLogger(), providers, 0)
+	require.ErrorContains(t, err, "quorum threshold must be between 1")
+
+	_, err = newEthereumQuorum(log.NewNopLogger(), providers, 3)
+	require.ErrorContains(t, err, "quorum threshold must be between 1")
+
+	_, err = newEthereumQuorum(log.NewNopLogger(), providers, 2)
+	require.NoError(t, err)
+}
+
+func TestQuorumBridgeContract_PastAssetsLockedEvents(t *testing.T) {
+	canonicalHash := common.HexToHash("0x01")
+	forkHash := common.HexToHash("0x02")
+
+	canonicalEvents := []*portal.MezoBridgeAssetsLocked{
+		testQuorumAssetsLockedEvent(1, canonicalHash),
+		testQuorumAssetsLockedEvent(2, canonicalHash),
+	}
+	forkEvents := []*portal.MezoBridgeAssetsLocked{
+		testQuorumAssetsLockedEvent(1, forkHash),
+		testQuorumAssetsLockedEvent(2, forkHash),
+	}
+	tamperedEvents := []*portal.MezoBridgeAssetsLocked{
+		testQuorumAssetsLockedEvent(1, canonicalHash),
+	}
+
+	providerErr := fmt.Errorf("provider failure")
+
+	tests := map[string]struct {
+		threshold      int
+		contracts      []*quorumProviderBridgeContract
+		expectedEvents []*portal.MezoBridgeAssetsLocked
+		expectedErr    error
+	}{
+		"all providers agree": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{assetsLockedEvents: canonicalEvents},
+				{assetsLockedEvents: canonicalEvents},
+				{assetsLockedEvents: canonicalEvents},
+			},
+			expectedEvents: canonicalEvents,
+		},
+		"all providers agree on no events": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{assetsLockedEvents: []*portal.MezoBridgeAssetsLocked{}},
+				{assetsLockedEvents: []*portal.MezoBridgeAssetsLocked{}},
+				{assetsLockedEvents: []*portal.MezoBridgeAssetsLocked{}},
+			},
+			expectedEvents: []*portal.MezoBridgeAssetsLocked{},
+		},
+		"one provider fails": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{err: providerErr},
+				{assetsLockedEvents: canonicalEvents},
+				{assetsLockedEvents: canonicalEvents},
+			},
+			expectedEvents: canonicalEvents,
+		},
+		"one provider returns tampered events": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{assetsLockedEvents: tamperedEvents},
+				{assetsLockedEvents: canonicalEvents},
+				{assetsLockedEvents: canonicalEvents},
+			},
+			expectedEvents: canonicalEvents,
+		},
+		"providers disagree on block hashes": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{assetsLockedEvents: canonicalEvents},
+				{assetsLockedEvents: forkEvents},
+				{err: providerErr},
+			},
+			expectedErr: errQuorumNotReached,
+		},
+		"too many providers fail": {
+			threshold: 2,
+			contracts: []*quorumProviderBridgeContract{
+				{assetsLockedEvents: canonicalEvents},
+				{err: providerErr},
+				{err: providerErr},
+			},
+			expectedErr: errQuorumNotReached,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			chains := make([]*quorumProviderChain, len(test.contracts))
+			for i := range chains {
+				chains[i] = &quorumProviderChain{}
+			}
+
+			quorumContract := newQuorumBridgeContract(
+				nil,
+				newTestQuorum(t, test.threshold, chains, test.contracts),
+			)
+
+			endBlock := uint64(200)
+			events, err := quorumContract.PastAssetsLockedEvents(
+				100,
+				&endBlock,
+				nil,
+				nil,
+				nil,
+			)
+
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, test.expectedEvents, events)
+		})
+	}
+}
+
+func TestQuorumBridgeContract_ConfirmedUnlocks(t *testing.T) {
+	quorumContract := newQuorumBridgeContract(
+		nil,
+		newTestQuorum(
+			t,
+			2,
+			[]*quorumProviderChain{{}, {}, {}},
+			[]*quorumProviderBridgeContract{
+				{confirmedUnlocks: false},
+				{confirmedUnlocks: true},
+				{confirmedUnlocks: true},
+			},
+		),
+	)
+
+	confirmed, err := quorumContract.ConfirmedUnlocks(big.NewInt(1))
+	require.NoError(t, err)
+	require.True(t, confirmed)
+}
+
+func TestQuorumChain_FinalizedBlock(t *testing.T) {
+	canonicalHashes := map[uint64]common.Hash{
+		100: common.HexToHash("0x0100"),
+		110: common.HexToHash("0x0110"),
+		120: common.HexToHash("0x0120"),
+	}
+	forkHashes := map[uint64]common.Hash{
+		100: common.HexToHash("0x0200"),
+		110: common.HexToHash("0x0210"),
+		120: common.HexToHash("0x0220"),
+	}
+
+	providerErr := fmt.Errorf("provider failure")
+
+	tests := map[string]struct {
+		threshold     int
+		chains        []*quorumProviderChain
+		expectedBlock *big.Int
+		expectedErr   error
+	}{
+		"all providers at the same block": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+		"one provider lags behind": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(100), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(120), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+		"one provider reports a block far ahead": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(1000000), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+		"one provider fails": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{err: providerErr},
+				{finalizedBlock: big.NewInt(120), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+		"one provider is on a fork": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(110), blockHashes: forkHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+		"providers disagree on the block hash": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(110), blockHashes: forkHashes},
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{err: providerErr},
+			},
+			expectedErr: errQuorumNotReached,
+		},
+		"too many providers fail": {
+			threshold: 2,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+				{err: providerErr},
+				{err: providerErr},
+			},
+			expectedErr: errQuorumNotReached,
+		},
+		"single provider threshold": {
+			threshold: 1,
+			chains: []*quorumProviderChain{
+				{finalizedBlock: big.NewInt(110), blockHashes: canonicalHashes},
+			},
+			expectedBlock: big.NewInt(110),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			contracts := make([]*quorumProviderBridgeContract, len(test.chains))
+			for i := range contracts {
+				contracts[i] = &quorumProviderBridgeContract{}
+			}
+
+			chain := newQuorumChain(
+				nil,
+				newTestQuorum(t, test.threshold, test.chains, contracts),
+			)
+
+			finalizedBlock, err := chain.FinalizedBlock(context.Background())
+
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, test.expectedBlock, finalizedBlock)
+		})
+	}
+}
+
+func TestProviderName(t *testing.T) {
+	require.Equal(
+		t,
+		"0-eth-mainnet.g.alchemy.com",
+		providerName(0, "wss://eth-mainnet.g.alchemy.com/v2/secret-api-key"),
+	)
+	require.Equal(t, "1-unknown", providerName(1, "not a url"))
+}
@@ -2,7 +2,6 @@ package sidecar
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 	"net"
@@ -12,12 +11,14 @@ import (
 
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethconfig "github.com/keep-network/keep-common/pkg/chain/ethereum"
 	ethconnect "github.com/mezo-org/mezod/ethereum"
 	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+	"github.com/mezo-org/mezod/ethereum/lightclient"
 	"github.com/mezo-org/mezod/ethereum/sidecar/mezotime"
 	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
 	"github.com/mezo-org/mezod/version"
@@ -50,6 +51,12 @@ var (
 	// enough.
 	cachedEventsLimit = 500000
 
+	// streamedEventsLimit is the maximum number of AssetsLocked events sent
+	// in a single message of the AssetsLocked events subscription stream.
+	// It keeps the messages well below the default gRPC message size limit
+	// while the events known to the sidecar are sent to a new subscriber.
+	streamedEventsLimit = 1000
+
 	// assetsUnlockedLookBackPeriod is the look-back period used when fetching
 	// AssetsUnlocked events from the Mezo chain. It defines how far back we
 	// look when searching for unconfirmed events.
@@ -123,6 +130,10 @@ type Server struct {
 
 	assetsLockedEventsMutex sync.RWMutex
 	assetsLockedEvents      []bridgetypes.AssetsLockedEvent
+	// assetsLockedEventsUpdated is closed and replaced whenever new events
+	// are added to assetsLockedEvents to wake up the subscribers of the
+	// AssetsLocked events stream. Guarded by assetsLockedEventsMutex.
+	assetsLockedEventsUpdated chan struct{}
 
 	lastFinalizedBlockMutex sync.RWMutex
 	lastFinalizedBlock      *big.Int
@@ -146,6 +157,15 @@ type Server struct {
 
 	attestationMutex sync.Mutex
 	attestationQueue []bridgetypes.AssetsUnlockedEvent
+	// currentAttestation is the attestation being processed by the
+	// attestation routine and currentSubmissionPosition is the 1-based
+	// position of this validator in its individual submission queue, or 0
+	// if not known yet. Both are guarded by attestationMutex.
+	currentAttestation        *bridgetypes.AssetsUnlockedEvent
+	currentSubmissionPosition int
+	// bridgeValidatorID is the ID of the bridge validator represented by the
+	// sidecar, or 0 if none. Guarded by attestationMutex.
+	bridgeValidatorID uint8
 
 	// Unguarded by mutex as only the AssetsUnlock event observation
 	// routine uses it.
@@ -158,6 +178,10 @@ type Server struct {
 
 	attestationFinalityChecksMutex sync.Mutex
 	attestationFinalityChecks      map[string]*attestationFinalityCheck
+
+	// store persists the server state between restarts. If nil, the state
+	// is kept in memory only.
+	store *store
 }
 
 // RunServer initializes the server, starts the event observing routine and
@@ -166,12 +190,21 @@ func RunServer(
 	logger log.Logger,
 	grpcAddress string,
 	providerURL string,
+	additionalProviderURLs []string,
+	quorumThreshold uint,
 	ethereumNetwork string,
 	batchSize uint64,
 	requestsPerMinute uint64,
 	assetsUnlockedEndpoint string,
 	registry codectypes.InterfaceRegistry,
-	privateKey *ecdsa.PrivateKey,
+	signer Signer,
+	dataDir string,
+	metricsAddress string,
+	beaconNodeURL string,
+	beaconCheckpoint string,
+	healthAddress string,
+	feePolicy FeePolicy,
+	bridgeWorkerURL string,
 ) {
 	network := ethconnect.NetworkFromString(ethereumNetwork)
 	mezoBridgeAddress := portal.MezoBridgeAddress(network)
@@ -189,10 +222,34 @@ func RunServer(
 		"ethereum_network", network,
 	)
 
+	// Keep a separate database per Ethereum network so the persisted state
+	// is never mixed up between networks.
+	db, err := dbm.NewDB(
+		fmt.Sprintf("ethereum-sidecar-%s", network),
+		dbm.GoLevelDBBackend,
+		dataDir,
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to open the sidecar database: %v", err))
+	}
+
+	store := newStore(db)
+	defer func() {
+		if err := store.close(); err != nil {
+			logger.Error("failed to close the sidecar database", "err", err)
+		}
+	}()
+
+	logger.Info("sidecar database opened", "data_dir", dataDir)
+
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
 
-	var err error
+	chainPrivateKey, err := chainPrivateKey(signer)
+	if err != nil {
+		panic(fmt.Sprintf("failed to get Ethereum chain key: %v", err))
+	}
+
 	// Connect to the Ethereum network
 	chain, err := ethconnect.Connect(
 		ctx,
@@ -201,7 +258,7 @@ func RunServer(
 			URL:               providerURL,
 			ContractAddresses: map[string]string{mezoBridgeName: mezoBridgeAddress},
 		},
-		privateKey,
+		chainPrivateKey,
 	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to connect to the Ethereum network: %v", err))
@@ -213,19 +270,170 @@ func RunServer(
 		panic(fmt.Sprintf("failed to initialize MezoBridge contract: %v", err))
 	}
 
-	bridgeContract := NewBridgeContract(bridgeContractBinding)
+	if err := feePolicy.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid fee policy: %v", err))
+	}
+
+	// Attestation transactions are signed by the Signer and tracked by the
+	// transaction manager applying the fee policy.
+	transactions := newTransactionManager(
+		logger,
+		signer,
+		chain.ChainID(),
+		newChainTransactionBackend(chain),
+		feePolicy,
+	)
+
+	bridgeContract, err := newSignerBridgeContract(
+		NewBridgeContract(bridgeContractBinding),
+		chain,
+		common.HexToAddress(mezoBridgeAddress),
+		transactions,
+	)
+	if err != nil {
+		panic(fmt.Sprintf("failed to set up MezoBridge contract signer: %v", err))
+	}
+
+	logger.Info(
+		"sidecar server resolved bridge validator signing address",
+		"address", signer.Address().Hex(),
+	)
+
+	providers := []*ethereumProvider{
+		{
+			name:           providerName(0, providerURL),
+			chain:          chain,
+			bridgeContract: bridgeContract,
+		},
+	}
+
+	// Connect to the additional Ethereum providers taking part in quorum
+	// reads along with the primary one.
+	for i, additionalProviderURL := range additionalProviderURLs {
+		additionalChain, err := ethconnect.Connect(
+			ctx,
+			ethconfig.Config{
+				Network:           network,
+				URL:               additionalProviderURL,
+				ContractAddresses: map[string]string{mezoBridgeName: mezoBridgeAddress},
+			},
+			chainPrivateKey,
+		)
+		if err != nil {
+			panic(fmt.Sprintf(
+				"failed to connect to additional Ethereum provider %d: %v",
+				i+1,
+				err,
+			))
+		}
+
+		additionalBridgeContractBinding, err := initializeBridgeContract(
+			common.HexToAddress(mezoBridgeAddress),
+			additionalChain,
+		)
+		if err != nil {
+			panic(fmt.Sprintf(
+				"failed to initialize MezoBridge contract for additional "+
+					"Ethereum provider %d: %v",
+				i+1,
+				err,
+			))
+		}
+
+		providers = append(providers, &ethereumProvider{
+			name:           providerName(i+1, additionalProviderURL),
+			chain:          additionalChain,
+			bridgeContract: NewBridgeContract(additionalBridgeContractBinding),
+		})
+	}
+
+	// By default, a majority of providers must agree on the read data.
+	threshold := int(quorumThreshold) //nolint:gosec
+	if threshold == 0 {
+		threshold = len(providers)/2 + 1
+	}
+
+	quorum, err := newEthereumQuorum(logger, providers, threshold)
+	if err != nil {
+		panic(fmt.Sprintf("failed to set up Ethereum providers quorum: %v", err))
+	}
+
+	logger.Info(
+		"sidecar server set up Ethereum providers quorum",
+		"providers", len(providers),
+		"threshold", threshold,
+	)
+
+	var serverChain ethconnect.Chain = newQuorumChain(chain, quorum)
+	var serverBridgeContract ethconnect.BridgeContract = newQuorumBridgeContract(
+		bridgeContract,
+		quorum,
+	)
+
+	// In the light client mode, the finalized block and AssetsLocked events
+	// read from the Ethereum providers are verified against the beacon chain
+	// instead of being trusted.
+	if beaconNodeURL != "" {
+		lightClient, err := newEthereumLightClient(
+			ctx,
+			logger,
+			ethereumNetwork,
+			beaconNodeURL,
+			beaconCheckpoint,
+			store,
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to set up Ethereum light client: %v", err))
+		}
+
+		serverChain = newLightClientChain(logger, serverChain, lightClient, store)
+		serverBridgeContract = newLightClientBridgeContract(
+			serverBridgeContract,
+			common.HexToAddress(mezoBridgeAddress),
+			lightclient.NewLogVerifier(lightClient, chain),
+		)
+
+		logger.Info(
+			"sidecar server verifies Ethereum data with light client",
+			"ethereum_network", network,
+		)
+	}
+
+	if metricsAddress != "" {
+		go func() {
+			if err := startPrometheus(logger, metricsAddress); err != nil {
+				logger.Error("prometheus metrics server failed", "err", err)
+			}
+		}()
+	}
 
 	attestationValidator := newAttestationValidation(
 		logger,
 		bridgeContract,
-		chain.Key().Address,
+		signer.Address(),
 	)
 
+	// The bridge worker is optional. If it is not set, attestations are
+	// always submitted individually.
+	var bridgeWorker BridgeWorker
+	if bridgeWorkerURL != "" {
+		bridgeWorkerClient, err := NewBridgeWorkerClient(bridgeWorkerURL)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create bridge worker client: %v", err))
+		}
+
+		logger.Info(
+			"batch attestation enabled",
+			"bridge_worker_url", bridgeWorkerURL,
+		)
+
+		bridgeWorker = bridgeWorkerClient
+	}
+
 	batchAttestation := newBatchAttestation(
 		logger,
-		privateKey,
-		// TODO: pass the bridge worked here when implemented
-		nil,
+		signer,
+		bridgeWorker,
 		bridgeContract,
 		chain.ChainID(),
 	)
@@ -233,7 +441,7 @@ func RunServer(
 	submissionQueue := newSubmissionQueue(
 		logger,
 		bridgeContract,
-		chain.Key().Address,
+		signer.Address(),
 	)
 
 	server := &Server{
@@ -241,8 +449,8 @@ func RunServer(
 		grpcServer:                   grpc.NewServer(),
 		assetsLockedEvents:           make([]bridgetypes.AssetsLockedEvent, 0),
 		lastFinalizedBlock:           new(big.Int),
-		bridgeContract:               bridgeContract,
-		chain:                        chain,
+		bridgeContract:               serverBridgeContract,
+		chain:                        serverChain,
 		batchSize:                    batchSize,
 		requestsPerMinute:            requestsPerMinute,
 		assetsLockedReady:            make(chan struct{}),
@@ -253,6 +461,15 @@ func RunServer(
 		submissionQueue:              submissionQueue,
 		batchAttestation:             batchAttestation,
 		attestationFinalityChecks:    make(map[string]*attestationFinalityCheck),
+		store:                        store,
+	}
+
+	if healthAddress != "" {
+		go func() {
+			if err := startHealthServer(logger, healthAddress, server.isReady); err != nil {
+				logger.Error("health server failed", "err", err)
+			}
+		}()
 	}
 
 	go func() {
@@ -292,12 +509,16 @@ func RunServer(
 		return
 	}
 
-	accountAddress := chain.Key().Address
+	accountAddress := signer.Address()
 	bridgeValidatorID, err := server.bridgeContract.ValidatorIDs(accountAddress)
 	if err != nil {
 		panic(fmt.Sprintf("failed to get bridge validator ID: %v", err))
 	}
 
+	server.attestationMutex.Lock()
+	server.bridgeValidatorID = bridgeValidatorID
+	server.attestationMutex.Unlock()
+
 	if bridgeValidatorID != 0 {
 		server.logger.Info(
 			"sidecar represents a bridge validator; proceeding with " +
@@ -340,6 +561,12 @@ func RunServer(
 			server.processAttestationFinalityChecks(ctx)
 			server.logger.Warn("attestation finality checks routine stopped")
 		}()
+
+		go func() {
+			defer cancelCtx()
+			transactions.monitor(ctx, chain.WatchBlocks(ctx))
+			server.logger.Warn("attestation transactions monitoring routine stopped")
+		}()
 	} else {
 		server.logger.Info(
 			"sidecar does not represent a bridge validator; skipping " +
@@ -356,8 +583,9 @@ func RunServer(
 //
 //   - Initializes a MezoBridge contract instance using the provided blockchain connection and contract address.
 //   - Retrieves the most recent finalized block number from the blockchain.
-//   - Calculates a start block that is two weeks prior to the current finalized block. It then fetches
-//     `AssetsLocked` events for this range.
+//   - Calculates a start block that is two weeks prior to the current finalized block. If the server
+//     state persisted before the last restart covers that range, it resumes from the persisted state
+//     instead. It then fetches `AssetsLocked` events for the remaining range.
 //   - Sets up a ticker channel to continuously monitor new finalized blocks.
 //   - On each new block notification from the ticker channel, calls `processEvents` to handle new events
 //     since the last finalized block.
@@ -374,14 +602,26 @@ func (s *Server) observeAssetsLockedEvents(ctx context.Context) error {
 	} else {
 		startBlock = 0
 	}
-	err = s.fetchFinalizedAssetsLockedEvents(startBlock, finalizedBlock.Uint64())
-	if err != nil {
-		return fmt.Errorf("failed to fetch historical events: [%w]", err)
+
+	if s.store != nil {
+		startBlock, err = s.restoreAssetsLockedEvents(startBlock)
+		if err != nil {
+			return fmt.Errorf("failed to restore persisted events: [%w]", err)
+		}
 	}
 
-	s.lastFinalizedBlockMutex.Lock()
-	s.lastFinalizedBlock = finalizedBlock
-	s.lastFinalizedBlockMutex.Unlock()
+	// The persisted state may already cover the current finalized block if
+	// the Ethereum provider lags behind the one used before the restart.
+	if startBlock <= finalizedBlock.Uint64() {
+		err = s.fetchFinalizedAssetsLockedEvents(startBlock, finalizedBlock.Uint64())
+		if err != nil {
+			return fmt.Errorf("failed to fetch historical events: [%w]", err)
+		}
+
+		s.lastFinalizedBlockMutex.Lock()
+		s.lastFinalizedBlock = finalizedBlock
+		s.lastFinalizedBlockMutex.Unlock()
+	}
 
 	// Signal that initial synchronization is ready.
 	close(s.assetsLockedReady)
@@ -456,18 +696,97 @@ func (s *Server) processAssetsLockedEvents(ctx context.Context) error {
 		s.assetsLockedEventsMutex.Lock()
 		trim := len(s.assetsLockedEvents) - cachedEventsLimit
 		s.assetsLockedEvents = s.assetsLockedEvents[trim:]
+		oldestSequence := s.assetsLockedEvents[0].Sequence
 		s.assetsLockedEventsMutex.Unlock()
+
+		// Prune the persisted events accordingly. A failure is not critical
+		// as the surplus events are pruned on the next occasion.
+		if s.store != nil {
+			if err := s.store.pruneAssetsLockedEvents(oldestSequence); err != nil {
+				s.logger.Error(
+					"failed to prune persisted AssetsLocked events",
+					"err", err,
+				)
+			}
+		}
 	}
 
 	return nil
 }
 
+// restoreAssetsLockedEvents restores the AssetsLocked events and the last
+// finalized block persisted before the last restart. It returns the block
+// from which fetching of AssetsLocked events should start. The persisted
+// state is discarded if it does not reach the given default start block, as
+// resuming from it would require fetching a range of blocks larger than the
+// searched range. In that case, the default start block is returned.
+func (s *Server) restoreAssetsLockedEvents(defaultStartBlock uint64) (uint64, error) {
+	lastFinalizedBlock, err := s.store.lastFinalizedBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	if lastFinalizedBlock == nil {
+		s.logger.Info("no persisted AssetsLocked state found; performing full sync")
+		return defaultStartBlock, nil
+	}
+
+	resumeBlock := lastFinalizedBlock.Uint64() + 1
+
+	if resumeBlock < defaultStartBlock {
+		s.logger.Warn(
+			"persisted AssetsLocked state is outdated; discarding it and "+
+				"performing full sync",
+			"last_finalized_block", lastFinalizedBlock.String(),
+			"start_block", defaultStartBlock,
+		)
+
+		return defaultStartBlock, s.store.resetAssetsLockedEvents()
+	}
+
+	events, err := s.store.assetsLockedEvents()
+	if err != nil {
+		return 0, err
+	}
+
+	if !bridgetypes.AssetsLockedEvents(events).IsValid() {
+		s.logger.Warn(
+			"persisted AssetsLocked events are invalid; discarding them and " +
+				"performing full sync",
+		)
+
+		return defaultStartBlock, s.store.resetAssetsLockedEvents()
+	}
+
+	if len(events) > cachedEventsLimit {
+		events = events[len(events)-cachedEventsLimit:]
+	}
+
+	s.assetsLockedEventsMutex.Lock()
+	s.assetsLockedEvents = events
+	s.notifyAssetsLockedEventsSubscribers()
+	s.assetsLockedEventsMutex.Unlock()
+
+	s.lastFinalizedBlockMutex.Lock()
+	s.lastFinalizedBlock = lastFinalizedBlock
+	s.lastFinalizedBlockMutex.Unlock()
+
+	s.logger.Info(
+		"restored persisted AssetsLocked state",
+		"events", len(events),
+		"last_finalized_block", lastFinalizedBlock.String(),
+	)
+
+	return resumeBlock, nil
+}
+
 // fetchFinalizedAssetsLockedEvents retrieves and processes finalized
 // `AssetsLocked` events from the Ethereum network, within a specified block
 // range. It uses the provided MezoBridge contract to filter these events.
 // Each event is transformed into an `AssetsLockedEvent` type compatible with
 // the bridgetypes package and added to the server's event list with mutex
-// protection.
+// protection. The events are persisted along with the end block of the range
+// before they are added to the list.
 func (s *Server) fetchFinalizedAssetsLockedEvents(startBlock uint64, endBlock uint64) error {
 	abiEvents, err := s.fetchAssetsLockedABIEvents(startBlock, endBlock)
 	if err != nil {
@@ -495,6 +814,14 @@ func (s *Server) fetchFinalizedAssetsLockedEvents(startBlock uint64, endBlock ui
 
 	if len(bufferedEvents) == 0 {
 		s.logger.Info("no new AssetsLocked events to process")
+
+		if s.store != nil {
+			err := s.store.saveAssetsLockedEvents(nil, new(big.Int).SetUint64(endBlock))
+			if err != nil {
+				return fmt.Errorf("failed to persist last finalized block: [%w]", err)
+			}
+		}
+
 		return nil
 	}
 
@@ -519,11 +846,33 @@ func (s *Server) fetchFinalizedAssetsLockedEvents(startBlock uint64, endBlock ui
 		return errInvalidEvents
 	}
 
+	if s.store != nil {
+		err := s.store.saveAssetsLockedEvents(
+			bufferedEvents,
+			new(big.Int).SetUint64(endBlock),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to persist AssetsLocked events: [%w]", err)
+		}
+	}
+
 	s.assetsLockedEvents = append(s.assetsLockedEvents, bufferedEvents...)
+	s.notifyAssetsLockedEventsSubscribers()
 
 	return nil
 }
 
+// notifyAssetsLockedEventsSubscribers wakes up the subscribers of the
+// AssetsLocked events stream waiting for new events. Must be called with
+// assetsLockedEventsMutex locked for writing.
+func (s *Server) notifyAssetsLockedEventsSubscribers() {
+	if s.assetsLockedEventsUpdated != nil {
+		close(s.assetsLockedEventsUpdated)
+	}
+
+	s.assetsLockedEventsUpdated = make(chan struct{})
+}
+
 // fetchAssetsLockedABIEvents retrieves raw `AssetsLocked` ABI events from the
 // MezoBridge contract within a specified block range. The function fetches
 // events in batches if the entire range is too large to fetch at once.
@@ -671,6 +1020,16 @@ func (s *Server) observeAssetsUnlockedEvents(ctx context.Context) error {
 		)
 	}
 
+	if s.store != nil {
+		unconfirmedEvents, err = s.restoreAttestationState(unconfirmedEvents)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to restore persisted attestation state: [%w]",
+				err,
+			)
+		}
+	}
+
 	s.queueAttestations(unconfirmedEvents...)
 
 	// Save the unlock sequence of the last event as the starting point for
@@ -855,6 +1214,55 @@ func (s *Server) findUnconfirmedAssetsUnlockedEvents(
 	return unconfirmedEvents, nil
 }
 
+// restoreAttestationState restores the attestation finality checks and the
+// pending attestations persisted before the last restart. Persisted pending
+// attestations are merged with the given unconfirmed events, and events that
+// already have an attestation finality check are excluded as the check
+// re-queues them if needed. The returned events are those to be queued for
+// attestation.
+func (s *Server) restoreAttestationState(
+	unconfirmedEvents []bridgetypes.AssetsUnlockedEvent,
+) ([]bridgetypes.AssetsUnlockedEvent, error) {
+	checks, err := s.store.attestationFinalityChecks()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingAttestations, err := s.store.pendingAttestations()
+	if err != nil {
+		return nil, err
+	}
+
+	s.attestationFinalityChecksMutex.Lock()
+	for _, check := range checks {
+		s.attestationFinalityChecks[check.key()] = check
+	}
+	s.attestationFinalityChecksMutex.Unlock()
+
+	seen := make(map[string]bool)
+	for _, check := range checks {
+		seen[check.key()] = true
+	}
+
+	attestations := make([]bridgetypes.AssetsUnlockedEvent, 0)
+	for _, event := range append(pendingAttestations, unconfirmedEvents...) {
+		if seen[event.UnlockSequence.String()] {
+			continue
+		}
+
+		seen[event.UnlockSequence.String()] = true
+		attestations = append(attestations, event)
+	}
+
+	s.logger.Info(
+		"restored persisted attestation state",
+		"attestation_finality_checks", len(checks),
+		"pending_attestations", len(pendingAttestations),
+	)
+
+	return attestations, nil
+}
+
 func (s *Server) fetchNewAssetsUnlockedEvents(ctx context.Context) error {
 	sequenceTip, err := s.assetsUnlockedEndpoint.GetAssetsUnlockedSequenceTip(ctx)
 	if err != nil {
@@ -1004,6 +1412,14 @@ func (s *Server) queueAttestations(attestations ...bridgetypes.AssetsUnlockedEve
 		return
 	}
 
+	// Persist the attestations so they survive a restart. A failure is not
+	// critical as unconfirmed events are searched for at startup anyway.
+	if s.store != nil {
+		if err := s.store.savePendingAttestations(attestations...); err != nil {
+			s.logger.Error("failed to persist pending attestations", "err", err)
+		}
+	}
+
 	s.attestationQueue = append(s.attestationQueue, attestations...)
 
 	// order attestations by unlock sequence in ascending order
@@ -1019,15 +1435,29 @@ func (s *Server) unqueueAttestation() *bridgetypes.AssetsUnlockedEvent {
 	s.attestationMutex.Lock()
 	defer s.attestationMutex.Unlock()
 	if len(s.attestationQueue) == 0 {
+		s.currentAttestation = nil
+		s.currentSubmissionPosition = 0
 		return nil
 	}
 
 	attestation := s.attestationQueue[0]
 	s.attestationQueue = s.attestationQueue[1:]
 
+	s.currentAttestation = &attestation
+	s.currentSubmissionPosition = 0
+
 	return &attestation
 }
 
+// setSubmissionPosition records the position of this validator in the
+// individual submission queue of the attestation being processed.
+func (s *Server) setSubmissionPosition(position int) {
+	s.attestationMutex.Lock()
+	defer s.attestationMutex.Unlock()
+
+	s.currentSubmissionPosition = position
+}
+
 func (s *Server) attestAssetsUnlockedEvents(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
@@ -1059,6 +1489,7 @@ func (s *Server) attestAssetsUnlockedEvents(ctx context.Context) {
 					attestationLogger.Info(
 						"entry already went through the attestation process - skipping",
 					)
+					s.completeAttestation(attestation)
 					continue
 				}
 
@@ -1087,10 +1518,14 @@ func (s *Server) attestAssetsUnlockedEvents(ctx context.Context) {
 					return
 				}
 
-				delay := s.submissionQueue.GetSubmissionDelay(bridgeAssetsUnlocked)
+				position := s.submissionQueue.GetSubmissionPosition(bridgeAssetsUnlocked)
+				s.setSubmissionPosition(position)
+
+				delay := submissionDelay(position)
 
 				attestationLogger.Info(
 					"waiting for individual attestation submission slot",
+					"position", position,
 					"delay", fmt.Sprintf("%vs", delay.Seconds()),
 				)
 
@@ -1132,6 +1567,7 @@ func (s *Server) attestAssetsUnlockedEvents(ctx context.Context) {
 						attestationProcessLogger.Info(
 							"attestation process completed with success",
 						)
+						s.completeAttestation(attestation)
 						break
 					}
 
@@ -1160,6 +1596,39 @@ func (s *Server) attestAssetsUnlockedEvents(ctx context.Context) {
 	}
 }
 
+// completeAttestation removes the attestation from the persisted pending
+// attestations once the attestation process completed for it.
+func (s *Server) completeAttestation(attestation *bridgetypes.AssetsUnlockedEvent) {
+	if s.store == nil {
+		return
+	}
+
+	if err := s.store.deletePendingAttestation(attestation.UnlockSequence); err != nil {
+		s.logger.Error(
+			"failed to delete persisted pending attestation",
+			"unlock_sequence", attestation.UnlockSequence.String(),
+			"err", err,
+		)
+	}
+}
+
+// persistAttestationFinalityCheck persists the attestation finality check so
+// it survives a restart. A failure is not critical as unconfirmed events are
+// searched for at startup anyway.
+func (s *Server) persistAttestationFinalityCheck(check *attestationFinalityCheck) {
+	if s.store == nil {
+		return
+	}
+
+	if err := s.store.saveAttestationFinalityCheck(check); err != nil {
+		s.logger.Error(
+			"failed to persist attestation finality check",
+			"unlock_sequence", check.key(),
+			"err", err,
+		)
+	}
+}
+
 func (s *Server) queueAttestationFinalityCheck(attestation *bridgetypes.AssetsUnlockedEvent) {
 	s.attestationFinalityChecksMutex.Lock()
 	defer s.attestationFinalityChecksMutex.Unlock()
@@ -1175,6 +1644,7 @@ func (s *Server) queueAttestationFinalityCheck(attestation *bridgetypes.AssetsUn
 	}
 
 	s.attestationFinalityChecks[check.key()] = check
+	s.persistAttestationFinalityCheck(check)
 
 	s.logger.Info(
 		"queued attestation finality check",
@@ -1213,6 +1683,8 @@ func (s *Server) processAttestationFinalityChecks(ctx context.Context) {
 					//nolint:gosec
 					check.scheduledAtHeight = big.NewInt(int64(height))
 
+					s.persistAttestationFinalityCheck(check)
+
 					checkLogger.Info(
 						"attestation finality check scheduled",
 						"scheduled_at_height", check.scheduledAtHeight.String(),
@@ -1270,6 +1742,16 @@ func (s *Server) processAttestationFinalityChecks(ctx context.Context) {
 				s.attestationFinalityChecksMutex.Lock()
 				delete(s.attestationFinalityChecks, check.key())
 				s.attestationFinalityChecksMutex.Unlock()
+
+				if s.store != nil {
+					err := s.store.deleteAttestationFinalityCheck(check.UnlockSequence)
+					if err != nil {
+						checkLogger.Error(
+							"failed to delete persisted attestation finality check",
+							"error", err,
+						)
+					}
+				}
 			}
 		case <-ctx.Done():
 			s.logger.Warn(
@@ -1290,6 +1772,69 @@ func (s *Server) Version(
 	}, nil
 }
 
+// Status returns the internal state of the Ethereum sidecar. It is executed
+// by the gRPC server.
+func (s *Server) Status(
+	_ context.Context,
+	_ *pb.StatusRequest,
+) (*pb.StatusResponse, error) {
+	response := &pb.StatusResponse{
+		Version: version.AppVersion,
+		Ready:   s.isReady(),
+		AssetsLockedEvents: pb.AssetsLockedEventsStatus{
+			FirstSequence: sdkmath.ZeroInt(),
+			LastSequence:  sdkmath.ZeroInt(),
+		},
+		Attestation: pb.AttestationStatus{
+			CurrentUnlockSequence: sdkmath.ZeroInt(),
+		},
+	}
+
+	s.lastFinalizedBlockMutex.RLock()
+	if s.lastFinalizedBlock != nil {
+		response.LastFinalizedBlock = s.lastFinalizedBlock.Uint64()
+	}
+	s.lastFinalizedBlockMutex.RUnlock()
+
+	s.assetsLockedEventsMutex.RLock()
+	if count := len(s.assetsLockedEvents); count > 0 {
+		response.AssetsLockedEvents.Count = uint64(count)
+		response.AssetsLockedEvents.FirstSequence = s.assetsLockedEvents[0].Sequence
+		response.AssetsLockedEvents.LastSequence = s.assetsLockedEvents[count-1].Sequence
+	}
+	s.assetsLockedEventsMutex.RUnlock()
+
+	s.attestationMutex.Lock()
+	response.Attestation.BridgeValidatorId = uint32(s.bridgeValidatorID)
+	response.Attestation.QueueLength = uint64(len(s.attestationQueue))
+	if s.currentAttestation != nil {
+		response.Attestation.CurrentUnlockSequence = s.currentAttestation.UnlockSequence
+		response.Attestation.SubmissionPosition = uint32(s.currentSubmissionPosition) //nolint:gosec // G115: position is bounded by the number of bridge validators
+	}
+	s.attestationMutex.Unlock()
+
+	s.attestationFinalityChecksMutex.Lock()
+	response.Attestation.PendingFinalityChecks = uint64(len(s.attestationFinalityChecks))
+	s.attestationFinalityChecksMutex.Unlock()
+
+	if s.batchAttestation != nil {
+		response.BridgeWorker = s.batchAttestation.bridgeWorkerStatus()
+	}
+
+	return response, nil
+}
+
+// isReady returns true once the initial synchronization of the AssetsLocked
+// events is complete and the sidecar can serve them to the Mezo node.
+func (s *Server) isReady() bool {
+	select {
+	case <-s.assetsLockedReady:
+		return true
+	default:
+		return false
+	}
+}
+
 // AssetsLockedEvents returns a list of AssetsLocked events based on the
 // passed request. It is executed by the gRPC server.
 func (s *Server) AssetsLockedEvents(
@@ -1333,6 +1878,90 @@ func (s *Server) AssetsLockedEvents(
 	}, nil
 }
 
+// SubscribeAssetsLockedEvents streams AssetsLocked events with the sequence
+// number starting from the requested one. The events already cached by the
+// server are sent first, then new events are pushed as soon as they are
+// finalized. It is executed by the gRPC server.
+func (s *Server) SubscribeAssetsLockedEvents(
+	req *pb.SubscribeAssetsLockedEventsRequest,
+	stream pb.EthereumSidecar_SubscribeAssetsLockedEventsServer,
+) error {
+	nextSequence := req.SequenceStart
+
+	// The sequence start must be positive.
+	if !nextSequence.IsNil() && !nextSequence.IsPositive() {
+		return fmt.Errorf("invalid non positive sequence start")
+	}
+
+	// The first response is sent even if there are no events yet so the
+	// subscriber knows the stream is established.
+	initial := true
+
+	for {
+		events, updated := s.assetsLockedEventsFrom(nextSequence)
+
+		for initial || len(events) > 0 {
+			batch := events[:min(len(events), streamedEventsLimit)]
+			events = events[len(batch):]
+
+			err := stream.Send(&pb.SubscribeAssetsLockedEventsResponse{
+				Events: batch,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send AssetsLocked events: [%w]", err)
+			}
+
+			if len(batch) > 0 {
+				nextSequence = batch[len(batch)-1].Sequence.Add(sdkmath.OneInt())
+			}
+
+			initial = false
+		}
+
+		select {
+		case <-updated:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// assetsLockedEventsFrom returns the cached AssetsLocked events with the
+// sequence number not lower than the given one, along with the channel
+// closed once new events are cached. A nil sequence means all cached events
+// are returned.
+func (s *Server) assetsLockedEventsFrom(sequence sdkmath.Int) (
+	[]*bridgetypes.AssetsLockedEvent,
+	<-chan struct{},
+) {
+	s.assetsLockedEventsMutex.Lock()
+	defer s.assetsLockedEventsMutex.Unlock()
+
+	if s.assetsLockedEventsUpdated == nil {
+		s.assetsLockedEventsUpdated = make(chan struct{})
+	}
+
+	// Cached events are sorted by the sequence number.
+	first := 0
+	if !sequence.IsNil() {
+		first = sort.Search(len(s.assetsLockedEvents), func(i int) bool {
+			return s.assetsLockedEvents[i].Sequence.GTE(sequence)
+		})
+	}
+
+	events := make([]*bridgetypes.AssetsLockedEvent, 0, len(s.assetsLockedEvents)-first)
+	for _, event := range s.assetsLockedEvents[first:] {
+		events = append(events, &bridgetypes.AssetsLockedEvent{
+			Sequence:  event.Sequence,
+			Recipient: event.Recipient,
+			Token:     event.Token,
+			Amount:    event.Amount,
+		})
+	}
+
+	return events, s.assetsLockedEventsUpdated
+}
+
 // Construct a new instance of the Ethereum MezoBridge contract.
 func initializeBridgeContract(
 	address common.Address,
@@ -18,6 +18,7 @@ import (
 	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
 )
@@ -360,6 +361,208 @@ func TestAssetsLockedEvents(t *testing.T) {
 	assert.Equal(t, "token2", resp.Events[1].Token)
 }
 
+// testAssetsLockedEventsStream is a fake server side of the AssetsLocked
+// events subscription stream.
+type testAssetsLockedEventsStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses chan *pb.SubscribeAssetsLockedEventsResponse
+}
+
+func (tales *testAssetsLockedEventsStream) Context() context.Context {
+	return tales.ctx
+}
+
+func (tales *testAssetsLockedEventsStream) Send(
+	response *pb.SubscribeAssetsLockedEventsResponse,
+) error {
+	tales.responses <- response
+	return nil
+}
+
+func (tales *testAssetsLockedEventsStream) receive(t *testing.T) []int64 {
+	t.Helper()
+
+	select {
+	case response := <-tales.responses:
+		sequences := make([]int64, len(response.Events))
+		for i, event := range response.Events {
+			sequences[i] = event.Sequence.Int64()
+		}
+		return sequences
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response received")
+		return nil
+	}
+}
+
+func TestSubscribeAssetsLockedEvents(t *testing.T) {
+	newEvent := func(sequence int64) bridgetypes.AssetsLockedEvent {
+		return bridgetypes.AssetsLockedEvent{
+			Sequence:  sdkmath.NewInt(sequence),
+			Recipient: "recipient",
+			Amount:    sdkmath.NewInt(100),
+			Token:     "token",
+		}
+	}
+
+	defaultStreamedEventsLimit := streamedEventsLimit
+	streamedEventsLimit = 2
+	t.Cleanup(func() {
+		streamedEventsLimit = defaultStreamedEventsLimit
+	})
+
+	server := &Server{
+		assetsLockedEvents: []bridgetypes.AssetsLockedEvent{
+			newEvent(1),
+			newEvent(2),
+			newEvent(3),
+			newEvent(4),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &testAssetsLockedEventsStream{
+		ctx:       ctx,
+		responses: make(chan *pb.SubscribeAssetsLockedEventsResponse),
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- server.SubscribeAssetsLockedEvents(
+			&pb.SubscribeAssetsLockedEventsRequest{
+				SequenceStart: sdkmath.NewInt(2),
+			},
+			stream,
+		)
+	}()
+
+	// Cached events are sent in batches.
+	require.Equal(t, []int64{2, 3}, stream.receive(t))
+	require.Equal(t, []int64{4}, stream.receive(t))
+
+	// New events are pushed once cached.
+	server.assetsLockedEventsMutex.Lock()
+	server.assetsLockedEvents = append(
+		server.assetsLockedEvents,
+		newEvent(5),
+		newEvent(6),
+	)
+	server.notifyAssetsLockedEventsSubscribers()
+	server.assetsLockedEventsMutex.Unlock()
+
+	require.Equal(t, []int64{5, 6}, stream.receive(t))
+
+	cancel()
+	require.NoError(t, <-result)
+}
+
+func TestSubscribeAssetsLockedEvents_NoEvents(t *testing.T) {
+	server := &Server{
+		assetsLockedEvents: []bridgetypes.AssetsLockedEvent{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &testAssetsLockedEventsStream{
+		ctx:       ctx,
+		responses: make(chan *pb.SubscribeAssetsLockedEventsResponse),
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- server.SubscribeAssetsLockedEvents(
+			&pb.SubscribeAssetsLockedEventsRequest{},
+			stream,
+		)
+	}()
+
+	// An empty response confirms the subscription.
+	require.Empty(t, stream.receive(t))
+
+	cancel()
+	require.NoError(t, <-result)
+
+	err := server.SubscribeAssetsLockedEvents(
+		&pb.SubscribeAssetsLockedEventsRequest{
+			SequenceStart: sdkmath.NewInt(0),
+		},
+		stream,
+	)
+	require.ErrorContains(t, err, "invalid non positive sequence start")
+}
+
+func TestStatus(t *testing.T) {
+	assetsLockedReady := make(chan struct{})
+
+	server := &Server{
+		assetsLockedEvents: []bridgetypes.AssetsLockedEvent{
+			{Sequence: sdkmath.NewInt(5), Recipient: "recipient1", Amount: sdkmath.NewInt(100), Token: "token1"},
+			{Sequence: sdkmath.NewInt(6), Recipient: "recipient2", Amount: sdkmath.NewInt(200), Token: "token2"},
+			{Sequence: sdkmath.NewInt(7), Recipient: "recipient3", Amount: sdkmath.NewInt(300), Token: "token3"},
+		},
+		lastFinalizedBlock: big.NewInt(1000),
+		assetsLockedReady:  assetsLockedReady,
+		bridgeValidatorID:  2,
+		attestationQueue: []bridgetypes.AssetsUnlockedEvent{
+			{UnlockSequence: sdkmath.NewInt(11)},
+			{UnlockSequence: sdkmath.NewInt(12)},
+		},
+		currentAttestation:        &bridgetypes.AssetsUnlockedEvent{UnlockSequence: sdkmath.NewInt(10)},
+		currentSubmissionPosition: 3,
+		attestationFinalityChecks: map[string]*attestationFinalityCheck{
+			"8": {AssetsUnlockedEvent: &bridgetypes.AssetsUnlockedEvent{UnlockSequence: sdkmath.NewInt(8)}},
+			"9": {AssetsUnlockedEvent: &bridgetypes.AssetsUnlockedEvent{UnlockSequence: sdkmath.NewInt(9)}},
+		},
+		batchAttestation: newBatchAttestation(log.NewNopLogger(), nil, nil, nil, nil),
+	}
+
+	resp, err := server.Status(context.Background(), &pb.StatusRequest{})
+	require.NoError(t, err)
+
+	assert.False(t, resp.Ready)
+	assert.Equal(t, uint64(1000), resp.LastFinalizedBlock)
+	assert.Equal(t, uint64(3), resp.AssetsLockedEvents.Count)
+	assert.Equal(t, int64(5), resp.AssetsLockedEvents.FirstSequence.Int64())
+	assert.Equal(t, int64(7), resp.AssetsLockedEvents.LastSequence.Int64())
+	assert.Equal(t, uint32(2), resp.Attestation.BridgeValidatorId)
+	assert.Equal(t, uint64(2), resp.Attestation.QueueLength)
+	assert.Equal(t, uint64(2), resp.Attestation.PendingFinalityChecks)
+	assert.Equal(t, int64(10), resp.Attestation.CurrentUnlockSequence.Int64())
+	assert.Equal(t, uint32(3), resp.Attestation.SubmissionPosition)
+	assert.False(t, resp.BridgeWorker.Configured)
+	assert.False(t, resp.BridgeWorker.Reachable)
+
+	// The sidecar becomes ready once the initial AssetsLocked sync is done.
+	close(assetsLockedReady)
+
+	resp, err = server.Status(context.Background(), &pb.StatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.Ready)
+}
+
+func TestStatus_Empty(t *testing.T) {
+	server := &Server{
+		assetsLockedEvents: []bridgetypes.AssetsLockedEvent{},
+		lastFinalizedBlock: new(big.Int),
+		assetsLockedReady:  make(chan struct{}),
+	}
+
+	resp, err := server.Status(context.Background(), &pb.StatusRequest{})
+	require.NoError(t, err)
+
+	assert.False(t, resp.Ready)
+	assert.Equal(t, uint64(0), resp.AssetsLockedEvents.Count)
+	assert.True(t, resp.AssetsLockedEvents.FirstSequence.IsZero())
+	assert.True(t, resp.AssetsLockedEvents.LastSequence.IsZero())
+	assert.True(t, resp.Attestation.CurrentUnlockSequence.IsZero())
+	assert.Equal(t, uint32(0), resp.Attestation.SubmissionPosition)
+}
+
 func TestFetchRecentAssetsUnlockedEvents(t *testing.T) {
 	// Use a mock function for getting the current time. It always returns
 	// 10000 seconds since Unix epoch.
@@ -1190,3 +1393,116 @@ func TestFindUnconfirmedAssetsUnlockedEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreAssetsLockedEvents(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount(config.Bech32Prefix, "")
+
+	recipient := sdk.AccAddress(
+		common.HexToAddress("0x0A219c03938FBC93aA23cAd65f7c480f52665C2a").Bytes(),
+	).String()
+
+	persistedEvents := []bridgetypes.AssetsLockedEvent{
+		{
+			Sequence:  sdkmath.NewInt(1),
+			Recipient: recipient,
+			Amount:    sdkmath.NewInt(10000),
+			Token:     "0x3A128b915bee3645396d43Fe7A13A59a66C427d6",
+		},
+		{
+			Sequence:  sdkmath.NewInt(2),
+			Recipient: recipient,
+			Amount:    sdkmath.NewInt(20000),
+			Token:     "0x3A128b915bee3645396d43Fe7A13A59a66C427d6",
+		},
+	}
+
+	tests := map[string]struct {
+		persistedEvents      []bridgetypes.AssetsLockedEvent
+		persistedBlock       *big.Int
+		defaultStartBlock    uint64
+		expectedStartBlock   uint64
+		expectedEvents       []bridgetypes.AssetsLockedEvent
+		expectedFinalized    *big.Int
+		expectedPersisted    []bridgetypes.AssetsLockedEvent
+		expectedPersistedEnd *big.Int
+	}{
+		"no persisted state": {
+			persistedEvents:      nil,
+			persistedBlock:       nil,
+			defaultStartBlock:    100,
+			expectedStartBlock:   100,
+			expectedEvents:       []bridgetypes.AssetsLockedEvent{},
+			expectedFinalized:    new(big.Int),
+			expectedPersisted:    []bridgetypes.AssetsLockedEvent{},
+			expectedPersistedEnd: nil,
+		},
+		"persisted state covers the searched range": {
+			persistedEvents:      persistedEvents,
+			persistedBlock:       big.NewInt(150),
+			defaultStartBlock:    100,
+			expectedStartBlock:   151,
+			expectedEvents:       persistedEvents,
+			expectedFinalized:    big.NewInt(150),
+			expectedPersisted:    persistedEvents,
+			expectedPersistedEnd: big.NewInt(150),
+		},
+		"persisted state ends right before the searched range": {
+			persistedEvents:      persistedEvents,
+			persistedBlock:       big.NewInt(99),
+			defaultStartBlock:    100,
+			expectedStartBlock:   100,
+			expectedEvents:       persistedEvents,
+			expectedFinalized:    big.NewInt(99),
+			expectedPersisted:    persistedEvents,
+			expectedPersistedEnd: big.NewInt(99),
+		},
+		"persisted state is outdated": {
+			persistedEvents:      persistedEvents,
+			persistedBlock:       big.NewInt(98),
+			defaultStartBlock:    100,
+			expectedStartBlock:   100,
+			expectedEvents:       []bridgetypes.AssetsLockedEvent{},
+			expectedFinalized:    new(big.Int),
+			expectedPersisted:    []bridgetypes.AssetsLockedEvent{},
+			expectedPersistedEnd: nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			store := newTestStore()
+
+			if test.persistedBlock != nil {
+				err := store.saveAssetsLockedEvents(
+					test.persistedEvents,
+					test.persistedBlock,
+				)
+				require.NoError(t, err)
+			}
+
+			server := &Server{
+				logger:             log.NewNopLogger(),
+				assetsLockedEvents: []bridgetypes.AssetsLockedEvent{},
+				lastFinalizedBlock: new(big.Int),
+				store:              store,
+			}
+
+			startBlock, err := server.restoreAssetsLockedEvents(
+				test.defaultStartBlock,
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedStartBlock, startBlock)
+			assert.Equal(t, test.expectedEvents, server.assetsLockedEvents)
+			assert.Equal(t, test.expectedFinalized, server.lastFinalizedBlock)
+
+			events, err := store.assetsLockedEvents()
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPersisted, events)
+
+			lastFinalizedBlock, err := store.lastFinalizedBlock()
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPersistedEnd, lastFinalizedBlock)
+		})
+	}
+}
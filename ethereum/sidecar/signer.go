@@ -0,0 +1,222 @@
+package sidecar
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
+)
+
+// remoteSignerRequestTimeout is the timeout of a single request to the
+// remote signer.
+var remoteSignerRequestTimeout = 10 * time.Second
+
+// Signer signs digests with the Ethereum key of the bridge validator
+// represented by the sidecar.
+type Signer interface {
+	// Address returns the Ethereum address of the signing key.
+	Address() common.Address
+	// SignDigest signs the given 32-byte digest and returns the 65-byte
+	// signature in the [R || S || V] format, where V is 0 or 1.
+	SignDigest(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+// LocalSigner is a Signer using a private key held in memory.
+type LocalSigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+func NewLocalSigner(privateKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+func (ls *LocalSigner) Address() common.Address {
+	return ls.address
+}
+
+func (ls *LocalSigner) SignDigest(_ context.Context, digest []byte) ([]byte, error) {
+	return crypto.Sign(digest, ls.privateKey)
+}
+
+// RemoteSigner is a Signer delegating signing to a remote signer service
+// holding the key, so the key never needs to be present on the sidecar
+// host. Signatures returned by the service are verified against the public
+// key of the signing key before use.
+type RemoteSigner struct {
+	connection *grpc.ClientConn
+	client     pb.RemoteSignerClient
+	keyID      string
+	address    common.Address
+}
+
+// NewRemoteSigner connects to the remote signer service with the given
+// address and resolves the public key of the signing key with the given ID.
+// If the CA certificate file is given, the connection is secured with TLS
+// using it to verify the service certificate.
+func NewRemoteSigner(
+	ctx context.Context,
+	serverAddress string,
+	keyID string,
+	tlsCAFile string,
+	registry codectypes.InterfaceRegistry,
+) (*RemoteSigner, error) {
+	transportCredentials := insecure.NewCredentials()
+	if tlsCAFile != "" {
+		var err error
+		transportCredentials, err = credentials.NewClientTLSFromFile(tlsCAFile, "")
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to load remote signer CA certificate: [%w]",
+				err,
+			)
+		}
+	}
+
+	connection, err := grpc.NewClient(
+		serverAddress,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(registry).GRPCCodec()),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to create grpc client for remote signer: [%w]",
+			err,
+		)
+	}
+
+	rs := &RemoteSigner{
+		connection: connection,
+		client:     pb.NewRemoteSignerClient(connection),
+		keyID:      keyID,
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, remoteSignerRequestTimeout)
+	defer cancel()
+
+	response, err := rs.client.PublicKey(
+		ctxWithTimeout,
+		&pb.PublicKeyRequest{KeyId: keyID},
+	)
+	if err != nil {
+		_ = connection.Close()
+		return nil, fmt.Errorf(
+			"failed to get public key from remote signer: [%w]",
+			err,
+		)
+	}
+
+	publicKey, err := unmarshalPublicKey(response.PublicKey)
+	if err != nil {
+		_ = connection.Close()
+		return nil, fmt.Errorf(
+			"remote signer returned invalid public key: [%w]",
+			err,
+		)
+	}
+
+	rs.address = crypto.PubkeyToAddress(*publicKey)
+
+	return rs, nil
+}
+
+func (rs *RemoteSigner) Address() common.Address {
+	return rs.address
+}
+
+func (rs *RemoteSigner) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	if len(digest) != common.HashLength {
+		return nil, fmt.Errorf(
+			"digest must be %d bytes long, got %d",
+			common.HashLength,
+			len(digest),
+		)
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, remoteSignerRequestTimeout)
+	defer cancel()
+
+	response, err := rs.client.SignDigest(
+		ctxWithTimeout,
+		&pb.SignDigestRequest{
+			KeyId:  rs.keyID,
+			Digest: digest,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer failed to sign digest: [%w]", err)
+	}
+
+	signature := common.CopyBytes(response.Signature)
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf(
+			"remote signer returned signature of %d bytes",
+			len(signature),
+		)
+	}
+
+	// Accept the Ethereum-style recovery identifier as well.
+	if signature[crypto.RecoveryIDOffset] >= 27 {
+		signature[crypto.RecoveryIDOffset] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"remote signer returned invalid signature: [%w]",
+			err,
+		)
+	}
+
+	if signer := crypto.PubkeyToAddress(*publicKey); signer != rs.address {
+		return nil, fmt.Errorf(
+			"remote signer returned signature of %s instead of %s",
+			signer.Hex(),
+			rs.address.Hex(),
+		)
+	}
+
+	return signature, nil
+}
+
+func (rs *RemoteSigner) Close() error {
+	return rs.connection.Close()
+}
+
+// chainPrivateKey returns the key of the Ethereum chain handle. The chain
+// handle does not sign attestation transactions, they are signed by the
+// Signer through the transaction manager, but it requires a key anyway. The
+// key of a local signer is used; other signers do not expose their key so a
+// throwaway key is generated instead.
+func chainPrivateKey(signer Signer) (*ecdsa.PrivateKey, error) {
+	if localSigner, ok := signer.(*LocalSigner); ok {
+		return localSigner.privateKey, nil
+	}
+
+	return crypto.GenerateKey()
+}
+
+// unmarshalPublicKey parses a secp256k1 public key in the compressed or
+// uncompressed form.
+func unmarshalPublicKey(publicKey []byte) (*ecdsa.PublicKey, error) {
+	if len(publicKey) == 33 {
+		return crypto.DecompressPubkey(publicKey)
+	}
+
+	return crypto.UnmarshalPubkey(publicKey)
+}
@@ -0,0 +1,210 @@
+package sidecar
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/mezo-org/mezod/ethereum/sidecar/types"
+)
+
+const testRemoteSignerKeyID = "bridge-validator"
+
+// testRemoteSignerServer is a mock remote signer service holding a single
+// key in memory.
+type testRemoteSignerServer struct {
+	pb.UnimplementedRemoteSignerServer
+
+	privateKey *ecdsa.PrivateKey
+	// signingKey, if set, is used instead of privateKey to sign digests.
+	signingKey *ecdsa.PrivateKey
+	// compressedPublicKey makes the server return the compressed public key.
+	compressedPublicKey bool
+	// ethereumRecoveryID makes the server return signatures with V of 27
+	// or 28.
+	ethereumRecoveryID bool
+}
+
+func (trss *testRemoteSignerServer) PublicKey(
+	_ context.Context,
+	request *pb.PublicKeyRequest,
+) (*pb.PublicKeyResponse, error) {
+	if request.KeyId != testRemoteSignerKeyID {
+		return nil, status.Errorf(codes.NotFound, "unknown key %s", request.KeyId)
+	}
+
+	publicKey := crypto.FromECDSAPub(&trss.privateKey.PublicKey)
+	if trss.compressedPublicKey {
+		publicKey = crypto.CompressPubkey(&trss.privateKey.PublicKey)
+	}
+
+	return &pb.PublicKeyResponse{PublicKey: publicKey}, nil
+}
+
+func (trss *testRemoteSignerServer) SignDigest(
+	_ context.Context,
+	request *pb.SignDigestRequest,
+) (*pb.SignDigestResponse, error) {
+	if request.KeyId != testRemoteSignerKeyID {
+		return nil, status.Errorf(codes.NotFound, "unknown key %s", request.KeyId)
+	}
+
+	signingKey := trss.privateKey
+	if trss.signingKey != nil {
+		signingKey = trss.signingKey
+	}
+
+	signature, err := crypto.Sign(request.Digest, signingKey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if trss.ethereumRecoveryID {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	return &pb.SignDigestResponse{Signature: signature}, nil
+}
+
+// startTestRemoteSignerServer serves the given mock remote signer on a local
+// port and returns its address.
+func startTestRemoteSignerServer(
+	t *testing.T,
+	server *testRemoteSignerServer,
+) (string, codectypes.InterfaceRegistry) {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(
+		grpc.ForceServerCodec(codec.NewProtoCodec(registry).GRPCCodec()),
+	)
+	pb.RegisterRemoteSignerServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String(), registry
+}
+
+func TestRemoteSigner(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	digest := crypto.Keccak256([]byte("attestation"))
+
+	tests := map[string]struct {
+		server      *testRemoteSignerServer
+		keyID       string
+		digest      []byte
+		expectedErr string
+	}{
+		"uncompressed public key": {
+			server: &testRemoteSignerServer{privateKey: privateKey},
+			keyID:  testRemoteSignerKeyID,
+			digest: digest,
+		},
+		"compressed public key": {
+			server: &testRemoteSignerServer{
+				privateKey:          privateKey,
+				compressedPublicKey: true,
+			},
+			keyID:  testRemoteSignerKeyID,
+			digest: digest,
+		},
+		"ethereum recovery identifier": {
+			server: &testRemoteSignerServer{
+				privateKey:         privateKey,
+				ethereumRecoveryID: true,
+			},
+			keyID:  testRemoteSignerKeyID,
+			digest: digest,
+		},
+		"signature of another key": {
+			server: &testRemoteSignerServer{
+				privateKey: privateKey,
+				signingKey: otherKey,
+			},
+			keyID:       testRemoteSignerKeyID,
+			digest:      digest,
+			expectedErr: "remote signer returned signature of " + crypto.PubkeyToAddress(otherKey.PublicKey).Hex(),
+		},
+		"invalid digest length": {
+			server:      &testRemoteSignerServer{privateKey: privateKey},
+			keyID:       testRemoteSignerKeyID,
+			digest:      digest[:31],
+			expectedErr: "digest must be 32 bytes long, got 31",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			address, registry := startTestRemoteSignerServer(t, test.server)
+
+			signer, err := NewRemoteSigner(
+				context.Background(),
+				address,
+				test.keyID,
+				"",
+				registry,
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = signer.Close()
+			})
+
+			require.Equal(
+				t,
+				crypto.PubkeyToAddress(privateKey.PublicKey),
+				signer.Address(),
+			)
+
+			signature, err := signer.SignDigest(context.Background(), test.digest)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedSignature, err := crypto.Sign(test.digest, privateKey)
+			require.NoError(t, err)
+			require.Equal(t, expectedSignature, signature)
+		})
+	}
+}
+
+func TestRemoteSigner_UnknownKey(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	address, registry := startTestRemoteSignerServer(
+		t,
+		&testRemoteSignerServer{privateKey: privateKey},
+	)
+
+	_, err = NewRemoteSigner(
+		context.Background(),
+		address,
+		"unknown",
+		"",
+		registry,
+	)
+	require.ErrorContains(t, err, "failed to get public key from remote signer")
+}
@@ -0,0 +1,367 @@
+package sidecar
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+)
+
+const (
+	// keyPrefixAssetsLockedEvent is the prefix of keys holding fetched
+	// AssetsLocked events, keyed by their sequence.
+	keyPrefixAssetsLockedEvent = byte(0x01)
+
+	// keyPrefixPendingAttestation is the prefix of keys holding
+	// AssetsUnlocked events waiting for attestation, keyed by their unlock
+	// sequence.
+	keyPrefixPendingAttestation = byte(0x02)
+
+	// keyPrefixAttestationFinalityCheck is the prefix of keys holding
+	// attestation finality checks, keyed by their unlock sequence.
+	keyPrefixAttestationFinalityCheck = byte(0x03)
+
+	// keyLastFinalizedBlock is the key holding the last finalized Ethereum
+	// block processed by the AssetsLocked observation routine.
+	keyLastFinalizedBlock = byte(0x10)
+
+	// keyLightClientCheckpoint is the key holding the root of the latest
+	// finalized beacon chain block verified by the Ethereum light client.
+	keyLightClientCheckpoint = byte(0x11)
+)
+
+// store persists the state of the sidecar server so it can resume
+// incrementally after a restart instead of re-scanning the entire searched
+// range of Ethereum blocks. It holds the fetched AssetsLocked events along
+// with the last finalized block they were fetched up to, and the pending
+// attestation state of AssetsUnlocked events.
+type store struct {
+	db dbm.DB
+}
+
+func newStore(db dbm.DB) *store {
+	return &store{db: db}
+}
+
+// close closes the underlying database.
+func (s *store) close() error {
+	return s.db.Close()
+}
+
+// sequenceKey returns the key of an entry with the given prefix and sequence.
+// The sequence is left-padded to 32 bytes so the lexicographic order of keys
+// is the numeric order of sequences.
+func sequenceKey(prefix byte, sequence sdkmath.Int) []byte {
+	return append([]byte{prefix}, common.LeftPadBytes(sequence.BigInt().Bytes(), 32)...)
+}
+
+// prefixEnd returns the exclusive upper bound of keys with the given prefix.
+func prefixEnd(prefix byte) []byte {
+	return []byte{prefix + 1}
+}
+
+// lastFinalizedBlock returns the last finalized block processed by the
+// AssetsLocked observation routine. It returns nil if no block was processed
+// yet.
+func (s *store) lastFinalizedBlock() (*big.Int, error) {
+	bz, err := s.db.Get([]byte{keyLastFinalizedBlock})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last finalized block: [%w]", err)
+	}
+
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	return new(big.Int).SetBytes(bz), nil
+}
+
+// assetsLockedEvents returns all stored AssetsLocked events in ascending
+// order of their sequences.
+func (s *store) assetsLockedEvents() ([]bridgetypes.AssetsLockedEvent, error) {
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixAssetsLockedEvent},
+		prefixEnd(keyPrefixAssetsLockedEvent),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate AssetsLocked events: [%w]", err)
+	}
+	defer iterator.Close()
+
+	events := make([]bridgetypes.AssetsLockedEvent, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var event bridgetypes.AssetsLockedEvent
+		if err := event.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal AssetsLocked event: [%w]", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, iterator.Error()
+}
+
+// saveAssetsLockedEvents atomically stores the given AssetsLocked events and
+// the finalized block they were fetched up to.
+func (s *store) saveAssetsLockedEvents(
+	events []bridgetypes.AssetsLockedEvent,
+	finalizedBlock *big.Int,
+) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, event := range events {
+		bz, err := event.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal AssetsLocked event: [%w]", err)
+		}
+
+		err = batch.Set(sequenceKey(keyPrefixAssetsLockedEvent, event.Sequence), bz)
+		if err != nil {
+			return fmt.Errorf("failed to store AssetsLocked event: [%w]", err)
+		}
+	}
+
+	err := batch.Set([]byte{keyLastFinalizedBlock}, finalizedBlock.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to store last finalized block: [%w]", err)
+	}
+
+	return batch.WriteSync()
+}
+
+// pruneAssetsLockedEvents removes stored AssetsLocked events whose sequences
+// are lower than the given sequence.
+func (s *store) pruneAssetsLockedEvents(sequenceEnd sdkmath.Int) error {
+	return s.deleteRange(
+		[]byte{keyPrefixAssetsLockedEvent},
+		sequenceKey(keyPrefixAssetsLockedEvent, sequenceEnd),
+	)
+}
+
+// resetAssetsLockedEvents removes all stored AssetsLocked events along with
+// the last finalized block.
+func (s *store) resetAssetsLockedEvents() error {
+	err := s.deleteRange(
+		[]byte{keyPrefixAssetsLockedEvent},
+		prefixEnd(keyPrefixAssetsLockedEvent),
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.db.DeleteSync([]byte{keyLastFinalizedBlock})
+}
+
+// lightClientCheckpoint returns the root of the latest finalized beacon chain
+// block verified by the Ethereum light client. It returns nil if no block was
+// verified yet.
+func (s *store) lightClientCheckpoint() (*common.Hash, error) {
+	bz, err := s.db.Get([]byte{keyLightClientCheckpoint})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get light client checkpoint: [%w]", err)
+	}
+
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	checkpoint := common.BytesToHash(bz)
+	return &checkpoint, nil
+}
+
+// saveLightClientCheckpoint stores the root of the latest finalized beacon
+// chain block verified by the Ethereum light client.
+func (s *store) saveLightClientCheckpoint(checkpoint common.Hash) error {
+	err := s.db.SetSync([]byte{keyLightClientCheckpoint}, checkpoint.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to store light client checkpoint: [%w]", err)
+	}
+
+	return nil
+}
+
+// pendingAttestations returns all stored AssetsUnlocked events waiting for
+// attestation in ascending order of their unlock sequences.
+func (s *store) pendingAttestations() ([]bridgetypes.AssetsUnlockedEvent, error) {
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixPendingAttestation},
+		prefixEnd(keyPrefixPendingAttestation),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate pending attestations: [%w]", err)
+	}
+	defer iterator.Close()
+
+	attestations := make([]bridgetypes.AssetsUnlockedEvent, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation bridgetypes.AssetsUnlockedEvent
+		if err := attestation.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pending attestation: [%w]", err)
+		}
+
+		attestations = append(attestations, attestation)
+	}
+
+	return attestations, iterator.Error()
+}
+
+// savePendingAttestations stores the given AssetsUnlocked events as waiting
+// for attestation.
+func (s *store) savePendingAttestations(
+	attestations ...bridgetypes.AssetsUnlockedEvent,
+) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, attestation := range attestations {
+		bz, err := attestation.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal pending attestation: [%w]", err)
+		}
+
+		err = batch.Set(
+			sequenceKey(keyPrefixPendingAttestation, attestation.UnlockSequence),
+			bz,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to store pending attestation: [%w]", err)
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// deletePendingAttestation removes the AssetsUnlocked event with the given
+// unlock sequence from the events waiting for attestation.
+func (s *store) deletePendingAttestation(unlockSequence sdkmath.Int) error {
+	return s.db.DeleteSync(sequenceKey(keyPrefixPendingAttestation, unlockSequence))
+}
+
+// attestationFinalityChecks returns all stored attestation finality checks
+// in ascending order of their unlock sequences.
+func (s *store) attestationFinalityChecks() ([]*attestationFinalityCheck, error) {
+	iterator, err := s.db.Iterator(
+		[]byte{keyPrefixAttestationFinalityCheck},
+		prefixEnd(keyPrefixAttestationFinalityCheck),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate attestation finality checks: [%w]", err)
+	}
+	defer iterator.Close()
+
+	checks := make([]*attestationFinalityCheck, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		check, err := unmarshalAttestationFinalityCheck(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks, iterator.Error()
+}
+
+// saveAttestationFinalityCheck stores the given attestation finality check.
+// The attested AssetsUnlocked event is no longer waiting for attestation so
+// it is atomically removed from pending attestations.
+func (s *store) saveAttestationFinalityCheck(check *attestationFinalityCheck) error {
+	bz, err := marshalAttestationFinalityCheck(check)
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Delete(sequenceKey(keyPrefixPendingAttestation, check.UnlockSequence))
+	if err != nil {
+		return fmt.Errorf("failed to delete pending attestation: [%w]", err)
+	}
+
+	err = batch.Set(sequenceKey(keyPrefixAttestationFinalityCheck, check.UnlockSequence), bz)
+	if err != nil {
+		return fmt.Errorf("failed to store attestation finality check: [%w]", err)
+	}
+
+	return batch.WriteSync()
+}
+
+// deleteAttestationFinalityCheck removes the attestation finality check with
+// the given unlock sequence.
+func (s *store) deleteAttestationFinalityCheck(unlockSequence sdkmath.Int) error {
+	return s.db.DeleteSync(sequenceKey(keyPrefixAttestationFinalityCheck, unlockSequence))
+}
+
+// deleteRange removes all entries with keys in the range [start, end).
+func (s *store) deleteRange(start, end []byte) error {
+	iterator, err := s.db.Iterator(start, end)
+	if err != nil {
+		return fmt.Errorf("failed to iterate entries: [%w]", err)
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	if err := iterator.Close(); err != nil {
+		return fmt.Errorf("failed to close iterator: [%w]", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete entry: [%w]", err)
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// marshalAttestationFinalityCheck encodes the attestation finality check as
+// the 8-byte big-endian height the check was scheduled at (zero if
+// unscheduled) followed by the encoded AssetsUnlocked event.
+func marshalAttestationFinalityCheck(check *attestationFinalityCheck) ([]byte, error) {
+	event, err := check.AssetsUnlockedEvent.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attestation finality check: [%w]", err)
+	}
+
+	scheduledAtHeight := uint64(0)
+	if check.scheduledAtHeight != nil {
+		scheduledAtHeight = check.scheduledAtHeight.Uint64()
+	}
+
+	return append(sdk.Uint64ToBigEndian(scheduledAtHeight), event...), nil
+}
+
+// unmarshalAttestationFinalityCheck decodes an attestation finality check
+// encoded with marshalAttestationFinalityCheck.
+func unmarshalAttestationFinalityCheck(bz []byte) (*attestationFinalityCheck, error) {
+	if len(bz) < 8 {
+		return nil, fmt.Errorf("invalid attestation finality check length: %d", len(bz))
+	}
+
+	event := &bridgetypes.AssetsUnlockedEvent{}
+	if err := event.Unmarshal(bz[8:]); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attestation finality check: [%w]", err)
+	}
+
+	check := &attestationFinalityCheck{
+		AssetsUnlockedEvent: event,
+	}
+
+	if scheduledAtHeight := sdk.BigEndianToUint64(bz[:8]); scheduledAtHeight > 0 {
+		check.scheduledAtHeight = new(big.Int).SetUint64(scheduledAtHeight)
+	}
+
+	return check, nil
+}
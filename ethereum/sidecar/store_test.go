@@ -0,0 +1,210 @@
+package sidecar
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+)
+
+func newTestStore() *store {
+	return newStore(dbm.NewMemDB())
+}
+
+func testAssetsLockedEvent(sequence int64) bridgetypes.AssetsLockedEvent {
+	return bridgetypes.AssetsLockedEvent{
+		Sequence:  sdkmath.NewInt(sequence),
+		Recipient: "mezo1wengafav9m5yht926qmx4gr3d3rhxk50a5rzk8",
+		Token:     "0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+		Amount:    sdkmath.NewInt(sequence * 100),
+	}
+}
+
+func testAssetsUnlockedEvent(unlockSequence int64) bridgetypes.AssetsUnlockedEvent {
+	return bridgetypes.AssetsUnlockedEvent{
+		UnlockSequence: sdkmath.NewInt(unlockSequence),
+		Recipient:      []byte{0x01, 0x02, 0x03},
+		Token:          "0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+		Sender:         "mezo1wengafav9m5yht926qmx4gr3d3rhxk50a5rzk8",
+		Amount:         sdkmath.NewInt(unlockSequence * 100),
+		Chain:          0,
+		BlockTime:      1000,
+		Fee:            sdkmath.ZeroInt(),
+	}
+}
+
+func TestStoreAssetsLockedEvents(t *testing.T) {
+	s := newTestStore()
+
+	lastFinalizedBlock, err := s.lastFinalizedBlock()
+	require.NoError(t, err)
+	require.Nil(t, lastFinalizedBlock)
+
+	events, err := s.assetsLockedEvents()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// Save events out of order to make sure they are returned in the
+	// ascending order of their sequences, including sequences whose byte
+	// length differ.
+	err = s.saveAssetsLockedEvents(
+		[]bridgetypes.AssetsLockedEvent{
+			testAssetsLockedEvent(256),
+			testAssetsLockedEvent(1),
+		},
+		big.NewInt(100),
+	)
+	require.NoError(t, err)
+
+	err = s.saveAssetsLockedEvents(
+		[]bridgetypes.AssetsLockedEvent{testAssetsLockedEvent(2)},
+		big.NewInt(200),
+	)
+	require.NoError(t, err)
+
+	events, err = s.assetsLockedEvents()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.AssetsLockedEvent{
+			testAssetsLockedEvent(1),
+			testAssetsLockedEvent(2),
+			testAssetsLockedEvent(256),
+		},
+		events,
+	)
+
+	lastFinalizedBlock, err = s.lastFinalizedBlock()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(200), lastFinalizedBlock)
+
+	// Saving no events updates the last finalized block only.
+	err = s.saveAssetsLockedEvents(nil, big.NewInt(300))
+	require.NoError(t, err)
+
+	lastFinalizedBlock, err = s.lastFinalizedBlock()
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(300), lastFinalizedBlock)
+
+	err = s.pruneAssetsLockedEvents(sdkmath.NewInt(2))
+	require.NoError(t, err)
+
+	events, err = s.assetsLockedEvents()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.AssetsLockedEvent{
+			testAssetsLockedEvent(2),
+			testAssetsLockedEvent(256),
+		},
+		events,
+	)
+
+	err = s.resetAssetsLockedEvents()
+	require.NoError(t, err)
+
+	events, err = s.assetsLockedEvents()
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	lastFinalizedBlock, err = s.lastFinalizedBlock()
+	require.NoError(t, err)
+	require.Nil(t, lastFinalizedBlock)
+}
+
+func TestStorePendingAttestations(t *testing.T) {
+	s := newTestStore()
+
+	err := s.savePendingAttestations(
+		testAssetsUnlockedEvent(3),
+		testAssetsUnlockedEvent(1),
+		testAssetsUnlockedEvent(2),
+	)
+	require.NoError(t, err)
+
+	err = s.deletePendingAttestation(sdkmath.NewInt(2))
+	require.NoError(t, err)
+
+	attestations, err := s.pendingAttestations()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.AssetsUnlockedEvent{
+			testAssetsUnlockedEvent(1),
+			testAssetsUnlockedEvent(3),
+		},
+		attestations,
+	)
+}
+
+func TestStoreAttestationFinalityChecks(t *testing.T) {
+	s := newTestStore()
+
+	event1 := testAssetsUnlockedEvent(1)
+	event2 := testAssetsUnlockedEvent(2)
+
+	err := s.savePendingAttestations(event1, event2)
+	require.NoError(t, err)
+
+	unscheduledCheck := &attestationFinalityCheck{
+		AssetsUnlockedEvent: &event1,
+		scheduledAtHeight:   nil,
+	}
+	scheduledCheck := &attestationFinalityCheck{
+		AssetsUnlockedEvent: &event2,
+		scheduledAtHeight:   big.NewInt(500),
+	}
+
+	require.NoError(t, s.saveAttestationFinalityCheck(unscheduledCheck))
+	require.NoError(t, s.saveAttestationFinalityCheck(scheduledCheck))
+
+	// Saving a finality check removes the corresponding pending attestation.
+	attestations, err := s.pendingAttestations()
+	require.NoError(t, err)
+	require.Empty(t, attestations)
+
+	checks, err := s.attestationFinalityChecks()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]*attestationFinalityCheck{unscheduledCheck, scheduledCheck},
+		checks,
+	)
+
+	err = s.deleteAttestationFinalityCheck(sdkmath.NewInt(1))
+	require.NoError(t, err)
+
+	checks, err = s.attestationFinalityChecks()
+	require.NoError(t, err)
+	require.Equal(t, []*attestationFinalityCheck{scheduledCheck}, checks)
+}
+
+func TestStoreLightClientCheckpoint(t *testing.T) {
+	s := newTestStore()
+
+	checkpoint, err := s.lightClientCheckpoint()
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	err = s.saveLightClientCheckpoint(common.HexToHash("0x01"))
+	require.NoError(t, err)
+
+	err = s.saveLightClientCheckpoint(common.HexToHash("0x02"))
+	require.NoError(t, err)
+
+	checkpoint, err = s.lightClientCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x02"), *checkpoint)
+
+	// Resetting AssetsLocked events does not affect the checkpoint.
+	require.NoError(t, s.resetAssetsLockedEvents())
+
+	checkpoint, err = s.lightClientCheckpoint()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x02"), *checkpoint)
+}
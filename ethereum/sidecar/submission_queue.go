@@ -36,17 +36,24 @@ func newSubmissionQueue(
 }
 
 func (s *submissionQueue) GetSubmissionDelay(attestation *portal.MezoBridgeAssetsUnlocked) time.Duration {
+	return submissionDelay(s.GetSubmissionPosition(attestation))
+}
+
+// GetSubmissionPosition returns the 1-based position of this validator in the
+// individual submission queue of the given attestation. Zero is returned if
+// the position cannot be determined or the validator is not in the queue.
+func (s *submissionQueue) GetSubmissionPosition(attestation *portal.MezoBridgeAssetsUnlocked) int {
 	queue, err := s.calculateSubmissionQueue(attestation.UnlockSequenceNumber)
 	if err != nil {
-		return time.Duration(0)
+		return 0
 	}
 
 	myValidatorID, err := s.bridgeContract.ValidatorIDs(s.address)
 	if err != nil {
-		return time.Duration(0)
+		return 0
 	}
 
-	return s.calculateSubmissionDelay(queue, myValidatorID)
+	return s.calculateSubmissionPosition(queue, myValidatorID)
 }
 
 func (s *submissionQueue) calculateSubmissionQueue(sequenceNumber *big.Int) ([]uint8, error) {
@@ -86,12 +93,23 @@ func (s *submissionQueue) shuffleValidatorIDs(validatorIDs []uint8, seed int64)
 	return cpy
 }
 
-func (s *submissionQueue) calculateSubmissionDelay(queue []uint8, myValidatorID uint8) time.Duration {
+func (s *submissionQueue) calculateSubmissionPosition(queue []uint8, myValidatorID uint8) int {
 	for i, validatorID := range queue {
 		if validatorID == myValidatorID {
-			return time.Duration(i) * defaultSubmissionDelay
+			return i + 1
 		}
 	}
 
 	return 0
 }
+
+// submissionDelay returns the delay before the individual submission of the
+// validator at the given 1-based position in the submission queue. There is
+// no delay if the position is unknown.
+func submissionDelay(position int) time.Duration {
+	if position <= 0 {
+		return 0
+	}
+
+	return time.Duration(position-1) * defaultSubmissionDelay
+}
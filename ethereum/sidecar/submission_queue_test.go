@@ -172,6 +172,42 @@ func TestSubmissionQueue_GetSubmissionDelay(t *testing.T) {
 	}
 }
 
+func TestSubmissionQueue_calculateSubmissionPosition(t *testing.T) {
+	tests := map[string]struct {
+		validatorID      uint8
+		expectedPosition int
+		expectedDelay    time.Duration
+	}{
+		"first in queue": {
+			validatorID:      3,
+			expectedPosition: 1,
+			expectedDelay:    0,
+		},
+		"last in queue": {
+			validatorID:      2,
+			expectedPosition: 3,
+			expectedDelay:    2 * defaultSubmissionDelay,
+		},
+		"not in queue": {
+			validatorID:      5,
+			expectedPosition: 0,
+			expectedDelay:    0,
+		},
+	}
+
+	queue := []uint8{3, 1, 2}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			tsq := newTestSubmissionQueue(t)
+
+			position := tsq.calculateSubmissionPosition(queue, test.validatorID)
+			assert.Equal(t, test.expectedPosition, position)
+			assert.Equal(t, test.expectedDelay, submissionDelay(position))
+		})
+	}
+}
+
 func TestSubmissionQueue_shuffleValidatorIDs(t *testing.T) {
 	tsq := newTestSubmissionQueue(t)
 
@@ -0,0 +1,718 @@
+package sidecar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/keep-network/keep-common/pkg/chain/ethereum/ethutil"
+
+	ethconnect "github.com/mezo-org/mezod/ethereum"
+	"github.com/mezo-org/mezod/ethereum/bindings/portal"
+)
+
+const (
+	// feeHistoryBlocks is the number of the latest blocks whose priority
+	// fees determine the priority fee of attestation transactions.
+	feeHistoryBlocks = 20
+	// replacementFeeBumpPercent is the percentage by which the fees of a
+	// replaced transaction are bumped.
+	replacementFeeBumpPercent = 20
+	// minReplacementFeeBumpPercent is the minimum fee bump Ethereum nodes
+	// accept for a transaction replacing a pending one.
+	minReplacementFeeBumpPercent = 10
+)
+
+// minPriorityFee is the minimum priority fee of attestation transactions,
+// equal to the default minimum accepted by go-ethereum miners. It also
+// ensures bumping the fees of a replaced transaction always raises them.
+var minPriorityFee = big.NewInt(params.GWei / 1000)
+
+// FeePolicy determines the EIP-1559 fees of attestation transactions
+// submitted to Ethereum and when they are replaced.
+type FeePolicy struct {
+	// MaxFeeCap is the maximum fee per gas, including the base fee,
+	// attestation transactions can pay. Replacement transactions are never
+	// bumped above it.
+	MaxFeeCap *big.Int
+	// MaxPriorityFee is the maximum priority fee per gas of new attestation
+	// transactions.
+	MaxPriorityFee *big.Int
+	// PriorityFeePercentile is the percentile of priority fees paid in the
+	// latest blocks used as the priority fee of new attestation transactions.
+	PriorityFeePercentile float64
+	// ReplacementBlocks is the number of blocks after which an attestation
+	// transaction that was not mined is replaced with a transaction paying
+	// higher fees.
+	ReplacementBlocks uint64
+}
+
+// Validate checks the fee policy is usable.
+func (fp FeePolicy) Validate() error {
+	if fp.MaxFeeCap == nil || fp.MaxFeeCap.Sign() <= 0 {
+		return fmt.Errorf("max fee cap must be positive")
+	}
+
+	if fp.MaxPriorityFee == nil || fp.MaxPriorityFee.Sign() <= 0 {
+		return fmt.Errorf("max priority fee must be positive")
+	}
+
+	if fp.PriorityFeePercentile < 0 || fp.PriorityFeePercentile > 100 {
+		return fmt.Errorf(
+			"priority fee percentile must be within [0, 100], got %v",
+			fp.PriorityFeePercentile,
+		)
+	}
+
+	if fp.ReplacementBlocks == 0 {
+		return fmt.Errorf("replacement blocks must be positive")
+	}
+
+	return nil
+}
+
+// fees returns the priority fee and the fee cap per gas of a new transaction.
+// The priority fee is the median of priority fees paid at the policy
+// percentile in the latest blocks. The fee cap allows the base fee to double
+// before the transaction stops being includable.
+func (fp FeePolicy) fees(
+	ctx context.Context,
+	backend transactionBackend,
+) (*big.Int, *big.Int, error) {
+	feeHistory, err := backend.FeeHistory(
+		ctx,
+		feeHistoryBlocks,
+		[]float64{fp.PriorityFeePercentile},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(feeHistory.BaseFee) == 0 {
+		return nil, nil, fmt.Errorf("fee history contains no base fees")
+	}
+
+	// The last base fee is the base fee of the next block.
+	baseFee := feeHistory.BaseFee[len(feeHistory.BaseFee)-1]
+
+	priorityFees := make([]*big.Int, 0, len(feeHistory.Reward))
+	for _, rewards := range feeHistory.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			priorityFees = append(priorityFees, rewards[0])
+		}
+	}
+
+	gasTipCap := new(big.Int).Set(minPriorityFee)
+	if len(priorityFees) > 0 {
+		sort.Slice(priorityFees, func(i, j int) bool {
+			return priorityFees[i].Cmp(priorityFees[j]) < 0
+		})
+
+		gasTipCap = maxBigInt(gasTipCap, priorityFees[len(priorityFees)/2])
+	}
+	gasTipCap = minBigInt(gasTipCap, fp.MaxPriorityFee)
+
+	gasFeeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+	gasFeeCap = minBigInt(gasFeeCap, fp.MaxFeeCap)
+
+	return minBigInt(gasTipCap, gasFeeCap), gasFeeCap, nil
+}
+
+// transactionBackend is the Ethereum client used to submit and track
+// attestation transactions.
+type transactionBackend interface {
+	FeeHistory(
+		ctx context.Context,
+		blockCount uint64,
+		rewardPercentiles []float64,
+	) (*ethereum.FeeHistory, error)
+	NonceAt(ctx context.Context, account common.Address) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SendTransaction(ctx context.Context, transaction *types.Transaction) error
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// chainTransactionBackend is a transactionBackend using the given Ethereum
+// chain handle.
+type chainTransactionBackend struct {
+	ethutil.EthereumClient
+
+	chain *ethconnect.BaseChain
+}
+
+func newChainTransactionBackend(chain *ethconnect.BaseChain) *chainTransactionBackend {
+	return &chainTransactionBackend{
+		EthereumClient: chain.Client(),
+		chain:          chain,
+	}
+}
+
+func (ctb *chainTransactionBackend) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	rewardPercentiles []float64,
+) (*ethereum.FeeHistory, error) {
+	return ctb.chain.FeeHistory(ctx, blockCount, rewardPercentiles)
+}
+
+func (ctb *chainTransactionBackend) NonceAt(
+	ctx context.Context,
+	account common.Address,
+) (uint64, error) {
+	return ctb.chain.NonceAt(ctx, account)
+}
+
+// pendingTransaction is a transaction submitted by the transaction manager
+// and not mined yet, along with all its replacements.
+type pendingTransaction struct {
+	// key identifies the attestation the transaction was submitted for. It
+	// is empty for transactions filling nonce gaps.
+	key string
+	// transactions are all submitted versions of the transaction, the
+	// latest one last.
+	transactions []*types.Transaction
+	// submittedAt is the block at which the latest version was submitted,
+	// or 0 if not observed yet.
+	submittedAt uint64
+}
+
+func (pt *pendingTransaction) latest() *types.Transaction {
+	return pt.transactions[len(pt.transactions)-1]
+}
+
+// transactionManager submits attestation transactions signed by the Signer
+// with fees determined by the fee policy. It tracks submitted transactions
+// until they are mined, replaces transactions that are not mined in time
+// and fills nonce gaps blocking them.
+type transactionManager struct {
+	logger    log.Logger
+	signer    Signer
+	chainID   *big.Int
+	backend   transactionBackend
+	feePolicy FeePolicy
+
+	mutex sync.Mutex
+	// nonce is the nonce of the next transaction; it must be synced with
+	// the pending nonce of the account if nonceSynced is false.
+	nonce       uint64
+	nonceSynced bool
+	pending     map[uint64]*pendingTransaction
+	// nonceGapSince is the block at which a nonce gap was detected, or 0
+	// if there is no gap.
+	nonceGapSince uint64
+	currentBlock  uint64
+}
+
+func newTransactionManager(
+	logger log.Logger,
+	signer Signer,
+	chainID *big.Int,
+	backend transactionBackend,
+	feePolicy FeePolicy,
+) *transactionManager {
+	return &transactionManager{
+		logger:    logger,
+		signer:    signer,
+		chainID:   chainID,
+		backend:   backend,
+		feePolicy: feePolicy,
+		pending:   make(map[uint64]*pendingTransaction),
+	}
+}
+
+// submit builds the transaction of the attestation with the given key using
+// the given function and submits it. If a transaction of the attestation is
+// still pending, it is returned instead of submitting another one; it is
+// replaced if it is not mined in time anyway.
+func (tm *transactionManager) submit(
+	ctx context.Context,
+	key string,
+	build func(opts *bind.TransactOpts) (*types.Transaction, error),
+) (*types.Transaction, error) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	for _, pending := range tm.pending {
+		if pending.key == key {
+			return pending.latest(), nil
+		}
+	}
+
+	nonce, err := tm.nextNonce(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve transaction nonce: [%w]", err)
+	}
+
+	gasTipCap, gasFeeCap, err := tm.feePolicy.fees(ctx, tm.backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine transaction fees: [%w]", err)
+	}
+
+	transaction, err := build(&bind.TransactOpts{
+		From:      tm.signer.Address(),
+		Nonce:     new(big.Int).SetUint64(nonce),
+		Signer:    tm.signTransaction,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Context:   ctx,
+		NoSend:    true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build transaction: [%w]", err)
+	}
+
+	if err := tm.backend.SendTransaction(ctx, transaction); err != nil {
+		if isNonceError(err) {
+			// The nonce is out of sync with the account, most likely due
+			// to transactions submitted outside of the sidecar.
+			tm.nonceSynced = false
+		}
+
+		return nil, fmt.Errorf("failed to send transaction: [%w]", err)
+	}
+
+	tm.pending[nonce] = &pendingTransaction{
+		key:          key,
+		transactions: []*types.Transaction{transaction},
+		submittedAt:  tm.currentBlock,
+	}
+	tm.nonce = nonce + 1
+
+	tm.logger.Info(
+		"submitted attestation transaction",
+		"key", key,
+		"tx_hash", transaction.Hash().Hex(),
+		"nonce", nonce,
+		"gas_fee_cap", gasFeeCap.String(),
+		"gas_tip_cap", gasTipCap.String(),
+	)
+
+	return transaction, nil
+}
+
+// nextNonce returns the nonce of the next transaction, syncing it with the
+// pending nonce of the account if needed. Must be called with the mutex
+// held.
+func (tm *transactionManager) nextNonce(ctx context.Context) (uint64, error) {
+	if tm.nonceSynced {
+		return tm.nonce, nil
+	}
+
+	nonce, err := tm.backend.PendingNonceAt(ctx, tm.signer.Address())
+	if err != nil {
+		return 0, err
+	}
+
+	// Never reuse nonces of tracked transactions.
+	for pendingNonce := range tm.pending {
+		if pendingNonce >= nonce {
+			nonce = pendingNonce + 1
+		}
+	}
+
+	tm.nonce = nonce
+	tm.nonceSynced = true
+
+	return nonce, nil
+}
+
+// monitor checks the pending transactions at every new block until the
+// context is done.
+func (tm *transactionManager) monitor(ctx context.Context, blocks <-chan uint64) {
+	for {
+		select {
+		case block := <-blocks:
+			tm.checkTransactions(ctx, block)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkTransactions records the pending transactions mined by the given
+// block, fills nonce gaps and replaces transactions not mined in time.
+func (tm *transactionManager) checkTransactions(ctx context.Context, block uint64) {
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+
+	tm.currentBlock = block
+
+	if len(tm.pending) == 0 {
+		tm.nonceGapSince = 0
+		return
+	}
+
+	minedNonce, err := tm.backend.NonceAt(ctx, tm.signer.Address())
+	if err != nil {
+		tm.logger.Error(
+			"failed to get account nonce; skipping transactions check",
+			"block", block,
+			"err", err,
+		)
+		return
+	}
+
+	nonces := make([]uint64, 0, len(tm.pending))
+	for nonce, pending := range tm.pending {
+		if nonce < minedNonce {
+			tm.recordMinedTransaction(ctx, nonce, pending)
+			delete(tm.pending, nonce)
+			continue
+		}
+
+		nonces = append(nonces, nonce)
+	}
+
+	if len(nonces) == 0 {
+		tm.nonceGapSince = 0
+		return
+	}
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	tm.fillNonceGap(ctx, minedNonce, nonces[0], block)
+
+	for _, nonce := range nonces {
+		pending := tm.pending[nonce]
+
+		if pending.submittedAt == 0 {
+			pending.submittedAt = block
+			continue
+		}
+
+		if block-pending.submittedAt >= tm.feePolicy.ReplacementBlocks {
+			tm.replaceTransaction(ctx, nonce, pending, block)
+		}
+	}
+}
+
+// recordMinedTransaction logs the mined version of the given transaction
+// and records the gas it spent.
+func (tm *transactionManager) recordMinedTransaction(
+	ctx context.Context,
+	nonce uint64,
+	pending *pendingTransaction,
+) {
+	transactionLogger := tm.logger.With("key", pending.key, "nonce", nonce)
+
+	for i := len(pending.transactions) - 1; i >= 0; i-- {
+		transaction := pending.transactions[i]
+
+		receipt, err := tm.backend.TransactionReceipt(ctx, transaction.Hash())
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			transactionLogger.Error(
+				"failed to get receipt of mined transaction",
+				"tx_hash", transaction.Hash().Hex(),
+				"err", err,
+			)
+			return
+		}
+
+		fee := new(big.Int).Mul(
+			new(big.Int).SetUint64(receipt.GasUsed),
+			receipt.EffectiveGasPrice,
+		)
+
+		if pending.key != "" {
+			attestationGasUsedHistogram.Observe(float64(receipt.GasUsed))
+			feeGwei, _ := new(big.Float).Quo(
+				new(big.Float).SetInt(fee),
+				big.NewFloat(params.GWei),
+			).Float64()
+			attestationFeeHistogram.Observe(feeGwei)
+		}
+
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			transactionLogger.Warn(
+				"transaction mined but reverted",
+				"tx_hash", transaction.Hash().Hex(),
+				"gas_used", receipt.GasUsed,
+				"fee", fee.String(),
+			)
+			return
+		}
+
+		transactionLogger.Info(
+			"transaction mined",
+			"tx_hash", transaction.Hash().Hex(),
+			"gas_used", receipt.GasUsed,
+			"fee", fee.String(),
+			"replacements", i,
+		)
+		return
+	}
+
+	transactionLogger.Warn(
+		"transaction nonce used by a transaction not submitted by the sidecar",
+	)
+}
+
+// fillNonceGap fills nonces between the mined nonce and the lowest nonce of
+// pending transactions with self-transfers if the gap persists for the
+// replacement blocks. The gap may come from transactions submitted before a
+// restart that were dropped by Ethereum nodes. It blocks all pending
+// transactions so the fills pay the maximum fees allowed by the policy.
+func (tm *transactionManager) fillNonceGap(
+	ctx context.Context,
+	minedNonce uint64,
+	lowestPendingNonce uint64,
+	block uint64,
+) {
+	if minedNonce >= lowestPendingNonce {
+		tm.nonceGapSince = 0
+		return
+	}
+
+	if tm.nonceGapSince == 0 {
+		tm.nonceGapSince = block
+		tm.logger.Warn(
+			"detected nonce gap blocking pending transactions",
+			"mined_nonce", minedNonce,
+			"lowest_pending_nonce", lowestPendingNonce,
+		)
+		return
+	}
+
+	if block-tm.nonceGapSince < tm.feePolicy.ReplacementBlocks {
+		return
+	}
+
+	tm.nonceGapSince = 0
+
+	address := tm.signer.Address()
+	gasFeeCap := tm.feePolicy.MaxFeeCap
+	gasTipCap := minBigInt(tm.feePolicy.MaxPriorityFee, gasFeeCap)
+
+	for nonce := minedNonce; nonce < lowestPendingNonce; nonce++ {
+		transaction, err := tm.signTransaction(
+			address,
+			types.NewTx(&types.DynamicFeeTx{
+				ChainID:   tm.chainID,
+				Nonce:     nonce,
+				GasTipCap: gasTipCap,
+				GasFeeCap: gasFeeCap,
+				Gas:       params.TxGas,
+				To:        &address,
+				Value:     new(big.Int),
+			}),
+		)
+		if err != nil {
+			tm.logger.Error("failed to sign nonce gap fill", "nonce", nonce, "err", err)
+			return
+		}
+
+		if err := tm.backend.SendTransaction(ctx, transaction); err != nil {
+			tm.logger.Error("failed to send nonce gap fill", "nonce", nonce, "err", err)
+			return
+		}
+
+		tm.pending[nonce] = &pendingTransaction{
+			transactions: []*types.Transaction{transaction},
+			submittedAt:  block,
+		}
+
+		nonceGapFillsCounter.Inc()
+
+		tm.logger.Info(
+			"sent nonce gap fill",
+			"nonce", nonce,
+			"tx_hash", transaction.Hash().Hex(),
+		)
+	}
+}
+
+// replaceTransaction replaces the given pending transaction with one paying
+// higher fees. The fees are bumped at least enough for Ethereum nodes to
+// accept the replacement and follow the current fees if they rose more.
+// The transaction is not replaced if that would exceed the maximum fee cap.
+func (tm *transactionManager) replaceTransaction(
+	ctx context.Context,
+	nonce uint64,
+	pending *pendingTransaction,
+	block uint64,
+) {
+	transactionLogger := tm.logger.With("key", pending.key, "nonce", nonce)
+
+	latest := pending.latest()
+
+	gasTipCap, gasFeeCap, err := tm.feePolicy.fees(ctx, tm.backend)
+	if err != nil {
+		transactionLogger.Error(
+			"failed to determine replacement transaction fees",
+			"err", err,
+		)
+		return
+	}
+
+	gasFeeCap = maxBigInt(gasFeeCap, bumpFee(latest.GasFeeCap(), replacementFeeBumpPercent))
+	gasFeeCap = minBigInt(gasFeeCap, tm.feePolicy.MaxFeeCap)
+	gasTipCap = maxBigInt(gasTipCap, bumpFee(latest.GasTipCap(), replacementFeeBumpPercent))
+	gasTipCap = minBigInt(gasTipCap, gasFeeCap)
+
+	if gasFeeCap.Cmp(bumpFee(latest.GasFeeCap(), minReplacementFeeBumpPercent)) < 0 ||
+		gasTipCap.Cmp(bumpFee(latest.GasTipCap(), minReplacementFeeBumpPercent)) < 0 {
+		transactionLogger.Warn(
+			"transaction not mined but cannot be replaced without "+
+				"exceeding the maximum fee cap",
+			"tx_hash", latest.Hash().Hex(),
+			"gas_fee_cap", latest.GasFeeCap().String(),
+		)
+		// Wait for another period before trying again.
+		pending.submittedAt = block
+		return
+	}
+
+	transaction, err := tm.signTransaction(
+		tm.signer.Address(),
+		types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tm.chainID,
+			Nonce:      nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        latest.Gas(),
+			To:         latest.To(),
+			Value:      latest.Value(),
+			Data:       latest.Data(),
+			AccessList: latest.AccessList(),
+		}),
+	)
+	if err != nil {
+		transactionLogger.Error("failed to sign replacement transaction", "err", err)
+		return
+	}
+
+	if err := tm.backend.SendTransaction(ctx, transaction); err != nil {
+		// The transaction may have been mined in the meantime; this is
+		// detected at the next block.
+		transactionLogger.Error("failed to send replacement transaction", "err", err)
+		return
+	}
+
+	pending.transactions = append(pending.transactions, transaction)
+	pending.submittedAt = block
+
+	transactionReplacementsCounter.Inc()
+
+	transactionLogger.Info(
+		"replaced transaction not mined in time",
+		"replaced_tx_hash", latest.Hash().Hex(),
+		"tx_hash", transaction.Hash().Hex(),
+		"gas_fee_cap", gasFeeCap.String(),
+		"gas_tip_cap", gasTipCap.String(),
+	)
+}
+
+// signTransaction signs the given transaction with the Signer. It
+// implements bind.SignerFn.
+func (tm *transactionManager) signTransaction(
+	address common.Address,
+	transaction *types.Transaction,
+) (*types.Transaction, error) {
+	if address != tm.signer.Address() {
+		return nil, bind.ErrNotAuthorized
+	}
+
+	txSigner := types.LatestSignerForChainID(tm.chainID)
+
+	signature, err := tm.signer.SignDigest(
+		context.Background(),
+		txSigner.Hash(transaction).Bytes(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: [%w]", err)
+	}
+
+	return transaction.WithSignature(txSigner, signature)
+}
+
+// isNonceError returns true if the error returned by an Ethereum node means
+// the nonce of the submitted transaction is already used.
+func isNonceError(err error) bool {
+	message := err.Error()
+
+	return strings.Contains(message, core.ErrNonceTooLow.Error()) ||
+		strings.Contains(message, txpool.ErrReplaceUnderpriced.Error()) ||
+		strings.Contains(message, txpool.ErrAlreadyKnown.Error())
+}
+
+// bumpFee returns the given fee increased by the given percentage, rounded
+// up.
+func bumpFee(fee *big.Int, percent int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func minBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
+
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+	return b
+}
+
+// signerBridgeContract is a MezoBridge contract handle submitting attestation
+// transactions signed by the Signer through the transaction manager instead
+// of the key of the Ethereum chain handle. Other operations are delegated to
+// the underlying contract handle.
+type signerBridgeContract struct {
+	ethconnect.BridgeContract
+
+	transactor   *portal.MezoBridgeTransactor
+	transactions *transactionManager
+}
+
+func newSignerBridgeContract(
+	bridgeContract ethconnect.BridgeContract,
+	chain *ethconnect.BaseChain,
+	address common.Address,
+	transactions *transactionManager,
+) (*signerBridgeContract, error) {
+	transactor, err := portal.NewMezoBridgeTransactor(address, chain.Client())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to attach to MezoBridge contract transactor: [%w]",
+			err,
+		)
+	}
+
+	return &signerBridgeContract{
+		BridgeContract: bridgeContract,
+		transactor:     transactor,
+		transactions:   transactions,
+	}, nil
+}
+
+func (sbc *signerBridgeContract) AttestBridgeOut(
+	assetsUnlocked *portal.MezoBridgeAssetsUnlocked,
+) (*types.Transaction, error) {
+	return sbc.transactions.submit(
+		context.Background(),
+		assetsUnlocked.UnlockSequenceNumber.String(),
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return sbc.transactor.AttestBridgeOut(opts, *assetsUnlocked)
+		},
+	)
+}
@@ -0,0 +1,448 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+var testTransactionChainID = big.NewInt(11155111)
+
+// testTransactionBackend is a fake Ethereum client recording sent
+// transactions.
+type testTransactionBackend struct {
+	baseFee      *big.Int
+	rewards      []*big.Int
+	minedNonce   uint64
+	pendingNonce uint64
+	receipts     map[common.Hash]*types.Receipt
+	sendErr      error
+
+	sentTransactions []*types.Transaction
+}
+
+func newTestTransactionBackend() *testTransactionBackend {
+	return &testTransactionBackend{
+		baseFee:  gwei(10),
+		rewards:  []*big.Int{gwei(1), gwei(2), gwei(3)},
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+}
+
+func (ttb *testTransactionBackend) FeeHistory(
+	_ context.Context,
+	_ uint64,
+	_ []float64,
+) (*ethereum.FeeHistory, error) {
+	rewards := make([][]*big.Int, len(ttb.rewards))
+	for i, reward := range ttb.rewards {
+		rewards[i] = []*big.Int{reward}
+	}
+
+	return &ethereum.FeeHistory{
+		Reward:  rewards,
+		BaseFee: []*big.Int{gwei(1), ttb.baseFee},
+	}, nil
+}
+
+func (ttb *testTransactionBackend) NonceAt(
+	_ context.Context,
+	_ common.Address,
+) (uint64, error) {
+	return ttb.minedNonce, nil
+}
+
+func (ttb *testTransactionBackend) PendingNonceAt(
+	_ context.Context,
+	_ common.Address,
+) (uint64, error) {
+	return ttb.pendingNonce, nil
+}
+
+func (ttb *testTransactionBackend) SendTransaction(
+	_ context.Context,
+	transaction *types.Transaction,
+) error {
+	if ttb.sendErr != nil {
+		return ttb.sendErr
+	}
+
+	ttb.sentTransactions = append(ttb.sentTransactions, transaction)
+	return nil
+}
+
+func (ttb *testTransactionBackend) TransactionReceipt(
+	_ context.Context,
+	hash common.Hash,
+) (*types.Receipt, error) {
+	receipt, ok := ttb.receipts[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+
+	return receipt, nil
+}
+
+func gwei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.GWei))
+}
+
+func testFeePolicy() FeePolicy {
+	return FeePolicy{
+		MaxFeeCap:             gwei(100),
+		MaxPriorityFee:        gwei(5),
+		PriorityFeePercentile: 50,
+		ReplacementBlocks:     3,
+	}
+}
+
+func newTestTransactionManager(
+	t *testing.T,
+	backend *testTransactionBackend,
+	feePolicy FeePolicy,
+) *transactionManager {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	return newTransactionManager(
+		log.NewNopLogger(),
+		NewLocalSigner(privateKey),
+		testTransactionChainID,
+		backend,
+		feePolicy,
+	)
+}
+
+// testBuildTransaction builds an attestation-like transaction as the
+// MezoBridge transactor does.
+func testBuildTransaction(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testTransactionChainID,
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: opts.GasTipCap,
+		GasFeeCap: opts.GasFeeCap,
+		Gas:       100000,
+		To:        &common.Address{0x01},
+		Data:      []byte{0x02},
+	}))
+}
+
+func TestFeePolicy_Fees(t *testing.T) {
+	tests := map[string]struct {
+		baseFee           *big.Int
+		rewards           []*big.Int
+		expectedGasTipCap *big.Int
+		expectedGasFeeCap *big.Int
+	}{
+		"median priority fee": {
+			baseFee:           gwei(10),
+			rewards:           []*big.Int{gwei(3), gwei(1), gwei(2)},
+			expectedGasTipCap: gwei(2),
+			expectedGasFeeCap: gwei(22),
+		},
+		"priority fee above maximum": {
+			baseFee:           gwei(10),
+			rewards:           []*big.Int{gwei(7), gwei(8), gwei(9)},
+			expectedGasTipCap: gwei(5),
+			expectedGasFeeCap: gwei(25),
+		},
+		"no priority fees": {
+			baseFee:           gwei(10),
+			rewards:           []*big.Int{},
+			expectedGasTipCap: minPriorityFee,
+			expectedGasFeeCap: new(big.Int).Add(gwei(20), minPriorityFee),
+		},
+		"fee cap above maximum": {
+			baseFee:           gwei(60),
+			rewards:           []*big.Int{gwei(2)},
+			expectedGasTipCap: gwei(2),
+			expectedGasFeeCap: gwei(100),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			backend := newTestTransactionBackend()
+			backend.baseFee = test.baseFee
+			backend.rewards = test.rewards
+
+			gasTipCap, gasFeeCap, err := testFeePolicy().fees(
+				context.Background(),
+				backend,
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedGasTipCap, gasTipCap)
+			require.Equal(t, test.expectedGasFeeCap, gasFeeCap)
+		})
+	}
+}
+
+func TestFeePolicy_Validate(t *testing.T) {
+	tests := map[string]struct {
+		modify      func(feePolicy *FeePolicy)
+		expectedErr string
+	}{
+		"valid policy": {
+			modify: func(_ *FeePolicy) {},
+		},
+		"zero max fee cap": {
+			modify:      func(feePolicy *FeePolicy) { feePolicy.MaxFeeCap = big.NewInt(0) },
+			expectedErr: "max fee cap must be positive",
+		},
+		"zero max priority fee": {
+			modify:      func(feePolicy *FeePolicy) { feePolicy.MaxPriorityFee = big.NewInt(0) },
+			expectedErr: "max priority fee must be positive",
+		},
+		"percentile out of range": {
+			modify:      func(feePolicy *FeePolicy) { feePolicy.PriorityFeePercentile = 101 },
+			expectedErr: "priority fee percentile must be within [0, 100], got 101",
+		},
+		"zero replacement blocks": {
+			modify:      func(feePolicy *FeePolicy) { feePolicy.ReplacementBlocks = 0 },
+			expectedErr: "replacement blocks must be positive",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			feePolicy := testFeePolicy()
+			test.modify(&feePolicy)
+
+			err := feePolicy.Validate()
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTransactionManager_Submit(t *testing.T) {
+	backend := newTestTransactionBackend()
+	backend.pendingNonce = 7
+
+	manager := newTestTransactionManager(t, backend, testFeePolicy())
+
+	transaction, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), transaction.Nonce())
+	require.Equal(t, gwei(2), transaction.GasTipCap())
+	require.Equal(t, gwei(22), transaction.GasFeeCap())
+	require.Equal(t, []*types.Transaction{transaction}, backend.sentTransactions)
+
+	sender, err := types.Sender(
+		types.LatestSignerForChainID(testTransactionChainID),
+		transaction,
+	)
+	require.NoError(t, err)
+	require.Equal(t, manager.signer.Address(), sender)
+
+	// The pending transaction of the same attestation is not submitted
+	// again.
+	sameTransaction, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, transaction.Hash(), sameTransaction.Hash())
+	require.Len(t, backend.sentTransactions, 1)
+
+	nextTransaction, err := manager.submit(context.Background(), "2", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), nextTransaction.Nonce())
+	require.Len(t, backend.sentTransactions, 2)
+}
+
+func TestTransactionManager_Submit_NonceTooLow(t *testing.T) {
+	backend := newTestTransactionBackend()
+	backend.pendingNonce = 7
+
+	manager := newTestTransactionManager(t, backend, testFeePolicy())
+
+	_, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+
+	// Another transaction of the account was submitted outside of the
+	// sidecar.
+	backend.pendingNonce = 9
+	backend.sendErr = fmt.Errorf("nonce too low: next nonce 9, tx nonce 8")
+
+	_, err = manager.submit(context.Background(), "2", testBuildTransaction)
+	require.ErrorContains(t, err, "failed to send transaction")
+
+	backend.sendErr = nil
+
+	transaction, err := manager.submit(context.Background(), "2", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), transaction.Nonce())
+}
+
+func TestTransactionManager_ReplaceTransaction(t *testing.T) {
+	backend := newTestTransactionBackend()
+	backend.pendingNonce = 7
+	backend.minedNonce = 7
+
+	manager := newTestTransactionManager(t, backend, testFeePolicy())
+
+	manager.checkTransactions(context.Background(), 100)
+
+	transaction, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+
+	// The transaction is not replaced before the replacement blocks pass.
+	manager.checkTransactions(context.Background(), 101)
+	manager.checkTransactions(context.Background(), 102)
+	require.Len(t, backend.sentTransactions, 1)
+
+	manager.checkTransactions(context.Background(), 103)
+	require.Len(t, backend.sentTransactions, 2)
+
+	replacement := backend.sentTransactions[1]
+	require.Equal(t, transaction.Nonce(), replacement.Nonce())
+	require.Equal(t, transaction.Data(), replacement.Data())
+	require.Equal(t, transaction.To(), replacement.To())
+	require.Equal(t, transaction.Gas(), replacement.Gas())
+	require.Equal(t, bumpFee(transaction.GasTipCap(), replacementFeeBumpPercent), replacement.GasTipCap())
+	require.Equal(t, bumpFee(transaction.GasFeeCap(), replacementFeeBumpPercent), replacement.GasFeeCap())
+
+	// The pending transaction of the attestation is the replacement now.
+	pendingTransaction, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, replacement.Hash(), pendingTransaction.Hash())
+
+	// The replacement is mined.
+	backend.minedNonce = 8
+	backend.receipts[replacement.Hash()] = &types.Receipt{
+		Status:            types.ReceiptStatusSuccessful,
+		GasUsed:           80000,
+		EffectiveGasPrice: gwei(12),
+	}
+
+	manager.checkTransactions(context.Background(), 104)
+	require.Empty(t, manager.pending)
+}
+
+func TestTransactionManager_ReplaceTransaction_MaxFeeCap(t *testing.T) {
+	backend := newTestTransactionBackend()
+	backend.baseFee = gwei(49)
+	backend.minedNonce = 7
+	backend.pendingNonce = 7
+
+	manager := newTestTransactionManager(t, backend, testFeePolicy())
+
+	manager.checkTransactions(context.Background(), 100)
+
+	transaction, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+	require.Equal(t, gwei(100), transaction.GasFeeCap())
+
+	manager.checkTransactions(context.Background(), 103)
+	require.Len(t, backend.sentTransactions, 1)
+	require.Equal(t, uint64(103), manager.pending[7].submittedAt)
+}
+
+func TestTransactionManager_FillNonceGap(t *testing.T) {
+	backend := newTestTransactionBackend()
+	// Transactions with nonces 5 and 6 were submitted before a restart and
+	// dropped by Ethereum nodes afterwards.
+	backend.minedNonce = 5
+	backend.pendingNonce = 7
+
+	feePolicy := testFeePolicy()
+	feePolicy.ReplacementBlocks = 100
+
+	manager := newTestTransactionManager(t, backend, feePolicy)
+
+	manager.checkTransactions(context.Background(), 100)
+
+	_, err := manager.submit(context.Background(), "1", testBuildTransaction)
+	require.NoError(t, err)
+
+	manager.checkTransactions(context.Background(), 101)
+	require.Equal(t, uint64(101), manager.nonceGapSince)
+	require.Len(t, backend.sentTransactions, 1)
+
+	// The gap is filled and the blocked transaction is replaced as it was
+	// not mined in time either.
+	manager.checkTransactions(context.Background(), 201)
+	require.Len(t, backend.sentTransactions, 4)
+	require.Equal(t, uint64(7), backend.sentTransactions[3].Nonce())
+
+	address := manager.signer.Address()
+	for i, fill := range backend.sentTransactions[1:3] {
+		require.Equal(t, uint64(5+i), fill.Nonce())
+		require.Equal(t, &address, fill.To())
+		require.Equal(t, big.NewInt(0), fill.Value())
+		require.Equal(t, feePolicy.MaxFeeCap, fill.GasFeeCap())
+		require.Equal(t, feePolicy.MaxPriorityFee, fill.GasTipCap())
+	}
+
+	// The gap is filled once.
+	manager.checkTransactions(context.Background(), 202)
+	require.Len(t, backend.sentTransactions, 4)
+	require.Zero(t, manager.nonceGapSince)
+}
+
+func TestTransactionManager_SignTransaction(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	address, registry := startTestRemoteSignerServer(
+		t,
+		&testRemoteSignerServer{privateKey: privateKey},
+	)
+
+	signer, err := NewRemoteSigner(
+		context.Background(),
+		address,
+		testRemoteSignerKeyID,
+		"",
+		registry,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = signer.Close()
+	})
+
+	manager := newTransactionManager(
+		log.NewNopLogger(),
+		signer,
+		testTransactionChainID,
+		newTestTransactionBackend(),
+		testFeePolicy(),
+	)
+
+	transaction := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testTransactionChainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       100000,
+		To:        &common.Address{0x01},
+		Data:      []byte{0x02},
+	})
+
+	signedTransaction, err := manager.signTransaction(
+		signer.Address(),
+		transaction,
+	)
+	require.NoError(t, err)
+
+	sender, err := types.Sender(
+		types.LatestSignerForChainID(testTransactionChainID),
+		signedTransaction,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), sender)
+
+	_, err = manager.signTransaction(common.Address{0x03}, transaction)
+	require.ErrorIs(t, err, bind.ErrNotAuthorized)
+}
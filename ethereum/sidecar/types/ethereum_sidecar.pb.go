@@ -121,6 +121,95 @@ func (m *AssetsLockedEventsResponse) GetEvents() []*types.AssetsLockedEvent {
 	return nil
 }
 
+// SubscribeAssetsLockedEventsRequest is the request type for the
+// SubscribeAssetsLockedEvents stream.
+type SubscribeAssetsLockedEventsRequest struct {
+	// sequence_start is the sequence of the first streamed event (inclusive).
+	// If null, all events known to the Ethereum sidecar are streamed.
+	SequenceStart cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=sequence_start,json=sequenceStart,proto3,customtype=cosmossdk.io/math.Int" json:"sequence_start"`
+}
+
+func (m *SubscribeAssetsLockedEventsRequest) Reset()         { *m = SubscribeAssetsLockedEventsRequest{} }
+func (m *SubscribeAssetsLockedEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeAssetsLockedEventsRequest) ProtoMessage()    {}
+func (*SubscribeAssetsLockedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{2}
+}
+func (m *SubscribeAssetsLockedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeAssetsLockedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeAssetsLockedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeAssetsLockedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeAssetsLockedEventsRequest.Merge(m, src)
+}
+func (m *SubscribeAssetsLockedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeAssetsLockedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeAssetsLockedEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeAssetsLockedEventsRequest proto.InternalMessageInfo
+
+// SubscribeAssetsLockedEventsResponse is the response type for the
+// SubscribeAssetsLockedEvents stream.
+type SubscribeAssetsLockedEventsResponse struct {
+	// events contains a list of AssetsLockedEvents following, without gaps,
+	// the events sent in previous responses of the stream.
+	Events []*types.AssetsLockedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *SubscribeAssetsLockedEventsResponse) Reset()         { *m = SubscribeAssetsLockedEventsResponse{} }
+func (m *SubscribeAssetsLockedEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeAssetsLockedEventsResponse) ProtoMessage()    {}
+func (*SubscribeAssetsLockedEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{3}
+}
+func (m *SubscribeAssetsLockedEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeAssetsLockedEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeAssetsLockedEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeAssetsLockedEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeAssetsLockedEventsResponse.Merge(m, src)
+}
+func (m *SubscribeAssetsLockedEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeAssetsLockedEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeAssetsLockedEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeAssetsLockedEventsResponse proto.InternalMessageInfo
+
+func (m *SubscribeAssetsLockedEventsResponse) GetEvents() []*types.AssetsLockedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 // VersionRequest is the request type for the Version query.
 type VersionRequest struct {
 }
@@ -129,7 +218,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8cb11e8648a9ddc, []int{2}
+	return fileDescriptor_f8cb11e8648a9ddc, []int{4}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +257,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8cb11e8648a9ddc, []int{3}
+	return fileDescriptor_f8cb11e8648a9ddc, []int{5}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,11 +293,352 @@ func (m *VersionResponse) GetVersion() string {
 	return ""
 }
 
+// StatusRequest is the request type for the Status query.
+type StatusRequest struct {
+}
+
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{6}
+}
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(m, src)
+}
+func (m *StatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+
+// StatusResponse is the response type for the Status query.
+type StatusResponse struct {
+	// version contains the version of the Ethereum sidecar.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// ready is true once the initial synchronization of AssetsLocked events is
+	// complete and the Ethereum sidecar serves them.
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	// last_finalized_block is the last finalized Ethereum block up to which
+	// AssetsLocked events were fetched.
+	LastFinalizedBlock uint64 `protobuf:"varint,3,opt,name=last_finalized_block,json=lastFinalizedBlock,proto3" json:"last_finalized_block,omitempty"`
+	// assets_locked_events is the status of the cached AssetsLocked events.
+	AssetsLockedEvents AssetsLockedEventsStatus `protobuf:"bytes,4,opt,name=assets_locked_events,json=assetsLockedEvents,proto3" json:"assets_locked_events"`
+	// attestation is the status of the AssetsUnlocked events attestation.
+	Attestation AttestationStatus `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation"`
+	// bridge_worker is the status of the connection to the bridge worker.
+	BridgeWorker BridgeWorkerStatus `protobuf:"bytes,6,opt,name=bridge_worker,json=bridgeWorker,proto3" json:"bridge_worker"`
+}
+
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{7}
+}
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(m, src)
+}
+func (m *StatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+
+func (m *StatusResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *StatusResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *StatusResponse) GetLastFinalizedBlock() uint64 {
+	if m != nil {
+		return m.LastFinalizedBlock
+	}
+	return 0
+}
+
+func (m *StatusResponse) GetAssetsLockedEvents() AssetsLockedEventsStatus {
+	if m != nil {
+		return m.AssetsLockedEvents
+	}
+	return AssetsLockedEventsStatus{}
+}
+
+func (m *StatusResponse) GetAttestation() AttestationStatus {
+	if m != nil {
+		return m.Attestation
+	}
+	return AttestationStatus{}
+}
+
+func (m *StatusResponse) GetBridgeWorker() BridgeWorkerStatus {
+	if m != nil {
+		return m.BridgeWorker
+	}
+	return BridgeWorkerStatus{}
+}
+
+// AssetsLockedEventsStatus is the status of the AssetsLocked events cached by
+// the Ethereum sidecar.
+type AssetsLockedEventsStatus struct {
+	// count is the number of cached events.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// first_sequence is the sequence of the first cached event. Null if there
+	// are no cached events.
+	FirstSequence cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=first_sequence,json=firstSequence,proto3,customtype=cosmossdk.io/math.Int" json:"first_sequence"`
+	// last_sequence is the sequence of the last cached event. Null if there are
+	// no cached events.
+	LastSequence cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=last_sequence,json=lastSequence,proto3,customtype=cosmossdk.io/math.Int" json:"last_sequence"`
+}
+
+func (m *AssetsLockedEventsStatus) Reset()         { *m = AssetsLockedEventsStatus{} }
+func (m *AssetsLockedEventsStatus) String() string { return proto.CompactTextString(m) }
+func (*AssetsLockedEventsStatus) ProtoMessage()    {}
+func (*AssetsLockedEventsStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{8}
+}
+func (m *AssetsLockedEventsStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetsLockedEventsStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetsLockedEventsStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetsLockedEventsStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsLockedEventsStatus.Merge(m, src)
+}
+func (m *AssetsLockedEventsStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetsLockedEventsStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsLockedEventsStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsLockedEventsStatus proto.InternalMessageInfo
+
+func (m *AssetsLockedEventsStatus) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// AttestationStatus is the status of the AssetsUnlocked events attestation
+// performed by the Ethereum sidecar.
+type AttestationStatus struct {
+	// bridge_validator_id is the ID of the bridge validator represented by the
+	// Ethereum sidecar. Zero if the sidecar does not represent a bridge
+	// validator or the ID is not known yet.
+	BridgeValidatorId uint32 `protobuf:"varint,1,opt,name=bridge_validator_id,json=bridgeValidatorId,proto3" json:"bridge_validator_id,omitempty"`
+	// queue_length is the number of AssetsUnlocked events waiting for
+	// attestation.
+	QueueLength uint64 `protobuf:"varint,2,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	// pending_finality_checks is the number of attestations waiting for the
+	// finality check.
+	PendingFinalityChecks uint64 `protobuf:"varint,3,opt,name=pending_finality_checks,json=pendingFinalityChecks,proto3" json:"pending_finality_checks,omitempty"`
+	// current_unlock_sequence is the unlock sequence of the AssetsUnlocked
+	// event whose attestation is in progress. Null if there is none.
+	CurrentUnlockSequence cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current_unlock_sequence,json=currentUnlockSequence,proto3,customtype=cosmossdk.io/math.Int" json:"current_unlock_sequence"`
+	// submission_position is the 1-based position of the Ethereum sidecar in
+	// the individual submission queue of the current attestation. Zero if the
+	// position is not determined.
+	SubmissionPosition uint32 `protobuf:"varint,5,opt,name=submission_position,json=submissionPosition,proto3" json:"submission_position,omitempty"`
+}
+
+func (m *AttestationStatus) Reset()         { *m = AttestationStatus{} }
+func (m *AttestationStatus) String() string { return proto.CompactTextString(m) }
+func (*AttestationStatus) ProtoMessage()    {}
+func (*AttestationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{9}
+}
+func (m *AttestationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationStatus.Merge(m, src)
+}
+func (m *AttestationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationStatus proto.InternalMessageInfo
+
+func (m *AttestationStatus) GetBridgeValidatorId() uint32 {
+	if m != nil {
+		return m.BridgeValidatorId
+	}
+	return 0
+}
+
+func (m *AttestationStatus) GetQueueLength() uint64 {
+	if m != nil {
+		return m.QueueLength
+	}
+	return 0
+}
+
+func (m *AttestationStatus) GetPendingFinalityChecks() uint64 {
+	if m != nil {
+		return m.PendingFinalityChecks
+	}
+	return 0
+}
+
+func (m *AttestationStatus) GetSubmissionPosition() uint32 {
+	if m != nil {
+		return m.SubmissionPosition
+	}
+	return 0
+}
+
+// BridgeWorkerStatus is the status of the connection between the Ethereum
+// sidecar and the bridge worker.
+type BridgeWorkerStatus struct {
+	// configured is true if the Ethereum sidecar is configured to send
+	// attestation signatures to the bridge worker.
+	Configured bool `protobuf:"varint,1,opt,name=configured,proto3" json:"configured,omitempty"`
+	// reachable is true if the last attempt to send an attestation signature
+	// to the bridge worker succeeded. False if no attempt was made yet.
+	Reachable bool `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// last_error is the error of the last attempt to send an attestation
+	// signature to the bridge worker. Empty if the attempt succeeded.
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *BridgeWorkerStatus) Reset()         { *m = BridgeWorkerStatus{} }
+func (m *BridgeWorkerStatus) String() string { return proto.CompactTextString(m) }
+func (*BridgeWorkerStatus) ProtoMessage()    {}
+func (*BridgeWorkerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8cb11e8648a9ddc, []int{10}
+}
+func (m *BridgeWorkerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeWorkerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeWorkerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeWorkerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeWorkerStatus.Merge(m, src)
+}
+func (m *BridgeWorkerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeWorkerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeWorkerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeWorkerStatus proto.InternalMessageInfo
+
+func (m *BridgeWorkerStatus) GetConfigured() bool {
+	if m != nil {
+		return m.Configured
+	}
+	return false
+}
+
+func (m *BridgeWorkerStatus) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *BridgeWorkerStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AssetsLockedEventsRequest)(nil), "mezo.ethereum_sidecar.v1.AssetsLockedEventsRequest")
 	proto.RegisterType((*AssetsLockedEventsResponse)(nil), "mezo.ethereum_sidecar.v1.AssetsLockedEventsResponse")
+	proto.RegisterType((*SubscribeAssetsLockedEventsRequest)(nil), "mezo.ethereum_sidecar.v1.SubscribeAssetsLockedEventsRequest")
+	proto.RegisterType((*SubscribeAssetsLockedEventsResponse)(nil), "mezo.ethereum_sidecar.v1.SubscribeAssetsLockedEventsResponse")
 	proto.RegisterType((*VersionRequest)(nil), "mezo.ethereum_sidecar.v1.VersionRequest")
 	proto.RegisterType((*VersionResponse)(nil), "mezo.ethereum_sidecar.v1.VersionResponse")
+	proto.RegisterType((*StatusRequest)(nil), "mezo.ethereum_sidecar.v1.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "mezo.ethereum_sidecar.v1.StatusResponse")
+	proto.RegisterType((*AssetsLockedEventsStatus)(nil), "mezo.ethereum_sidecar.v1.AssetsLockedEventsStatus")
+	proto.RegisterType((*AttestationStatus)(nil), "mezo.ethereum_sidecar.v1.AttestationStatus")
+	proto.RegisterType((*BridgeWorkerStatus)(nil), "mezo.ethereum_sidecar.v1.BridgeWorkerStatus")
 }
 
 func init() {
@@ -216,32 +646,61 @@ func init() {
 }
 
 var fileDescriptor_f8cb11e8648a9ddc = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xc3, 0x40,
-	0x10, 0xce, 0x2a, 0xb4, 0xb8, 0xd5, 0x56, 0x16, 0x85, 0x18, 0x31, 0xad, 0x39, 0x55, 0xc4, 0x8d,
-	0x6d, 0xbd, 0x78, 0xd3, 0x62, 0x0f, 0x8a, 0xa7, 0x14, 0x14, 0x3c, 0x58, 0xda, 0x64, 0x48, 0x43,
-	0x49, 0xb6, 0x66, 0xb7, 0x01, 0xbd, 0xf8, 0x0a, 0xbe, 0x83, 0x2f, 0xd3, 0x63, 0x8f, 0xe2, 0xa1,
-	0x48, 0xfb, 0x06, 0x3e, 0x81, 0xe4, 0xaf, 0x20, 0xb1, 0x50, 0x6f, 0x93, 0x6f, 0xe7, 0xfb, 0x32,
-	0xdf, 0xcc, 0x87, 0x75, 0x17, 0x5e, 0x98, 0x0e, 0xa2, 0x0f, 0x3e, 0x8c, 0xdc, 0x0e, 0x77, 0x2c,
-	0x30, 0xbb, 0xbe, 0x1e, 0xd4, 0x32, 0x18, 0x1d, 0xfa, 0x4c, 0x30, 0x22, 0x87, 0x04, 0x9a, 0x79,
-	0x0c, 0x6a, 0xca, 0x8e, 0xcd, 0x6c, 0x16, 0x35, 0xe9, 0x61, 0x15, 0xf7, 0x2b, 0xfb, 0xd1, 0x0f,
-	0x7a, 0xbe, 0x63, 0xd9, 0x10, 0xca, 0xc6, 0x55, 0xfc, 0xa8, 0xbd, 0x23, 0xbc, 0x77, 0xc9, 0x39,
-	0x08, 0x7e, 0xcb, 0xcc, 0x01, 0x58, 0xad, 0x00, 0x3c, 0xc1, 0x0d, 0x78, 0x1a, 0x01, 0x17, 0xe4,
-	0x0a, 0x17, 0x79, 0x58, 0x7a, 0x26, 0x74, 0xb8, 0xe8, 0xfa, 0x42, 0x46, 0x15, 0x54, 0xdd, 0x68,
-	0x1e, 0x8c, 0xa7, 0x65, 0xe9, 0x73, 0x5a, 0xde, 0x35, 0x19, 0x77, 0x19, 0xe7, 0xd6, 0x80, 0x3a,
-	0x4c, 0x77, 0xbb, 0xa2, 0x4f, 0xaf, 0x3d, 0x61, 0x6c, 0xa5, 0xa4, 0x76, 0xc8, 0x21, 0x17, 0x78,
-	0x73, 0xa1, 0x02, 0x9e, 0x25, 0xaf, 0xad, 0xa2, 0x51, 0x48, 0x29, 0x2d, 0xcf, 0xd2, 0xee, 0xb1,
-	0xf2, 0xd7, 0x90, 0x7c, 0xc8, 0x3c, 0x0e, 0xe4, 0x1c, 0xe7, 0x20, 0x42, 0x64, 0x54, 0x59, 0xaf,
-	0x16, 0xea, 0x87, 0x34, 0xda, 0x50, 0xe2, 0x33, 0xa8, 0xd1, 0x0c, 0xd7, 0x48, 0x08, 0xda, 0x36,
-	0x2e, 0xde, 0x81, 0xcf, 0x1d, 0xe6, 0x25, 0x96, 0xb5, 0x63, 0x5c, 0x5a, 0x20, 0x89, 0xbe, 0x8c,
-	0xf3, 0x41, 0x0c, 0xc5, 0xf6, 0x8d, 0xf4, 0xb3, 0xfe, 0x8d, 0x70, 0xa9, 0x95, 0x1c, 0xa2, 0x1d,
-	0xdf, 0x81, 0xbc, 0x62, 0x92, 0x9d, 0x95, 0x34, 0xe8, 0xb2, 0xab, 0xd1, 0xa5, 0xeb, 0x57, 0xce,
-	0xfe, 0x47, 0x4a, 0xc6, 0x7d, 0xc4, 0xf9, 0xc4, 0x01, 0xa9, 0x2e, 0x17, 0xf8, 0x6d, 0x5b, 0x39,
-	0x5a, 0xa1, 0x33, 0xd6, 0x6f, 0xde, 0x8c, 0x67, 0x2a, 0x9a, 0xcc, 0x54, 0xf4, 0x35, 0x53, 0xd1,
-	0xdb, 0x5c, 0x95, 0x26, 0x73, 0x55, 0xfa, 0x98, 0xab, 0xd2, 0xc3, 0xa9, 0xed, 0x88, 0xfe, 0xa8,
-	0x47, 0x4d, 0xe6, 0x46, 0xa9, 0x3e, 0x61, 0xbe, 0x1d, 0x15, 0xd6, 0x22, 0xcb, 0x7a, 0x9a, 0x6f,
-	0xf1, 0x3c, 0x04, 0xde, 0xcb, 0x45, 0x29, 0x6c, 0xfc, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xff,
-	0x60, 0x3d, 0x05, 0x03, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0x6e, 0xda, 0x3c, 0xc7, 0x09, 0x9d, 0x26, 0xea, 0xe2, 0x52, 0x37, 0x5d, 0x0e,
+	0x18, 0x15, 0xd6, 0x49, 0x8a, 0x90, 0x90, 0x40, 0x02, 0x43, 0x2a, 0x15, 0xf5, 0x80, 0xd6, 0x6a,
+	0x23, 0x81, 0xc4, 0x32, 0xbb, 0xfb, 0xb2, 0xde, 0x7a, 0xbd, 0xe3, 0xcc, 0xcc, 0x1a, 0xa5, 0x17,
+	0xfe, 0x00, 0x07, 0x7e, 0x00, 0x37, 0x24, 0x7e, 0x04, 0xbf, 0xa0, 0xc7, 0x1e, 0x11, 0x87, 0x0a,
+	0x25, 0x77, 0x7e, 0x03, 0xda, 0x99, 0x59, 0xdb, 0xd1, 0xe2, 0xc4, 0x05, 0x71, 0xdb, 0x7d, 0xdf,
+	0xfb, 0xbe, 0x99, 0xef, 0xcd, 0x9b, 0xa7, 0x81, 0xee, 0x08, 0x9f, 0xb3, 0x2e, 0xca, 0x01, 0x72,
+	0xcc, 0x47, 0xbe, 0x48, 0x22, 0x0c, 0x29, 0xef, 0x4e, 0xf6, 0x2a, 0x31, 0x77, 0xcc, 0x99, 0x64,
+	0xc4, 0x2e, 0x08, 0x6e, 0x05, 0x9c, 0xec, 0xb5, 0xb6, 0x62, 0x16, 0x33, 0x95, 0xd4, 0x2d, 0xbe,
+	0x74, 0x7e, 0xeb, 0xb6, 0x5a, 0x20, 0xe0, 0x49, 0x14, 0x63, 0x21, 0xab, 0xbf, 0x34, 0xe8, 0xfc,
+	0x62, 0xc1, 0x9b, 0x9f, 0x09, 0x81, 0x52, 0x3c, 0x66, 0xe1, 0x10, 0xa3, 0x83, 0x09, 0x66, 0x52,
+	0x78, 0x78, 0x9c, 0xa3, 0x90, 0xe4, 0x0b, 0xd8, 0x10, 0xc5, 0x67, 0x16, 0xa2, 0x2f, 0x24, 0xe5,
+	0xd2, 0xb6, 0x76, 0xac, 0xce, 0x5a, 0xef, 0xce, 0x8b, 0x57, 0x77, 0x57, 0xfe, 0x78, 0x75, 0x77,
+	0x3b, 0x64, 0x62, 0xc4, 0x84, 0x88, 0x86, 0x6e, 0xc2, 0xba, 0x23, 0x2a, 0x07, 0xee, 0xa3, 0x4c,
+	0x7a, 0xcd, 0x92, 0xd4, 0x2f, 0x38, 0xe4, 0x53, 0x58, 0x9f, 0xaa, 0x60, 0x16, 0xd9, 0x57, 0x96,
+	0xd1, 0x68, 0x94, 0x94, 0x83, 0x2c, 0x72, 0x0e, 0xa1, 0xf5, 0x4f, 0x9b, 0x14, 0x63, 0x96, 0x09,
+	0x24, 0x1f, 0xc1, 0x2a, 0xaa, 0x88, 0x6d, 0xed, 0xd4, 0x3a, 0x8d, 0xfd, 0x7b, 0xae, 0xaa, 0x90,
+	0xf1, 0x39, 0xd9, 0x73, 0x2b, 0x5c, 0xcf, 0x10, 0x9c, 0x67, 0xe0, 0xf4, 0xf3, 0x40, 0x84, 0x3c,
+	0x09, 0xf0, 0x7f, 0x2e, 0x83, 0xf3, 0x1d, 0xbc, 0x7d, 0xe1, 0x5a, 0xff, 0xdd, 0xcd, 0x1b, 0xb0,
+	0xf1, 0x14, 0xb9, 0x48, 0x58, 0x66, 0x76, 0xee, 0xdc, 0x87, 0xcd, 0x69, 0xc4, 0xe8, 0xdb, 0x70,
+	0x6d, 0xa2, 0x43, 0xda, 0x85, 0x57, 0xfe, 0x3a, 0x9b, 0xd0, 0xec, 0x4b, 0x2a, 0xf3, 0xd2, 0xb7,
+	0xf3, 0x63, 0x0d, 0x36, 0xca, 0xc8, 0x65, 0x6c, 0xb2, 0x05, 0x57, 0x39, 0xd2, 0xe8, 0x44, 0x1d,
+	0xef, 0x75, 0x4f, 0xff, 0x90, 0x5d, 0xd8, 0x4a, 0xa9, 0x90, 0xfe, 0x51, 0x92, 0xd1, 0x34, 0x79,
+	0x8e, 0x91, 0x1f, 0xa4, 0x2c, 0x1c, 0xda, 0xb5, 0x1d, 0xab, 0x53, 0xf7, 0x48, 0x81, 0x3d, 0x2c,
+	0xa1, 0x5e, 0x81, 0x90, 0x67, 0xb0, 0x45, 0x95, 0x43, 0x3f, 0x55, 0x16, 0x7d, 0x53, 0x8d, 0xfa,
+	0x8e, 0xd5, 0x69, 0xec, 0xef, 0xbb, 0x8b, 0xba, 0xbf, 0x5a, 0x17, 0xa1, 0xf7, 0xde, 0xab, 0x17,
+	0xc7, 0xe4, 0x11, 0x5a, 0xc1, 0x49, 0x1f, 0x1a, 0x54, 0x4a, 0x14, 0x92, 0xca, 0xc2, 0xd1, 0x55,
+	0xb5, 0xc4, 0xfd, 0x0b, 0x96, 0x98, 0x25, 0x9f, 0xd3, 0x9e, 0x57, 0x21, 0x87, 0xd0, 0xd4, 0x87,
+	0xe5, 0x7f, 0xcf, 0xf8, 0x10, 0xb9, 0xbd, 0xaa, 0x64, 0xdf, 0x5b, 0x2c, 0xdb, 0x53, 0xe9, 0x87,
+	0x2a, 0xfb, 0x9c, 0xee, 0x7a, 0x30, 0x87, 0x38, 0xbf, 0x59, 0x60, 0x2f, 0x32, 0x59, 0x94, 0x3f,
+	0x64, 0x79, 0xa6, 0x5b, 0xb3, 0xee, 0xe9, 0x9f, 0xa2, 0x73, 0x8f, 0x12, 0x2e, 0xa4, 0x5f, 0xb6,
+	0xe2, 0x72, 0x97, 0xaf, 0xa9, 0x48, 0x7d, 0xc3, 0x21, 0x3d, 0x68, 0xa6, 0x74, 0x5e, 0xa4, 0xb6,
+	0x8c, 0xc8, 0x7a, 0x4a, 0x67, 0x1a, 0xce, 0xaf, 0x57, 0xe0, 0x46, 0xa5, 0x7c, 0xc4, 0x85, 0x9b,
+	0xa6, 0x56, 0x13, 0x9a, 0x26, 0x11, 0x95, 0x8c, 0xfb, 0x49, 0xa4, 0x3c, 0x34, 0xbd, 0x1b, 0x1a,
+	0x7a, 0x5a, 0x22, 0x8f, 0x22, 0x72, 0x0f, 0xd6, 0x8f, 0x73, 0xcc, 0xd1, 0x4f, 0x31, 0x8b, 0xe5,
+	0x40, 0xb9, 0xa9, 0x7b, 0x0d, 0x15, 0x7b, 0xac, 0x42, 0xe4, 0x43, 0xb8, 0x35, 0xc6, 0x2c, 0x4a,
+	0xb2, 0xd8, 0x34, 0x9d, 0x3c, 0xf1, 0xc3, 0x01, 0x86, 0x43, 0x61, 0x9a, 0x6e, 0xdb, 0xc0, 0x0f,
+	0x0d, 0xfa, 0xb9, 0x02, 0xc9, 0x13, 0xb8, 0x15, 0xe6, 0x9c, 0x63, 0x26, 0xfd, 0x3c, 0x2b, 0x5a,
+	0x6f, 0x66, 0xb7, 0xbe, 0x8c, 0xdd, 0x6d, 0xc3, 0x7e, 0xa2, 0xc8, 0xd3, 0xda, 0x75, 0xe1, 0xa6,
+	0xc8, 0x83, 0x51, 0x22, 0x8a, 0x4b, 0xe2, 0x8f, 0x99, 0x48, 0xa6, 0xad, 0xd6, 0xf4, 0xc8, 0x0c,
+	0xfa, 0xca, 0x20, 0xce, 0x31, 0x90, 0x6a, 0x3f, 0x90, 0x36, 0x40, 0xc8, 0xb2, 0xa3, 0x24, 0xce,
+	0x39, 0xea, 0xfa, 0x5c, 0xf7, 0xe6, 0x22, 0xe4, 0x2d, 0x58, 0xe3, 0x48, 0xc3, 0x01, 0x0d, 0x52,
+	0x34, 0x37, 0x70, 0x16, 0x20, 0x77, 0x00, 0xd4, 0x01, 0x22, 0xe7, 0x8c, 0xeb, 0xd3, 0xf3, 0xd6,
+	0x8a, 0xc8, 0x41, 0x11, 0xd8, 0xff, 0xab, 0x06, 0x9b, 0x07, 0xa6, 0x2f, 0xfb, 0xba, 0x2d, 0xc9,
+	0x0f, 0x40, 0xaa, 0xbd, 0x46, 0x1e, 0xbc, 0xce, 0xf5, 0x33, 0x63, 0xa4, 0xf5, 0xc1, 0xeb, 0x91,
+	0xcc, 0xa4, 0xf9, 0xd9, 0x82, 0xdb, 0x17, 0xcc, 0x4b, 0xf2, 0xf1, 0x62, 0xd5, 0xcb, 0x47, 0x7a,
+	0xeb, 0x93, 0x7f, 0xc9, 0xd6, 0x9b, 0xdb, 0xb5, 0xc8, 0xb7, 0x70, 0xcd, 0x4c, 0x56, 0xd2, 0x59,
+	0xac, 0x75, 0x7e, 0x1c, 0xb7, 0xde, 0x5d, 0x22, 0xd3, 0xd8, 0xff, 0x06, 0x56, 0xcd, 0xd1, 0xbf,
+	0x73, 0xc1, 0x56, 0xe7, 0xc7, 0x75, 0xab, 0x73, 0x79, 0xa2, 0x16, 0xef, 0x7d, 0xf9, 0xe2, 0xb4,
+	0x6d, 0xbd, 0x3c, 0x6d, 0x5b, 0x7f, 0x9e, 0xb6, 0xad, 0x9f, 0xce, 0xda, 0x2b, 0x2f, 0xcf, 0xda,
+	0x2b, 0xbf, 0x9f, 0xb5, 0x57, 0xbe, 0xde, 0x8d, 0x13, 0x39, 0xc8, 0x03, 0x37, 0x64, 0x23, 0xf5,
+	0x30, 0x79, 0x9f, 0xf1, 0x58, 0x7d, 0x44, 0xd3, 0xe7, 0x48, 0xb7, 0x7c, 0xa2, 0xc8, 0x93, 0x31,
+	0x8a, 0x60, 0x55, 0x3d, 0x24, 0x1e, 0xfc, 0x3d, 0x00, 0xef, 0x64, 0x14, 0x33, 0xc8, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -258,9 +717,15 @@ const _ = grpc.SupportPackageIsVersion4
 type EthereumSidecarClient interface {
 	// AssetsLockedEvents returns AssetsLockedEvents within a sequence range.
 	AssetsLockedEvents(ctx context.Context, in *AssetsLockedEventsRequest, opts ...grpc.CallOption) (*AssetsLockedEventsResponse, error)
+	// SubscribeAssetsLockedEvents streams AssetsLockedEvents starting from the
+	// given sequence. The events already known to the Ethereum sidecar are sent
+	// first, then newly finalized events are pushed as they are observed.
+	SubscribeAssetsLockedEvents(ctx context.Context, in *SubscribeAssetsLockedEventsRequest, opts ...grpc.CallOption) (EthereumSidecar_SubscribeAssetsLockedEventsClient, error)
 	// Version returns the current version of the Ethereum sidecar (can be used as
 	// an health check).
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// Status returns the internal state of the Ethereum sidecar.
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 }
 
 type ethereumSidecarClient struct {
@@ -280,6 +745,38 @@ func (c *ethereumSidecarClient) AssetsLockedEvents(ctx context.Context, in *Asse
 	return out, nil
 }
 
+func (c *ethereumSidecarClient) SubscribeAssetsLockedEvents(ctx context.Context, in *SubscribeAssetsLockedEventsRequest, opts ...grpc.CallOption) (EthereumSidecar_SubscribeAssetsLockedEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EthereumSidecar_serviceDesc.Streams[0], "/mezo.ethereum_sidecar.v1.EthereumSidecar/SubscribeAssetsLockedEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ethereumSidecarSubscribeAssetsLockedEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EthereumSidecar_SubscribeAssetsLockedEventsClient interface {
+	Recv() (*SubscribeAssetsLockedEventsResponse, error)
+	grpc.ClientStream
+}
+
+type ethereumSidecarSubscribeAssetsLockedEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ethereumSidecarSubscribeAssetsLockedEventsClient) Recv() (*SubscribeAssetsLockedEventsResponse, error) {
+	m := new(SubscribeAssetsLockedEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ethereumSidecarClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
 	out := new(VersionResponse)
 	err := c.cc.Invoke(ctx, "/mezo.ethereum_sidecar.v1.EthereumSidecar/Version", in, out, opts...)
@@ -289,13 +786,28 @@ func (c *ethereumSidecarClient) Version(ctx context.Context, in *VersionRequest,
 	return out, nil
 }
 
+func (c *ethereumSidecarClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/mezo.ethereum_sidecar.v1.EthereumSidecar/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EthereumSidecarServer is the server API for EthereumSidecar service.
 type EthereumSidecarServer interface {
 	// AssetsLockedEvents returns AssetsLockedEvents within a sequence range.
 	AssetsLockedEvents(context.Context, *AssetsLockedEventsRequest) (*AssetsLockedEventsResponse, error)
+	// SubscribeAssetsLockedEvents streams AssetsLockedEvents starting from the
+	// given sequence. The events already known to the Ethereum sidecar are sent
+	// first, then newly finalized events are pushed as they are observed.
+	SubscribeAssetsLockedEvents(*SubscribeAssetsLockedEventsRequest, EthereumSidecar_SubscribeAssetsLockedEventsServer) error
 	// Version returns the current version of the Ethereum sidecar (can be used as
 	// an health check).
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// Status returns the internal state of the Ethereum sidecar.
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
 }
 
 // UnimplementedEthereumSidecarServer can be embedded to have forward compatible implementations.
@@ -305,9 +817,15 @@ type UnimplementedEthereumSidecarServer struct {
 func (*UnimplementedEthereumSidecarServer) AssetsLockedEvents(ctx context.Context, req *AssetsLockedEventsRequest) (*AssetsLockedEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetsLockedEvents not implemented")
 }
+func (*UnimplementedEthereumSidecarServer) SubscribeAssetsLockedEvents(req *SubscribeAssetsLockedEventsRequest, srv EthereumSidecar_SubscribeAssetsLockedEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAssetsLockedEvents not implemented")
+}
 func (*UnimplementedEthereumSidecarServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (*UnimplementedEthereumSidecarServer) Status(ctx context.Context, req *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
 
 func RegisterEthereumSidecarServer(s grpc1.Server, srv EthereumSidecarServer) {
 	s.RegisterService(&_EthereumSidecar_serviceDesc, srv)
@@ -331,6 +849,27 @@ func _EthereumSidecar_AssetsLockedEvents_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EthereumSidecar_SubscribeAssetsLockedEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAssetsLockedEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EthereumSidecarServer).SubscribeAssetsLockedEvents(m, &ethereumSidecarSubscribeAssetsLockedEventsServer{stream})
+}
+
+type EthereumSidecar_SubscribeAssetsLockedEventsServer interface {
+	Send(*SubscribeAssetsLockedEventsResponse) error
+	grpc.ServerStream
+}
+
+type ethereumSidecarSubscribeAssetsLockedEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ethereumSidecarSubscribeAssetsLockedEventsServer) Send(m *SubscribeAssetsLockedEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _EthereumSidecar_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionRequest)
 	if err := dec(in); err != nil {
@@ -349,8 +888,26 @@ func _EthereumSidecar_Version_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _EthereumSidecar_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mezo.ethereum_sidecar.v1.EthereumSidecar",
+func _EthereumSidecar_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthereumSidecarServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.ethereum_sidecar.v1.EthereumSidecar/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthereumSidecarServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EthereumSidecar_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mezo.ethereum_sidecar.v1.EthereumSidecar",
 	HandlerType: (*EthereumSidecarServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -361,8 +918,18 @@ var _EthereumSidecar_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _EthereumSidecar_Version_Handler,
 		},
+		{
+			MethodName: "Status",
+			Handler:    _EthereumSidecar_Status_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeAssetsLockedEvents",
+			Handler:       _EthereumSidecar_SubscribeAssetsLockedEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mezo/ethereum_sidecar/v1/ethereum_sidecar.proto",
 }
 
@@ -446,6 +1013,76 @@ func (m *AssetsLockedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscribeAssetsLockedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeAssetsLockedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeAssetsLockedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SequenceStart.Size()
+		i -= size
+		if _, err := m.SequenceStart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeAssetsLockedEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeAssetsLockedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeAssetsLockedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VersionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -499,71 +1136,438 @@ func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEthereumSidecar(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEthereumSidecar(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AssetsLockedEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SequenceStart.Size()
-	n += 1 + l + sovEthereumSidecar(uint64(l))
-	l = m.SequenceEnd.Size()
-	n += 1 + l + sovEthereumSidecar(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetsLockedEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovEthereumSidecar(uint64(l))
+	{
+		size, err := m.BridgeWorker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AssetsLockedEvents.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.LastFinalizedBlock != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.LastFinalizedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssetsLockedEventsStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetsLockedEventsStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetsLockedEventsStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.LastSequence.Size()
+		i -= size
+		if _, err := m.LastSequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FirstSequence.Size()
+		i -= size
+		if _, err := m.FirstSequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Count != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *VersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovEthereumSidecar(uint64(l))
+	if m.SubmissionPosition != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.SubmissionPosition))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	{
+		size := m.CurrentUnlockSequence.Size()
+		i -= size
+		if _, err := m.CurrentUnlockSequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PendingFinalityChecks != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.PendingFinalityChecks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueueLength != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.QueueLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BridgeValidatorId != 0 {
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(m.BridgeValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEthereumSidecar(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BridgeWorkerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozEthereumSidecar(x uint64) (n int) {
+
+func (m *BridgeWorkerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeWorkerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintEthereumSidecar(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reachable {
+		i--
+		if m.Reachable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Configured {
+		i--
+		if m.Configured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEthereumSidecar(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEthereumSidecar(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetsLockedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceStart.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	l = m.SequenceEnd.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	return n
+}
+
+func (m *AssetsLockedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEthereumSidecar(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeAssetsLockedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceStart.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	return n
+}
+
+func (m *SubscribeAssetsLockedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEthereumSidecar(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEthereumSidecar(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEthereumSidecar(uint64(l))
+	}
+	if m.Ready {
+		n += 2
+	}
+	if m.LastFinalizedBlock != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.LastFinalizedBlock))
+	}
+	l = m.AssetsLockedEvents.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	l = m.Attestation.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	l = m.BridgeWorker.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	return n
+}
+
+func (m *AssetsLockedEventsStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.Count))
+	}
+	l = m.FirstSequence.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	l = m.LastSequence.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	return n
+}
+
+func (m *AttestationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeValidatorId != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.BridgeValidatorId))
+	}
+	if m.QueueLength != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.QueueLength))
+	}
+	if m.PendingFinalityChecks != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.PendingFinalityChecks))
+	}
+	l = m.CurrentUnlockSequence.Size()
+	n += 1 + l + sovEthereumSidecar(uint64(l))
+	if m.SubmissionPosition != 0 {
+		n += 1 + sovEthereumSidecar(uint64(m.SubmissionPosition))
+	}
+	return n
+}
+
+func (m *BridgeWorkerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configured {
+		n += 2
+	}
+	if m.Reachable {
+		n += 2
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovEthereumSidecar(uint64(l))
+	}
+	return n
+}
+
+func sovEthereumSidecar(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEthereumSidecar(x uint64) (n int) {
 	return sovEthereumSidecar(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *AssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
@@ -576,30 +1580,686 @@ func (m *AssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEthereumSidecar
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsLockedEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsLockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsLockedEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsLockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &types.AssetsLockedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeAssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeAssetsLockedEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeAssetsLockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeAssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeAssetsLockedEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeAssetsLockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &types.AssetsLockedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSidecar
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFinalizedBlock", wireType)
+			}
+			m.LastFinalizedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFinalizedBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AssetsLockedEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsLockedEventsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsLockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEthereumSidecar
@@ -609,31 +2269,30 @@ func (m *AssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeWorker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEthereumSidecar
@@ -643,23 +2302,22 @@ func (m *AssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BridgeWorker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -684,7 +2342,7 @@ func (m *AssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *AssetsLockedEventsStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -707,17 +2365,36 @@ func (m *AssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsLockedEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssetsLockedEventsStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsLockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssetsLockedEventsStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSequence", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEthereumSidecar
@@ -727,23 +2404,57 @@ func (m *AssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEthereumSidecar
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &types.AssetsLockedEvent{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FirstSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -768,7 +2479,7 @@ func (m *AssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionRequest) Unmarshal(dAtA []byte) error {
+func (m *AttestationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -791,12 +2502,122 @@ func (m *VersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeValidatorId", wireType)
+			}
+			m.BridgeValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeValidatorId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLength", wireType)
+			}
+			m.QueueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFinalityChecks", wireType)
+			}
+			m.PendingFinalityChecks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingFinalityChecks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentUnlockSequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSidecar
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentUnlockSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionPosition", wireType)
+			}
+			m.SubmissionPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionPosition |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEthereumSidecar(dAtA[iNdEx:])
@@ -818,7 +2639,7 @@ func (m *VersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+func (m *BridgeWorkerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -841,15 +2662,55 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeWorkerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeWorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configured", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Configured = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reachable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSidecar
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reachable = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -877,7 +2738,7 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
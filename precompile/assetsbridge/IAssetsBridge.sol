@@ -49,7 +49,7 @@ interface IAssetsBridge {
      * @param recipient The address it's bridged out to on the target chain.
      * @param token The address of the ERC20 token on the target chain.
      * @param sender The address bridging out.
-     * @param amount The amount bridged out.
+     * @param amount The amount bridged out, net of the bridge-out fee.
      * @param chain The chain to which the funds are being bridged out to, for reference
      *        please see the enum on the MezoBridge contract
      *        https://github.com/thesis/mezo-portal/blob/main/solidity/contracts/MezoBridge.sol#L22-L27
@@ -123,6 +123,111 @@ interface IAssetsBridge {
         bool isAllowed
     );
 
+    /**
+     * @notice Emitted when a bridge-out is held in the delayed bridge-out
+     *         queue instead of being unlocked right away.
+     * @param id The identifier of the delayed bridge-out.
+     * @param recipient The address it's bridged out to on the target chain.
+     * @param token The address of the token on the Mezo chain.
+     * @param sender The address bridging out.
+     * @param amount The amount bridged out.
+     * @param chain The chain to which the funds are being bridged out to.
+     * @param releaseHeight The block height at which the bridge-out is
+     *        released as an AssetsUnlocked event.
+     */
+    event BridgeOutDelayed(
+        uint256 indexed id,
+        bytes indexed recipient,
+        address indexed token,
+        address sender,
+        uint256 amount,
+        uint8 chain,
+        uint256 releaseHeight
+    );
+
+    /**
+     * @notice Emitted when a delayed bridge-out is cancelled and its assets
+     *         are refunded to the sender.
+     * @param id The identifier of the cancelled bridge-out.
+     * @param sender The address the assets are refunded to.
+     * @param token The address of the token on the Mezo chain.
+     * @param amount The refunded amount.
+     */
+    event DelayedBridgeOutCancelled(
+        uint256 indexed id,
+        address indexed sender,
+        address indexed token,
+        uint256 amount
+    );
+
+    /**
+     * @notice Emitted when an additional bridge-in source chain is registered.
+     * @param chain The identifier of the source chain.
+     * @param name The human-readable name of the source chain.
+     * @param sourceBTCToken The address of the BTC token on the source chain.
+     */
+    event SourceChainRegistered(
+        uint32 indexed chain,
+        string name,
+        address sourceBTCToken
+    );
+
+    /**
+     * @notice Emitted when a new ERC20 token mapping of an additional
+     *         source chain is created.
+     * @param chain The identifier of the source chain.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param mezoToken The address of the ERC20 token on the Mezo chain.
+     */
+    event SourceChainERC20TokenMappingCreated(
+        uint32 indexed chain,
+        address indexed sourceToken,
+        address indexed mezoToken
+    );
+
+    /**
+     * @notice Emitted when an ERC20 token mapping of an additional source
+     *         chain is deleted.
+     * @param chain The identifier of the source chain.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param mezoToken The address of the ERC20 token on the Mezo chain.
+     */
+    event SourceChainERC20TokenMappingDeleted(
+        uint32 indexed chain,
+        address indexed sourceToken,
+        address indexed mezoToken
+    );
+
+    /**
+     * @notice Emitted when a bridge-out fee is collected to the bridge-out
+     *         fee treasury.
+     * @param token The address of the token on the Mezo chain.
+     * @param treasury The address of the bridge-out fee treasury.
+     * @param amount The collected fee.
+     */
+    event BridgeOutFeeCollected(
+        address indexed token,
+        address indexed treasury,
+        uint256 amount
+    );
+
+    /**
+     * @notice Emitted when the lifecycle state of an ERC20 token mapping is
+     *         set.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param mezoToken The address of the ERC20 token on the Mezo chain.
+     * @param state The new lifecycle state of the mapping. See
+     *        getERC20TokenMappingState for the possible values.
+     * @param activationHeight The Mezo block height at which the mapping
+     *        becomes active. Set only for mappings pending activation.
+     */
+    event ERC20TokenMappingStateSet(
+        address indexed sourceToken,
+        address indexed mezoToken,
+        uint8 state,
+        uint64 activationHeight
+    );
+
     /**
      * @notice Helper function used to enable bridged assets observability.
      */
@@ -192,6 +297,13 @@ interface IAssetsBridge {
               - On Ethereum: recipient is a 20-byte EVM address
               - On Bitcoin: recipient is a proper standard-type Bitcoin script
                 supported by tBTC, i.e. P2PKH, P2WPKH, P2SH or P2WSH
+     * @dev The whole amount is taken from the caller. The bridge-out fee of
+     *      the token and the target chain, if any, is deducted from it and
+     *      sent to the bridge-out fee treasury. The fee of a delayed
+     *      bridge-out is sent only once the bridge-out is released. The
+     *      amount cannot exceed the part of the token supply minted for
+     *      deposits on Ethereum; tokens minted for deposits on additional
+     *      source chains are not backed by Ethereum liquidity.
      * @return True if the call succeeded, false otherwise.
      */
     function bridgeOut(address token, uint256 amount, uint8 chain, bytes calldata recipient) external returns (bool);
@@ -199,7 +311,8 @@ interface IAssetsBridge {
     /**
      * @notice Sets the outflow limit for a specific token.
      * @param token The address of the token to set the limit for.
-     * @param limit The maximum amount that can be bridged out in a 25,000 block window,
+     * @param limit The maximum amount that can be bridged out in a 25,000 block period,
+     *              or in the token's rolling outflow window if one is set,
      *              in the token-specific precision.
      * @dev Requirements:
      *      - The caller must be the PoA owner.
@@ -220,6 +333,8 @@ interface IAssetsBridge {
      * @return capacity The remaining outflow capacity for the token (outflow limit - current outflow),
      *                         in the token-specific precision.
      * @return resetHeight The block height when the capacity will reset (last outflow reset + reset blocks).
+     *                     For tokens with a rolling outflow window, the block height when the
+     *                     oldest outflow leaves the window.
      */
     function getOutflowCapacity(address token) external view returns (uint256 capacity, uint256 resetHeight);
 
@@ -423,4 +538,566 @@ interface IAssetsBridge {
      * @return sequenceTip The last processed request sequence number.
      */
     function getTripartyProcessedSequenceTip() external view returns (uint256 sequenceTip);
+
+    /**
+     * @notice Sets the rolling outflow window of a specific token. The window
+     *         is split into equally sized buckets; an outflow counts against
+     *         the outflow limit until its bucket is older than the window.
+     *         A zero window switches the token back to the fixed 25,000 block
+     *         outflow periods. The outflow accumulated so far is carried over.
+     * @param token The address of the token to set the window for.
+     * @param windowBlocks The length of the window, in blocks.
+     * @param buckets The number of buckets the window is split into.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The window must be zero or windowBlocks must be a positive
+     *        multiple of buckets, with at most 100 buckets.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setOutflowWindow(
+        address token,
+        uint64 windowBlocks,
+        uint32 buckets
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the rolling outflow window of a specific token.
+     * @param token The address of the token to check the window for.
+     * @return windowBlocks The length of the window, in blocks. Zero if the
+     *         token uses the fixed outflow periods.
+     * @return buckets The number of buckets the window is split into.
+     */
+    function getOutflowWindow(address token) external view returns (uint64 windowBlocks, uint32 buckets);
+
+    /**
+     * @notice Sets the global USD outflow limit. The limit covers the
+     *         outflows of all tokens having an outflow price feed.
+     * @param limit The maximum USD value that can be bridged out, with 18
+     *              decimals. Zero disables the limit.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setUSDOutflowLimit(uint256 limit) external returns (bool);
+
+    /**
+     * @notice Gets the global USD outflow limit.
+     * @return The USD outflow limit, with 18 decimals.
+     */
+    function getUSDOutflowLimit() external view returns (uint256);
+
+    /**
+     * @notice Sets the rolling window of the USD outflow limit. A zero window
+     *         switches the USD outflow limit to the fixed 25,000 block outflow
+     *         periods.
+     * @param windowBlocks The length of the window, in blocks.
+     * @param buckets The number of buckets the window is split into.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The window must be zero or windowBlocks must be a positive
+     *        multiple of buckets, with at most 100 buckets.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setUSDOutflowWindow(uint64 windowBlocks, uint32 buckets) external returns (bool);
+
+    /**
+     * @notice Gets the rolling window of the USD outflow limit.
+     * @return windowBlocks The length of the window, in blocks. Zero if the
+     *         fixed outflow periods are used.
+     * @return buckets The number of buckets the window is split into.
+     */
+    function getUSDOutflowWindow() external view returns (uint64 windowBlocks, uint32 buckets);
+
+    /**
+     * @notice Gets the remaining USD outflow capacity.
+     * @return capacity The remaining USD outflow capacity, with 18 decimals.
+     *         Zero if the limit is disabled.
+     * @return resetHeight The block height when the capacity will be next
+     *         replenished.
+     */
+    function getUSDOutflowCapacity() external view returns (uint256 capacity, uint256 resetHeight);
+
+    /**
+     * @notice Sets the oracle price feed valuing the outflows of a specific
+     *         token against the USD outflow limit.
+     * @param token The address of the token on the Mezo chain.
+     * @param currencyPair The oracle currency pair quoting the token in USD,
+     *        e.g. "ETH/USD". An empty pair removes the feed.
+     * @param decimals The number of decimals of the token.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The currency pair must be well-formed,
+     *      - The decimals must not exceed 36.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setOutflowPriceFeed(
+        address token,
+        string calldata currencyPair,
+        uint8 decimals
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the oracle price feed of a specific token.
+     * @param token The address of the token on the Mezo chain.
+     * @return currencyPair The oracle currency pair. Empty if the token has
+     *         no price feed.
+     * @return decimals The number of decimals of the token.
+     */
+    function getOutflowPriceFeed(address token) external view returns (string memory currencyPair, uint8 decimals);
+
+    /**
+     * @notice Sets the per-sender bridge-out limit parameters. Bridge-outs of
+     *         every sender are tracked in fixed windows of the given length.
+     * @param windowBlocks The length of the per-sender window, in blocks.
+     *        Zero disables the per-sender limits.
+     * @param maxCount The maximum number of bridge-outs a single sender can
+     *        execute in a window. Zero means the count is not limited.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner or a member of the emergency team,
+     *      - The max count must be zero if the window is zero.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setSenderOutflowParams(uint64 windowBlocks, uint32 maxCount) external returns (bool);
+
+    /**
+     * @notice Gets the per-sender bridge-out limit parameters.
+     * @return windowBlocks The length of the per-sender window, in blocks.
+     *         Zero if the per-sender limits are disabled.
+     * @return maxCount The maximum number of bridge-outs per sender and
+     *         window. Zero if the count is not limited.
+     */
+    function getSenderOutflowParams() external view returns (uint64 windowBlocks, uint32 maxCount);
+
+    /**
+     * @notice Sets the maximum amount of a specific token a single sender can
+     *         bridge out in a per-sender window.
+     * @param token The address of the token on the Mezo chain.
+     * @param limit The per-sender limit. Zero means the amount is not limited.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner or a member of the emergency team.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setSenderOutflowLimit(address token, uint256 limit) external returns (bool);
+
+    /**
+     * @notice Gets the per-sender outflow limit of a specific token.
+     * @param token The address of the token on the Mezo chain.
+     * @return The per-sender limit. Zero if the amount is not limited.
+     */
+    function getSenderOutflowLimit(address token) external view returns (uint256);
+
+    /**
+     * @notice Gets the remaining bridge-out capacity of a specific sender in
+     *         the current per-sender window.
+     * @param sender The address of the sender on the Mezo chain.
+     * @param token The address of the token on the Mezo chain.
+     * @return amountLimit The per-sender limit of the token.
+     * @return amountCapacity The amount of the token the sender can still
+     *         bridge out in the current window.
+     * @return countLimit The maximum number of bridge-outs per window.
+     * @return countCapacity The number of bridge-outs the sender can still
+     *         execute in the current window.
+     * @return resetHeight The block height at which the current window ends.
+     *         Zero if the per-sender limits are disabled.
+     */
+    function getSenderOutflowCapacity(
+        address sender,
+        address token
+    )
+        external
+        view
+        returns (
+            uint256 amountLimit,
+            uint256 amountCapacity,
+            uint32 countLimit,
+            uint32 countCapacity,
+            uint256 resetHeight
+        );
+
+    /**
+     * @notice Sets the number of blocks bridge-outs reaching the delayed
+     *         bridge-out threshold of their token are held in the queue.
+     * @param blocks The delay, in blocks. Zero disables the queue for new
+     *        bridge-outs; queued bridge-outs keep their release height.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The delay must not exceed 1,000,000 blocks.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setDelayedBridgeOutBlocks(uint64 blocks) external returns (bool);
+
+    /**
+     * @notice Gets the number of blocks large bridge-outs are held in the
+     *         delayed bridge-out queue.
+     * @return The delay, in blocks. Zero if the queue is disabled.
+     */
+    function getDelayedBridgeOutBlocks() external view returns (uint64);
+
+    /**
+     * @notice Sets the minimum amount of a bridge-out of a specific token
+     *         held in the delayed bridge-out queue.
+     * @param token The address of the token on the Mezo chain.
+     * @param threshold The threshold. Zero means bridge-outs of the token are
+     *        never delayed.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setDelayedBridgeOutThreshold(address token, uint256 threshold) external returns (bool);
+
+    /**
+     * @notice Gets the delayed bridge-out threshold of a specific token.
+     * @param token The address of the token on the Mezo chain.
+     * @return The threshold. Zero if bridge-outs of the token are never delayed.
+     */
+    function getDelayedBridgeOutThreshold(address token) external view returns (uint256);
+
+    /**
+     * @notice Gets a bridge-out held in the delayed bridge-out queue.
+     *         Released and cancelled bridge-outs are removed from the queue.
+     * @param id The identifier of the delayed bridge-out.
+     * @return sender The address bridging out.
+     * @return token The address of the token on the Mezo chain.
+     * @return amount The amount bridged out.
+     * @return chain The chain to which the funds are being bridged out to.
+     * @return recipient The address it's bridged out to on the target chain.
+     * @return releaseHeight The block height at which the bridge-out is released.
+     * @dev Reverts if the bridge-out is not in the queue.
+     */
+    function getDelayedBridgeOut(
+        uint256 id
+    )
+        external
+        view
+        returns (
+            address sender,
+            address token,
+            uint256 amount,
+            uint8 chain,
+            bytes memory recipient,
+            uint256 releaseHeight
+        );
+
+    /**
+     * @notice Cancels a bridge-out held in the delayed bridge-out queue and
+     *         refunds the whole burnt amount, fee included, to the sender.
+     *         The outflow consumed by the bridge-out is given back if it is
+     *         still counted in the current outflow period or window.
+     * @param id The identifier of the delayed bridge-out.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner or a member of the emergency team,
+     *      - The bridge-out must be in the queue.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function cancelDelayedBridgeOut(uint256 id) external returns (bool);
+
+    /**
+     * @notice Registers an additional source chain from which assets can be
+     *         bridged in, e.g. an L2 deployment of the MezoBridge contract.
+     *         Ethereum is the primary source chain and is always registered
+     *         under the identifier 0.
+     * @param chain The identifier of the source chain.
+     * @param name The human-readable name of the source chain.
+     * @param sourceBTCToken The address of the BTC token on the source chain.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The chain identifier must not be 0,
+     *      - The chain must not be registered yet,
+     *      - The name must not be empty or longer than 64 bytes,
+     *      - The sourceBTCToken address must not be the zero address,
+     *      - The maximum number of source chains must not be reached.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function registerSourceChain(
+        uint32 chain,
+        string calldata name,
+        address sourceBTCToken
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the sequence number of the last AssetsLocked event
+     *         bridged in from an additional source chain.
+     * @param chain The identifier of the source chain.
+     * @dev Reverts if the source chain is not registered.
+     * @return The sequence tip of the source chain.
+     */
+    function getSourceChainSequenceTip(uint32 chain) external view returns (uint256);
+
+    /**
+     * @notice Creates a new ERC20 token mapping of an additional source chain.
+     * @param chain The identifier of the source chain.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param mezoToken The address of the ERC20 token on the Mezo chain.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The source chain must be registered,
+     *      - The sourceToken address must not be the zero address,
+     *      - The mezoToken address must not be the zero address,
+     *      - The sourceToken must not be already mapped for the source chain,
+     *      - The maximum number of mappings must not be reached for the
+     *        source chain.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function createSourceChainERC20TokenMapping(
+        uint32 chain,
+        address sourceToken,
+        address mezoToken
+    ) external returns (bool);
+
+    /**
+     * @notice Deletes an existing ERC20 token mapping of an additional
+     *         source chain.
+     * @param chain The identifier of the source chain.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The mapping must exist.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function deleteSourceChainERC20TokenMapping(
+        uint32 chain,
+        address sourceToken
+    ) external returns (bool);
+
+    /**
+     * @notice Sets the address receiving the bridge-out fees.
+     * @param treasury The address of the bridge-out fee treasury.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The treasury address must not be the zero address.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setBridgeOutFeeTreasury(address treasury) external returns (bool);
+
+    /**
+     * @notice Gets the address receiving the bridge-out fees.
+     * @return The address of the bridge-out fee treasury. The zero address
+     *         if the treasury is not set.
+     */
+    function getBridgeOutFeeTreasury() external view returns (address);
+
+    /**
+     * @notice Sets the fee charged for bridging out a specific token to a
+     *         specific target chain. The fee is the flat part plus the given
+     *         basis points of the bridged-out amount. A zero fee removes it.
+     * @param token The address of the token on the Mezo chain.
+     * @param chain The target chain, 0 for Ethereum, 1 for Bitcoin.
+     * @param flat The flat part of the fee, in the token-specific precision.
+     * @param basisPoints The proportional part of the fee, in basis points.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The chain must be a supported target chain,
+     *      - The basis points must not exceed 10000,
+     *      - The bridge-out fee treasury must be set for a non-zero fee.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setBridgeOutFee(
+        address token,
+        uint8 chain,
+        uint256 flat,
+        uint32 basisPoints
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the fee charged for bridging out a specific token to a
+     *         specific target chain.
+     * @param token The address of the token on the Mezo chain.
+     * @param chain The target chain, 0 for Ethereum, 1 for Bitcoin.
+     * @return flat The flat part of the fee, in the token-specific precision.
+     * @return basisPoints The proportional part of the fee, in basis points.
+     */
+    function getBridgeOutFee(
+        address token,
+        uint8 chain
+    ) external view returns (uint256 flat, uint32 basisPoints);
+
+    /**
+     * @notice Creates a new ERC20 token mapping pending activation. The
+     *         mapping is announced right away but the token can be bridged
+     *         only from the activation height.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param mezoToken The address of the ERC20 token on the Mezo chain.
+     * @param activationHeight The Mezo block height at which the mapping
+     *        becomes active.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - Same requirements as for createERC20TokenMapping,
+     *      - The activation height must be above the current block height.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function scheduleERC20TokenMapping(
+        address sourceToken,
+        address mezoToken,
+        uint64 activationHeight
+    ) external returns (bool);
+
+    /**
+     * @notice Sets the lifecycle state of an existing ERC20 token mapping.
+     *         Setting the active state on a mapping pending activation
+     *         activates it early.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @param state The new lifecycle state of the mapping. See
+     *        getERC20TokenMappingState for the possible values. The pending
+     *        activation state cannot be set directly.
+     * @dev Requirements:
+     *      - The mapping must exist,
+     *      - The caller must be the PoA owner or a member of the emergency
+     *        team when switching between the active and paused states, and
+     *        the PoA owner otherwise.
+     * @return True if the call succeeded, false otherwise.
+     */
+    function setERC20TokenMappingState(
+        address sourceToken,
+        uint8 state
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the lifecycle state of an ERC20 token mapping.
+     * @param sourceToken The address of the ERC20 token on the source chain.
+     * @dev Reverts if the mapping does not exist.
+     * @return state The lifecycle state of the mapping: 0 for active, 1 for
+     *         pending activation, 2 for bridge-in paused, 3 for bridge-out
+     *         paused, 4 for paused in both directions and 5 for deprecated,
+     *         i.e. bridge-out only.
+     * @return activationHeight The Mezo block height at which the mapping
+     *         becomes active. Zero unless the mapping is pending activation.
+     */
+    function getERC20TokenMappingState(
+        address sourceToken
+    ) external view returns (uint8 state, uint64 activationHeight);
+
+    /**
+     * @notice Gets the outcome of a processed triparty bridge request.
+     *         Outcomes are retained for a limited number of blocks after the
+     *         request is processed.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @return status The outcome of the request: 0 if the request is pending,
+     *         unknown or its outcome was pruned, 1 if BTC was minted and the
+     *         callback succeeded, 2 if the request was skipped without
+     *         minting, 3 if BTC was minted but the callback failed and 4 if
+     *         the request was cancelled before maturing.
+     * @return reason The validation error of a skipped request or the error
+     *         of a failed callback. Empty otherwise.
+     * @return processingHeight The Mezo block height at which the request
+     *         was processed.
+     * @return gasUsed The gas used by the callback. Zero for skipped requests.
+     */
+    function getTripartyRequestOutcome(
+        uint256 sequence
+    )
+        external
+        view
+        returns (
+            uint8 status,
+            string memory reason,
+            uint64 processingHeight,
+            uint64 gasUsed
+        );
+
+    /**
+     * @notice Emitted when a failed triparty callback is retried with
+     *         retryTripartyCallback.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @param delivered Whether the callback was delivered.
+     */
+    event TripartyCallbackRetried(uint256 indexed sequence, bool delivered);
+
+    /**
+     * @notice Retries the failed controller callback of a processed triparty
+     *         bridge request. Failed callbacks are also retried automatically
+     *         according to the triparty callback retry policy.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @return delivered Whether the callback was delivered. A callback that
+     *         fails again stays queued for retry.
+     * @dev Manual retries count against the maximum number of retries of the
+     *      retry policy. The caller pays for the gas used by the callback,
+     *      up to the gas limit of the retry policy.
+     *
+     *      Requirements:
+     *      - The gas left to the call must cover the gas limit of the retry
+     *        policy,
+     *      - The callback must be queued for retry,
+     *      - The retries of the callback must not be exhausted,
+     *      - The callback must not have been attempted in the current block,
+     *      - Bridge-in must not be paused.
+     */
+    function retryTripartyCallback(
+        uint256 sequence
+    ) external returns (bool delivered);
+
+    /**
+     * @notice Gets the retry state of the failed controller callback of
+     *         a processed triparty bridge request.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @return queued Whether the callback is queued for retry.
+     * @return attempts The number of failed delivery attempts, including the
+     *         initial callback.
+     * @return lastAttemptHeight The Mezo block height of the last failed
+     *         delivery attempt.
+     * @return nextRetryHeight The Mezo block height of the next automatic
+     *         retry. Zero if automatic retries are exhausted.
+     */
+    function getFailedTripartyCallback(
+        uint256 sequence
+    )
+        external
+        view
+        returns (
+            bool queued,
+            uint32 attempts,
+            uint64 lastAttemptHeight,
+            uint64 nextRetryHeight
+        );
+
+    /**
+     * @notice Sets the retry policy of failed triparty callbacks.
+     * @param maxAttempts The maximum number of automatic retries of a failed
+     *        callback. Zero disables automatic retries.
+     * @param intervalBlocks The number of blocks between delivery attempts.
+     * @param gasLimit The gas limit of a retried callback.
+     * @dev Requirements:
+     *      - The caller must be the PoA owner,
+     *      - The max attempts must not exceed 10,
+     *      - The interval must be at least 1,
+     *      - The gas limit must be in range (0, 5000000].
+     */
+    function setTripartyCallbackRetryPolicy(
+        uint32 maxAttempts,
+        uint64 intervalBlocks,
+        uint64 gasLimit
+    ) external returns (bool);
+
+    /**
+     * @notice Gets the retry policy of failed triparty callbacks.
+     * @return maxAttempts The maximum number of automatic retries.
+     * @return intervalBlocks The number of blocks between delivery attempts.
+     * @return gasLimit The gas limit of a retried callback.
+     */
+    function getTripartyCallbackRetryPolicy()
+        external
+        view
+        returns (uint32 maxAttempts, uint64 intervalBlocks, uint64 gasLimit);
+
+    /**
+     * @notice Emitted when a pending triparty bridge request is cancelled.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @param cancelledBy The address that cancelled the request.
+     */
+    event TripartyRequestCancelled(
+        uint256 indexed sequence,
+        address indexed cancelledBy
+    );
+
+    /**
+     * @notice Cancels a pending triparty bridge request before it matures.
+     *         No BTC is minted for a cancelled request and its amount is
+     *         released from the current triparty window.
+     * @param sequence The sequence number of the triparty bridge request.
+     * @dev Requirements:
+     *      - The caller must be the controller that submitted the request,
+     *        the PoA owner or a member of the emergency team,
+     *      - The request must be pending and not cancelled yet,
+     *      - The request must not be mature yet.
+     */
+    function cancelTripartyRequest(uint256 sequence) external returns (bool);
 }
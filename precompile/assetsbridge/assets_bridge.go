@@ -144,6 +144,41 @@ func NewPrecompileVersionMap(
 		return nil, err
 	}
 
+	// v7 is all previous settings plus the methods managing rolling outflow
+	// windows, the USD-denominated outflow limit, the per-sender bridge-out
+	// limits, the delayed bridge-out queue, the methods managing additional
+	// bridge-in source chains, the methods managing bridge-out fees, the
+	// methods managing the ERC20 token mapping lifecycle, the method
+	// exposing the outcomes of triparty bridge requests, the methods
+	// retrying failed triparty callbacks and the method cancelling pending
+	// triparty bridge requests.
+	contractV7, err := NewPrecompile(
+		poaKeeper,
+		bridgeKeeper,
+		authzKeeper,
+		&Settings{
+			Observability:         true,
+			BTCManagement:         true,
+			ERC20Management:       true,
+			SequenceTipView:       true,
+			BridgeOut:             true,
+			Triparty:              true,
+			BridgeOutChains:       true,
+			OutflowPolicies:       true,
+			SenderOutflowLimits:   true,
+			DelayedBridgeOut:      true,
+			SourceChains:          true,
+			BridgeOutFees:         true,
+			ERC20MappingLifecycle: true,
+			TripartyOutcomes:      true,
+			TripartyCallbackRetry: true,
+			TripartyCancellation:  true,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return precompile.NewVersionMap(
 		map[int]*precompile.Contract{
 			0: contractV1, // returning v1 as v0 is legacy to support this precompile before versioning was introduced
@@ -152,19 +187,29 @@ func NewPrecompileVersionMap(
 			3: contractV3,
 			4: contractV4,
 			5: contractV5,
-			evmtypes.AssetsBridgePrecompileLatestVersion: contractV6,
+			6: contractV6,
+			evmtypes.AssetsBridgePrecompileLatestVersion: contractV7,
 		},
 	), nil
 }
 
 type Settings struct {
-	Observability   bool // enable methods related to the bridge observability
-	BTCManagement   bool // enable methods related to the BTC bridging management
-	ERC20Management bool // enable methods related to the ERC20 bridging management
-	SequenceTipView bool // enable the method to expose the sequence tip
-	BridgeOut       bool // enable the bridgeOut method
-	Triparty        bool // enable triparty bridging methods
-	BridgeOutChains bool // enable methods managing the set of chains enabled for bridge-outs
+	Observability         bool // enable methods related to the bridge observability
+	BTCManagement         bool // enable methods related to the BTC bridging management
+	ERC20Management       bool // enable methods related to the ERC20 bridging management
+	SequenceTipView       bool // enable the method to expose the sequence tip
+	BridgeOut             bool // enable the bridgeOut method
+	Triparty              bool // enable triparty bridging methods
+	BridgeOutChains       bool // enable methods managing the set of chains enabled for bridge-outs
+	OutflowPolicies       bool // enable methods managing rolling outflow windows and the USD outflow limit
+	SenderOutflowLimits   bool // enable methods managing the per-sender bridge-out limits
+	DelayedBridgeOut      bool // enable the delayed bridge-out queue and the methods managing it
+	SourceChains          bool // enable methods managing additional bridge-in source chains
+	BridgeOutFees         bool // enable methods managing the bridge-out fees and their treasury
+	ERC20MappingLifecycle bool // enable methods managing the ERC20 token mapping lifecycle states
+	TripartyOutcomes      bool // enable the method exposing the outcomes of processed triparty bridge requests
+	TripartyCallbackRetry bool // enable methods retrying failed triparty callbacks and managing their retry policy
+	TripartyCancellation  bool // enable the method cancelling pending triparty bridge requests
 }
 
 // NewPrecompile creates a new Assets Bridge precompile.
@@ -209,7 +254,7 @@ func NewPrecompile(
 	}
 
 	if settings.BridgeOut {
-		methods = append(methods, newBridgeOutMethod(bridgeKeeper, authzKeeper))
+		methods = append(methods, newBridgeOutMethod(bridgeKeeper, authzKeeper, settings.DelayedBridgeOut))
 		methods = append(methods, newSetOutflowLimitMethod(poaKeeper, bridgeKeeper))
 		methods = append(methods, newGetOutflowLimitMethod(bridgeKeeper))
 		methods = append(methods, newGetOutflowCapacityMethod(bridgeKeeper))
@@ -240,6 +285,70 @@ func NewPrecompile(
 		methods = append(methods, newGetBridgeOutChainsMethod(bridgeKeeper))
 	}
 
+	if settings.OutflowPolicies {
+		methods = append(methods, newSetOutflowWindowMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetOutflowWindowMethod(bridgeKeeper))
+		methods = append(methods, newSetUSDOutflowLimitMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetUSDOutflowLimitMethod(bridgeKeeper))
+		methods = append(methods, newSetUSDOutflowWindowMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetUSDOutflowWindowMethod(bridgeKeeper))
+		methods = append(methods, newGetUSDOutflowCapacityMethod(bridgeKeeper))
+		methods = append(methods, newSetOutflowPriceFeedMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetOutflowPriceFeedMethod(bridgeKeeper))
+	}
+
+	if settings.SenderOutflowLimits {
+		methods = append(methods, newSetSenderOutflowParamsMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetSenderOutflowParamsMethod(bridgeKeeper))
+		methods = append(methods, newSetSenderOutflowLimitMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetSenderOutflowLimitMethod(bridgeKeeper))
+		methods = append(methods, newGetSenderOutflowCapacityMethod(bridgeKeeper))
+	}
+
+	if settings.DelayedBridgeOut {
+		methods = append(methods, newSetDelayedBridgeOutBlocksMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetDelayedBridgeOutBlocksMethod(bridgeKeeper))
+		methods = append(methods, newSetDelayedBridgeOutThresholdMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetDelayedBridgeOutThresholdMethod(bridgeKeeper))
+		methods = append(methods, newGetDelayedBridgeOutMethod(bridgeKeeper))
+		methods = append(methods, newCancelDelayedBridgeOutMethod(poaKeeper, bridgeKeeper))
+	}
+
+	if settings.SourceChains {
+		methods = append(methods, newRegisterSourceChainMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetSourceChainSequenceTipMethod(bridgeKeeper))
+		methods = append(methods, newCreateSourceChainERC20TokenMappingMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newDeleteSourceChainERC20TokenMappingMethod(poaKeeper, bridgeKeeper))
+	}
+
+	if settings.BridgeOutFees {
+		methods = append(methods, newSetBridgeOutFeeTreasuryMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetBridgeOutFeeTreasuryMethod(bridgeKeeper))
+		methods = append(methods, newSetBridgeOutFeeMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetBridgeOutFeeMethod(bridgeKeeper))
+	}
+
+	if settings.ERC20MappingLifecycle {
+		methods = append(methods, newScheduleERC20TokenMappingMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newSetERC20TokenMappingStateMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetERC20TokenMappingStateMethod(bridgeKeeper))
+	}
+
+	if settings.TripartyOutcomes {
+		methods = append(methods, newGetTripartyRequestOutcomeMethod(bridgeKeeper))
+	}
+
+	if settings.TripartyCallbackRetry {
+		methods = append(methods, newRetryTripartyCallbackMethod(bridgeKeeper))
+		methods = append(methods, newGetFailedTripartyCallbackMethod(bridgeKeeper))
+		methods = append(methods, newSetTripartyCallbackRetryPolicyMethod(poaKeeper, bridgeKeeper))
+		methods = append(methods, newGetTripartyCallbackRetryPolicyMethod(bridgeKeeper))
+	}
+
+	if settings.TripartyCancellation {
+		methods = append(methods, newCancelTripartyRequestMethod(poaKeeper, bridgeKeeper))
+	}
+
 	contract.RegisterMethods(methods...)
 
 	return contract, nil
@@ -270,6 +379,7 @@ func PackEventsToInput(events []AssetsLockedEvent) ([]byte, error) {
 
 type PoaKeeper interface {
 	CheckOwner(ctx sdk.Context, sender sdk.AccAddress) error
+	CheckOwnerOrEmergencyTeam(ctx sdk.Context, sender sdk.AccAddress) error
 }
 
 type BridgeKeeper interface {
@@ -311,6 +421,56 @@ type BridgeKeeper interface {
 	SetOutflowLimit(ctx sdk.Context, token []byte, limit math.Int)
 	GetOutflowLimit(ctx sdk.Context, token []byte) math.Int
 	GetOutflowCapacity(ctx sdk.Context, token []byte) (capacity math.Int, resetHeight uint64)
+	GetOutflowWindow(ctx sdk.Context, token []byte) bridgetypes.OutflowWindow
+	SetOutflowWindow(ctx sdk.Context, token []byte, window bridgetypes.OutflowWindow) error
+	GetUSDOutflowLimit(ctx sdk.Context) math.Int
+	SetUSDOutflowLimit(ctx sdk.Context, limit math.Int)
+	GetUSDOutflowWindow(ctx sdk.Context) bridgetypes.OutflowWindow
+	SetUSDOutflowWindow(ctx sdk.Context, window bridgetypes.OutflowWindow) error
+	GetUSDOutflowCapacity(ctx sdk.Context) (capacity math.Int, resetHeight uint64)
+	GetOutflowPriceFeed(ctx sdk.Context, token []byte) (bridgetypes.OutflowPriceFeed, bool)
+	SetOutflowPriceFeed(ctx sdk.Context, feed bridgetypes.OutflowPriceFeed) error
+	RemoveOutflowPriceFeed(ctx sdk.Context, token []byte)
+	GetSenderOutflowParams(ctx sdk.Context) bridgetypes.SenderOutflowParams
+	SetSenderOutflowParams(ctx sdk.Context, params bridgetypes.SenderOutflowParams) error
+	GetSenderOutflowLimit(ctx sdk.Context, token []byte) math.Int
+	SetSenderOutflowLimit(ctx sdk.Context, token []byte, limit math.Int)
+	GetSenderOutflowCapacity(ctx sdk.Context, sender []byte, token []byte) bridgetypes.SenderOutflowCapacity
+	GetDelayedBridgeOutBlocks(ctx sdk.Context) uint64
+	SetDelayedBridgeOutBlocks(ctx sdk.Context, blocks uint64) error
+	GetDelayedBridgeOutThreshold(ctx sdk.Context, token []byte) math.Int
+	SetDelayedBridgeOutThreshold(ctx sdk.Context, token []byte, threshold math.Int)
+	IsDelayedBridgeOut(ctx sdk.Context, token []byte, amount math.Int) bool
+	SaveDelayedBridgeOut(
+		ctx sdk.Context,
+		recipient []byte,
+		token []byte,
+		sender []byte,
+		amount math.Int,
+		chain uint8,
+	) (*bridgetypes.DelayedBridgeOut, error)
+	GetDelayedBridgeOut(ctx sdk.Context, id uint64) (*bridgetypes.DelayedBridgeOut, bool)
+	CancelDelayedBridgeOut(ctx sdk.Context, id uint64) (*bridgetypes.DelayedBridgeOut, []statedb.StateChange, error)
+	GetBridgeOutFeeTreasury(ctx sdk.Context) []byte
+	SetBridgeOutFeeTreasury(ctx sdk.Context, treasury []byte) error
+	GetBridgeOutFee(ctx sdk.Context, mezoToken []byte, chain uint8) bridgetypes.BridgeOutFee
+	SetBridgeOutFee(ctx sdk.Context, fee bridgetypes.BridgeOutFee) error
+	CollectBridgeOutFee(ctx sdk.Context, mezoToken []byte, fee math.Int) ([]statedb.StateChange, error)
+	ScheduleERC20TokenMapping(ctx sdk.Context, sourceToken, mezoToken []byte, activationHeight uint64) error
+	SetERC20TokenMappingState(ctx sdk.Context, sourceToken []byte, state bridgetypes.ERC20TokenMappingState) error
+	GetTripartyBridgeRequestOutcome(ctx sdk.Context, sequence math.Int) (*bridgetypes.TripartyBridgeRequestOutcome, bool)
+	RetryTripartyCallback(ctx sdk.Context, sequence math.Int, availableGas uint64) (bool, uint64, []statedb.StateChange, error)
+	GetFailedTripartyCallback(ctx sdk.Context, sequence math.Int) (*bridgetypes.FailedTripartyCallback, bool)
+	SetTripartyCallbackRetryPolicy(ctx sdk.Context, policy bridgetypes.TripartyCallbackRetryPolicy) error
+	GetTripartyCallbackRetryPolicy(ctx sdk.Context) bridgetypes.TripartyCallbackRetryPolicy
+	GetTripartyBridgeRequest(ctx sdk.Context, sequence math.Int) (*bridgetypes.TripartyBridgeRequest, bool)
+	CancelTripartyBridgeRequest(ctx sdk.Context, sequence math.Int, cancelledBy string) error
+	RegisterSourceChain(ctx sdk.Context, chain bridgetypes.SourceChain) error
+	IsSourceChainRegistered(ctx sdk.Context, chain uint32) bool
+	GetSourceChainAssetsLockedSequenceTip(ctx sdk.Context, chain uint32) math.Int
+	CreateSourceChainERC20TokenMapping(ctx sdk.Context, chain uint32, sourceToken, mezoToken []byte) error
+	DeleteSourceChainERC20TokenMapping(ctx sdk.Context, chain uint32, sourceToken []byte) error
+	GetSourceChainERC20TokenMapping(ctx sdk.Context, chain uint32, sourceToken []byte) (*bridgetypes.ERC20TokenMapping, bool)
 	IsAllowedTripartyController(ctx sdk.Context, controller []byte) bool
 	AllowTripartyController(ctx sdk.Context, controller []byte, isAllowed bool)
 	GetTripartyBlockDelay(ctx sdk.Context) int64
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/math"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,15 +33,20 @@ var SendMsgURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
 type BridgeOutMethod struct {
 	bridgeKeeper BridgeKeeper
 	authzKeeper  AuthzKeeper
+	// delayedBridgeOut enables holding large bridge-outs in the delayed
+	// bridge-out queue.
+	delayedBridgeOut bool
 }
 
 func newBridgeOutMethod(
 	bridgeKeeper BridgeKeeper,
 	authzKeeper AuthzKeeper,
+	delayedBridgeOut bool,
 ) *BridgeOutMethod {
 	return &BridgeOutMethod{
-		bridgeKeeper: bridgeKeeper,
-		authzKeeper:  authzKeeper,
+		bridgeKeeper:     bridgeKeeper,
+		authzKeeper:      authzKeeper,
+		delayedBridgeOut: delayedBridgeOut,
 	}
 }
 
@@ -78,13 +84,19 @@ func (m *BridgeOutMethod) Run(
 }
 
 // execute will execute the burn of the token then send
-// AssetsUnlocked events to the bridgeKeeper.
+// AssetsUnlocked events to the bridgeKeeper. Bridge-outs reaching the delayed
+// bridge-out threshold are held in the delayed bridge-out queue instead; their
+// assets are burnt right away and refunded if the bridge-out is cancelled.
+// The whole amount is burnt from the sender and the bridge-out fee, if any,
+// is minted to the bridge-out fee treasury afterwards. The fee of a delayed
+// bridge-out is collected by the bridge keeper only once it is released.
 func (m *BridgeOutMethod) execute(
 	context *precompile.RunContext,
 	inputs *bridgeOutInputs,
 ) (precompile.MethodOutputs, []statedb.StateChange, error) {
 	var (
 		err   error
+		fee   math.Int
 		isBTC = bytes.Equal(
 			common.HexToAddress(evmtypes.BTCTokenPrecompileAddress).Bytes(),
 			inputs.Token.Bytes(),
@@ -95,6 +107,88 @@ func (m *BridgeOutMethod) execute(
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to convert amount: [%w]", err)
 	}
+	if m.delayedBridgeOut &&
+		m.bridgeKeeper.IsDelayedBridgeOut(context.SdkCtx(), inputs.Token.Bytes(), sdkAmount) {
+		fee, err = m.saveDelayedBridgeOut(context, inputs, sdkAmount)
+	} else {
+		fee, err = m.saveAssetsUnlocked(context, inputs, sdkAmount)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []statedb.StateChange
+
+	switch inputs.Chain {
+	case TargetChainEthereum:
+		if isBTC {
+			err = m.burnBitcoin(context, inputs)
+		} else {
+			changes, err = m.burnERC20(context, inputs)
+		}
+	case TargetChainBitcoin:
+		err = m.burnBitcoin(context, inputs)
+	default:
+		panic(fmt.Sprintf("unreachable, unsupported target chain: %v", inputs.Chain))
+	}
+	if err != nil {
+		return precompile.MethodOutputs{false}, nil, err
+	}
+
+	feeChanges, err := m.collectFee(context, inputs.Token, isBTC, fee)
+	if err != nil {
+		return precompile.MethodOutputs{false}, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, append(changes, feeChanges...), nil
+}
+
+// collectFee mints the bridge-out fee to the bridge-out fee treasury. It is
+// a no-op if no fee was charged.
+func (m *BridgeOutMethod) collectFee(
+	context *precompile.RunContext,
+	token common.Address,
+	isBTC bool,
+	fee math.Int,
+) ([]statedb.StateChange, error) {
+	if fee.IsNil() || !fee.IsPositive() {
+		return nil, nil
+	}
+
+	changes, err := m.bridgeKeeper.CollectBridgeOutFee(context.SdkCtx(), token.Bytes(), fee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect bridge-out fee: %w", err)
+	}
+
+	treasury := common.BytesToAddress(m.bridgeKeeper.GetBridgeOutFeeTreasury(context.SdkCtx()))
+	amount := precompile.TypesConverter.BigInt.FromSDK(fee)
+
+	// BTC is minted directly in x/bank so the journal must be updated to
+	// propagate the change done to the gas token, as for BTC burns.
+	if isBTC {
+		balanceDelta, overflow := uint256.FromBig(amount)
+		if overflow {
+			return nil, fmt.Errorf("conversion from big.Int to uint256.Int overflowed: %v", amount)
+		}
+
+		context.Journal().AddBalance(treasury, balanceDelta, tracing.BalanceChangeTransfer)
+	}
+
+	err = context.EventEmitter().Emit(
+		NewBridgeOutFeeCollectedEvent(token, treasury, amount),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to emit BridgeOutFeeCollected event: [%w]", err)
+	}
+
+	return changes, nil
+}
+
+func (m *BridgeOutMethod) saveAssetsUnlocked(
+	context *precompile.RunContext,
+	inputs *bridgeOutInputs,
+	sdkAmount math.Int,
+) (math.Int, error) {
 	assetsUnlocked, err := m.bridgeKeeper.SaveAssetsUnlocked(
 		context.SdkCtx(),
 		inputs.Recipient,
@@ -104,7 +198,7 @@ func (m *BridgeOutMethod) execute(
 		uint8(inputs.Chain),
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to send AssetsUnlocked to bridge: %w", err)
+		return math.Int{}, fmt.Errorf("failed to send AssetsUnlocked to bridge: %w", err)
 	}
 
 	err = context.EventEmitter().Emit(
@@ -118,25 +212,46 @@ func (m *BridgeOutMethod) execute(
 		),
 	)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to emit AssetsUnlocked event: [%w]", err)
+		return math.Int{}, fmt.Errorf("failed to emit AssetsUnlocked event: [%w]", err)
 	}
 
-	var changes []statedb.StateChange
+	return assetsUnlocked.Fee, nil
+}
 
-	switch inputs.Chain {
-	case TargetChainEthereum:
-		if isBTC {
-			err = m.burnBitcoin(context, inputs)
-		} else {
-			changes, err = m.burnERC20(context, inputs)
-		}
-	case TargetChainBitcoin:
-		err = m.burnBitcoin(context, inputs)
-	default:
-		panic(fmt.Sprintf("unreachable, unsupported target chain: %v", inputs.Chain))
+func (m *BridgeOutMethod) saveDelayedBridgeOut(
+	context *precompile.RunContext,
+	inputs *bridgeOutInputs,
+	sdkAmount math.Int,
+) (math.Int, error) {
+	bridgeOut, err := m.bridgeKeeper.SaveDelayedBridgeOut(
+		context.SdkCtx(),
+		inputs.Recipient,
+		inputs.Token.Bytes(),
+		context.MsgSender().Bytes(),
+		sdkAmount,
+		uint8(inputs.Chain),
+	)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to send delayed bridge-out to bridge: %w", err)
+	}
+
+	err = context.EventEmitter().Emit(
+		NewBridgeOutDelayedEvent(
+			new(big.Int).SetUint64(bridgeOut.Id),
+			bridgeOut.Recipient,
+			inputs.Token,
+			context.MsgSender(),
+			bridgeOut.Amount.BigInt(),
+			uint8(bridgeOut.Chain), //nolint:gosec // G115: Safe conversion, Chain is validated elsewhere
+			new(big.Int).SetUint64(bridgeOut.ReleaseHeight),
+		),
+	)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to emit BridgeOutDelayed event: [%w]", err)
 	}
 
-	return precompile.MethodOutputs{err == nil}, changes, err
+	// The fee is collected on release.
+	return math.ZeroInt(), nil
 }
 
 func (m *BridgeOutMethod) burnERC20(
@@ -0,0 +1,310 @@
+package assetsbridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const (
+	SetBridgeOutFeeTreasuryMethodName = "setBridgeOutFeeTreasury"
+	GetBridgeOutFeeTreasuryMethodName = "getBridgeOutFeeTreasury"
+	SetBridgeOutFeeMethodName         = "setBridgeOutFee"
+	GetBridgeOutFeeMethodName         = "getBridgeOutFee"
+)
+
+type SetBridgeOutFeeTreasuryMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetBridgeOutFeeTreasuryMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetBridgeOutFeeTreasuryMethod {
+	return &SetBridgeOutFeeTreasuryMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetBridgeOutFeeTreasuryMethod) MethodName() string {
+	return SetBridgeOutFeeTreasuryMethodName
+}
+
+func (m *SetBridgeOutFeeTreasuryMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetBridgeOutFeeTreasuryMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetBridgeOutFeeTreasuryMethod) Payable() bool {
+	return false
+}
+
+func (m *SetBridgeOutFeeTreasuryMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	treasury, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid treasury address: %v", rawInputs[0])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.bridgeKeeper.SetBridgeOutFeeTreasury(context.SdkCtx(), treasury.Bytes()); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetBridgeOutFeeTreasuryMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetBridgeOutFeeTreasuryMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetBridgeOutFeeTreasuryMethod {
+	return &GetBridgeOutFeeTreasuryMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetBridgeOutFeeTreasuryMethod) MethodName() string {
+	return GetBridgeOutFeeTreasuryMethodName
+}
+
+func (m *GetBridgeOutFeeTreasuryMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetBridgeOutFeeTreasuryMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetBridgeOutFeeTreasuryMethod) Payable() bool {
+	return false
+}
+
+func (m *GetBridgeOutFeeTreasuryMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{
+		common.BytesToAddress(m.bridgeKeeper.GetBridgeOutFeeTreasury(context.SdkCtx())),
+	}, nil, nil
+}
+
+type SetBridgeOutFeeMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetBridgeOutFeeMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetBridgeOutFeeMethod {
+	return &SetBridgeOutFeeMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetBridgeOutFeeMethod) MethodName() string {
+	return SetBridgeOutFeeMethodName
+}
+
+func (m *SetBridgeOutFeeMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetBridgeOutFeeMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetBridgeOutFeeMethod) Payable() bool {
+	return false
+}
+
+func (m *SetBridgeOutFeeMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 4); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	chain, ok := rawInputs[1].(uint8)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid chain: %v", rawInputs[1])
+	}
+
+	flat, ok := rawInputs[2].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid flat fee: %v", rawInputs[2])
+	}
+
+	basisPoints, ok := rawInputs[3].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid basis points: %v", rawInputs[3])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := TargetChain(chain).Validate(); !ok {
+		return nil, nil, fmt.Errorf("unsupported chain: %v", chain)
+	}
+
+	if flat.Sign() < 0 {
+		return nil, nil, errors.New("flat fee must be non-negative")
+	}
+
+	sdkFlat, err := precompile.TypesConverter.BigInt.ToSDK(flat)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert flat fee: [%w]", err)
+	}
+
+	err = m.bridgeKeeper.SetBridgeOutFee(
+		context.SdkCtx(),
+		bridgetypes.NewBridgeOutFee(token.Bytes(), chain, sdkFlat, basisPoints),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetBridgeOutFeeMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetBridgeOutFeeMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetBridgeOutFeeMethod {
+	return &GetBridgeOutFeeMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetBridgeOutFeeMethod) MethodName() string {
+	return GetBridgeOutFeeMethodName
+}
+
+func (m *GetBridgeOutFeeMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetBridgeOutFeeMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetBridgeOutFeeMethod) Payable() bool {
+	return false
+}
+
+func (m *GetBridgeOutFeeMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	chain, ok := rawInputs[1].(uint8)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid chain: %v", rawInputs[1])
+	}
+
+	fee := m.bridgeKeeper.GetBridgeOutFee(context.SdkCtx(), token.Bytes(), chain)
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(fee.Flat),
+		fee.BasisPoints,
+	}, nil, nil
+}
+
+// BridgeOutFeeCollectedEventName is the name of the BridgeOutFeeCollected
+// event. It matches the name of the event in the contract ABI.
+const BridgeOutFeeCollectedEventName = "BridgeOutFeeCollected"
+
+// BridgeOutFeeCollectedEvent is the implementation of the
+// BridgeOutFeeCollected event that contains the following arguments:
+// - token (indexed): the token the fee is charged in, on the Mezo chain
+// - treasury (indexed): the address receiving the fee
+// - amount (non-indexed): the fee amount
+type BridgeOutFeeCollectedEvent struct {
+	token    common.Address
+	treasury common.Address
+	amount   *big.Int
+}
+
+func NewBridgeOutFeeCollectedEvent(
+	token common.Address,
+	treasury common.Address,
+	amount *big.Int,
+) *BridgeOutFeeCollectedEvent {
+	return &BridgeOutFeeCollectedEvent{
+		token:    token,
+		treasury: treasury,
+		amount:   amount,
+	}
+}
+
+func (e *BridgeOutFeeCollectedEvent) EventName() string {
+	return BridgeOutFeeCollectedEventName
+}
+
+func (e *BridgeOutFeeCollectedEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.token,
+		},
+		{
+			Indexed: true,
+			Value:   e.treasury,
+		},
+		{
+			Indexed: false,
+			Value:   e.amount,
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type BridgeOutFeeTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestBridgeOutFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(BridgeOutFeeTestSuite))
+}
+
+func (s *BridgeOutFeeTestSuite) TestSetBridgeOutFeeTreasuryMethod() {
+	treasury := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	testCases := []TestCase{
+		{
+			name: "success - owner sets treasury",
+			run: func() []interface{} {
+				return []interface{}{treasury}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(treasury.Bytes(), s.bridgeKeeper.GetBridgeOutFeeTreasury(s.ctx))
+			},
+		},
+		{
+			name: "failure - zero address",
+			run: func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "zero EVM address",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{treasury}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid treasury type",
+			run: func() []interface{} {
+				return []interface{}{"invalid treasury"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetBridgeOutFeeTreasuryMethodName)
+}
+
+func (s *BridgeOutFeeTestSuite) TestGetBridgeOutFeeTreasuryMethod() {
+	treasury := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	testCases := []TestCase{
+		{
+			name: "success - returns zero address when unset",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{common.Address{}},
+		},
+		{
+			name: "success - returns set treasury",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.SetBridgeOutFeeTreasury(s.ctx, treasury.Bytes()))
+				return []interface{}{}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{treasury},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetBridgeOutFeeTreasuryMethodName)
+}
+
+func (s *BridgeOutFeeTestSuite) TestSetBridgeOutFeeMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	treasury := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	testCases := []TestCase{
+		{
+			name: "failure - treasury not set",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(0), big.NewInt(10), uint32(25)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "bridge-out fee treasury is not set",
+		},
+		{
+			name: "success - owner sets fee",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.SetBridgeOutFeeTreasury(s.ctx, treasury.Bytes()))
+				return []interface{}{token, uint8(0), big.NewInt(10), uint32(25)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				fee := s.bridgeKeeper.GetBridgeOutFee(s.ctx, token.Bytes(), 0)
+				s.Require().Equal(math.NewInt(10), fee.Flat)
+				s.Require().Equal(uint32(25), fee.BasisPoints)
+			},
+		},
+		{
+			name: "success - owner removes fee",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(0), big.NewInt(0), uint32(0)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.bridgeKeeper.GetBridgeOutFee(s.ctx, token.Bytes(), 0).IsZero())
+			},
+		},
+		{
+			name: "failure - basis points above maximum",
+			run: func() []interface{} {
+				return []interface{}{
+					token,
+					uint8(0),
+					big.NewInt(0),
+					uint32(bridgetypes.BasisPointsDenominator + 1),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "bridge-out fee basis points cannot exceed",
+		},
+		{
+			name: "failure - unsupported chain",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(2), big.NewInt(10), uint32(0)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "unsupported chain",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(0), big.NewInt(10), uint32(25)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid basis points type",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(0), big.NewInt(10), "invalid"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetBridgeOutFeeMethodName)
+}
+
+func (s *BridgeOutFeeTestSuite) TestGetBridgeOutFeeMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	treasury := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	testCases := []TestCase{
+		{
+			name: "success - returns zero fee when unset",
+			run: func() []interface{} {
+				return []interface{}{token, uint8(1)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(0), uint32(0)},
+		},
+		{
+			name: "success - returns set fee",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.SetBridgeOutFeeTreasury(s.ctx, treasury.Bytes()))
+				s.Require().NoError(s.bridgeKeeper.SetBridgeOutFee(
+					s.ctx,
+					bridgetypes.NewBridgeOutFee(token.Bytes(), 1, math.NewInt(500), 30),
+				))
+				return []interface{}{token, uint8(1)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(500), uint32(30)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetBridgeOutFeeMethodName)
+}
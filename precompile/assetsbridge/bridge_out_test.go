@@ -90,7 +90,7 @@ func NewExtendedFakeBridgeKeeper(sourceBTCToken []byte) *ExtendedFakeBridgeKeepe
 }
 
 func (k *ExtendedFakeBridgeKeeper) SaveAssetsUnlocked(
-	_ sdk.Context,
+	ctx sdk.Context,
 	recipient []byte,
 	token []byte,
 	sender []byte,
@@ -103,13 +103,16 @@ func (k *ExtendedFakeBridgeKeeper) SaveAssetsUnlocked(
 		return nil, errors.New("AssetsUnlocked failed")
 	}
 
+	fee := k.GetBridgeOutFee(ctx, token, chain).Amount(amount)
+
 	event := &bridgetypes.AssetsUnlockedEvent{
 		Token:          common.BytesToAddress(token).Hex(),
-		Amount:         amount,
+		Amount:         amount.Sub(fee),
 		Chain:          uint32(chain),
 		Sender:         common.BytesToAddress(sender).Hex(),
 		Recipient:      recipient,
 		UnlockSequence: math.NewInt(k.sequenceNumber),
+		Fee:            fee,
 	}
 	k.sequenceNumber++
 	k.lastAssetsUnlocked = event
@@ -130,6 +133,7 @@ func (k *ExtendedFakeBridgeKeeper) Reset() {
 	k.lastAssetsUnlocked = nil
 	k.burnErr = nil
 	k.minAmountByToken = make(map[string]math.Int)
+	k.collectedBridgeOutFee = make(map[string]math.Int)
 }
 
 type BridgeOutTestSuite struct {
@@ -572,6 +576,167 @@ func (s *BridgeOutTestSuite) TestBridgeOutBitcoinExecution() {
 	s.RunMethodTestCasesWithKeepers(testcases, "bridgeOut")
 }
 
+func (s *BridgeOutTestSuite) TestBridgeOutDelayed() {
+	testcases := []TestCase{
+		{
+			name: "amount below threshold is unlocked right away",
+			run: func() []interface{} {
+				s.Require().NoError(s.extBridgeKeeper.SetDelayedBridgeOutBlocks(s.ctx, 100))
+				s.extBridgeKeeper.SetDelayedBridgeOutThreshold(s.ctx, testERC20Token.Bytes(), math.NewInt(1000))
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testERC20Token, big.NewInt(999), uint8(0), ethRecipient}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.extBridgeKeeper.AssetsUnlockedCalled())
+				_, found := s.extBridgeKeeper.GetDelayedBridgeOut(s.ctx, 1)
+				s.Require().False(found)
+			},
+		},
+		{
+			name: "amount reaching threshold is delayed",
+			run: func() []interface{} {
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testERC20Token, big.NewInt(1000), uint8(0), ethRecipient}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().False(s.extBridgeKeeper.AssetsUnlockedCalled())
+
+				bridgeOut, found := s.extBridgeKeeper.GetDelayedBridgeOut(s.ctx, 1)
+				s.Require().True(found)
+				s.Require().Equal(testERC20Token.Hex(), bridgeOut.Token)
+				s.Require().Equal(ethRecipient, bridgeOut.Recipient)
+				s.Require().Equal(math.NewInt(1000), bridgeOut.Amount)
+			},
+		},
+		{
+			name: "burn failure reverts the delayed bridge-out",
+			run: func() []interface{} {
+				s.extBridgeKeeper.SetBurnError(errors.New("failed to execute ERC20 burnFrom call"))
+
+				return []interface{}{testERC20Token, big.NewInt(1000), uint8(0), ethRecipient}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "failed to execute ERC20 burnFrom call",
+		},
+	}
+
+	s.RunMethodTestCasesWithKeepersAndSettings(
+		testcases,
+		"bridgeOut",
+		&assetsbridge.Settings{
+			Observability:    true,
+			BTCManagement:    true,
+			ERC20Management:  true,
+			SequenceTipView:  true,
+			BridgeOut:        true,
+			DelayedBridgeOut: true,
+		},
+	)
+}
+
+func (s *BridgeOutTestSuite) TestBridgeOutFee() {
+	testcases := []TestCase{
+		{
+			name: "no fee is collected without a fee",
+			run: func() []interface{} {
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testERC20Token, big.NewInt(1000), uint8(0), ethRecipient}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(math.NewInt(1000), s.extBridgeKeeper.lastAssetsUnlocked.Amount)
+				s.Require().Empty(s.extBridgeKeeper.collectedBridgeOutFee)
+			},
+		},
+		{
+			name: "ERC20 fee is deducted and collected",
+			run: func() []interface{} {
+				s.Require().NoError(s.extBridgeKeeper.SetBridgeOutFeeTreasury(s.ctx, s.account2.EvmAddr.Bytes()))
+				s.Require().NoError(s.extBridgeKeeper.SetBridgeOutFee(
+					s.ctx,
+					bridgetypes.NewBridgeOutFee(testERC20Token.Bytes(), 0, math.NewInt(10), 100),
+				))
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testERC20Token, big.NewInt(1000), uint8(0), ethRecipient}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(math.NewInt(980), s.extBridgeKeeper.lastAssetsUnlocked.Amount)
+				s.Require().Equal(math.NewInt(20), s.extBridgeKeeper.lastAssetsUnlocked.Fee)
+				s.Require().Equal(
+					math.NewInt(20),
+					s.extBridgeKeeper.collectedBridgeOutFee[testERC20Token.Hex()],
+				)
+			},
+		},
+		{
+			name: "BTC fee is deducted and collected",
+			run: func() []interface{} {
+				s.Require().NoError(s.extBridgeKeeper.SetBridgeOutFee(
+					s.ctx,
+					bridgetypes.NewBridgeOutFee(testBTCToken.Bytes(), 1, math.NewInt(5), 0),
+				))
+				s.authzKeeper.SetAuthorization(
+					s.account1.SdkAddr,
+					sdk.AccAddress(bridgeAddress.Bytes()),
+					assetsbridge.SendMsgURL,
+					&banktypes.SendAuthorization{
+						SpendLimit: sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(1000))),
+					},
+					nil,
+				)
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testBTCToken, big.NewInt(100), uint8(1), btcRecipient}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(math.NewInt(95), s.extBridgeKeeper.lastAssetsUnlocked.Amount)
+				s.Require().Equal(
+					math.NewInt(5),
+					s.extBridgeKeeper.collectedBridgeOutFee[testBTCToken.Hex()],
+				)
+			},
+		},
+		{
+			name: "burn failure does not collect the fee",
+			run: func() []interface{} {
+				s.extBridgeKeeper.SetBurnError(errors.New("failed to execute ERC20 burnFrom call"))
+				s.extBridgeKeeper.SetAssetsUnlockedSuccess(true)
+
+				return []interface{}{testERC20Token, big.NewInt(1000), uint8(0), ethRecipient}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "failed to execute ERC20 burnFrom call",
+			postCheck: func() {
+				s.Require().Empty(s.extBridgeKeeper.collectedBridgeOutFee)
+			},
+		},
+	}
+
+	s.RunMethodTestCasesWithKeepers(testcases, "bridgeOut")
+}
+
 func (s *BridgeOutTestSuite) TestBridgeOutBitcoinAuthorization() {
 	testcases := []TestCase{
 		{
@@ -746,6 +911,24 @@ func (s *BridgeOutTestSuite) TestBridgeOutInputValidation() {
 }
 
 func (s *BridgeOutTestSuite) RunMethodTestCasesWithKeepers(testcases []TestCase, methodName string) {
+	s.RunMethodTestCasesWithKeepersAndSettings(
+		testcases,
+		methodName,
+		&assetsbridge.Settings{
+			Observability:   true,
+			BTCManagement:   true,
+			ERC20Management: true,
+			SequenceTipView: true,
+			BridgeOut:       true,
+		},
+	)
+}
+
+func (s *BridgeOutTestSuite) RunMethodTestCasesWithKeepersAndSettings(
+	testcases []TestCase,
+	methodName string,
+	settings *assetsbridge.Settings,
+) {
 	for _, tc := range testcases {
 		s.Run(tc.name, func() {
 			// Reset keepers state
@@ -755,13 +938,7 @@ func (s *BridgeOutTestSuite) RunMethodTestCasesWithKeepers(testcases []TestCase,
 				s.poaKeeper,
 				s.extBridgeKeeper,
 				s.authzKeeper,
-				&assetsbridge.Settings{
-					Observability:   true,
-					BTCManagement:   true,
-					ERC20Management: true,
-					SequenceTipView: true,
-					BridgeOut:       true,
-				},
+				settings,
 			)
 			s.Require().NoError(err)
 			s.assetsBridgePrecompile = assetsBridgePrecompile
@@ -0,0 +1,540 @@
+package assetsbridge
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
+	"github.com/mezo-org/mezod/precompile"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+const (
+	SetDelayedBridgeOutBlocksMethodName    = "setDelayedBridgeOutBlocks"
+	GetDelayedBridgeOutBlocksMethodName    = "getDelayedBridgeOutBlocks"
+	SetDelayedBridgeOutThresholdMethodName = "setDelayedBridgeOutThreshold"
+	GetDelayedBridgeOutThresholdMethodName = "getDelayedBridgeOutThreshold"
+	GetDelayedBridgeOutMethodName          = "getDelayedBridgeOut"
+	CancelDelayedBridgeOutMethodName       = "cancelDelayedBridgeOut"
+)
+
+type SetDelayedBridgeOutBlocksMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetDelayedBridgeOutBlocksMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetDelayedBridgeOutBlocksMethod {
+	return &SetDelayedBridgeOutBlocksMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetDelayedBridgeOutBlocksMethod) MethodName() string {
+	return SetDelayedBridgeOutBlocksMethodName
+}
+
+func (m *SetDelayedBridgeOutBlocksMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetDelayedBridgeOutBlocksMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetDelayedBridgeOutBlocksMethod) Payable() bool {
+	return false
+}
+
+func (m *SetDelayedBridgeOutBlocksMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	blocks, ok := rawInputs[0].(uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid blocks: %v", rawInputs[0])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.bridgeKeeper.SetDelayedBridgeOutBlocks(context.SdkCtx(), blocks); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetDelayedBridgeOutBlocksMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetDelayedBridgeOutBlocksMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetDelayedBridgeOutBlocksMethod {
+	return &GetDelayedBridgeOutBlocksMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetDelayedBridgeOutBlocksMethod) MethodName() string {
+	return GetDelayedBridgeOutBlocksMethodName
+}
+
+func (m *GetDelayedBridgeOutBlocksMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetDelayedBridgeOutBlocksMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetDelayedBridgeOutBlocksMethod) Payable() bool {
+	return false
+}
+
+func (m *GetDelayedBridgeOutBlocksMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{
+		m.bridgeKeeper.GetDelayedBridgeOutBlocks(context.SdkCtx()),
+	}, nil, nil
+}
+
+type SetDelayedBridgeOutThresholdMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetDelayedBridgeOutThresholdMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetDelayedBridgeOutThresholdMethod {
+	return &SetDelayedBridgeOutThresholdMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetDelayedBridgeOutThresholdMethod) MethodName() string {
+	return SetDelayedBridgeOutThresholdMethodName
+}
+
+func (m *SetDelayedBridgeOutThresholdMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetDelayedBridgeOutThresholdMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetDelayedBridgeOutThresholdMethod) Payable() bool {
+	return false
+}
+
+func (m *SetDelayedBridgeOutThresholdMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	threshold, ok := rawInputs[1].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid threshold: %v", rawInputs[1])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if threshold.Sign() < 0 {
+		return nil, nil, errors.New("threshold must be non-negative")
+	}
+
+	sdkThreshold, err := precompile.TypesConverter.BigInt.ToSDK(threshold)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert threshold: [%w]", err)
+	}
+
+	m.bridgeKeeper.SetDelayedBridgeOutThreshold(context.SdkCtx(), token.Bytes(), sdkThreshold)
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetDelayedBridgeOutThresholdMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetDelayedBridgeOutThresholdMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetDelayedBridgeOutThresholdMethod {
+	return &GetDelayedBridgeOutThresholdMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetDelayedBridgeOutThresholdMethod) MethodName() string {
+	return GetDelayedBridgeOutThresholdMethodName
+}
+
+func (m *GetDelayedBridgeOutThresholdMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetDelayedBridgeOutThresholdMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetDelayedBridgeOutThresholdMethod) Payable() bool {
+	return false
+}
+
+func (m *GetDelayedBridgeOutThresholdMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	threshold := m.bridgeKeeper.GetDelayedBridgeOutThreshold(context.SdkCtx(), token.Bytes())
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(threshold),
+	}, nil, nil
+}
+
+type GetDelayedBridgeOutMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetDelayedBridgeOutMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetDelayedBridgeOutMethod {
+	return &GetDelayedBridgeOutMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetDelayedBridgeOutMethod) MethodName() string {
+	return GetDelayedBridgeOutMethodName
+}
+
+func (m *GetDelayedBridgeOutMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetDelayedBridgeOutMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetDelayedBridgeOutMethod) Payable() bool {
+	return false
+}
+
+func (m *GetDelayedBridgeOutMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	id, err := delayedBridgeOutID(rawInputs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bridgeOut, found := m.bridgeKeeper.GetDelayedBridgeOut(context.SdkCtx(), id)
+	if !found {
+		return nil, nil, fmt.Errorf("delayed bridge-out %d not found", id)
+	}
+
+	return precompile.MethodOutputs{
+		common.HexToAddress(bridgeOut.Sender),
+		common.HexToAddress(bridgeOut.Token),
+		precompile.TypesConverter.BigInt.FromSDK(bridgeOut.Amount),
+		uint8(bridgeOut.Chain), //nolint:gosec // G115: Safe conversion, Chain is validated on save
+		bridgeOut.Recipient,
+		new(big.Int).SetUint64(bridgeOut.ReleaseHeight),
+	}, nil, nil
+}
+
+type CancelDelayedBridgeOutMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newCancelDelayedBridgeOutMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *CancelDelayedBridgeOutMethod {
+	return &CancelDelayedBridgeOutMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *CancelDelayedBridgeOutMethod) MethodName() string {
+	return CancelDelayedBridgeOutMethodName
+}
+
+func (m *CancelDelayedBridgeOutMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *CancelDelayedBridgeOutMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *CancelDelayedBridgeOutMethod) Payable() bool {
+	return false
+}
+
+func (m *CancelDelayedBridgeOutMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	id, err := delayedBridgeOutID(rawInputs[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// This method is restricted to the PoA owner and the emergency team.
+	if err := m.poaKeeper.CheckOwnerOrEmergencyTeam(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	bridgeOut, changes, err := m.bridgeKeeper.CancelDelayedBridgeOut(context.SdkCtx(), id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to cancel delayed bridge-out: %w", err)
+	}
+
+	sender := common.HexToAddress(bridgeOut.Sender)
+	token := common.HexToAddress(bridgeOut.Token)
+	// The fee of a delayed bridge-out is collected only on release, so it
+	// is refunded along with the amount.
+	amount := precompile.TypesConverter.BigInt.FromSDK(
+		bridgeOut.Amount.Add(bridgeOut.Fee),
+	)
+
+	// BTC is refunded directly in x/bank so the journal must be updated to
+	// propagate the change done to the gas token, as for BTC burns.
+	if bytes.Equal(token.Bytes(), common.HexToAddress(evmtypes.BTCTokenPrecompileAddress).Bytes()) {
+		balanceDelta, overflow := uint256.FromBig(amount)
+		if overflow {
+			return nil, nil, fmt.Errorf("conversion from big.Int to uint256.Int overflowed: %v", amount)
+		}
+
+		context.Journal().AddBalance(sender, balanceDelta, tracing.BalanceChangeTransfer)
+	}
+
+	err = context.EventEmitter().Emit(
+		NewDelayedBridgeOutCancelledEvent(
+			new(big.Int).SetUint64(bridgeOut.Id),
+			sender,
+			token,
+			amount,
+		),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to emit DelayedBridgeOutCancelled event: [%w]", err)
+	}
+
+	return precompile.MethodOutputs{true}, changes, nil
+}
+
+// delayedBridgeOutID converts a uint256 method input into a delayed
+// bridge-out identifier.
+func delayedBridgeOutID(input interface{}) (uint64, error) {
+	id, ok := input.(*big.Int)
+	if !ok {
+		return 0, fmt.Errorf("invalid id: %v", input)
+	}
+
+	if id.Sign() <= 0 || !id.IsUint64() {
+		return 0, fmt.Errorf("id out of range: %v", id)
+	}
+
+	return id.Uint64(), nil
+}
+
+// BridgeOutDelayedEventName is the name of the BridgeOutDelayed event. It
+// matches the name of the event in the contract ABI.
+const BridgeOutDelayedEventName = "BridgeOutDelayed"
+
+// BridgeOutDelayedEvent is the implementation of the BridgeOutDelayed event
+// that contains the following arguments:
+// - id (indexed): the identifier of the delayed bridge-out
+// - recipient (indexed): the address to which the tokens are transferred
+// - token (indexed): the token being bridged out, on the Mezo chain
+// - sender (non-indexed): the address from which the tokens are bridged out
+// - amount (non-indexed): the amount of tokens transferred
+// - chain (non-indexed): the destination chain
+// - releaseHeight (non-indexed): the block height at which the bridge-out is released
+type BridgeOutDelayedEvent struct {
+	id            *big.Int
+	recipient     []byte
+	token         common.Address
+	sender        common.Address
+	amount        *big.Int
+	chain         uint8
+	releaseHeight *big.Int
+}
+
+func NewBridgeOutDelayedEvent(
+	id *big.Int,
+	recipient []byte,
+	token common.Address,
+	sender common.Address,
+	amount *big.Int,
+	chain uint8,
+	releaseHeight *big.Int,
+) *BridgeOutDelayedEvent {
+	return &BridgeOutDelayedEvent{
+		id:            id,
+		recipient:     recipient,
+		token:         token,
+		sender:        sender,
+		amount:        amount,
+		chain:         chain,
+		releaseHeight: releaseHeight,
+	}
+}
+
+func (e *BridgeOutDelayedEvent) EventName() string {
+	return BridgeOutDelayedEventName
+}
+
+func (e *BridgeOutDelayedEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.id,
+		},
+		{
+			Indexed: true,
+			Value:   e.recipient,
+		},
+		{
+			Indexed: true,
+			Value:   e.token,
+		},
+		{
+			Indexed: false,
+			Value:   e.sender,
+		},
+		{
+			Indexed: false,
+			Value:   e.amount,
+		},
+		{
+			Indexed: false,
+			Value:   e.chain,
+		},
+		{
+			Indexed: false,
+			Value:   e.releaseHeight,
+		},
+	}
+}
+
+// DelayedBridgeOutCancelledEventName is the name of the
+// DelayedBridgeOutCancelled event. It matches the name of the event in the
+// contract ABI.
+const DelayedBridgeOutCancelledEventName = "DelayedBridgeOutCancelled"
+
+// DelayedBridgeOutCancelledEvent is the implementation of the
+// DelayedBridgeOutCancelled event that contains the following arguments:
+// - id (indexed): the identifier of the cancelled bridge-out
+// - sender (indexed): the address the tokens are refunded to
+// - token (indexed): the refunded token, on the Mezo chain
+// - amount (non-indexed): the refunded amount
+type DelayedBridgeOutCancelledEvent struct {
+	id     *big.Int
+	sender common.Address
+	token  common.Address
+	amount *big.Int
+}
+
+func NewDelayedBridgeOutCancelledEvent(
+	id *big.Int,
+	sender common.Address,
+	token common.Address,
+	amount *big.Int,
+) *DelayedBridgeOutCancelledEvent {
+	return &DelayedBridgeOutCancelledEvent{
+		id:     id,
+		sender: sender,
+		token:  token,
+		amount: amount,
+	}
+}
+
+func (e *DelayedBridgeOutCancelledEvent) EventName() string {
+	return DelayedBridgeOutCancelledEventName
+}
+
+func (e *DelayedBridgeOutCancelledEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.id,
+		},
+		{
+			Indexed: true,
+			Value:   e.sender,
+		},
+		{
+			Indexed: true,
+			Value:   e.token,
+		},
+		{
+			Indexed: false,
+			Value:   e.amount,
+		},
+	}
+}
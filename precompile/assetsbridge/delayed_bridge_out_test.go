@@ -0,0 +1,328 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type DelayedBridgeOutTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestDelayedBridgeOutTestSuite(t *testing.T) {
+	suite.Run(t, new(DelayedBridgeOutTestSuite))
+}
+
+func (s *DelayedBridgeOutTestSuite) TestSetDelayedBridgeOutBlocksMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets blocks",
+			run: func() []interface{} {
+				return []interface{}{uint64(1000)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(uint64(1000), s.bridgeKeeper.GetDelayedBridgeOutBlocks(s.ctx))
+			},
+		},
+		{
+			name: "success - owner disables the queue",
+			run: func() []interface{} {
+				return []interface{}{uint64(0)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Zero(s.bridgeKeeper.GetDelayedBridgeOutBlocks(s.ctx))
+			},
+		},
+		{
+			name: "failure - blocks above maximum",
+			run: func() []interface{} {
+				return []interface{}{uint64(bridgetypes.MaxDelayedBridgeOutBlocks + 1)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "delayed bridge-out blocks cannot exceed",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{uint64(1000)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid blocks type",
+			run: func() []interface{} {
+				return []interface{}{"invalid blocks"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetDelayedBridgeOutBlocksMethodName)
+}
+
+func (s *DelayedBridgeOutTestSuite) TestGetDelayedBridgeOutBlocksMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns zero when unset",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(0)},
+		},
+		{
+			name: "success - returns set blocks",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.SetDelayedBridgeOutBlocks(s.ctx, 500))
+				return []interface{}{}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(500)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetDelayedBridgeOutBlocksMethodName)
+}
+
+func (s *DelayedBridgeOutTestSuite) TestSetDelayedBridgeOutThresholdMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	testCases := []TestCase{
+		{
+			name: "success - owner sets threshold",
+			run: func() []interface{} {
+				return []interface{}{token, big.NewInt(1000)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(
+					math.NewInt(1000),
+					s.bridgeKeeper.GetDelayedBridgeOutThreshold(s.ctx, token.Bytes()),
+				)
+			},
+		},
+		{
+			name: "failure - negative threshold",
+			run: func() []interface{} {
+				return []interface{}{token, big.NewInt(-1)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "threshold must be non-negative",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{token, big.NewInt(1000)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{token}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetDelayedBridgeOutThresholdMethodName)
+}
+
+func (s *DelayedBridgeOutTestSuite) TestGetDelayedBridgeOutThresholdMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	testCases := []TestCase{
+		{
+			name: "success - returns zero when unset",
+			run: func() []interface{} {
+				return []interface{}{token}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(0)},
+		},
+		{
+			name: "success - returns set threshold",
+			run: func() []interface{} {
+				s.bridgeKeeper.SetDelayedBridgeOutThreshold(s.ctx, token.Bytes(), math.NewInt(2000))
+				return []interface{}{token}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(2000)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetDelayedBridgeOutThresholdMethodName)
+}
+
+func (s *DelayedBridgeOutTestSuite) TestGetDelayedBridgeOutMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	sender := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	recipient := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	testCases := []TestCase{
+		{
+			name: "success - returns queued bridge-out",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.SetDelayedBridgeOutBlocks(s.ctx, 100))
+				_, err := s.bridgeKeeper.SaveDelayedBridgeOut(
+					s.ctx,
+					recipient,
+					token.Bytes(),
+					sender.Bytes(),
+					math.NewInt(5000),
+					uint8(assetsbridge.TargetChainEthereum),
+				)
+				s.Require().NoError(err)
+
+				return []interface{}{big.NewInt(1)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				sender,
+				token,
+				big.NewInt(5000),
+				uint8(assetsbridge.TargetChainEthereum),
+				recipient,
+				big.NewInt(100),
+			},
+		},
+		{
+			name: "failure - not found",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(2)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "delayed bridge-out 2 not found",
+		},
+		{
+			name: "failure - zero id",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(0)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "id out of range",
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetDelayedBridgeOutMethodName)
+}
+
+func (s *DelayedBridgeOutTestSuite) TestCancelDelayedBridgeOutMethod() {
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	btcToken := common.HexToAddress(evmtypes.BTCTokenPrecompileAddress)
+	sender := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	recipient := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	queue := func(token common.Address) {
+		s.Require().NoError(s.bridgeKeeper.SetDelayedBridgeOutBlocks(s.ctx, 100))
+		_, err := s.bridgeKeeper.SaveDelayedBridgeOut(
+			s.ctx,
+			recipient,
+			token.Bytes(),
+			sender.Bytes(),
+			math.NewInt(5000),
+			uint8(assetsbridge.TargetChainEthereum),
+		)
+		s.Require().NoError(err)
+	}
+
+	testCases := []TestCase{
+		{
+			name: "success - owner cancels ERC20 bridge-out",
+			run: func() []interface{} {
+				queue(token)
+				return []interface{}{big.NewInt(1)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				_, found := s.bridgeKeeper.GetDelayedBridgeOut(s.ctx, 1)
+				s.Require().False(found)
+			},
+		},
+		{
+			name: "success - emergency team cancels BTC bridge-out",
+			run: func() []interface{} {
+				queue(btcToken)
+				s.poaKeeper.emergencyTeam = s.account2.SdkAddr
+				return []interface{}{big.NewInt(2)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.poaKeeper.emergencyTeam = nil
+				_, found := s.bridgeKeeper.GetDelayedBridgeOut(s.ctx, 2)
+				s.Require().False(found)
+			},
+		},
+		{
+			name: "failure - not found",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(1)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "delayed bridge-out not found",
+		},
+		{
+			name: "failure - neither owner nor emergency team",
+			run: func() []interface{} {
+				queue(token)
+				return []interface{}{big.NewInt(3)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "emergency team address is empty",
+		},
+		{
+			name: "failure - invalid id type",
+			run: func() []interface{} {
+				return []interface{}{"invalid id"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.CancelDelayedBridgeOutMethodName)
+}
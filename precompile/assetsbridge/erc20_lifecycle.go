@@ -0,0 +1,358 @@
+package assetsbridge
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const (
+	//nolint:gosec
+	ScheduleERC20TokenMappingMethodName = "scheduleERC20TokenMapping"
+	//nolint:gosec
+	SetERC20TokenMappingStateMethodName = "setERC20TokenMappingState"
+	//nolint:gosec
+	GetERC20TokenMappingStateMethodName = "getERC20TokenMappingState"
+)
+
+// ScheduleERC20TokenMappingMethod is the implementation of the
+// scheduleERC20TokenMapping method that creates an ERC20 token mapping
+// pending activation at a given Mezo block height.
+type ScheduleERC20TokenMappingMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newScheduleERC20TokenMappingMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *ScheduleERC20TokenMappingMethod {
+	return &ScheduleERC20TokenMappingMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *ScheduleERC20TokenMappingMethod) MethodName() string {
+	return ScheduleERC20TokenMappingMethodName
+}
+
+func (m *ScheduleERC20TokenMappingMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *ScheduleERC20TokenMappingMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *ScheduleERC20TokenMappingMethod) Payable() bool {
+	return false
+}
+
+func (m *ScheduleERC20TokenMappingMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	sourceToken, ok := inputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source token must be common.Address")
+	}
+
+	mezoToken, ok := inputs[1].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("mezo token must be common.Address")
+	}
+
+	activationHeight, ok := inputs[2].(uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid activation height: %v", inputs[2])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	err := m.bridgeKeeper.ScheduleERC20TokenMapping(
+		context.SdkCtx(),
+		sourceToken.Bytes(),
+		mezoToken.Bytes(),
+		activationHeight,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewERC20TokenMappingCreatedEvent(sourceToken, mezoToken),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit ERC20TokenMappingCreated event: [%w]",
+			err,
+		)
+	}
+
+	err = context.EventEmitter().Emit(
+		NewERC20TokenMappingStateSetEvent(
+			sourceToken,
+			mezoToken,
+			uint8(bridgetypes.ERC20TokenMappingStatePendingActivation),
+			activationHeight,
+		),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit ERC20TokenMappingStateSet event: [%w]",
+			err,
+		)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// SetERC20TokenMappingStateMethod is the implementation of the
+// setERC20TokenMappingState method that sets the lifecycle state of an
+// existing ERC20 token mapping.
+type SetERC20TokenMappingStateMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetERC20TokenMappingStateMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetERC20TokenMappingStateMethod {
+	return &SetERC20TokenMappingStateMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetERC20TokenMappingStateMethod) MethodName() string {
+	return SetERC20TokenMappingStateMethodName
+}
+
+func (m *SetERC20TokenMappingStateMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetERC20TokenMappingStateMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetERC20TokenMappingStateMethod) Payable() bool {
+	return false
+}
+
+func (m *SetERC20TokenMappingStateMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	sourceToken, ok := inputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source token must be common.Address")
+	}
+
+	rawState, ok := inputs[1].(uint8)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid state: %v", inputs[1])
+	}
+
+	state := bridgetypes.ERC20TokenMappingState(rawState)
+
+	mapping, exists := m.bridgeKeeper.GetERC20TokenMapping(
+		context.SdkCtx(),
+		sourceToken.Bytes(),
+	)
+	if !exists {
+		return nil, nil, bridgetypes.ErrNotMapping
+	}
+
+	sender := precompile.TypesConverter.Address.ToSDK(context.MsgSender())
+
+	// The emergency team can only pause and unpause mappings that are
+	// already live. Activating and deprecating mappings is restricted to
+	// the owner.
+	var err error
+	if isPauseTransition(mapping.State, state) {
+		err = m.poaKeeper.CheckOwnerOrEmergencyTeam(context.SdkCtx(), sender)
+	} else {
+		err = m.poaKeeper.CheckOwner(context.SdkCtx(), sender)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.bridgeKeeper.SetERC20TokenMappingState(
+		context.SdkCtx(),
+		sourceToken.Bytes(),
+		state,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewERC20TokenMappingStateSetEvent(
+			sourceToken,
+			common.BytesToAddress(mapping.MezoTokenBytes()),
+			rawState,
+			0,
+		),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit ERC20TokenMappingStateSet event: [%w]",
+			err,
+		)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// isPauseTransition returns true if both states are either active or one of
+// the paused states.
+func isPauseTransition(from, to bridgetypes.ERC20TokenMappingState) bool {
+	isLive := func(state bridgetypes.ERC20TokenMappingState) bool {
+		switch state {
+		case bridgetypes.ERC20TokenMappingStateActive,
+			bridgetypes.ERC20TokenMappingStateBridgeInPaused,
+			bridgetypes.ERC20TokenMappingStateBridgeOutPaused,
+			bridgetypes.ERC20TokenMappingStatePaused:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return isLive(from) && isLive(to)
+}
+
+// GetERC20TokenMappingStateMethod is the implementation of the
+// getERC20TokenMappingState method that returns the lifecycle state of an
+// ERC20 token mapping.
+type GetERC20TokenMappingStateMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetERC20TokenMappingStateMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetERC20TokenMappingStateMethod {
+	return &GetERC20TokenMappingStateMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetERC20TokenMappingStateMethod) MethodName() string {
+	return GetERC20TokenMappingStateMethodName
+}
+
+func (m *GetERC20TokenMappingStateMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetERC20TokenMappingStateMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetERC20TokenMappingStateMethod) Payable() bool {
+	return false
+}
+
+func (m *GetERC20TokenMappingStateMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	sourceToken, ok := inputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source token must be common.Address")
+	}
+
+	mapping, exists := m.bridgeKeeper.GetERC20TokenMapping(
+		context.SdkCtx(),
+		sourceToken.Bytes(),
+	)
+	if !exists {
+		return nil, nil, bridgetypes.ErrNotMapping
+	}
+
+	return precompile.MethodOutputs{
+		uint8(mapping.State), //nolint:gosec
+		mapping.ActivationHeight,
+	}, nil, nil
+}
+
+// ERC20TokenMappingStateSetEventName is the name of the
+// ERC20TokenMappingStateSet event. It matches the name of the event in the
+// contract ABI.
+//
+//nolint:gosec
+const ERC20TokenMappingStateSetEventName = "ERC20TokenMappingStateSet"
+
+// ERC20TokenMappingStateSetEvent is the implementation of the
+// ERC20TokenMappingStateSet event that contains the following arguments:
+// - sourceToken (indexed): the address of the ERC20 token on the source chain,
+// - mezoToken (indexed): the address of the ERC20 token on the Mezo chain,
+// - state (non-indexed): the new lifecycle state of the mapping,
+// - activationHeight (non-indexed): the activation height, if pending.
+type ERC20TokenMappingStateSetEvent struct {
+	sourceToken, mezoToken common.Address
+	state                  uint8
+	activationHeight       uint64
+}
+
+func NewERC20TokenMappingStateSetEvent(
+	sourceToken, mezoToken common.Address,
+	state uint8,
+	activationHeight uint64,
+) *ERC20TokenMappingStateSetEvent {
+	return &ERC20TokenMappingStateSetEvent{
+		sourceToken:      sourceToken,
+		mezoToken:        mezoToken,
+		state:            state,
+		activationHeight: activationHeight,
+	}
+}
+
+func (e *ERC20TokenMappingStateSetEvent) EventName() string {
+	return ERC20TokenMappingStateSetEventName
+}
+
+func (e *ERC20TokenMappingStateSetEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.sourceToken,
+		},
+		{
+			Indexed: true,
+			Value:   e.mezoToken,
+		},
+		{
+			Indexed: false,
+			Value:   e.state,
+		},
+		{
+			Indexed: false,
+			Value:   e.activationHeight,
+		},
+	}
+}
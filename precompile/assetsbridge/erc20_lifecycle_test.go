@@ -0,0 +1,238 @@
+package assetsbridge_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ERC20LifecycleTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestERC20LifecycleTestSuite(t *testing.T) {
+	suite.Run(t, new(ERC20LifecycleTestSuite))
+}
+
+func (s *ERC20LifecycleTestSuite) TestScheduleERC20TokenMappingMethod() {
+	sourceToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	mezoToken := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	testCases := []TestCase{
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{sourceToken, mezoToken, uint64(100)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - activation height not above the current height",
+			run: func() []interface{} {
+				return []interface{}{sourceToken, mezoToken, uint64(1)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "invalid ERC20 token mapping state",
+		},
+		{
+			name: "success - owner schedules mapping",
+			run: func() []interface{} {
+				return []interface{}{sourceToken, mezoToken, uint64(100)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				mapping, ok := s.bridgeKeeper.GetERC20TokenMapping(s.ctx, sourceToken.Bytes())
+				s.Require().True(ok)
+				s.Require().Equal(bridgetypes.ERC20TokenMappingStatePendingActivation, mapping.State)
+				s.Require().Equal(uint64(100), mapping.ActivationHeight)
+			},
+		},
+		{
+			name: "failure - invalid activation height type",
+			run: func() []interface{} {
+				return []interface{}{sourceToken, mezoToken, "invalid"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.ScheduleERC20TokenMappingMethodName)
+}
+
+func (s *ERC20LifecycleTestSuite) TestSetERC20TokenMappingStateMethod() {
+	sourceToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	mezoToken := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	state := func() bridgetypes.ERC20TokenMappingState {
+		mapping, ok := s.bridgeKeeper.GetERC20TokenMapping(s.ctx, sourceToken.Bytes())
+		s.Require().True(ok)
+		return mapping.State
+	}
+
+	testCases := []TestCase{
+		{
+			name: "failure - mapping does not exist",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStatePaused),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "given ERC20 mapping does not exist",
+		},
+		{
+			name: "failure - emergency team activates pending mapping",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.ScheduleERC20TokenMapping(
+					s.ctx,
+					sourceToken.Bytes(),
+					mezoToken.Bytes(),
+					100,
+				))
+				s.poaKeeper.emergencyTeam = s.account2.SdkAddr
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStateActive),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "success - owner activates pending mapping",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStateActive),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(bridgetypes.ERC20TokenMappingStateActive, state())
+			},
+		},
+		{
+			name: "success - emergency team pauses mapping",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStatePaused),
+				}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(bridgetypes.ERC20TokenMappingStatePaused, state())
+			},
+		},
+		{
+			name: "failure - emergency team deprecates mapping",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStateDeprecated),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - pending activation set directly",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStatePendingActivation),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "invalid ERC20 token mapping state",
+		},
+		{
+			name: "success - owner deprecates mapping",
+			run: func() []interface{} {
+				return []interface{}{
+					sourceToken,
+					uint8(bridgetypes.ERC20TokenMappingStateDeprecated),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(bridgetypes.ERC20TokenMappingStateDeprecated, state())
+				s.poaKeeper.emergencyTeam = nil
+			},
+		},
+		{
+			name: "failure - invalid state type",
+			run: func() []interface{} {
+				return []interface{}{sourceToken, "invalid"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetERC20TokenMappingStateMethodName)
+}
+
+func (s *ERC20LifecycleTestSuite) TestGetERC20TokenMappingStateMethod() {
+	sourceToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	mezoToken := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	testCases := []TestCase{
+		{
+			name: "failure - mapping does not exist",
+			run: func() []interface{} {
+				return []interface{}{sourceToken}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "given ERC20 mapping does not exist",
+		},
+		{
+			name: "success - returns pending state",
+			run: func() []interface{} {
+				s.Require().NoError(s.bridgeKeeper.ScheduleERC20TokenMapping(
+					s.ctx,
+					sourceToken.Bytes(),
+					mezoToken.Bytes(),
+					100,
+				))
+				return []interface{}{sourceToken}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				uint8(bridgetypes.ERC20TokenMappingStatePendingActivation),
+				uint64(100),
+			},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetERC20TokenMappingStateMethodName)
+}
@@ -0,0 +1,545 @@
+package assetsbridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+const (
+	SetOutflowWindowMethodName      = "setOutflowWindow"
+	GetOutflowWindowMethodName      = "getOutflowWindow"
+	SetUSDOutflowLimitMethodName    = "setUSDOutflowLimit"
+	GetUSDOutflowLimitMethodName    = "getUSDOutflowLimit"
+	SetUSDOutflowWindowMethodName   = "setUSDOutflowWindow"
+	GetUSDOutflowWindowMethodName   = "getUSDOutflowWindow"
+	GetUSDOutflowCapacityMethodName = "getUSDOutflowCapacity"
+	SetOutflowPriceFeedMethodName   = "setOutflowPriceFeed"
+	GetOutflowPriceFeedMethodName   = "getOutflowPriceFeed"
+)
+
+type SetOutflowWindowMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetOutflowWindowMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetOutflowWindowMethod {
+	return &SetOutflowWindowMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetOutflowWindowMethod) MethodName() string {
+	return SetOutflowWindowMethodName
+}
+
+func (m *SetOutflowWindowMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetOutflowWindowMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetOutflowWindowMethod) Payable() bool {
+	return false
+}
+
+func (m *SetOutflowWindowMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	window, err := extractOutflowWindowInputs(rawInputs[1], rawInputs[2])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.bridgeKeeper.SetOutflowWindow(
+		context.SdkCtx(),
+		token.Bytes(),
+		window,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetOutflowWindowMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetOutflowWindowMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetOutflowWindowMethod {
+	return &GetOutflowWindowMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetOutflowWindowMethod) MethodName() string {
+	return GetOutflowWindowMethodName
+}
+
+func (m *GetOutflowWindowMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetOutflowWindowMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetOutflowWindowMethod) Payable() bool {
+	return false
+}
+
+func (m *GetOutflowWindowMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	window := m.bridgeKeeper.GetOutflowWindow(context.SdkCtx(), token.Bytes())
+
+	return precompile.MethodOutputs{
+		window.WindowBlocks,
+		window.Buckets,
+	}, nil, nil
+}
+
+type SetUSDOutflowLimitMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetUSDOutflowLimitMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetUSDOutflowLimitMethod {
+	return &SetUSDOutflowLimitMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetUSDOutflowLimitMethod) MethodName() string {
+	return SetUSDOutflowLimitMethodName
+}
+
+func (m *SetUSDOutflowLimitMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetUSDOutflowLimitMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetUSDOutflowLimitMethod) Payable() bool {
+	return false
+}
+
+func (m *SetUSDOutflowLimitMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	limit, ok := rawInputs[0].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid limit: %v", rawInputs[0])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if limit.Sign() < 0 {
+		return nil, nil, errors.New("limit must be non-negative")
+	}
+
+	sdkLimit, err := precompile.TypesConverter.BigInt.ToSDK(limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert limit: [%w]", err)
+	}
+
+	m.bridgeKeeper.SetUSDOutflowLimit(context.SdkCtx(), sdkLimit)
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetUSDOutflowLimitMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetUSDOutflowLimitMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetUSDOutflowLimitMethod {
+	return &GetUSDOutflowLimitMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetUSDOutflowLimitMethod) MethodName() string {
+	return GetUSDOutflowLimitMethodName
+}
+
+func (m *GetUSDOutflowLimitMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetUSDOutflowLimitMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetUSDOutflowLimitMethod) Payable() bool {
+	return false
+}
+
+func (m *GetUSDOutflowLimitMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	limit := m.bridgeKeeper.GetUSDOutflowLimit(context.SdkCtx())
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(limit),
+	}, nil, nil
+}
+
+type SetUSDOutflowWindowMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetUSDOutflowWindowMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetUSDOutflowWindowMethod {
+	return &SetUSDOutflowWindowMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetUSDOutflowWindowMethod) MethodName() string {
+	return SetUSDOutflowWindowMethodName
+}
+
+func (m *SetUSDOutflowWindowMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetUSDOutflowWindowMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetUSDOutflowWindowMethod) Payable() bool {
+	return false
+}
+
+func (m *SetUSDOutflowWindowMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	window, err := extractOutflowWindowInputs(rawInputs[0], rawInputs[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.bridgeKeeper.SetUSDOutflowWindow(context.SdkCtx(), window); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetUSDOutflowWindowMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetUSDOutflowWindowMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetUSDOutflowWindowMethod {
+	return &GetUSDOutflowWindowMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetUSDOutflowWindowMethod) MethodName() string {
+	return GetUSDOutflowWindowMethodName
+}
+
+func (m *GetUSDOutflowWindowMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetUSDOutflowWindowMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetUSDOutflowWindowMethod) Payable() bool {
+	return false
+}
+
+func (m *GetUSDOutflowWindowMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	window := m.bridgeKeeper.GetUSDOutflowWindow(context.SdkCtx())
+
+	return precompile.MethodOutputs{
+		window.WindowBlocks,
+		window.Buckets,
+	}, nil, nil
+}
+
+type GetUSDOutflowCapacityMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetUSDOutflowCapacityMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetUSDOutflowCapacityMethod {
+	return &GetUSDOutflowCapacityMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetUSDOutflowCapacityMethod) MethodName() string {
+	return GetUSDOutflowCapacityMethodName
+}
+
+func (m *GetUSDOutflowCapacityMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetUSDOutflowCapacityMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetUSDOutflowCapacityMethod) Payable() bool {
+	return false
+}
+
+func (m *GetUSDOutflowCapacityMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	capacity, resetHeight := m.bridgeKeeper.GetUSDOutflowCapacity(context.SdkCtx())
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(capacity),
+		new(big.Int).SetUint64(resetHeight),
+	}, nil, nil
+}
+
+type SetOutflowPriceFeedMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetOutflowPriceFeedMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetOutflowPriceFeedMethod {
+	return &SetOutflowPriceFeedMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetOutflowPriceFeedMethod) MethodName() string {
+	return SetOutflowPriceFeedMethodName
+}
+
+func (m *SetOutflowPriceFeedMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetOutflowPriceFeedMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetOutflowPriceFeedMethod) Payable() bool {
+	return false
+}
+
+func (m *SetOutflowPriceFeedMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	currencyPair, ok := rawInputs[1].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid currency pair: %v", rawInputs[1])
+	}
+
+	decimals, ok := rawInputs[2].(uint8)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid decimals: %v", rawInputs[2])
+	}
+
+	if err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	// An empty currency pair removes the feed.
+	if len(currencyPair) == 0 {
+		m.bridgeKeeper.RemoveOutflowPriceFeed(context.SdkCtx(), token.Bytes())
+		return precompile.MethodOutputs{true}, nil, nil
+	}
+
+	if err := m.bridgeKeeper.SetOutflowPriceFeed(
+		context.SdkCtx(),
+		bridgetypes.OutflowPriceFeed{
+			Token:        evmtypes.BytesToHexAddress(token.Bytes()),
+			CurrencyPair: currencyPair,
+			Decimals:     uint32(decimals),
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetOutflowPriceFeedMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetOutflowPriceFeedMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetOutflowPriceFeedMethod {
+	return &GetOutflowPriceFeedMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetOutflowPriceFeedMethod) MethodName() string {
+	return GetOutflowPriceFeedMethodName
+}
+
+func (m *GetOutflowPriceFeedMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetOutflowPriceFeedMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetOutflowPriceFeedMethod) Payable() bool {
+	return false
+}
+
+func (m *GetOutflowPriceFeedMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	// A missing feed is returned as an empty currency pair.
+	feed, _ := m.bridgeKeeper.GetOutflowPriceFeed(context.SdkCtx(), token.Bytes())
+
+	return precompile.MethodOutputs{
+		feed.CurrencyPair,
+		uint8(feed.Decimals), //nolint:gosec // Decimals are validated to fit.
+	}, nil, nil
+}
+
+// extractOutflowWindowInputs converts the raw windowBlocks and buckets inputs
+// into an outflow window.
+func extractOutflowWindowInputs(
+	rawWindowBlocks interface{},
+	rawBuckets interface{},
+) (bridgetypes.OutflowWindow, error) {
+	windowBlocks, ok := rawWindowBlocks.(uint64)
+	if !ok {
+		return bridgetypes.OutflowWindow{}, fmt.Errorf("invalid window blocks: %v", rawWindowBlocks)
+	}
+
+	buckets, ok := rawBuckets.(uint32)
+	if !ok {
+		return bridgetypes.OutflowWindow{}, fmt.Errorf("invalid buckets: %v", rawBuckets)
+	}
+
+	return bridgetypes.NewOutflowWindow(windowBlocks, buckets), nil
+}
@@ -0,0 +1,492 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutflowPolicyTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestOutflowPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(OutflowPolicyTestSuite))
+}
+
+func (s *OutflowPolicyTestSuite) TestSetOutflowWindowMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets valid window",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					uint64(1000),
+					uint32(10),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				window := s.bridgeKeeper.GetOutflowWindow(s.ctx, testTokenAddress.Bytes())
+				s.Require().Equal(bridgetypes.NewOutflowWindow(1000, 10), window)
+			},
+		},
+		{
+			name: "success - owner clears window",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					uint64(0),
+					uint32(0),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				window := s.bridgeKeeper.GetOutflowWindow(s.ctx, testTokenAddress.Bytes())
+				s.Require().True(window.IsZero())
+			},
+		},
+		{
+			name: "failure - window not a multiple of buckets",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					uint64(1001),
+					uint32(10),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "positive multiple of buckets",
+		},
+		{
+			name: "failure - non-owner attempts to set window",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					uint64(1000),
+					uint32(10),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid buckets type",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					uint64(1000),
+					"invalid buckets",
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetOutflowWindowMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestGetOutflowWindowMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns set window",
+			run: func() []interface{} {
+				err := s.bridgeKeeper.SetOutflowWindow(
+					s.ctx,
+					testTokenAddress.Bytes(),
+					bridgetypes.NewOutflowWindow(2000, 20),
+				)
+				s.Require().NoError(err)
+
+				return []interface{}{
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(2000), uint32(20)},
+		},
+		{
+			name: "success - returns zero window for unset token",
+			run: func() []interface{} {
+				return []interface{}{
+					common.HexToAddress("0x9999999999999999999999999999999999999999"),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(0), uint32(0)},
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetOutflowWindowMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestSetUSDOutflowLimitMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets valid limit",
+			run: func() []interface{} {
+				return []interface{}{
+					big.NewInt(1000000),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(
+					math.NewInt(1000000),
+					s.bridgeKeeper.GetUSDOutflowLimit(s.ctx),
+				)
+			},
+		},
+		{
+			name: "success - owner disables limit",
+			run: func() []interface{} {
+				return []interface{}{
+					big.NewInt(0),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.bridgeKeeper.GetUSDOutflowLimit(s.ctx).IsZero())
+			},
+		},
+		{
+			name: "failure - non-owner attempts to set limit",
+			run: func() []interface{} {
+				return []interface{}{
+					big.NewInt(1000000),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid limit type",
+			run: func() []interface{} {
+				return []interface{}{
+					"invalid limit",
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetUSDOutflowLimitMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestGetUSDOutflowLimitMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns set limit",
+			run: func() []interface{} {
+				s.bridgeKeeper.SetUSDOutflowLimit(s.ctx, math.NewInt(5000000))
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(5000000)},
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{
+					big.NewInt(123),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetUSDOutflowLimitMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestSetUSDOutflowWindowMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets valid window",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(600),
+					uint32(6),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(
+					bridgetypes.NewOutflowWindow(600, 6),
+					s.bridgeKeeper.GetUSDOutflowWindow(s.ctx),
+				)
+			},
+		},
+		{
+			name: "failure - too many buckets",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(1010),
+					uint32(101),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "outflow window buckets must be between 1 and 100",
+		},
+		{
+			name: "failure - non-owner attempts to set window",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(600),
+					uint32(6),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetUSDOutflowWindowMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestGetUSDOutflowWindowMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns set window",
+			run: func() []interface{} {
+				err := s.bridgeKeeper.SetUSDOutflowWindow(
+					s.ctx,
+					bridgetypes.NewOutflowWindow(300, 3),
+				)
+				s.Require().NoError(err)
+
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(300), uint32(3)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetUSDOutflowWindowMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestGetUSDOutflowCapacityMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - when no outflow",
+			run: func() []interface{} {
+				s.bridgeKeeper.SetUSDOutflowLimit(s.ctx, math.NewInt(1000000))
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				big.NewInt(1000000), // capacity equals limit when no outflow
+				big.NewInt(25000),   // reset height from fake keeper
+			},
+		},
+		{
+			name: "success - with outflow",
+			run: func() []interface{} {
+				s.bridgeKeeper.SetUSDOutflowLimit(s.ctx, math.NewInt(1000000))
+				s.bridgeKeeper.usdOutflowCurrent = math.NewInt(400000)
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				big.NewInt(600000),
+				big.NewInt(25000),
+			},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetUSDOutflowCapacityMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestSetOutflowPriceFeedMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets valid feed",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"ETH/USD",
+					uint8(18),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				feed, ok := s.bridgeKeeper.GetOutflowPriceFeed(s.ctx, testTokenAddress.Bytes())
+				s.Require().True(ok)
+				s.Require().Equal(
+					bridgetypes.OutflowPriceFeed{
+						Token:        evmtypes.BytesToHexAddress(testTokenAddress.Bytes()),
+						CurrencyPair: "ETH/USD",
+						Decimals:     18,
+					},
+					feed,
+				)
+			},
+		},
+		{
+			name: "success - owner removes feed with empty pair",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"",
+					uint8(0),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				_, ok := s.bridgeKeeper.GetOutflowPriceFeed(s.ctx, testTokenAddress.Bytes())
+				s.Require().False(ok)
+			},
+		},
+		{
+			name: "failure - invalid currency pair",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"ETHUSD",
+					uint8(18),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "invalid currency pair",
+		},
+		{
+			name: "failure - too many decimals",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"ETH/USD",
+					uint8(37),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "outflow price feed decimals cannot exceed 36",
+		},
+		{
+			name: "failure - non-owner attempts to set feed",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"ETH/USD",
+					uint8(18),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid decimals type",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					"ETH/USD",
+					"invalid decimals",
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetOutflowPriceFeedMethodName)
+}
+
+func (s *OutflowPolicyTestSuite) TestGetOutflowPriceFeedMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns set feed",
+			run: func() []interface{} {
+				err := s.bridgeKeeper.SetOutflowPriceFeed(
+					s.ctx,
+					bridgetypes.OutflowPriceFeed{
+						Token:        evmtypes.BytesToHexAddress(testTokenAddress.Bytes()),
+						CurrencyPair: "BTC/USD",
+						Decimals:     8,
+					},
+				)
+				s.Require().NoError(err)
+
+				return []interface{}{
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{"BTC/USD", uint8(8)},
+		},
+		{
+			name: "success - returns empty feed for unset token",
+			run: func() []interface{} {
+				return []interface{}{
+					common.HexToAddress("0x9999999999999999999999999999999999999999"),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{"", uint8(0)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetOutflowPriceFeedMethodName)
+}
@@ -0,0 +1,311 @@
+package assetsbridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const (
+	SetSenderOutflowParamsMethodName   = "setSenderOutflowParams"
+	GetSenderOutflowParamsMethodName   = "getSenderOutflowParams"
+	SetSenderOutflowLimitMethodName    = "setSenderOutflowLimit"
+	GetSenderOutflowLimitMethodName    = "getSenderOutflowLimit"
+	GetSenderOutflowCapacityMethodName = "getSenderOutflowCapacity"
+)
+
+type SetSenderOutflowParamsMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetSenderOutflowParamsMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetSenderOutflowParamsMethod {
+	return &SetSenderOutflowParamsMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetSenderOutflowParamsMethod) MethodName() string {
+	return SetSenderOutflowParamsMethodName
+}
+
+func (m *SetSenderOutflowParamsMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetSenderOutflowParamsMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetSenderOutflowParamsMethod) Payable() bool {
+	return false
+}
+
+func (m *SetSenderOutflowParamsMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	windowBlocks, ok := rawInputs[0].(uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid window blocks: %v", rawInputs[0])
+	}
+
+	maxCount, ok := rawInputs[1].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid max count: %v", rawInputs[1])
+	}
+
+	// This method is restricted to the PoA owner and the emergency team.
+	if err := m.poaKeeper.CheckOwnerOrEmergencyTeam(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.bridgeKeeper.SetSenderOutflowParams(
+		context.SdkCtx(),
+		bridgetypes.NewSenderOutflowParams(windowBlocks, maxCount),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetSenderOutflowParamsMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetSenderOutflowParamsMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetSenderOutflowParamsMethod {
+	return &GetSenderOutflowParamsMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetSenderOutflowParamsMethod) MethodName() string {
+	return GetSenderOutflowParamsMethodName
+}
+
+func (m *GetSenderOutflowParamsMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetSenderOutflowParamsMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetSenderOutflowParamsMethod) Payable() bool {
+	return false
+}
+
+func (m *GetSenderOutflowParamsMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	params := m.bridgeKeeper.GetSenderOutflowParams(context.SdkCtx())
+
+	return precompile.MethodOutputs{
+		params.WindowBlocks,
+		params.MaxCount,
+	}, nil, nil
+}
+
+type SetSenderOutflowLimitMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetSenderOutflowLimitMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetSenderOutflowLimitMethod {
+	return &SetSenderOutflowLimitMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetSenderOutflowLimitMethod) MethodName() string {
+	return SetSenderOutflowLimitMethodName
+}
+
+func (m *SetSenderOutflowLimitMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetSenderOutflowLimitMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetSenderOutflowLimitMethod) Payable() bool {
+	return false
+}
+
+func (m *SetSenderOutflowLimitMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	limit, ok := rawInputs[1].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid limit: %v", rawInputs[1])
+	}
+
+	// This method is restricted to the PoA owner and the emergency team.
+	if err := m.poaKeeper.CheckOwnerOrEmergencyTeam(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if limit.Sign() < 0 {
+		return nil, nil, errors.New("limit must be non-negative")
+	}
+
+	sdkLimit, err := precompile.TypesConverter.BigInt.ToSDK(limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert limit: [%w]", err)
+	}
+
+	m.bridgeKeeper.SetSenderOutflowLimit(context.SdkCtx(), token.Bytes(), sdkLimit)
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+type GetSenderOutflowLimitMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetSenderOutflowLimitMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetSenderOutflowLimitMethod {
+	return &GetSenderOutflowLimitMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetSenderOutflowLimitMethod) MethodName() string {
+	return GetSenderOutflowLimitMethodName
+}
+
+func (m *GetSenderOutflowLimitMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetSenderOutflowLimitMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetSenderOutflowLimitMethod) Payable() bool {
+	return false
+}
+
+func (m *GetSenderOutflowLimitMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	token, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[0])
+	}
+
+	limit := m.bridgeKeeper.GetSenderOutflowLimit(context.SdkCtx(), token.Bytes())
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(limit),
+	}, nil, nil
+}
+
+type GetSenderOutflowCapacityMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetSenderOutflowCapacityMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetSenderOutflowCapacityMethod {
+	return &GetSenderOutflowCapacityMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetSenderOutflowCapacityMethod) MethodName() string {
+	return GetSenderOutflowCapacityMethodName
+}
+
+func (m *GetSenderOutflowCapacityMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetSenderOutflowCapacityMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetSenderOutflowCapacityMethod) Payable() bool {
+	return false
+}
+
+func (m *GetSenderOutflowCapacityMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	sender, ok := rawInputs[0].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid sender address: %v", rawInputs[0])
+	}
+
+	token, ok := rawInputs[1].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid token address: %v", rawInputs[1])
+	}
+
+	capacity := m.bridgeKeeper.GetSenderOutflowCapacity(
+		context.SdkCtx(),
+		sender.Bytes(),
+		token.Bytes(),
+	)
+
+	return precompile.MethodOutputs{
+		precompile.TypesConverter.BigInt.FromSDK(capacity.AmountLimit),
+		precompile.TypesConverter.BigInt.FromSDK(capacity.AmountCapacity),
+		capacity.CountLimit,
+		capacity.CountCapacity,
+		new(big.Int).SetUint64(capacity.ResetHeight),
+	}, nil, nil
+}
@@ -0,0 +1,327 @@
+package assetsbridge_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type SenderOutflowTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestSenderOutflowTestSuite(t *testing.T) {
+	suite.Run(t, new(SenderOutflowTestSuite))
+}
+
+func (s *SenderOutflowTestSuite) TestSetSenderOutflowParamsMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets params",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(1000),
+					uint32(5),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				params := s.bridgeKeeper.GetSenderOutflowParams(s.ctx)
+				s.Require().Equal(bridgetypes.NewSenderOutflowParams(1000, 5), params)
+			},
+		},
+		{
+			name: "success - emergency team disables params",
+			run: func() []interface{} {
+				s.poaKeeper.emergencyTeam = s.account2.SdkAddr
+				return []interface{}{
+					uint64(0),
+					uint32(0),
+				}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.poaKeeper.emergencyTeam = nil
+				params := s.bridgeKeeper.GetSenderOutflowParams(s.ctx)
+				s.Require().False(params.IsEnabled())
+			},
+		},
+		{
+			name: "failure - max count without window",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(0),
+					uint32(5),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "requires a non-zero window",
+		},
+		{
+			name: "failure - neither owner nor emergency team",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(1000),
+					uint32(5),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "emergency team address is empty",
+		},
+		{
+			name: "failure - invalid max count type",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(1000),
+					"invalid max count",
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{
+					uint64(1000),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetSenderOutflowParamsMethodName)
+}
+
+func (s *SenderOutflowTestSuite) TestGetSenderOutflowParamsMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns zero params when unset",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(0), uint32(0)},
+		},
+		{
+			name: "success - returns set params",
+			run: func() []interface{} {
+				err := s.bridgeKeeper.SetSenderOutflowParams(
+					s.ctx,
+					bridgetypes.NewSenderOutflowParams(2000, 3),
+				)
+				s.Require().NoError(err)
+
+				return []interface{}{}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{uint64(2000), uint32(3)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetSenderOutflowParamsMethodName)
+}
+
+func (s *SenderOutflowTestSuite) TestSetSenderOutflowLimitMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner sets limit",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					big.NewInt(1000),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				limit := s.bridgeKeeper.GetSenderOutflowLimit(s.ctx, testTokenAddress.Bytes())
+				s.Require().Equal(math.NewInt(1000), limit)
+			},
+		},
+		{
+			name: "success - emergency team sets limit",
+			run: func() []interface{} {
+				s.poaKeeper.emergencyTeam = s.account2.SdkAddr
+				return []interface{}{
+					testTokenAddress,
+					big.NewInt(500),
+				}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.poaKeeper.emergencyTeam = nil
+				limit := s.bridgeKeeper.GetSenderOutflowLimit(s.ctx, testTokenAddress.Bytes())
+				s.Require().Equal(math.NewInt(500), limit)
+			},
+		},
+		{
+			name: "failure - negative limit",
+			run: func() []interface{} {
+				return []interface{}{
+					testTokenAddress,
+					big.NewInt(-1),
+				}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "limit must be non-negative",
+		},
+		{
+			name: "failure - not the emergency team",
+			run: func() []interface{} {
+				s.poaKeeper.emergencyTeam = s.account1.SdkAddr
+				return []interface{}{
+					testTokenAddress,
+					big.NewInt(1000),
+				}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not the emergency team",
+		},
+		{
+			name: "failure - invalid token type",
+			run: func() []interface{} {
+				return []interface{}{
+					"invalid token",
+					big.NewInt(1000),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetSenderOutflowLimitMethodName)
+}
+
+func (s *SenderOutflowTestSuite) TestGetSenderOutflowLimitMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns set limit",
+			run: func() []interface{} {
+				s.bridgeKeeper.SetSenderOutflowLimit(
+					s.ctx,
+					testTokenAddress.Bytes(),
+					math.NewInt(2000),
+				)
+				return []interface{}{
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(2000)},
+		},
+		{
+			name: "success - returns zero for unset token",
+			run: func() []interface{} {
+				return []interface{}{
+					common.HexToAddress("0x9999999999999999999999999999999999999999"),
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(0)},
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetSenderOutflowLimitMethodName)
+}
+
+func (s *SenderOutflowTestSuite) TestGetSenderOutflowCapacityMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - when disabled",
+			run: func() []interface{} {
+				return []interface{}{
+					s.account2.EvmAddr,
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				big.NewInt(0),
+				big.NewInt(0),
+				uint32(0),
+				uint32(0),
+				big.NewInt(0),
+			},
+		},
+		{
+			name: "success - with outflow",
+			run: func() []interface{} {
+				err := s.bridgeKeeper.SetSenderOutflowParams(
+					s.ctx,
+					bridgetypes.NewSenderOutflowParams(100, 5),
+				)
+				s.Require().NoError(err)
+				s.bridgeKeeper.SetSenderOutflowLimit(
+					s.ctx,
+					testTokenAddress.Bytes(),
+					math.NewInt(1000),
+				)
+				s.bridgeKeeper.senderOutflowCurrent[hex.EncodeToString(s.account2.EvmAddr.Bytes())] = math.NewInt(400)
+				s.bridgeKeeper.senderOutflowCount[hex.EncodeToString(s.account2.EvmAddr.Bytes())] = 2
+
+				return []interface{}{
+					s.account2.EvmAddr,
+					testTokenAddress,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				big.NewInt(1000),
+				big.NewInt(600),
+				uint32(5),
+				uint32(3),
+				big.NewInt(100), // reset height from fake keeper
+			},
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{
+					s.account2.EvmAddr,
+				}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetSenderOutflowCapacityMethodName)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"slices"
 	"testing"
@@ -27,6 +28,7 @@ import (
 	"github.com/mezo-org/mezod/testutil"
 	utiltx "github.com/mezo-org/mezod/testutil/tx"
 	"github.com/mezo-org/mezod/x/evm/statedb"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -39,6 +41,8 @@ type TestCase struct {
 	run func() []interface{}
 	// address to execute method as (msg.sender)
 	as common.Address
+	// gas left to the method call
+	gas uint64
 	// function to perform any post checks
 	postCheck func()
 	// true if expected good inputs, false if expect an input related error (set errContains)
@@ -119,13 +123,22 @@ func (s *PrecompileTestSuite) SetupTest() {
 // version.
 func latestSettings() *assetsbridge.Settings {
 	return &assetsbridge.Settings{
-		Observability:   true,
-		BTCManagement:   true,
-		ERC20Management: true,
-		SequenceTipView: true,
-		BridgeOut:       true,
-		Triparty:        true,
-		BridgeOutChains: true,
+		Observability:         true,
+		BTCManagement:         true,
+		ERC20Management:       true,
+		SequenceTipView:       true,
+		BridgeOut:             true,
+		Triparty:              true,
+		BridgeOutChains:       true,
+		OutflowPolicies:       true,
+		SenderOutflowLimits:   true,
+		DelayedBridgeOut:      true,
+		SourceChains:          true,
+		BridgeOutFees:         true,
+		ERC20MappingLifecycle: true,
+		TripartyOutcomes:      true,
+		TripartyCallbackRetry: true,
+		TripartyCancellation:  true,
 	}
 }
 
@@ -174,7 +187,7 @@ func (s *PrecompileTestSuite) RunMethodTestCasesWithSettings(
 				return
 			}
 
-			vmContract := vm.NewPrecompile(tc.as, common.Address{}, nil, 0)
+			vmContract := vm.NewPrecompile(tc.as, common.Address{}, nil, tc.gas)
 			vmContract.Input = append(vmContract.Input, method.ID...)
 			vmContract.Input = append(vmContract.Input, methodInputArgs...)
 
@@ -206,7 +219,8 @@ func (s *PrecompileTestSuite) RunMethodTestCasesWithSettings(
 }
 
 type FakePoaKeeper struct {
-	owner sdk.AccAddress
+	owner         sdk.AccAddress
+	emergencyTeam sdk.AccAddress
 }
 
 func NewFakePoaKeeper(owner sdk.AccAddress) *FakePoaKeeper {
@@ -225,6 +239,31 @@ func (k *FakePoaKeeper) CheckOwner(_ sdk.Context, sender sdk.AccAddress) error {
 	return nil
 }
 
+func (k *FakePoaKeeper) CheckOwnerOrEmergencyTeam(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+) error {
+	if k.CheckOwner(ctx, sender) == nil {
+		return nil
+	}
+
+	if k.emergencyTeam.Empty() {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidAddress,
+			"emergency team address is empty",
+		)
+	}
+
+	if !sender.Equals(k.emergencyTeam) {
+		return errorsmod.Wrap(
+			sdkerrors.ErrUnauthorized,
+			"sender is not the emergency team",
+		)
+	}
+
+	return nil
+}
+
 type tripartyBridgeRequestParams struct {
 	recipient    string
 	amount       math.Int
@@ -246,6 +285,30 @@ type FakeBridgeKeeper struct {
 	outflowCurrent  map[string]math.Int
 	lastResetHeight uint64
 
+	outflowWindows    map[string]bridgetypes.OutflowWindow
+	usdOutflowLimit   math.Int
+	usdOutflowWindow  bridgetypes.OutflowWindow
+	usdOutflowCurrent math.Int
+	outflowPriceFeeds map[string]bridgetypes.OutflowPriceFeed
+
+	senderOutflowParams  bridgetypes.SenderOutflowParams
+	senderOutflowLimits  map[string]math.Int
+	senderOutflowCurrent map[string]math.Int
+	senderOutflowCount   map[string]uint32
+
+	delayedBridgeOutBlocks     uint64
+	delayedBridgeOutThresholds map[string]math.Int
+	delayedBridgeOuts          map[uint64]*bridgetypes.DelayedBridgeOut
+	delayedBridgeOutTip        uint64
+
+	sourceChains            map[uint32]bridgetypes.SourceChain
+	sourceChainSequenceTips map[uint32]math.Int
+	sourceChainMappings     map[uint32][]*bridgetypes.ERC20TokenMapping
+
+	bridgeOutFeeTreasury  []byte
+	bridgeOutFees         map[string]bridgetypes.BridgeOutFee
+	collectedBridgeOutFee map[string]math.Int
+
 	tripartyControllers             map[string]bool
 	tripartyBlockDelay              int64
 	tripartyPerRequestLimit         math.Int
@@ -254,6 +317,11 @@ type FakeBridgeKeeper struct {
 	tripartyWindowMinted            math.Int
 	tripartyControllerBTCMinted     map[string]math.Int
 	lastTripartyBridgeRequestParams *tripartyBridgeRequestParams
+	tripartyOutcomes                map[string]*bridgetypes.TripartyBridgeRequestOutcome
+	tripartyCallbackRetryPolicy     bridgetypes.TripartyCallbackRetryPolicy
+	failedTripartyCallbacks         map[string]*bridgetypes.FailedTripartyCallback
+	tripartyCallbackRetryGasUsed    uint64
+	tripartyRequests                map[string]*bridgetypes.TripartyBridgeRequest
 }
 
 func NewFakeBridgeKeeper(sourceBTCToken []byte) *FakeBridgeKeeper {
@@ -264,6 +332,20 @@ func NewFakeBridgeKeeper(sourceBTCToken []byte) *FakeBridgeKeeper {
 		outflowLimits:               make(map[string]math.Int),
 		outflowCurrent:              make(map[string]math.Int),
 		lastResetHeight:             0,
+		outflowWindows:              make(map[string]bridgetypes.OutflowWindow),
+		usdOutflowLimit:             math.ZeroInt(),
+		usdOutflowCurrent:           math.ZeroInt(),
+		outflowPriceFeeds:           make(map[string]bridgetypes.OutflowPriceFeed),
+		senderOutflowLimits:         make(map[string]math.Int),
+		senderOutflowCurrent:        make(map[string]math.Int),
+		senderOutflowCount:          make(map[string]uint32),
+		delayedBridgeOutThresholds:  make(map[string]math.Int),
+		delayedBridgeOuts:           make(map[uint64]*bridgetypes.DelayedBridgeOut),
+		sourceChains:                make(map[uint32]bridgetypes.SourceChain),
+		sourceChainSequenceTips:     make(map[uint32]math.Int),
+		sourceChainMappings:         make(map[uint32][]*bridgetypes.ERC20TokenMapping),
+		bridgeOutFees:               make(map[string]bridgetypes.BridgeOutFee),
+		collectedBridgeOutFee:       make(map[string]math.Int),
 		minAmountByToken:            make(map[string]math.Int),
 		minAmountForBitcoinChain:    math.ZeroInt(),
 		bridgeOutChains:             make(map[uint8]bool),
@@ -274,6 +356,10 @@ func NewFakeBridgeKeeper(sourceBTCToken []byte) *FakeBridgeKeeper {
 		tripartySequenceTip:         math.ZeroInt(),
 		tripartyWindowMinted:        math.ZeroInt(),
 		tripartyControllerBTCMinted: make(map[string]math.Int),
+		tripartyOutcomes:            make(map[string]*bridgetypes.TripartyBridgeRequestOutcome),
+		tripartyCallbackRetryPolicy: bridgetypes.DefaultTripartyCallbackRetryPolicy(),
+		failedTripartyCallbacks:     make(map[string]*bridgetypes.FailedTripartyCallback),
+		tripartyRequests:            make(map[string]*bridgetypes.TripartyBridgeRequest),
 	}
 }
 
@@ -470,6 +556,388 @@ func (k *FakeBridgeKeeper) increaseCurrentOutflow(token []byte, amount math.Int)
 	k.outflowCurrent[key] = current.Add(amount)
 }
 
+func (k *FakeBridgeKeeper) GetOutflowWindow(_ sdk.Context, token []byte) bridgetypes.OutflowWindow {
+	return k.outflowWindows[hex.EncodeToString(token)]
+}
+
+func (k *FakeBridgeKeeper) SetOutflowWindow(
+	_ sdk.Context,
+	token []byte,
+	window bridgetypes.OutflowWindow,
+) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+	k.outflowWindows[hex.EncodeToString(token)] = window
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetUSDOutflowLimit(_ sdk.Context) math.Int {
+	return k.usdOutflowLimit
+}
+
+func (k *FakeBridgeKeeper) SetUSDOutflowLimit(_ sdk.Context, limit math.Int) {
+	k.usdOutflowLimit = limit
+}
+
+func (k *FakeBridgeKeeper) GetUSDOutflowWindow(_ sdk.Context) bridgetypes.OutflowWindow {
+	return k.usdOutflowWindow
+}
+
+func (k *FakeBridgeKeeper) SetUSDOutflowWindow(_ sdk.Context, window bridgetypes.OutflowWindow) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+	k.usdOutflowWindow = window
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetUSDOutflowCapacity(_ sdk.Context) (capacity math.Int, resetHeight uint64) {
+	capacity = k.usdOutflowLimit.Sub(k.usdOutflowCurrent)
+	if capacity.IsNegative() {
+		capacity = math.ZeroInt()
+	}
+
+	// Use a fixed reset height for testing
+	resetHeight = k.lastResetHeight + 25000
+
+	return capacity, resetHeight
+}
+
+func (k *FakeBridgeKeeper) GetOutflowPriceFeed(
+	_ sdk.Context,
+	token []byte,
+) (bridgetypes.OutflowPriceFeed, bool) {
+	feed, ok := k.outflowPriceFeeds[hex.EncodeToString(token)]
+	return feed, ok
+}
+
+func (k *FakeBridgeKeeper) SetOutflowPriceFeed(_ sdk.Context, feed bridgetypes.OutflowPriceFeed) error {
+	if err := feed.Validate(); err != nil {
+		return err
+	}
+	k.outflowPriceFeeds[hex.EncodeToString(evmtypes.HexAddressToBytes(feed.Token))] = feed
+	return nil
+}
+
+func (k *FakeBridgeKeeper) RemoveOutflowPriceFeed(_ sdk.Context, token []byte) {
+	delete(k.outflowPriceFeeds, hex.EncodeToString(token))
+}
+
+func (k *FakeBridgeKeeper) GetSenderOutflowParams(_ sdk.Context) bridgetypes.SenderOutflowParams {
+	return k.senderOutflowParams
+}
+
+func (k *FakeBridgeKeeper) SetSenderOutflowParams(_ sdk.Context, params bridgetypes.SenderOutflowParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.senderOutflowParams = params
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetSenderOutflowLimit(_ sdk.Context, token []byte) math.Int {
+	if limit, ok := k.senderOutflowLimits[hex.EncodeToString(token)]; ok {
+		return limit
+	}
+	return math.ZeroInt()
+}
+
+func (k *FakeBridgeKeeper) SetSenderOutflowLimit(_ sdk.Context, token []byte, limit math.Int) {
+	k.senderOutflowLimits[hex.EncodeToString(token)] = limit
+}
+
+func (k *FakeBridgeKeeper) GetSenderOutflowCapacity(
+	ctx sdk.Context,
+	sender []byte,
+	token []byte,
+) bridgetypes.SenderOutflowCapacity {
+	capacity := bridgetypes.SenderOutflowCapacity{
+		AmountLimit:    k.GetSenderOutflowLimit(ctx, token),
+		CurrentAmount:  math.ZeroInt(),
+		AmountCapacity: math.ZeroInt(),
+		CountLimit:     k.senderOutflowParams.MaxCount,
+	}
+
+	if current, ok := k.senderOutflowCurrent[hex.EncodeToString(sender)]; ok {
+		capacity.CurrentAmount = current
+	}
+	if remaining := capacity.AmountLimit.Sub(capacity.CurrentAmount); remaining.IsPositive() {
+		capacity.AmountCapacity = remaining
+	}
+
+	capacity.CurrentCount = k.senderOutflowCount[hex.EncodeToString(sender)]
+	if capacity.CurrentCount < capacity.CountLimit {
+		capacity.CountCapacity = capacity.CountLimit - capacity.CurrentCount
+	}
+
+	// Use a fixed reset height for testing
+	if k.senderOutflowParams.IsEnabled() {
+		capacity.ResetHeight = k.senderOutflowParams.WindowBlocks
+	}
+
+	return capacity
+}
+
+func (k *FakeBridgeKeeper) GetDelayedBridgeOutBlocks(_ sdk.Context) uint64 {
+	return k.delayedBridgeOutBlocks
+}
+
+func (k *FakeBridgeKeeper) SetDelayedBridgeOutBlocks(_ sdk.Context, blocks uint64) error {
+	if err := bridgetypes.ValidateDelayedBridgeOutBlocks(blocks); err != nil {
+		return err
+	}
+
+	k.delayedBridgeOutBlocks = blocks
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetDelayedBridgeOutThreshold(_ sdk.Context, token []byte) math.Int {
+	if threshold, ok := k.delayedBridgeOutThresholds[hex.EncodeToString(token)]; ok {
+		return threshold
+	}
+	return math.ZeroInt()
+}
+
+func (k *FakeBridgeKeeper) SetDelayedBridgeOutThreshold(_ sdk.Context, token []byte, threshold math.Int) {
+	k.delayedBridgeOutThresholds[hex.EncodeToString(token)] = threshold
+}
+
+func (k *FakeBridgeKeeper) IsDelayedBridgeOut(ctx sdk.Context, token []byte, amount math.Int) bool {
+	threshold := k.GetDelayedBridgeOutThreshold(ctx, token)
+	return k.delayedBridgeOutBlocks > 0 && threshold.IsPositive() && amount.GTE(threshold)
+}
+
+func (k *FakeBridgeKeeper) SaveDelayedBridgeOut(
+	ctx sdk.Context,
+	recipient []byte,
+	token []byte,
+	sender []byte,
+	amount math.Int,
+	chain uint8,
+) (*bridgetypes.DelayedBridgeOut, error) {
+	k.delayedBridgeOutTip++
+
+	fee := k.GetBridgeOutFee(ctx, token, chain).Amount(amount)
+
+	bridgeOut := &bridgetypes.DelayedBridgeOut{
+		Id:        k.delayedBridgeOutTip,
+		Recipient: recipient,
+		Token:     common.BytesToAddress(token).Hex(),
+		Sender:    common.BytesToAddress(sender).Hex(),
+		Amount:    amount.Sub(fee),
+		Fee:       fee,
+		Chain:     uint32(chain),
+		// Use the delay as the release height for testing
+		ReleaseHeight: k.delayedBridgeOutBlocks,
+	}
+	k.delayedBridgeOuts[bridgeOut.Id] = bridgeOut
+
+	return bridgeOut, nil
+}
+
+func (k *FakeBridgeKeeper) GetDelayedBridgeOut(_ sdk.Context, id uint64) (*bridgetypes.DelayedBridgeOut, bool) {
+	bridgeOut, ok := k.delayedBridgeOuts[id]
+	return bridgeOut, ok
+}
+
+func (k *FakeBridgeKeeper) CancelDelayedBridgeOut(
+	_ sdk.Context,
+	id uint64,
+) (*bridgetypes.DelayedBridgeOut, []statedb.StateChange, error) {
+	bridgeOut, ok := k.delayedBridgeOuts[id]
+	if !ok {
+		return nil, nil, bridgetypes.ErrDelayedBridgeOutNotFound
+	}
+
+	delete(k.delayedBridgeOuts, id)
+
+	return bridgeOut, nil, nil
+}
+
+func (k *FakeBridgeKeeper) GetBridgeOutFeeTreasury(_ sdk.Context) []byte {
+	return k.bridgeOutFeeTreasury
+}
+
+func (k *FakeBridgeKeeper) SetBridgeOutFeeTreasury(_ sdk.Context, treasury []byte) error {
+	if bytes.Equal(treasury, common.Address{}.Bytes()) {
+		return bridgetypes.ErrZeroEVMAddress
+	}
+
+	k.bridgeOutFeeTreasury = treasury
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetBridgeOutFee(
+	_ sdk.Context,
+	mezoToken []byte,
+	chain uint8,
+) bridgetypes.BridgeOutFee {
+	key := fmt.Sprintf("%s-%d", common.BytesToAddress(mezoToken).Hex(), chain)
+	if fee, ok := k.bridgeOutFees[key]; ok {
+		return fee
+	}
+
+	return bridgetypes.NewBridgeOutFee(mezoToken, chain, math.ZeroInt(), 0)
+}
+
+func (k *FakeBridgeKeeper) SetBridgeOutFee(_ sdk.Context, fee bridgetypes.BridgeOutFee) error {
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	if !fee.IsZero() && len(k.bridgeOutFeeTreasury) == 0 {
+		return bridgetypes.ErrBridgeOutFeeTreasuryNotSet
+	}
+
+	key := fmt.Sprintf("%s-%d", common.HexToAddress(fee.Token).Hex(), fee.Chain)
+	if fee.IsZero() {
+		delete(k.bridgeOutFees, key)
+	} else {
+		k.bridgeOutFees[key] = fee
+	}
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) CollectBridgeOutFee(
+	_ sdk.Context,
+	mezoToken []byte,
+	fee math.Int,
+) ([]statedb.StateChange, error) {
+	if len(k.bridgeOutFeeTreasury) == 0 {
+		return nil, bridgetypes.ErrBridgeOutFeeTreasuryNotSet
+	}
+
+	key := common.BytesToAddress(mezoToken).Hex()
+	collected, ok := k.collectedBridgeOutFee[key]
+	if !ok {
+		collected = math.ZeroInt()
+	}
+	k.collectedBridgeOutFee[key] = collected.Add(fee)
+
+	return nil, nil
+}
+
+func (k *FakeBridgeKeeper) ScheduleERC20TokenMapping(
+	ctx sdk.Context,
+	sourceToken, mezoToken []byte,
+	activationHeight uint64,
+) error {
+	//nolint:gosec
+	if activationHeight <= uint64(ctx.BlockHeight()) {
+		return bridgetypes.ErrInvalidERC20TokenMappingState
+	}
+
+	k.erc20TokensMappings = append(
+		k.erc20TokensMappings,
+		bridgetypes.NewPendingERC20TokenMapping(sourceToken, mezoToken, activationHeight),
+	)
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) SetERC20TokenMappingState(
+	ctx sdk.Context,
+	sourceToken []byte,
+	state bridgetypes.ERC20TokenMappingState,
+) error {
+	if !state.IsValid() || state == bridgetypes.ERC20TokenMappingStatePendingActivation {
+		return bridgetypes.ErrInvalidERC20TokenMappingState
+	}
+
+	mapping, ok := k.GetERC20TokenMapping(ctx, sourceToken)
+	if !ok {
+		return bridgetypes.ErrNotMapping
+	}
+
+	mapping.State = state
+	mapping.ActivationHeight = 0
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) RegisterSourceChain(
+	_ sdk.Context,
+	chain bridgetypes.SourceChain,
+) error {
+	if err := chain.Validate(); err != nil {
+		return errorsmod.Wrap(bridgetypes.ErrInvalidSourceChain, err.Error())
+	}
+
+	if _, ok := k.sourceChains[chain.Id]; ok {
+		return bridgetypes.ErrSourceChainAlreadyRegistered
+	}
+
+	k.sourceChains[chain.Id] = chain
+	return nil
+}
+
+func (k *FakeBridgeKeeper) IsSourceChainRegistered(_ sdk.Context, chain uint32) bool {
+	_, ok := k.sourceChains[chain]
+	return ok
+}
+
+func (k *FakeBridgeKeeper) GetSourceChainAssetsLockedSequenceTip(_ sdk.Context, chain uint32) math.Int {
+	if tip, ok := k.sourceChainSequenceTips[chain]; ok {
+		return tip
+	}
+	return math.ZeroInt()
+}
+
+func (k *FakeBridgeKeeper) CreateSourceChainERC20TokenMapping(
+	_ sdk.Context,
+	chain uint32,
+	sourceToken, mezoToken []byte,
+) error {
+	if _, ok := k.sourceChains[chain]; !ok {
+		return bridgetypes.ErrSourceChainNotRegistered
+	}
+
+	k.sourceChainMappings[chain] = append(
+		k.sourceChainMappings[chain],
+		&bridgetypes.ERC20TokenMapping{
+			SourceToken: common.BytesToAddress(sourceToken).Hex(),
+			MezoToken:   common.BytesToAddress(mezoToken).Hex(),
+		},
+	)
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) DeleteSourceChainERC20TokenMapping(
+	_ sdk.Context,
+	chain uint32,
+	sourceToken []byte,
+) error {
+	k.sourceChainMappings[chain] = slices.DeleteFunc(
+		k.sourceChainMappings[chain],
+		func(m *bridgetypes.ERC20TokenMapping) bool {
+			return bytes.Equal(m.SourceTokenBytes(), sourceToken)
+		},
+	)
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetSourceChainERC20TokenMapping(
+	_ sdk.Context,
+	chain uint32,
+	sourceToken []byte,
+) (*bridgetypes.ERC20TokenMapping, bool) {
+	index := slices.IndexFunc(
+		k.sourceChainMappings[chain],
+		func(m *bridgetypes.ERC20TokenMapping) bool {
+			return bytes.Equal(m.SourceTokenBytes(), sourceToken)
+		},
+	)
+	if index == -1 {
+		return nil, false
+	}
+
+	return k.sourceChainMappings[chain][index], true
+}
+
 func (k *FakeBridgeKeeper) IsAllowedTripartyController(_ sdk.Context, controller []byte) bool {
 	return k.tripartyControllers[common.BytesToAddress(controller).Hex()]
 }
@@ -558,3 +1026,88 @@ func (k *FakeBridgeKeeper) GetTripartyRequestSequenceTip(_ sdk.Context) math.Int
 func (k *FakeBridgeKeeper) GetTripartyProcessedSequenceTip(_ sdk.Context) math.Int {
 	return math.ZeroInt()
 }
+
+func (k *FakeBridgeKeeper) GetTripartyBridgeRequestOutcome(
+	_ sdk.Context,
+	sequence math.Int,
+) (*bridgetypes.TripartyBridgeRequestOutcome, bool) {
+	outcome, ok := k.tripartyOutcomes[sequence.String()]
+	return outcome, ok
+}
+
+func (k *FakeBridgeKeeper) RetryTripartyCallback(
+	_ sdk.Context,
+	sequence math.Int,
+	availableGas uint64,
+) (bool, uint64, []statedb.StateChange, error) {
+	failed, ok := k.failedTripartyCallbacks[sequence.String()]
+	if !ok {
+		return false, 0, nil, bridgetypes.ErrTripartyCallbackNotFound
+	}
+
+	if failed.NextRetryHeight == 0 {
+		return false, 0, nil, bridgetypes.ErrTripartyCallbackRetriesExhausted
+	}
+
+	if availableGas < k.tripartyCallbackRetryPolicy.GasLimit {
+		return false, 0, nil, bridgetypes.ErrInsufficientTripartyCallbackRetryGas
+	}
+
+	delete(k.failedTripartyCallbacks, sequence.String())
+
+	return true, k.tripartyCallbackRetryGasUsed, nil, nil
+}
+
+func (k *FakeBridgeKeeper) GetFailedTripartyCallback(
+	_ sdk.Context,
+	sequence math.Int,
+) (*bridgetypes.FailedTripartyCallback, bool) {
+	failed, ok := k.failedTripartyCallbacks[sequence.String()]
+	return failed, ok
+}
+
+func (k *FakeBridgeKeeper) SetTripartyCallbackRetryPolicy(
+	_ sdk.Context,
+	policy bridgetypes.TripartyCallbackRetryPolicy,
+) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	k.tripartyCallbackRetryPolicy = policy
+
+	return nil
+}
+
+func (k *FakeBridgeKeeper) GetTripartyCallbackRetryPolicy(
+	_ sdk.Context,
+) bridgetypes.TripartyCallbackRetryPolicy {
+	return k.tripartyCallbackRetryPolicy
+}
+
+func (k *FakeBridgeKeeper) GetTripartyBridgeRequest(
+	_ sdk.Context,
+	sequence math.Int,
+) (*bridgetypes.TripartyBridgeRequest, bool) {
+	req, ok := k.tripartyRequests[sequence.String()]
+	return req, ok
+}
+
+func (k *FakeBridgeKeeper) CancelTripartyBridgeRequest(
+	_ sdk.Context,
+	sequence math.Int,
+	_ string,
+) error {
+	req, ok := k.tripartyRequests[sequence.String()]
+	if !ok {
+		return bridgetypes.ErrTripartyRequestNotFound
+	}
+
+	if req.Cancelled {
+		return bridgetypes.ErrTripartyRequestAlreadyCancelled
+	}
+
+	req.Cancelled = true
+
+	return nil
+}
@@ -0,0 +1,523 @@
+package assetsbridge
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+// RegisterSourceChainMethodName is the name of the registerSourceChain method.
+// It matches the name of the method in the contract ABI.
+const RegisterSourceChainMethodName = "registerSourceChain"
+
+// RegisterSourceChainMethod is the implementation of the registerSourceChain
+// method.
+type RegisterSourceChainMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newRegisterSourceChainMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *RegisterSourceChainMethod {
+	return &RegisterSourceChainMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *RegisterSourceChainMethod) MethodName() string {
+	return RegisterSourceChainMethodName
+}
+
+func (m *RegisterSourceChainMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *RegisterSourceChainMethod) RequiredGas(_ []byte) (uint64, bool) {
+	// Fallback to the default gas calculation.
+	return 0, false
+}
+
+func (m *RegisterSourceChainMethod) Payable() bool {
+	return false
+}
+
+func (m *RegisterSourceChainMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	chain, ok := inputs[0].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid chain: %v", inputs[0])
+	}
+
+	name, ok := inputs[1].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("name must be string")
+	}
+
+	sourceBTCToken, ok := inputs[2].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source BTC token must be common.Address")
+	}
+
+	err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.bridgeKeeper.RegisterSourceChain(
+		context.SdkCtx(),
+		bridgetypes.NewSourceChain(chain, name, sourceBTCToken.Bytes()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewSourceChainRegisteredEvent(chain, name, sourceBTCToken),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit SourceChainRegistered event: [%w]",
+			err,
+		)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// GetSourceChainSequenceTipMethodName is the name of the
+// getSourceChainSequenceTip method. It matches the name of the method in the
+// contract ABI.
+const GetSourceChainSequenceTipMethodName = "getSourceChainSequenceTip"
+
+// GetSourceChainSequenceTipMethod is the implementation of the
+// getSourceChainSequenceTip method.
+type GetSourceChainSequenceTipMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetSourceChainSequenceTipMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetSourceChainSequenceTipMethod {
+	return &GetSourceChainSequenceTipMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetSourceChainSequenceTipMethod) MethodName() string {
+	return GetSourceChainSequenceTipMethodName
+}
+
+func (m *GetSourceChainSequenceTipMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetSourceChainSequenceTipMethod) RequiredGas(_ []byte) (uint64, bool) {
+	// Fallback to the default gas calculation.
+	return 0, false
+}
+
+func (m *GetSourceChainSequenceTipMethod) Payable() bool {
+	return false
+}
+
+func (m *GetSourceChainSequenceTipMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	chain, err := extractSourceChainInput(context, m.bridgeKeeper, inputs, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sequenceTip := m.bridgeKeeper.GetSourceChainAssetsLockedSequenceTip(
+		context.SdkCtx(),
+		chain,
+	)
+
+	return precompile.MethodOutputs{sequenceTip.BigInt()}, nil, nil
+}
+
+// CreateSourceChainERC20TokenMappingMethodName is the name of the
+// createSourceChainERC20TokenMapping method. It matches the name of the
+// method in the contract ABI.
+//
+//nolint:gosec
+const CreateSourceChainERC20TokenMappingMethodName = "createSourceChainERC20TokenMapping"
+
+// CreateSourceChainERC20TokenMappingMethod is the implementation of the
+// createSourceChainERC20TokenMapping method.
+type CreateSourceChainERC20TokenMappingMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newCreateSourceChainERC20TokenMappingMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *CreateSourceChainERC20TokenMappingMethod {
+	return &CreateSourceChainERC20TokenMappingMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *CreateSourceChainERC20TokenMappingMethod) MethodName() string {
+	return CreateSourceChainERC20TokenMappingMethodName
+}
+
+func (m *CreateSourceChainERC20TokenMappingMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *CreateSourceChainERC20TokenMappingMethod) RequiredGas(_ []byte) (uint64, bool) {
+	// Fallback to the default gas calculation.
+	return 0, false
+}
+
+func (m *CreateSourceChainERC20TokenMappingMethod) Payable() bool {
+	return false
+}
+
+func (m *CreateSourceChainERC20TokenMappingMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	chain, ok := inputs[0].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid chain: %v", inputs[0])
+	}
+
+	sourceToken, ok := inputs[1].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source token must be common.Address")
+	}
+
+	mezoToken, ok := inputs[2].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("mezo token must be common.Address")
+	}
+
+	err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.bridgeKeeper.CreateSourceChainERC20TokenMapping(
+		context.SdkCtx(),
+		chain,
+		sourceToken.Bytes(),
+		mezoToken.Bytes(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewSourceChainERC20TokenMappingCreatedEvent(chain, sourceToken, mezoToken),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit SourceChainERC20TokenMappingCreated event: [%w]",
+			err,
+		)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// DeleteSourceChainERC20TokenMappingMethodName is the name of the
+// deleteSourceChainERC20TokenMapping method. It matches the name of the
+// method in the contract ABI.
+//
+//nolint:gosec
+const DeleteSourceChainERC20TokenMappingMethodName = "deleteSourceChainERC20TokenMapping"
+
+// DeleteSourceChainERC20TokenMappingMethod is the implementation of the
+// deleteSourceChainERC20TokenMapping method.
+type DeleteSourceChainERC20TokenMappingMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newDeleteSourceChainERC20TokenMappingMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *DeleteSourceChainERC20TokenMappingMethod {
+	return &DeleteSourceChainERC20TokenMappingMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *DeleteSourceChainERC20TokenMappingMethod) MethodName() string {
+	return DeleteSourceChainERC20TokenMappingMethodName
+}
+
+func (m *DeleteSourceChainERC20TokenMappingMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *DeleteSourceChainERC20TokenMappingMethod) RequiredGas(_ []byte) (uint64, bool) {
+	// Fallback to the default gas calculation.
+	return 0, false
+}
+
+func (m *DeleteSourceChainERC20TokenMappingMethod) Payable() bool {
+	return false
+}
+
+func (m *DeleteSourceChainERC20TokenMappingMethod) Run(
+	context *precompile.RunContext,
+	inputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, 2); err != nil {
+		return nil, nil, err
+	}
+
+	chain, ok := inputs[0].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid chain: %v", inputs[0])
+	}
+
+	sourceToken, ok := inputs[1].(common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf("source token must be common.Address")
+	}
+
+	err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapping, ok := m.bridgeKeeper.GetSourceChainERC20TokenMapping(
+		context.SdkCtx(),
+		chain,
+		sourceToken.Bytes(),
+	)
+	if !ok {
+		return nil, nil, bridgetypes.ErrNotMapping
+	}
+
+	err = m.bridgeKeeper.DeleteSourceChainERC20TokenMapping(
+		context.SdkCtx(),
+		chain,
+		sourceToken.Bytes(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewSourceChainERC20TokenMappingDeletedEvent(
+			chain,
+			sourceToken,
+			common.BytesToAddress(mapping.MezoTokenBytes()),
+		),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"failed to emit SourceChainERC20TokenMappingDeleted event: [%w]",
+			err,
+		)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// extractSourceChainInput extracts the source chain from the first of the
+// expected number of inputs and checks the chain is registered.
+func extractSourceChainInput(
+	context *precompile.RunContext,
+	bridgeKeeper BridgeKeeper,
+	inputs precompile.MethodInputs,
+	count int,
+) (uint32, error) {
+	if err := precompile.ValidateMethodInputsCount(inputs, count); err != nil {
+		return 0, err
+	}
+
+	chain, ok := inputs[0].(uint32)
+	if !ok {
+		return 0, fmt.Errorf("invalid chain: %v", inputs[0])
+	}
+
+	if !bridgeKeeper.IsSourceChainRegistered(context.SdkCtx(), chain) {
+		return 0, fmt.Errorf("source chain %d is not registered", chain)
+	}
+
+	return chain, nil
+}
+
+// SourceChainRegisteredEventName is the name of the SourceChainRegistered
+// event. It matches the name of the event in the contract ABI.
+const SourceChainRegisteredEventName = "SourceChainRegistered"
+
+// SourceChainRegisteredEvent is the implementation of the
+// SourceChainRegistered event that contains the following arguments:
+// - chain (indexed): the identifier of the registered source chain,
+// - name (non-indexed): the human-readable name of the source chain,
+// - sourceBTCToken (non-indexed): the address of the BTC token on the
+// source chain.
+type SourceChainRegisteredEvent struct {
+	chain          uint32
+	name           string
+	sourceBTCToken common.Address
+}
+
+func NewSourceChainRegisteredEvent(
+	chain uint32,
+	name string,
+	sourceBTCToken common.Address,
+) *SourceChainRegisteredEvent {
+	return &SourceChainRegisteredEvent{
+		chain:          chain,
+		name:           name,
+		sourceBTCToken: sourceBTCToken,
+	}
+}
+
+func (e *SourceChainRegisteredEvent) EventName() string {
+	return SourceChainRegisteredEventName
+}
+
+func (e *SourceChainRegisteredEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.chain,
+		},
+		{
+			Indexed: false,
+			Value:   e.name,
+		},
+		{
+			Indexed: false,
+			Value:   e.sourceBTCToken,
+		},
+	}
+}
+
+// SourceChainERC20TokenMappingCreatedEventName is the name of the
+// SourceChainERC20TokenMappingCreated event. It matches the name of the event
+// in the contract ABI.
+//
+//nolint:gosec
+const SourceChainERC20TokenMappingCreatedEventName = "SourceChainERC20TokenMappingCreated"
+
+// SourceChainERC20TokenMappingCreatedEvent is the implementation of the
+// SourceChainERC20TokenMappingCreated event that contains the following
+// arguments:
+// - chain (indexed): the identifier of the source chain,
+// - sourceToken (indexed): the address of the ERC20 token on the source chain,
+// - mezoToken (indexed): the address of the ERC20 token on the Mezo chain.
+type SourceChainERC20TokenMappingCreatedEvent struct {
+	chain                  uint32
+	sourceToken, mezoToken common.Address
+}
+
+func NewSourceChainERC20TokenMappingCreatedEvent(
+	chain uint32,
+	sourceToken, mezoToken common.Address,
+) *SourceChainERC20TokenMappingCreatedEvent {
+	return &SourceChainERC20TokenMappingCreatedEvent{
+		chain:       chain,
+		sourceToken: sourceToken,
+		mezoToken:   mezoToken,
+	}
+}
+
+func (e *SourceChainERC20TokenMappingCreatedEvent) EventName() string {
+	return SourceChainERC20TokenMappingCreatedEventName
+}
+
+func (e *SourceChainERC20TokenMappingCreatedEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.chain,
+		},
+		{
+			Indexed: true,
+			Value:   e.sourceToken,
+		},
+		{
+			Indexed: true,
+			Value:   e.mezoToken,
+		},
+	}
+}
+
+// SourceChainERC20TokenMappingDeletedEventName is the name of the
+// SourceChainERC20TokenMappingDeleted event. It matches the name of the event
+// in the contract ABI.
+//
+//nolint:gosec
+const SourceChainERC20TokenMappingDeletedEventName = "SourceChainERC20TokenMappingDeleted"
+
+// SourceChainERC20TokenMappingDeletedEvent is the implementation of the
+// SourceChainERC20TokenMappingDeleted event that contains the following
+// arguments:
+// - chain (indexed): the identifier of the source chain,
+// - sourceToken (indexed): the address of the ERC20 token on the source chain,
+// - mezoToken (indexed): the address of the ERC20 token on the Mezo chain.
+type SourceChainERC20TokenMappingDeletedEvent struct {
+	chain                  uint32
+	sourceToken, mezoToken common.Address
+}
+
+func NewSourceChainERC20TokenMappingDeletedEvent(
+	chain uint32,
+	sourceToken, mezoToken common.Address,
+) *SourceChainERC20TokenMappingDeletedEvent {
+	return &SourceChainERC20TokenMappingDeletedEvent{
+		chain:       chain,
+		sourceToken: sourceToken,
+		mezoToken:   mezoToken,
+	}
+}
+
+func (e *SourceChainERC20TokenMappingDeletedEvent) EventName() string {
+	return SourceChainERC20TokenMappingDeletedEventName
+}
+
+func (e *SourceChainERC20TokenMappingDeletedEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{
+			Indexed: true,
+			Value:   e.chain,
+		},
+		{
+			Indexed: true,
+			Value:   e.sourceToken,
+		},
+		{
+			Indexed: true,
+			Value:   e.mezoToken,
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type SourceChainTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestSourceChainTestSuite(t *testing.T) {
+	suite.Run(t, new(SourceChainTestSuite))
+}
+
+var (
+	testSourceChainBTCToken = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testSourceChainToken    = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testSourceChainMezo     = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+func (s *SourceChainTestSuite) registerSourceChain(chain uint32) {
+	s.Require().NoError(s.bridgeKeeper.RegisterSourceChain(
+		s.ctx,
+		bridgetypes.NewSourceChain(chain, "Arbitrum", testSourceChainBTCToken.Bytes()),
+	))
+}
+
+func (s *SourceChainTestSuite) TestRegisterSourceChainMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner registers source chain",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), "Arbitrum", testSourceChainBTCToken}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.bridgeKeeper.IsSourceChainRegistered(s.ctx, 1))
+			},
+		},
+		{
+			name: "failure - primary source chain",
+			run: func() []interface{} {
+				return []interface{}{uint32(0), "Ethereum", testSourceChainBTCToken}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "reserved for Ethereum",
+		},
+		{
+			name: "failure - already registered",
+			run: func() []interface{} {
+				s.registerSourceChain(1)
+				return []interface{}{uint32(1), "Arbitrum", testSourceChainBTCToken}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "source chain is already registered",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), "Arbitrum", testSourceChainBTCToken}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - wrong number of inputs",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), "Arbitrum"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.RegisterSourceChainMethodName)
+}
+
+func (s *SourceChainTestSuite) TestGetSourceChainSequenceTipMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns the sequence tip",
+			run: func() []interface{} {
+				s.registerSourceChain(1)
+				s.bridgeKeeper.sourceChainSequenceTips[1] = math.NewInt(42)
+				return []interface{}{uint32(1)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{big.NewInt(42)},
+		},
+		{
+			name: "failure - source chain not registered",
+			run: func() []interface{} {
+				return []interface{}{uint32(2)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "source chain 2 is not registered",
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetSourceChainSequenceTipMethodName)
+}
+
+func (s *SourceChainTestSuite) TestCreateSourceChainERC20TokenMappingMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner creates mapping",
+			run: func() []interface{} {
+				s.registerSourceChain(1)
+				return []interface{}{uint32(1), testSourceChainToken, testSourceChainMezo}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				mapping, ok := s.bridgeKeeper.GetSourceChainERC20TokenMapping(
+					s.ctx,
+					1,
+					testSourceChainToken.Bytes(),
+				)
+				s.Require().True(ok)
+				s.Require().Equal(testSourceChainMezo.Hex(), mapping.MezoToken)
+			},
+		},
+		{
+			name: "failure - source chain not registered",
+			run: func() []interface{} {
+				return []interface{}{uint32(2), testSourceChainToken, testSourceChainMezo}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "source chain is not registered",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), testSourceChainToken, testSourceChainMezo}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid chain type",
+			run: func() []interface{} {
+				return []interface{}{"invalid chain", testSourceChainToken, testSourceChainMezo}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.CreateSourceChainERC20TokenMappingMethodName)
+}
+
+func (s *SourceChainTestSuite) TestDeleteSourceChainERC20TokenMappingMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - owner deletes mapping",
+			run: func() []interface{} {
+				s.registerSourceChain(1)
+				s.Require().NoError(s.bridgeKeeper.CreateSourceChainERC20TokenMapping(
+					s.ctx,
+					1,
+					testSourceChainToken.Bytes(),
+					testSourceChainMezo.Bytes(),
+				))
+				return []interface{}{uint32(1), testSourceChainToken}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				_, ok := s.bridgeKeeper.GetSourceChainERC20TokenMapping(
+					s.ctx,
+					1,
+					testSourceChainToken.Bytes(),
+				)
+				s.Require().False(ok)
+			},
+		},
+		{
+			name: "failure - mapping does not exist",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), testSourceChainToken}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "mapping does not exist",
+		},
+		{
+			name: "failure - not owner",
+			run: func() []interface{} {
+				return []interface{}{uint32(1), testSourceChainToken}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.DeleteSourceChainERC20TokenMappingMethodName)
+}
@@ -0,0 +1,338 @@
+package assetsbridge
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const (
+	RetryTripartyCallbackMethodName          = "retryTripartyCallback"
+	GetFailedTripartyCallbackMethodName      = "getFailedTripartyCallback"
+	SetTripartyCallbackRetryPolicyMethodName = "setTripartyCallbackRetryPolicy"
+	GetTripartyCallbackRetryPolicyMethodName = "getTripartyCallbackRetryPolicy"
+)
+
+// TripartyCallbackRetriedEventName is the name of the event emitted when
+// a failed triparty callback is retried with retryTripartyCallback.
+const TripartyCallbackRetriedEventName = "TripartyCallbackRetried"
+
+// --- retryTripartyCallback ---
+
+// RetryTripartyCallbackMethod is the implementation of the
+// retryTripartyCallback method that retries the failed callback of
+// a processed triparty bridge request. The method is permissionless so
+// controllers can re-trigger the delivery of their callbacks themselves.
+// The caller pays for the gas used by the callback, on top of the required
+// gas of the method, and must have enough gas left to cover the gas limit
+// of the retry policy before the callback is issued.
+type RetryTripartyCallbackMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newRetryTripartyCallbackMethod(
+	bridgeKeeper BridgeKeeper,
+) *RetryTripartyCallbackMethod {
+	return &RetryTripartyCallbackMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *RetryTripartyCallbackMethod) MethodName() string {
+	return RetryTripartyCallbackMethodName
+}
+
+func (m *RetryTripartyCallbackMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *RetryTripartyCallbackMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *RetryTripartyCallbackMethod) Payable() bool {
+	return false
+}
+
+// Run retries the failed callback and returns whether it was delivered.
+// A callback that fails again stays queued for retry, so the method
+// succeeds in that case as well. The call fails without issuing the callback
+// if the gas left does not cover the gas limit of the retry policy. The gas
+// used by the callback is then charged to the caller.
+func (m *RetryTripartyCallbackMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	sequence, ok := rawInputs[0].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid sequence: %v", rawInputs[0])
+	}
+
+	sdkSequence, err := precompile.TypesConverter.BigInt.ToSDK(sequence)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert sequence: [%w]", err)
+	}
+
+	delivered, gasUsed, changes, err := m.bridgeKeeper.RetryTripartyCallback(
+		context.SdkCtx(),
+		sdkSequence,
+		context.GasLeft(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The callback runs in a nested EVM call that is not metered by the
+	// outer call so its gas is charged explicitly.
+	if !context.UseGas(gasUsed) {
+		return nil, nil, vm.ErrOutOfGas
+	}
+
+	err = context.EventEmitter().Emit(
+		NewTripartyCallbackRetriedEvent(sequence, delivered),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to emit TripartyCallbackRetried event: [%w]", err)
+	}
+
+	return precompile.MethodOutputs{delivered}, changes, nil
+}
+
+// TripartyCallbackRetriedEvent is emitted when a failed triparty callback is
+// retried with retryTripartyCallback.
+type TripartyCallbackRetriedEvent struct {
+	sequence  *big.Int
+	delivered bool
+}
+
+func NewTripartyCallbackRetriedEvent(
+	sequence *big.Int,
+	delivered bool,
+) *TripartyCallbackRetriedEvent {
+	return &TripartyCallbackRetriedEvent{
+		sequence:  sequence,
+		delivered: delivered,
+	}
+}
+
+func (e *TripartyCallbackRetriedEvent) EventName() string {
+	return TripartyCallbackRetriedEventName
+}
+
+func (e *TripartyCallbackRetriedEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{Indexed: true, Value: e.sequence},
+		{Indexed: false, Value: e.delivered},
+	}
+}
+
+// --- getFailedTripartyCallback ---
+
+// GetFailedTripartyCallbackMethod is the implementation of the
+// getFailedTripartyCallback method that returns the retry state of the
+// failed callback of a processed triparty bridge request.
+type GetFailedTripartyCallbackMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetFailedTripartyCallbackMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetFailedTripartyCallbackMethod {
+	return &GetFailedTripartyCallbackMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetFailedTripartyCallbackMethod) MethodName() string {
+	return GetFailedTripartyCallbackMethodName
+}
+
+func (m *GetFailedTripartyCallbackMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetFailedTripartyCallbackMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetFailedTripartyCallbackMethod) Payable() bool {
+	return false
+}
+
+// Run returns whether the callback is queued for retry, the number of failed
+// delivery attempts, the height of the last attempt, and the height of the
+// next automatic retry (zero if automatic retries are exhausted). A callback
+// that is not queued has zero values.
+func (m *GetFailedTripartyCallbackMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	sequence, ok := rawInputs[0].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid sequence: %v", rawInputs[0])
+	}
+
+	sdkSequence, err := precompile.TypesConverter.BigInt.ToSDK(sequence)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert sequence: [%w]", err)
+	}
+
+	failed, found := m.bridgeKeeper.GetFailedTripartyCallback(
+		context.SdkCtx(),
+		sdkSequence,
+	)
+	if !found {
+		return precompile.MethodOutputs{false, uint32(0), uint64(0), uint64(0)}, nil, nil
+	}
+
+	return precompile.MethodOutputs{
+		true,
+		failed.Attempts,
+		uint64(failed.LastAttemptHeight), //nolint:gosec
+		uint64(failed.NextRetryHeight),   //nolint:gosec
+	}, nil, nil
+}
+
+// --- setTripartyCallbackRetryPolicy ---
+
+// SetTripartyCallbackRetryPolicyMethod is the implementation of the
+// setTripartyCallbackRetryPolicy method that sets the retry policy of failed
+// triparty callbacks. Only the owner can call it.
+type SetTripartyCallbackRetryPolicyMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newSetTripartyCallbackRetryPolicyMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *SetTripartyCallbackRetryPolicyMethod {
+	return &SetTripartyCallbackRetryPolicyMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *SetTripartyCallbackRetryPolicyMethod) MethodName() string {
+	return SetTripartyCallbackRetryPolicyMethodName
+}
+
+func (m *SetTripartyCallbackRetryPolicyMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *SetTripartyCallbackRetryPolicyMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *SetTripartyCallbackRetryPolicyMethod) Payable() bool {
+	return false
+}
+
+func (m *SetTripartyCallbackRetryPolicyMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 3); err != nil {
+		return nil, nil, err
+	}
+
+	maxAttempts, ok := rawInputs[0].(uint32)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid max attempts: %v", rawInputs[0])
+	}
+
+	intervalBlocks, ok := rawInputs[1].(uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid interval blocks: %v", rawInputs[1])
+	}
+
+	gasLimit, ok := rawInputs[2].(uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid gas limit: %v", rawInputs[2])
+	}
+
+	err := m.poaKeeper.CheckOwner(
+		context.SdkCtx(),
+		precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.bridgeKeeper.SetTripartyCallbackRetryPolicy(
+		context.SdkCtx(),
+		bridgetypes.TripartyCallbackRetryPolicy{
+			MaxAttempts:    maxAttempts,
+			IntervalBlocks: intervalBlocks,
+			GasLimit:       gasLimit,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// --- getTripartyCallbackRetryPolicy ---
+
+// GetTripartyCallbackRetryPolicyMethod is the implementation of the
+// getTripartyCallbackRetryPolicy method that returns the retry policy of
+// failed triparty callbacks.
+type GetTripartyCallbackRetryPolicyMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetTripartyCallbackRetryPolicyMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetTripartyCallbackRetryPolicyMethod {
+	return &GetTripartyCallbackRetryPolicyMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetTripartyCallbackRetryPolicyMethod) MethodName() string {
+	return GetTripartyCallbackRetryPolicyMethodName
+}
+
+func (m *GetTripartyCallbackRetryPolicyMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetTripartyCallbackRetryPolicyMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetTripartyCallbackRetryPolicyMethod) Payable() bool {
+	return false
+}
+
+func (m *GetTripartyCallbackRetryPolicyMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	policy := m.bridgeKeeper.GetTripartyCallbackRetryPolicy(context.SdkCtx())
+
+	return precompile.MethodOutputs{
+		policy.MaxAttempts,
+		policy.IntervalBlocks,
+		policy.GasLimit,
+	}, nil, nil
+}
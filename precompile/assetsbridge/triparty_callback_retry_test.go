@@ -0,0 +1,217 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type TripartyCallbackRetryTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestTripartyCallbackRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(TripartyCallbackRetryTestSuite))
+}
+
+func (s *TripartyCallbackRetryTestSuite) failedTripartyCallback(
+	sequence int64,
+) *bridgetypes.FailedTripartyCallback {
+	return &bridgetypes.FailedTripartyCallback{
+		Request: bridgetypes.TripartyBridgeRequest{
+			Sequence:    math.NewInt(sequence),
+			BlockHeight: 1,
+			Recipient:   s.account2.EvmAddr.Hex(),
+			Amount:      math.NewInt(100),
+			Controller:  s.account1.EvmAddr.Hex(),
+		},
+		Attempts:          2,
+		LastAttemptHeight: 10,
+		NextRetryHeight:   110,
+	}
+}
+
+func (s *TripartyCallbackRetryTestSuite) TestRetryTripartyCallback() {
+	testCases := []TestCase{
+		{
+			name: "failure - callback is not queued",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(1)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: bridgetypes.ErrTripartyCallbackNotFound.Error(),
+		},
+		{
+			name: "failure - invalid sequence type",
+			run: func() []interface{} {
+				return []interface{}{"invalid"}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: false,
+		},
+		{
+			name: "failure - retries are exhausted",
+			run: func() []interface{} {
+				failed := s.failedTripartyCallback(2)
+				failed.NextRetryHeight = 0
+				s.bridgeKeeper.failedTripartyCallbacks["2"] = failed
+				return []interface{}{big.NewInt(2)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: bridgetypes.ErrTripartyCallbackRetriesExhausted.Error(),
+		},
+		{
+			name: "failure - gas left is below the policy gas limit",
+			run: func() []interface{} {
+				s.bridgeKeeper.failedTripartyCallbacks["2"] = s.failedTripartyCallback(2)
+				return []interface{}{big.NewInt(2)}
+			},
+			as:          s.account2.EvmAddr,
+			gas:         bridgetypes.DefaultTripartyCallbackRetryPolicy().GasLimit - 1,
+			basicPass:   true,
+			revert:      true,
+			errContains: bridgetypes.ErrInsufficientTripartyCallbackRetryGas.Error(),
+		},
+		{
+			name: "failure - caller cannot pay for the callback gas",
+			run: func() []interface{} {
+				s.bridgeKeeper.failedTripartyCallbacks["2"] = s.failedTripartyCallback(2)
+				s.bridgeKeeper.tripartyCallbackRetryGasUsed =
+					bridgetypes.DefaultTripartyCallbackRetryPolicy().GasLimit + 1
+				return []interface{}{big.NewInt(2)}
+			},
+			as:          s.account2.EvmAddr,
+			gas:         bridgetypes.DefaultTripartyCallbackRetryPolicy().GasLimit,
+			basicPass:   true,
+			revert:      true,
+			errContains: "out of gas",
+		},
+		{
+			name: "success - anyone can retry a queued callback",
+			run: func() []interface{} {
+				s.bridgeKeeper.failedTripartyCallbacks["2"] = s.failedTripartyCallback(2)
+				s.bridgeKeeper.tripartyCallbackRetryGasUsed = 0
+				return []interface{}{big.NewInt(2)}
+			},
+			as:        s.account2.EvmAddr,
+			gas:       bridgetypes.DefaultTripartyCallbackRetryPolicy().GasLimit,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().NotContains(s.bridgeKeeper.failedTripartyCallbacks, "2")
+			},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.RetryTripartyCallbackMethodName)
+}
+
+func (s *TripartyCallbackRetryTestSuite) TestGetFailedTripartyCallback() {
+	testCases := []TestCase{
+		{
+			name: "success - returns zero values for a callback that is not queued",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(1)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{false, uint32(0), uint64(0), uint64(0)},
+		},
+		{
+			name: "success - returns the retry state of a queued callback",
+			run: func() []interface{} {
+				s.bridgeKeeper.failedTripartyCallbacks["2"] = s.failedTripartyCallback(2)
+				return []interface{}{big.NewInt(2)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true, uint32(2), uint64(10), uint64(110)},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetFailedTripartyCallbackMethodName)
+}
+
+func (s *TripartyCallbackRetryTestSuite) TestSetTripartyCallbackRetryPolicy() {
+	testCases := []TestCase{
+		{
+			name: "failure - caller is not owner",
+			run: func() []interface{} {
+				return []interface{}{uint32(5), uint64(10), uint64(2_000_000)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "sender is not owner",
+		},
+		{
+			name: "failure - invalid policy",
+			run: func() []interface{} {
+				return []interface{}{uint32(5), uint64(0), uint64(2_000_000)}
+			},
+			as:          s.account1.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "interval blocks must be positive",
+		},
+		{
+			name: "failure - invalid max attempts type",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(5), uint64(10), uint64(2_000_000)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+		{
+			name: "success - sets the policy",
+			run: func() []interface{} {
+				return []interface{}{uint32(5), uint64(10), uint64(2_000_000)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().Equal(
+					bridgetypes.TripartyCallbackRetryPolicy{
+						MaxAttempts:    5,
+						IntervalBlocks: 10,
+						GasLimit:       2_000_000,
+					},
+					s.bridgeKeeper.tripartyCallbackRetryPolicy,
+				)
+			},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.SetTripartyCallbackRetryPolicyMethodName)
+}
+
+func (s *TripartyCallbackRetryTestSuite) TestGetTripartyCallbackRetryPolicy() {
+	defaultPolicy := bridgetypes.DefaultTripartyCallbackRetryPolicy()
+
+	testCases := []TestCase{
+		{
+			name: "success - returns the default policy",
+			run: func() []interface{} {
+				return []interface{}{}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				defaultPolicy.MaxAttempts,
+				defaultPolicy.IntervalBlocks,
+				defaultPolicy.GasLimit,
+			},
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetTripartyCallbackRetryPolicyMethodName)
+}
@@ -0,0 +1,134 @@
+package assetsbridge
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const CancelTripartyRequestMethodName = "cancelTripartyRequest"
+
+// TripartyRequestCancelledEventName is the name of the event emitted when
+// a pending triparty bridge request is cancelled.
+const TripartyRequestCancelledEventName = "TripartyRequestCancelled"
+
+// CancelTripartyRequestMethod is the implementation of the
+// cancelTripartyRequest method that cancels a pending triparty bridge request
+// before it matures. Only the controller that submitted the request, the PoA
+// owner and the emergency team can call it.
+type CancelTripartyRequestMethod struct {
+	poaKeeper    PoaKeeper
+	bridgeKeeper BridgeKeeper
+}
+
+func newCancelTripartyRequestMethod(
+	poaKeeper PoaKeeper,
+	bridgeKeeper BridgeKeeper,
+) *CancelTripartyRequestMethod {
+	return &CancelTripartyRequestMethod{
+		poaKeeper:    poaKeeper,
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *CancelTripartyRequestMethod) MethodName() string {
+	return CancelTripartyRequestMethodName
+}
+
+func (m *CancelTripartyRequestMethod) MethodType() precompile.MethodType {
+	return precompile.Write
+}
+
+func (m *CancelTripartyRequestMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *CancelTripartyRequestMethod) Payable() bool {
+	return false
+}
+
+func (m *CancelTripartyRequestMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	sequence, ok := rawInputs[0].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid sequence: %v", rawInputs[0])
+	}
+
+	sdkSequence, err := precompile.TypesConverter.BigInt.ToSDK(sequence)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert sequence: [%w]", err)
+	}
+
+	req, found := m.bridgeKeeper.GetTripartyBridgeRequest(context.SdkCtx(), sdkSequence)
+	if !found {
+		return nil, nil, bridgetypes.ErrTripartyRequestNotFound
+	}
+
+	// The controller that submitted the request can always cancel it.
+	// Anyone else must be the PoA owner or a member of the emergency team.
+	if context.MsgSender() != common.HexToAddress(req.Controller) {
+		err := m.poaKeeper.CheckOwnerOrEmergencyTeam(
+			context.SdkCtx(),
+			precompile.TypesConverter.Address.ToSDK(context.MsgSender()),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = m.bridgeKeeper.CancelTripartyBridgeRequest(
+		context.SdkCtx(),
+		sdkSequence,
+		context.MsgSender().Hex(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = context.EventEmitter().Emit(
+		NewTripartyRequestCancelledEvent(sequence, context.MsgSender()),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to emit TripartyRequestCancelled event: [%w]", err)
+	}
+
+	return precompile.MethodOutputs{true}, nil, nil
+}
+
+// TripartyRequestCancelledEvent is emitted when a pending triparty bridge
+// request is cancelled.
+type TripartyRequestCancelledEvent struct {
+	sequence    *big.Int
+	cancelledBy common.Address
+}
+
+func NewTripartyRequestCancelledEvent(
+	sequence *big.Int,
+	cancelledBy common.Address,
+) *TripartyRequestCancelledEvent {
+	return &TripartyRequestCancelledEvent{
+		sequence:    sequence,
+		cancelledBy: cancelledBy,
+	}
+}
+
+func (e *TripartyRequestCancelledEvent) EventName() string {
+	return TripartyRequestCancelledEventName
+}
+
+func (e *TripartyRequestCancelledEvent) Arguments() []*precompile.EventArgument {
+	return []*precompile.EventArgument{
+		{Indexed: true, Value: e.sequence},
+		{Indexed: true, Value: e.cancelledBy},
+	}
+}
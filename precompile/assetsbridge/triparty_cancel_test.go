@@ -0,0 +1,108 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type TripartyCancelTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestTripartyCancelTestSuite(t *testing.T) {
+	suite.Run(t, new(TripartyCancelTestSuite))
+}
+
+func (s *TripartyCancelTestSuite) pendingTripartyRequest(
+	sequence int64,
+) *bridgetypes.TripartyBridgeRequest {
+	return &bridgetypes.TripartyBridgeRequest{
+		Sequence:    math.NewInt(sequence),
+		BlockHeight: 1,
+		Recipient:   s.account1.EvmAddr.Hex(),
+		Amount:      math.NewInt(100),
+		Controller:  s.account2.EvmAddr.Hex(),
+	}
+}
+
+func (s *TripartyCancelTestSuite) TestCancelTripartyRequest() {
+	testCases := []TestCase{
+		{
+			name: "failure - request not found",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(1)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: bridgetypes.ErrTripartyRequestNotFound.Error(),
+		},
+		{
+			name: "failure - invalid sequence type",
+			run: func() []interface{} {
+				return []interface{}{"invalid"}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: false,
+		},
+		{
+			name: "failure - caller is not the controller nor owner",
+			run: func() []interface{} {
+				req := s.pendingTripartyRequest(2)
+				req.Controller = s.account1.EvmAddr.Hex()
+				s.bridgeKeeper.tripartyRequests["2"] = req
+				return []interface{}{big.NewInt(2)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: "emergency team address is empty",
+		},
+		{
+			name: "success - controller cancels the request",
+			run: func() []interface{} {
+				s.bridgeKeeper.tripartyRequests["3"] = s.pendingTripartyRequest(3)
+				return []interface{}{big.NewInt(3)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.bridgeKeeper.tripartyRequests["3"].Cancelled)
+			},
+		},
+		{
+			name: "success - owner cancels the request",
+			run: func() []interface{} {
+				s.bridgeKeeper.tripartyRequests["4"] = s.pendingTripartyRequest(4)
+				return []interface{}{big.NewInt(4)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output:    []interface{}{true},
+			postCheck: func() {
+				s.Require().True(s.bridgeKeeper.tripartyRequests["4"].Cancelled)
+			},
+		},
+		{
+			name: "failure - request already cancelled",
+			run: func() []interface{} {
+				req := s.pendingTripartyRequest(5)
+				req.Cancelled = true
+				s.bridgeKeeper.tripartyRequests["5"] = req
+				return []interface{}{big.NewInt(5)}
+			},
+			as:          s.account2.EvmAddr,
+			basicPass:   true,
+			revert:      true,
+			errContains: bridgetypes.ErrTripartyRequestAlreadyCancelled.Error(),
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.CancelTripartyRequestMethodName)
+}
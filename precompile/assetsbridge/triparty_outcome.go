@@ -0,0 +1,85 @@
+package assetsbridge
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/mezo-org/mezod/precompile"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+const GetTripartyRequestOutcomeMethodName = "getTripartyRequestOutcome"
+
+// GetTripartyRequestOutcomeMethod is the implementation of the
+// getTripartyRequestOutcome method that returns the outcome record of a
+// processed triparty bridge request.
+type GetTripartyRequestOutcomeMethod struct {
+	bridgeKeeper BridgeKeeper
+}
+
+func newGetTripartyRequestOutcomeMethod(
+	bridgeKeeper BridgeKeeper,
+) *GetTripartyRequestOutcomeMethod {
+	return &GetTripartyRequestOutcomeMethod{
+		bridgeKeeper: bridgeKeeper,
+	}
+}
+
+func (m *GetTripartyRequestOutcomeMethod) MethodName() string {
+	return GetTripartyRequestOutcomeMethodName
+}
+
+func (m *GetTripartyRequestOutcomeMethod) MethodType() precompile.MethodType {
+	return precompile.Read
+}
+
+func (m *GetTripartyRequestOutcomeMethod) RequiredGas(_ []byte) (uint64, bool) {
+	return 0, false
+}
+
+func (m *GetTripartyRequestOutcomeMethod) Payable() bool {
+	return false
+}
+
+// Run returns the status, reason, processing height and callback gas used of
+// the request. A request that is still pending, does not exist, or whose
+// outcome record was pruned has the unspecified status and zero values.
+func (m *GetTripartyRequestOutcomeMethod) Run(
+	context *precompile.RunContext,
+	rawInputs precompile.MethodInputs,
+) (precompile.MethodOutputs, []statedb.StateChange, error) {
+	if err := precompile.ValidateMethodInputsCount(rawInputs, 1); err != nil {
+		return nil, nil, err
+	}
+
+	sequence, ok := rawInputs[0].(*big.Int)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid sequence: %v", rawInputs[0])
+	}
+
+	sdkSequence, err := precompile.TypesConverter.BigInt.ToSDK(sequence)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert sequence: [%w]", err)
+	}
+
+	outcome, found := m.bridgeKeeper.GetTripartyBridgeRequestOutcome(
+		context.SdkCtx(),
+		sdkSequence,
+	)
+	if !found {
+		return precompile.MethodOutputs{
+			uint8(bridgetypes.TripartyBridgeRequestStatusUnspecified),
+			"",
+			uint64(0),
+			uint64(0),
+		}, nil, nil
+	}
+
+	return precompile.MethodOutputs{
+		uint8(outcome.Status), //nolint:gosec
+		outcome.Reason,
+		uint64(outcome.ProcessingHeight), //nolint:gosec
+		outcome.GasUsed,
+	}, nil, nil
+}
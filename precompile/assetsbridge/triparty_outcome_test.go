@@ -0,0 +1,70 @@
+package assetsbridge_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type TripartyOutcomeTestSuite struct {
+	PrecompileTestSuite
+}
+
+func TestTripartyOutcomeTestSuite(t *testing.T) {
+	suite.Run(t, new(TripartyOutcomeTestSuite))
+}
+
+func (s *TripartyOutcomeTestSuite) TestGetTripartyRequestOutcomeMethod() {
+	testCases := []TestCase{
+		{
+			name: "success - returns unspecified status for unknown request",
+			run: func() []interface{} {
+				return []interface{}{big.NewInt(1)}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				uint8(bridgetypes.TripartyBridgeRequestStatusUnspecified),
+				"",
+				uint64(0),
+				uint64(0),
+			},
+		},
+		{
+			name: "success - returns recorded outcome",
+			run: func() []interface{} {
+				s.bridgeKeeper.tripartyOutcomes["2"] = &bridgetypes.TripartyBridgeRequestOutcome{
+					Sequence:         math.NewInt(2),
+					Controller:       s.account1.EvmAddr.Hex(),
+					Status:           bridgetypes.TripartyBridgeRequestStatusCallbackFailed,
+					Reason:           "execution reverted",
+					ProcessingHeight: 10,
+					GasUsed:          21000,
+				}
+				return []interface{}{big.NewInt(2)}
+			},
+			as:        s.account2.EvmAddr,
+			basicPass: true,
+			output: []interface{}{
+				uint8(bridgetypes.TripartyBridgeRequestStatusCallbackFailed),
+				"execution reverted",
+				uint64(10),
+				uint64(21000),
+			},
+		},
+		{
+			name: "failure - invalid sequence type",
+			run: func() []interface{} {
+				return []interface{}{"invalid"}
+			},
+			as:        s.account1.EvmAddr,
+			basicPass: false,
+		},
+	}
+
+	s.RunMethodTestCases(testCases, assetsbridge.GetTripartyRequestOutcomeMethodName)
+}
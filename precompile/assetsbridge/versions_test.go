@@ -2,11 +2,15 @@ package assetsbridge_test
 
 import (
 	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/mezo-org/mezod/precompile"
 	"github.com/mezo-org/mezod/precompile/assetsbridge"
+	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
 	"github.com/mezo-org/mezod/x/evm/statedb"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
@@ -56,8 +60,8 @@ func (s *PrecompileTestSuite) TestRetiredPauseMethodsVersions() {
 	s.Require().NoError(err)
 
 	// Version 6 retires the pause methods in favor of the maintenance
-	// precompile.
-	s.Require().Equal(6, evmtypes.AssetsBridgePrecompileLatestVersion)
+	// precompile. Version 7 adds the outflow policy methods on top.
+	s.Require().Equal(7, evmtypes.AssetsBridgePrecompileLatestVersion)
 	s.Require().Equal(
 		evmtypes.AssetsBridgePrecompileLatestVersion,
 		versionMap.GetLatestVersion(),
@@ -171,3 +175,372 @@ func (s *PrecompileTestSuite) TestBridgeOutChainMethodsVersions() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *PrecompileTestSuite) TestOutflowPolicyMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	calls := []struct {
+		methodName string
+		inputs     []interface{}
+	}{
+		{"getOutflowWindow", []interface{}{common.Address{}}},
+		{"getUSDOutflowLimit", nil},
+		{"getUSDOutflowWindow", nil},
+		{"getUSDOutflowCapacity", nil},
+		{"getOutflowPriceFeed", []interface{}{common.Address{}}},
+	}
+
+	for _, call := range calls {
+		s.Run(call.methodName+" is not registered in v6", func() {
+			err := s.callMethod(
+				contractV6,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().ErrorContains(err, "method not found in precompile")
+		})
+
+		s.Run(call.methodName+" is registered in v7", func() {
+			err := s.callMethod(
+				contractV7,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSenderOutflowMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	calls := []struct {
+		methodName string
+		inputs     []interface{}
+	}{
+		{"getSenderOutflowParams", nil},
+		{"getSenderOutflowLimit", []interface{}{common.Address{}}},
+		{"getSenderOutflowCapacity", []interface{}{common.Address{}, common.Address{}}},
+	}
+
+	for _, call := range calls {
+		s.Run(call.methodName+" is not registered in v6", func() {
+			err := s.callMethod(
+				contractV6,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().ErrorContains(err, "method not found in precompile")
+		})
+
+		s.Run(call.methodName+" is registered in v7", func() {
+			err := s.callMethod(
+				contractV7,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelayedBridgeOutMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	calls := []struct {
+		methodName string
+		inputs     []interface{}
+	}{
+		{"getDelayedBridgeOutBlocks", nil},
+		{"getDelayedBridgeOutThreshold", []interface{}{common.Address{}}},
+	}
+
+	for _, call := range calls {
+		s.Run(call.methodName+" is not registered in v6", func() {
+			err := s.callMethod(
+				contractV6,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().ErrorContains(err, "method not found in precompile")
+		})
+
+		s.Run(call.methodName+" is registered in v7", func() {
+			err := s.callMethod(
+				contractV7,
+				call.methodName,
+				s.account1.EvmAddr,
+				call.inputs...,
+			)
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSourceChainMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	s.Require().NoError(s.bridgeKeeper.RegisterSourceChain(
+		s.ctx,
+		bridgetypes.NewSourceChain(
+			1,
+			"Arbitrum",
+			common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes(),
+		),
+	))
+
+	s.Run("getSourceChainSequenceTip is not registered in v6", func() {
+		err := s.callMethod(
+			contractV6,
+			"getSourceChainSequenceTip",
+			s.account1.EvmAddr,
+			uint32(1),
+		)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("getSourceChainSequenceTip is registered in v7", func() {
+		err := s.callMethod(
+			contractV7,
+			"getSourceChainSequenceTip",
+			s.account1.EvmAddr,
+			uint32(1),
+		)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *PrecompileTestSuite) TestBridgeOutFeeMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	s.Run("getBridgeOutFeeTreasury is not registered in v6", func() {
+		err := s.callMethod(contractV6, "getBridgeOutFeeTreasury", s.account1.EvmAddr)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("getBridgeOutFeeTreasury is registered in v7", func() {
+		err := s.callMethod(contractV7, "getBridgeOutFeeTreasury", s.account1.EvmAddr)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *PrecompileTestSuite) TestERC20MappingLifecycleMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	sourceToken := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	s.Require().NoError(s.bridgeKeeper.CreateERC20TokenMapping(
+		s.ctx,
+		sourceToken.Bytes(),
+		common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes(),
+	))
+
+	s.Run("getERC20TokenMappingState is not registered in v6", func() {
+		err := s.callMethod(
+			contractV6,
+			"getERC20TokenMappingState",
+			s.account1.EvmAddr,
+			sourceToken,
+		)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("getERC20TokenMappingState is registered in v7", func() {
+		err := s.callMethod(
+			contractV7,
+			"getERC20TokenMappingState",
+			s.account1.EvmAddr,
+			sourceToken,
+		)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *PrecompileTestSuite) TestTripartyOutcomesMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	s.Run("getTripartyRequestOutcome is not registered in v6", func() {
+		err := s.callMethod(
+			contractV6,
+			"getTripartyRequestOutcome",
+			s.account1.EvmAddr,
+			big.NewInt(1),
+		)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("getTripartyRequestOutcome is registered in v7", func() {
+		err := s.callMethod(
+			contractV7,
+			"getTripartyRequestOutcome",
+			s.account1.EvmAddr,
+			big.NewInt(1),
+		)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *PrecompileTestSuite) TestTripartyCallbackRetryMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	s.Run("getTripartyCallbackRetryPolicy is not registered in v6", func() {
+		err := s.callMethod(
+			contractV6,
+			"getTripartyCallbackRetryPolicy",
+			s.account1.EvmAddr,
+		)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("getTripartyCallbackRetryPolicy is registered in v7", func() {
+		err := s.callMethod(
+			contractV7,
+			"getTripartyCallbackRetryPolicy",
+			s.account1.EvmAddr,
+		)
+		s.Require().NoError(err)
+	})
+
+	s.Run("getFailedTripartyCallback is registered in v7", func() {
+		err := s.callMethod(
+			contractV7,
+			"getFailedTripartyCallback",
+			s.account1.EvmAddr,
+			big.NewInt(1),
+		)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *PrecompileTestSuite) TestTripartyCancellationMethodsVersions() {
+	versionMap, err := assetsbridge.NewPrecompileVersionMap(
+		s.poaKeeper,
+		s.bridgeKeeper,
+		&FakeAuthzKeeper{},
+	)
+	s.Require().NoError(err)
+
+	contractV6, ok := versionMap.GetByVersion(6)
+	s.Require().True(ok)
+
+	contractV7, ok := versionMap.GetByVersion(7)
+	s.Require().True(ok)
+
+	s.Run("cancelTripartyRequest is not registered in v6", func() {
+		err := s.callMethod(
+			contractV6,
+			"cancelTripartyRequest",
+			s.account1.EvmAddr,
+			big.NewInt(1),
+		)
+		s.Require().ErrorContains(err, "method not found in precompile")
+	})
+
+	s.Run("cancelTripartyRequest is registered in v7", func() {
+		s.bridgeKeeper.tripartyRequests["1"] = &bridgetypes.TripartyBridgeRequest{
+			Sequence:   math.NewInt(1),
+			Controller: s.account1.EvmAddr.Hex(),
+		}
+
+		err := s.callMethod(
+			contractV7,
+			"cancelTripartyRequest",
+			s.account1.EvmAddr,
+			big.NewInt(1),
+		)
+		s.Require().NoError(err)
+	})
+}
@@ -408,6 +408,24 @@ func (rc *RunContext) IsMsgValue() bool {
 	return rc.MsgValue().Sign() > 0
 }
 
+// GasLeft returns the gas left for the call, after the required gas of the
+// method was consumed.
+func (rc *RunContext) GasLeft() uint64 {
+	return rc.contract.Gas
+}
+
+// UseGas charges the given amount of gas on top of the required gas already
+// consumed for the call. It is meant for methods whose execution cost is
+// known only after they run, e.g. methods executing nested EVM calls.
+// It returns false if the gas left for the call is insufficient.
+func (rc *RunContext) UseGas(gas uint64) bool {
+	return rc.contract.UseGas(
+		gas,
+		rc.evm.Config.Tracer,
+		tracing.GasChangeCallPrecompiledContract,
+	)
+}
+
 // EventEmitter returns the event emitter instance associated with the run context.
 // The event emitter can be used to emit EVM events from the precompiled contract.
 func (rc *RunContext) EventEmitter() *EventEmitter {
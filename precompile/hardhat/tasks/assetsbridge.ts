@@ -229,6 +229,255 @@ task(
   }
 )
 
+task('assetsBridge:setOutflowWindow', 'Sets the rolling outflow window for a specific token')
+  .addParam('token', 'The address of the token to set the window for')
+  .addParam('windowBlocks', 'The window length in blocks (set to 0 with 0 buckets to use fixed periods)')
+  .addParam('buckets', 'The number of buckets the window is split into')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setOutflowWindow(
+      taskArguments.token,
+      taskArguments.windowBlocks,
+      taskArguments.buckets
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getOutflowWindow',
+  'Gets the rolling outflow window for a specific token'
+)
+  .addParam('token', 'The address of the token to check the window for')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getOutflowWindow(taskArguments.token)
+    console.log('window blocks:', result[0].toString())
+    console.log('buckets:', result[1].toString())
+  })
+
+task('assetsBridge:setUSDOutflowLimit', 'Sets the global USD outflow limit')
+  .addParam('limit', 'The maximum USD value, with 18 decimals, that can be bridged out in the window (set to 0 to disable)')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setUSDOutflowLimit(taskArguments.limit)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getUSDOutflowLimit',
+  'Gets the global USD outflow limit',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getUSDOutflowLimit()
+    console.log(result.toString())
+  }
+)
+
+task('assetsBridge:setUSDOutflowWindow', 'Sets the rolling window of the global USD outflow limit')
+  .addParam('windowBlocks', 'The window length in blocks (set to 0 with 0 buckets to use fixed periods)')
+  .addParam('buckets', 'The number of buckets the window is split into')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setUSDOutflowWindow(
+      taskArguments.windowBlocks,
+      taskArguments.buckets
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getUSDOutflowWindow',
+  'Gets the rolling window of the global USD outflow limit',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getUSDOutflowWindow()
+    console.log('window blocks:', result[0].toString())
+    console.log('buckets:', result[1].toString())
+  }
+)
+
+task(
+  'assetsBridge:getUSDOutflowCapacity',
+  'Gets the remaining global USD outflow capacity',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getUSDOutflowCapacity()
+    console.log('capacity:', result[0].toString())
+    console.log('reset height:', result[1].toString())
+  }
+)
+
+task('assetsBridge:setOutflowPriceFeed', 'Sets the oracle price feed valuing a token against the USD outflow limit')
+  .addParam('token', 'The address of the token to set the feed for')
+  .addParam('currencyPair', 'The oracle currency pair, e.g. BTC/USD (set to empty to remove the feed)')
+  .addParam('decimals', 'The number of decimals of the token')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setOutflowPriceFeed(
+      taskArguments.token,
+      taskArguments.currencyPair,
+      taskArguments.decimals
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getOutflowPriceFeed',
+  'Gets the oracle price feed valuing a token against the USD outflow limit'
+)
+  .addParam('token', 'The address of the token to check the feed for')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getOutflowPriceFeed(taskArguments.token)
+    console.log('currency pair:', result[0])
+    console.log('decimals:', result[1].toString())
+  })
+
+task('assetsBridge:setSenderOutflowParams', 'Sets the per-sender bridge-out limit parameters')
+  .addParam('windowBlocks', 'The per-sender window length in blocks (set to 0 to disable per-sender limits)')
+  .addParam('maxCount', 'The maximum number of bridge-outs per sender and window (set to 0 for no limit)')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner or emergency team member')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setSenderOutflowParams(
+      taskArguments.windowBlocks,
+      taskArguments.maxCount
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getSenderOutflowParams',
+  'Gets the per-sender bridge-out limit parameters',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getSenderOutflowParams()
+    console.log('window blocks:', result[0].toString())
+    console.log('max count:', result[1].toString())
+  }
+)
+
+task('assetsBridge:setSenderOutflowLimit', 'Sets the per-sender outflow limit for a specific token')
+  .addParam('token', 'The address of the token to set the limit for')
+  .addParam('limit', 'The maximum amount a single sender can bridge out in the window (set to 0 for no limit)')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner or emergency team member')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setSenderOutflowLimit(
+      taskArguments.token,
+      taskArguments.limit
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getSenderOutflowLimit', 'Gets the per-sender outflow limit for a specific token')
+  .addParam('token', 'The address of the token to check the limit for')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getSenderOutflowLimit(taskArguments.token)
+    console.log(result.toString())
+  })
+
+task('assetsBridge:getSenderOutflowCapacity', 'Gets the remaining bridge-out capacity of a specific sender')
+  .addParam('sender', 'The address of the sender to check the capacity for')
+  .addParam('token', 'The address of the token to check the capacity for')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getSenderOutflowCapacity(
+      taskArguments.sender,
+      taskArguments.token
+    )
+    console.log('amount limit:', result[0].toString())
+    console.log('amount capacity:', result[1].toString())
+    console.log('count limit:', result[2].toString())
+    console.log('count capacity:', result[3].toString())
+    console.log('reset height:', result[4].toString())
+  })
+
+task('assetsBridge:setDelayedBridgeOutBlocks', 'Sets the number of blocks large bridge-outs are delayed')
+  .addParam('blocks', 'The delay in blocks (set to 0 to disable the delayed bridge-out queue)')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setDelayedBridgeOutBlocks(taskArguments.blocks)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getDelayedBridgeOutBlocks',
+  'Gets the number of blocks large bridge-outs are delayed',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getDelayedBridgeOutBlocks()
+    console.log(result.toString())
+  }
+)
+
+task('assetsBridge:setDelayedBridgeOutThreshold', 'Sets the delayed bridge-out threshold for a specific token')
+  .addParam('token', 'The address of the token to set the threshold for')
+  .addParam('threshold', 'The minimum amount of a delayed bridge-out (set to 0 to never delay)')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setDelayedBridgeOutThreshold(
+      taskArguments.token,
+      taskArguments.threshold
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getDelayedBridgeOutThreshold', 'Gets the delayed bridge-out threshold for a specific token')
+  .addParam('token', 'The address of the token to check the threshold for')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getDelayedBridgeOutThreshold(taskArguments.token)
+    console.log(result.toString())
+  })
+
+task('assetsBridge:getDelayedBridgeOut', 'Gets a bridge-out held in the delayed bridge-out queue')
+  .addParam('id', 'The identifier of the delayed bridge-out')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getDelayedBridgeOut(taskArguments.id)
+    console.log('sender:', result[0])
+    console.log('token:', result[1])
+    console.log('amount:', result[2].toString())
+    console.log('chain:', result[3].toString())
+    console.log('recipient:', result[4])
+    console.log('release height:', result[5].toString())
+  })
+
+task('assetsBridge:cancelDelayedBridgeOut', 'Cancels a delayed bridge-out and refunds the sender')
+  .addParam('id', 'The identifier of the delayed bridge-out')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner or emergency team member')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.cancelDelayedBridgeOut(taskArguments.id)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
 task('assetsBridge:bridgeTriparty', 'Requests a triparty BTC mint through the bridge')
   .addParam('recipient', 'The address to receive the minted BTC')
   .addParam('amount', 'The amount of BTC to mint')
@@ -370,4 +619,227 @@ task(
     const result = await bridge.getTripartyProcessedSequenceTip()
     console.log(result.toString())
   }
-)
\ No newline at end of file
+)
+task('assetsBridge:registerSourceChain', 'Registers an additional bridge-in source chain')
+  .addParam('chain', 'The identifier of the source chain (must not be 0, reserved for Ethereum)')
+  .addParam('name', 'The human-readable name of the source chain')
+  .addParam('sourceBtcToken', 'The address of the BTC token on the source chain')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.registerSourceChain(
+      taskArguments.chain,
+      taskArguments.name,
+      taskArguments.sourceBtcToken
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getSourceChainSequenceTip', 'Gets the AssetsLocked sequence tip of a source chain')
+  .addParam('chain', 'The identifier of the source chain')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getSourceChainSequenceTip(taskArguments.chain)
+    console.log(result.toString())
+  })
+
+task('assetsBridge:createSourceChainERC20TokenMapping', 'Creates a new ERC20 token mapping of a source chain')
+  .addParam('chain', 'The identifier of the source chain')
+  .addParam('sourceToken', 'The address of the ERC20 token on the source chain')
+  .addParam('mezoToken', 'The address of the ERC20 token on the Mezo chain')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.createSourceChainERC20TokenMapping(
+      taskArguments.chain,
+      taskArguments.sourceToken,
+      taskArguments.mezoToken
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:deleteSourceChainERC20TokenMapping', 'Deletes an existing ERC20 token mapping of a source chain')
+  .addParam('chain', 'The identifier of the source chain')
+  .addParam('sourceToken', 'The address of the ERC20 token on the source chain')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.deleteSourceChainERC20TokenMapping(
+      taskArguments.chain,
+      taskArguments.sourceToken
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:setBridgeOutFeeTreasury', 'Sets the address receiving the bridge-out fees')
+  .addParam('treasury', 'The address of the bridge-out fee treasury')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setBridgeOutFeeTreasury(taskArguments.treasury)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getBridgeOutFeeTreasury',
+  'Gets the address receiving the bridge-out fees',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getBridgeOutFeeTreasury()
+    console.log(result)
+  }
+)
+
+task('assetsBridge:setBridgeOutFee', 'Sets the bridge-out fee of a token and a target chain')
+  .addParam('token', 'The address of the token on the Mezo chain')
+  .addParam('chain', 'The target chain, 0 for Ethereum, 1 for Bitcoin')
+  .addParam('flat', 'The flat part of the fee')
+  .addParam('basisPoints', 'The proportional part of the fee, in basis points')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setBridgeOutFee(
+      taskArguments.token,
+      taskArguments.chain,
+      taskArguments.flat,
+      taskArguments.basisPoints
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getBridgeOutFee', 'Gets the bridge-out fee of a token and a target chain')
+  .addParam('token', 'The address of the token on the Mezo chain')
+  .addParam('chain', 'The target chain, 0 for Ethereum, 1 for Bitcoin')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getBridgeOutFee(taskArguments.token, taskArguments.chain)
+    console.log('flat:', result[0].toString())
+    console.log('basis points:', result[1].toString())
+  })
+
+task('assetsBridge:scheduleERC20TokenMapping', 'Creates an ERC20 token mapping pending activation')
+  .addParam('sourceToken', 'The address of the ERC20 token on the source chain')
+  .addParam('mezoToken', 'The address of the ERC20 token on the Mezo chain')
+  .addParam('activationHeight', 'The Mezo block height at which the mapping becomes active')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.scheduleERC20TokenMapping(
+      taskArguments.sourceToken,
+      taskArguments.mezoToken,
+      taskArguments.activationHeight
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:setERC20TokenMappingState', 'Sets the lifecycle state of an ERC20 token mapping')
+  .addParam('sourceToken', 'The address of the ERC20 token on the source chain')
+  .addParam(
+    'state',
+    '0 active, 2 bridge-in paused, 3 bridge-out paused, 4 paused, 5 deprecated'
+  )
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner or emergency team member for pauses')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setERC20TokenMappingState(
+      taskArguments.sourceToken,
+      taskArguments.state
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getERC20TokenMappingState', 'Gets the lifecycle state of an ERC20 token mapping')
+  .addParam('sourceToken', 'The address of the ERC20 token on the source chain')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getERC20TokenMappingState(taskArguments.sourceToken)
+    console.log('state:', result[0].toString())
+    console.log('activation height:', result[1].toString())
+  })
+
+task('assetsBridge:getTripartyRequestOutcome', 'Gets the outcome of a processed triparty bridge request')
+  .addParam('sequence', 'The sequence number of the triparty bridge request')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getTripartyRequestOutcome(taskArguments.sequence)
+    console.log('status:', result[0].toString())
+    console.log('reason:', result[1])
+    console.log('processing height:', result[2].toString())
+    console.log('gas used:', result[3].toString())
+  })
+
+task('assetsBridge:retryTripartyCallback', 'Retries the failed callback of a processed triparty bridge request')
+  .addParam('sequence', 'The sequence number of the triparty bridge request')
+  .addParam('signer', 'The signer address (msg.sender)')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.retryTripartyCallback(taskArguments.sequence)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task('assetsBridge:getFailedTripartyCallback', 'Gets the retry state of a failed triparty callback')
+  .addParam('sequence', 'The sequence number of the triparty bridge request')
+  .setAction(async (taskArguments, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getFailedTripartyCallback(taskArguments.sequence)
+    console.log('queued:', result[0])
+    console.log('attempts:', result[1].toString())
+    console.log('last attempt height:', result[2].toString())
+    console.log('next retry height:', result[3].toString())
+  })
+
+task('assetsBridge:setTripartyCallbackRetryPolicy', 'Sets the retry policy of failed triparty callbacks')
+  .addParam('maxAttempts', 'The maximum number of automatic retries of a failed callback')
+  .addParam('intervalBlocks', 'The number of blocks between delivery attempts')
+  .addParam('gasLimit', 'The gas limit of a retried callback')
+  .addParam('signer', 'The signer address (msg.sender) - must be PoA owner')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.setTripartyCallbackRetryPolicy(
+      taskArguments.maxAttempts,
+      taskArguments.intervalBlocks,
+      taskArguments.gasLimit
+    )
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
+
+task(
+  'assetsBridge:getTripartyCallbackRetryPolicy',
+  'Gets the retry policy of failed triparty callbacks',
+  async (_, hre) => {
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, hre.ethers.provider)
+    const result = await bridge.getTripartyCallbackRetryPolicy()
+    console.log('max attempts:', result[0].toString())
+    console.log('interval blocks:', result[1].toString())
+    console.log('gas limit:', result[2].toString())
+  }
+)
+
+task('assetsBridge:cancelTripartyRequest', 'Cancels a pending triparty bridge request before it matures')
+  .addParam('sequence', 'The sequence number of the triparty bridge request')
+  .addParam('signer', 'The signer address (msg.sender) - must be the request controller, PoA owner or emergency team')
+  .setAction(async (taskArguments, hre) => {
+    const signer = await hre.ethers.getSigner(taskArguments.signer)
+    const bridge = new hre.ethers.Contract(precompileAddress, abi, signer)
+    const pending = await bridge.cancelTripartyRequest(taskArguments.sequence)
+    const confirmed = await pending.wait()
+    console.log(confirmed.hash)
+  })
@@ -19,13 +19,15 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	oracleconfig "github.com/skip-mev/connect/v2/oracle/config"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -225,6 +227,10 @@ type EthereumSidecarConfig struct {
 	ServerAddress string `mapstructure:"server-address"`
 	// RequestTimeout is the timeout for requests to the Ethereum sidecar server.
 	RequestTimeout time.Duration `mapstructure:"request-timeout"`
+	// SourceChainServerAddresses are the addresses of the sidecar servers
+	// observing additional bridge-in source chains, each in the
+	// <chain>=<address> format.
+	SourceChainServerAddresses []string `mapstructure:"source-chain-server-addresses"`
 }
 
 // AppConfig helps to override default appConfig template and configs.
@@ -297,7 +303,7 @@ func DefaultOracleConfig() *oracleconfig.AppConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !cmtstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -443,9 +449,53 @@ func (c EthereumSidecarConfig) Validate() error {
 		return fmt.Errorf("ethereum sidecar request timeout cannot be negative")
 	}
 
+	if _, err := ParseSourceChainServerAddresses(c.SourceChainServerAddresses); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// ParseSourceChainServerAddresses parses the given sidecar server addresses
+// of additional bridge-in source chains, each in the <chain>=<address> format,
+// and returns them indexed by the source chain identifier.
+func ParseSourceChainServerAddresses(entries []string) (map[uint32]string, error) {
+	addresses := make(map[uint32]string, len(entries))
+
+	for _, entry := range entries {
+		chainStr, address, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf(
+				"source chain server address %q is not in the <chain>=<address> format",
+				entry,
+			)
+		}
+
+		chain, err := strconv.ParseUint(strings.TrimSpace(chainStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source chain in %q: %w", entry, err)
+		}
+
+		// Chain 0 is Ethereum, observed by the sidecar at server-address.
+		if chain == 0 {
+			return nil, fmt.Errorf("source chain 0 is reserved for Ethereum")
+		}
+
+		address = strings.TrimSpace(address)
+		if address == "" {
+			return nil, fmt.Errorf("source chain %d server address cannot be empty", chain)
+		}
+
+		if _, ok := addresses[uint32(chain)]; ok {
+			return nil, fmt.Errorf("duplicate server address of source chain %d", chain)
+		}
+
+		addresses[uint32(chain)] = address
+	}
+
+	return addresses, nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	cfg, err := config.GetConfig(v)
@@ -505,6 +555,9 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		EthereumSidecar: EthereumSidecarConfig{
 			ServerAddress:  v.GetString("ethereum-sidecar.client.server-address"),
 			RequestTimeout: v.GetDuration("ethereum-sidecar.client.request-timeout"),
+			SourceChainServerAddresses: v.GetStringSlice(
+				"ethereum-sidecar.client.source-chain-server-addresses",
+			),
 		},
 		Oracle: oracleconfig.AppConfig{
 			Enabled:        v.GetBool("oracle.enabled"),
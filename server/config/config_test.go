@@ -50,3 +50,30 @@ func TestGetConfigReadsGetProofStorageKeysCap(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int32(7), cfg.JSONRPC.GetProofStorageKeysCap)
 }
+
+func TestParseSourceChainServerAddresses(t *testing.T) {
+	t.Run("parses the addresses", func(t *testing.T) {
+		addresses, err := ParseSourceChainServerAddresses(
+			[]string{"1=127.0.0.1:7510", " 2 = 127.0.0.1:7520"},
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[uint32]string{1: "127.0.0.1:7510", 2: "127.0.0.1:7520"},
+			addresses,
+		)
+	})
+
+	t.Run("rejects malformed entries", func(t *testing.T) {
+		for _, entries := range [][]string{
+			{"127.0.0.1:7510"},
+			{"x=127.0.0.1:7510"},
+			{"0=127.0.0.1:7510"},
+			{"1="},
+			{"1=127.0.0.1:7510", "1=127.0.0.1:7520"},
+		} {
+			_, err := ParseSourceChainServerAddresses(entries)
+			require.Error(t, err, entries)
+		}
+	})
+}
@@ -142,4 +142,9 @@ server-address = "{{ .EthereumSidecar.ServerAddress }}"
 
 # Request timeout defines the timeout for requests to the Ethereum sidecar server.
 request-timeout = "{{ .EthereumSidecar.RequestTimeout }}"
+
+# Source chain server addresses define the addresses of the sidecar servers
+# observing additional bridge-in source chains, as a comma-separated list of
+# <chain>=<address> entries, e.g. "1=127.0.0.1:7510,2=127.0.0.1:7520".
+source-chain-server-addresses = "{{range $index, $elmt := .EthereumSidecar.SourceChainServerAddresses}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 `
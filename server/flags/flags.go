@@ -90,8 +90,9 @@ const (
 
 // Ethereum sidecar flags
 const (
-	EthereumSidecarServerAddress  = "ethereum-sidecar.client.server-address"
-	EthereumSidecarRequestTimeout = "ethereum-sidecar.client.request-timeout"
+	EthereumSidecarServerAddress              = "ethereum-sidecar.client.server-address"
+	EthereumSidecarRequestTimeout             = "ethereum-sidecar.client.request-timeout"
+	EthereumSidecarSourceChainServerAddresses = "ethereum-sidecar.client.source-chain-server-addresses"
 )
 
 // Connect oracle sidecar flags
@@ -231,6 +231,7 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(srvflags.EthereumSidecarServerAddress, config.DefaultEthereumSidecarServerAddress, "Address of the Ethereum sidecar server")
 	cmd.Flags().Duration(srvflags.EthereumSidecarRequestTimeout, config.DefaultEthereumSidecarRequestTimeout, "Timeout for requests to the Ethereum sidecar server")
+	cmd.Flags().StringSlice(srvflags.EthereumSidecarSourceChainServerAddresses, nil, "Addresses of the sidecar servers of additional bridge-in source chains, as <chain>=<address>")
 
 	cmd.Flags().Bool(srvflags.ConnectOracleEnabled, config.DefaultConnectOracleEnabled, "Enable the Connect oracle")
 	cmd.Flags().String(srvflags.ConnectOracleAddress, config.DefaultConnectOracleAddress, "Address of the Connect oracle sidecar")
@@ -35,4 +35,11 @@ type BridgeKeeper interface {
 	AcceptAssetsLocked(ctx sdk.Context, events types.AssetsLockedEvents) error
 	ProcessTripartyBridgeRequests(ctx sdk.Context) error
 	IsBridgeInPaused(ctx sdk.Context) bool
+	IsSourceChainRegistered(ctx sdk.Context, chain uint32) bool
+	GetSourceChainAssetsLockedSequenceTip(ctx sdk.Context, chain uint32) math.Int
+	AcceptSourceChainAssetsLocked(
+		ctx sdk.Context,
+		chain uint32,
+		events types.AssetsLockedEvents,
+	) error
 }
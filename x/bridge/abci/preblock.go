@@ -39,6 +39,8 @@ func NewPreBlockHandler(
 //   - Accepts the AssetsLocked events by calling the bridge keeper's AcceptAssetsLocked
 //     method. This call updates the internal state of the bridge module to reflect
 //     the new AssetsLocked events and mints new BTC as result.
+//   - Accepts the AssetsLocked events of each additional source chain section
+//     by calling the bridge keeper's AcceptSourceChainAssetsLocked method.
 //
 // Dev note: In case of success, this function should return a non-nil pointer value
 // (&sdk.ResponsePreBlock{}) as ResponsePreBlock and a nil error. The specific
@@ -119,9 +121,35 @@ func (pbh *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 
 		// We do not validate the `events` slice as we assume all requirements
 		// of AcceptAssetsLocked were ensured during the proposal phase.
-		err := pbh.bridgeKeeper.AcceptAssetsLocked(ctx, events)
-		if err != nil {
-			return nil, fmt.Errorf("cannot accept AssetsLocked events: %w", err)
+		// The slice can be empty if the injected pseudo-transaction holds
+		// events of additional source chains only.
+		if len(events) > 0 {
+			err := pbh.bridgeKeeper.AcceptAssetsLocked(ctx, events)
+			if err != nil {
+				return nil, fmt.Errorf("cannot accept AssetsLocked events: %w", err)
+			}
+		}
+
+		for _, section := range injectedTx.SourceChainAssetsLockedEvents {
+			pbh.logger.Info(
+				"source chain AssetsLocked events extracted from block",
+				"height", req.Height,
+				"source_chain", section.Chain,
+				"events_count", len(section.Events),
+			)
+
+			err := pbh.bridgeKeeper.AcceptSourceChainAssetsLocked(
+				ctx,
+				section.Chain,
+				section.Events,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"cannot accept AssetsLocked events of source chain %d: %w",
+					section.Chain,
+					err,
+				)
+			}
 		}
 
 		pbh.logger.Info(
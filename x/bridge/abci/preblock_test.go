@@ -180,6 +180,100 @@ func (s *PreBlockHandlerTestSuite) TestPreBlocker() {
 			expectedRes: &sdk.ResponsePreBlock{},
 			errContains: "",
 		},
+		{
+			name: "keeper not accepting source chain events",
+			bridgeKeeperFn: func() *mockBridgeKeeper {
+				bridgeKeeper := newMockBridgeKeeper()
+				bridgeKeeper.On(
+					"ProcessTripartyBridgeRequests", s.ctx,
+				).Return(nil)
+
+				bridgeKeeper.On(
+					"AcceptSourceChainAssetsLocked",
+					s.ctx,
+					uint32(1),
+					bridgetypes.AssetsLockedEvents{
+						mockEvent(1, recipient1, 100, token),
+					},
+				).Return(fmt.Errorf("keeper error"))
+
+				return bridgeKeeper
+			},
+			reqTxs: [][]byte{
+				marshalInjectedTx(
+					types.InjectedTx{
+						ExtendedCommitInfo: []byte("extendedCommitInfo"),
+						SourceChainAssetsLockedEvents: []types.SourceChainAssetsLockedEvents{
+							{
+								Chain: 1,
+								Events: bridgetypes.AssetsLockedEvents{
+									mockEvent(1, recipient1, 100, token),
+								},
+							},
+						},
+					},
+				),
+			},
+			expectedRes: nil,
+			errContains: "cannot accept AssetsLocked events of source chain 1",
+		},
+		{
+			name: "keeper accepting source chain events only",
+			bridgeKeeperFn: func() *mockBridgeKeeper {
+				bridgeKeeper := newMockBridgeKeeper()
+				bridgeKeeper.On(
+					"ProcessTripartyBridgeRequests", s.ctx,
+				).Return(nil)
+
+				bridgeKeeper.On(
+					"AcceptSourceChainAssetsLocked",
+					s.ctx,
+					uint32(1),
+					bridgetypes.AssetsLockedEvents{
+						mockEvent(1, recipient1, 100, token),
+					},
+				).Return(nil)
+
+				bridgeKeeper.On(
+					"AcceptSourceChainAssetsLocked",
+					s.ctx,
+					uint32(2),
+					bridgetypes.AssetsLockedEvents{
+						mockEvent(7, recipient2, 200, token),
+					},
+				).Return(nil)
+
+				bridgeKeeper.On(
+					"GetAssetsLockedSequenceTip",
+					s.ctx,
+				).Return(math.NewInt(2))
+
+				return bridgeKeeper
+			},
+			reqTxs: [][]byte{
+				marshalInjectedTx(
+					types.InjectedTx{
+						ExtendedCommitInfo: []byte("extendedCommitInfo"),
+						SourceChainAssetsLockedEvents: []types.SourceChainAssetsLockedEvents{
+							{
+								Chain: 1,
+								Events: bridgetypes.AssetsLockedEvents{
+									mockEvent(1, recipient1, 100, token),
+								},
+							},
+							{
+								Chain: 2,
+								Events: bridgetypes.AssetsLockedEvents{
+									mockEvent(7, recipient2, 200, token),
+								},
+							},
+						},
+					},
+				),
+			},
+			expectedRes: &sdk.ResponsePreBlock{},
+			errContains: "",
+		},
 	}
 
 	for _, test := range tests {
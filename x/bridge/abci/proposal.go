@@ -3,6 +3,7 @@ package abci
 import (
 	"bytes"
 	"fmt"
+	"maps"
 	"slices"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -52,6 +53,18 @@ type AssetsLockedExtractor interface {
 		extendedCommitInfo cmtabci.ExtendedCommitInfo,
 		height int64,
 	) (bridgetypes.AssetsLockedEvents, error)
+
+	// CanonicalSourceChainEvents takes the given extended commit info and
+	// determines the sequences of canonical AssetsLocked events of additional
+	// source chains, in the same way as CanonicalEvents does for the primary
+	// source chain. The returned sections are ordered by the chain identifier
+	// and hold non-empty sequences strictly increasing by 1. Source chains
+	// whose canonical sequence cannot be determined are left out.
+	CanonicalSourceChainEvents(
+		ctx sdk.Context,
+		extendedCommitInfo cmtabci.ExtendedCommitInfo,
+		height int64,
+	) ([]types.SourceChainAssetsLockedEvents, error)
 }
 
 // ProposalHandler is the bridge-specific handler for the PrepareProposal and
@@ -93,6 +106,8 @@ func NewProposalHandler(
 //   - Determines the sequence of canonical AssetsLocked events supported
 //     by 2/3+ of the bridge validators and confirmed by 2/3+ of the non-bridge
 //     validators
+//   - Does the same for each additional source chain, keeping only the
+//     sequences of registered chains that stick to their sequence tips
 //   - Injects the pseudo-transaction containing the canonical events at the
 //     beginning of the original transaction list being part of the proposal.
 //
@@ -157,50 +172,28 @@ func (ph *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 			)
 		}
 
-		// If there are no canonical events, we do not inject the pseudo-transaction
-		// and return the proposal txs vector as is.
-		if len(canonicalEvents) == 0 {
-			ph.logger.Info(
-				"canonical AssetsLocked events sequence is empty",
-				"height", req.Height,
-			)
-
-			return &cmtabci.ResponsePrepareProposal{Txs: req.Txs}, nil
-		}
+		canonicalEvents = ph.admissibleEvents(ctx, req.Height, canonicalEvents)
 
-		ph.logger.Info(
-			"canonical AssetsLocked events sequence extracted",
-			"height", req.Height,
-			"events_count", len(canonicalEvents),
-			"events_sequence_start", canonicalEvents[0].Sequence,
+		sourceChainEvents, err := ph.assetsLockedExtractor.CanonicalSourceChainEvents(
+			ctx,
+			req.LocalLastCommit,
+			req.Height,
 		)
-
-		sequenceTip := ph.keeper.GetAssetsLockedSequenceTip(ctx)
-		// If sequence of canonical events does not start directly after the
-		// current sequence tip, that means some earlier AssetsLocked events
-		// are missing. In this case, we do not inject the pseudo-transaction
-		// and return the proposal txs vector as is.
-		if !canonicalEvents[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
-			ph.logger.Info(
-				"canonical AssetsLocked events sequence does not "+
-					"stick to the current sequence tip",
-				"height", req.Height,
-				"events_count", len(canonicalEvents),
-				"events_sequence_start", canonicalEvents[0].Sequence,
-				"sequence_tip", sequenceTip,
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to determine canonical source chain AssetsLocked events: %w",
+				err,
 			)
+		}
 
+		sourceChainEvents = ph.admissibleSourceChainEvents(ctx, sourceChainEvents)
+
+		// If there are no events to inject, we do not inject the
+		// pseudo-transaction and return the proposal txs vector as is.
+		if len(canonicalEvents) == 0 && len(sourceChainEvents) == 0 {
 			return &cmtabci.ResponsePrepareProposal{Txs: req.Txs}, nil
 		}
 
-		ph.logger.Info(
-			"canonical AssetsLocked events sequence sticks to the current sequence tip",
-			"height", req.Height,
-			"events_count", len(canonicalEvents),
-			"events_sequence_start", canonicalEvents[0].Sequence,
-			"sequence_tip", sequenceTip,
-		)
-
 		extendedCommitInfo, err := req.LocalLastCommit.Marshal()
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal the extended commit info: %w", err)
@@ -208,8 +201,9 @@ func (ph *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 
 		// Construct the bridge-specific injected pseudo-transaction.
 		injectedTx := types.InjectedTx{
-			AssetsLockedEvents: canonicalEvents,
-			ExtendedCommitInfo: extendedCommitInfo,
+			AssetsLockedEvents:            canonicalEvents,
+			ExtendedCommitInfo:            extendedCommitInfo,
+			SourceChainAssetsLockedEvents: sourceChainEvents,
 		}
 		// Marshal the injected pseudo-transaction into bytes.
 		injectedTxBytes, err := injectedTx.Marshal()
@@ -238,7 +232,8 @@ func (ph *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 // proposal acceptance or rejection. Specifically it:
 //   - Makes sure the injected pseudo-tx exists and unmarshals correctly
 //   - Verifies whether the commit info attached to the pseudo-tx unmarshals correctly
-//   - Ensures injected pseudo-tx contains a non-empty slice of AssetsLocked events
+//   - Ensures injected pseudo-tx contains a non-empty slice of AssetsLocked
+//     events of the primary source chain or of any additional source chain
 //   - Validates the signatures of the vote extensions attached to the injected
 //     pseudo-tx (as part of the commit info)
 //   - Recreates the canonical sequence of AssetsLocked events using the attached
@@ -246,6 +241,8 @@ func (ph *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 //     injected pseudo-tx
 //   - Makes sure the AssetsLocked events from the injected pseudo-tx start directly
 //     after the current sequence tip
+//   - Recreates the canonical AssetsLocked events of additional source chains
+//     and ensures they match the source chain sections of the injected pseudo-tx
 //
 // If the injected pseudo-tx is valid, the proposal is accepted. Empty pseudo-txs
 // lead to proposal acceptance by default.
@@ -320,10 +317,10 @@ func (ph *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			}, fmt.Errorf("failed to unmarshal injected tx: %w", err)
 		}
 
-		// If the pseudo-transaction is present but holds an empty slice of
-		// AssetsLocked events, we reject the proposal as the block proposer
-		// misbehaved.
-		if len(injectedTx.AssetsLockedEvents) == 0 {
+		// If the pseudo-transaction is present but holds no AssetsLocked
+		// events, we reject the proposal as the block proposer misbehaved.
+		if len(injectedTx.AssetsLockedEvents) == 0 &&
+			len(injectedTx.SourceChainAssetsLockedEvents) == 0 {
 			return &cmtabci.ResponseProcessProposal{
 				Status: cmtabci.ResponseProcessProposal_REJECT,
 			}, fmt.Errorf("injected tx does not contain AssetsLocked events")
@@ -350,11 +347,11 @@ func (ph *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 		)
 		if err != nil {
 			return &cmtabci.ResponseProcessProposal{
-					Status: cmtabci.ResponseProcessProposal_REJECT,
-				}, fmt.Errorf(
-					"failed to validate vote extensions from injected tx: %w",
-					err,
-				)
+				Status: cmtabci.ResponseProcessProposal_REJECT,
+			}, fmt.Errorf(
+				"failed to validate vote extensions from injected tx: %w",
+				err,
+			)
 		}
 
 		// Re-create the canonical events using vote extensions from the
@@ -371,33 +368,77 @@ func (ph *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 			}, fmt.Errorf("failed to recreate canonical AssetsLocked events: %w", err)
 		}
 
-		// Make sure the recreated canonical events match the injected ones.
-		// This is a proof that the block proposer behaved correctly and used
-		// signed vote extensions to inject the canonical AssetsLocked events.
-		if !recreatedCanonicalEvents.Equal(injectedTx.AssetsLockedEvents) {
-			return &cmtabci.ResponseProcessProposal{
+		sequenceTip := ph.keeper.GetAssetsLockedSequenceTip(ctx)
+
+		if len(injectedTx.AssetsLockedEvents) > 0 {
+			// Make sure the recreated canonical events match the injected ones.
+			// This is a proof that the block proposer behaved correctly and used
+			// signed vote extensions to inject the canonical AssetsLocked events.
+			if !recreatedCanonicalEvents.Equal(injectedTx.AssetsLockedEvents) {
+				return &cmtabci.ResponseProcessProposal{
 					Status: cmtabci.ResponseProcessProposal_REJECT,
 				}, fmt.Errorf(
 					"recreated canonical AssetsLocked events do not match " +
 						"events from injected tx",
 				)
-		}
+			}
 
-		sequenceTip := ph.keeper.GetAssetsLockedSequenceTip(ctx)
-		// If sequence of injected events does not start directly after the
-		// current sequence tip, that means some earlier AssetsLocked events
-		// are missing. That means the block proposer misbehaved and the proposal
-		// should be rejected. Note that we could have done this check earlier
-		// but, at this point, we know that the injected events match the recreated
-		// canonical events that are guaranteed to be valid. This way, we can safely
-		// compare the sequence of the first injected event with the sequence tip.
-		if !injectedTx.AssetsLockedEvents[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
-			return &cmtabci.ResponseProcessProposal{
+			// If sequence of injected events does not start directly after the
+			// current sequence tip, that means some earlier AssetsLocked events
+			// are missing. That means the block proposer misbehaved and the proposal
+			// should be rejected. Note that we could have done this check earlier
+			// but, at this point, we know that the injected events match the recreated
+			// canonical events that are guaranteed to be valid. This way, we can safely
+			// compare the sequence of the first injected event with the sequence tip.
+			if !injectedTx.AssetsLockedEvents[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
+				return &cmtabci.ResponseProcessProposal{
 					Status: cmtabci.ResponseProcessProposal_REJECT,
 				}, fmt.Errorf(
 					"AssetsLocked events from injected tx do not start " +
 						"after the current sequence tip",
 				)
+			}
+		} else if len(recreatedCanonicalEvents) > 0 &&
+			recreatedCanonicalEvents[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
+			// The injected pseudo-tx holds only source chain sections while
+			// the primary source chain has canonical events sticking to the
+			// sequence tip. The block proposer misbehaved by omitting them.
+			return &cmtabci.ResponseProcessProposal{
+				Status: cmtabci.ResponseProcessProposal_REJECT,
+			}, fmt.Errorf("injected tx omits canonical AssetsLocked events")
+		}
+
+		// Re-create the canonical events of additional source chains and
+		// keep the admissible ones, the same way the block proposer does.
+		recreatedSourceChainEvents, err := ph.assetsLockedExtractor.CanonicalSourceChainEvents(
+			ctx,
+			extendedCommitInfo,
+			req.Height,
+		)
+		if err != nil {
+			return &cmtabci.ResponseProcessProposal{
+				Status: cmtabci.ResponseProcessProposal_REJECT,
+			}, fmt.Errorf(
+				"failed to recreate canonical source chain AssetsLocked events: %w",
+				err,
+			)
+		}
+
+		recreatedSourceChainEvents = ph.admissibleSourceChainEvents(
+			ctx,
+			recreatedSourceChainEvents,
+		)
+
+		if !sourceChainAssetsLockedEventsEqual(
+			recreatedSourceChainEvents,
+			injectedTx.SourceChainAssetsLockedEvents,
+		) {
+			return &cmtabci.ResponseProcessProposal{
+				Status: cmtabci.ResponseProcessProposal_REJECT,
+			}, fmt.Errorf(
+				"source chain AssetsLocked events from injected tx do not " +
+					"match the recreated canonical ones",
+			)
 		}
 
 		ph.logger.Debug(
@@ -412,6 +453,100 @@ func (ph *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	}
 }
 
+// admissibleEvents returns the given canonical AssetsLocked events of the
+// primary source chain if they start directly after the current sequence
+// tip. Otherwise, some earlier AssetsLocked events are missing and the
+// function returns nil so the events are not injected.
+func (ph *ProposalHandler) admissibleEvents(
+	ctx sdk.Context,
+	height int64,
+	canonicalEvents []bridgetypes.AssetsLockedEvent,
+) []bridgetypes.AssetsLockedEvent {
+	if len(canonicalEvents) == 0 {
+		ph.logger.Info(
+			"canonical AssetsLocked events sequence is empty",
+			"height", height,
+		)
+
+		return nil
+	}
+
+	ph.logger.Info(
+		"canonical AssetsLocked events sequence extracted",
+		"height", height,
+		"events_count", len(canonicalEvents),
+		"events_sequence_start", canonicalEvents[0].Sequence,
+	)
+
+	sequenceTip := ph.keeper.GetAssetsLockedSequenceTip(ctx)
+	if !canonicalEvents[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
+		ph.logger.Info(
+			"canonical AssetsLocked events sequence does not "+
+				"stick to the current sequence tip",
+			"height", height,
+			"events_count", len(canonicalEvents),
+			"events_sequence_start", canonicalEvents[0].Sequence,
+			"sequence_tip", sequenceTip,
+		)
+
+		return nil
+	}
+
+	ph.logger.Info(
+		"canonical AssetsLocked events sequence sticks to the current sequence tip",
+		"height", height,
+		"events_count", len(canonicalEvents),
+		"events_sequence_start", canonicalEvents[0].Sequence,
+		"sequence_tip", sequenceTip,
+	)
+
+	return canonicalEvents
+}
+
+// admissibleSourceChainEvents returns the given canonical sections of
+// additional source chains that can be injected into the proposal, that is,
+// sections of registered source chains whose events start directly after the
+// sequence tip of the given chain. Returns nil if no section is admissible.
+func (ph *ProposalHandler) admissibleSourceChainEvents(
+	ctx sdk.Context,
+	sections []types.SourceChainAssetsLockedEvents,
+) []types.SourceChainAssetsLockedEvents {
+	var admissible []types.SourceChainAssetsLockedEvents
+
+	for _, section := range sections {
+		if !ph.keeper.IsSourceChainRegistered(ctx, section.Chain) {
+			continue
+		}
+
+		sequenceTip := ph.keeper.GetSourceChainAssetsLockedSequenceTip(ctx, section.Chain)
+		if !section.Events[0].Sequence.Equal(sequenceTip.AddRaw(1)) {
+			ph.logger.Info(
+				"canonical source chain AssetsLocked events sequence does "+
+					"not stick to the current sequence tip",
+				"source_chain", section.Chain,
+				"events_sequence_start", section.Events[0].Sequence,
+				"sequence_tip", sequenceTip,
+			)
+			continue
+		}
+
+		admissible = append(admissible, section)
+	}
+
+	return admissible
+}
+
+// sourceChainAssetsLockedEventsEqual returns true if both slices hold the
+// same sections, in the same order.
+func sourceChainAssetsLockedEventsEqual(
+	a, b []types.SourceChainAssetsLockedEvents,
+) bool {
+	return slices.EqualFunc(a, b, func(x, y types.SourceChainAssetsLockedEvents) bool {
+		return x.Chain == y.Chain &&
+			bridgetypes.AssetsLockedEvents(x.Events).Equal(y.Events)
+	})
+}
+
 // assetsLockedExtractor is the default implementation of the
 // AssetsLockedExtractor interface.
 type assetsLockedExtractor struct {
@@ -440,12 +575,68 @@ func (ale *assetsLockedExtractor) CanonicalEvents(
 	extendedCommitInfo cmtabci.ExtendedCommitInfo,
 	height int64,
 ) (bridgetypes.AssetsLockedEvents, error) {
+	voteCounter, _ := ale.countVotes(ctx, extendedCommitInfo, height)
+
+	// canonicalEvents returns the sequence of canonical AssetsLocked events
+	// and guarantees that the sequence is strictly increasing by 1.
+	return voteCounter.canonicalEvents()
+}
+
+// CanonicalSourceChainEvents is the default implementation of the
+// AssetsLockedExtractor.CanonicalSourceChainEvents method.
+func (ale *assetsLockedExtractor) CanonicalSourceChainEvents(
+	ctx sdk.Context,
+	extendedCommitInfo cmtabci.ExtendedCommitInfo,
+	height int64,
+) ([]types.SourceChainAssetsLockedEvents, error) {
+	_, sourceChainVoteCounters := ale.countVotes(ctx, extendedCommitInfo, height)
+
+	var sections []types.SourceChainAssetsLockedEvents
+
+	for _, chain := range slices.Sorted(maps.Keys(sourceChainVoteCounters)) {
+		events, err := sourceChainVoteCounters[chain].canonicalEvents()
+		if err != nil {
+			// A source chain whose canonical sequence cannot be determined
+			// must not prevent bridging from other chains.
+			ale.logger.Error(
+				"failed to determine canonical source chain AssetsLocked events",
+				"height", height,
+				"source_chain", chain,
+				"err", err,
+			)
+			continue
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		sections = append(sections, types.SourceChainAssetsLockedEvents{
+			Chain:  chain,
+			Events: events,
+		})
+	}
+
+	return sections, nil
+}
+
+// countVotes counts the votes for AssetsLocked events held by the vote
+// extensions of the given extended commit info. It returns the vote counter
+// of the primary source chain and the vote counters of additional source
+// chains, indexed by the chain identifier. All counters share the same
+// voters so the super-majority is computed against the same voting power.
+func (ale *assetsLockedExtractor) countVotes(
+	ctx sdk.Context,
+	extendedCommitInfo cmtabci.ExtendedCommitInfo,
+	height int64,
+) (*assetsLockedVoteCounter, map[uint32]*assetsLockedVoteCounter) {
 	bridgeValsConsAddrs := ale.valStore.GetValidatorsConsAddrsByPrivilege(
 		ctx,
 		bridgetypes.ValidatorPrivilege,
 	)
 
 	voteCounter := newAssetsLockedVoteCounter()
+	sourceChainVoteCounters := make(map[uint32]*assetsLockedVoteCounter)
 
 	// extendedCommitInfo.Votes is actually a list of validators in the
 	// last CometBFT validator set, with voting information regarding
@@ -546,15 +737,16 @@ func (ale *assetsLockedExtractor) CanonicalEvents(
 			continue
 		}
 
-		// A non-empty bridge-specific vote extension with empty AssetsLocked
-		// sequence is an invalid case. It should never happen in practice
+		// A non-empty bridge-specific vote extension with no AssetsLocked
+		// events at all is an invalid case. It should never happen in practice
 		// given the current implementation of the bridge-specific vote extension
 		// handler that returns empty bytes as the vote extension if it does not
 		// receive any events from the sidecar. However, we perform this
 		// check just in case to make sure such an invalid vote extension is
 		// rejected properly, even if the implementation of the bridge-specific
 		// vote extension handler changes in the future.
-		if len(voteExtension.AssetsLockedEvents) == 0 {
+		if len(voteExtension.AssetsLockedEvents) == 0 &&
+			len(voteExtension.SourceChainAssetsLockedEvents) == 0 {
 			logIgnoredVoteExtension("no AssetsLocked events")
 			continue
 		}
@@ -564,22 +756,40 @@ func (ale *assetsLockedExtractor) CanonicalEvents(
 		// This is because extensions of votes included in the commit info
 		// after the minimum of +2/3 had been reached are not verified.
 		// See: https://docs.cometbft.com/v0.38/spec/abci/abci++_methods#prepareproposal
-		if err := validateAssetsLockedEvents(voteExtension.AssetsLockedEvents); err != nil {
+		if err := validateVoteExtension(voteExtension); err != nil {
 			logIgnoredVoteExtension(fmt.Sprintf("invalid AssetsLocked sequence: %v", err))
 			continue
 		}
 
 		// addVote assumes the given event is valid and does not perform
 		// any validation over it. We ensure validity by calling
-		// validateAssetsLockedEvents above.
+		// validateVoteExtension above.
 		for i := range voteExtension.AssetsLockedEvents {
 			voteCounter.addVote(&voteExtension.AssetsLockedEvents[i], valVP, isBridgeVal)
 		}
+
+		for _, section := range voteExtension.SourceChainAssetsLockedEvents {
+			sourceChainVoteCounter, ok := sourceChainVoteCounters[section.Chain]
+			if !ok {
+				sourceChainVoteCounter = newAssetsLockedVoteCounter()
+				sourceChainVoteCounters[section.Chain] = sourceChainVoteCounter
+			}
+
+			for i := range section.Events {
+				sourceChainVoteCounter.addVote(&section.Events[i], valVP, isBridgeVal)
+			}
+		}
 	}
 
-	// canonicalEvents returns the sequence of canonical AssetsLocked events
-	// and guarantees that the sequence is strictly increasing by 1.
-	return voteCounter.canonicalEvents()
+	// Voters of additional source chains are the same as the voters of
+	// the primary source chain. Their total voting power is known only
+	// once all votes have been registered.
+	for _, sourceChainVoteCounter := range sourceChainVoteCounters {
+		sourceChainVoteCounter.bridgeValsTotalVP = voteCounter.bridgeValsTotalVP
+		sourceChainVoteCounter.nonBridgeValsTotalVP = voteCounter.nonBridgeValsTotalVP
+	}
+
+	return voteCounter, sourceChainVoteCounters
 }
 
 // assetsLockedVoteCounter is a helper structure that counts votes for
@@ -937,6 +937,151 @@ func (s *ProposalHandlerTestSuite) TestProcessProposal() {
 	}
 }
 
+func (s *ProposalHandlerTestSuite) TestSourceChainProposals() {
+	s.bridgeKeeper.On("IsBridgeInPaused", s.ctx).Return(false)
+	s.bridgeKeeper.On("IsSourceChainRegistered", s.ctx, uint32(1)).Return(true)
+	s.bridgeKeeper.On("IsSourceChainRegistered", s.ctx, uint32(2)).Return(true)
+	s.bridgeKeeper.On(
+		"GetSourceChainAssetsLockedSequenceTip",
+		s.ctx,
+		uint32(1),
+	).Return(sdkmath.NewInt(10))
+	s.bridgeKeeper.On(
+		"GetSourceChainAssetsLockedSequenceTip",
+		s.ctx,
+		uint32(2),
+	).Return(sdkmath.NewInt(10))
+
+	// Chain 2 events do not stick to its sequence tip so only the chain 1
+	// section is admissible.
+	canonicalSections := []types.SourceChainAssetsLockedEvents{
+		{
+			Chain:  1,
+			Events: []bridgetypes.AssetsLockedEvent{mockEvent(11, recipient1, 100, token)},
+		},
+		{
+			Chain:  2,
+			Events: []bridgetypes.AssetsLockedEvent{mockEvent(5, recipient2, 200, token)},
+		},
+	}
+	admissibleSections := canonicalSections[:1]
+
+	extendedCommitInfo := cmtabci.ExtendedCommitInfo{
+		Round: 1,
+		Votes: []cmtabci.ExtendedVoteInfo{
+			mockVoteExtension("val1Bridge", 100, tmproto.BlockIDFlagCommit),
+		},
+	}
+	extendedCommitInfoBytes, err := extendedCommitInfo.Marshal()
+	s.Require().NoError(err)
+	// Use the unmarshaled form so mock expectations match the commit info
+	// the process handler recovers from the injected tx.
+	extendedCommitInfo = cmtabci.ExtendedCommitInfo{}
+	s.Require().NoError(extendedCommitInfo.Unmarshal(extendedCommitInfoBytes))
+
+	newHandler := func(
+		canonicalEvents []bridgetypes.AssetsLockedEvent,
+	) *mockAssetsLockedExtractor {
+		s.handler = NewProposalHandler(
+			s.logger,
+			newMockValidatorStore(),
+			s.bridgeKeeper,
+			nil,
+			newMockVoteExtensionsValidator(nil).call,
+		)
+
+		extractor := &mockAssetsLockedExtractor{}
+		extractor.On(
+			"CanonicalEvents",
+			s.ctx,
+			extendedCommitInfo,
+			s.requestHeight,
+		).Return(canonicalEvents, nil)
+		extractor.On(
+			"CanonicalSourceChainEvents",
+			s.ctx,
+			extendedCommitInfo,
+			s.requestHeight,
+		).Return(canonicalSections, nil)
+
+		s.handler.assetsLockedExtractor = extractor
+
+		return extractor
+	}
+
+	s.Run("prepare injects source chain sections only", func() {
+		extractor := newHandler(nil)
+
+		req := &cmtabci.RequestPrepareProposal{
+			Height:          s.requestHeight,
+			Txs:             txsVector("tx1"),
+			LocalLastCommit: extendedCommitInfo,
+		}
+
+		res, err := s.handler.PrepareProposalHandler()(s.ctx, req)
+		s.Require().NoError(err)
+
+		extractor.AssertExpectations(s.T())
+
+		var injectedTx types.InjectedTx
+		s.Require().NoError(injectedTx.Unmarshal(extractInjectedTx(req, res)))
+
+		s.Require().Empty(injectedTx.AssetsLockedEvents)
+		s.Require().Equal(admissibleSections, injectedTx.SourceChainAssetsLockedEvents)
+		s.Require().Equal(txsVector("tx1"), res.Txs[1:])
+	})
+
+	process := func(
+		canonicalEvents []bridgetypes.AssetsLockedEvent,
+		injectedSections []types.SourceChainAssetsLockedEvents,
+	) (*cmtabci.ResponseProcessProposal, error) {
+		newHandler(canonicalEvents)
+
+		return s.handler.ProcessProposalHandler()(s.ctx, &cmtabci.RequestProcessProposal{
+			Height: s.requestHeight,
+			Txs: append(
+				[][]byte{
+					marshalInjectedTx(types.InjectedTx{
+						ExtendedCommitInfo:            extendedCommitInfoBytes,
+						SourceChainAssetsLockedEvents: injectedSections,
+					}),
+				},
+				txsVector("tx1")...,
+			),
+			ProposerAddress: []byte("proposerAddress"),
+		})
+	}
+
+	s.Run("process accepts matching source chain sections", func() {
+		res, err := process(nil, admissibleSections)
+		s.Require().NoError(err)
+		s.Require().Equal(cmtabci.ResponseProcessProposal_ACCEPT, res.Status)
+	})
+
+	s.Run("process rejects not matching source chain sections", func() {
+		res, err := process(nil, []types.SourceChainAssetsLockedEvents{
+			{
+				Chain:  1,
+				Events: []bridgetypes.AssetsLockedEvent{mockEvent(11, recipient1, 101, token)},
+			},
+		})
+		s.Require().ErrorContains(
+			err,
+			"source chain AssetsLocked events from injected tx do not match",
+		)
+		s.Require().Equal(cmtabci.ResponseProcessProposal_REJECT, res.Status)
+	})
+
+	s.Run("process rejects omitted canonical events", func() {
+		res, err := process(
+			[]bridgetypes.AssetsLockedEvent{mockEvent(201, recipient1, 100, token)},
+			admissibleSections,
+		)
+		s.Require().ErrorContains(err, "injected tx omits canonical AssetsLocked events")
+		s.Require().Equal(cmtabci.ResponseProcessProposal_REJECT, res.Status)
+	})
+}
+
 func TestAssetsLockedExtractorTestSuite(t *testing.T) {
 	suite.Run(t, new(AssetsLockedExtractorTestSuite))
 }
@@ -1774,6 +1919,85 @@ func (s *AssetsLockedExtractorTestSuite) TestCanonicalEvents() {
 }
 
 //nolint:all
+func (s *AssetsLockedExtractorTestSuite) TestCanonicalSourceChainEvents() {
+	s.extractor = newAssetsLockedExtractor(
+		s.logger,
+		newMockValidatorStore("val1Bridge", "val2Bridge", "val3Bridge", "val4Bridge"),
+		newMockVoteExtensionDecomposer().withReturnInputMode().call,
+	)
+
+	// Chain 1 events are supported by the super-majority of bridge
+	// validators. Chain 2 events are supported by a single one.
+	chain1 := types.SourceChainAssetsLockedEvents{
+		Chain: 1,
+		Events: []bridgetypes.AssetsLockedEvent{
+			mockEvent(11, recipient1, 100, token),
+			mockEvent(12, recipient2, 200, token),
+		},
+	}
+	chain1Outlier := types.SourceChainAssetsLockedEvents{
+		Chain: 1,
+		Events: []bridgetypes.AssetsLockedEvent{
+			mockEvent(11, recipient1, 100, token),
+			mockEvent(12, recipient2, 201, token),
+		},
+	}
+	chain2 := types.SourceChainAssetsLockedEvents{
+		Chain: 2,
+		Events: []bridgetypes.AssetsLockedEvent{
+			mockEvent(5, recipient3, 300, token),
+		},
+	}
+
+	extendedCommitInfo := cmtabci.ExtendedCommitInfo{
+		Round: 1, // Just an arbitrary value.
+		Votes: []cmtabci.ExtendedVoteInfo{
+			mockSourceChainVoteExtension("val1Bridge", 100, chain1, chain2),
+			mockSourceChainVoteExtension("val2Bridge", 100, chain1),
+			mockSourceChainVoteExtension("val3Bridge", 100, chain1),
+			mockSourceChainVoteExtension("val4Bridge", 100, chain1Outlier),
+			mockSourceChainVoteExtension("val5NonBridge", 100, chain1),
+		},
+	}
+
+	sections, err := s.extractor.CanonicalSourceChainEvents(
+		s.ctx,
+		extendedCommitInfo,
+		s.height,
+	)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SourceChainAssetsLockedEvents{chain1}, sections)
+
+	// Vote extensions holding source chain sections only are not counted
+	// for the primary source chain.
+	events, err := s.extractor.CanonicalEvents(s.ctx, extendedCommitInfo, s.height)
+	s.Require().NoError(err)
+	s.Require().Empty(events)
+}
+
+// mockSourceChainVoteExtension creates a committed vote holding only
+// AssetsLocked events of additional source chains.
+func mockSourceChainVoteExtension(
+	valAddress string,
+	power int64,
+	sections ...types.SourceChainAssetsLockedEvents,
+) cmtabci.ExtendedVoteInfo {
+	voteExtension := &types.VoteExtension{SourceChainAssetsLockedEvents: sections}
+	voteExtensionBytes, err := voteExtension.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return cmtabci.ExtendedVoteInfo{
+		Validator: cmtabci.Validator{
+			Address: []byte(valAddress),
+			Power:   power,
+		},
+		VoteExtension: voteExtensionBytes,
+		BlockIdFlag:   tmproto.BlockIDFlagCommit,
+	}
+}
+
 func mockVoteExtension(
 	valAddress string,
 	power int64,
@@ -1955,8 +2179,20 @@ type mockAssetsLockedExtractor struct {
 	mock.Mock
 }
 
+// newMockAssetsLockedExtractor creates a mock extractor that returns no
+// canonical events of additional source chains unless the test constructs
+// the mock on its own.
 func newMockAssetsLockedExtractor() *mockAssetsLockedExtractor {
-	return &mockAssetsLockedExtractor{}
+	extractor := &mockAssetsLockedExtractor{}
+
+	extractor.On(
+		"CanonicalSourceChainEvents",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil, nil).Maybe()
+
+	return extractor
 }
 
 func (male *mockAssetsLockedExtractor) CanonicalEvents(
@@ -1973,6 +2209,20 @@ func (male *mockAssetsLockedExtractor) CanonicalEvents(
 	return nil, args.Error(1)
 }
 
+func (male *mockAssetsLockedExtractor) CanonicalSourceChainEvents(
+	ctx sdk.Context,
+	extendedCommitInfo cmtabci.ExtendedCommitInfo,
+	height int64,
+) ([]types.SourceChainAssetsLockedEvents, error) {
+	args := male.Called(ctx, extendedCommitInfo, height)
+
+	if res := args.Get(0); res != nil {
+		return res.([]types.SourceChainAssetsLockedEvents), args.Error(1)
+	}
+
+	return nil, args.Error(1)
+}
+
 func marshalInjectedTx(injectedTx types.InjectedTx) []byte {
 	injectedTxBytes, err := injectedTx.Marshal()
 	if err != nil {
@@ -36,6 +36,12 @@ type InjectedTx struct {
 	// It holds the vote extensions that are used to derive the
 	// assets_locked_events.
 	ExtendedCommitInfo []byte `protobuf:"bytes,2,opt,name=extended_commit_info,json=extendedCommitInfo,proto3" json:"extended_commit_info,omitempty"`
+	// source_chain_assets_locked_events are the canonical AssetsLocked events
+	// of the additional source chains, with one non-empty section per source
+	// chain, ordered by the chain identifier. Each section starts directly
+	// after the sequence tip of its chain. They are derived from the
+	// extended_commit_info field.
+	SourceChainAssetsLockedEvents []SourceChainAssetsLockedEvents `protobuf:"bytes,3,rep,name=source_chain_assets_locked_events,json=sourceChainAssetsLockedEvents,proto3" json:"source_chain_assets_locked_events"`
 }
 
 func (m *InjectedTx) Reset()         { *m = InjectedTx{} }
@@ -85,6 +91,13 @@ func (m *InjectedTx) GetExtendedCommitInfo() []byte {
 	return nil
 }
 
+func (m *InjectedTx) GetSourceChainAssetsLockedEvents() []SourceChainAssetsLockedEvents {
+	if m != nil {
+		return m.SourceChainAssetsLockedEvents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InjectedTx)(nil), "mezo.bridge.v1.InjectedTx")
 }
@@ -92,24 +105,27 @@ func init() {
 func init() { proto.RegisterFile("mezo/bridge/v1/proposal.proto", fileDescriptor_1ef15b170ac62f07) }
 
 var fileDescriptor_1ef15b170ac62f07 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x4d, 0xad, 0xca,
-	0xd7, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xc8,
-	0x2f, 0x4e, 0xcc, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0x49, 0xeb, 0x41, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95,
-	0x94, 0x34, 0x9a, 0x21, 0x50, 0xf5, 0x60, 0x49, 0xa5, 0x99, 0x8c, 0x5c, 0x5c, 0x9e, 0x79, 0x59,
-	0xa9, 0xc9, 0x25, 0xa9, 0x29, 0x21, 0x15, 0x42, 0x91, 0x5c, 0x22, 0x89, 0xc5, 0xc5, 0xa9, 0x25,
-	0xc5, 0xf1, 0x39, 0xf9, 0xc9, 0xd9, 0xa9, 0x29, 0xf1, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0xc5, 0x12,
-	0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x8a, 0x7a, 0xa8, 0x16, 0xea, 0x39, 0x82, 0xd5, 0xfa, 0x80,
-	0x95, 0xba, 0x82, 0x54, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x24, 0x94, 0x88, 0x2e, 0x51,
-	0x2c, 0x64, 0xc0, 0x25, 0x92, 0x5a, 0x51, 0x92, 0x9a, 0x97, 0x92, 0x9a, 0x12, 0x9f, 0x9c, 0x9f,
-	0x9b, 0x9b, 0x59, 0x12, 0x9f, 0x99, 0x97, 0x96, 0x2f, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x13, 0x24,
-	0x04, 0x93, 0x73, 0x06, 0x4b, 0x79, 0xe6, 0xa5, 0xe5, 0x3b, 0xb9, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xc8, 0x49, 0xba, 0xf9, 0x45, 0xe9, 0x60, 0x46, 0x8a, 0x7e, 0x05, 0xcc, 0xa7, 0x89,
-	0x49, 0xc9, 0x99, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbf, 0x1a, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x16, 0x3b, 0x7c, 0xe2, 0x4f, 0x01, 0x00, 0x00,
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0x80, 0x5b, 0x30, 0x0e, 0xd5, 0x38, 0x34, 0x0c, 0x04, 0x43, 0x05, 0x5d, 0x58, 0xe8, 0x89,
+	0xfe, 0x02, 0x25, 0xc6, 0x90, 0x38, 0xa1, 0x8b, 0x2e, 0x97, 0xf6, 0xee, 0x51, 0x4e, 0xe9, 0xbd,
+	0xa6, 0x77, 0x34, 0x68, 0xe2, 0x7f, 0x70, 0xf4, 0x27, 0x31, 0x32, 0x3a, 0x19, 0x43, 0xff, 0x88,
+	0xe9, 0x15, 0x06, 0xab, 0x71, 0xbb, 0xe4, 0xfb, 0xde, 0xbd, 0x2f, 0xcf, 0x69, 0xc7, 0xf0, 0x82,
+	0x24, 0x4c, 0x05, 0x8f, 0x80, 0x64, 0x03, 0x92, 0xa4, 0x98, 0xa0, 0x0a, 0x66, 0x7e, 0x92, 0xa2,
+	0x46, 0xf7, 0xa0, 0xc0, 0x7e, 0x89, 0xfd, 0x6c, 0xd0, 0x6a, 0x44, 0x18, 0xa1, 0x41, 0xa4, 0x78,
+	0x95, 0x56, 0xeb, 0xb0, 0xf2, 0xc9, 0xc6, 0x2f, 0xe1, 0x49, 0x05, 0x66, 0xa8, 0x81, 0xc2, 0x42,
+	0x83, 0x54, 0x02, 0x65, 0x29, 0x1d, 0xbf, 0xd7, 0x1c, 0x67, 0x24, 0x1f, 0x81, 0x69, 0xe0, 0x77,
+	0x0b, 0xf7, 0xde, 0x69, 0x04, 0x4a, 0x81, 0x56, 0x74, 0x86, 0xec, 0x09, 0x38, 0x85, 0x0c, 0xa4,
+	0x56, 0x4d, 0xbb, 0x53, 0xef, 0xed, 0x9d, 0x75, 0xfd, 0x9f, 0x55, 0xfe, 0x85, 0x71, 0x6f, 0x8c,
+	0x7a, 0x55, 0x98, 0x97, 0x3b, 0xcb, 0xcf, 0x23, 0x6b, 0xec, 0x06, 0x55, 0xa0, 0xdc, 0x53, 0xa7,
+	0x61, 0x96, 0x73, 0xe0, 0x94, 0x61, 0x1c, 0x0b, 0x4d, 0x85, 0x9c, 0x60, 0xb3, 0xd6, 0xb1, 0x7b,
+	0xfb, 0x63, 0x77, 0xcb, 0x86, 0x06, 0x8d, 0xe4, 0x04, 0xdd, 0x57, 0xa7, 0xab, 0x70, 0x9e, 0x32,
+	0xa0, 0x6c, 0x1a, 0x08, 0x49, 0xff, 0x2c, 0xab, 0x9b, 0xb2, 0x7e, 0xb5, 0xec, 0xd6, 0x0c, 0x0e,
+	0x8b, 0xb9, 0x5f, 0x91, 0x6a, 0x53, 0xd9, 0x56, 0xff, 0x4a, 0xd7, 0xcb, 0xb5, 0x67, 0xaf, 0xd6,
+	0x9e, 0xfd, 0xb5, 0xf6, 0xec, 0xb7, 0xdc, 0xb3, 0x56, 0xb9, 0x67, 0x7d, 0xe4, 0x9e, 0xf5, 0xd0,
+	0x8f, 0x84, 0x9e, 0xce, 0x43, 0x9f, 0x61, 0x4c, 0x8a, 0xbd, 0x7d, 0x4c, 0x23, 0xf3, 0xe0, 0x64,
+	0xb1, 0x3d, 0x78, 0x10, 0x32, 0x41, 0xf4, 0x73, 0x02, 0x2a, 0xdc, 0x35, 0xa7, 0x3e, 0xff, 0x1e,
+	0x00, 0xce, 0xbe, 0x00, 0x00, 0xf3, 0x01, 0x00, 0x00,
 }
 
 func (m *InjectedTx) Marshal() (dAtA []byte, err error) {
@@ -132,6 +148,20 @@ func (m *InjectedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for iNdEx := len(m.SourceChainAssetsLockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainAssetsLockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ExtendedCommitInfo) > 0 {
 		i -= len(m.ExtendedCommitInfo)
 		copy(dAtA[i:], m.ExtendedCommitInfo)
@@ -183,6 +213,12 @@ func (m *InjectedTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProposal(uint64(l))
 	}
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for _, e := range m.SourceChainAssetsLockedEvents {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -289,6 +325,40 @@ func (m *InjectedTx) Unmarshal(dAtA []byte) error {
 				m.ExtendedCommitInfo = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainAssetsLockedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChainAssetsLockedEvents = append(m.SourceChainAssetsLockedEvents, SourceChainAssetsLockedEvents{})
+			if err := m.SourceChainAssetsLockedEvents[len(m.SourceChainAssetsLockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -29,6 +29,10 @@ type VoteExtension struct {
 	// assets_locked_events is a list of AssetsLockedEvent that are part of the
 	// vote extension.
 	AssetsLockedEvents []types.AssetsLockedEvent `protobuf:"bytes,1,rep,name=assets_locked_events,json=assetsLockedEvents,proto3" json:"assets_locked_events"`
+	// source_chain_assets_locked_events are the AssetsLocked events observed on
+	// the additional source chains, with one section per source chain, ordered
+	// by the chain identifier.
+	SourceChainAssetsLockedEvents []SourceChainAssetsLockedEvents `protobuf:"bytes,2,rep,name=source_chain_assets_locked_events,json=sourceChainAssetsLockedEvents,proto3" json:"source_chain_assets_locked_events"`
 }
 
 func (m *VoteExtension) Reset()         { *m = VoteExtension{} }
@@ -71,8 +75,73 @@ func (m *VoteExtension) GetAssetsLockedEvents() []types.AssetsLockedEvent {
 	return nil
 }
 
+func (m *VoteExtension) GetSourceChainAssetsLockedEvents() []SourceChainAssetsLockedEvents {
+	if m != nil {
+		return m.SourceChainAssetsLockedEvents
+	}
+	return nil
+}
+
+// SourceChainAssetsLockedEvents defines the AssetsLocked events of a single
+// additional source chain.
+type SourceChainAssetsLockedEvents struct {
+	// chain is the identifier of the source chain.
+	Chain uint32 `protobuf:"varint,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// events is a list of AssetsLockedEvent of the source chain, forming
+	// a sequence strictly increasing by 1.
+	Events []types.AssetsLockedEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+}
+
+func (m *SourceChainAssetsLockedEvents) Reset()         { *m = SourceChainAssetsLockedEvents{} }
+func (m *SourceChainAssetsLockedEvents) String() string { return proto.CompactTextString(m) }
+func (*SourceChainAssetsLockedEvents) ProtoMessage()    {}
+func (*SourceChainAssetsLockedEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92f77ea72398eb8e, []int{1}
+}
+func (m *SourceChainAssetsLockedEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceChainAssetsLockedEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceChainAssetsLockedEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceChainAssetsLockedEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChainAssetsLockedEvents.Merge(m, src)
+}
+func (m *SourceChainAssetsLockedEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceChainAssetsLockedEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChainAssetsLockedEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceChainAssetsLockedEvents proto.InternalMessageInfo
+
+func (m *SourceChainAssetsLockedEvents) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
+	}
+	return 0
+}
+
+func (m *SourceChainAssetsLockedEvents) GetEvents() []types.AssetsLockedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VoteExtension)(nil), "mezo.bridge.v1.VoteExtension")
+	proto.RegisterType((*SourceChainAssetsLockedEvents)(nil), "mezo.bridge.v1.SourceChainAssetsLockedEvents")
 }
 
 func init() {
@@ -80,22 +149,26 @@ func init() {
 }
 
 var fileDescriptor_92f77ea72398eb8e = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
+	// 301 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4d, 0xad, 0xca,
 	0xd7, 0x4f, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xcb, 0x2f, 0x49, 0x8d,
 	0x4f, 0xad, 0x28, 0x49, 0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0xe2, 0x03, 0x29, 0xd2, 0x83, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x4b, 0xe9, 0x83, 0x58, 0x10, 0x55, 0x52, 0xd2, 0x68, 0x46, 0x41, 0xd5, 0x83, 0x25, 0x95, 0xb2,
-	0xb8, 0x78, 0xc3, 0xf2, 0x4b, 0x52, 0x5d, 0x61, 0x26, 0x0b, 0x45, 0x72, 0x89, 0x24, 0x16, 0x17,
-	0xa7, 0x96, 0x14, 0xc7, 0xe7, 0xe4, 0x27, 0x67, 0xa7, 0xa6, 0xc4, 0xa7, 0x96, 0xa5, 0xe6, 0x95,
-	0x14, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x29, 0xea, 0xa1, 0x5a, 0xa9, 0xe7, 0x08, 0x56,
-	0xeb, 0x03, 0x56, 0xea, 0x0a, 0x52, 0xe9, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x90, 0x50, 0x22,
-	0xba, 0x44, 0xb1, 0x93, 0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x2c, 0xd0, 0xcd, 0x2f,
-	0x4a, 0x07, 0x33, 0x52, 0xf4, 0x2b, 0x60, 0x2e, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x89, 0x5c, 0x6c,
-	0x44, 0x25, 0x01, 0x00, 0x00,
+	0x4b, 0xe9, 0x83, 0x58, 0x10, 0x55, 0x52, 0xd2, 0x68, 0x46, 0x41, 0xd5, 0x83, 0x25, 0x95, 0x5e,
+	0x32, 0x72, 0xf1, 0x86, 0xe5, 0x97, 0xa4, 0xba, 0xc2, 0x8c, 0x16, 0x8a, 0xe4, 0x12, 0x49, 0x2c,
+	0x2e, 0x4e, 0x2d, 0x29, 0x8e, 0xcf, 0xc9, 0x4f, 0xce, 0x4e, 0x4d, 0x89, 0x4f, 0x2d, 0x4b, 0xcd,
+	0x2b, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd4, 0x43, 0xb5, 0x53, 0xcf, 0x11,
+	0xac, 0xd6, 0x07, 0xac, 0xd4, 0x15, 0xa4, 0xd2, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa1,
+	0x44, 0x74, 0x89, 0x62, 0xa1, 0x5a, 0x2e, 0xc5, 0xe2, 0xfc, 0xd2, 0xa2, 0xe4, 0xd4, 0xf8, 0xe4,
+	0x8c, 0xc4, 0xcc, 0xbc, 0x78, 0xac, 0xf6, 0x30, 0x81, 0xed, 0xd1, 0x45, 0xb7, 0x27, 0x18, 0xac,
+	0xd1, 0x19, 0xa4, 0x0f, 0xc3, 0xca, 0x62, 0xa8, 0x9d, 0xb2, 0xc5, 0xf8, 0x14, 0x29, 0x95, 0x71,
+	0xc9, 0xe2, 0x35, 0x45, 0x48, 0x84, 0x8b, 0x15, 0xec, 0x30, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xde,
+	0x20, 0x08, 0x47, 0xc8, 0x9e, 0x8b, 0x0d, 0xc5, 0x69, 0x44, 0x07, 0x01, 0x54, 0x9b, 0x93, 0xfb,
+	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94,
+	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x0c, 0xd5, 0xcd, 0x2f, 0x4a, 0x07, 0x33, 0x52, 0xf4,
+	0x2b, 0x60, 0x31, 0x96, 0x98, 0x94, 0x9c, 0xa9, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
+	0x8e, 0x33, 0x63, 0xc0, 0x00, 0xaa, 0x80, 0xa0, 0xaa, 0x1d, 0x02, 0x00, 0x00,
 }
 
 func (m *VoteExtension) Marshal() (dAtA []byte, err error) {
@@ -118,6 +191,20 @@ func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for iNdEx := len(m.SourceChainAssetsLockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainAssetsLockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AssetsLockedEvents) > 0 {
 		for iNdEx := len(m.AssetsLockedEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -135,6 +222,48 @@ func (m *VoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceChainAssetsLockedEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChainAssetsLockedEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChainAssetsLockedEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVoteExtension(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Chain != 0 {
+		i = encodeVarintVoteExtension(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVoteExtension(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVoteExtension(v)
 	base := offset
@@ -158,6 +287,30 @@ func (m *VoteExtension) Size() (n int) {
 			n += 1 + l + sovVoteExtension(uint64(l))
 		}
 	}
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for _, e := range m.SourceChainAssetsLockedEvents {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceChainAssetsLockedEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovVoteExtension(uint64(m.Chain))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovVoteExtension(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -230,6 +383,143 @@ func (m *VoteExtension) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainAssetsLockedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChainAssetsLockedEvents = append(m.SourceChainAssetsLockedEvents, SourceChainAssetsLockedEvents{})
+			if err := m.SourceChainAssetsLockedEvents[len(m.SourceChainAssetsLockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVoteExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChainAssetsLockedEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVoteExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChainAssetsLockedEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChainAssetsLockedEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVoteExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVoteExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types.AssetsLockedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVoteExtension(dAtA[iNdEx:])
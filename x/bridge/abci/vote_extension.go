@@ -2,6 +2,8 @@ package abci
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -20,21 +22,28 @@ const AssetsLockedEventsLimit = 10
 // VoteExtensionHandler is the bridge-specific handler for the ExtendVote and
 // VerifyVoteExtension ABCI requests.
 type VoteExtensionHandler struct {
-	logger        log.Logger
-	sidecarClient EthereumSidecarClient
-	bridgeKeeper  BridgeKeeper
+	logger             log.Logger
+	sidecarClient      EthereumSidecarClient
+	sourceChainClients map[uint32]EthereumSidecarClient
+	bridgeKeeper       BridgeKeeper
 }
 
 // NewVoteExtensionHandler creates a new VoteExtensionHandler instance.
+// The sidecarClient observes Ethereum, the primary source chain, while
+// sourceChainClients observe additional source chains, keyed by the chain
+// identifier. Additional source chains without a client are not observed
+// by this validator.
 func NewVoteExtensionHandler(
 	logger log.Logger,
 	sidecarClient EthereumSidecarClient,
+	sourceChainClients map[uint32]EthereumSidecarClient,
 	bridgeKeeper BridgeKeeper,
 ) *VoteExtensionHandler {
 	return &VoteExtensionHandler{
-		logger:        logger,
-		sidecarClient: sidecarClient,
-		bridgeKeeper:  bridgeKeeper,
+		logger:             logger,
+		sidecarClient:      sidecarClient,
+		sourceChainClients: sourceChainClients,
+		bridgeKeeper:       bridgeKeeper,
 	}
 }
 
@@ -46,6 +55,12 @@ func NewVoteExtensionHandler(
 // It is guaranteed that the number of events included in the vote extension
 // will not exceed AssetsLockedEventsLimit.
 //
+// The same is done for each registered additional source chain having
+// a sidecar client configured, using the sequence tip of the given chain.
+// The events of each source chain form a separate section of the vote
+// extension. A source chain whose sidecar fails is left out of the vote
+// extension so it does not prevent bridging from other chains.
+//
 // Dev note: It is fine to return a nil response and an error from this
 // function in case of failure. The upstream app-level vote extension handler
 // will handle the error gracefully and won't include the bridge-specific part
@@ -69,8 +84,8 @@ func (veh *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 		// tip based on the proposal's events, we can avoid fetching the same
 		// events from the sidecar twice and burning vote extension cycles on them.
 		var sequenceTip math.Int
+		var injectedTx types.InjectedTx
 		if len(req.Txs) > 0 && len(req.Txs[0]) > 0 {
-			var injectedTx types.InjectedTx
 			if err := injectedTx.Unmarshal(req.Txs[0]); err != nil {
 				// If the transaction vector and the first tx are not empty, the
 				// first transaction must be the injected bridge-specific
@@ -79,15 +94,17 @@ func (veh *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 				return nil, fmt.Errorf("failed to unmarshal injected tx: %w", err)
 			}
 
-			events := injectedTx.AssetsLockedEvents
-			if len(events) == 0 {
+			if len(injectedTx.AssetsLockedEvents) == 0 &&
+				len(injectedTx.SourceChainAssetsLockedEvents) == 0 {
 				// This should not happen because the proposal phase guarantees
 				// the presence of AssetsLocked events in the injected
 				// bridge-specific pseudo-transaction.
 				return nil, fmt.Errorf("no AssetsLocked events in the injected tx")
 			}
 
-			sequenceTip = events[len(events)-1].Sequence
+			if events := injectedTx.AssetsLockedEvents; len(events) > 0 {
+				sequenceTip = events[len(events)-1].Sequence
+			}
 		}
 
 		// If the sequence tip is not determined from the proposal, fetch it from
@@ -144,10 +161,15 @@ func (veh *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 
 		voteExtension := types.VoteExtension{
 			AssetsLockedEvents: events,
+			SourceChainAssetsLockedEvents: veh.sourceChainAssetsLockedEvents(
+				ctx,
+				req.Height,
+				injectedTx.SourceChainAssetsLockedEvents,
+			),
 		}
-		// Marshal the vote extension into bytes. Note that if len(events) == 0,
-		// the Marshal method will return an empty byte slice so an empty
-		// vote extension part will be returned from this handler.
+		// Marshal the vote extension into bytes. Note that if there are no
+		// events at all, the Marshal method will return an empty byte slice
+		// so an empty vote extension part will be returned from this handler.
 		voteExtensionBytes, err := voteExtension.Marshal()
 		if err != nil {
 			// If marshaling fails, we cannot recover, so return an error.
@@ -169,6 +191,7 @@ func (veh *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 //   - AssetsLocked events are valid (positive sequence number, positive amount,
 //     proper bech32 recipient) and form a sequence strictly increasing by 1
 //   - The number of AssetsLocked events does not exceed the limit
+//   - Source chain sections are valid (see validateSourceChainAssetsLockedEvents)
 //
 // If the vote extension is valid, it is accepted. Empty vote extensions are
 // accepted by default.
@@ -228,7 +251,7 @@ func (veh *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExte
 			}, fmt.Errorf("failed to unmarshal vote extension: %w", err)
 		}
 
-		if err := validateAssetsLockedEvents(voteExtension.AssetsLockedEvents); err != nil {
+		if err := validateVoteExtension(voteExtension); err != nil {
 			return &cmtabci.ResponseVerifyVoteExtension{
 				Status: cmtabci.ResponseVerifyVoteExtension_REJECT,
 			}, err
@@ -246,6 +269,145 @@ func (veh *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExte
 	}
 }
 
+// sourceChainAssetsLockedEvents fetches the AssetsLocked events of all
+// registered additional source chains having a sidecar client configured, in
+// the same way as done for the primary source chain. The sequence tip of each
+// chain is determined from the given sections of the injected tx if present,
+// or from the bridge state otherwise. Chains without new events are left out,
+// and so are chains whose sidecar fails or returns invalid events. The
+// returned sections are ordered by the chain identifier.
+func (veh *VoteExtensionHandler) sourceChainAssetsLockedEvents(
+	ctx sdk.Context,
+	height int64,
+	injectedSections []types.SourceChainAssetsLockedEvents,
+) []types.SourceChainAssetsLockedEvents {
+	chains := slices.Sorted(maps.Keys(veh.sourceChainClients))
+
+	var sections []types.SourceChainAssetsLockedEvents
+
+	for _, chain := range chains {
+		if !veh.bridgeKeeper.IsSourceChainRegistered(ctx, chain) {
+			veh.logger.Debug(
+				"skipping unregistered source chain",
+				"height", height,
+				"source_chain", chain,
+			)
+			continue
+		}
+
+		var sequenceTip math.Int
+		for _, section := range injectedSections {
+			if section.Chain == chain && len(section.Events) > 0 {
+				sequenceTip = section.Events[len(section.Events)-1].Sequence
+			}
+		}
+
+		if sequenceTip.IsNil() {
+			sequenceTip = veh.bridgeKeeper.GetSourceChainAssetsLockedSequenceTip(ctx, chain)
+		}
+
+		sequenceStart := sequenceTip.Add(math.NewInt(1))
+		sequenceEnd := sequenceStart.Add(math.NewInt(AssetsLockedEventsLimit))
+
+		events, err := veh.sourceChainClients[chain].GetAssetsLockedEvents(
+			ctx,
+			sequenceStart,
+			sequenceEnd,
+		)
+		if err != nil {
+			veh.logger.Error(
+				"failed to fetch source chain AssetsLocked events from the sidecar",
+				"height", height,
+				"source_chain", chain,
+				"err", err,
+			)
+			continue
+		}
+
+		if err := validateAssetsLockedEvents(events); err != nil {
+			veh.logger.Error(
+				"sidecar returned invalid source chain AssetsLocked events",
+				"height", height,
+				"source_chain", chain,
+				"err", err,
+			)
+			continue
+		}
+
+		if len(events) == 0 {
+			continue
+		}
+
+		veh.logger.Info(
+			"sidecar returned source chain assets locked events",
+			"height", height,
+			"source_chain", chain,
+			"events_count", len(events),
+		)
+
+		sections = append(sections, types.SourceChainAssetsLockedEvents{
+			Chain:  chain,
+			Events: events,
+		})
+	}
+
+	return sections
+}
+
+// validateVoteExtension validates the AssetsLocked events of the primary
+// source chain and the sections of additional source chains held by the given
+// bridge vote extension. If the validation passes, the function returns nil.
+// Otherwise, it returns an error describing the reason.
+func validateVoteExtension(voteExtension types.VoteExtension) error {
+	if err := validateAssetsLockedEvents(voteExtension.AssetsLockedEvents); err != nil {
+		return err
+	}
+
+	return validateSourceChainAssetsLockedEvents(
+		voteExtension.SourceChainAssetsLockedEvents,
+	)
+}
+
+// validateSourceChainAssetsLockedEvents validates the given sections of
+// additional source chains in the context of the bridge vote extension.
+//
+// The given sections are considered valid if:
+//   - The number of sections does not exceed the MaxSourceChains
+//   - No section uses the primary source chain identifier
+//   - Sections are strictly ordered by the chain identifier
+//   - No section is empty
+//   - The events of each section are valid (see validateAssetsLockedEvents)
+//
+// If the validation passes, the function returns nil. Otherwise, it returns
+// an error describing the reason.
+func validateSourceChainAssetsLockedEvents(
+	sections []types.SourceChainAssetsLockedEvents,
+) error {
+	if len(sections) > bridgetypes.MaxSourceChains {
+		return fmt.Errorf("number of source chains exceeds the limit")
+	}
+
+	for i, section := range sections {
+		if section.Chain == bridgetypes.PrimarySourceChain {
+			return fmt.Errorf("source chain section uses the primary source chain")
+		}
+
+		if i > 0 && section.Chain <= sections[i-1].Chain {
+			return fmt.Errorf("source chain sections are not strictly ordered")
+		}
+
+		if len(section.Events) == 0 {
+			return fmt.Errorf("source chain %d section is empty", section.Chain)
+		}
+
+		if err := validateAssetsLockedEvents(section.Events); err != nil {
+			return fmt.Errorf("source chain %d: %w", section.Chain, err)
+		}
+	}
+
+	return nil
+}
+
 // validateAssetsLockedEvents validates the given list of AssetsLocked events
 // in the context of the bridge vote extension.
 //
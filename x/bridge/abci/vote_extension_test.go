@@ -460,6 +460,7 @@ func (s *VoteExtensionHandlerTestSuite) TestExtendVote() {
 			s.handler = NewVoteExtensionHandler(
 				s.logger,
 				sidecar,
+				nil,
 				s.bridgeKeeper,
 			)
 
@@ -714,6 +715,7 @@ func (s *VoteExtensionHandlerTestSuite) TestVerifyVoteExtension() {
 			s.handler = NewVoteExtensionHandler(
 				s.logger,
 				newMockEthereumSidecarClient(),
+				nil,
 				s.bridgeKeeper,
 			)
 
@@ -747,6 +749,177 @@ func (s *VoteExtensionHandlerTestSuite) TestVerifyVoteExtension() {
 	}
 }
 
+func (s *VoteExtensionHandlerTestSuite) TestExtendVoteSourceChains() {
+	s.bridgeKeeper.On("IsSourceChainRegistered", s.ctx, uint32(1)).Return(true)
+	s.bridgeKeeper.On("IsSourceChainRegistered", s.ctx, uint32(2)).Return(true)
+	s.bridgeKeeper.On("IsSourceChainRegistered", s.ctx, uint32(3)).Return(false)
+	s.bridgeKeeper.On(
+		"GetSourceChainAssetsLockedSequenceTip",
+		s.ctx,
+		uint32(1),
+	).Return(sdkmath.NewInt(10))
+	s.bridgeKeeper.On(
+		"GetSourceChainAssetsLockedSequenceTip",
+		s.ctx,
+		uint32(2),
+	).Return(sdkmath.NewInt(20))
+
+	sidecar := newMockEthereumSidecarClient()
+	sidecar.On(
+		"GetAssetsLockedEvents",
+		s.ctx,
+		sdkmath.NewInt(201),
+		sdkmath.NewInt(211),
+	).Return(nil, nil)
+
+	// Source chain 1 has a pending section in the injected tx so the
+	// sidecar is asked for events after it.
+	sourceChain1 := newMockEthereumSidecarClient()
+	sourceChain1.On(
+		"GetAssetsLockedEvents",
+		s.ctx,
+		sdkmath.NewInt(13),
+		sdkmath.NewInt(23),
+	).Return([]bridgetypes.AssetsLockedEvent{
+		mockEvent(13, recipient1, 1000, token),
+	}, nil)
+
+	// A failing source chain sidecar does not prevent the vote extension.
+	sourceChain2 := newMockEthereumSidecarClient()
+	sourceChain2.On(
+		"GetAssetsLockedEvents",
+		s.ctx,
+		sdkmath.NewInt(21),
+		sdkmath.NewInt(31),
+	).Return(nil, fmt.Errorf("sidecar error"))
+
+	// Source chain 3 is not registered so its sidecar is not used.
+	sourceChain3 := newMockEthereumSidecarClient()
+
+	s.handler = NewVoteExtensionHandler(
+		s.logger,
+		sidecar,
+		map[uint32]EthereumSidecarClient{
+			1: sourceChain1,
+			2: sourceChain2,
+			3: sourceChain3,
+		},
+		s.bridgeKeeper,
+	)
+
+	injectedTx, err := (&types.InjectedTx{
+		SourceChainAssetsLockedEvents: []types.SourceChainAssetsLockedEvents{
+			{
+				Chain: 1,
+				Events: []bridgetypes.AssetsLockedEvent{
+					mockEvent(11, recipient1, 1000, token),
+					mockEvent(12, recipient1, 1000, token),
+				},
+			},
+		},
+	}).Marshal()
+	s.Require().NoError(err)
+
+	res, err := s.handler.ExtendVoteHandler()(s.ctx, &cmtabci.RequestExtendVote{
+		Height: s.requestHeight,
+		Txs:    [][]byte{injectedTx},
+	})
+	s.Require().NoError(err)
+
+	sidecar.AssertExpectations(s.T())
+	sourceChain1.AssertExpectations(s.T())
+	sourceChain2.AssertExpectations(s.T())
+	sourceChain3.AssertNotCalled(s.T(), "GetAssetsLockedEvents")
+
+	var voteExtension types.VoteExtension
+	s.Require().NoError(voteExtension.Unmarshal(res.VoteExtension))
+
+	s.Require().Equal(
+		types.VoteExtension{
+			SourceChainAssetsLockedEvents: []types.SourceChainAssetsLockedEvents{
+				{
+					Chain: 1,
+					Events: []bridgetypes.AssetsLockedEvent{
+						mockEvent(13, recipient1, 1000, token),
+					},
+				},
+			},
+		},
+		voteExtension,
+	)
+}
+
+func (s *VoteExtensionHandlerTestSuite) TestValidateSourceChainAssetsLockedEvents() {
+	section := func(chain uint32, events ...bridgetypes.AssetsLockedEvent) types.SourceChainAssetsLockedEvents {
+		return types.SourceChainAssetsLockedEvents{Chain: chain, Events: events}
+	}
+
+	tests := []struct {
+		name        string
+		sections    []types.SourceChainAssetsLockedEvents
+		errContains string
+	}{
+		{
+			name:     "no sections",
+			sections: nil,
+		},
+		{
+			name: "valid sections",
+			sections: []types.SourceChainAssetsLockedEvents{
+				section(1, mockEvent(1, recipient1, 1000, token)),
+				section(2, mockEvent(5, recipient1, 1000, token), mockEvent(6, recipient1, 1000, token)),
+			},
+		},
+		{
+			name: "primary source chain",
+			sections: []types.SourceChainAssetsLockedEvents{
+				section(bridgetypes.PrimarySourceChain, mockEvent(1, recipient1, 1000, token)),
+			},
+			errContains: "source chain section uses the primary source chain",
+		},
+		{
+			name: "sections not ordered",
+			sections: []types.SourceChainAssetsLockedEvents{
+				section(2, mockEvent(1, recipient1, 1000, token)),
+				section(1, mockEvent(1, recipient1, 1000, token)),
+			},
+			errContains: "source chain sections are not strictly ordered",
+		},
+		{
+			name: "duplicate sections",
+			sections: []types.SourceChainAssetsLockedEvents{
+				section(1, mockEvent(1, recipient1, 1000, token)),
+				section(1, mockEvent(2, recipient1, 1000, token)),
+			},
+			errContains: "source chain sections are not strictly ordered",
+		},
+		{
+			name:        "empty section",
+			sections:    []types.SourceChainAssetsLockedEvents{section(1)},
+			errContains: "source chain 1 section is empty",
+		},
+		{
+			name: "invalid events",
+			sections: []types.SourceChainAssetsLockedEvents{
+				section(1, mockEvent(1, recipient1, 1000, token), mockEvent(3, recipient1, 1000, token)),
+			},
+			errContains: "source chain 1: events list is not valid",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			err := validateSourceChainAssetsLockedEvents(test.sections)
+
+			if len(test.errContains) == 0 {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, test.errContains)
+			}
+		})
+	}
+}
+
 type mockEthereumSidecarClient struct {
 	mock.Mock
 }
@@ -801,3 +974,28 @@ func (mbk *mockBridgeKeeper) IsBridgeInPaused(ctx sdk.Context) bool {
 	args := mbk.Called(ctx)
 	return args.Bool(0)
 }
+
+func (mbk *mockBridgeKeeper) IsSourceChainRegistered(
+	ctx sdk.Context,
+	chain uint32,
+) bool {
+	args := mbk.Called(ctx, chain)
+	return args.Bool(0)
+}
+
+func (mbk *mockBridgeKeeper) GetSourceChainAssetsLockedSequenceTip(
+	ctx sdk.Context,
+	chain uint32,
+) sdkmath.Int {
+	args := mbk.Called(ctx, chain)
+	return args.Get(0).(sdkmath.Int)
+}
+
+func (mbk *mockBridgeKeeper) AcceptSourceChainAssetsLocked(
+	ctx sdk.Context,
+	chain uint32,
+	events bridgetypes.AssetsLockedEvents,
+) error {
+	args := mbk.Called(ctx, chain, events)
+	return args.Error(0)
+}
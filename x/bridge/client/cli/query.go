@@ -3,12 +3,21 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/mezo-org/mezod/x/bridge/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagSequenceStart = "sequence-start"
+	flagSequenceEnd   = "sequence-end"
+	flagSourceChain   = "source-chain"
+)
+
 // NewQueryCmd returns the cli query commands for this module
 func NewQueryCmd() *cobra.Command {
 	// Group poa queries under a subcommand
@@ -22,6 +31,41 @@ func NewQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		NewCmdQueryParams(),
+		NewCmdQueryAssetsLockedSequenceTip(),
+		NewCmdQueryAssetsLockedEvent(),
+		NewCmdQueryAssetsLockedEvents(),
+		NewCmdQueryAssetsUnlockedSequenceTip(),
+		NewCmdQuerySourceBTCToken(),
+		NewCmdQueryERC20TokenMappings(),
+		NewCmdQueryERC20TokenMapping(),
+		NewCmdQueryBTCSupply(),
+		NewCmdQueryERC20Supplies(),
+		NewCmdQueryERC20Supply(),
+		NewCmdQuerySourceChains(),
+		NewCmdQuerySourceChain(),
+		NewCmdQueryOutflowLimits(),
+		NewCmdQueryOutflowCapacity(),
+		NewCmdQueryUSDOutflowCapacity(),
+		NewCmdQueryOutflowPriceFeeds(),
+		NewCmdQuerySenderOutflowLimits(),
+		NewCmdQuerySenderOutflowCapacity(),
+		NewCmdQueryBridgeOutFees(),
+		NewCmdQueryDelayedBridgeOutParams(),
+		NewCmdQueryDelayedBridgeOuts(),
+		NewCmdQueryDelayedBridgeOut(),
+		NewCmdQueryMinBridgeOutAmounts(),
+		NewCmdQueryMinBridgeOutAmountForBitcoinChain(),
+		NewCmdQueryBridgeOutChains(),
+		NewCmdQueryPauseState(),
+		NewCmdQueryTripartyControllers(),
+		NewCmdQueryTripartyBlockDelay(),
+		NewCmdQueryTripartyLimits(),
+		NewCmdQueryTripartyCapacity(),
+		NewCmdQueryTripartySequenceTips(),
+		NewCmdQueryTripartyPendingRequests(),
+		NewCmdQueryTripartyRequest(),
+		NewCmdQueryTripartyControllersBTCMinted(),
+		NewCmdQueryTripartyRequestOutcome(),
 	)
 
 	return queryCmd
@@ -52,3 +96,1251 @@ func NewCmdQueryParams() *cobra.Command {
 		},
 	}
 }
+
+// NewCmdQueryAssetsLockedSequenceTip queries the assets locked sequence tip.
+func NewCmdQueryAssetsLockedSequenceTip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets-locked-sequence-tip",
+		Short: "Query the sequence number of the last processed AssetsLocked event",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.AssetsLockedSequenceTip(
+				cmd.Context(),
+				&types.QueryAssetsLockedSequenceTipRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryAssetsLockedEvent queries a single accepted AssetsLocked event.
+func NewCmdQueryAssetsLockedEvent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "assets-locked-event [sequence]",
+		Short:   "Query an accepted AssetsLocked event by its sequence number",
+		Example: "assets-locked-event 42",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence: %w", err)
+			}
+
+			sourceChain, err := cmd.Flags().GetUint32(flagSourceChain)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.AssetsLockedEvent(
+				cmd.Context(),
+				&types.QueryAssetsLockedEventRequest{
+					Sequence:    sequence,
+					SourceChain: sourceChain,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().Uint32(flagSourceChain, 0, "Source chain of the event (0 for the primary source chain)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryAssetsLockedEvents queries accepted AssetsLocked events from
+// a sequence range.
+func NewCmdQueryAssetsLockedEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets-locked-events",
+		Short: "Query accepted AssetsLocked events from a sequence range",
+		Long: "Query accepted AssetsLocked events from a sequence range. " +
+			"The start is inclusive and the end is exclusive. If omitted, " +
+			"the range starts at the first retained event and ends at the " +
+			"sequence tip.",
+		Example: "assets-locked-events --sequence-start 10 --sequence-end 20",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sourceChain, err := cmd.Flags().GetUint32(flagSourceChain)
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryAssetsLockedEventsRequest{
+				SourceChain: sourceChain,
+			}
+
+			if cmd.Flags().Changed(flagSequenceStart) {
+				start, err := cmd.Flags().GetUint64(flagSequenceStart)
+				if err != nil {
+					return err
+				}
+				request.SequenceStart = math.NewIntFromUint64(start)
+			}
+
+			if cmd.Flags().Changed(flagSequenceEnd) {
+				end, err := cmd.Flags().GetUint64(flagSequenceEnd)
+				if err != nil {
+					return err
+				}
+				request.SequenceEnd = math.NewIntFromUint64(end)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.AssetsLockedEvents(
+				cmd.Context(),
+				request,
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSequenceStart, 0, "Start of the sequence range (inclusive)")
+	cmd.Flags().Uint64(flagSequenceEnd, 0, "End of the sequence range (exclusive)")
+	cmd.Flags().Uint32(flagSourceChain, 0, "Source chain of the events (0 for the primary source chain)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryAssetsUnlockedSequenceTip queries the assets unlocked sequence
+// tip.
+func NewCmdQueryAssetsUnlockedSequenceTip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets-unlocked-sequence-tip",
+		Short: "Query the sequence number of the last AssetsUnlocked event",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.AssetsUnlockedSequenceTip(
+				cmd.Context(),
+				&types.QueryAssetsUnlockedSequenceTipRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQuerySourceBTCToken queries the BTC token address on the source chain.
+func NewCmdQuerySourceBTCToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "source-btc-token",
+		Short: "Query the BTC token address on the source chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SourceBTCToken(
+				cmd.Context(),
+				&types.QuerySourceBTCTokenRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryERC20TokenMappings queries all ERC20 token mappings.
+func NewCmdQueryERC20TokenMappings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-token-mappings",
+		Short: "Query all ERC20 token mappings supported by the bridge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ERC20TokenMappings(
+				cmd.Context(),
+				&types.QueryERC20TokenMappingsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "erc20-token-mappings")
+
+	return cmd
+}
+
+// NewCmdQueryERC20TokenMapping queries a single ERC20 token mapping.
+func NewCmdQueryERC20TokenMapping() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "erc20-token-mapping [source-token]",
+		Short:   "Query the ERC20 token mapping of a source chain token",
+		Example: "erc20-token-mapping 0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ERC20TokenMapping(
+				cmd.Context(),
+				&types.QueryERC20TokenMappingRequest{SourceToken: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryBTCSupply queries the total BTC minted and burnt by the bridge.
+func NewCmdQueryBTCSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-supply",
+		Short: "Query the total BTC minted and burnt by the bridge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.BTCSupply(
+				cmd.Context(),
+				&types.QueryBTCSupplyRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryERC20Supplies queries the supply accounting of all mapped ERC20
+// tokens.
+func NewCmdQueryERC20Supplies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-supplies",
+		Short: "Query the amounts of all mapped ERC20 tokens minted and burnt by the bridge",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ERC20Supplies(
+				cmd.Context(),
+				&types.QueryERC20SuppliesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryERC20Supply queries the supply accounting of a single mapped
+// ERC20 token.
+func NewCmdQueryERC20Supply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "erc20-supply [source-token]",
+		Short:   "Query the amounts of a mapped ERC20 token minted and burnt by the bridge",
+		Example: "erc20-supply 0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ERC20Supply(
+				cmd.Context(),
+				&types.QueryERC20SupplyRequest{SourceToken: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQuerySourceChains queries all additional bridge-in source chains.
+func NewCmdQuerySourceChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "source-chains",
+		Short: "Query the additional bridge-in source chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SourceChains(
+				cmd.Context(),
+				&types.QuerySourceChainsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQuerySourceChain queries a single additional bridge-in source chain.
+func NewCmdQuerySourceChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "source-chain [chain]",
+		Short:   "Query an additional bridge-in source chain",
+		Example: "source-chain 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid chain: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SourceChain(
+				cmd.Context(),
+				&types.QuerySourceChainRequest{Chain: uint32(chain)},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryOutflowLimits queries the outflow state of all limited tokens.
+func NewCmdQueryOutflowLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outflow-limits",
+		Short: "Query the outflow limit, current outflow and capacity of all limited tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.OutflowLimits(
+				cmd.Context(),
+				&types.QueryOutflowLimitsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outflow-limits")
+
+	return cmd
+}
+
+// NewCmdQueryOutflowCapacity queries the outflow state of a single token.
+func NewCmdQueryOutflowCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "outflow-capacity [mezo-token]",
+		Short:   "Query the outflow limit, current outflow and capacity of a Mezo token",
+		Example: "outflow-capacity 0x7b7C000000000000000000000000000000000000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.OutflowCapacity(
+				cmd.Context(),
+				&types.QueryOutflowCapacityRequest{Token: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryUSDOutflowCapacity queries the state of the global USD outflow
+// limit.
+func NewCmdQueryUSDOutflowCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usd-outflow-capacity",
+		Short: "Query the USD outflow limit, current USD outflow and USD capacity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.USDOutflowCapacity(
+				cmd.Context(),
+				&types.QueryUSDOutflowCapacityRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryOutflowPriceFeeds queries the per-token outflow price feeds.
+func NewCmdQueryOutflowPriceFeeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outflow-price-feeds",
+		Short: "Query the oracle price feeds valuing token outflows in USD",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.OutflowPriceFeeds(
+				cmd.Context(),
+				&types.QueryOutflowPriceFeedsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outflow-price-feeds")
+
+	return cmd
+}
+
+// NewCmdQuerySenderOutflowLimits queries the per-sender bridge-out limit
+// parameters and the per-sender outflow limits of tokens.
+func NewCmdQuerySenderOutflowLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sender-outflow-limits",
+		Short: "Query the per-sender bridge-out limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SenderOutflowLimits(
+				cmd.Context(),
+				&types.QuerySenderOutflowLimitsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sender-outflow-limits")
+
+	return cmd
+}
+
+// NewCmdQuerySenderOutflowCapacity queries the remaining per-sender bridge-out
+// capacity of a sender.
+func NewCmdQuerySenderOutflowCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sender-outflow-capacity [sender] [mezo-token]",
+		Short: "Query the remaining per-sender bridge-out capacity of a sender, optionally for a Mezo token",
+		Example: "sender-outflow-capacity 0x40C7b9612B394212394Ea860caCd0E176CA4ae5b " +
+			"0x7b7C000000000000000000000000000000000000",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			request := &types.QuerySenderOutflowCapacityRequest{Sender: args[0]}
+			if len(args) > 1 {
+				request.Token = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SenderOutflowCapacity(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryBridgeOutFees queries the bridge-out fee treasury and the
+// bridge-out fees of tokens and target chains.
+func NewCmdQueryBridgeOutFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-out-fees",
+		Short: "Query the bridge-out fee treasury and fees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.BridgeOutFees(
+				cmd.Context(),
+				&types.QueryBridgeOutFeesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-out-fees")
+
+	return cmd
+}
+
+// NewCmdQueryDelayedBridgeOutParams queries the delay of the delayed
+// bridge-out queue and the delayed bridge-out thresholds of tokens.
+func NewCmdQueryDelayedBridgeOutParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-bridge-out-params",
+		Short: "Query the delayed bridge-out queue delay and thresholds",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.DelayedBridgeOutParams(
+				cmd.Context(),
+				&types.QueryDelayedBridgeOutParamsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delayed-bridge-out-params")
+
+	return cmd
+}
+
+// NewCmdQueryDelayedBridgeOuts queries the bridge-outs held in the delayed
+// bridge-out queue.
+func NewCmdQueryDelayedBridgeOuts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delayed-bridge-outs",
+		Short: "Query the bridge-outs held in the delayed bridge-out queue",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.DelayedBridgeOuts(
+				cmd.Context(),
+				&types.QueryDelayedBridgeOutsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delayed-bridge-outs")
+
+	return cmd
+}
+
+// NewCmdQueryDelayedBridgeOut queries a single bridge-out held in the delayed
+// bridge-out queue by its identifier.
+func NewCmdQueryDelayedBridgeOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delayed-bridge-out [id]",
+		Short:   "Query a bridge-out held in the delayed bridge-out queue by its identifier",
+		Example: "delayed-bridge-out 7",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.DelayedBridgeOut(
+				cmd.Context(),
+				&types.QueryDelayedBridgeOutRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryMinBridgeOutAmounts queries the per-token minimum bridge-out
+// amounts.
+func NewCmdQueryMinBridgeOutAmounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-bridge-out-amounts",
+		Short: "Query the per-token minimum bridge-out amounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.MinBridgeOutAmounts(
+				cmd.Context(),
+				&types.QueryMinBridgeOutAmountsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "min-bridge-out-amounts")
+
+	return cmd
+}
+
+// NewCmdQueryMinBridgeOutAmountForBitcoinChain queries the minimum bridge-out
+// amount for the Bitcoin chain.
+func NewCmdQueryMinBridgeOutAmountForBitcoinChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-bridge-out-amount-for-bitcoin-chain",
+		Short: "Query the minimum bridge-out amount for the Bitcoin chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.MinBridgeOutAmountForBitcoinChain(
+				cmd.Context(),
+				&types.QueryMinBridgeOutAmountForBitcoinChainRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryBridgeOutChains queries the target chains accepting bridge-outs.
+func NewCmdQueryBridgeOutChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-out-chains",
+		Short: "Query the target chains that accept bridge-outs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.BridgeOutChains(
+				cmd.Context(),
+				&types.QueryBridgeOutChainsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryPauseState queries the bridge-in and bridge-out paused flags.
+func NewCmdQueryPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-state",
+		Short: "Query whether bridging in and bridging out are paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.PauseState(
+				cmd.Context(),
+				&types.QueryPauseStateRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartyControllers queries the allowed triparty controllers.
+func NewCmdQueryTripartyControllers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-controllers",
+		Short: "Query the allowed triparty controllers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyControllers(
+				cmd.Context(),
+				&types.QueryTripartyControllersRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "triparty-controllers")
+
+	return cmd
+}
+
+// NewCmdQueryTripartyBlockDelay queries the triparty block delay.
+func NewCmdQueryTripartyBlockDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-block-delay",
+		Short: "Query the number of blocks between triparty request creation and processing",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyBlockDelay(
+				cmd.Context(),
+				&types.QueryTripartyBlockDelayRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartyLimits queries the triparty per-request and window
+// limits.
+func NewCmdQueryTripartyLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-limits",
+		Short: "Query the triparty per-request and window limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyLimits(
+				cmd.Context(),
+				&types.QueryTripartyLimitsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartyCapacity queries the remaining triparty window capacity.
+func NewCmdQueryTripartyCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-capacity",
+		Short: "Query the remaining triparty window capacity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyCapacity(
+				cmd.Context(),
+				&types.QueryTripartyCapacityRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartySequenceTips queries the triparty request and processed
+// sequence tips.
+func NewCmdQueryTripartySequenceTips() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-sequence-tips",
+		Short: "Query the triparty request and processed sequence tips",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartySequenceTips(
+				cmd.Context(),
+				&types.QueryTripartySequenceTipsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartyPendingRequests queries the pending triparty bridge
+// requests.
+func NewCmdQueryTripartyPendingRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-pending-requests",
+		Short: "Query the pending triparty bridge requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyPendingRequests(
+				cmd.Context(),
+				&types.QueryTripartyPendingRequestsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "triparty-pending-requests")
+
+	return cmd
+}
+
+// NewCmdQueryTripartyRequest queries a single pending triparty bridge request.
+func NewCmdQueryTripartyRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "triparty-request [sequence]",
+		Short:   "Query a pending triparty bridge request by its sequence number",
+		Example: "triparty-request 42",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyRequest(
+				cmd.Context(),
+				&types.QueryTripartyRequestRequest{Sequence: sequence},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdQueryTripartyControllersBTCMinted queries the BTC minted through the
+// triparty bridge path per controller.
+func NewCmdQueryTripartyControllersBTCMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triparty-controllers-btc-minted",
+		Short: "Query the BTC minted through the triparty bridge path per controller",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyControllersBTCMinted(
+				cmd.Context(),
+				&types.QueryTripartyControllersBTCMintedRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "triparty-controllers-btc-minted")
+
+	return cmd
+}
+
+// NewCmdQueryTripartyRequestOutcome queries the outcome record of a processed
+// triparty bridge request.
+func NewCmdQueryTripartyRequestOutcome() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "triparty-request-outcome [sequence]",
+		Short:   "Query the outcome of a processed triparty bridge request by its sequence number",
+		Example: "triparty-request-outcome 42",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TripartyRequestOutcome(
+				cmd.Context(),
+				&types.QueryTripartyRequestOutcomeRequest{Sequence: sequence},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
 
@@ -12,7 +13,9 @@ import (
 // Each assertion will ensure that the mezo state is in sync with its
 // understanding of the state of the bridge at the time.
 // In case an assertion prove false, the function will panic, leaving time
-// for the node operators to investigate.
+// for the node operators to investigate. The ERC20 supply check does not
+// panic; it pauses bridge-out of the inconsistent token instead (see
+// checkERC20Supplies).
 func (k *Keeper) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(sdkCtx)
@@ -30,8 +33,20 @@ func (k *Keeper) EndBlock(ctx context.Context) error {
 		}
 	}
 
+	// Checked before releasing delayed bridge-outs so a token found
+	// inconsistent is not bridged out in the same block.
+	if params.Erc20SupplyAssertionEnabled {
+		k.checkERC20Supplies(sdkCtx)
+	}
+
 	k.handleOutflowReset(sdkCtx)
 	k.handleTripartyWindowReset(sdkCtx)
+	k.releaseDelayedBridgeOuts(sdkCtx)
+	k.activatePendingERC20TokenMappings(sdkCtx)
+	k.pruneAssetsLockedEvents(sdkCtx)
+	k.pruneSenderOutflows(sdkCtx)
+	k.pruneTripartyBridgeRequestOutcomes(sdkCtx)
+	k.pruneExhaustedTripartyCallbacks(sdkCtx)
 
 	return nil
 }
@@ -87,6 +102,7 @@ func (k *Keeper) handleOutflowReset(ctx sdk.Context) {
 	if currentHeight-lastResetHeight >= OutflowResetBlocks {
 		k.resetAllOutflows(ctx)
 		k.setLastOutflowReset(ctx, currentHeight)
+		k.emitEvent(ctx, &types.EventOutflowReset{Height: currentHeight})
 		k.Logger(ctx).Info(
 			"bridge outflow limits reset",
 			"height", currentHeight,
@@ -104,6 +120,7 @@ func (k *Keeper) handleTripartyWindowReset(ctx sdk.Context) {
 
 	if currentHeight-lastResetHeight >= TripartyWindowResetBlocks {
 		k.resetTripartyWindowConsumed(ctx)
+		k.emitEvent(ctx, &types.EventTripartyWindowReset{Height: currentHeight})
 		k.Logger(ctx).Info(
 			"triparty window reset",
 			"height", currentHeight,
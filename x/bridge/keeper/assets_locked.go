@@ -13,6 +13,10 @@ import (
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
 
+// maxAssetsLockedEventsPrunedPerBlock is the maximum number of AssetsLocked
+// events pruned from the store in a single block.
+const maxAssetsLockedEventsPrunedPerBlock = 100
+
 // GetAssetsLockedSequenceTip returns the current sequence tip for the
 // AssetsLocked events. The tip denotes the sequence number of the last event
 // processed by the x/bridge module.
@@ -45,6 +49,151 @@ func (k Keeper) setAssetsLockedSequenceTip(
 	ctx.KVStore(k.storeKey).Set(types.AssetsLockedSequenceTipKey, bz)
 }
 
+// GetAssetsLockedPrunedSequenceTip returns the sequence number of the last
+// AssetsLocked event that is not retained in the store, either because it
+// was pruned or because it was accepted before the module started persisting
+// AssetsLocked events. All events with greater sequence numbers, up to the
+// AssetsLocked sequence tip, are retained.
+func (k Keeper) GetAssetsLockedPrunedSequenceTip(ctx sdk.Context) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.AssetsLockedPrunedSequenceTipKey)
+	if len(bz) == 0 {
+		// No event has been persisted yet so none of the events accepted
+		// so far is retained.
+		return k.GetAssetsLockedSequenceTip(ctx)
+	}
+
+	prunedSequenceTip := math.ZeroInt()
+	if err := prunedSequenceTip.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return prunedSequenceTip
+}
+
+// setAssetsLockedPrunedSequenceTip sets the sequence number of the last
+// AssetsLocked event that is not retained in the store.
+func (k Keeper) setAssetsLockedPrunedSequenceTip(
+	ctx sdk.Context,
+	prunedSequenceTip math.Int,
+) {
+	bz, err := prunedSequenceTip.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.AssetsLockedPrunedSequenceTipKey, bz)
+}
+
+func (k Keeper) saveAssetsLocked(
+	ctx sdk.Context,
+	record *types.AssetsLockedRecord,
+) {
+	bz, err := record.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		assetsLockedKey(record.SourceChain, record.Event.Sequence),
+		bz,
+	)
+}
+
+// assetsLockedKey gets the key for an accepted AssetsLocked event of the
+// given source chain.
+func assetsLockedKey(sourceChain uint32, sequence math.Int) []byte {
+	if sourceChain == types.PrimarySourceChain {
+		return types.GetAssetsLockedKey(sequence)
+	}
+
+	return types.GetSourceChainAssetsLockedKey(sourceChain, sequence)
+}
+
+// GetAssetsLocked gets the accepted AssetsLocked event of the primary source
+// chain for the given sequence number. The returned boolean value indicates
+// whether the record was found in the store. Records of pruned events are
+// not found.
+func (k Keeper) GetAssetsLocked(
+	ctx sdk.Context,
+	sequence math.Int,
+) (
+	*types.AssetsLockedRecord,
+	bool,
+) {
+	return k.getAssetsLocked(ctx, types.PrimarySourceChain, sequence)
+}
+
+// getAssetsLocked gets the accepted AssetsLocked event of the given source
+// chain for the given sequence number.
+func (k Keeper) getAssetsLocked(
+	ctx sdk.Context,
+	sourceChain uint32,
+	sequence math.Int,
+) (
+	*types.AssetsLockedRecord,
+	bool,
+) {
+	bz := ctx.KVStore(k.storeKey).Get(assetsLockedKey(sourceChain, sequence))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var record types.AssetsLockedRecord
+	err := record.Unmarshal(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return &record, true
+}
+
+// GetAllAssetsLocked returns all accepted AssetsLocked events of the primary
+// source chain retained in the store, ordered by their sequence numbers.
+func (k Keeper) GetAllAssetsLocked(ctx sdk.Context) []*types.AssetsLockedRecord {
+	return k.getAllAssetsLocked(ctx, types.PrimarySourceChain)
+}
+
+// getAllAssetsLocked returns all accepted AssetsLocked events of the given
+// source chain retained in the store, ordered by their sequence numbers.
+func (k Keeper) getAllAssetsLocked(
+	ctx sdk.Context,
+	sourceChain uint32,
+) []*types.AssetsLockedRecord {
+	var records []*types.AssetsLockedRecord
+
+	sequenceTip, prunedSequenceTip := k.getAssetsLockedSequenceTips(ctx, sourceChain)
+
+	for seq := prunedSequenceTip.AddRaw(1); seq.LTE(sequenceTip); seq = seq.AddRaw(1) {
+		record, found := k.getAssetsLocked(ctx, sourceChain, seq)
+		if !found {
+			panic(fmt.Sprintf(
+				"missing retained AssetsLocked event %s of source chain %d",
+				seq,
+				sourceChain,
+			))
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// getAssetsLockedSequenceTips returns the AssetsLocked sequence tip and
+// pruned sequence tip of the given source chain.
+func (k Keeper) getAssetsLockedSequenceTips(
+	ctx sdk.Context,
+	sourceChain uint32,
+) (sequenceTip, prunedSequenceTip math.Int) {
+	if sourceChain == types.PrimarySourceChain {
+		return k.GetAssetsLockedSequenceTip(ctx),
+			k.GetAssetsLockedPrunedSequenceTip(ctx)
+	}
+
+	return k.GetSourceChainAssetsLockedSequenceTip(ctx, sourceChain),
+		k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, sourceChain)
+}
+
 // AcceptAssetsLocked processes the given AssetsLocked events sequence by minting
 // the corresponding amount of coins for each event and sending them to the
 // recipient address.
@@ -63,7 +212,8 @@ func (k Keeper) setAssetsLockedSequenceTip(
 //
 // If all requirements are met and x/bank interactions are all successful, the
 // current sequence tip in the state is updated to the sequence number of the
-// last event in the slice.
+// last event in the slice. Each event is persisted along with the current
+// block height, including events that were skipped without minting.
 func (k Keeper) AcceptAssetsLocked(
 	ctx sdk.Context,
 	events types.AssetsLockedEvents,
@@ -86,8 +236,45 @@ func (k Keeper) AcceptAssetsLocked(
 		)
 	}
 
-	sourceBTCToken := k.GetSourceBTCToken(ctx)
+	// Pin the pruned sequence tip before persisting the first event. Events
+	// accepted before the module started persisting them are not retained.
+	if !ctx.KVStore(k.storeKey).Has(types.AssetsLockedPrunedSequenceTipKey) {
+		k.setAssetsLockedPrunedSequenceTip(ctx, currentSequenceTip)
+	}
+
+	err := k.mintAssetsLocked(
+		ctx,
+		types.PrimarySourceChain,
+		events,
+		k.GetSourceBTCToken(ctx),
+		func(sourceToken []byte) (*types.ERC20TokenMapping, bool) {
+			return k.GetERC20TokenMapping(ctx, sourceToken)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	k.setAssetsLockedSequenceTip(ctx, events[len(events)-1].Sequence)
 
+	return nil
+}
+
+// mintAssetsLocked mints the assets of the given AssetsLocked events of the
+// given source chain and records each event. Events carrying the given source
+// BTC token mint BTC; other events mint the Mezo ERC20 token resolved by the
+// given mapping lookup. Amounts minted for an additional source chain are
+// tracked per Mezo token so bridge-outs can be capped to the liquidity of the
+// primary source chain (see checkPrimarySourceChainLiquidity). The caller is
+// responsible for validating the events against the sequence tip of the
+// source chain.
+func (k Keeper) mintAssetsLocked(
+	ctx sdk.Context,
+	sourceChain uint32,
+	events types.AssetsLockedEvents,
+	sourceBTCToken []byte,
+	getERC20TokenMapping func(sourceToken []byte) (*types.ERC20TokenMapping, bool),
+) error {
 	for _, event := range events {
 		recipient, err := sdk.AccAddressFromBech32(event.Recipient)
 		if err != nil {
@@ -101,9 +288,11 @@ func (k Keeper) AcceptAssetsLocked(
 			ctx.Logger().Warn(
 				"deposit recipient is a blocked address; "+
 					"AssetsLocked event skipped",
+				"sourceChain", sourceChain,
 				"eventSequence", event.Sequence,
 			)
 
+			k.recordAssetsLocked(ctx, sourceChain, event, true)
 			continue
 		}
 
@@ -127,8 +316,15 @@ func (k Keeper) AcceptAssetsLocked(
 					err,
 				)
 			}
+
+			k.increaseSourceChainMinted(
+				ctx,
+				sourceChain,
+				evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress),
+				event.Amount,
+			)
 		} else {
-			mapping, exists := k.GetERC20TokenMapping(ctx, event.TokenBytes())
+			mapping, exists := getERC20TokenMapping(event.TokenBytes())
 			if !exists {
 				// In case of a missing mapping, we skip the event and log a
 				// warning. This is because we cannot mint ERC20 tokens without
@@ -137,8 +333,26 @@ func (k Keeper) AcceptAssetsLocked(
 				ctx.Logger().Warn(
 					"ERC20 mapping for source token not found; "+
 						"AssetsLocked event skipped",
+					"sourceChain", sourceChain,
 					"eventSequence", event.Sequence,
 				)
+				k.recordAssetsLocked(ctx, sourceChain, event, true)
+				continue
+			}
+
+			if !mapping.IsBridgeInEnabled() {
+				// A mapping pending activation, paused for bridge-ins or
+				// deprecated is treated like a missing mapping. NOTE THAT THE
+				// SKIPPED EVENT WON'T BE RE-PROCESSED SO FUNDS REMAIN LOCKED
+				// ON THE SOURCE CHAIN.
+				ctx.Logger().Warn(
+					"ERC20 mapping for source token does not allow bridge-ins; "+
+						"AssetsLocked event skipped",
+					"sourceChain", sourceChain,
+					"eventSequence", event.Sequence,
+					"mappingState", mapping.State.String(),
+				)
+				k.recordAssetsLocked(ctx, sourceChain, event, true)
 				continue
 			}
 
@@ -160,19 +374,140 @@ func (k Keeper) AcceptAssetsLocked(
 				ctx.Logger().Error(
 					"Encountered error while minting ERC20; "+
 						"AssetsLocked event skipped",
+					"sourceChain", sourceChain,
 					"eventSequence", event.Sequence,
 					"error", err,
 				)
+				k.recordAssetsLocked(ctx, sourceChain, event, true)
 				continue
 			}
+
+			k.increaseSourceChainMinted(
+				ctx,
+				sourceChain,
+				mapping.MezoTokenBytes(),
+				event.Amount,
+			)
 		}
-	}
 
-	k.setAssetsLockedSequenceTip(ctx, events[len(events)-1].Sequence)
+		k.recordAssetsLocked(ctx, sourceChain, event, false)
+	}
 
 	return nil
 }
 
+// recordAssetsLocked persists the given AssetsLocked event of the given source
+// chain accepted in the current block and emits the corresponding typed event.
+func (k Keeper) recordAssetsLocked(
+	ctx sdk.Context,
+	sourceChain uint32,
+	event types.AssetsLockedEvent,
+	skipped bool,
+) {
+	k.saveAssetsLocked(ctx, &types.AssetsLockedRecord{
+		Event:       event,
+		BlockHeight: ctx.BlockHeight(),
+		Skipped:     skipped,
+		SourceChain: sourceChain,
+	})
+
+	k.emitEvent(ctx, &types.EventAssetsLocked{
+		Sequence:    event.Sequence,
+		Recipient:   event.Recipient,
+		Token:       event.Token,
+		Amount:      event.Amount,
+		Skipped:     skipped,
+		SourceChain: sourceChain,
+	})
+}
+
+// pruneAssetsLockedEvents removes accepted AssetsLocked events that were
+// included in blocks older than the configured retention period. Events of
+// the primary source chain are pruned first, then events of the additional
+// source chains in identifier order. Events of each chain are pruned in
+// sequence order and at most maxAssetsLockedEventsPrunedPerBlock events are
+// removed in a single call across all chains, to keep the per-block work
+// bounded when the retention period is shortened.
+func (k Keeper) pruneAssetsLockedEvents(ctx sdk.Context) {
+	retention := k.GetParams(ctx).AssetsLockedEventsRetentionBlocks
+	if retention == 0 || retention >= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return
+	}
+
+	// Events included at or below this height are pruned.
+	cutoffHeight := ctx.BlockHeight() - int64(retention) //nolint:gosec
+
+	budget := maxAssetsLockedEventsPrunedPerBlock
+	budget -= k.pruneSourceChainAssetsLockedEvents(
+		ctx,
+		types.PrimarySourceChain,
+		cutoffHeight,
+		budget,
+	)
+
+	for _, chain := range k.GetSourceChains(ctx) {
+		if budget == 0 {
+			break
+		}
+
+		budget -= k.pruneSourceChainAssetsLockedEvents(
+			ctx,
+			chain.Id,
+			cutoffHeight,
+			budget,
+		)
+	}
+}
+
+// pruneSourceChainAssetsLockedEvents removes at most limit accepted
+// AssetsLocked events of the given source chain included at or below the
+// given cutoff height. It returns the number of removed events.
+func (k Keeper) pruneSourceChainAssetsLockedEvents(
+	ctx sdk.Context,
+	sourceChain uint32,
+	cutoffHeight int64,
+	limit int,
+) int {
+	sequenceTip, prunedSequenceTip := k.getAssetsLockedSequenceTips(ctx, sourceChain)
+
+	store := ctx.KVStore(k.storeKey)
+
+	pruned := 0
+	for ; pruned < limit; pruned++ {
+		if prunedSequenceTip.GTE(sequenceTip) {
+			break
+		}
+
+		nextSequence := prunedSequenceTip.AddRaw(1)
+
+		record, found := k.getAssetsLocked(ctx, sourceChain, nextSequence)
+		if !found {
+			panic(fmt.Sprintf(
+				"missing retained AssetsLocked event %s of source chain %d",
+				nextSequence,
+				sourceChain,
+			))
+		}
+
+		if record.BlockHeight > cutoffHeight {
+			break
+		}
+
+		store.Delete(assetsLockedKey(sourceChain, nextSequence))
+		prunedSequenceTip = nextSequence
+	}
+
+	if pruned > 0 {
+		if sourceChain == types.PrimarySourceChain {
+			k.setAssetsLockedPrunedSequenceTip(ctx, prunedSequenceTip)
+		} else {
+			k.setSourceChainAssetsLockedPrunedSequenceTip(ctx, sourceChain, prunedSequenceTip)
+		}
+	}
+
+	return pruned
+}
+
 // mintBTC mints the given amount of BTC to the recipient address, directly
 // in the x/bank module.
 func (k Keeper) mintBTC(
@@ -229,5 +564,7 @@ func (k Keeper) mintERC20(
 		return fmt.Errorf("failed to execute ERC20 mint call: %w", err)
 	}
 
+	k.increaseERC20Minted(ctx, token, amount)
+
 	return nil
 }
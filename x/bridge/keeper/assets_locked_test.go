@@ -338,6 +338,11 @@ func TestAcceptAssetsLocked(t *testing.T) {
 					math.NewInt(11),
 					k.GetAssetsLockedSequenceTip(ctx),
 				)
+
+				// The event should have been persisted as skipped.
+				record, found := k.GetAssetsLocked(ctx, math.NewInt(11))
+				require.True(t, found)
+				require.True(t, record.Skipped)
 			},
 		},
 		{
@@ -380,6 +385,12 @@ func TestAcceptAssetsLocked(t *testing.T) {
 					math.NewInt(11),
 					k.GetAssetsLockedSequenceTip(ctx),
 				)
+
+				// Nothing was minted.
+				require.True(
+					t,
+					k.GetERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1)).IsZero(),
+				)
 			},
 		},
 		{
@@ -482,6 +493,47 @@ func TestAcceptAssetsLocked(t *testing.T) {
 					math.NewInt(14),
 					k.GetAssetsLockedSequenceTip(ctx),
 				)
+
+				// Events accepted before the first persisted one are not
+				// retained.
+				require.EqualValues(
+					t,
+					math.NewInt(10),
+					k.GetAssetsLockedPrunedSequenceTip(ctx),
+				)
+
+				require.Equal(
+					t,
+					[]*types.AssetsLockedRecord{
+						{Event: mockEvent(11, recipient1, 1, testSourceBTCToken), BlockHeight: ctx.BlockHeight()},
+						{Event: mockEvent(12, recipient2, 2, testSourceBTCToken), BlockHeight: ctx.BlockHeight()},
+						{Event: mockEvent(13, recipient1, 3, testSourceERC20Token1), BlockHeight: ctx.BlockHeight()},
+						{Event: mockEvent(14, recipient2, 4, testSourceERC20Token2), BlockHeight: ctx.BlockHeight()},
+					},
+					k.GetAllAssetsLocked(ctx),
+				)
+
+				require.Equal(
+					t,
+					[]*types.EventAssetsLocked{
+						{Sequence: math.NewInt(11), Recipient: recipient1, Token: testSourceBTCToken, Amount: math.NewInt(1)},
+						{Sequence: math.NewInt(12), Recipient: recipient2, Token: testSourceBTCToken, Amount: math.NewInt(2)},
+						{Sequence: math.NewInt(13), Recipient: recipient1, Token: testSourceERC20Token1, Amount: math.NewInt(3)},
+						{Sequence: math.NewInt(14), Recipient: recipient2, Token: testSourceERC20Token2, Amount: math.NewInt(4)},
+					},
+					emittedEvents[*types.EventAssetsLocked](t, ctx),
+				)
+
+				require.Equal(
+					t,
+					math.NewInt(3),
+					k.GetERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1)),
+				)
+				require.Equal(
+					t,
+					math.NewInt(4),
+					k.GetERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token2)),
+				)
 			},
 		},
 	}
@@ -532,6 +584,119 @@ func TestAcceptAssetsLocked(t *testing.T) {
 	}
 }
 
+func TestAcceptAssetsLockedERC20TokenMappingState(t *testing.T) {
+	// Set bech32 prefixes to make the recipient address validation in
+	// AssetsLocked events possible (see AssetsLockedEvent.IsValid).
+	cfg := sdk.GetConfig()
+	config.SetBech32Prefixes(cfg)
+
+	tests := []struct {
+		state   types.ERC20TokenMappingState
+		allowed bool
+	}{
+		{types.ERC20TokenMappingStateActive, true},
+		{types.ERC20TokenMappingStatePendingActivation, false},
+		{types.ERC20TokenMappingStateBridgeInPaused, false},
+		{types.ERC20TokenMappingStateBridgeOutPaused, true},
+		{types.ERC20TokenMappingStatePaused, false},
+		{types.ERC20TokenMappingStateDeprecated, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.state.String(), func(t *testing.T) {
+			ctx, k := mockContext()
+
+			k.evmKeeper.(*mockEvmKeeper).On(
+				"ExecuteContractCall",
+				ctx,
+				mock.Anything,
+			).Return(&evmtypes.MsgEthereumTxResponse{}, nil)
+
+			k.setAssetsLockedSequenceTip(ctx, math.NewInt(10))
+
+			mapping := types.NewERC20TokenMapping(
+				evmtypes.HexAddressToBytes(testSourceERC20Token1),
+				evmtypes.HexAddressToBytes(testMezoERC20Token1),
+			)
+			mapping.State = test.state
+			k.setERC20TokenMapping(ctx, mapping)
+
+			err := k.AcceptAssetsLocked(
+				ctx,
+				types.AssetsLockedEvents{
+					mockEvent(11, recipient1, 1, testSourceERC20Token1),
+				},
+			)
+			require.NoError(t, err)
+
+			// The sequence tip is updated whether the event is minted or
+			// skipped.
+			require.EqualValues(t, math.NewInt(11), k.GetAssetsLockedSequenceTip(ctx))
+
+			record, found := k.GetAssetsLocked(ctx, math.NewInt(11))
+			require.True(t, found)
+			require.Equal(t, !test.allowed, record.Skipped)
+
+			if test.allowed {
+				k.evmKeeper.(*mockEvmKeeper).AssertCalled(
+					t,
+					"ExecuteContractCall",
+					ctx,
+					mock.Anything,
+				)
+			} else {
+				k.evmKeeper.(*mockEvmKeeper).AssertNotCalled(
+					t,
+					"ExecuteContractCall",
+					mock.Anything,
+					mock.Anything,
+				)
+			}
+		})
+	}
+}
+
+func TestPruneAssetsLockedEvents(t *testing.T) {
+	ctx, k := mockContext()
+
+	params := k.GetParams(ctx)
+	params.AssetsLockedEventsRetentionBlocks = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.setAssetsLockedSequenceTip(ctx, math.NewInt(5))
+	k.setAssetsLockedPrunedSequenceTip(ctx, math.NewInt(2))
+
+	// Events 3 and 4 were included at height 10, event 5 at height 20.
+	for sequence, height := range map[int64]int64{3: 10, 4: 10, 5: 20} {
+		k.saveAssetsLocked(ctx, &types.AssetsLockedRecord{
+			Event:       mockEvent(sequence, recipient1, 1, testSourceBTCToken),
+			BlockHeight: height,
+		})
+	}
+
+	// Nothing is old enough to be pruned.
+	k.pruneAssetsLockedEvents(ctx.WithBlockHeight(105))
+	require.EqualValues(t, math.NewInt(2), k.GetAssetsLockedPrunedSequenceTip(ctx))
+
+	// Events included at height 10 fall out of the retention period.
+	k.pruneAssetsLockedEvents(ctx.WithBlockHeight(115))
+	require.EqualValues(t, math.NewInt(4), k.GetAssetsLockedPrunedSequenceTip(ctx))
+
+	_, found := k.GetAssetsLocked(ctx, math.NewInt(3))
+	require.False(t, found)
+	_, found = k.GetAssetsLocked(ctx, math.NewInt(4))
+	require.False(t, found)
+	_, found = k.GetAssetsLocked(ctx, math.NewInt(5))
+	require.True(t, found)
+
+	// Disabling the retention period stops pruning.
+	params.AssetsLockedEventsRetentionBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.pruneAssetsLockedEvents(ctx.WithBlockHeight(1000))
+	require.EqualValues(t, math.NewInt(4), k.GetAssetsLockedPrunedSequenceTip(ctx))
+}
+
 func mockEvent(
 	sequence int64,
 	recipient string,
@@ -100,6 +100,11 @@ func (k Keeper) GetAssetsUnlocked(
 	return &assetsUnlocked, true
 }
 
+// SaveAssetsUnlocked records a bridge-out as an AssetsUnlocked event,
+// consuming the outflow limits of the token and the sender. The bridge-out
+// fee is deducted from the given amount so the event carries the net amount
+// and the fee. The caller is responsible for burning the whole amount from
+// the sender and collecting the fee (see CollectBridgeOutFee).
 func (k Keeper) SaveAssetsUnlocked(
 	ctx sdk.Context,
 	recipient []byte,
@@ -108,18 +113,62 @@ func (k Keeper) SaveAssetsUnlocked(
 	amount math.Int,
 	chain uint8,
 ) (*types.AssetsUnlockedEvent, error) {
+	fee, err := k.bridgeOutFeeAmount(ctx, token, amount, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	netAmount := amount.Sub(fee)
+
+	targetToken, _, err := k.consumeBridgeOut(ctx, token, sender, netAmount, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.recordAssetsUnlocked(
+		ctx,
+		recipient,
+		targetToken,
+		evmtypes.BytesToHexAddress(sender),
+		netAmount,
+		fee,
+		uint32(chain),
+	), nil
+}
+
+// consumeBridgeOut validates a bridge-out against the pause state, the
+// enabled target chains, the outflow limits, the ERC20 token mapping state
+// and the primary source chain liquidity, then consumes the outflow limits. It returns the hex-encoded address
+// of the token on the target chain and the USD value added to the USD
+// outflow.
+func (k Keeper) consumeBridgeOut(
+	ctx sdk.Context,
+	token []byte,
+	sender []byte,
+	amount math.Int,
+	chain uint8,
+) (string, math.Int, error) {
 	// This is the single point covering BTC and ERC20 bridge-outs to both
 	// target chains.
 	if k.IsBridgeOutPaused(ctx) {
-		return nil, types.ErrBridgeOutPaused
+		return "", math.Int{}, types.ErrBridgeOutPaused
 	}
 
 	if !k.IsBridgeOutChainEnabled(ctx, chain) {
-		return nil, types.ErrBridgeOutChainNotEnabled
+		return "", math.Int{}, types.ErrBridgeOutChainNotEnabled
 	}
 
 	if err := k.checkOutflowLimit(ctx, token, amount); err != nil {
-		return nil, fmt.Errorf("outflow limit check error: [%w]", err)
+		return "", math.Int{}, fmt.Errorf("outflow limit check error: [%w]", err)
+	}
+
+	usdValue, err := k.checkUSDOutflowLimit(ctx, token, amount)
+	if err != nil {
+		return "", math.Int{}, fmt.Errorf("USD outflow limit check error: [%w]", err)
+	}
+
+	if err := k.checkSenderOutflowLimit(ctx, sender, token, amount); err != nil {
+		return "", math.Int{}, fmt.Errorf("sender outflow limit check error: [%w]", err)
 	}
 
 	var targetToken string
@@ -131,6 +180,14 @@ func (k Keeper) SaveAssetsUnlocked(
 		targetToken = evmtypes.BytesToHexAddress(k.GetSourceBTCToken(ctx))
 	} else {
 		if mapping, ok := k.GetERC20TokenMappingFromMezoToken(ctx, token); ok {
+			if !mapping.IsBridgeOutEnabled() {
+				return "", math.Int{}, fmt.Errorf(
+					"%w: mapping state %s",
+					types.ErrTokenBridgeOutDisabled,
+					mapping.State,
+				)
+			}
+
 			targetToken = evmtypes.BytesToHexAddress(
 				mapping.SourceTokenBytes(),
 			)
@@ -138,11 +195,33 @@ func (k Keeper) SaveAssetsUnlocked(
 	}
 
 	if len(targetToken) == 0 {
-		return nil, fmt.Errorf("unknown token %v", hex.EncodeToString(token))
+		return "", math.Int{}, fmt.Errorf("unknown token %v", hex.EncodeToString(token))
 	}
 
-	senderAddress := evmtypes.BytesToHexAddress(sender)
+	if err := k.checkPrimarySourceChainLiquidity(ctx, token, amount); err != nil {
+		return "", math.Int{}, err
+	}
 
+	k.increaseCurrentOutflow(ctx, token, amount)
+	if usdValue.IsPositive() {
+		k.increaseCurrentUSDOutflow(ctx, usdValue)
+	}
+	k.increaseSenderOutflow(ctx, sender, token, amount)
+
+	return targetToken, usdValue, nil
+}
+
+// recordAssetsUnlocked assigns the next unlock sequence to a bridge-out and
+// saves it as an AssetsUnlocked event.
+func (k Keeper) recordAssetsUnlocked(
+	ctx sdk.Context,
+	recipient []byte,
+	targetToken string,
+	sender string,
+	amount math.Int,
+	fee math.Int,
+	chain uint32,
+) *types.AssetsUnlockedEvent {
 	// calculate the next unlock sequence
 	nextSequence := k.GetAssetsUnlockedSequenceTip(ctx).AddRaw(1)
 
@@ -159,16 +238,25 @@ func (k Keeper) SaveAssetsUnlocked(
 		UnlockSequence: nextSequence,
 		Recipient:      recipient,
 		Token:          targetToken,
-		Sender:         senderAddress,
+		Sender:         sender,
 		Amount:         amount,
-		Chain:          uint32(chain),
+		Chain:          chain,
 		BlockTime:      blockTime,
+		Fee:            fee,
 	}
 	k.saveAssetsUnlocked(ctx, assetsUnlocked)
 
-	k.increaseCurrentOutflow(ctx, token, amount)
-
-	return assetsUnlocked, nil
+	k.emitEvent(ctx, &types.EventAssetsUnlocked{
+		UnlockSequence: assetsUnlocked.UnlockSequence,
+		Recipient:      assetsUnlocked.Recipient,
+		Token:          assetsUnlocked.Token,
+		Sender:         assetsUnlocked.Sender,
+		Amount:         assetsUnlocked.Amount,
+		Chain:          assetsUnlocked.Chain,
+		Fee:            assetsUnlocked.Fee,
+	})
+
+	return assetsUnlocked
 }
 
 func (k Keeper) BurnBTC(
@@ -221,6 +309,8 @@ func (k Keeper) BurnERC20(
 		return nil, fmt.Errorf("failed to execute ERC20 burnFrom call: %w", err)
 	}
 
+	k.increaseERC20Burnt(ctx, token, math.NewIntFromBigInt(amount))
+
 	return changes, nil
 }
 
@@ -283,6 +373,12 @@ func (k Keeper) SetMinBridgeOutAmount(
 	}
 
 	store.Set(types.GetMinBridgeOutAmountKey(mezoToken), bz)
+
+	k.emitEvent(ctx, &types.EventMinBridgeOutAmountSet{
+		Token:  evmtypes.BytesToHexAddress(mezoToken),
+		Amount: minAmount,
+	})
+
 	return nil
 }
 
@@ -313,4 +409,8 @@ func (k Keeper) SetMinBridgeOutAmountForBitcoinChain(
 	}
 
 	store.Set(types.MinBridgeOutAmountForBitcoinChainKey, bz)
+
+	k.emitEvent(ctx, &types.EventMinBridgeOutAmountForBitcoinChainSet{
+		Amount: minAmount,
+	})
 }
@@ -66,6 +66,7 @@ func TestSaveAssetsUnlocked(t *testing.T) {
 				Amount:         math.NewInt(1),
 				Chain:          0,
 				BlockTime:      blockTime,
+				Fee:            math.ZeroInt(),
 			},
 			run: func(ctx sdk.Context, k Keeper) (*types.AssetsUnlockedEvent, error) {
 				token, _ := hex.DecodeString(testMezoERC20Token1[2:])
@@ -86,6 +87,7 @@ func TestSaveAssetsUnlocked(t *testing.T) {
 				Amount:         math.NewInt(1),
 				Chain:          0,
 				BlockTime:      blockTime,
+				Fee:            math.ZeroInt(),
 			},
 			run: func(ctx sdk.Context, k Keeper) (*types.AssetsUnlockedEvent, error) {
 				btcToken := evmtypes.HexAddressToBytes(
@@ -355,6 +357,10 @@ func TestBurnERC20(t *testing.T) {
 				_, err := k.BurnERC20(ctx, token, recipient, big.NewInt(1))
 				return err
 			},
+			postCheckFn: func(ctx sdk.Context, k Keeper, t *testing.T) {
+				token, _ := hex.DecodeString(testSourceERC20Token1[2:])
+				require.Equal(t, math.NewInt(1), k.GetERC20Burnt(ctx, token))
+			},
 		},
 		{
 			name: "burn erc20 failure",
@@ -393,6 +399,10 @@ func TestBurnERC20(t *testing.T) {
 				return err
 			},
 			errContains: "execution reverted",
+			postCheckFn: func(ctx sdk.Context, k Keeper, t *testing.T) {
+				token, _ := hex.DecodeString(testSourceERC20Token1[2:])
+				require.True(t, k.GetERC20Burnt(ctx, token).IsZero())
+			},
 		},
 	}
 
@@ -555,6 +565,91 @@ func TestSaveAssetsUnlockedWithOutflowLimits(t *testing.T) {
 		require.ErrorContains(t, err, "outflow limit check error")
 	})
 
+	t.Run("SaveAssetsUnlocked with USD outflow limit", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(100)
+		erc20Token := common.HexToAddress("0x7777777777777777777777777777777777777777").Bytes()
+
+		sourceToken := common.HexToAddress("0xC2b86a33E6441b5B6F7BB33b8F2D8F9FD6D5F0C2").Bytes()
+		mapping := types.NewERC20TokenMapping(sourceToken, erc20Token)
+		keeper.setERC20TokenMapping(ctx, mapping)
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(2000))
+
+		// The token is worth 2 USD and the USD limit allows 3000 USD.
+		keeper.oracleKeeper.(*mockOracleKeeper).setPrice("TKN/USD", math.NewInt(2), 0, 100)
+		require.NoError(t, keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+			Token:        evmtypes.BytesToHexAddress(erc20Token),
+			CurrencyPair: "TKN/USD",
+			Decimals:     0,
+		}))
+		keeper.SetUSDOutflowLimit(ctx, math.NewIntWithDecimal(3000, 18))
+
+		_, err := keeper.SaveAssetsUnlocked(
+			ctx,
+			[]byte("recipient"),
+			erc20Token,
+			[]byte("sender_address"),
+			math.NewInt(1000),
+			0,
+		)
+		require.NoError(t, err)
+		require.Equal(t, math.NewIntWithDecimal(2000, 18), keeper.getCurrentUSDOutflow(ctx))
+
+		// The token limit allows 1000 more, but the USD limit only 500.
+		_, err = keeper.SaveAssetsUnlocked(
+			ctx,
+			[]byte("recipient"),
+			erc20Token,
+			[]byte("sender_address"),
+			math.NewInt(501),
+			0,
+		)
+		require.ErrorIs(t, err, types.ErrUSDOutflowLimitExceeded)
+		require.Equal(t, math.NewInt(1000), keeper.getCurrentOutflow(ctx, erc20Token))
+	})
+
+	t.Run("SaveAssetsUnlocked with sender outflow limits", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(100)
+		erc20Token := common.HexToAddress("0x7777777777777777777777777777777777777777").Bytes()
+
+		sourceToken := common.HexToAddress("0xC2b86a33E6441b5B6F7BB33b8F2D8F9FD6D5F0C2").Bytes()
+		mapping := types.NewERC20TokenMapping(sourceToken, erc20Token)
+		keeper.setERC20TokenMapping(ctx, mapping)
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(10000))
+
+		require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(50, 2)))
+		keeper.SetSenderOutflowLimit(ctx, erc20Token, math.NewInt(1000))
+
+		sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+		otherSender := common.HexToAddress("0x4444444444444444444444444444444444444444").Bytes()
+
+		_, err := keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, sender, math.NewInt(800), 0)
+		require.NoError(t, err)
+
+		// The sender amount limit allows only 200 more.
+		_, err = keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, sender, math.NewInt(201), 0)
+		require.ErrorIs(t, err, types.ErrSenderOutflowLimitExceeded)
+
+		_, err = keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, sender, math.NewInt(100), 0)
+		require.NoError(t, err)
+
+		// The sender count limit is reached.
+		_, err = keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, sender, math.NewInt(1), 0)
+		require.ErrorIs(t, err, types.ErrSenderOutflowCountExceeded)
+
+		// Other senders are not affected.
+		_, err = keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, otherSender, math.NewInt(1000), 0)
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewInt(1900), keeper.getCurrentOutflow(ctx, erc20Token))
+
+		// The sender capacity is replenished in the next window.
+		ctx = ctx.WithBlockHeight(150)
+		_, err = keeper.SaveAssetsUnlocked(ctx, []byte("recipient"), erc20Token, sender, math.NewInt(1000), 0)
+		require.NoError(t, err)
+	})
+
 	t.Run("SaveAssetsUnlocked with zero outflow limit", func(t *testing.T) {
 		ctx, keeper := mockContext()
 		zeroLimitToken := common.HexToAddress("0x9999999999999999999999999999999999999999").Bytes()
@@ -764,6 +859,57 @@ func TestSaveAssetsUnlockedWhenBridgeOutPaused(t *testing.T) {
 	})
 }
 
+func TestSaveAssetsUnlockedERC20TokenMappingState(t *testing.T) {
+	cfg := sdk.GetConfig()
+	config.SetBech32Prefixes(cfg)
+
+	sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+	mezoToken := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	tests := []struct {
+		state   types.ERC20TokenMappingState
+		allowed bool
+	}{
+		{types.ERC20TokenMappingStateActive, true},
+		{types.ERC20TokenMappingStatePendingActivation, false},
+		{types.ERC20TokenMappingStateBridgeInPaused, true},
+		{types.ERC20TokenMappingStateBridgeOutPaused, false},
+		{types.ERC20TokenMappingStatePaused, false},
+		{types.ERC20TokenMappingStateDeprecated, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.state.String(), func(t *testing.T) {
+			ctx, keeper := mockContext()
+			keeper.SetOutflowLimit(ctx, mezoToken, math.NewInt(1000))
+
+			mapping := types.NewERC20TokenMapping(sourceToken, mezoToken)
+			mapping.State = test.state
+			keeper.setERC20TokenMapping(ctx, mapping)
+
+			event, err := keeper.SaveAssetsUnlocked(
+				ctx,
+				[]byte("recipient"),
+				mezoToken,
+				[]byte("sender_address"),
+				math.NewInt(500),
+				0,
+			)
+
+			if test.allowed {
+				require.NoError(t, err)
+				require.Equal(t, testSourceERC20Token1, event.Token)
+				require.Equal(t, math.NewInt(500), keeper.getCurrentOutflow(ctx, mezoToken))
+			} else {
+				require.ErrorIs(t, err, types.ErrTokenBridgeOutDisabled)
+
+				// The outflow must not be tracked for a rejected bridge-out.
+				require.True(t, keeper.getCurrentOutflow(ctx, mezoToken).IsZero())
+			}
+		})
+	}
+}
+
 func TestSaveAssetsUnlockedWhenBridgeOutChainDisabled(t *testing.T) {
 	cfg := sdk.GetConfig()
 	config.SetBech32Prefixes(cfg)
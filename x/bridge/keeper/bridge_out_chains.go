@@ -17,12 +17,16 @@ func (k Keeper) IsBridgeOutChainEnabled(ctx sdk.Context, chain uint8) bool {
 // a chain that is already enabled changes nothing.
 func (k Keeper) EnableBridgeOutChain(ctx sdk.Context, chain uint8) {
 	ctx.KVStore(k.storeKey).Set(types.GetBridgeOutChainKey(chain), []byte{0x01})
+
+	k.emitEvent(ctx, &types.EventBridgeOutChainEnabled{Chain: uint32(chain)})
 }
 
 // DisableBridgeOutChain stops the given target chain from accepting
 // bridge-outs. Disabling a chain that is already disabled changes nothing.
 func (k Keeper) DisableBridgeOutChain(ctx sdk.Context, chain uint8) {
 	ctx.KVStore(k.storeKey).Delete(types.GetBridgeOutChainKey(chain))
+
+	k.emitEvent(ctx, &types.EventBridgeOutChainDisabled{Chain: uint32(chain)})
 }
 
 // GetBridgeOutChains returns all target chains that accept bridge-outs, in
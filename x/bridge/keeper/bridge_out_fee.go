@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// GetBridgeOutFeeTreasury returns the address receiving the bridge-out fees.
+// Returns nil if the treasury is not set.
+func (k Keeper) GetBridgeOutFeeTreasury(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.BridgeOutFeeTreasuryKey)
+}
+
+// SetBridgeOutFeeTreasury sets the address receiving the bridge-out fees.
+func (k Keeper) SetBridgeOutFeeTreasury(ctx sdk.Context, treasury []byte) error {
+	if len(treasury) == 0 || bytes.Equal(treasury, make([]byte, len(treasury))) {
+		return types.ErrZeroEVMAddress
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BridgeOutFeeTreasuryKey, treasury)
+
+	k.emitEvent(ctx, &types.EventBridgeOutFeeTreasurySet{
+		Treasury: evmtypes.BytesToHexAddress(treasury),
+	})
+
+	return nil
+}
+
+// GetBridgeOutFee returns the fee charged for bridging out a specific token
+// to a specific target chain. Returns a zero fee if none is set.
+func (k Keeper) GetBridgeOutFee(
+	ctx sdk.Context,
+	mezoToken []byte,
+	chain uint8,
+) types.BridgeOutFee {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBridgeOutFeeKey(mezoToken, chain))
+	if len(bz) == 0 {
+		return types.NewBridgeOutFee(mezoToken, chain, math.ZeroInt(), 0)
+	}
+
+	var fee types.BridgeOutFee
+	k.cdc.MustUnmarshal(bz, &fee)
+
+	return fee
+}
+
+// SetBridgeOutFee sets the fee charged for bridging out a specific token to
+// a specific target chain. A zero fee removes the fee. A non-zero fee
+// requires the bridge-out fee treasury to be set.
+func (k Keeper) SetBridgeOutFee(ctx sdk.Context, fee types.BridgeOutFee) error {
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	var (
+		store     = ctx.KVStore(k.storeKey)
+		mezoToken = evmtypes.HexAddressToBytes(fee.Token)
+		key       = types.GetBridgeOutFeeKey(mezoToken, uint8(fee.Chain)) //nolint:gosec
+	)
+
+	// Normalize the token address so the fee round-trips through the export.
+	fee.Token = evmtypes.BytesToHexAddress(mezoToken)
+
+	if fee.IsZero() {
+		store.Delete(key)
+	} else {
+		if len(k.GetBridgeOutFeeTreasury(ctx)) == 0 {
+			return types.ErrBridgeOutFeeTreasuryNotSet
+		}
+
+		store.Set(key, k.cdc.MustMarshal(&fee))
+	}
+
+	k.emitEvent(ctx, &types.EventBridgeOutFeeSet{
+		Token:       fee.Token,
+		Chain:       fee.Chain,
+		Flat:        fee.Flat,
+		BasisPoints: fee.BasisPoints,
+	})
+
+	return nil
+}
+
+// GetAllBridgeOutFees returns the bridge-out fees of all tokens and target
+// chains having one, ordered by the target chain.
+func (k Keeper) GetAllBridgeOutFees(ctx sdk.Context) []types.BridgeOutFee {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.BridgeOutFeeKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var fees []types.BridgeOutFee
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.BridgeOutFee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+
+		fees = append(fees, fee)
+	}
+
+	return fees
+}
+
+// bridgeOutFeeAmount returns the fee charged for bridging out the given
+// amount of a specific token to a specific target chain. It returns an error
+// if the fee consumes the whole amount.
+func (k Keeper) bridgeOutFeeAmount(
+	ctx sdk.Context,
+	mezoToken []byte,
+	amount math.Int,
+	chain uint8,
+) (math.Int, error) {
+	fee := k.GetBridgeOutFee(ctx, mezoToken, chain).Amount(amount)
+
+	if fee.GTE(amount) {
+		return math.Int{}, fmt.Errorf(
+			"%w: amount %s, fee %s",
+			types.ErrBridgeOutAmountNotAboveFee,
+			amount,
+			fee,
+		)
+	}
+
+	return fee, nil
+}
+
+// CollectBridgeOutFee mints the given bridge-out fee of a specific token to
+// the bridge-out fee treasury. It is called after the whole bridged-out
+// amount, fee included, is burnt from the sender. The returned state changes
+// must be applied by the EVM caller; BTC fees are minted directly in x/bank.
+func (k Keeper) CollectBridgeOutFee(
+	ctx sdk.Context,
+	mezoToken []byte,
+	fee math.Int,
+) ([]statedb.StateChange, error) {
+	if !fee.IsPositive() {
+		return nil, nil
+	}
+
+	treasury := k.GetBridgeOutFeeTreasury(ctx)
+	if len(treasury) == 0 {
+		return nil, types.ErrBridgeOutFeeTreasuryNotSet
+	}
+
+	var changes []statedb.StateChange
+
+	if bytes.Equal(mezoToken, evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)) {
+		if err := k.mintBTC(ctx, treasury, fee); err != nil {
+			return nil, fmt.Errorf("failed to mint BTC fee: %w", err)
+		}
+	} else {
+		call, err := evmtypes.NewERC20MintCall(
+			authtypes.NewModuleAddress(types.ModuleName).Bytes(),
+			mezoToken,
+			treasury,
+			fee.BigInt(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ERC20 mint call: %w", err)
+		}
+
+		_, changes, err = k.evmKeeper.ExecuteContractCall(ctx, call)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute ERC20 mint call: %w", err)
+		}
+
+		k.increaseERC20Minted(ctx, mezoToken, fee)
+	}
+
+	k.emitEvent(ctx, &types.EventBridgeOutFeeCollected{
+		Token:    evmtypes.BytesToHexAddress(mezoToken),
+		Treasury: evmtypes.BytesToHexAddress(treasury),
+		Amount:   fee,
+	})
+
+	return changes, nil
+}
+
+// setBridgeOutFee stores a bridge-out fee as is. It is used to initialize
+// the module state from genesis.
+func (k Keeper) setBridgeOutFee(ctx sdk.Context, fee types.BridgeOutFee) {
+	mezoToken := evmtypes.HexAddressToBytes(fee.Token)
+	fee.Token = evmtypes.BytesToHexAddress(mezoToken)
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetBridgeOutFeeKey(mezoToken, uint8(fee.Chain)), //nolint:gosec
+		k.cdc.MustMarshal(&fee),
+	)
+}
@@ -0,0 +1,368 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testBridgeOutFeeTreasury = common.HexToAddress(
+	"0x4444444444444444444444444444444444444444",
+).Bytes()
+
+func TestBridgeOutFeeTreasury(t *testing.T) {
+	ctx, k := mockContext()
+
+	require.Nil(t, k.GetBridgeOutFeeTreasury(ctx))
+
+	require.ErrorIs(
+		t,
+		k.SetBridgeOutFeeTreasury(ctx, nil),
+		types.ErrZeroEVMAddress,
+	)
+	require.ErrorIs(
+		t,
+		k.SetBridgeOutFeeTreasury(ctx, common.Address{}.Bytes()),
+		types.ErrZeroEVMAddress,
+	)
+
+	require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+	require.Equal(t, testBridgeOutFeeTreasury, k.GetBridgeOutFeeTreasury(ctx))
+
+	events := emittedEvents[*types.EventBridgeOutFeeTreasurySet](t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(
+		t,
+		evmtypes.BytesToHexAddress(testBridgeOutFeeTreasury),
+		events[0].Treasury,
+	)
+}
+
+func TestBridgeOutFees(t *testing.T) {
+	ctx, k := mockContext()
+
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	btcFee := types.NewBridgeOutFee(
+		btcToken,
+		types.TargetChainEthereum,
+		math.NewInt(100),
+		25,
+	)
+
+	// The fee is zero by default.
+	require.True(t, k.GetBridgeOutFee(ctx, btcToken, types.TargetChainEthereum).IsZero())
+
+	// A non-zero fee requires the treasury.
+	require.ErrorIs(t, k.SetBridgeOutFee(ctx, btcFee), types.ErrBridgeOutFeeTreasuryNotSet)
+
+	require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+
+	require.Error(t, k.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+		btcToken,
+		types.TargetChainEthereum,
+		math.ZeroInt(),
+		types.BasisPointsDenominator+1,
+	)))
+
+	require.NoError(t, k.SetBridgeOutFee(ctx, btcFee))
+	require.NoError(t, k.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+		erc20Token,
+		types.TargetChainBitcoin,
+		math.NewInt(5),
+		0,
+	)))
+
+	require.Equal(t, btcFee, k.GetBridgeOutFee(ctx, btcToken, types.TargetChainEthereum))
+	// Fees are set per target chain.
+	require.True(t, k.GetBridgeOutFee(ctx, btcToken, types.TargetChainBitcoin).IsZero())
+
+	events := emittedEvents[*types.EventBridgeOutFeeSet](t, ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, evmtypes.BytesToHexAddress(btcToken), events[0].Token)
+	require.Equal(t, math.NewInt(100), events[0].Flat)
+	require.Equal(t, uint32(25), events[0].BasisPoints)
+
+	require.Len(t, k.GetAllBridgeOutFees(ctx), 2)
+
+	// A zero fee removes the fee.
+	require.NoError(t, k.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+		btcToken,
+		types.TargetChainEthereum,
+		math.ZeroInt(),
+		0,
+	)))
+	require.True(t, k.GetBridgeOutFee(ctx, btcToken, types.TargetChainEthereum).IsZero())
+	require.Len(t, k.GetAllBridgeOutFees(ctx), 1)
+}
+
+func TestSaveAssetsUnlockedWithBridgeOutFee(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	setup := func() (sdk.Context, Keeper) {
+		ctx, k := mockContext()
+
+		k.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+		require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+		require.NoError(t, k.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+			btcToken,
+			types.TargetChainEthereum,
+			math.NewInt(10),
+			100, // 1%
+		)))
+
+		return ctx, k
+	}
+
+	t.Run("deducts the fee from the amount", func(t *testing.T) {
+		ctx, k := setup()
+
+		event, err := k.SaveAssetsUnlocked(
+			ctx,
+			[]byte("recipient"),
+			btcToken,
+			sender,
+			math.NewInt(1000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(980), event.Amount)
+		require.Equal(t, math.NewInt(20), event.Fee)
+
+		stored, found := k.GetAssetsUnlocked(ctx, event.UnlockSequence)
+		require.True(t, found)
+		require.Equal(t, math.NewInt(980), stored.Amount)
+		require.Equal(t, math.NewInt(20), stored.Fee)
+
+		events := emittedEvents[*types.EventAssetsUnlocked](t, ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, math.NewInt(980), events[0].Amount)
+		require.Equal(t, math.NewInt(20), events[0].Fee)
+
+		// Only the net amount leaves the chain.
+		require.Equal(t, math.NewInt(980), k.getCurrentOutflow(ctx, btcToken))
+	})
+
+	t.Run("does not charge other target chains", func(t *testing.T) {
+		ctx, k := setup()
+
+		event, err := k.SaveAssetsUnlocked(
+			ctx,
+			[]byte("recipient"),
+			btcToken,
+			sender,
+			math.NewInt(1000),
+			types.TargetChainBitcoin,
+		)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(1000), event.Amount)
+		require.True(t, event.Fee.IsZero())
+	})
+
+	t.Run("rejects amounts not above the fee", func(t *testing.T) {
+		ctx, k := setup()
+
+		_, err := k.SaveAssetsUnlocked(
+			ctx,
+			[]byte("recipient"),
+			btcToken,
+			sender,
+			math.NewInt(10),
+			types.TargetChainEthereum,
+		)
+		require.ErrorIs(t, err, types.ErrBridgeOutAmountNotAboveFee)
+		require.True(t, k.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+	})
+}
+
+func TestSaveDelayedBridgeOutWithBridgeOutFee(t *testing.T) {
+	ctx, k := mockContext()
+	ctx = ctx.WithBlockHeight(50)
+
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	require.NoError(t, k.SetDelayedBridgeOutBlocks(ctx, 100))
+	k.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+	require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+	require.NoError(t, k.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+		btcToken,
+		types.TargetChainEthereum,
+		math.NewInt(100),
+		0,
+	)))
+
+	bridgeOut, err := k.SaveDelayedBridgeOut(
+		ctx,
+		[]byte("recipient"),
+		btcToken,
+		sender,
+		math.NewInt(2000),
+		types.TargetChainEthereum,
+	)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1900), bridgeOut.Amount)
+	require.Equal(t, math.NewInt(100), bridgeOut.Fee)
+
+	events := emittedEvents[*types.EventBridgeOutDelayed](t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, math.NewInt(1900), events[0].Amount)
+	require.Equal(t, math.NewInt(100), events[0].Fee)
+
+	// The fee is not collected until the bridge-out is released.
+	require.Empty(t, emittedEvents[*types.EventBridgeOutFeeCollected](t, ctx))
+
+	fee := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(100)))
+
+	bankKeeper := k.bankKeeper.(*mockBankKeeper)
+	bankKeeper.On("MintCoins", mock.Anything, types.ModuleName, fee).Return(nil).Once()
+	bankKeeper.On(
+		"SendCoinsFromModuleToAccount",
+		mock.Anything,
+		types.ModuleName,
+		sdk.AccAddress(testBridgeOutFeeTreasury),
+		fee,
+	).Return(nil).Once()
+
+	// The released AssetsUnlocked event carries the fee charged at queue time.
+	releaseCtx := ctx.WithBlockHeight(150)
+	k.releaseDelayedBridgeOuts(releaseCtx)
+	bankKeeper.AssertExpectations(t)
+
+	event, found := k.GetAssetsUnlocked(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1900), event.Amount)
+	require.Equal(t, math.NewInt(100), event.Fee)
+
+	feeEvents := emittedEvents[*types.EventBridgeOutFeeCollected](t, releaseCtx)
+	require.Len(t, feeEvents, 1)
+	require.Equal(t, math.NewInt(100), feeEvents[0].Amount)
+}
+
+func TestSaveDelayedBridgeOutWithoutBridgeOutFeeTreasury(t *testing.T) {
+	ctx, k := mockContext()
+
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	require.NoError(t, k.SetDelayedBridgeOutBlocks(ctx, 100))
+	k.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+	k.setBridgeOutFee(ctx, types.NewBridgeOutFee(
+		btcToken,
+		types.TargetChainEthereum,
+		math.NewInt(100),
+		0,
+	))
+
+	_, err := k.SaveDelayedBridgeOut(
+		ctx,
+		[]byte("recipient"),
+		btcToken,
+		sender,
+		math.NewInt(2000),
+		types.TargetChainEthereum,
+	)
+	require.ErrorIs(t, err, types.ErrBridgeOutFeeTreasuryNotSet)
+	require.Zero(t, k.GetDelayedBridgeOutSequenceTip(ctx))
+	require.True(t, k.getCurrentOutflow(ctx, btcToken).IsZero())
+}
+
+func TestCollectBridgeOutFee(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	t.Run("does nothing for a zero fee", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		changes, err := k.CollectBridgeOutFee(ctx, btcToken, math.ZeroInt())
+		require.NoError(t, err)
+		require.Nil(t, changes)
+		require.Empty(t, emittedEvents[*types.EventBridgeOutFeeCollected](t, ctx))
+	})
+
+	t.Run("fails without a treasury", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		_, err := k.CollectBridgeOutFee(ctx, btcToken, math.NewInt(10))
+		require.ErrorIs(t, err, types.ErrBridgeOutFeeTreasuryNotSet)
+	})
+
+	t.Run("mints the BTC fee to the treasury", func(t *testing.T) {
+		ctx, k := mockContext()
+		require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+
+		coins := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, math.NewInt(10)))
+
+		bankKeeper := k.bankKeeper.(*mockBankKeeper)
+		bankKeeper.On("MintCoins", ctx, types.ModuleName, coins).Return(nil).Once()
+		bankKeeper.On(
+			"SendCoinsFromModuleToAccount",
+			ctx,
+			types.ModuleName,
+			sdk.AccAddress(testBridgeOutFeeTreasury),
+			coins,
+		).Return(nil).Once()
+
+		mintedBefore := k.GetBTCMinted(ctx)
+
+		changes, err := k.CollectBridgeOutFee(ctx, btcToken, math.NewInt(10))
+		require.NoError(t, err)
+		require.Nil(t, changes)
+		bankKeeper.AssertExpectations(t)
+		require.Equal(t, mintedBefore.AddRaw(10), k.GetBTCMinted(ctx))
+
+		events := emittedEvents[*types.EventBridgeOutFeeCollected](t, ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, evmtypes.BytesToHexAddress(btcToken), events[0].Token)
+		require.Equal(
+			t,
+			evmtypes.BytesToHexAddress(testBridgeOutFeeTreasury),
+			events[0].Treasury,
+		)
+		require.Equal(t, math.NewInt(10), events[0].Amount)
+	})
+
+	t.Run("mints the ERC20 fee to the treasury", func(t *testing.T) {
+		ctx, k := mockContext()
+		require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+
+		call, err := evmtypes.NewERC20MintCall(
+			authtypes.NewModuleAddress(types.ModuleName).Bytes(),
+			erc20Token,
+			testBridgeOutFeeTreasury,
+			math.NewInt(10).BigInt(),
+		)
+		require.NoError(t, err)
+
+		evmKeeper := k.evmKeeper.(*mockEvmKeeper)
+		evmKeeper.On("ExecuteContractCall", ctx, call).Return(nil, nil).Once()
+
+		_, err = k.CollectBridgeOutFee(ctx, erc20Token, math.NewInt(10))
+		require.NoError(t, err)
+		evmKeeper.AssertCalled(t, "ExecuteContractCall", ctx, call)
+		require.Equal(t, math.NewInt(10), k.GetERC20Minted(ctx, erc20Token))
+	})
+
+	t.Run("returns an error when the ERC20 mint fails", func(t *testing.T) {
+		ctx, k := mockContext()
+		require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+
+		k.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", mock.Anything, mock.Anything).
+			Return(nil, types.ErrZeroEVMAddress).
+			Once()
+
+		_, err := k.CollectBridgeOutFee(ctx, erc20Token, math.NewInt(10))
+		require.ErrorContains(t, err, "failed to execute ERC20 mint call")
+		require.True(t, k.GetERC20Minted(ctx, erc20Token).IsZero())
+	})
+}
@@ -0,0 +1,530 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// DelayedBridgeOutBatch is the maximum number of delayed bridge-outs released
+// in a single block. The bound keeps the end-blocker cost constant even if
+// many bridge-outs mature at the same height.
+const DelayedBridgeOutBatch = 20
+
+// maxDelayedBridgeOutsScannedPerBlock is the maximum number of mature
+// delayed bridge-outs visited by the end-blocker in a single block, released
+// or held. The bound keeps the end-blocker cost constant even if many mature
+// bridge-outs are held. Held bridge-outs are rescheduled behind the ones
+// already mature, so they cannot starve them.
+const maxDelayedBridgeOutsScannedPerBlock = 100
+
+// GetDelayedBridgeOutBlocks returns the number of blocks bridge-outs reaching
+// the delayed bridge-out threshold of their token are held in the queue.
+// Zero means the queue is disabled.
+func (k Keeper) GetDelayedBridgeOutBlocks(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.DelayedBridgeOutBlocksKey)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetDelayedBridgeOutBlocks sets the number of blocks bridge-outs reaching
+// the delayed bridge-out threshold of their token are held in the queue.
+// Zero disables the queue for new bridge-outs. Bridge-outs already in the
+// queue keep their release height.
+func (k Keeper) SetDelayedBridgeOutBlocks(ctx sdk.Context, blocks uint64) error {
+	if err := types.ValidateDelayedBridgeOutBlocks(blocks); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	if blocks == 0 {
+		store.Delete(types.DelayedBridgeOutBlocksKey)
+	} else {
+		store.Set(types.DelayedBridgeOutBlocksKey, sdk.Uint64ToBigEndian(blocks))
+	}
+
+	k.emitEvent(ctx, &types.EventDelayedBridgeOutBlocksSet{Blocks: blocks})
+
+	return nil
+}
+
+// GetDelayedBridgeOutThreshold returns the minimum amount of a bridge-out of
+// a specific token held in the delayed bridge-out queue. Zero means
+// bridge-outs of the token are never delayed.
+func (k Keeper) GetDelayedBridgeOutThreshold(
+	ctx sdk.Context,
+	token []byte,
+) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDelayedBridgeOutThresholdKey(token))
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	threshold := math.ZeroInt()
+	if err := threshold.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return threshold
+}
+
+// SetDelayedBridgeOutThreshold sets the minimum amount of a bridge-out of
+// a specific token held in the delayed bridge-out queue. Zero removes the
+// threshold.
+func (k Keeper) SetDelayedBridgeOutThreshold(
+	ctx sdk.Context,
+	token []byte,
+	threshold math.Int,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	if threshold.IsZero() {
+		store.Delete(types.GetDelayedBridgeOutThresholdKey(token))
+	} else {
+		bz, err := threshold.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set(types.GetDelayedBridgeOutThresholdKey(token), bz)
+	}
+
+	k.emitEvent(ctx, &types.EventDelayedBridgeOutThresholdSet{
+		Token:     evmtypes.BytesToHexAddress(token),
+		Threshold: threshold,
+	})
+}
+
+// GetAllDelayedBridgeOutThresholds returns the delayed bridge-out thresholds
+// of all tokens having one.
+func (k Keeper) GetAllDelayedBridgeOutThresholds(
+	ctx sdk.Context,
+) []types.DelayedBridgeOutThreshold {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.DelayedBridgeOutThresholdKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var thresholds []types.DelayedBridgeOutThreshold
+
+	for ; iterator.Valid(); iterator.Next() {
+		token := iterator.Key()[len(types.DelayedBridgeOutThresholdKeyPrefix):]
+
+		threshold := math.ZeroInt()
+		if err := threshold.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		thresholds = append(thresholds, types.DelayedBridgeOutThreshold{
+			Token:     evmtypes.BytesToHexAddress(token),
+			Threshold: threshold,
+		})
+	}
+
+	return thresholds
+}
+
+// IsDelayedBridgeOut returns true if a bridge-out of the given amount of
+// a specific token must be held in the delayed bridge-out queue.
+func (k Keeper) IsDelayedBridgeOut(
+	ctx sdk.Context,
+	token []byte,
+	amount math.Int,
+) bool {
+	if k.GetDelayedBridgeOutBlocks(ctx) == 0 {
+		return false
+	}
+
+	threshold := k.GetDelayedBridgeOutThreshold(ctx, token)
+
+	return threshold.IsPositive() && amount.GTE(threshold)
+}
+
+// SaveDelayedBridgeOut holds a bridge-out in the delayed bridge-out queue,
+// consuming the outflow limits of the token and the sender. The bridge-out
+// is released as an AssetsUnlocked event by the end-blocker once the delay
+// passes. The bridge-out fee is deducted from the given amount right away
+// but collected only on release. The caller is responsible for burning the
+// whole amount from the sender.
+func (k Keeper) SaveDelayedBridgeOut(
+	ctx sdk.Context,
+	recipient []byte,
+	token []byte,
+	sender []byte,
+	amount math.Int,
+	chain uint8,
+) (*types.DelayedBridgeOut, error) {
+	fee, err := k.bridgeOutFeeAmount(ctx, token, amount, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fee is collected by the end-blocker which cannot fail the
+	// bridge-out, so the treasury must be known upfront.
+	if fee.IsPositive() && len(k.GetBridgeOutFeeTreasury(ctx)) == 0 {
+		return nil, types.ErrBridgeOutFeeTreasuryNotSet
+	}
+
+	netAmount := amount.Sub(fee)
+
+	targetToken, usdValue, err := k.consumeBridgeOut(ctx, token, sender, netAmount, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	id := k.GetDelayedBridgeOutSequenceTip(ctx) + 1
+	k.setDelayedBridgeOutSequenceTip(ctx, id)
+
+	//nolint:gosec
+	queuedHeight := uint64(ctx.BlockHeight())
+	releaseHeight := queuedHeight + k.GetDelayedBridgeOutBlocks(ctx)
+
+	bridgeOut := &types.DelayedBridgeOut{
+		Id:            id,
+		Recipient:     recipient,
+		Token:         evmtypes.BytesToHexAddress(token),
+		TargetToken:   targetToken,
+		Sender:        evmtypes.BytesToHexAddress(sender),
+		Amount:        netAmount,
+		Chain:         uint32(chain),
+		ReleaseHeight: releaseHeight,
+		Fee:           fee,
+		QueuedHeight:  queuedHeight,
+		UsdValue:      usdValue,
+	}
+	k.setDelayedBridgeOut(ctx, bridgeOut)
+
+	k.emitEvent(ctx, &types.EventBridgeOutDelayed{
+		Id:            bridgeOut.Id,
+		Recipient:     bridgeOut.Recipient,
+		Token:         bridgeOut.Token,
+		Sender:        bridgeOut.Sender,
+		Amount:        bridgeOut.Amount,
+		Chain:         bridgeOut.Chain,
+		ReleaseHeight: bridgeOut.ReleaseHeight,
+		Fee:           bridgeOut.Fee,
+	})
+
+	return bridgeOut, nil
+}
+
+// CancelDelayedBridgeOut removes a bridge-out from the delayed bridge-out
+// queue and mints the whole burnt amount, fee included, back to the sender.
+// The outflow consumed by the bridge-out is given back if it is still
+// counted (see decreaseCounterOutflow). The returned state changes must be
+// applied by the EVM caller; BTC refunds are made directly in x/bank.
+func (k Keeper) CancelDelayedBridgeOut(
+	ctx sdk.Context,
+	id uint64,
+) (*types.DelayedBridgeOut, []statedb.StateChange, error) {
+	bridgeOut, found := k.GetDelayedBridgeOut(ctx, id)
+	if !found {
+		return nil, nil, types.ErrDelayedBridgeOutNotFound
+	}
+
+	token := evmtypes.HexAddressToBytes(bridgeOut.Token)
+	sender := evmtypes.HexAddressToBytes(bridgeOut.Sender)
+	refund := bridgeOut.Amount.Add(bridgeOut.Fee)
+
+	var changes []statedb.StateChange
+
+	if bytes.Equal(token, evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)) {
+		if err := k.mintBTC(ctx, sender, refund); err != nil {
+			return nil, nil, fmt.Errorf("failed to refund BTC: %w", err)
+		}
+	} else {
+		call, err := evmtypes.NewERC20MintCall(
+			authtypes.NewModuleAddress(types.ModuleName).Bytes(),
+			token,
+			sender,
+			refund.BigInt(),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create ERC20 mint call: %w", err)
+		}
+
+		_, changes, err = k.evmKeeper.ExecuteContractCall(ctx, call)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to execute ERC20 mint call: %w", err)
+		}
+
+		k.increaseERC20Minted(ctx, token, refund)
+	}
+
+	k.decreaseCurrentOutflow(ctx, token, bridgeOut.QueuedHeight, bridgeOut.Amount)
+	if !bridgeOut.UsdValue.IsNil() && bridgeOut.UsdValue.IsPositive() {
+		k.decreaseCurrentUSDOutflow(ctx, bridgeOut.QueuedHeight, bridgeOut.UsdValue)
+	}
+	k.decreaseSenderOutflow(ctx, sender, token, bridgeOut.QueuedHeight, bridgeOut.Amount)
+
+	k.deleteDelayedBridgeOut(ctx, bridgeOut)
+
+	k.emitEvent(ctx, &types.EventDelayedBridgeOutCancelled{
+		Id:     bridgeOut.Id,
+		Token:  bridgeOut.Token,
+		Sender: bridgeOut.Sender,
+		Amount: refund,
+	})
+
+	return bridgeOut, changes, nil
+}
+
+// GetDelayedBridgeOut returns the bridge-out held in the delayed bridge-out
+// queue under the given identifier. The returned boolean value indicates
+// whether the bridge-out was found. Released and cancelled bridge-outs are
+// removed from the queue.
+func (k Keeper) GetDelayedBridgeOut(
+	ctx sdk.Context,
+	id uint64,
+) (*types.DelayedBridgeOut, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDelayedBridgeOutKey(id))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var bridgeOut types.DelayedBridgeOut
+	k.cdc.MustUnmarshal(bz, &bridgeOut)
+
+	return &bridgeOut, true
+}
+
+// GetAllDelayedBridgeOuts returns all bridge-outs held in the delayed
+// bridge-out queue, in identifier order.
+func (k Keeper) GetAllDelayedBridgeOuts(ctx sdk.Context) []types.DelayedBridgeOut {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.DelayedBridgeOutKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var bridgeOuts []types.DelayedBridgeOut
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bridgeOut types.DelayedBridgeOut
+		k.cdc.MustUnmarshal(iterator.Value(), &bridgeOut)
+
+		bridgeOuts = append(bridgeOuts, bridgeOut)
+	}
+
+	return bridgeOuts
+}
+
+// GetDelayedBridgeOutSequenceTip returns the identifier of the last queued
+// delayed bridge-out.
+func (k Keeper) GetDelayedBridgeOutSequenceTip(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.DelayedBridgeOutSequenceTipKey)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setDelayedBridgeOutSequenceTip(ctx sdk.Context, tip uint64) {
+	ctx.KVStore(k.storeKey).Set(
+		types.DelayedBridgeOutSequenceTipKey,
+		sdk.Uint64ToBigEndian(tip),
+	)
+}
+
+func (k Keeper) setDelayedBridgeOut(
+	ctx sdk.Context,
+	bridgeOut *types.DelayedBridgeOut,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(
+		types.GetDelayedBridgeOutKey(bridgeOut.Id),
+		k.cdc.MustMarshal(bridgeOut),
+	)
+	store.Set(
+		types.GetDelayedBridgeOutReleaseScheduleKey(bridgeOut.ReleaseHeight, bridgeOut.Id),
+		[]byte{0x01},
+	)
+}
+
+func (k Keeper) deleteDelayedBridgeOut(
+	ctx sdk.Context,
+	bridgeOut *types.DelayedBridgeOut,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetDelayedBridgeOutKey(bridgeOut.Id))
+	store.Delete(
+		types.GetDelayedBridgeOutReleaseScheduleKey(bridgeOut.ReleaseHeight, bridgeOut.Id),
+	)
+}
+
+// releaseDelayedBridgeOuts releases up to DelayedBridgeOutBatch mature
+// bridge-outs from the delayed bridge-out queue as AssetsUnlocked events and
+// collects their bridge-out fees.
+//
+// Bridge-outs are released in release height order, then in identifier
+// order, so lowering the delay does not hold newer bridge-outs behind older
+// ones. Nothing is released while bridge-out is paused. A mature bridge-out
+// is held in the queue while its target chain or its token's bridge-out is
+// disabled, or if its fee cannot be collected. A held bridge-out is
+// rescheduled to the next block, behind the bridge-outs already mature, so
+// held bridge-outs do not block the ones behind them.
+func (k Keeper) releaseDelayedBridgeOuts(ctx sdk.Context) {
+	if k.IsBridgeOutPaused(ctx) {
+		return
+	}
+
+	//nolint:gosec
+	currentHeight := uint64(ctx.BlockHeight())
+
+	var mature []*types.DelayedBridgeOut
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.DelayedBridgeOutReleaseScheduleKeyPrefix,
+		types.GetDelayedBridgeOutReleaseScheduleKey(currentHeight+1, 0),
+	)
+	for ; iterator.Valid() && len(mature) < maxDelayedBridgeOutsScannedPerBlock; iterator.Next() {
+		key := iterator.Key()[len(types.DelayedBridgeOutReleaseScheduleKeyPrefix):]
+
+		bridgeOut, found := k.GetDelayedBridgeOut(ctx, sdk.BigEndianToUint64(key[8:]))
+		if !found {
+			panic(fmt.Sprintf("delayed bridge-out %d not found", sdk.BigEndianToUint64(key[8:])))
+		}
+
+		mature = append(mature, bridgeOut)
+	}
+	_ = iterator.Close()
+
+	released := 0
+
+	for _, bridgeOut := range mature {
+		if released == DelayedBridgeOutBatch {
+			break
+		}
+
+		if err := k.checkDelayedBridgeOutReleasable(ctx, bridgeOut); err != nil {
+			k.Logger(ctx).Debug(
+				"delayed bridge-out held",
+				"id", bridgeOut.Id,
+				"reason", err,
+			)
+			k.rescheduleDelayedBridgeOut(ctx, bridgeOut, currentHeight+1)
+			continue
+		}
+
+		// Release atomically so a failed fee collection leaves the
+		// bridge-out queued.
+		cacheCtx, write := ctx.CacheContext()
+
+		assetsUnlocked, err := k.releaseDelayedBridgeOut(cacheCtx, bridgeOut)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to release delayed bridge-out",
+				"id", bridgeOut.Id,
+				"error", err,
+			)
+			k.rescheduleDelayedBridgeOut(ctx, bridgeOut, currentHeight+1)
+			continue
+		}
+
+		write()
+		released++
+
+		k.Logger(ctx).Info(
+			"delayed bridge-out released",
+			"id", bridgeOut.Id,
+			"unlockSequence", assetsUnlocked.UnlockSequence,
+		)
+	}
+}
+
+// rescheduleDelayedBridgeOut moves a held bridge-out to the given release
+// height in the delayed bridge-out release schedule.
+func (k Keeper) rescheduleDelayedBridgeOut(
+	ctx sdk.Context,
+	bridgeOut *types.DelayedBridgeOut,
+	releaseHeight uint64,
+) {
+	ctx.KVStore(k.storeKey).Delete(
+		types.GetDelayedBridgeOutReleaseScheduleKey(bridgeOut.ReleaseHeight, bridgeOut.Id),
+	)
+
+	bridgeOut.ReleaseHeight = releaseHeight
+	k.setDelayedBridgeOut(ctx, bridgeOut)
+}
+
+// checkDelayedBridgeOutReleasable verifies the target chain of a mature
+// delayed bridge-out and the bridge-out state of its token still allow the
+// release.
+func (k Keeper) checkDelayedBridgeOutReleasable(
+	ctx sdk.Context,
+	bridgeOut *types.DelayedBridgeOut,
+) error {
+	if !k.IsBridgeOutChainEnabled(ctx, uint8(bridgeOut.Chain)) { //nolint:gosec
+		return types.ErrBridgeOutChainNotEnabled
+	}
+
+	token := evmtypes.HexAddressToBytes(bridgeOut.Token)
+	if bytes.Equal(token, evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)) {
+		return nil
+	}
+
+	mapping, ok := k.GetERC20TokenMappingFromMezoToken(ctx, token)
+	if !ok {
+		return fmt.Errorf("unknown token %s", bridgeOut.Token)
+	}
+
+	if !mapping.IsBridgeOutEnabled() {
+		return fmt.Errorf(
+			"%w: mapping state %s",
+			types.ErrTokenBridgeOutDisabled,
+			mapping.State,
+		)
+	}
+
+	return nil
+}
+
+// releaseDelayedBridgeOut collects the fee of a delayed bridge-out, records
+// it as an AssetsUnlocked event and removes it from the queue.
+func (k Keeper) releaseDelayedBridgeOut(
+	ctx sdk.Context,
+	bridgeOut *types.DelayedBridgeOut,
+) (*types.AssetsUnlockedEvent, error) {
+	_, err := k.CollectBridgeOutFee(ctx, evmtypes.HexAddressToBytes(bridgeOut.Token), bridgeOut.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	assetsUnlocked := k.recordAssetsUnlocked(
+		ctx,
+		bridgeOut.Recipient,
+		bridgeOut.TargetToken,
+		bridgeOut.Sender,
+		bridgeOut.Amount,
+		bridgeOut.Fee,
+		bridgeOut.Chain,
+	)
+
+	k.deleteDelayedBridgeOut(ctx, bridgeOut)
+
+	k.emitEvent(ctx, &types.EventDelayedBridgeOutReleased{
+		Id:             bridgeOut.Id,
+		UnlockSequence: assetsUnlocked.UnlockSequence,
+	})
+
+	return assetsUnlocked, nil
+}
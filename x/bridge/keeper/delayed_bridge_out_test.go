@@ -0,0 +1,652 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelayedBridgeOutBlocks(t *testing.T) {
+	ctx, keeper := mockContext()
+
+	// The queue is disabled by default.
+	require.Zero(t, keeper.GetDelayedBridgeOutBlocks(ctx))
+
+	require.Error(t, keeper.SetDelayedBridgeOutBlocks(ctx, types.MaxDelayedBridgeOutBlocks+1))
+
+	require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+	require.Equal(t, uint64(100), keeper.GetDelayedBridgeOutBlocks(ctx))
+
+	events := emittedEvents[*types.EventDelayedBridgeOutBlocksSet](t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(100), events[0].Blocks)
+
+	require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 0))
+	require.Zero(t, keeper.GetDelayedBridgeOutBlocks(ctx))
+}
+
+func TestDelayedBridgeOutThresholds(t *testing.T) {
+	ctx, keeper := mockContext()
+	token1 := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+	token2 := common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()
+
+	require.True(t, keeper.GetDelayedBridgeOutThreshold(ctx, token1).IsZero())
+
+	keeper.SetDelayedBridgeOutThreshold(ctx, token1, math.NewInt(1000))
+	keeper.SetDelayedBridgeOutThreshold(ctx, token2, math.NewInt(2000))
+	require.Equal(t, math.NewInt(1000), keeper.GetDelayedBridgeOutThreshold(ctx, token1))
+
+	events := emittedEvents[*types.EventDelayedBridgeOutThresholdSet](t, ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, evmtypes.BytesToHexAddress(token1), events[0].Token)
+	require.Equal(t, math.NewInt(1000), events[0].Threshold)
+
+	require.Equal(
+		t,
+		[]types.DelayedBridgeOutThreshold{
+			{Token: evmtypes.BytesToHexAddress(token1), Threshold: math.NewInt(1000)},
+			{Token: evmtypes.BytesToHexAddress(token2), Threshold: math.NewInt(2000)},
+		},
+		keeper.GetAllDelayedBridgeOutThresholds(ctx),
+	)
+
+	// Zero removes the threshold.
+	keeper.SetDelayedBridgeOutThreshold(ctx, token1, math.ZeroInt())
+	require.True(t, keeper.GetDelayedBridgeOutThreshold(ctx, token1).IsZero())
+	require.Len(t, keeper.GetAllDelayedBridgeOutThresholds(ctx), 1)
+}
+
+func TestIsDelayedBridgeOut(t *testing.T) {
+	ctx, keeper := mockContext()
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+	otherToken := common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()
+
+	keeper.SetDelayedBridgeOutThreshold(ctx, token, math.NewInt(1000))
+
+	// Nothing is delayed while the queue is disabled.
+	require.False(t, keeper.IsDelayedBridgeOut(ctx, token, math.NewInt(5000)))
+
+	require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+
+	require.False(t, keeper.IsDelayedBridgeOut(ctx, token, math.NewInt(999)))
+	require.True(t, keeper.IsDelayedBridgeOut(ctx, token, math.NewInt(1000)))
+	require.True(t, keeper.IsDelayedBridgeOut(ctx, token, math.NewInt(5000)))
+
+	// Tokens without a threshold are never delayed.
+	require.False(t, keeper.IsDelayedBridgeOut(ctx, otherToken, math.NewInt(5000)))
+}
+
+func TestSaveDelayedBridgeOut(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	recipient := []byte("recipient")
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	t.Run("queues the bridge-out", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+
+		bridgeOut, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			recipient,
+			btcToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			&types.DelayedBridgeOut{
+				Id:            1,
+				Recipient:     recipient,
+				Token:         evmtypes.BytesToHexAddress(btcToken),
+				TargetToken:   testSourceBTCToken,
+				Sender:        evmtypes.BytesToHexAddress(sender),
+				Amount:        math.NewInt(2000),
+				Chain:         uint32(types.TargetChainEthereum),
+				ReleaseHeight: 150,
+				Fee:           math.ZeroInt(),
+				QueuedHeight:  50,
+				UsdValue:      math.ZeroInt(),
+			},
+			bridgeOut,
+		)
+
+		stored, found := keeper.GetDelayedBridgeOut(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, bridgeOut, stored)
+		require.Equal(t, uint64(1), keeper.GetDelayedBridgeOutSequenceTip(ctx))
+
+		// The outflow is consumed when the bridge-out is queued.
+		require.Equal(t, math.NewInt(2000), keeper.getCurrentOutflow(ctx, btcToken))
+
+		// No AssetsUnlocked event is recorded until the release.
+		require.True(t, keeper.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+
+		events := emittedEvents[*types.EventBridgeOutDelayed](t, ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(1), events[0].Id)
+		require.Equal(t, uint64(150), events[0].ReleaseHeight)
+
+		bridgeOut, err = keeper.SaveDelayedBridgeOut(
+			ctx,
+			recipient,
+			btcToken,
+			sender,
+			math.NewInt(3000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), bridgeOut.Id)
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 2)
+	})
+
+	t.Run("respects outflow limits", func(t *testing.T) {
+		ctx, keeper := mockContext()
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1000))
+
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			recipient,
+			btcToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.ErrorIs(t, err, types.ErrOutflowLimitExceeded)
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+		require.Zero(t, keeper.GetDelayedBridgeOutSequenceTip(ctx))
+	})
+
+	t.Run("rejects bridge-out while paused", func(t *testing.T) {
+		ctx, keeper := mockContext()
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetBridgeOutPaused(ctx, true)
+
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			recipient,
+			btcToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.ErrorIs(t, err, types.ErrBridgeOutPaused)
+	})
+
+	t.Run("rejects unknown token", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		unknownToken := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, unknownToken, math.NewInt(10000))
+
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			recipient,
+			unknownToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.ErrorContains(t, err, "unknown token")
+	})
+}
+
+func TestReleaseDelayedBridgeOuts(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	queue := func(t *testing.T, ctx sdk.Context, keeper Keeper, count int) {
+		for i := 0; i < count; i++ {
+			_, err := keeper.SaveDelayedBridgeOut(
+				ctx,
+				[]byte(fmt.Sprintf("recipient%d", i)),
+				btcToken,
+				sender,
+				math.NewInt(int64(1000+i)),
+				types.TargetChainEthereum,
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("holds immature bridge-outs", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+		queue(t, ctx, keeper, 1)
+
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(149))
+
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 1)
+		require.True(t, keeper.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+	})
+
+	t.Run("releases mature bridge-outs in release order", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+		queue(t, ctx, keeper, 2)
+
+		// A bridge-out queued later with a shorter delay matures first and
+		// does not wait for the bridge-outs queued before it.
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 10))
+		queue(t, ctx.WithBlockHeight(60), keeper, 1)
+
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(100))
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 2)
+
+		releaseCtx := ctx.WithBlockHeight(150)
+		keeper.releaseDelayedBridgeOuts(releaseCtx)
+
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+		require.Equal(t, math.NewInt(3), keeper.GetAssetsUnlockedSequenceTip(ctx))
+
+		for i, recipient := range []string{"recipient0", "recipient0", "recipient1"} {
+			assetsUnlocked, found := keeper.GetAssetsUnlocked(ctx, math.NewInt(int64(i+1)))
+			require.True(t, found)
+			require.Equal(t, []byte(recipient), assetsUnlocked.Recipient)
+			require.Equal(t, testSourceBTCToken, assetsUnlocked.Token)
+			require.Equal(t, evmtypes.BytesToHexAddress(sender), assetsUnlocked.Sender)
+		}
+
+		events := emittedEvents[*types.EventDelayedBridgeOutReleased](t, releaseCtx)
+		require.Len(t, events, 3)
+		for i, id := range []uint64{3, 1, 2} {
+			require.Equal(t, id, events[i].Id)
+			require.Equal(t, math.NewInt(int64(i+1)), events[i].UnlockSequence)
+		}
+	})
+
+	t.Run("holds bridge-outs to disabled chains", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+		queue(t, ctx, keeper, 1)
+
+		keeper.DisableBridgeOutChain(ctx, types.TargetChainEthereum)
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(150))
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 1)
+		require.True(t, keeper.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+
+		keeper.EnableBridgeOutChain(ctx, types.TargetChainEthereum)
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(151))
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+	})
+
+	t.Run("holds bridge-outs of tokens with bridge-out disabled", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+		sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+		keeper.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(sourceToken, erc20Token))
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(1_000_000))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			[]byte("recipient"),
+			erc20Token,
+			sender,
+			math.NewInt(1000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		queue(t, ctx, keeper, 1)
+
+		require.NoError(t, keeper.SetERC20TokenMappingState(
+			ctx,
+			sourceToken,
+			types.ERC20TokenMappingStateBridgeOutPaused,
+		))
+
+		// The held bridge-out does not block the one behind it.
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(150))
+		require.Equal(t, math.NewInt(1), keeper.GetAssetsUnlockedSequenceTip(ctx))
+
+		held := keeper.GetAllDelayedBridgeOuts(ctx)
+		require.Len(t, held, 1)
+		require.Equal(t, uint64(1), held[0].Id)
+
+		require.NoError(t, keeper.SetERC20TokenMappingState(
+			ctx,
+			sourceToken,
+			types.ERC20TokenMappingStateActive,
+		))
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(151))
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+	})
+
+	t.Run("held bridge-outs do not starve the ones behind them", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+		sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+		keeper.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(sourceToken, erc20Token))
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(1_000_000))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+
+		heldCount := maxDelayedBridgeOutsScannedPerBlock + 5
+		for i := 0; i < heldCount; i++ {
+			_, err := keeper.SaveDelayedBridgeOut(
+				ctx,
+				[]byte("recipient"),
+				erc20Token,
+				sender,
+				math.NewInt(1000),
+				types.TargetChainEthereum,
+			)
+			require.NoError(t, err)
+		}
+		queue(t, ctx, keeper, 1)
+
+		require.NoError(t, keeper.SetERC20TokenMappingState(
+			ctx,
+			sourceToken,
+			types.ERC20TokenMappingStateBridgeOutPaused,
+		))
+
+		// The scan bound is used up by held bridge-outs, which are moved
+		// to the next block.
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(150))
+		require.True(t, keeper.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+
+		held, found := keeper.GetDelayedBridgeOut(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, uint64(151), held.ReleaseHeight)
+
+		// The bridge-out behind them is reached in the next block.
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(151))
+		require.Equal(t, math.NewInt(1), keeper.GetAssetsUnlockedSequenceTip(ctx))
+
+		_, found = keeper.GetDelayedBridgeOut(ctx, uint64(heldCount+1))
+		require.False(t, found)
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), heldCount)
+	})
+
+	t.Run("holds bridge-outs while paused", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+		queue(t, ctx, keeper, 1)
+
+		keeper.SetBridgeOutPaused(ctx, true)
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(200))
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 1)
+
+		keeper.SetBridgeOutPaused(ctx, false)
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(201))
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+	})
+
+	t.Run("releases in batches", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(1_000_000))
+		queue(t, ctx, keeper, DelayedBridgeOutBatch+5)
+
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(150))
+		require.Len(t, keeper.GetAllDelayedBridgeOuts(ctx), 5)
+		require.Equal(
+			t,
+			math.NewInt(DelayedBridgeOutBatch),
+			keeper.GetAssetsUnlockedSequenceTip(ctx),
+		)
+
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(151))
+		require.Empty(t, keeper.GetAllDelayedBridgeOuts(ctx))
+		require.Equal(
+			t,
+			math.NewInt(DelayedBridgeOutBatch+5),
+			keeper.GetAssetsUnlockedSequenceTip(ctx),
+		)
+	})
+}
+
+func TestCancelDelayedBridgeOut(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	t.Run("not found", func(t *testing.T) {
+		ctx, keeper := mockContext()
+
+		_, _, err := keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.ErrorIs(t, err, types.ErrDelayedBridgeOutNotFound)
+	})
+
+	t.Run("refunds BTC", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			[]byte("recipient"),
+			btcToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+
+		bankKeeper := keeper.bankKeeper.(*mockBankKeeper)
+		expectMintBTC(bankKeeper, ctx, sender, math.NewInt(2000))
+
+		bridgeOut, changes, err := keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.NoError(t, err)
+		require.Empty(t, changes)
+		require.Equal(t, uint64(1), bridgeOut.Id)
+		bankKeeper.AssertExpectations(t)
+
+		_, found := keeper.GetDelayedBridgeOut(ctx, 1)
+		require.False(t, found)
+		require.Equal(t, math.NewInt(2000), keeper.GetBTCMinted(ctx))
+
+		// The outflow is given back.
+		require.True(t, keeper.getCurrentOutflow(ctx, btcToken).IsZero())
+
+		events := emittedEvents[*types.EventDelayedBridgeOutCancelled](t, ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, uint64(1), events[0].Id)
+		require.Equal(t, evmtypes.BytesToHexAddress(sender), events[0].Sender)
+		require.Equal(t, math.NewInt(2000), events[0].Amount)
+
+		// The bridge-out is not released after the cancellation.
+		keeper.releaseDelayedBridgeOuts(ctx.WithBlockHeight(200))
+		require.True(t, keeper.GetAssetsUnlockedSequenceTip(ctx).IsZero())
+	})
+
+	t.Run("refunds ERC20", func(t *testing.T) {
+		ctx, keeper := mockContext()
+
+		keeper.setERC20TokenMapping(
+			ctx,
+			types.NewERC20TokenMapping(
+				evmtypes.HexAddressToBytes(testSourceERC20Token1),
+				erc20Token,
+			),
+		)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(10000))
+		bridgeOut, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			[]byte("recipient"),
+			erc20Token,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		require.Equal(t, testSourceERC20Token1, bridgeOut.TargetToken)
+
+		call, err := evmtypes.NewERC20MintCall(
+			authtypes.NewModuleAddress(types.ModuleName).Bytes(),
+			erc20Token,
+			sender,
+			math.NewInt(2000).BigInt(),
+		)
+		require.NoError(t, err)
+
+		evmKeeper := keeper.evmKeeper.(*mockEvmKeeper)
+		evmKeeper.On("ExecuteContractCall", ctx, call).Return(nil, nil).Once()
+
+		_, _, err = keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.NoError(t, err)
+		evmKeeper.AssertCalled(t, "ExecuteContractCall", ctx, call)
+		require.Equal(t, math.NewInt(2000), keeper.GetERC20Minted(ctx, erc20Token))
+
+		_, found := keeper.GetDelayedBridgeOut(ctx, 1)
+		require.False(t, found)
+	})
+
+	t.Run("keeps the bridge-out if the refund fails", func(t *testing.T) {
+		ctx, keeper := mockContext()
+
+		keeper.setERC20TokenMapping(
+			ctx,
+			types.NewERC20TokenMapping(
+				evmtypes.HexAddressToBytes(testSourceERC20Token1),
+				erc20Token,
+			),
+		)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, erc20Token, math.NewInt(10000))
+		_, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			[]byte("recipient"),
+			erc20Token,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+
+		keeper.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", ctx, mock.Anything).
+			Return(nil, fmt.Errorf("mint error"))
+
+		_, _, err = keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.ErrorContains(t, err, "mint error")
+
+		_, found := keeper.GetDelayedBridgeOut(ctx, 1)
+		require.True(t, found)
+	})
+
+	t.Run("refunds the fee", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+		require.NoError(t, keeper.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+		require.NoError(t, keeper.SetBridgeOutFee(ctx, types.NewBridgeOutFee(
+			btcToken,
+			types.TargetChainEthereum,
+			math.NewInt(100),
+			0,
+		)))
+
+		bridgeOut, err := keeper.SaveDelayedBridgeOut(
+			ctx,
+			[]byte("recipient"),
+			btcToken,
+			sender,
+			math.NewInt(2000),
+			types.TargetChainEthereum,
+		)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(1900), bridgeOut.Amount)
+
+		bankKeeper := keeper.bankKeeper.(*mockBankKeeper)
+		expectMintBTC(bankKeeper, ctx, sender, math.NewInt(2000))
+
+		_, _, err = keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.NoError(t, err)
+		bankKeeper.AssertExpectations(t)
+
+		events := emittedEvents[*types.EventDelayedBridgeOutCancelled](t, ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, math.NewInt(2000), events[0].Amount)
+	})
+
+	t.Run("gives back the outflow still counted", func(t *testing.T) {
+		ctx, keeper := mockContext()
+		ctx = ctx.WithBlockHeight(50)
+
+		require.NoError(t, keeper.SetDelayedBridgeOutBlocks(ctx, 100))
+		require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(100, 5)))
+		keeper.SetOutflowLimit(ctx, btcToken, math.NewInt(10000))
+
+		for i := 0; i < 2; i++ {
+			_, err := keeper.SaveDelayedBridgeOut(
+				ctx,
+				[]byte("recipient"),
+				btcToken,
+				sender,
+				math.NewInt(2000),
+				types.TargetChainEthereum,
+			)
+			require.NoError(t, err)
+		}
+		require.Equal(t, math.NewInt(4000), keeper.getCurrentOutflow(ctx, btcToken))
+
+		bankKeeper := keeper.bankKeeper.(*mockBankKeeper)
+		expectMintBTC(bankKeeper, ctx, sender, math.NewInt(2000))
+
+		_, _, err := keeper.CancelDelayedBridgeOut(ctx, 1)
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewInt(2000), keeper.getCurrentOutflow(ctx, btcToken))
+
+		capacity := keeper.GetSenderOutflowCapacity(ctx, sender, btcToken)
+		require.Equal(t, uint32(1), capacity.CurrentCount)
+		require.Equal(t, math.NewInt(2000), capacity.CurrentAmount)
+
+		// The outflow cleared by a reset is not given back again.
+		keeper.setLastOutflowReset(ctx, 60)
+		keeper.setOutflowAmount(ctx, types.GetCurrentOutflowKey(btcToken), math.NewInt(500))
+
+		cancelCtx := ctx.WithBlockHeight(70)
+		expectMintBTC(bankKeeper, cancelCtx, sender, math.NewInt(2000))
+
+		_, _, err = keeper.CancelDelayedBridgeOut(cancelCtx, 2)
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewInt(500), keeper.getCurrentOutflow(cancelCtx, btcToken))
+	})
+}
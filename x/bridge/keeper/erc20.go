@@ -71,7 +71,8 @@ func (k Keeper) GetERC20TokenMappingFromMezoToken(
 	return nil, false
 }
 
-// CreateERC20TokenMapping creates a new ERC20 token mapping.
+// CreateERC20TokenMapping creates a new ERC20 token mapping that is active
+// immediately.
 // Requirements:
 // - The source token address must be a valid EVM address and not zero,
 // - The Mezo token address must be a valid EVM address and not zero,
@@ -81,8 +82,44 @@ func (k Keeper) CreateERC20TokenMapping(
 	ctx sdk.Context,
 	sourceToken, mezoToken []byte,
 ) error {
-	mapping := types.NewERC20TokenMapping(sourceToken, mezoToken)
+	return k.createERC20TokenMapping(
+		ctx,
+		types.NewERC20TokenMapping(sourceToken, mezoToken),
+	)
+}
+
+// ScheduleERC20TokenMapping creates a new ERC20 token mapping pending
+// activation. The mapping is announced right away but the token can be
+// bridged only from the given Mezo block height.
+// Requirements:
+// - Same as for CreateERC20TokenMapping,
+// - The activation height must be above the current block height.
+func (k Keeper) ScheduleERC20TokenMapping(
+	ctx sdk.Context,
+	sourceToken, mezoToken []byte,
+	activationHeight uint64,
+) error {
+	//nolint:gosec
+	if activationHeight <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidERC20TokenMappingState,
+			"activation height %d is not above the current block height %d",
+			activationHeight,
+			ctx.BlockHeight(),
+		)
+	}
+
+	return k.createERC20TokenMapping(
+		ctx,
+		types.NewPendingERC20TokenMapping(sourceToken, mezoToken, activationHeight),
+	)
+}
 
+// createERC20TokenMapping validates and stores a new ERC20 token mapping.
+func (k Keeper) createERC20TokenMapping(
+	ctx sdk.Context,
+	mapping *types.ERC20TokenMapping,
+) error {
 	// In the current implementation using types.NewERC20TokenMapping, there is
 	// no possibility for the sourceToken to be an invalid hex-encoded EVM address.
 	// However, we keep this check for to make this code future-proof. Same for the mezoToken.
@@ -119,9 +156,88 @@ func (k Keeper) CreateERC20TokenMapping(
 
 	k.setERC20TokenMapping(ctx, mapping)
 
+	k.emitEvent(ctx, &types.EventERC20TokenMappingCreated{
+		SourceToken: mapping.SourceToken,
+		MezoToken:   mapping.MezoToken,
+	})
+
+	if mapping.State != types.ERC20TokenMappingStateActive {
+		k.emitERC20TokenMappingStateSet(ctx, mapping)
+	}
+
 	return nil
 }
 
+// SetERC20TokenMappingState sets the lifecycle state of an existing ERC20
+// token mapping. Setting the active state on a mapping pending activation
+// activates it early.
+// Use ScheduleERC20TokenMapping to announce a mapping ahead of activation.
+// Requirements:
+// - The mapping must exist,
+// - The state must be a known state other than pending activation.
+func (k Keeper) SetERC20TokenMappingState(
+	ctx sdk.Context,
+	sourceToken []byte,
+	state types.ERC20TokenMappingState,
+) error {
+	if !state.IsValid() || state == types.ERC20TokenMappingStatePendingActivation {
+		return sdkerrors.Wrapf(types.ErrInvalidERC20TokenMappingState, "%d", state)
+	}
+
+	mapping, exists := k.GetERC20TokenMapping(ctx, sourceToken)
+	if !exists {
+		return types.ErrNotMapping
+	}
+
+	mapping.State = state
+	mapping.ActivationHeight = 0
+
+	k.setERC20TokenMapping(ctx, mapping)
+	k.emitERC20TokenMappingStateSet(ctx, mapping)
+
+	return nil
+}
+
+// activatePendingERC20TokenMappings activates the ERC20 token mappings whose
+// activation height is the next block height or below. It is called by the
+// end-blocker so the mappings are active for the whole activation block.
+func (k Keeper) activatePendingERC20TokenMappings(ctx sdk.Context) {
+	//nolint:gosec
+	nextHeight := uint64(ctx.BlockHeight()) + 1
+
+	for _, mapping := range k.GetERC20TokensMappings(ctx) {
+		if mapping.State != types.ERC20TokenMappingStatePendingActivation ||
+			mapping.ActivationHeight > nextHeight {
+			continue
+		}
+
+		mapping.State = types.ERC20TokenMappingStateActive
+		mapping.ActivationHeight = 0
+
+		k.setERC20TokenMapping(ctx, mapping)
+		k.emitERC20TokenMappingStateSet(ctx, mapping)
+
+		k.Logger(ctx).Info(
+			"ERC20 token mapping activated",
+			"sourceToken", mapping.SourceToken,
+			"mezoToken", mapping.MezoToken,
+			"activationHeight", nextHeight,
+		)
+	}
+}
+
+func (k Keeper) emitERC20TokenMappingStateSet(
+	ctx sdk.Context,
+	mapping *types.ERC20TokenMapping,
+) {
+	k.emitEvent(ctx, &types.EventERC20TokenMappingStateSet{
+		SourceToken:      mapping.SourceToken,
+		MezoToken:        mapping.MezoToken,
+		State:            mapping.State,
+		ActivationHeight: mapping.ActivationHeight,
+	})
+}
+
 // DeleteERC20TokenMapping deletes an ERC20 token mapping.
 // Requirements:
 // - The mapping must exist.
@@ -129,13 +245,19 @@ func (k Keeper) DeleteERC20TokenMapping(
 	ctx sdk.Context,
 	sourceToken []byte,
 ) error {
-	if _, exists := k.GetERC20TokenMapping(ctx, sourceToken); !exists {
+	mapping, exists := k.GetERC20TokenMapping(ctx, sourceToken)
+	if !exists {
 		return types.ErrNotMapping
 	}
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetERC20TokenMappingKey(sourceToken))
 
+	k.emitEvent(ctx, &types.EventERC20TokenMappingDeleted{
+		SourceToken: mapping.SourceToken,
+		MezoToken:   mapping.MezoToken,
+	})
+
 	return nil
 }
 
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// GetERC20Minted returns the total amount of the given Mezo ERC20 token
+// minted by the bridge.
+func (k Keeper) GetERC20Minted(ctx sdk.Context, mezoToken []byte) math.Int {
+	return k.getERC20Amount(ctx, types.GetERC20MintedKey(mezoToken))
+}
+
+// GetERC20Burnt returns the total amount of the given Mezo ERC20 token
+// burnt by the bridge.
+func (k Keeper) GetERC20Burnt(ctx sdk.Context, mezoToken []byte) math.Int {
+	return k.getERC20Amount(ctx, types.GetERC20BurntKey(mezoToken))
+}
+
+// increaseERC20Minted increases the total amount of the given Mezo ERC20
+// token minted by the bridge.
+func (k Keeper) increaseERC20Minted(
+	ctx sdk.Context,
+	mezoToken []byte,
+	amount math.Int,
+) {
+	key := types.GetERC20MintedKey(mezoToken)
+	k.setERC20Amount(ctx, key, k.getERC20Amount(ctx, key).Add(amount))
+}
+
+// increaseERC20Burnt increases the total amount of the given Mezo ERC20
+// token burnt by the bridge.
+func (k Keeper) increaseERC20Burnt(
+	ctx sdk.Context,
+	mezoToken []byte,
+	amount math.Int,
+) {
+	key := types.GetERC20BurntKey(mezoToken)
+	k.setERC20Amount(ctx, key, k.getERC20Amount(ctx, key).Add(amount))
+}
+
+// GetAllERC20Supplies returns the amounts minted and burnt by the bridge of
+// all ERC20 tokens having any, ordered by the Mezo token address.
+func (k Keeper) GetAllERC20Supplies(ctx sdk.Context) []types.ERC20Supply {
+	supplies := make(map[string]*types.ERC20Supply)
+
+	collect := func(prefix []byte, set func(*types.ERC20Supply, math.Int)) {
+		iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+		defer func() {
+			_ = iterator.Close()
+		}()
+
+		for ; iterator.Valid(); iterator.Next() {
+			token := evmtypes.BytesToHexAddress(iterator.Key()[len(prefix):])
+
+			supply, ok := supplies[token]
+			if !ok {
+				supply = &types.ERC20Supply{
+					Token:  token,
+					Minted: math.ZeroInt(),
+					Burnt:  math.ZeroInt(),
+				}
+				supplies[token] = supply
+			}
+
+			set(supply, unmarshalERC20Amount(iterator.Value()))
+		}
+	}
+
+	collect(types.ERC20MintedKeyPrefix, func(supply *types.ERC20Supply, amount math.Int) {
+		supply.Minted = amount
+	})
+	collect(types.ERC20BurntKeyPrefix, func(supply *types.ERC20Supply, amount math.Int) {
+		supply.Burnt = amount
+	})
+
+	if len(supplies) == 0 {
+		return nil
+	}
+
+	out := make([]types.ERC20Supply, 0, len(supplies))
+	for _, supply := range supplies {
+		out = append(out, *supply)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return bytes.Compare(
+			evmtypes.HexAddressToBytes(out[i].Token),
+			evmtypes.HexAddressToBytes(out[j].Token),
+		) < 0
+	})
+
+	return out
+}
+
+// setERC20Supply stores the amounts of an ERC20 token minted and burnt by
+// the bridge. It is used to initialize the module state from genesis.
+func (k Keeper) setERC20Supply(ctx sdk.Context, supply types.ERC20Supply) {
+	token := evmtypes.HexAddressToBytes(supply.Token)
+
+	k.setERC20Amount(ctx, types.GetERC20MintedKey(token), supply.Minted)
+	k.setERC20Amount(ctx, types.GetERC20BurntKey(token), supply.Burnt)
+}
+
+// GetERC20TotalSupply returns the total supply reported by the given Mezo
+// ERC20 token contract. The totalSupply call is executed against a cached
+// context so it never changes the module state.
+func (k Keeper) GetERC20TotalSupply(
+	ctx sdk.Context,
+	mezoToken []byte,
+) (math.Int, error) {
+	call, err := evmtypes.NewERC20TotalSupplyCall(
+		authtypes.NewModuleAddress(types.ModuleName).Bytes(),
+		mezoToken,
+	)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to create ERC20 totalSupply call: %w", err)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	res, _, err := k.evmKeeper.ExecuteContractCall(cacheCtx, call)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to execute ERC20 totalSupply call: %w", err)
+	}
+
+	totalSupply, err := evmtypes.UnpackERC20TotalSupply(res.Ret)
+	if err != nil {
+		return math.Int{}, fmt.Errorf("failed to unpack ERC20 totalSupply result: %w", err)
+	}
+
+	return math.NewIntFromBigInt(totalSupply), nil
+}
+
+// InitERC20Supplies sets the minted amount of every mapped ERC20 token to
+// the current total supply of the token contract and clears the burnt
+// amount. It is meant to start the supply tracking of tokens that were
+// bridged before the tracking existed.
+func (k Keeper) InitERC20Supplies(ctx sdk.Context) error {
+	for _, mapping := range k.GetERC20TokensMappings(ctx) {
+		mezoToken := evmtypes.HexAddressToBytes(mapping.MezoToken)
+
+		totalSupply, err := k.GetERC20TotalSupply(ctx, mezoToken)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to get total supply of %s: %w",
+				mapping.MezoToken,
+				err,
+			)
+		}
+
+		k.setERC20Supply(ctx, types.ERC20Supply{
+			Token:  mapping.MezoToken,
+			Minted: totalSupply,
+			Burnt:  math.ZeroInt(),
+		})
+	}
+
+	return nil
+}
+
+// ERC20SupplyCheckBlocks is the number of blocks between two ERC20 supply
+// checks run by the end-blocker. Each check executes one totalSupply EVM
+// call per mapped token that can be bridged out.
+const ERC20SupplyCheckBlocks = 100
+
+// checkERC20Supplies asserts that, for each mapped ERC20 token that can be
+// bridged out: token_supply = total_token_minted - total_token_burnt.
+// token_supply being the total supply reported by the token contract on
+// Mezo and total_token_{minted/burnt} being value tracked when the x/bridge
+// mints or burns the token. The check runs every ERC20SupplyCheckBlocks
+// blocks. The invariant holds only if mapped tokens are minted and burnt by
+// the bridge alone. A violation does not halt the chain. Bridge-out of the token
+// is paused instead, so source chain liquidity cannot be released against
+// tokens the bridge did not mint, and an EventERC20SupplyMismatch is emitted
+// for the operators to investigate.
+func (k *Keeper) checkERC20Supplies(ctx sdk.Context) {
+	if ctx.BlockHeight()%ERC20SupplyCheckBlocks != 0 {
+		return
+	}
+
+	for _, mapping := range k.GetERC20TokensMappings(ctx) {
+		if !mapping.IsBridgeOutEnabled() {
+			continue
+		}
+
+		totalSupply, totalMinted, totalBurnt, err := k.getERC20SupplyState(ctx, mapping)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to check ERC20 supply",
+				"token", mapping.MezoToken,
+				"error", err,
+			)
+			continue
+		}
+
+		if totalSupply.Equal(totalMinted.Sub(totalBurnt)) {
+			continue
+		}
+
+		// Keep bridge-in paused if it already was.
+		if mapping.State == types.ERC20TokenMappingStateActive {
+			mapping.State = types.ERC20TokenMappingStateBridgeOutPaused
+		} else {
+			mapping.State = types.ERC20TokenMappingStatePaused
+		}
+
+		k.setERC20TokenMapping(ctx, mapping)
+		k.emitERC20TokenMappingStateSet(ctx, mapping)
+
+		k.emitEvent(ctx, &types.EventERC20SupplyMismatch{
+			SourceToken: mapping.SourceToken,
+			MezoToken:   mapping.MezoToken,
+			TotalSupply: totalSupply,
+			Minted:      totalMinted,
+			Burnt:       totalBurnt,
+		})
+
+		k.Logger(ctx).Error(
+			"ERC20 supply mismatch; bridge-out of the token paused",
+			"token", mapping.MezoToken,
+			"totalSupply", totalSupply.String(),
+			"totalMinted", totalMinted.String(),
+			"totalBurnt", totalBurnt.String(),
+		)
+	}
+}
+
+// getERC20SupplyState returns the total supply reported by the Mezo token
+// contract of the mapping and the amounts of the token minted and burnt by
+// the bridge.
+func (k *Keeper) getERC20SupplyState(
+	ctx sdk.Context,
+	mapping *types.ERC20TokenMapping,
+) (totalSupply, totalMinted, totalBurnt math.Int, err error) {
+	mezoToken := evmtypes.HexAddressToBytes(mapping.MezoToken)
+
+	totalSupply, err = k.GetERC20TotalSupply(ctx, mezoToken)
+	if err != nil {
+		return math.Int{}, math.Int{}, math.Int{}, fmt.Errorf(
+			"failed to get total supply of %s: %w",
+			mapping.MezoToken,
+			err,
+		)
+	}
+
+	return totalSupply, k.GetERC20Minted(ctx, mezoToken), k.GetERC20Burnt(ctx, mezoToken), nil
+}
+
+// getERC20Amount returns the ERC20 amount stored under the given key.
+func (k Keeper) getERC20Amount(ctx sdk.Context, key []byte) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	return unmarshalERC20Amount(bz)
+}
+
+// setERC20Amount stores the ERC20 amount under the given key. A zero amount
+// removes the key.
+func (k Keeper) setERC20Amount(ctx sdk.Context, key []byte, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+
+	if amount.IsNil() || amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(key, bz)
+}
+
+// unmarshalERC20Amount decodes an ERC20 amount read from the store.
+func unmarshalERC20Amount(bz []byte) math.Int {
+	amount := math.ZeroInt()
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
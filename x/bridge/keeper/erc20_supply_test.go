@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockERC20TotalSupply makes the next totalSupply call of the given token
+// return the given amount.
+func mockERC20TotalSupply(k Keeper, token string, amount int64) {
+	k.evmKeeper.(*mockEvmKeeper).
+		On(
+			"ExecuteContractCall",
+			mock.Anything,
+			mock.MatchedBy(func(call evmtypes.ContractCall) bool {
+				_, ok := call.(*evmtypes.ERC20TotalSupplyCall)
+				return ok && *call.To() == common.HexToAddress(token)
+			}),
+		).
+		Return(
+			&evmtypes.MsgEthereumTxResponse{
+				Ret: common.LeftPadBytes(math.NewInt(amount).BigInt().Bytes(), 32),
+			},
+			nil,
+		).
+		Once()
+}
+
+func TestERC20MintedAndBurnt(t *testing.T) {
+	ctx, k := mockContext()
+
+	token1 := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+	token2 := evmtypes.HexAddressToBytes(testMezoERC20Token2)
+
+	require.True(t, k.GetERC20Minted(ctx, token1).IsZero())
+	require.True(t, k.GetERC20Burnt(ctx, token1).IsZero())
+	require.Nil(t, k.GetAllERC20Supplies(ctx))
+
+	k.increaseERC20Minted(ctx, token1, math.NewInt(100))
+	k.increaseERC20Minted(ctx, token1, math.NewInt(50))
+	k.increaseERC20Burnt(ctx, token1, math.NewInt(30))
+	k.increaseERC20Burnt(ctx, token2, math.NewInt(10))
+
+	require.Equal(t, math.NewInt(150), k.GetERC20Minted(ctx, token1))
+	require.Equal(t, math.NewInt(30), k.GetERC20Burnt(ctx, token1))
+	require.True(t, k.GetERC20Minted(ctx, token2).IsZero())
+	require.Equal(t, math.NewInt(10), k.GetERC20Burnt(ctx, token2))
+
+	// The supplies are ordered by the token address.
+	require.Equal(
+		t,
+		[]types.ERC20Supply{
+			{
+				Token:  evmtypes.BytesToHexAddress(token1),
+				Minted: math.NewInt(150),
+				Burnt:  math.NewInt(30),
+			},
+			{
+				Token:  evmtypes.BytesToHexAddress(token2),
+				Minted: math.ZeroInt(),
+				Burnt:  math.NewInt(10),
+			},
+		},
+		k.GetAllERC20Supplies(ctx),
+	)
+}
+
+func TestGetERC20TotalSupply(t *testing.T) {
+	t.Run("returns the token total supply", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		mockERC20TotalSupply(k, testMezoERC20Token1, 1000)
+
+		totalSupply, err := k.GetERC20TotalSupply(
+			ctx,
+			evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		)
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(1000), totalSupply)
+	})
+
+	t.Run("returns an error when the call fails", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		k.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", mock.Anything, mock.Anything).
+			Return(nil, errors.New("execution reverted"))
+
+		_, err := k.GetERC20TotalSupply(
+			ctx,
+			evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		)
+		require.ErrorContains(t, err, "execution reverted")
+	})
+
+	t.Run("returns an error when the result is malformed", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		k.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", mock.Anything, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{Ret: []byte{0x01}}, nil)
+
+		_, err := k.GetERC20TotalSupply(
+			ctx,
+			evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		)
+		require.ErrorContains(t, err, "invalid totalSupply return data length")
+	})
+}
+
+func TestCheckERC20Supplies(t *testing.T) {
+	setup := func() (sdk.Context, Keeper) {
+		ctx, k := mockContext()
+
+		k.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes(testSourceERC20Token1),
+			evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		))
+		k.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes(testSourceERC20Token2),
+			evmtypes.HexAddressToBytes(testMezoERC20Token2),
+		))
+
+		k.increaseERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1), math.NewInt(42))
+		k.increaseERC20Burnt(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1), math.NewInt(21))
+		k.increaseERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token2), math.NewInt(7))
+
+		return ctx, k
+	}
+
+	t.Run("end-blocker pauses bridge-out when state is invalid", func(t *testing.T) {
+		ctx, k := setup()
+		ctx = ctx.WithBlockHeight(ERC20SupplyCheckBlocks)
+
+		params := k.GetParams(ctx)
+		params.BtcSupplyAssertionEnabled = false
+		params.Erc20SupplyAssertionEnabled = true
+		require.NoError(t, k.SetParams(ctx, params))
+
+		require.NoError(t, k.SetERC20TokenMappingState(
+			ctx,
+			evmtypes.HexAddressToBytes(testSourceERC20Token2),
+			types.ERC20TokenMappingStateBridgeInPaused,
+		))
+
+		mockERC20TotalSupply(k, testMezoERC20Token1, 21)
+		mockERC20TotalSupply(k, testMezoERC20Token2, 7)
+
+		require.NotPanics(t, func() { require.NoError(t, k.EndBlock(ctx)) })
+		require.Empty(t, emittedEvents[*types.EventERC20SupplyMismatch](t, ctx))
+
+		mockERC20TotalSupply(k, testMezoERC20Token1, 22)
+		mockERC20TotalSupply(k, testMezoERC20Token2, 8)
+
+		checkCtx := ctx.WithBlockHeight(2 * ERC20SupplyCheckBlocks)
+		require.NotPanics(t, func() { require.NoError(t, k.EndBlock(checkCtx)) })
+
+		mapping1, _ := k.GetERC20TokenMapping(ctx, evmtypes.HexAddressToBytes(testSourceERC20Token1))
+		require.Equal(t, types.ERC20TokenMappingStateBridgeOutPaused, mapping1.State)
+
+		// Bridge-in stays paused.
+		mapping2, _ := k.GetERC20TokenMapping(ctx, evmtypes.HexAddressToBytes(testSourceERC20Token2))
+		require.Equal(t, types.ERC20TokenMappingStatePaused, mapping2.State)
+
+		events := emittedEvents[*types.EventERC20SupplyMismatch](t, checkCtx)
+		require.Len(t, events, 2)
+		require.Contains(t, events, &types.EventERC20SupplyMismatch{
+			SourceToken: testSourceERC20Token1,
+			MezoToken:   testMezoERC20Token1,
+			TotalSupply: math.NewInt(22),
+			Minted:      math.NewInt(42),
+			Burnt:       math.NewInt(21),
+		})
+
+		// Tokens with bridge-out paused are not checked anymore.
+		require.NoError(t, k.EndBlock(ctx.WithBlockHeight(3*ERC20SupplyCheckBlocks)))
+		k.evmKeeper.(*mockEvmKeeper).AssertNumberOfCalls(t, "ExecuteContractCall", 4)
+	})
+
+	t.Run("end-blocker skips tokens with unavailable supply", func(t *testing.T) {
+		ctx, k := setup()
+		ctx = ctx.WithBlockHeight(ERC20SupplyCheckBlocks)
+
+		params := k.GetParams(ctx)
+		params.BtcSupplyAssertionEnabled = false
+		params.Erc20SupplyAssertionEnabled = true
+		require.NoError(t, k.SetParams(ctx, params))
+
+		k.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", mock.Anything, mock.Anything).
+			Return(nil, errors.New("execution reverted"))
+
+		require.NotPanics(t, func() { require.NoError(t, k.EndBlock(ctx)) })
+		require.Empty(t, emittedEvents[*types.EventERC20SupplyMismatch](t, ctx))
+
+		mapping, _ := k.GetERC20TokenMapping(ctx, evmtypes.HexAddressToBytes(testSourceERC20Token1))
+		require.Equal(t, types.ERC20TokenMappingStateActive, mapping.State)
+	})
+
+	t.Run("end-blocker checks the supply periodically", func(t *testing.T) {
+		ctx, k := setup()
+		ctx = ctx.WithBlockHeight(ERC20SupplyCheckBlocks + 1)
+
+		params := k.GetParams(ctx)
+		params.BtcSupplyAssertionEnabled = false
+		params.Erc20SupplyAssertionEnabled = true
+		require.NoError(t, k.SetParams(ctx, params))
+
+		require.NoError(t, k.EndBlock(ctx))
+		k.evmKeeper.(*mockEvmKeeper).AssertNotCalled(
+			t,
+			"ExecuteContractCall",
+			mock.Anything,
+			mock.Anything,
+		)
+	})
+
+	t.Run("end-blocker skips the assertion when disabled", func(t *testing.T) {
+		ctx, k := setup()
+
+		params := k.GetParams(ctx)
+		params.BtcSupplyAssertionEnabled = false
+		require.NoError(t, k.SetParams(ctx, params))
+
+		require.NoError(t, k.EndBlock(ctx))
+		k.evmKeeper.(*mockEvmKeeper).AssertNotCalled(
+			t,
+			"ExecuteContractCall",
+			mock.Anything,
+			mock.Anything,
+		)
+	})
+}
+
+func TestInitERC20Supplies(t *testing.T) {
+	ctx, k := mockContext()
+
+	token1 := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+	token2 := evmtypes.HexAddressToBytes(testMezoERC20Token2)
+
+	k.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(
+		evmtypes.HexAddressToBytes(testSourceERC20Token1),
+		token1,
+	))
+	k.setERC20TokenMapping(ctx, types.NewERC20TokenMapping(
+		evmtypes.HexAddressToBytes(testSourceERC20Token2),
+		token2,
+	))
+
+	// Stale amounts are replaced.
+	k.increaseERC20Minted(ctx, token1, math.NewInt(5))
+	k.increaseERC20Burnt(ctx, token1, math.NewInt(3))
+
+	mockERC20TotalSupply(k, testMezoERC20Token1, 100)
+	mockERC20TotalSupply(k, testMezoERC20Token2, 0)
+
+	require.NoError(t, k.InitERC20Supplies(ctx))
+
+	require.Equal(t, math.NewInt(100), k.GetERC20Minted(ctx, token1))
+	require.True(t, k.GetERC20Burnt(ctx, token1).IsZero())
+	require.True(t, k.GetERC20Minted(ctx, token2).IsZero())
+	require.True(t, k.GetERC20Burnt(ctx, token2).IsZero())
+}
@@ -70,6 +70,17 @@ func TestCreateERC20TokenMapping(t *testing.T) {
 	err = k.CreateERC20TokenMapping(ctx, sourceToken, mezoToken)
 	require.NoError(t, err)
 
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingCreated{
+			{
+				SourceToken: testSourceERC20Token1,
+				MezoToken:   testMezoERC20Token1,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingCreated](t, ctx),
+	)
+
 	// Test duplicate mapping.
 	err = k.CreateERC20TokenMapping(ctx, sourceToken, mezoToken)
 	require.ErrorContains(t, err, types.ErrAlreadyMapping.Error())
@@ -106,4 +117,179 @@ func TestDeleteERC20TokenMapping(t *testing.T) {
 	// Test mapping is deleted
 	_, found := k.GetERC20TokenMapping(ctx, sourceToken)
 	require.False(t, found)
+
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingDeleted{
+			{
+				SourceToken: mapping.SourceToken,
+				MezoToken:   mapping.MezoToken,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingDeleted](t, ctx),
+	)
+}
+
+func TestScheduleERC20TokenMapping(t *testing.T) {
+	ctx, k := mockContext()
+	ctx = ctx.WithBlockHeight(100)
+	sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+	mezoToken := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	err := k.SetParams(ctx, types.Params{MaxErc20TokensMappings: 1})
+	require.NoError(t, err)
+
+	k.evmKeeper.(*mockEvmKeeper).On("IsContract", ctx, mezoToken).Return(true)
+
+	// Test activation height not above the current height.
+	err = k.ScheduleERC20TokenMapping(ctx, sourceToken, mezoToken, 100)
+	require.ErrorIs(t, err, types.ErrInvalidERC20TokenMappingState)
+
+	// Test valid scheduling.
+	err = k.ScheduleERC20TokenMapping(ctx, sourceToken, mezoToken, 150)
+	require.NoError(t, err)
+
+	mapping, found := k.GetERC20TokenMapping(ctx, sourceToken)
+	require.True(t, found)
+	require.Equal(t, types.ERC20TokenMappingStatePendingActivation, mapping.State)
+	require.Equal(t, uint64(150), mapping.ActivationHeight)
+
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingCreated{
+			{
+				SourceToken: testSourceERC20Token1,
+				MezoToken:   testMezoERC20Token1,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingCreated](t, ctx),
+	)
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingStateSet{
+			{
+				SourceToken:      testSourceERC20Token1,
+				MezoToken:        testMezoERC20Token1,
+				State:            types.ERC20TokenMappingStatePendingActivation,
+				ActivationHeight: 150,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingStateSet](t, ctx),
+	)
+
+	// Test pending mappings count towards the maximum.
+	err = k.ScheduleERC20TokenMapping(
+		ctx,
+		evmtypes.HexAddressToBytes(testSourceERC20Token2),
+		evmtypes.HexAddressToBytes(testMezoERC20Token2),
+		150,
+	)
+	require.ErrorIs(t, err, types.ErrMaxMappingsReached)
+}
+
+func TestSetERC20TokenMappingState(t *testing.T) {
+	ctx, k := mockContext()
+	sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+	mezoToken := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	// Test non-existing mapping.
+	err := k.SetERC20TokenMappingState(
+		ctx,
+		sourceToken,
+		types.ERC20TokenMappingStatePaused,
+	)
+	require.ErrorIs(t, err, types.ErrNotMapping)
+
+	k.setERC20TokenMapping(
+		ctx,
+		types.NewPendingERC20TokenMapping(sourceToken, mezoToken, 150),
+	)
+
+	// Test pending activation cannot be set directly.
+	err = k.SetERC20TokenMappingState(
+		ctx,
+		sourceToken,
+		types.ERC20TokenMappingStatePendingActivation,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidERC20TokenMappingState)
+
+	// Test unknown state.
+	err = k.SetERC20TokenMappingState(ctx, sourceToken, 42)
+	require.ErrorIs(t, err, types.ErrInvalidERC20TokenMappingState)
+
+	// Test pausing a pending mapping clears the activation height.
+	err = k.SetERC20TokenMappingState(
+		ctx,
+		sourceToken,
+		types.ERC20TokenMappingStateBridgeInPaused,
+	)
+	require.NoError(t, err)
+
+	mapping, found := k.GetERC20TokenMapping(ctx, sourceToken)
+	require.True(t, found)
+	require.Equal(t, types.ERC20TokenMappingStateBridgeInPaused, mapping.State)
+	require.Zero(t, mapping.ActivationHeight)
+
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingStateSet{
+			{
+				SourceToken: testSourceERC20Token1,
+				MezoToken:   testMezoERC20Token1,
+				State:       types.ERC20TokenMappingStateBridgeInPaused,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingStateSet](t, ctx),
+	)
+}
+
+func TestActivatePendingERC20TokenMappings(t *testing.T) {
+	ctx, k := mockContext()
+	sourceToken1 := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+	sourceToken2 := evmtypes.HexAddressToBytes(testSourceERC20Token2)
+
+	k.setERC20TokensMappings(
+		ctx,
+		[]*types.ERC20TokenMapping{
+			types.NewPendingERC20TokenMapping(
+				sourceToken1,
+				evmtypes.HexAddressToBytes(testMezoERC20Token1),
+				150,
+			),
+			types.NewPendingERC20TokenMapping(
+				sourceToken2,
+				evmtypes.HexAddressToBytes(testMezoERC20Token2),
+				200,
+			),
+		},
+	)
+
+	// The end-blocker of the block before the activation height activates
+	// the mapping so it is active for the whole activation block.
+	k.activatePendingERC20TokenMappings(ctx.WithBlockHeight(148))
+
+	mapping1, _ := k.GetERC20TokenMapping(ctx, sourceToken1)
+	require.Equal(t, types.ERC20TokenMappingStatePendingActivation, mapping1.State)
+
+	k.activatePendingERC20TokenMappings(ctx.WithBlockHeight(149))
+
+	mapping1, _ = k.GetERC20TokenMapping(ctx, sourceToken1)
+	require.Equal(t, types.ERC20TokenMappingStateActive, mapping1.State)
+	require.Zero(t, mapping1.ActivationHeight)
+
+	mapping2, _ := k.GetERC20TokenMapping(ctx, sourceToken2)
+	require.Equal(t, types.ERC20TokenMappingStatePendingActivation, mapping2.State)
+	require.Equal(t, uint64(200), mapping2.ActivationHeight)
+
+	require.Equal(
+		t,
+		[]*types.EventERC20TokenMappingStateSet{
+			{
+				SourceToken: testSourceERC20Token1,
+				MezoToken:   testMezoERC20Token1,
+				State:       types.ERC20TokenMappingStateActive,
+			},
+		},
+		emittedEvents[*types.EventERC20TokenMappingStateSet](t, ctx),
+	)
 }
@@ -24,6 +24,17 @@ func (k Keeper) InitGenesis(
 	}
 
 	k.setAssetsLockedSequenceTip(ctx, genState.AssetsLockedSequenceTip)
+
+	// A genesis state predating the AssetsLocked event store has no pruned
+	// sequence tip. In that case, none of the accepted events is retained.
+	if !genState.AssetsLockedPrunedSequenceTip.IsNil() {
+		k.setAssetsLockedPrunedSequenceTip(ctx, genState.AssetsLockedPrunedSequenceTip)
+	}
+
+	for _, record := range genState.AssetsLockedEvents {
+		k.saveAssetsLocked(ctx, record)
+	}
+
 	k.setAssetsUnlockedSequenceTip(ctx, genState.AssetsUnlockedSequenceTip)
 	k.SetSourceBTCToken(ctx, evmtypes.HexAddressToBytes(genState.SourceBtcToken))
 	k.setERC20TokensMappings(ctx, genState.Erc20TokensMappings)
@@ -61,6 +72,94 @@ func (k Keeper) InitGenesis(
 		k.increaseCurrentOutflow(ctx, evmtypes.HexAddressToBytes(outflowAmount.Token), outflowAmount.Amount)
 	}
 
+	// Windows are set after the fixed-interval amounts so the amounts land in
+	// the slots they were exported from.
+	for _, entry := range genState.OutflowWindows {
+		k.setOutflowWindow(
+			ctx,
+			types.GetOutflowWindowKey(evmtypes.HexAddressToBytes(entry.Token)),
+			entry.Window,
+		)
+	}
+
+	for _, bucket := range genState.OutflowBuckets {
+		k.setOutflowAmount(
+			ctx,
+			types.GetOutflowBucketKey(evmtypes.HexAddressToBytes(bucket.Token), bucket.Index),
+			bucket.Amount,
+		)
+	}
+
+	// A genesis state predating the USD outflow limit has no USD amounts.
+	if !genState.UsdOutflowLimit.IsNil() {
+		k.SetUSDOutflowLimit(ctx, genState.UsdOutflowLimit)
+	}
+
+	k.setOutflowWindow(ctx, types.USDOutflowWindowKey, genState.UsdOutflowWindow)
+
+	if !genState.CurrentUsdOutflow.IsNil() && genState.CurrentUsdOutflow.IsPositive() {
+		k.setOutflowAmount(ctx, types.CurrentUSDOutflowKey, genState.CurrentUsdOutflow)
+	}
+
+	for _, bucket := range genState.UsdOutflowBuckets {
+		k.setOutflowAmount(ctx, types.GetUSDOutflowBucketKey(bucket.Index), bucket.Amount)
+	}
+
+	for _, feed := range genState.OutflowPriceFeeds {
+		if err := k.SetOutflowPriceFeed(ctx, feed); err != nil {
+			panic(errorsmod.Wrapf(err, "error setting outflow price feed"))
+		}
+	}
+
+	if err := k.SetSenderOutflowParams(ctx, genState.SenderOutflowParams); err != nil {
+		panic(errorsmod.Wrapf(err, "error setting sender outflow params"))
+	}
+
+	for _, entry := range genState.SenderOutflowLimits {
+		k.SetSenderOutflowLimit(ctx, evmtypes.HexAddressToBytes(entry.Token), entry.Limit)
+	}
+
+	for _, outflow := range genState.SenderOutflows {
+		k.setSenderOutflow(ctx, evmtypes.HexAddressToBytes(outflow.Sender), outflow)
+	}
+
+	if err := k.SetDelayedBridgeOutBlocks(ctx, genState.DelayedBridgeOutBlocks); err != nil {
+		panic(errorsmod.Wrapf(err, "error setting delayed bridge-out blocks"))
+	}
+
+	for _, entry := range genState.DelayedBridgeOutThresholds {
+		k.SetDelayedBridgeOutThreshold(ctx, evmtypes.HexAddressToBytes(entry.Token), entry.Threshold)
+	}
+
+	for i := range genState.DelayedBridgeOuts {
+		k.setDelayedBridgeOut(ctx, &genState.DelayedBridgeOuts[i])
+	}
+
+	k.setDelayedBridgeOutSequenceTip(ctx, genState.DelayedBridgeOutSequenceTip)
+
+	for _, supply := range genState.Erc20Supplies {
+		k.setERC20Supply(ctx, supply)
+	}
+
+	for _, state := range genState.SourceChains {
+		k.setSourceChainState(ctx, state)
+	}
+
+	for _, record := range genState.SourceChainAssetsLockedEvents {
+		k.saveAssetsLocked(ctx, record)
+	}
+
+	if genState.BridgeOutFeeTreasury != "" {
+		ctx.KVStore(k.storeKey).Set(
+			types.BridgeOutFeeTreasuryKey,
+			evmtypes.HexAddressToBytes(genState.BridgeOutFeeTreasury),
+		)
+	}
+
+	for _, fee := range genState.BridgeOutFees {
+		k.setBridgeOutFee(ctx, fee)
+	}
+
 	err = k.IncreaseBTCMinted(ctx, genState.InitialBtcSupply)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error setting params"))
@@ -107,39 +206,101 @@ func (k Keeper) InitGenesis(
 			entry.Amount,
 		)
 	}
+
+	// A genesis state predating the triparty outcome store has no pruned
+	// sequence tip. In that case, none of the processed requests has a
+	// retained outcome.
+	if !genState.TripartyOutcomesPrunedSequenceTip.IsNil() {
+		k.setTripartyOutcomesPrunedSequenceTip(ctx, genState.TripartyOutcomesPrunedSequenceTip)
+	}
+
+	for _, outcome := range genState.TripartyOutcomes {
+		k.saveTripartyBridgeRequestOutcome(ctx, outcome)
+	}
+
+	// A genesis state predating the triparty callback retry queue has no
+	// retry policy. In that case, the default policy is used.
+	if genState.TripartyCallbackRetryPolicy != nil {
+		err = k.SetTripartyCallbackRetryPolicy(ctx, *genState.TripartyCallbackRetryPolicy)
+		if err != nil {
+			panic(errorsmod.Wrapf(err, "error setting triparty callback retry policy"))
+		}
+	}
+
+	for _, failed := range genState.FailedTripartyCallbacks {
+		k.saveFailedTripartyCallback(ctx, failed)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	tripartyCallbackRetryPolicy := k.GetTripartyCallbackRetryPolicy(ctx)
+
 	return &types.GenesisState{
-		Params:                         k.GetParams(ctx),
-		AssetsLockedSequenceTip:        k.GetAssetsLockedSequenceTip(ctx),
-		AssetsUnlockedSequenceTip:      k.GetAssetsUnlockedSequenceTip(ctx),
-		SourceBtcToken:                 evmtypes.BytesToHexAddress(k.GetSourceBTCToken(ctx)),
-		Erc20TokensMappings:            k.GetERC20TokensMappings(ctx),
-		InitialBtcSupply:               k.GetBTCMinted(ctx).Sub(k.GetBTCBurnt(ctx)),
-		AssetsUnlockedEvents:           k.GetAllAssetsUnlockedEvents(ctx),
-		BitcoinChainMinBridgeOutAmount: k.GetMinBridgeOutAmountForBitcoinChain(ctx),
-		TokenMinBridgeOutAmounts:       k.GetAllMinBridgeOutAmounts(ctx),
-		LastOutflowReset:               k.getLastOutflowReset(ctx),
-		CurrentOutflowLimits:           k.GetAllCurrentOutflowLimits(ctx),
-		CurrentOutflowAmounts:          k.GetAllCurrentOutflowAmounts(ctx),
-		AllowedTripartyControllers:     k.getAllAllowedTripartyControllers(ctx),
-		TripartyBlockDelay:             k.GetTripartyBlockDelay(ctx),
-		TripartyPerRequestLimit:        k.GetTripartyPerRequestLimit(ctx),
-		TripartyWindowLimit:            k.GetTripartyWindowLimit(ctx),
-		TripartyRequestSequenceTip:     k.GetTripartyRequestSequenceTip(ctx),
-		TripartyProcessedSequenceTip:   k.GetTripartyProcessedSequenceTip(ctx),
-		TripartyPendingRequests:        k.getAllPendingTripartyBridgeRequests(ctx),
-		TripartyWindowConsumed:         k.getTripartyWindowConsumed(ctx),
-		TripartyWindowLastReset:        k.getTripartyWindowLastReset(ctx),
-		TripartyControllerBtcMinted:    k.getAllTripartyControllerBTCMinted(ctx),
-		BridgeOutPaused:                k.IsBridgeOutPaused(ctx),
-		BridgeInPaused:                 k.IsBridgeInPaused(ctx),
-		BridgeOutChains:                k.exportBridgeOutChains(ctx),
+		Params:                            k.GetParams(ctx),
+		AssetsLockedSequenceTip:           k.GetAssetsLockedSequenceTip(ctx),
+		AssetsUnlockedSequenceTip:         k.GetAssetsUnlockedSequenceTip(ctx),
+		SourceBtcToken:                    evmtypes.BytesToHexAddress(k.GetSourceBTCToken(ctx)),
+		Erc20TokensMappings:               k.GetERC20TokensMappings(ctx),
+		InitialBtcSupply:                  k.GetBTCMinted(ctx).Sub(k.GetBTCBurnt(ctx)),
+		AssetsUnlockedEvents:              k.GetAllAssetsUnlockedEvents(ctx),
+		BitcoinChainMinBridgeOutAmount:    k.GetMinBridgeOutAmountForBitcoinChain(ctx),
+		TokenMinBridgeOutAmounts:          k.GetAllMinBridgeOutAmounts(ctx),
+		LastOutflowReset:                  k.getLastOutflowReset(ctx),
+		CurrentOutflowLimits:              k.GetAllCurrentOutflowLimits(ctx),
+		CurrentOutflowAmounts:             k.GetAllCurrentOutflowAmounts(ctx),
+		AllowedTripartyControllers:        k.getAllAllowedTripartyControllers(ctx),
+		TripartyBlockDelay:                k.GetTripartyBlockDelay(ctx),
+		TripartyPerRequestLimit:           k.GetTripartyPerRequestLimit(ctx),
+		TripartyWindowLimit:               k.GetTripartyWindowLimit(ctx),
+		TripartyRequestSequenceTip:        k.GetTripartyRequestSequenceTip(ctx),
+		TripartyProcessedSequenceTip:      k.GetTripartyProcessedSequenceTip(ctx),
+		TripartyPendingRequests:           k.getAllPendingTripartyBridgeRequests(ctx),
+		TripartyWindowConsumed:            k.getTripartyWindowConsumed(ctx),
+		TripartyWindowLastReset:           k.getTripartyWindowLastReset(ctx),
+		TripartyControllerBtcMinted:       k.getAllTripartyControllerBTCMinted(ctx),
+		BridgeOutPaused:                   k.IsBridgeOutPaused(ctx),
+		BridgeInPaused:                    k.IsBridgeInPaused(ctx),
+		BridgeOutChains:                   k.exportBridgeOutChains(ctx),
+		AssetsLockedEvents:                k.GetAllAssetsLocked(ctx),
+		AssetsLockedPrunedSequenceTip:     k.GetAssetsLockedPrunedSequenceTip(ctx),
+		OutflowWindows:                    k.GetAllOutflowWindows(ctx),
+		OutflowBuckets:                    k.GetAllOutflowBuckets(ctx),
+		UsdOutflowLimit:                   k.GetUSDOutflowLimit(ctx),
+		UsdOutflowWindow:                  k.GetUSDOutflowWindow(ctx),
+		CurrentUsdOutflow:                 k.getOutflowAmount(ctx, types.CurrentUSDOutflowKey),
+		UsdOutflowBuckets:                 k.GetAllUSDOutflowBuckets(ctx),
+		OutflowPriceFeeds:                 k.GetAllOutflowPriceFeeds(ctx),
+		SenderOutflowParams:               k.GetSenderOutflowParams(ctx),
+		SenderOutflowLimits:               k.GetAllSenderOutflowLimits(ctx),
+		SenderOutflows:                    k.GetAllSenderOutflows(ctx),
+		DelayedBridgeOutBlocks:            k.GetDelayedBridgeOutBlocks(ctx),
+		DelayedBridgeOutThresholds:        k.GetAllDelayedBridgeOutThresholds(ctx),
+		DelayedBridgeOuts:                 k.GetAllDelayedBridgeOuts(ctx),
+		DelayedBridgeOutSequenceTip:       k.GetDelayedBridgeOutSequenceTip(ctx),
+		Erc20Supplies:                     k.GetAllERC20Supplies(ctx),
+		SourceChains:                      k.GetAllSourceChainStates(ctx),
+		BridgeOutFeeTreasury:              k.exportBridgeOutFeeTreasury(ctx),
+		BridgeOutFees:                     k.GetAllBridgeOutFees(ctx),
+		TripartyOutcomes:                  k.GetAllTripartyBridgeRequestOutcomes(ctx),
+		TripartyOutcomesPrunedSequenceTip: k.GetTripartyOutcomesPrunedSequenceTip(ctx),
+		TripartyCallbackRetryPolicy:       &tripartyCallbackRetryPolicy,
+		FailedTripartyCallbacks:           k.GetAllFailedTripartyCallbacks(ctx),
+		SourceChainAssetsLockedEvents:     k.GetAllSourceChainAssetsLocked(ctx),
 	}
 }
 
+// exportBridgeOutFeeTreasury returns the hex-encoded bridge-out fee treasury
+// or an empty string if the treasury is not set.
+func (k Keeper) exportBridgeOutFeeTreasury(ctx sdk.Context) string {
+	treasury := k.GetBridgeOutFeeTreasury(ctx)
+	if len(treasury) == 0 {
+		return ""
+	}
+
+	return evmtypes.BytesToHexAddress(treasury)
+}
+
 // exportBridgeOutChains returns the bridge-out chain set widened to uint32.
 // Protobuf has no 8-bit integer type.
 func (k Keeper) exportBridgeOutChains(ctx sdk.Context) []uint32 {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -79,6 +80,37 @@ func TestGenesisTripartyState(t *testing.T) {
 			Amount:     sdkmath.NewInt(100),
 		},
 	}
+	genesisState.TripartyOutcomes = []*types.TripartyBridgeRequestOutcome{
+		{
+			Sequence:         sdkmath.NewInt(1),
+			Controller:       "0x1111111111111111111111111111111111111111",
+			Status:           types.TripartyBridgeRequestStatusCallbackFailed,
+			Reason:           "execution reverted",
+			ProcessingHeight: 99,
+			GasUsed:          21000,
+		},
+	}
+	genesisState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(0)
+	genesisState.TripartyCallbackRetryPolicy = &types.TripartyCallbackRetryPolicy{
+		MaxAttempts:    5,
+		IntervalBlocks: 50,
+		GasLimit:       2_000_000,
+	}
+	genesisState.FailedTripartyCallbacks = []*types.FailedTripartyCallback{
+		{
+			Request: types.TripartyBridgeRequest{
+				Sequence:     sdkmath.NewInt(1),
+				BlockHeight:  98,
+				Recipient:    "0x3333333333333333333333333333333333333333",
+				Amount:       sdkmath.NewInt(100),
+				CallbackData: []byte("callback-0"),
+				Controller:   "0x1111111111111111111111111111111111111111",
+			},
+			Attempts:          1,
+			LastAttemptHeight: 99,
+			NextRetryHeight:   149,
+		},
+	}
 
 	accountKeeper := newMockAccountKeeper()
 	accountKeeper.On(
@@ -96,6 +128,282 @@ func TestGenesisTripartyState(t *testing.T) {
 	accountKeeper.AssertExpectations(t)
 }
 
+func TestGenesisAssetsLockedEvents(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	genesisState.Params.AssetsLockedEventsRetentionBlocks = 1000
+	genesisState.AssetsLockedSequenceTip = sdkmath.NewInt(3)
+	genesisState.AssetsLockedPrunedSequenceTip = sdkmath.NewInt(1)
+	genesisState.AssetsLockedEvents = []*types.AssetsLockedRecord{
+		{
+			Event:       mockEvent(2, recipient1, 10, testSourceBTCToken),
+			BlockHeight: 100,
+		},
+		{
+			Event:       mockEvent(3, recipient2, 20, testSourceERC20Token1),
+			BlockHeight: 101,
+			Skipped:     true,
+		},
+	}
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
+func TestGenesisOutflowPolicies(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	genesisState.CurrentOutflowAmounts = []*types.CurrentOutflowAmount{
+		{Token: testSourceERC20Token1, Amount: sdkmath.NewInt(5)},
+	}
+	genesisState.OutflowWindows = []*types.TokenOutflowWindow{
+		{Token: testSourceERC20Token2, Window: types.NewOutflowWindow(100, 10)},
+	}
+	genesisState.OutflowBuckets = []*types.OutflowBucket{
+		{Token: testSourceERC20Token2, Index: 7, Amount: sdkmath.NewInt(10)},
+		{Token: testSourceERC20Token2, Index: 8, Amount: sdkmath.NewInt(20)},
+	}
+	genesisState.UsdOutflowLimit = sdkmath.NewInt(1000)
+	genesisState.UsdOutflowWindow = types.NewOutflowWindow(50, 5)
+	genesisState.UsdOutflowBuckets = []*types.OutflowBucket{
+		{Index: 3, Amount: sdkmath.NewInt(30)},
+	}
+	genesisState.OutflowPriceFeeds = []types.OutflowPriceFeed{
+		{Token: testSourceERC20Token1, CurrencyPair: "ETH/USD", Decimals: 18},
+	}
+	genesisState.SenderOutflowParams = types.NewSenderOutflowParams(100, 5)
+	genesisState.SenderOutflowLimits = []types.SenderOutflowLimit{
+		{Token: testSourceERC20Token1, Limit: sdkmath.NewInt(40)},
+	}
+	genesisState.SenderOutflows = []types.SenderOutflow{
+		{
+			Sender:      testSourceERC20Token2,
+			WindowStart: 100,
+			Count:       2,
+			Amounts: []types.SenderTokenOutflow{
+				{Token: testSourceERC20Token1, Amount: sdkmath.NewInt(15)},
+			},
+		},
+	}
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
+func TestGenesisDelayedBridgeOuts(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	genesisState.DelayedBridgeOutBlocks = 100
+	genesisState.DelayedBridgeOutThresholds = []types.DelayedBridgeOutThreshold{
+		{Token: testSourceERC20Token1, Threshold: sdkmath.NewInt(50)},
+	}
+	genesisState.DelayedBridgeOuts = []types.DelayedBridgeOut{
+		{
+			Id:            2,
+			Recipient:     []byte{0x01, 0x02},
+			Token:         testSourceERC20Token2,
+			TargetToken:   testSourceERC20Token1,
+			Sender:        testSourceERC20Token2,
+			Amount:        sdkmath.NewInt(60),
+			Chain:         uint32(types.TargetChainEthereum),
+			ReleaseHeight: 200,
+			Fee:           sdkmath.ZeroInt(),
+			QueuedHeight:  100,
+		},
+	}
+	genesisState.DelayedBridgeOutSequenceTip = 3
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
+func TestGenesisERC20Supplies(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	// The supplies are exported ordered by the token address.
+	genesisState.Erc20Supplies = []types.ERC20Supply{
+		{Token: testMezoERC20Token1, Minted: sdkmath.NewInt(100), Burnt: sdkmath.NewInt(40)},
+		{Token: testMezoERC20Token2, Minted: sdkmath.NewInt(0), Burnt: sdkmath.NewInt(5)},
+	}
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
+func TestGenesisBridgeOutFees(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	genesisState.BridgeOutFeeTreasury = evmtypes.BytesToHexAddress(testBridgeOutFeeTreasury)
+	// The fees are exported ordered by the target chain.
+	genesisState.BridgeOutFees = []types.BridgeOutFee{
+		{
+			Token:       testMezoERC20Token1,
+			Chain:       uint32(types.TargetChainEthereum),
+			Flat:        sdkmath.NewInt(10),
+			BasisPoints: 25,
+		},
+		{
+			Token:       testMezoERC20Token2,
+			Chain:       uint32(types.TargetChainBitcoin),
+			Flat:        sdkmath.ZeroInt(),
+			BasisPoints: 5,
+		},
+	}
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	require.Equal(t, testBridgeOutFeeTreasury, k.GetBridgeOutFeeTreasury(ctx))
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
+func TestGenesisSourceChains(t *testing.T) {
+	ctx, k := mockContext()
+
+	genesisState := types.DefaultGenesis()
+	genesisState.SourceBtcToken = testSourceBTCToken
+	genesisState.SourceChains = []types.SourceChainState{
+		{
+			Chain: types.NewSourceChain(
+				1,
+				"Arbitrum",
+				evmtypes.HexAddressToBytes(testSourceERC20Token2),
+			),
+			AssetsLockedSequenceTip: sdkmath.NewInt(7),
+			Erc20TokensMappings: []types.ERC20TokenMapping{
+				{
+					SourceToken: testSourceERC20Token1,
+					MezoToken:   testMezoERC20Token1,
+				},
+			},
+			AssetsLockedPrunedSequenceTip: sdkmath.NewInt(5),
+			Minted: []types.SourceChainMinted{
+				{
+					Token:  testMezoERC20Token1,
+					Amount: sdkmath.NewInt(30),
+				},
+			},
+		},
+		{
+			Chain: types.NewSourceChain(
+				2,
+				"Base",
+				evmtypes.HexAddressToBytes(testSourceERC20Token1),
+			),
+			AssetsLockedSequenceTip:       sdkmath.ZeroInt(),
+			AssetsLockedPrunedSequenceTip: sdkmath.ZeroInt(),
+		},
+	}
+	genesisState.SourceChainAssetsLockedEvents = []*types.AssetsLockedRecord{
+		{
+			Event:       mockEvent(6, recipient1, 10, testSourceERC20Token1),
+			BlockHeight: 100,
+			SourceChain: 1,
+		},
+		{
+			Event:       mockEvent(7, recipient2, 20, testSourceERC20Token1),
+			BlockHeight: 101,
+			SourceChain: 1,
+		},
+	}
+
+	accountKeeper := newMockAccountKeeper()
+	accountKeeper.On(
+		"GetModuleAccount",
+		ctx,
+		types.ModuleName,
+	).Return(authtypes.NewEmptyModuleAccount(types.ModuleName))
+
+	k.InitGenesis(ctx, *genesisState, accountKeeper)
+
+	require.Equal(t, sdkmath.NewInt(7), k.GetSourceChainAssetsLockedSequenceTip(ctx, 1))
+	require.Equal(t, sdkmath.NewInt(5), k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, 1))
+	require.Equal(
+		t,
+		sdkmath.NewInt(30),
+		k.GetSourceChainMinted(ctx, 1, evmtypes.HexAddressToBytes(testMezoERC20Token1)),
+	)
+
+	record, found := k.GetSourceChainAssetsLocked(ctx, 1, sdkmath.NewInt(7))
+	require.True(t, found)
+	require.Equal(t, genesisState.SourceChainAssetsLockedEvents[1], record)
+
+	got := k.ExportGenesis(ctx)
+
+	require.NotNil(t, got)
+	require.EqualValues(t, genesisState, got)
+	accountKeeper.AssertExpectations(t)
+}
+
 func TestGenesisLockdownFlags(t *testing.T) {
 	tests := map[string]struct {
 		bridgeInPaused  bool
@@ -7,6 +7,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/mezo-org/mezod/x/bridge/types"
 )
@@ -16,6 +17,7 @@ type Keeper struct {
 	storeKey     storetypes.StoreKey
 	bankKeeper   types.BankKeeper
 	evmKeeper    types.EvmKeeper
+	oracleKeeper types.OracleKeeper
 	blockedAddrs map[string]bool
 }
 
@@ -24,6 +26,7 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	evmKeeper types.EvmKeeper,
+	oracleKeeper types.OracleKeeper,
 	blockedAddrs map[string]bool,
 ) Keeper {
 	return Keeper{
@@ -31,6 +34,7 @@ func NewKeeper(
 		storeKey:     storeKey,
 		bankKeeper:   bankKeeper,
 		evmKeeper:    evmKeeper,
+		oracleKeeper: oracleKeeper,
 		blockedAddrs: blockedAddrs,
 	}
 }
@@ -38,3 +42,11 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// emitEvent emits the given typed event. The events are protobuf messages
+// defined by the module so a failure to encode one is a programming error.
+func (k Keeper) emitEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
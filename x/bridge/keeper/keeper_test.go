@@ -2,19 +2,25 @@ package keeper
 
 import (
 	"context"
+	"testing"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/mezo-org/mezod/x/bridge/types"
 	"github.com/mezo-org/mezod/x/evm/statedb"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -36,7 +42,14 @@ func mockContext() (sdk.Context, Keeper) {
 	evmKeeper.On("IsCustomPrecompileAddress", mock.Anything).Return(false)
 
 	// Create the keeper
-	keeper := NewKeeper(cdc, keys[types.StoreKey], newMockBankKeeper(), evmKeeper, map[string]bool{testBlockedAddress: true})
+	keeper := NewKeeper(
+		cdc,
+		keys[types.StoreKey],
+		newMockBankKeeper(),
+		evmKeeper,
+		newMockOracleKeeper(),
+		map[string]bool{testBlockedAddress: true},
+	)
 
 	// Create multiStore in memory
 	db := dbm.NewMemDB()
@@ -68,6 +81,28 @@ func mockContext() (sdk.Context, Keeper) {
 	return ctx, keeper
 }
 
+// emittedEvents returns the typed events of type T emitted so far through
+// the event manager of the given context.
+func emittedEvents[T proto.Message](t *testing.T, ctx sdk.Context) []T {
+	t.Helper()
+
+	var out []T
+
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			// Not a typed event.
+			continue
+		}
+
+		if typed, ok := msg.(T); ok {
+			out = append(out, typed)
+		}
+	}
+
+	return out
+}
+
 type mockBankKeeper struct {
 	mock.Mock
 }
@@ -130,6 +165,52 @@ func (mbk *mockBankKeeper) SendCoinsFromAccountToModule(
 	return args.Error(0)
 }
 
+type mockOracleKeeper struct {
+	prices   map[string]oracletypes.QuotePrice
+	decimals map[string]uint64
+}
+
+func newMockOracleKeeper() *mockOracleKeeper {
+	return &mockOracleKeeper{
+		prices:   make(map[string]oracletypes.QuotePrice),
+		decimals: make(map[string]uint64),
+	}
+}
+
+// setPrice sets the price of the given currency pair, updated at the given
+// block height.
+func (mok *mockOracleKeeper) setPrice(
+	currencyPair string,
+	price math.Int,
+	decimals uint64,
+	height uint64,
+) {
+	mok.prices[currencyPair] = oracletypes.QuotePrice{
+		Price:       price,
+		BlockHeight: height,
+	}
+	mok.decimals[currencyPair] = decimals
+}
+
+func (mok *mockOracleKeeper) GetPriceForCurrencyPair(
+	_ context.Context,
+	cp connecttypes.CurrencyPair,
+) (oracletypes.QuotePrice, error) {
+	price, ok := mok.prices[cp.String()]
+	if !ok {
+		return oracletypes.QuotePrice{}, oracletypes.NewQuotePriceNotExistError(cp)
+	}
+
+	return price, nil
+}
+
+func (mok *mockOracleKeeper) GetDecimalsForCurrencyPair(
+	_ context.Context,
+	cp connecttypes.CurrencyPair,
+) (uint64, error) {
+	return mok.decimals[cp.String()], nil
+}
+
 type mockEvmKeeper struct {
 	mock.Mock
 }
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,8 +12,17 @@ import (
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
 
-// OutflowResetBlocks is the number of blocks after which the outflow limit is reset.
-const OutflowResetBlocks = 25000
+const (
+	// OutflowResetBlocks is the number of blocks after which the outflow limit is reset.
+	OutflowResetBlocks = 25000
+
+	// OutflowPriceMaxAgeBlocks is the maximum age of an oracle price, in
+	// blocks, accepted when valuing outflows against the USD outflow limit.
+	OutflowPriceMaxAgeBlocks = 100
+
+	// usdOutflowDecimals is the precision of USD outflow values.
+	usdOutflowDecimals = 18
+)
 
 // GetAllCurrentOutflowLimits returns all the current outflow limits.
 func (k Keeper) GetAllCurrentOutflowLimits(ctx sdk.Context) []*types.CurrentOutflowLimit {
@@ -89,6 +102,11 @@ func (k Keeper) SetOutflowLimit(
 	}
 
 	store.Set(types.GetOutflowLimitKey(token), bz)
+
+	k.emitEvent(ctx, &types.EventOutflowLimitSet{
+		Token: evmtypes.BytesToHexAddress(token),
+		Limit: limit,
+	})
 }
 
 // GetOutflowLimit returns the current outflow limit for a specific token.
@@ -112,23 +130,13 @@ func (k Keeper) GetOutflowLimit(
 }
 
 // getCurrentOutflow returns the current outflow amount for a specific token.
+// For tokens using a rolling window, this is the outflow of the window ending
+// at the current block.
 func (k Keeper) getCurrentOutflow(
 	ctx sdk.Context,
 	token []byte,
 ) math.Int {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(types.GetCurrentOutflowKey(token))
-	if len(bz) == 0 {
-		return math.ZeroInt()
-	}
-
-	outflow := math.ZeroInt()
-	if err := outflow.Unmarshal(bz); err != nil {
-		panic(err)
-	}
-
-	return outflow
+	return k.getCounterOutflow(ctx, k.tokenOutflowCounter(ctx, token))
 }
 
 // increaseCurrentOutflow adds the specified amount to the current outflow for a token.
@@ -137,17 +145,18 @@ func (k Keeper) increaseCurrentOutflow(
 	token []byte,
 	amount math.Int,
 ) {
-	currentOutflow := k.getCurrentOutflow(ctx, token)
-	newOutflow := currentOutflow.Add(amount)
-
-	store := ctx.KVStore(k.storeKey)
-
-	bz, err := newOutflow.Marshal()
-	if err != nil {
-		panic(err)
-	}
+	k.increaseCounterOutflow(ctx, k.tokenOutflowCounter(ctx, token), amount)
+}
 
-	store.Set(types.GetCurrentOutflowKey(token), bz)
+// decreaseCurrentOutflow gives back the specified amount added to the
+// current outflow for a token at the given height. See decreaseCounterOutflow.
+func (k Keeper) decreaseCurrentOutflow(
+	ctx sdk.Context,
+	token []byte,
+	height uint64,
+	amount math.Int,
+) {
+	k.decreaseCounterOutflow(ctx, k.tokenOutflowCounter(ctx, token), height, amount)
 }
 
 // checkOutflowLimit verifies if adding the amount would exceed the outflow limit for a token.
@@ -177,7 +186,8 @@ func (k Keeper) setLastOutflowReset(ctx sdk.Context, height uint64) {
 	store.Set(types.LastOutflowResetKey, sdk.Uint64ToBigEndian(height))
 }
 
-// resetAllOutflows clears all current outflow amounts.
+// resetAllOutflows clears all current outflow amounts of the fixed-interval
+// outflow periods. Rolling window buckets are not affected.
 func (k Keeper) resetAllOutflows(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -195,17 +205,21 @@ func (k Keeper) resetAllOutflows(ctx sdk.Context) {
 	for _, key := range keys {
 		store.Delete(key)
 	}
+
+	store.Delete(types.CurrentUSDOutflowKey)
 }
 
 // GetOutflowCapacity returns the outflow capacity for a specific token
-// and the capacity reset block.
+// and the capacity reset block. For tokens using a rolling window, the reset
+// block is the block at which the oldest outflow leaves the window.
 func (k Keeper) GetOutflowCapacity(
 	ctx sdk.Context,
 	token []byte,
 ) (capacity math.Int, resetHeight uint64) {
+	counter := k.tokenOutflowCounter(ctx, token)
+
 	limit := k.GetOutflowLimit(ctx, token)
-	current := k.getCurrentOutflow(ctx, token)
-	lastReset := k.getLastOutflowReset(ctx)
+	current := k.getCounterOutflow(ctx, counter)
 
 	// Calculate outflow capacity (limit - current)
 	capacity = limit.Sub(current)
@@ -214,8 +228,669 @@ func (k Keeper) GetOutflowCapacity(
 		capacity = math.ZeroInt()
 	}
 
-	// Calculate capacity reset block (last reset + reset blocks)
-	resetHeight = lastReset + OutflowResetBlocks
+	return capacity, k.getCounterResetHeight(ctx, counter)
+}
+
+// getOutflowCapacity returns the outflow limit, current outflow and remaining
+// capacity for a specific token.
+func (k Keeper) getOutflowCapacity(
+	ctx sdk.Context,
+	token []byte,
+) types.OutflowCapacity {
+	capacity, resetHeight := k.GetOutflowCapacity(ctx, token)
+
+	return types.OutflowCapacity{
+		Token:          evmtypes.BytesToHexAddress(token),
+		Limit:          k.GetOutflowLimit(ctx, token),
+		CurrentOutflow: k.getCurrentOutflow(ctx, token),
+		Capacity:       capacity,
+		Window:         k.GetOutflowWindow(ctx, token),
+		ResetHeight:    resetHeight,
+	}
+}
+
+// GetOutflowWindow returns the rolling outflow window of a specific token.
+// The zero window means the token uses the fixed-interval outflow periods.
+func (k Keeper) GetOutflowWindow(
+	ctx sdk.Context,
+	token []byte,
+) types.OutflowWindow {
+	return k.getOutflowWindow(ctx, types.GetOutflowWindowKey(token))
+}
+
+// SetOutflowWindow sets the rolling outflow window of a specific token.
+// The zero window switches the token back to the fixed-interval outflow
+// periods. The outflow accumulated so far is carried over to the new window,
+// so changing the window never releases capacity.
+func (k Keeper) SetOutflowWindow(
+	ctx sdk.Context,
+	token []byte,
+	window types.OutflowWindow,
+) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	outflow := k.clearOutflowCounter(ctx, k.tokenOutflowCounter(ctx, token))
+	k.setOutflowWindow(ctx, types.GetOutflowWindowKey(token), window)
+	if outflow.IsPositive() {
+		k.increaseCurrentOutflow(ctx, token, outflow)
+	}
+
+	k.emitEvent(ctx, &types.EventOutflowWindowSet{
+		Token:        evmtypes.BytesToHexAddress(token),
+		WindowBlocks: window.WindowBlocks,
+		Buckets:      window.Buckets,
+	})
+
+	return nil
+}
+
+// GetAllOutflowWindows returns the rolling outflow windows of all tokens
+// using one.
+func (k Keeper) GetAllOutflowWindows(ctx sdk.Context) []*types.TokenOutflowWindow {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.OutflowWindowKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []*types.TokenOutflowWindow
+
+	for ; iterator.Valid(); iterator.Next() {
+		token := iterator.Key()[len(types.OutflowWindowKeyPrefix):]
+
+		var window types.OutflowWindow
+		k.cdc.MustUnmarshal(iterator.Value(), &window)
+
+		out = append(
+			out,
+			&types.TokenOutflowWindow{
+				Token:  evmtypes.BytesToHexAddress(token),
+				Window: window,
+			},
+		)
+	}
+
+	return out
+}
+
+// GetAllOutflowBuckets returns the non-empty rolling window buckets of all
+// tokens, including the ones that already left their window but were not
+// pruned yet.
+func (k Keeper) GetAllOutflowBuckets(ctx sdk.Context) []*types.OutflowBucket {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.OutflowBucketKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []*types.OutflowBucket
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.OutflowBucketKeyPrefix):]
+		token, index := key[:len(key)-8], key[len(key)-8:]
+
+		out = append(
+			out,
+			&types.OutflowBucket{
+				Token:  evmtypes.BytesToHexAddress(token),
+				Index:  sdk.BigEndianToUint64(index),
+				Amount: unmarshalOutflowAmount(iterator.Value()),
+			},
+		)
+	}
+
+	return out
+}
+
+// GetUSDOutflowLimit returns the global USD outflow limit, with 18 decimals.
+// Zero means the limit is disabled.
+func (k Keeper) GetUSDOutflowLimit(ctx sdk.Context) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.USDOutflowLimitKey)
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	return unmarshalOutflowAmount(bz)
+}
+
+// SetUSDOutflowLimit sets the global USD outflow limit, with 18 decimals.
+// The limit covers the outflows of all tokens having a price feed. Zero
+// disables the limit.
+func (k Keeper) SetUSDOutflowLimit(ctx sdk.Context, limit math.Int) {
+	bz, err := limit.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.USDOutflowLimitKey, bz)
+
+	k.emitEvent(ctx, &types.EventUSDOutflowLimitSet{Limit: limit})
+}
+
+// GetUSDOutflowWindow returns the rolling window of the USD outflow limit.
+// The zero window means the fixed-interval outflow periods are used.
+func (k Keeper) GetUSDOutflowWindow(ctx sdk.Context) types.OutflowWindow {
+	return k.getOutflowWindow(ctx, types.USDOutflowWindowKey)
+}
+
+// SetUSDOutflowWindow sets the rolling window of the USD outflow limit.
+// As for token windows, the USD outflow accumulated so far is carried over
+// to the new window.
+func (k Keeper) SetUSDOutflowWindow(
+	ctx sdk.Context,
+	window types.OutflowWindow,
+) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	outflow := k.clearOutflowCounter(ctx, k.usdOutflowCounter(ctx))
+	k.setOutflowWindow(ctx, types.USDOutflowWindowKey, window)
+	if outflow.IsPositive() {
+		k.increaseCurrentUSDOutflow(ctx, outflow)
+	}
+
+	k.emitEvent(ctx, &types.EventUSDOutflowWindowSet{
+		WindowBlocks: window.WindowBlocks,
+		Buckets:      window.Buckets,
+	})
+
+	return nil
+}
+
+// getCurrentUSDOutflow returns the current USD outflow, with 18 decimals.
+func (k Keeper) getCurrentUSDOutflow(ctx sdk.Context) math.Int {
+	return k.getCounterOutflow(ctx, k.usdOutflowCounter(ctx))
+}
+
+// increaseCurrentUSDOutflow adds the specified USD value to the current USD
+// outflow.
+func (k Keeper) increaseCurrentUSDOutflow(ctx sdk.Context, value math.Int) {
+	k.increaseCounterOutflow(ctx, k.usdOutflowCounter(ctx), value)
+}
+
+// decreaseCurrentUSDOutflow gives back the specified USD value added to the
+// current USD outflow at the given height. See decreaseCounterOutflow.
+func (k Keeper) decreaseCurrentUSDOutflow(
+	ctx sdk.Context,
+	height uint64,
+	value math.Int,
+) {
+	k.decreaseCounterOutflow(ctx, k.usdOutflowCounter(ctx), height, value)
+}
+
+// GetAllUSDOutflowBuckets returns the non-empty buckets of the USD outflow
+// rolling window.
+func (k Keeper) GetAllUSDOutflowBuckets(ctx sdk.Context) []*types.OutflowBucket {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.USDOutflowBucketKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []*types.OutflowBucket
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := iterator.Key()[len(types.USDOutflowBucketKeyPrefix):]
+
+		out = append(
+			out,
+			&types.OutflowBucket{
+				Index:  sdk.BigEndianToUint64(index),
+				Amount: unmarshalOutflowAmount(iterator.Value()),
+			},
+		)
+	}
+
+	return out
+}
+
+// GetUSDOutflowCapacity returns the remaining USD outflow capacity, with 18
+// decimals, and the capacity reset block. The capacity is zero if the limit
+// is disabled.
+func (k Keeper) GetUSDOutflowCapacity(
+	ctx sdk.Context,
+) (capacity math.Int, resetHeight uint64) {
+	counter := k.usdOutflowCounter(ctx)
+
+	capacity = k.GetUSDOutflowLimit(ctx).Sub(k.getCounterOutflow(ctx, counter))
+	if capacity.IsNegative() {
+		capacity = math.ZeroInt()
+	}
+
+	return capacity, k.getCounterResetHeight(ctx, counter)
+}
+
+// checkUSDOutflowLimit verifies if bridging out the amount of the token
+// would exceed the USD outflow limit. It returns the USD value of the amount
+// that should be added to the current USD outflow once the bridge-out is
+// done. The value is zero if the limit is disabled or the token has no price
+// feed; such outflows are not covered by the USD outflow limit.
+func (k Keeper) checkUSDOutflowLimit(
+	ctx sdk.Context,
+	token []byte,
+	amount math.Int,
+) (math.Int, error) {
+	if k.GetUSDOutflowLimit(ctx).IsZero() {
+		return math.ZeroInt(), nil
+	}
+
+	feed, ok := k.GetOutflowPriceFeed(ctx, token)
+	if !ok {
+		return math.ZeroInt(), nil
+	}
+
+	value, err := k.getOutflowUSDValue(ctx, feed, amount)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	capacity, _ := k.GetUSDOutflowCapacity(ctx)
+	if value.GT(capacity) {
+		return math.Int{}, types.ErrUSDOutflowLimitExceeded
+	}
+
+	return value, nil
+}
+
+// getOutflowUSDValue returns the USD value of the given token amount, with 18
+// decimals, according to the oracle price of the given price feed. The value
+// is rounded up.
+func (k Keeper) getOutflowUSDValue(
+	ctx sdk.Context,
+	feed types.OutflowPriceFeed,
+	amount math.Int,
+) (math.Int, error) {
+	currencyPair, err := feed.ParseCurrencyPair()
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	price, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, currencyPair)
+	if err != nil {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrOutflowPriceUnavailable,
+			"no price for %s: %v",
+			currencyPair,
+			err,
+		)
+	}
+
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrOutflowPriceUnavailable,
+			"non-positive price for %s",
+			currencyPair,
+		)
+	}
+
+	//nolint:gosec
+	if height := uint64(ctx.BlockHeight()); height > price.BlockHeight &&
+		height-price.BlockHeight > OutflowPriceMaxAgeBlocks {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrOutflowPriceUnavailable,
+			"stale price for %s updated at height %d",
+			currencyPair,
+			price.BlockHeight,
+		)
+	}
+
+	priceDecimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, currencyPair)
+	if err != nil {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrOutflowPriceUnavailable,
+			"no decimals for %s: %v",
+			currencyPair,
+			err,
+		)
+	}
+
+	// value = ceil(amount * price * 10^18 / 10^(tokenDecimals + priceDecimals))
+	numerator := new(big.Int).Mul(amount.BigInt(), price.Price.BigInt())
+	numerator.Mul(numerator, precisionMultiplier(usdOutflowDecimals))
+
+	denominator := precisionMultiplier(uint64(feed.Decimals) + priceDecimals)
+
+	value := numerator.Add(numerator, denominator)
+	value.Sub(value, big.NewInt(1))
+	value.Quo(value, denominator)
+
+	if value.BitLen() > math.MaxBitLen {
+		return math.Int{}, fmt.Errorf("USD value of %s %s is too large", amount, currencyPair)
+	}
+
+	return math.NewIntFromBigInt(value), nil
+}
+
+// GetOutflowPriceFeed returns the outflow price feed of a specific token.
+// The returned boolean value indicates whether the feed was found.
+func (k Keeper) GetOutflowPriceFeed(
+	ctx sdk.Context,
+	token []byte,
+) (types.OutflowPriceFeed, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOutflowPriceFeedKey(token))
+	if len(bz) == 0 {
+		return types.OutflowPriceFeed{}, false
+	}
+
+	var feed types.OutflowPriceFeed
+	k.cdc.MustUnmarshal(bz, &feed)
+
+	return feed, true
+}
+
+// SetOutflowPriceFeed sets the price feed valuing the outflows of the feed
+// token against the USD outflow limit.
+func (k Keeper) SetOutflowPriceFeed(
+	ctx sdk.Context,
+	feed types.OutflowPriceFeed,
+) error {
+	if err := feed.Validate(); err != nil {
+		return err
+	}
+
+	// Store the normalized address so the token key and the stored value
+	// always match.
+	token := evmtypes.HexAddressToBytes(feed.Token)
+	feed.Token = evmtypes.BytesToHexAddress(token)
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetOutflowPriceFeedKey(token),
+		k.cdc.MustMarshal(&feed),
+	)
+
+	k.emitEvent(ctx, &types.EventOutflowPriceFeedSet{
+		Token:        feed.Token,
+		CurrencyPair: feed.CurrencyPair,
+		Decimals:     feed.Decimals,
+	})
+
+	return nil
+}
+
+// RemoveOutflowPriceFeed removes the outflow price feed of a specific token.
+// The token outflows are no longer covered by the USD outflow limit.
+func (k Keeper) RemoveOutflowPriceFeed(ctx sdk.Context, token []byte) {
+	ctx.KVStore(k.storeKey).Delete(types.GetOutflowPriceFeedKey(token))
+
+	k.emitEvent(ctx, &types.EventOutflowPriceFeedSet{
+		Token: evmtypes.BytesToHexAddress(token),
+	})
+}
+
+// GetAllOutflowPriceFeeds returns all outflow price feeds.
+func (k Keeper) GetAllOutflowPriceFeeds(ctx sdk.Context) []types.OutflowPriceFeed {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.OutflowPriceFeedKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []types.OutflowPriceFeed
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feed types.OutflowPriceFeed
+		k.cdc.MustUnmarshal(iterator.Value(), &feed)
+
+		out = append(out, feed)
+	}
+
+	return out
+}
+
+// outflowCounter locates the state of a single outflow counter. A counter
+// accumulates outflows either in a single fixed-interval slot, cleared by
+// the periodic outflow reset, or in the buckets of a rolling window.
+type outflowCounter struct {
+	fixedKey     []byte
+	bucketPrefix []byte
+	window       types.OutflowWindow
+}
+
+// tokenOutflowCounter returns the outflow counter of a specific token.
+func (k Keeper) tokenOutflowCounter(ctx sdk.Context, token []byte) outflowCounter {
+	return outflowCounter{
+		fixedKey:     types.GetCurrentOutflowKey(token),
+		bucketPrefix: types.GetOutflowBucketKeyPrefix(token),
+		window:       k.GetOutflowWindow(ctx, token),
+	}
+}
+
+// usdOutflowCounter returns the outflow counter of the USD outflow limit.
+func (k Keeper) usdOutflowCounter(ctx sdk.Context) outflowCounter {
+	return outflowCounter{
+		fixedKey:     types.CurrentUSDOutflowKey,
+		bucketPrefix: types.USDOutflowBucketKeyPrefix,
+		window:       k.GetUSDOutflowWindow(ctx),
+	}
+}
+
+// bucketKey returns the key of the counter bucket with the given index.
+func (c outflowCounter) bucketKey(index uint64) []byte {
+	return append(append([]byte{}, c.bucketPrefix...), sdk.Uint64ToBigEndian(index)...)
+}
+
+// currentBucket returns the index of the window bucket covering the current
+// block.
+func (c outflowCounter) currentBucket(ctx sdk.Context) uint64 {
+	//nolint:gosec
+	return uint64(ctx.BlockHeight()) / c.window.BucketBlocks()
+}
+
+// firstLiveBucket returns the index of the oldest bucket still in the window
+// ending at the current block.
+func (c outflowCounter) firstLiveBucket(ctx sdk.Context) uint64 {
+	current := c.currentBucket(ctx)
+	buckets := uint64(c.window.Buckets)
+
+	if current+1 < buckets {
+		return 0
+	}
+
+	return current + 1 - buckets
+}
+
+// getCounterOutflow returns the outflow accumulated by the counter in the
+// current fixed-interval period or in the window ending at the current block.
+func (k Keeper) getCounterOutflow(ctx sdk.Context, c outflowCounter) math.Int {
+	if c.window.IsZero() {
+		return k.getOutflowAmount(ctx, c.fixedKey)
+	}
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		c.bucketKey(c.firstLiveBucket(ctx)),
+		storetypes.PrefixEndBytes(c.bucketPrefix),
+	)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	outflow := math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		outflow = outflow.Add(unmarshalOutflowAmount(iterator.Value()))
+	}
+
+	return outflow
+}
+
+// increaseCounterOutflow adds the specified amount to the counter. For
+// rolling windows, the amount is added to the current bucket and the buckets
+// that left the window are pruned.
+func (k Keeper) increaseCounterOutflow(
+	ctx sdk.Context,
+	c outflowCounter,
+	amount math.Int,
+) {
+	key := c.fixedKey
+	if !c.window.IsZero() {
+		k.pruneCounterBuckets(ctx, c.bucketKey(0), c.bucketKey(c.firstLiveBucket(ctx)))
+		key = c.bucketKey(c.currentBucket(ctx))
+	}
+
+	k.setOutflowAmount(ctx, key, k.getOutflowAmount(ctx, key).Add(amount))
+}
+
+// decreaseCounterOutflow gives back the specified amount added to the
+// counter at the given height, provided it is still counted, i.e. the
+// fixed-interval period was not reset or the bucket covering the height did
+// not leave the window since. At most the amount still held by the slot or
+// bucket is given back.
+func (k Keeper) decreaseCounterOutflow(
+	ctx sdk.Context,
+	c outflowCounter,
+	height uint64,
+	amount math.Int,
+) {
+	key := c.fixedKey
+	if c.window.IsZero() {
+		if height <= k.getLastOutflowReset(ctx) {
+			return
+		}
+	} else {
+		index := height / c.window.BucketBlocks()
+		if index < c.firstLiveBucket(ctx) {
+			return
+		}
+		key = c.bucketKey(index)
+	}
+
+	current := k.getOutflowAmount(ctx, key)
+	if !current.IsPositive() {
+		return
+	}
+
+	k.setOutflowAmount(ctx, key, current.Sub(math.MinInt(current, amount)))
+}
+
+// getCounterResetHeight returns the block height at which the counter
+// capacity is next replenished. For rolling windows, this is the height at
+// which the oldest live bucket leaves the window or the current height if the
+// window is empty.
+func (k Keeper) getCounterResetHeight(ctx sdk.Context, c outflowCounter) uint64 {
+	if c.window.IsZero() {
+		return k.getLastOutflowReset(ctx) + OutflowResetBlocks
+	}
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		c.bucketKey(c.firstLiveBucket(ctx)),
+		storetypes.PrefixEndBytes(c.bucketPrefix),
+	)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	if !iterator.Valid() {
+		//nolint:gosec
+		return uint64(ctx.BlockHeight())
+	}
+
+	index := sdk.BigEndianToUint64(iterator.Key()[len(c.bucketPrefix):])
+
+	return (index + uint64(c.window.Buckets)) * c.window.BucketBlocks()
+}
+
+// clearOutflowCounter deletes the whole state of the counter and returns the
+// outflow it accumulated in the current fixed-interval period or window.
+func (k Keeper) clearOutflowCounter(ctx sdk.Context, c outflowCounter) math.Int {
+	outflow := k.getCounterOutflow(ctx, c)
+
+	ctx.KVStore(k.storeKey).Delete(c.fixedKey)
+	k.pruneCounterBuckets(ctx, c.bucketPrefix, storetypes.PrefixEndBytes(c.bucketPrefix))
+
+	return outflow
+}
+
+// pruneCounterBuckets deletes all counter buckets with keys in the [start, end)
+// range.
+func (k Keeper) pruneCounterBuckets(ctx sdk.Context, start, end []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(start, end)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	// separate the deletion from the iteration
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getOutflowWindow returns the rolling outflow window stored under the given
+// key or the zero window if the key is absent.
+func (k Keeper) getOutflowWindow(ctx sdk.Context, key []byte) types.OutflowWindow {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return types.OutflowWindow{}
+	}
+
+	var window types.OutflowWindow
+	k.cdc.MustUnmarshal(bz, &window)
+
+	return window
+}
+
+// setOutflowWindow stores the rolling outflow window under the given key.
+// The zero window deletes the key.
+func (k Keeper) setOutflowWindow(
+	ctx sdk.Context,
+	key []byte,
+	window types.OutflowWindow,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	if window.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&window))
+}
+
+// getOutflowAmount returns the outflow amount of a fixed-interval slot or
+// a rolling window bucket stored under the given key.
+func (k Keeper) getOutflowAmount(ctx sdk.Context, key []byte) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	return unmarshalOutflowAmount(bz)
+}
+
+// setOutflowAmount stores the outflow amount of a fixed-interval slot or
+// a rolling window bucket under the given key.
+func (k Keeper) setOutflowAmount(ctx sdk.Context, key []byte, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+// unmarshalOutflowAmount decodes an outflow amount read from the store.
+func unmarshalOutflowAmount(bz []byte) math.Int {
+	amount := math.ZeroInt()
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
 
-	return capacity, resetHeight
+// precisionMultiplier returns 10^decimals.
+func precisionMultiplier(decimals uint64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(decimals), nil)
 }
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -297,3 +299,284 @@ func TestGetAllCurrentOutflowAmounts(t *testing.T) {
 		require.Empty(t, amounts, "should return empty slice after reset")
 	})
 }
+
+func TestRollingOutflowWindow(t *testing.T) {
+	ctx, keeper := mockContext()
+	tokenAddr := common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes()
+
+	// 100-block window split into 10-block buckets.
+	err := keeper.SetOutflowWindow(ctx, tokenAddr, types.NewOutflowWindow(100, 10))
+	require.NoError(t, err)
+	keeper.SetOutflowLimit(ctx, tokenAddr, math.NewInt(1000))
+
+	ctx = ctx.WithBlockHeight(95)
+	keeper.increaseCurrentOutflow(ctx, tokenAddr, math.NewInt(600))
+
+	ctx = ctx.WithBlockHeight(105)
+	keeper.increaseCurrentOutflow(ctx, tokenAddr, math.NewInt(400))
+
+	// Both outflows are within the window ending at block 105.
+	capacity, resetHeight := keeper.GetOutflowCapacity(ctx, tokenAddr)
+	require.True(t, capacity.IsZero())
+	// The bucket of block 95 (90-99) leaves the window at block 190.
+	require.Equal(t, uint64(190), resetHeight)
+	require.ErrorIs(t, keeper.checkOutflowLimit(ctx, tokenAddr, math.NewInt(1)), types.ErrOutflowLimitExceeded)
+
+	// The fixed-interval reset does not touch rolling windows.
+	keeper.resetAllOutflows(ctx)
+	require.Equal(t, math.NewInt(1000), keeper.getCurrentOutflow(ctx, tokenAddr))
+
+	ctx = ctx.WithBlockHeight(189)
+	require.Equal(t, math.NewInt(1000), keeper.getCurrentOutflow(ctx, tokenAddr))
+
+	// The outflow of block 95 leaves the window.
+	ctx = ctx.WithBlockHeight(190)
+	require.Equal(t, math.NewInt(400), keeper.getCurrentOutflow(ctx, tokenAddr))
+	capacity, resetHeight = keeper.GetOutflowCapacity(ctx, tokenAddr)
+	require.Equal(t, math.NewInt(600), capacity)
+	require.Equal(t, uint64(200), resetHeight)
+
+	// A new outflow prunes the buckets that left the window.
+	keeper.increaseCurrentOutflow(ctx, tokenAddr, math.NewInt(100))
+	require.Equal(
+		t,
+		[]*types.OutflowBucket{
+			{Token: evmtypes.BytesToHexAddress(tokenAddr), Index: 10, Amount: math.NewInt(400)},
+			{Token: evmtypes.BytesToHexAddress(tokenAddr), Index: 19, Amount: math.NewInt(100)},
+		},
+		keeper.GetAllOutflowBuckets(ctx),
+	)
+
+	// Once all outflows leave the window, the capacity is fully restored.
+	ctx = ctx.WithBlockHeight(290)
+	capacity, resetHeight = keeper.GetOutflowCapacity(ctx, tokenAddr)
+	require.Equal(t, math.NewInt(1000), capacity)
+	require.Equal(t, uint64(290), resetHeight)
+}
+
+func TestSetOutflowWindow(t *testing.T) {
+	ctx, keeper := mockContext()
+	tokenAddr := common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes()
+
+	t.Run("invalid windows", func(t *testing.T) {
+		for _, window := range []types.OutflowWindow{
+			types.NewOutflowWindow(100, 0),
+			types.NewOutflowWindow(0, 10),
+			types.NewOutflowWindow(105, 10),
+			types.NewOutflowWindow(1010, types.MaxOutflowWindowBuckets+1),
+		} {
+			require.Error(t, keeper.SetOutflowWindow(ctx, tokenAddr, window))
+		}
+
+		require.True(t, keeper.GetOutflowWindow(ctx, tokenAddr).IsZero())
+	})
+
+	t.Run("outflow is carried over", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+
+		keeper.increaseCurrentOutflow(ctx, tokenAddr, math.NewInt(300))
+
+		window := types.NewOutflowWindow(100, 4)
+		require.NoError(t, keeper.SetOutflowWindow(ctx, tokenAddr, window))
+		require.Equal(t, window, keeper.GetOutflowWindow(ctx, tokenAddr))
+		require.Equal(t, math.NewInt(300), keeper.getCurrentOutflow(ctx, tokenAddr))
+		require.Empty(t, keeper.GetAllCurrentOutflowAmounts(ctx))
+
+		require.Equal(
+			t,
+			[]*types.EventOutflowWindowSet{
+				{
+					Token:        evmtypes.BytesToHexAddress(tokenAddr),
+					WindowBlocks: 100,
+					Buckets:      4,
+				},
+			},
+			emittedEvents[*types.EventOutflowWindowSet](t, ctx),
+		)
+
+		// Changing the window collapses the outflow into the current bucket.
+		ctx = ctx.WithBlockHeight(60)
+		keeper.increaseCurrentOutflow(ctx, tokenAddr, math.NewInt(200))
+		require.NoError(t, keeper.SetOutflowWindow(ctx, tokenAddr, types.NewOutflowWindow(10, 10)))
+		require.Equal(t, math.NewInt(500), keeper.getCurrentOutflow(ctx, tokenAddr))
+		require.Len(t, keeper.GetAllOutflowBuckets(ctx), 1)
+
+		// The zero window switches back to the fixed-interval periods.
+		require.NoError(t, keeper.SetOutflowWindow(ctx, tokenAddr, types.OutflowWindow{}))
+		require.True(t, keeper.GetOutflowWindow(ctx, tokenAddr).IsZero())
+		require.Equal(t, math.NewInt(500), keeper.getCurrentOutflow(ctx, tokenAddr))
+		require.Empty(t, keeper.GetAllOutflowBuckets(ctx))
+		require.Empty(t, keeper.GetAllOutflowWindows(ctx))
+	})
+}
+
+func TestOutflowPriceFeeds(t *testing.T) {
+	ctx, keeper := mockContext()
+	tokenAddr := common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes()
+
+	_, ok := keeper.GetOutflowPriceFeed(ctx, tokenAddr)
+	require.False(t, ok)
+
+	err := keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+		Token:        evmtypes.BytesToHexAddress(tokenAddr),
+		CurrencyPair: "invalid",
+		Decimals:     18,
+	})
+	require.Error(t, err)
+
+	err = keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+		Token:        evmtypes.BytesToHexAddress(tokenAddr),
+		CurrencyPair: "ETH/USD",
+		Decimals:     types.MaxOutflowPriceFeedDecimals + 1,
+	})
+	require.Error(t, err)
+
+	feed := types.OutflowPriceFeed{
+		Token:        evmtypes.BytesToHexAddress(tokenAddr),
+		CurrencyPair: "ETH/USD",
+		Decimals:     18,
+	}
+	require.NoError(t, keeper.SetOutflowPriceFeed(ctx, feed))
+
+	storedFeed, ok := keeper.GetOutflowPriceFeed(ctx, tokenAddr)
+	require.True(t, ok)
+	require.Equal(t, feed, storedFeed)
+	require.Equal(t, []types.OutflowPriceFeed{feed}, keeper.GetAllOutflowPriceFeeds(ctx))
+
+	keeper.RemoveOutflowPriceFeed(ctx, tokenAddr)
+	_, ok = keeper.GetOutflowPriceFeed(ctx, tokenAddr)
+	require.False(t, ok)
+
+	require.Equal(
+		t,
+		[]*types.EventOutflowPriceFeedSet{
+			{Token: feed.Token, CurrencyPair: "ETH/USD", Decimals: 18},
+			{Token: feed.Token},
+		},
+		emittedEvents[*types.EventOutflowPriceFeedSet](t, ctx),
+	)
+}
+
+func TestCheckUSDOutflowLimit(t *testing.T) {
+	ctx, keeper := mockContext()
+	ctx = ctx.WithBlockHeight(1000)
+
+	oracle := keeper.oracleKeeper.(*mockOracleKeeper)
+	ethToken := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+	usdcToken := common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()
+	unpricedToken := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+
+	// 1 ETH = 2000.00000000 USD, 1 USDC = 1.000000 USD.
+	oracle.setPrice("ETH/USD", math.NewInt(200000000000), 8, 1000)
+	oracle.setPrice("USDC/USD", math.NewInt(1000000), 6, 1000)
+
+	require.NoError(t, keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+		Token:        evmtypes.BytesToHexAddress(ethToken),
+		CurrencyPair: "ETH/USD",
+		Decimals:     18,
+	}))
+	require.NoError(t, keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+		Token:        evmtypes.BytesToHexAddress(usdcToken),
+		CurrencyPair: "USDC/USD",
+		Decimals:     6,
+	}))
+
+	oneEther := math.NewIntWithDecimal(1, 18)
+	usd := func(amount int64) math.Int {
+		return math.NewIntWithDecimal(amount, 18)
+	}
+
+	t.Run("limit disabled", func(t *testing.T) {
+		value, err := keeper.checkUSDOutflowLimit(ctx, ethToken, oneEther)
+		require.NoError(t, err)
+		require.True(t, value.IsZero())
+	})
+
+	keeper.SetUSDOutflowLimit(ctx, usd(5000))
+
+	t.Run("token without price feed", func(t *testing.T) {
+		value, err := keeper.checkUSDOutflowLimit(ctx, unpricedToken, oneEther)
+		require.NoError(t, err)
+		require.True(t, value.IsZero())
+	})
+
+	t.Run("one limit covers all tokens", func(t *testing.T) {
+		value, err := keeper.checkUSDOutflowLimit(ctx, ethToken, oneEther.MulRaw(2))
+		require.NoError(t, err)
+		require.Equal(t, usd(4000), value)
+		keeper.increaseCurrentUSDOutflow(ctx, value)
+
+		// 1000 USDC fits in the remaining capacity.
+		value, err = keeper.checkUSDOutflowLimit(ctx, usdcToken, math.NewInt(1000000000))
+		require.NoError(t, err)
+		require.Equal(t, usd(1000), value)
+
+		// 1000.000001 USDC does not.
+		_, err = keeper.checkUSDOutflowLimit(ctx, usdcToken, math.NewInt(1000000001))
+		require.ErrorIs(t, err, types.ErrUSDOutflowLimitExceeded)
+
+		capacity, resetHeight := keeper.GetUSDOutflowCapacity(ctx)
+		require.Equal(t, usd(1000), capacity)
+		require.Equal(t, uint64(OutflowResetBlocks), resetHeight)
+
+		// The fixed-interval reset clears the USD outflow.
+		keeper.resetAllOutflows(ctx)
+		capacity, _ = keeper.GetUSDOutflowCapacity(ctx)
+		require.Equal(t, usd(5000), capacity)
+	})
+
+	t.Run("value is rounded up", func(t *testing.T) {
+		value, err := keeper.checkUSDOutflowLimit(ctx, ethToken, math.NewInt(1))
+		require.NoError(t, err)
+		// 1 wei is worth 2000 * 10^-18 USD, i.e. 2000 units of 10^-18 USD.
+		require.Equal(t, math.NewInt(2000), value)
+
+		value, err = keeper.checkUSDOutflowLimit(ctx, usdcToken, math.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, math.NewIntWithDecimal(1, 12), value)
+	})
+
+	t.Run("stale price", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(1000 + OutflowPriceMaxAgeBlocks + 1)
+
+		_, err := keeper.checkUSDOutflowLimit(ctx, ethToken, oneEther)
+		require.ErrorIs(t, err, types.ErrOutflowPriceUnavailable)
+	})
+
+	t.Run("missing price", func(t *testing.T) {
+		require.NoError(t, keeper.SetOutflowPriceFeed(ctx, types.OutflowPriceFeed{
+			Token:        evmtypes.BytesToHexAddress(unpricedToken),
+			CurrencyPair: "BTC/USD",
+			Decimals:     18,
+		}))
+
+		_, err := keeper.checkUSDOutflowLimit(ctx, unpricedToken, oneEther)
+		require.ErrorIs(t, err, types.ErrOutflowPriceUnavailable)
+	})
+}
+
+func TestUSDOutflowWindow(t *testing.T) {
+	ctx, keeper := mockContext()
+	ctx = ctx.WithBlockHeight(50)
+
+	keeper.SetUSDOutflowLimit(ctx, math.NewInt(1000))
+	keeper.increaseCurrentUSDOutflow(ctx, math.NewInt(300))
+
+	require.Error(t, keeper.SetUSDOutflowWindow(ctx, types.NewOutflowWindow(10, 3)))
+
+	window := types.NewOutflowWindow(100, 10)
+	require.NoError(t, keeper.SetUSDOutflowWindow(ctx, window))
+	require.Equal(t, window, keeper.GetUSDOutflowWindow(ctx))
+
+	// The outflow is carried over to the bucket of block 50.
+	capacity, resetHeight := keeper.GetUSDOutflowCapacity(ctx)
+	require.Equal(t, math.NewInt(700), capacity)
+	require.Equal(t, uint64(150), resetHeight)
+
+	// The fixed-interval reset does not touch the rolling window.
+	keeper.resetAllOutflows(ctx)
+	require.Equal(t, math.NewInt(300), keeper.getCurrentUSDOutflow(ctx))
+
+	ctx = ctx.WithBlockHeight(150)
+	require.True(t, keeper.getCurrentUSDOutflow(ctx).IsZero())
+}
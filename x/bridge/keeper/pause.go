@@ -15,6 +15,8 @@ func (k Keeper) SetBridgeOutPaused(ctx sdk.Context, isPaused bool) {
 	} else {
 		store.Delete(types.BridgeOutPausedKey)
 	}
+
+	k.emitEvent(ctx, &types.EventBridgeOutPausedSet{Paused: isPaused})
 }
 
 // IsBridgeOutPaused checks if bridging out is paused.
@@ -33,6 +35,8 @@ func (k Keeper) SetBridgeInPaused(ctx sdk.Context, isPaused bool) {
 	} else {
 		store.Delete(types.BridgeInPausedKey)
 	}
+
+	k.emitEvent(ctx, &types.EventBridgeInPausedSet{Paused: isPaused})
 }
 
 // IsBridgeInPaused checks if bridging in is paused.
@@ -22,6 +22,12 @@ func TestBridgeOutPaused(t *testing.T) {
 
 	k.SetBridgeOutPaused(ctx, false)
 	require.False(t, k.IsBridgeOutPaused(ctx))
+
+	require.Equal(
+		t,
+		[]*types.EventBridgeOutPausedSet{{Paused: true}, {Paused: false}},
+		emittedEvents[*types.EventBridgeOutPausedSet](t, ctx),
+	)
 }
 
 func TestBridgeInPaused(t *testing.T) {
@@ -37,6 +43,12 @@ func TestBridgeInPaused(t *testing.T) {
 
 	k.SetBridgeInPaused(ctx, false)
 	require.False(t, k.IsBridgeInPaused(ctx))
+
+	require.Equal(
+		t,
+		[]*types.EventBridgeInPausedSet{{Paused: true}, {Paused: false}},
+		emittedEvents[*types.EventBridgeInPausedSet](t, ctx),
+	)
 }
 
 func TestBridgeOutPausedKeepsOutflowLimits(t *testing.T) {
@@ -5,8 +5,13 @@ import (
 	"fmt"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -121,3 +126,1060 @@ func (qs queryServer) AssetsUnlockedEvents(
 		Events: events,
 	}, nil
 }
+
+// AssetsLockedEvent returns the accepted `AssetsLocked` event of the requested
+// source chain with the given sequence number, along with the height of the
+// block that included it.
+func (qs queryServer) AssetsLockedEvent(
+	ctx context.Context,
+	req *types.QueryAssetsLockedEventRequest,
+) (*types.QueryAssetsLockedEventResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.SourceChain != types.PrimarySourceChain &&
+		!qs.keeper.IsSourceChainRegistered(sdkCtx, req.SourceChain) {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrSourceChainNotRegistered.Error(),
+		)
+	}
+
+	record, found := qs.keeper.getAssetsLocked(
+		sdkCtx,
+		req.SourceChain,
+		math.NewIntFromUint64(req.Sequence),
+	)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			"event not found; it may not be accepted yet or may be pruned",
+		)
+	}
+
+	return &types.QueryAssetsLockedEventResponse{
+		Record: *record,
+	}, nil
+}
+
+// AssetsLockedEvents returns accepted `AssetsLocked` events of the requested
+// source chain from the requested sequence range. The range is inclusive for sequence start and exclusive for
+// sequence end. If the start of the requested range is not provided, the
+// range starts at the first event retained in the state. If the end of the
+// requested range is not provided, the range ends at the current sequence tip.
+// Requesting a range that includes pruned events results in an error.
+// The requested range cannot exceed 10000 events.
+func (qs queryServer) AssetsLockedEvents(
+	ctx context.Context,
+	req *types.QueryAssetsLockedEventsRequest,
+) (*types.QueryAssetsLockedEventsResponse, error) {
+	start, end := req.SequenceStart, req.SequenceEnd
+
+	// If the non-nil start and end of sequence were requested, ensure start is
+	// smaller than end.
+	if !start.IsNil() && !end.IsNil() && start.GTE(end) {
+		return nil, fmt.Errorf("sequence start is not lower than sequence end")
+	}
+
+	// If the non-nil start and end of sequence were requested, ensure they are
+	// positive.
+	if (!start.IsNil() && !start.IsPositive()) || (!end.IsNil() && !end.IsPositive()) {
+		return nil, fmt.Errorf("invalid non-positive sequence range")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.SourceChain != types.PrimarySourceChain &&
+		!qs.keeper.IsSourceChainRegistered(sdkCtx, req.SourceChain) {
+		return nil, types.ErrSourceChainNotRegistered
+	}
+
+	sequenceTip, prunedSequenceTip := qs.keeper.getAssetsLockedSequenceTips(
+		sdkCtx,
+		req.SourceChain,
+	)
+	records := []types.AssetsLockedRecord{}
+
+	// If sequence start is nil, start at the first retained event.
+	if start.IsNil() {
+		start = prunedSequenceTip.AddRaw(1)
+	} else if start.LTE(prunedSequenceTip) {
+		return nil, fmt.Errorf(
+			"events up to sequence %s are not retained",
+			prunedSequenceTip,
+		)
+	}
+
+	// If sequence end is nil, use the current sequence tip plus `1` as the
+	// end is exclusive.
+	if end.IsNil() {
+		end = sequenceTip.AddRaw(1)
+	} else {
+		end = math.MinInt(end, sequenceTip.AddRaw(1))
+	}
+
+	// Limit the number of events we can retrieve to avoid loading too much data
+	// into memory.
+	if end.Sub(start).GT(math.NewInt(10000)) {
+		return nil, fmt.Errorf("requested sequence range exceeds 10000")
+	}
+
+	for seq := start; seq.LT(end); seq = seq.AddRaw(1) {
+		record, found := qs.keeper.getAssetsLocked(sdkCtx, req.SourceChain, seq)
+		if !found {
+			return nil, fmt.Errorf(
+				"event from requested sequence range not found",
+			)
+		}
+
+		records = append(records, *record)
+	}
+
+	return &types.QueryAssetsLockedEventsResponse{
+		Records: records,
+	}, nil
+}
+
+// AssetsLockedSequenceTip returns the current assets locked sequence tip.
+func (qs queryServer) AssetsLockedSequenceTip(
+	ctx context.Context,
+	_ *types.QueryAssetsLockedSequenceTipRequest,
+) (*types.QueryAssetsLockedSequenceTipResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryAssetsLockedSequenceTipResponse{
+		SequenceTip: qs.keeper.GetAssetsLockedSequenceTip(sdkCtx),
+	}, nil
+}
+
+// SourceBTCToken returns the BTC token address on the source chain.
+func (qs queryServer) SourceBTCToken(
+	ctx context.Context,
+	_ *types.QuerySourceBTCTokenRequest,
+) (*types.QuerySourceBTCTokenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QuerySourceBTCTokenResponse{
+		SourceBtcToken: evmtypes.BytesToHexAddress(
+			qs.keeper.GetSourceBTCToken(sdkCtx),
+		),
+	}, nil
+}
+
+// ERC20TokenMappings returns a page of ERC20 token mappings supported by
+// the bridge, ordered by the source token address.
+func (qs queryServer) ERC20TokenMappings(
+	ctx context.Context,
+	req *types.QueryERC20TokenMappingsRequest,
+) (*types.QueryERC20TokenMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.ERC20TokenMappingKeyPrefix,
+	)
+
+	mappings := []types.ERC20TokenMapping{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			mapping, err := types.UnmarshalERC20TokenMapping(qs.keeper.cdc, value)
+			if err != nil {
+				return err
+			}
+
+			mappings = append(mappings, mapping)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryERC20TokenMappingsResponse{
+		Mappings:   mappings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ERC20TokenMapping returns the ERC20 token mapping for the given source
+// token address.
+func (qs queryServer) ERC20TokenMapping(
+	ctx context.Context,
+	req *types.QueryERC20TokenMappingRequest,
+) (*types.QueryERC20TokenMappingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !evmtypes.IsHexAddress(req.SourceToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid source token")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mapping, found := qs.keeper.GetERC20TokenMapping(
+		sdkCtx,
+		evmtypes.HexAddressToBytes(req.SourceToken),
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNotMapping.Error())
+	}
+
+	return &types.QueryERC20TokenMappingResponse{
+		Mapping: *mapping,
+	}, nil
+}
+
+// BTCSupply returns the total amount of BTC minted and burnt by the bridge.
+func (qs queryServer) BTCSupply(
+	ctx context.Context,
+	_ *types.QueryBTCSupplyRequest,
+) (*types.QueryBTCSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryBTCSupplyResponse{
+		Minted: qs.keeper.GetBTCMinted(sdkCtx),
+		Burnt:  qs.keeper.GetBTCBurnt(sdkCtx),
+	}, nil
+}
+
+// ERC20Supplies returns the supply accounting of all mapped ERC20 tokens,
+// ordered by the source token address.
+func (qs queryServer) ERC20Supplies(
+	ctx context.Context,
+	req *types.QueryERC20SuppliesRequest,
+) (*types.QueryERC20SuppliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mappings := qs.keeper.GetERC20TokensMappings(sdkCtx)
+	supplies := make([]types.ERC20TokenSupply, 0, len(mappings))
+
+	for _, mapping := range mappings {
+		supply, err := qs.erc20TokenSupply(sdkCtx, mapping)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		supplies = append(supplies, supply)
+	}
+
+	return &types.QueryERC20SuppliesResponse{
+		Supplies: supplies,
+	}, nil
+}
+
+// ERC20Supply returns the supply accounting of the ERC20 token mapped to
+// the given source token address.
+func (qs queryServer) ERC20Supply(
+	ctx context.Context,
+	req *types.QueryERC20SupplyRequest,
+) (*types.QueryERC20SupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !evmtypes.IsHexAddress(req.SourceToken) {
+		return nil, status.Error(codes.InvalidArgument, "invalid source token")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mapping, found := qs.keeper.GetERC20TokenMapping(
+		sdkCtx,
+		evmtypes.HexAddressToBytes(req.SourceToken),
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNotMapping.Error())
+	}
+
+	supply, err := qs.erc20TokenSupply(sdkCtx, mapping)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryERC20SupplyResponse{
+		Supply: supply,
+	}, nil
+}
+
+// erc20TokenSupply builds the supply accounting of the given ERC20 token
+// mapping.
+func (qs queryServer) erc20TokenSupply(
+	ctx sdk.Context,
+	mapping *types.ERC20TokenMapping,
+) (types.ERC20TokenSupply, error) {
+	mezoToken := evmtypes.HexAddressToBytes(mapping.MezoToken)
+
+	totalSupply, err := qs.keeper.GetERC20TotalSupply(ctx, mezoToken)
+	if err != nil {
+		return types.ERC20TokenSupply{}, err
+	}
+
+	return types.ERC20TokenSupply{
+		SourceToken: mapping.SourceToken,
+		MezoToken:   mapping.MezoToken,
+		Minted:      qs.keeper.GetERC20Minted(ctx, mezoToken),
+		Burnt:       qs.keeper.GetERC20Burnt(ctx, mezoToken),
+		TotalSupply: totalSupply,
+	}, nil
+}
+
+// SourceChains returns the bridge-in state of all registered additional
+// source chains, ordered by their identifiers.
+func (qs queryServer) SourceChains(
+	ctx context.Context,
+	req *types.QuerySourceChainsRequest,
+) (*types.QuerySourceChainsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QuerySourceChainsResponse{
+		SourceChains: qs.keeper.GetAllSourceChainStates(sdkCtx),
+	}, nil
+}
+
+// SourceChain returns the bridge-in state of the given additional source
+// chain.
+func (qs queryServer) SourceChain(
+	ctx context.Context,
+	req *types.QuerySourceChainRequest,
+) (*types.QuerySourceChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	state, found := qs.keeper.GetSourceChainState(sdkCtx, req.Chain)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrSourceChainNotRegistered.Error(),
+		)
+	}
+
+	return &types.QuerySourceChainResponse{
+		SourceChain: state,
+	}, nil
+}
+
+// OutflowLimits returns a page of outflow states for all tokens having an
+// outflow limit, ordered by the token address.
+func (qs queryServer) OutflowLimits(
+	ctx context.Context,
+	req *types.QueryOutflowLimitsRequest,
+) (*types.QueryOutflowLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.OutflowLimitKeyPrefix,
+	)
+
+	outflows := []types.OutflowCapacity{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, _ []byte) error {
+			outflows = append(outflows, qs.keeper.getOutflowCapacity(sdkCtx, key))
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The top-level reset height is the end of the fixed-interval outflow
+	// period so it is meaningful only for tokens using it. Tokens on rolling
+	// windows are replenished at their own per-token reset heights.
+	var resetHeight uint64
+	for _, outflow := range outflows {
+		if outflow.Window.IsZero() {
+			resetHeight = outflow.ResetHeight
+			break
+		}
+	}
+
+	return &types.QueryOutflowLimitsResponse{
+		Outflows:    outflows,
+		ResetHeight: resetHeight,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// OutflowCapacity returns the outflow state of the given Mezo token.
+func (qs queryServer) OutflowCapacity(
+	ctx context.Context,
+	req *types.QueryOutflowCapacityRequest,
+) (*types.QueryOutflowCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !evmtypes.IsHexAddress(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	token := evmtypes.HexAddressToBytes(req.Token)
+
+	outflow := qs.keeper.getOutflowCapacity(sdkCtx, token)
+
+	// The top-level reset height is the end of the fixed-interval outflow
+	// period so it is set only for tokens using it.
+	var resetHeight uint64
+	if outflow.Window.IsZero() {
+		resetHeight = outflow.ResetHeight
+	}
+
+	return &types.QueryOutflowCapacityResponse{
+		Outflow:     outflow,
+		ResetHeight: resetHeight,
+	}, nil
+}
+
+// USDOutflowCapacity returns the state of the global USD outflow limit.
+func (qs queryServer) USDOutflowCapacity(
+	ctx context.Context,
+	_ *types.QueryUSDOutflowCapacityRequest,
+) (*types.QueryUSDOutflowCapacityResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	capacity, resetHeight := qs.keeper.GetUSDOutflowCapacity(sdkCtx)
+
+	return &types.QueryUSDOutflowCapacityResponse{
+		Limit:          qs.keeper.GetUSDOutflowLimit(sdkCtx),
+		CurrentOutflow: qs.keeper.getCurrentUSDOutflow(sdkCtx),
+		Capacity:       capacity,
+		Window:         qs.keeper.GetUSDOutflowWindow(sdkCtx),
+		ResetHeight:    resetHeight,
+	}, nil
+}
+
+// OutflowPriceFeeds returns a page of per-token outflow price feeds, ordered
+// by the token address.
+func (qs queryServer) OutflowPriceFeeds(
+	ctx context.Context,
+	req *types.QueryOutflowPriceFeedsRequest,
+) (*types.QueryOutflowPriceFeedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.OutflowPriceFeedKeyPrefix,
+	)
+
+	feeds := []types.OutflowPriceFeed{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var feed types.OutflowPriceFeed
+			if err := qs.keeper.cdc.Unmarshal(value, &feed); err != nil {
+				return err
+			}
+
+			feeds = append(feeds, feed)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutflowPriceFeedsResponse{
+		PriceFeeds: feeds,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SenderOutflowLimits returns the per-sender bridge-out limit parameters and
+// a page of per-sender outflow limits of tokens, ordered by the token address.
+func (qs queryServer) SenderOutflowLimits(
+	ctx context.Context,
+	req *types.QuerySenderOutflowLimitsRequest,
+) (*types.QuerySenderOutflowLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.SenderOutflowLimitKeyPrefix,
+	)
+
+	limits := []types.SenderOutflowLimit{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var limit math.Int
+			if err := limit.Unmarshal(value); err != nil {
+				return err
+			}
+
+			limits = append(limits, types.SenderOutflowLimit{
+				Token: evmtypes.BytesToHexAddress(key),
+				Limit: limit,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySenderOutflowLimitsResponse{
+		Params:     qs.keeper.GetSenderOutflowParams(sdkCtx),
+		Limits:     limits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SenderOutflowCapacity returns the per-sender bridge-out state of a specific
+// sender.
+func (qs queryServer) SenderOutflowCapacity(
+	ctx context.Context,
+	req *types.QuerySenderOutflowCapacityRequest,
+) (*types.QuerySenderOutflowCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !evmtypes.IsHexAddress(req.Sender) {
+		return nil, status.Error(codes.InvalidArgument, "invalid sender")
+	}
+
+	var token []byte
+	if len(req.Token) > 0 {
+		if !evmtypes.IsHexAddress(req.Token) {
+			return nil, status.Error(codes.InvalidArgument, "invalid token")
+		}
+
+		token = evmtypes.HexAddressToBytes(req.Token)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QuerySenderOutflowCapacityResponse{
+		Capacity: qs.keeper.GetSenderOutflowCapacity(
+			sdkCtx,
+			evmtypes.HexAddressToBytes(req.Sender),
+			token,
+		),
+	}, nil
+}
+
+// DelayedBridgeOutParams returns the delay of the delayed bridge-out queue
+// and a page of delayed bridge-out thresholds of tokens, ordered by the token
+// address.
+func (qs queryServer) DelayedBridgeOutParams(
+	ctx context.Context,
+	req *types.QueryDelayedBridgeOutParamsRequest,
+) (*types.QueryDelayedBridgeOutParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.DelayedBridgeOutThresholdKeyPrefix,
+	)
+
+	thresholds := []types.DelayedBridgeOutThreshold{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var threshold math.Int
+			if err := threshold.Unmarshal(value); err != nil {
+				return err
+			}
+
+			thresholds = append(thresholds, types.DelayedBridgeOutThreshold{
+				Token:     evmtypes.BytesToHexAddress(key),
+				Threshold: threshold,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelayedBridgeOutParamsResponse{
+		DelayBlocks: qs.keeper.GetDelayedBridgeOutBlocks(sdkCtx),
+		Thresholds:  thresholds,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// BridgeOutFees returns the bridge-out fee treasury and a page of the
+// bridge-out fees of tokens and target chains.
+func (qs queryServer) BridgeOutFees(
+	ctx context.Context,
+	req *types.QueryBridgeOutFeesRequest,
+) (*types.QueryBridgeOutFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.BridgeOutFeeKeyPrefix,
+	)
+
+	fees := []types.BridgeOutFee{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var fee types.BridgeOutFee
+			if err := qs.keeper.cdc.Unmarshal(value, &fee); err != nil {
+				return err
+			}
+
+			fees = append(fees, fee)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var treasury string
+	if bz := qs.keeper.GetBridgeOutFeeTreasury(sdkCtx); len(bz) > 0 {
+		treasury = evmtypes.BytesToHexAddress(bz)
+	}
+
+	return &types.QueryBridgeOutFeesResponse{
+		Treasury:   treasury,
+		Fees:       fees,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DelayedBridgeOuts returns a page of bridge-outs held in the delayed
+// bridge-out queue, in release order.
+func (qs queryServer) DelayedBridgeOuts(
+	ctx context.Context,
+	req *types.QueryDelayedBridgeOutsRequest,
+) (*types.QueryDelayedBridgeOutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.DelayedBridgeOutKeyPrefix,
+	)
+
+	bridgeOuts := []types.DelayedBridgeOut{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var bridgeOut types.DelayedBridgeOut
+			if err := bridgeOut.Unmarshal(value); err != nil {
+				return err
+			}
+
+			bridgeOuts = append(bridgeOuts, bridgeOut)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelayedBridgeOutsResponse{
+		BridgeOuts: bridgeOuts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DelayedBridgeOut returns the bridge-out held in the delayed bridge-out
+// queue under the given identifier. Released and cancelled bridge-outs are
+// removed from the queue and cannot be queried.
+func (qs queryServer) DelayedBridgeOut(
+	ctx context.Context,
+	req *types.QueryDelayedBridgeOutRequest,
+) (*types.QueryDelayedBridgeOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bridgeOut, found := qs.keeper.GetDelayedBridgeOut(sdkCtx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "delayed bridge-out not found")
+	}
+
+	return &types.QueryDelayedBridgeOutResponse{
+		BridgeOut: *bridgeOut,
+	}, nil
+}
+
+// MinBridgeOutAmounts returns a page of per-token minimum bridge-out
+// amounts, ordered by the token address.
+func (qs queryServer) MinBridgeOutAmounts(
+	ctx context.Context,
+	req *types.QueryMinBridgeOutAmountsRequest,
+) (*types.QueryMinBridgeOutAmountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.MinBridgeOutAmountKeyPrefix,
+	)
+
+	amounts := []types.TokenMinBridgeOutAmount{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var amount math.Int
+			if err := amount.Unmarshal(value); err != nil {
+				return err
+			}
+
+			amounts = append(amounts, types.TokenMinBridgeOutAmount{
+				Token:  evmtypes.BytesToHexAddress(key),
+				Amount: amount,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMinBridgeOutAmountsResponse{
+		MinBridgeOutAmounts: amounts,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// MinBridgeOutAmountForBitcoinChain returns the minimum bridge-out amount
+// that applies specifically to the Bitcoin chain.
+func (qs queryServer) MinBridgeOutAmountForBitcoinChain(
+	ctx context.Context,
+	_ *types.QueryMinBridgeOutAmountForBitcoinChainRequest,
+) (*types.QueryMinBridgeOutAmountForBitcoinChainResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryMinBridgeOutAmountForBitcoinChainResponse{
+		Amount: qs.keeper.GetMinBridgeOutAmountForBitcoinChain(sdkCtx),
+	}, nil
+}
+
+// BridgeOutChains returns the target chains that accept bridge-outs.
+func (qs queryServer) BridgeOutChains(
+	ctx context.Context,
+	_ *types.QueryBridgeOutChainsRequest,
+) (*types.QueryBridgeOutChainsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryBridgeOutChainsResponse{
+		Chains: qs.keeper.exportBridgeOutChains(sdkCtx),
+	}, nil
+}
+
+// PauseState returns the bridge-in and bridge-out paused flags.
+func (qs queryServer) PauseState(
+	ctx context.Context,
+	_ *types.QueryPauseStateRequest,
+) (*types.QueryPauseStateResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryPauseStateResponse{
+		BridgeInPaused:  qs.keeper.IsBridgeInPaused(sdkCtx),
+		BridgeOutPaused: qs.keeper.IsBridgeOutPaused(sdkCtx),
+	}, nil
+}
+
+// TripartyControllers returns a page of allowed triparty controllers,
+// ordered by the controller address.
+func (qs queryServer) TripartyControllers(
+	ctx context.Context,
+	req *types.QueryTripartyControllersRequest,
+) (*types.QueryTripartyControllersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.TripartyControllerKeyPrefix,
+	)
+
+	controllers := []string{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, _ []byte) error {
+			controllers = append(controllers, evmtypes.BytesToHexAddress(key))
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTripartyControllersResponse{
+		Controllers: controllers,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// TripartyBlockDelay returns the triparty block delay.
+func (qs queryServer) TripartyBlockDelay(
+	ctx context.Context,
+	_ *types.QueryTripartyBlockDelayRequest,
+) (*types.QueryTripartyBlockDelayResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryTripartyBlockDelayResponse{
+		BlockDelay: qs.keeper.GetTripartyBlockDelay(sdkCtx),
+	}, nil
+}
+
+// TripartyLimits returns the triparty per-request and window limits.
+func (qs queryServer) TripartyLimits(
+	ctx context.Context,
+	_ *types.QueryTripartyLimitsRequest,
+) (*types.QueryTripartyLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryTripartyLimitsResponse{
+		PerRequestLimit: qs.keeper.GetTripartyPerRequestLimit(sdkCtx),
+		WindowLimit:     qs.keeper.GetTripartyWindowLimit(sdkCtx),
+	}, nil
+}
+
+// TripartyCapacity returns the remaining triparty window capacity and the
+// block height at which the window resets.
+func (qs queryServer) TripartyCapacity(
+	ctx context.Context,
+	_ *types.QueryTripartyCapacityRequest,
+) (*types.QueryTripartyCapacityResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	capacity, resetHeight := qs.keeper.GetTripartyCapacity(sdkCtx)
+
+	return &types.QueryTripartyCapacityResponse{
+		Capacity:    capacity,
+		ResetHeight: resetHeight,
+	}, nil
+}
+
+// TripartySequenceTips returns the triparty request and processed sequence
+// tips.
+func (qs queryServer) TripartySequenceTips(
+	ctx context.Context,
+	_ *types.QueryTripartySequenceTipsRequest,
+) (*types.QueryTripartySequenceTipsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryTripartySequenceTipsResponse{
+		RequestSequenceTip:   qs.keeper.GetTripartyRequestSequenceTip(sdkCtx),
+		ProcessedSequenceTip: qs.keeper.GetTripartyProcessedSequenceTip(sdkCtx),
+	}, nil
+}
+
+// TripartyPendingRequests returns a page of pending triparty bridge
+// requests. Requests are keyed by the big-endian bytes of their sequence,
+// so pages are only ordered by sequence within a single key length.
+func (qs queryServer) TripartyPendingRequests(
+	ctx context.Context,
+	req *types.QueryTripartyPendingRequestsRequest,
+) (*types.QueryTripartyPendingRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.TripartyRequestKeyPrefix,
+	)
+
+	requests := []types.TripartyBridgeRequest{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var request types.TripartyBridgeRequest
+			if err := request.Unmarshal(value); err != nil {
+				return err
+			}
+
+			requests = append(requests, request)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTripartyPendingRequestsResponse{
+		Requests:   requests,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TripartyRequest returns the pending triparty bridge request with the given
+// sequence number. Processed requests are removed from the state and cannot
+// be queried.
+func (qs queryServer) TripartyRequest(
+	ctx context.Context,
+	req *types.QueryTripartyRequestRequest,
+) (*types.QueryTripartyRequestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	request, found := qs.keeper.GetTripartyBridgeRequest(
+		sdkCtx,
+		math.NewIntFromUint64(req.Sequence),
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "triparty request not found")
+	}
+
+	return &types.QueryTripartyRequestResponse{
+		Request: *request,
+	}, nil
+}
+
+// TripartyRequestOutcome returns the outcome record of a processed triparty
+// bridge request by its sequence number.
+func (qs queryServer) TripartyRequestOutcome(
+	ctx context.Context,
+	req *types.QueryTripartyRequestOutcomeRequest,
+) (*types.QueryTripartyRequestOutcomeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sequence must be positive")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	outcome, found := qs.keeper.GetTripartyBridgeRequestOutcome(
+		sdkCtx,
+		math.NewIntFromUint64(req.Sequence),
+	)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			"triparty request outcome not found; the request may not be processed yet or its outcome may be pruned",
+		)
+	}
+
+	return &types.QueryTripartyRequestOutcomeResponse{
+		Outcome: *outcome,
+	}, nil
+}
+
+// TripartyControllersBTCMinted returns a page of per-controller BTC amounts
+// minted through the triparty bridge path, ordered by the controller address,
+// along with the total across all controllers.
+func (qs queryServer) TripartyControllersBTCMinted(
+	ctx context.Context,
+	req *types.QueryTripartyControllersBTCMintedRequest,
+) (*types.QueryTripartyControllersBTCMintedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(
+		sdkCtx.KVStore(qs.keeper.storeKey),
+		types.TripartyControllerBTCMintedKeyPrefix,
+	)
+
+	minted := []types.TripartyControllerBTCMinted{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var amount math.Int
+			if err := amount.Unmarshal(value); err != nil {
+				return err
+			}
+
+			minted = append(minted, types.TripartyControllerBTCMinted{
+				Controller: evmtypes.BytesToHexAddress(key),
+				Amount:     amount,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTripartyControllersBTCMintedResponse{
+		ControllersBtcMinted: minted,
+		Total:                qs.keeper.GetTripartyTotalBTCMinted(sdkCtx),
+		Pagination:           pageRes,
+	}, nil
+}
@@ -6,8 +6,12 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bridgetypes "github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestAssetsUnlockedSequenceTip(t *testing.T) {
@@ -103,6 +107,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -112,6 +117,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{},
@@ -125,6 +131,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(100),
 						Chain:          1,
 						BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 					{
 						UnlockSequence: math.NewInt(2),
@@ -134,6 +141,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -149,6 +157,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -158,6 +167,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(3),
@@ -167,6 +177,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(300),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -182,6 +193,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(100),
 						Chain:          1,
 						BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 					{
 						UnlockSequence: math.NewInt(2),
@@ -191,6 +203,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -206,6 +219,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -215,6 +229,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(3),
@@ -224,6 +239,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(300),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -239,6 +255,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 					{
 						UnlockSequence: math.NewInt(3),
@@ -248,6 +265,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(300),
 						Chain:          1,
 						BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -263,6 +281,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -272,6 +291,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -293,6 +313,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -302,6 +323,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(3),
@@ -311,6 +333,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(300),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -327,6 +350,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 					{
 						UnlockSequence: math.NewInt(3),
@@ -336,6 +360,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(300),
 						Chain:          1,
 						BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -351,6 +376,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -360,6 +386,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(3),
@@ -369,6 +396,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(300),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(3, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -385,6 +413,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -400,6 +429,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(100),
 					Chain:          1,
 					BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 				{
 					UnlockSequence: math.NewInt(2),
@@ -409,6 +439,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 					Amount:         math.NewInt(200),
 					Chain:          2,
 					BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+					Fee:            math.ZeroInt(),
 				},
 			},
 			request: &bridgetypes.QueryAssetsUnlockedEventsRequest{
@@ -425,6 +456,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(100),
 						Chain:          1,
 						BlockTime:      uint32(time.Unix(1, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 					{
 						UnlockSequence: math.NewInt(2),
@@ -434,6 +466,7 @@ func TestAssetsUnlockedEvents(t *testing.T) {
 						Amount:         math.NewInt(200),
 						Chain:          2,
 						BlockTime:      uint32(time.Unix(2, 0).Unix()), //nolint:gosec
+						Fee:            math.ZeroInt(),
 					},
 				},
 			},
@@ -535,3 +568,512 @@ func TestAssetsUnlockedEvents_MissingEventInSequence(t *testing.T) {
 		"event from requested sequence range not found",
 	)
 }
+
+func TestERC20TokenMappings(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	mappings := []*bridgetypes.ERC20TokenMapping{
+		bridgetypes.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes("0x1111111111111111111111111111111111111111"),
+			evmtypes.HexAddressToBytes("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		),
+		bridgetypes.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes("0x2222222222222222222222222222222222222222"),
+			evmtypes.HexAddressToBytes("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"),
+		),
+		bridgetypes.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes("0x3333333333333333333333333333333333333333"),
+			evmtypes.HexAddressToBytes("0xcccccccccccccccccccccccccccccccccccccccc"),
+		),
+	}
+	k.setERC20TokensMappings(ctx, mappings)
+
+	_, err := qs.ERC20TokenMappings(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	firstPage, err := qs.ERC20TokenMappings(
+		ctx,
+		&bridgetypes.QueryERC20TokenMappingsRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.ERC20TokenMapping{*mappings[0], *mappings[1]},
+		firstPage.Mappings,
+	)
+	require.EqualValues(t, 3, firstPage.Pagination.Total)
+	require.NotEmpty(t, firstPage.Pagination.NextKey)
+
+	secondPage, err := qs.ERC20TokenMappings(
+		ctx,
+		&bridgetypes.QueryERC20TokenMappingsRequest{
+			Pagination: &query.PageRequest{
+				Key:   firstPage.Pagination.NextKey,
+				Limit: 2,
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.ERC20TokenMapping{*mappings[2]},
+		secondPage.Mappings,
+	)
+	require.Empty(t, secondPage.Pagination.NextKey)
+}
+
+func TestERC20TokenMapping(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	mapping := bridgetypes.NewERC20TokenMapping(
+		evmtypes.HexAddressToBytes("0x1111111111111111111111111111111111111111"),
+		evmtypes.HexAddressToBytes("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+	)
+	k.setERC20TokensMappings(ctx, []*bridgetypes.ERC20TokenMapping{mapping})
+
+	response, err := qs.ERC20TokenMapping(
+		ctx,
+		&bridgetypes.QueryERC20TokenMappingRequest{
+			SourceToken: mapping.SourceToken,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, *mapping, response.Mapping)
+
+	_, err = qs.ERC20TokenMapping(
+		ctx,
+		&bridgetypes.QueryERC20TokenMappingRequest{
+			SourceToken: "0x2222222222222222222222222222222222222222",
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.ERC20TokenMapping(
+		ctx,
+		&bridgetypes.QueryERC20TokenMappingRequest{SourceToken: "invalid"},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestERC20Supplies(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	mapping1 := bridgetypes.NewERC20TokenMapping(
+		evmtypes.HexAddressToBytes(testSourceERC20Token2),
+		evmtypes.HexAddressToBytes(testMezoERC20Token2),
+	)
+	mapping2 := bridgetypes.NewERC20TokenMapping(
+		evmtypes.HexAddressToBytes(testSourceERC20Token1),
+		evmtypes.HexAddressToBytes(testMezoERC20Token1),
+	)
+	k.setERC20TokensMappings(ctx, []*bridgetypes.ERC20TokenMapping{mapping1, mapping2})
+
+	k.increaseERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1), math.NewInt(100))
+	k.increaseERC20Burnt(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1), math.NewInt(40))
+
+	mockERC20TotalSupply(k, testMezoERC20Token2, 0)
+	mockERC20TotalSupply(k, testMezoERC20Token1, 60)
+
+	response, err := qs.ERC20Supplies(ctx, &bridgetypes.QueryERC20SuppliesRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.ERC20TokenSupply{
+			{
+				SourceToken: mapping1.SourceToken,
+				MezoToken:   mapping1.MezoToken,
+				Minted:      math.ZeroInt(),
+				Burnt:       math.ZeroInt(),
+				TotalSupply: math.ZeroInt(),
+			},
+			{
+				SourceToken: mapping2.SourceToken,
+				MezoToken:   mapping2.MezoToken,
+				Minted:      math.NewInt(100),
+				Burnt:       math.NewInt(40),
+				TotalSupply: math.NewInt(60),
+			},
+		},
+		response.Supplies,
+	)
+
+	mockERC20TotalSupply(k, testMezoERC20Token1, 60)
+
+	supplyResponse, err := qs.ERC20Supply(
+		ctx,
+		&bridgetypes.QueryERC20SupplyRequest{SourceToken: mapping2.SourceToken},
+	)
+	require.NoError(t, err)
+	require.Equal(t, response.Supplies[1], supplyResponse.Supply)
+
+	_, err = qs.ERC20Supply(
+		ctx,
+		&bridgetypes.QueryERC20SupplyRequest{
+			SourceToken: "0x2222222222222222222222222222222222222222",
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.ERC20Supply(
+		ctx,
+		&bridgetypes.QueryERC20SupplyRequest{SourceToken: "invalid"},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSourceChains(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	response, err := qs.SourceChains(ctx, &bridgetypes.QuerySourceChainsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.SourceChains)
+
+	state := bridgetypes.SourceChainState{
+		Chain: bridgetypes.NewSourceChain(
+			1,
+			"Arbitrum",
+			evmtypes.HexAddressToBytes(testSourceERC20Token2),
+		),
+		AssetsLockedSequenceTip: math.NewInt(3),
+		Erc20TokensMappings: []bridgetypes.ERC20TokenMapping{
+			{SourceToken: testSourceERC20Token1, MezoToken: testMezoERC20Token1},
+		},
+		AssetsLockedPrunedSequenceTip: math.NewInt(3),
+		Minted: []bridgetypes.SourceChainMinted{
+			{Token: testMezoERC20Token1, Amount: math.NewInt(100)},
+		},
+	}
+	k.setSourceChainState(ctx, state)
+
+	response, err = qs.SourceChains(ctx, &bridgetypes.QuerySourceChainsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []bridgetypes.SourceChainState{state}, response.SourceChains)
+
+	chainResponse, err := qs.SourceChain(ctx, &bridgetypes.QuerySourceChainRequest{Chain: 1})
+	require.NoError(t, err)
+	require.Equal(t, state, chainResponse.SourceChain)
+
+	_, err = qs.SourceChain(ctx, &bridgetypes.QuerySourceChainRequest{Chain: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBridgeOutFeesQuery(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	response, err := qs.BridgeOutFees(ctx, &bridgetypes.QueryBridgeOutFeesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Treasury)
+	require.Empty(t, response.Fees)
+
+	fee := bridgetypes.NewBridgeOutFee(
+		evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		bridgetypes.TargetChainEthereum,
+		math.NewInt(10),
+		25,
+	)
+
+	require.NoError(t, k.SetBridgeOutFeeTreasury(ctx, testBridgeOutFeeTreasury))
+	require.NoError(t, k.SetBridgeOutFee(ctx, fee))
+
+	response, err = qs.BridgeOutFees(ctx, &bridgetypes.QueryBridgeOutFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, evmtypes.BytesToHexAddress(testBridgeOutFeeTreasury), response.Treasury)
+	require.Equal(t, []bridgetypes.BridgeOutFee{fee}, response.Fees)
+}
+
+func TestOutflowLimits(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	token1 := evmtypes.HexAddressToBytes("0x1111111111111111111111111111111111111111")
+	token2 := evmtypes.HexAddressToBytes("0x2222222222222222222222222222222222222222")
+
+	k.SetOutflowLimit(ctx, token1, math.NewInt(1000))
+	k.SetOutflowLimit(ctx, token2, math.NewInt(500))
+	k.increaseCurrentOutflow(ctx, token1, math.NewInt(300))
+
+	response, err := qs.OutflowLimits(
+		ctx,
+		&bridgetypes.QueryOutflowLimitsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.OutflowCapacity{
+			{
+				Token:          evmtypes.BytesToHexAddress(token1),
+				Limit:          math.NewInt(1000),
+				CurrentOutflow: math.NewInt(300),
+				Capacity:       math.NewInt(700),
+				ResetHeight:    OutflowResetBlocks,
+			},
+			{
+				Token:          evmtypes.BytesToHexAddress(token2),
+				Limit:          math.NewInt(500),
+				CurrentOutflow: math.ZeroInt(),
+				Capacity:       math.NewInt(500),
+				ResetHeight:    OutflowResetBlocks,
+			},
+		},
+		response.Outflows,
+	)
+	require.Equal(t, uint64(OutflowResetBlocks), response.ResetHeight)
+
+	capacityResponse, err := qs.OutflowCapacity(
+		ctx,
+		&bridgetypes.QueryOutflowCapacityRequest{
+			Token: evmtypes.BytesToHexAddress(token1),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, response.Outflows[0], capacityResponse.Outflow)
+	require.Equal(t, uint64(OutflowResetBlocks), capacityResponse.ResetHeight)
+
+	// The top-level reset height is not set for tokens on rolling windows.
+	require.NoError(t, k.SetOutflowWindow(ctx, token1, bridgetypes.NewOutflowWindow(100, 10)))
+
+	capacityResponse, err = qs.OutflowCapacity(
+		ctx,
+		&bridgetypes.QueryOutflowCapacityRequest{
+			Token: evmtypes.BytesToHexAddress(token1),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), capacityResponse.Outflow.ResetHeight)
+	require.Zero(t, capacityResponse.ResetHeight)
+
+	response, err = qs.OutflowLimits(
+		ctx,
+		&bridgetypes.QueryOutflowLimitsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(OutflowResetBlocks), response.ResetHeight)
+
+	require.NoError(t, k.SetOutflowWindow(ctx, token2, bridgetypes.NewOutflowWindow(100, 10)))
+
+	response, err = qs.OutflowLimits(
+		ctx,
+		&bridgetypes.QueryOutflowLimitsRequest{},
+	)
+	require.NoError(t, err)
+	require.Zero(t, response.ResetHeight)
+
+	_, err = qs.OutflowCapacity(
+		ctx,
+		&bridgetypes.QueryOutflowCapacityRequest{Token: "invalid"},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTripartyRequest(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	request := &bridgetypes.TripartyBridgeRequest{
+		Sequence:     math.NewInt(1),
+		BlockHeight:  10,
+		Recipient:    "0x1111111111111111111111111111111111111111",
+		Amount:       math.NewInt(1000),
+		CallbackData: []byte{0x01},
+		Controller:   "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	k.saveTripartyBridgeRequest(ctx, request)
+
+	response, err := qs.TripartyRequest(
+		ctx,
+		&bridgetypes.QueryTripartyRequestRequest{Sequence: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, *request, response.Request)
+
+	pendingResponse, err := qs.TripartyPendingRequests(
+		ctx,
+		&bridgetypes.QueryTripartyPendingRequestsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]bridgetypes.TripartyBridgeRequest{*request},
+		pendingResponse.Requests,
+	)
+
+	_, err = qs.TripartyRequest(
+		ctx,
+		&bridgetypes.QueryTripartyRequestRequest{Sequence: 2},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.TripartyRequest(
+		ctx,
+		&bridgetypes.QueryTripartyRequestRequest{Sequence: 0},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTripartyRequestOutcome(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	outcome := &bridgetypes.TripartyBridgeRequestOutcome{
+		Sequence:         math.NewInt(1),
+		Controller:       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Status:           bridgetypes.TripartyBridgeRequestStatusCallbackFailed,
+		Reason:           "execution reverted",
+		ProcessingHeight: 10,
+		GasUsed:          21000,
+	}
+	k.saveTripartyBridgeRequestOutcome(ctx, outcome)
+
+	response, err := qs.TripartyRequestOutcome(
+		ctx,
+		&bridgetypes.QueryTripartyRequestOutcomeRequest{Sequence: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, *outcome, response.Outcome)
+
+	_, err = qs.TripartyRequestOutcome(
+		ctx,
+		&bridgetypes.QueryTripartyRequestOutcomeRequest{Sequence: 2},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.TripartyRequestOutcome(
+		ctx,
+		&bridgetypes.QueryTripartyRequestOutcomeRequest{Sequence: 0},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAssetsLockedEvents(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	// Events up to sequence 2 are pruned.
+	k.setAssetsLockedSequenceTip(ctx, math.NewInt(5))
+	k.setAssetsLockedPrunedSequenceTip(ctx, math.NewInt(2))
+
+	records := make([]bridgetypes.AssetsLockedRecord, 0)
+	for sequence := int64(3); sequence <= 5; sequence++ {
+		record := bridgetypes.AssetsLockedRecord{
+			Event: bridgetypes.AssetsLockedEvent{
+				Sequence:  math.NewInt(sequence),
+				Recipient: "mezo12wsc0qgyfwwfj3wrlpgm9q3lmndl2m4qmm34dp",
+				Amount:    math.NewInt(sequence * 100),
+				Token:     testSourceBTCToken,
+			},
+			BlockHeight: sequence * 10,
+		}
+		k.saveAssetsLocked(ctx, &record)
+		records = append(records, record)
+	}
+
+	response, err := qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, records, response.Records)
+
+	response, err = qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{
+			SequenceStart: math.NewInt(4),
+			SequenceEnd:   math.NewInt(10),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, records[1:], response.Records)
+
+	_, err = qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{
+			SequenceStart: math.NewInt(2),
+		},
+	)
+	require.EqualError(t, err, "events up to sequence 2 are not retained")
+
+	eventResponse, err := qs.AssetsLockedEvent(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventRequest{Sequence: 4},
+	)
+	require.NoError(t, err)
+	require.Equal(t, records[1], eventResponse.Record)
+
+	_, err = qs.AssetsLockedEvent(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventRequest{Sequence: 2},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSourceChainAssetsLockedEvents(t *testing.T) {
+	ctx, k := mockContext()
+	qs := queryServer{k}
+
+	registerTestSourceChain(t, ctx, k, 1)
+	k.setSourceChainAssetsLockedSequenceTip(ctx, 1, math.NewInt(2))
+	k.setSourceChainAssetsLockedPrunedSequenceTip(ctx, 1, math.ZeroInt())
+
+	records := make([]bridgetypes.AssetsLockedRecord, 0)
+	for sequence := int64(1); sequence <= 2; sequence++ {
+		record := bridgetypes.AssetsLockedRecord{
+			Event: bridgetypes.AssetsLockedEvent{
+				Sequence:  math.NewInt(sequence),
+				Recipient: "mezo12wsc0qgyfwwfj3wrlpgm9q3lmndl2m4qmm34dp",
+				Amount:    math.NewInt(sequence * 100),
+				Token:     testSourceChainBTCToken,
+			},
+			BlockHeight: sequence * 10,
+			SourceChain: 1,
+		}
+		k.saveAssetsLocked(ctx, &record)
+		records = append(records, record)
+	}
+
+	response, err := qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{SourceChain: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, records, response.Records)
+
+	// Events of the source chain are not mixed with the primary ones.
+	response, err = qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, response.Records)
+
+	eventResponse, err := qs.AssetsLockedEvent(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventRequest{Sequence: 2, SourceChain: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, records[1], eventResponse.Record)
+
+	_, err = qs.AssetsLockedEvent(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventRequest{Sequence: 2},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.AssetsLockedEvent(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventRequest{Sequence: 1, SourceChain: 2},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.AssetsLockedEvents(
+		ctx,
+		&bridgetypes.QueryAssetsLockedEventsRequest{SourceChain: 2},
+	)
+	require.ErrorIs(t, err, bridgetypes.ErrSourceChainNotRegistered)
+}
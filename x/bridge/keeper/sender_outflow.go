@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// maxSenderOutflowsPrunedPerBlock is the maximum number of stale per-sender
+// bridge-out records pruned from the store in a single block.
+const maxSenderOutflowsPrunedPerBlock = 100
+
+// GetSenderOutflowParams returns the per-sender bridge-out limit parameters.
+func (k Keeper) GetSenderOutflowParams(ctx sdk.Context) types.SenderOutflowParams {
+	bz := ctx.KVStore(k.storeKey).Get(types.SenderOutflowParamsKey)
+	if len(bz) == 0 {
+		return types.SenderOutflowParams{}
+	}
+
+	var params types.SenderOutflowParams
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetSenderOutflowParams sets the per-sender bridge-out limit parameters.
+// A zero window disables the per-sender limits. Changing the window length
+// starts a new window for every sender.
+func (k Keeper) SetSenderOutflowParams(
+	ctx sdk.Context,
+	params types.SenderOutflowParams,
+) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	if params.IsEnabled() {
+		store.Set(types.SenderOutflowParamsKey, k.cdc.MustMarshal(&params))
+	} else {
+		store.Delete(types.SenderOutflowParamsKey)
+	}
+
+	k.emitEvent(ctx, &types.EventSenderOutflowParamsSet{
+		WindowBlocks: params.WindowBlocks,
+		MaxCount:     params.MaxCount,
+	})
+
+	return nil
+}
+
+// GetSenderOutflowLimit returns the maximum amount of a specific token
+// a single sender can bridge out in a per-sender window. Zero means the
+// amount is not limited.
+func (k Keeper) GetSenderOutflowLimit(
+	ctx sdk.Context,
+	token []byte,
+) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSenderOutflowLimitKey(token))
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	limit := math.ZeroInt()
+	if err := limit.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return limit
+}
+
+// SetSenderOutflowLimit sets the maximum amount of a specific token a single
+// sender can bridge out in a per-sender window. Zero removes the limit.
+func (k Keeper) SetSenderOutflowLimit(
+	ctx sdk.Context,
+	token []byte,
+	limit math.Int,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	if limit.IsZero() {
+		store.Delete(types.GetSenderOutflowLimitKey(token))
+	} else {
+		bz, err := limit.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set(types.GetSenderOutflowLimitKey(token), bz)
+	}
+
+	k.emitEvent(ctx, &types.EventSenderOutflowLimitSet{
+		Token: evmtypes.BytesToHexAddress(token),
+		Limit: limit,
+	})
+}
+
+// GetAllSenderOutflowLimits returns all the per-sender outflow limits.
+func (k Keeper) GetAllSenderOutflowLimits(ctx sdk.Context) []types.SenderOutflowLimit {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SenderOutflowLimitKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []types.SenderOutflowLimit
+
+	for ; iterator.Valid(); iterator.Next() {
+		token := iterator.Key()[len(types.SenderOutflowLimitKeyPrefix):]
+
+		var limit math.Int
+		if err := limit.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		out = append(out, types.SenderOutflowLimit{
+			Token: evmtypes.BytesToHexAddress(token),
+			Limit: limit,
+		})
+	}
+
+	return out
+}
+
+// GetAllSenderOutflows returns the bridge-outs tracked for all senders.
+// Stale records of past windows not pruned yet are included.
+func (k Keeper) GetAllSenderOutflows(ctx sdk.Context) []types.SenderOutflow {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SenderOutflowKeyPrefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var out []types.SenderOutflow
+
+	for ; iterator.Valid(); iterator.Next() {
+		var outflow types.SenderOutflow
+		k.cdc.MustUnmarshal(iterator.Value(), &outflow)
+
+		out = append(out, outflow)
+	}
+
+	return out
+}
+
+// GetSenderOutflowCapacity returns the per-sender bridge-out state of
+// a specific sender. If the token is empty, only the count fields are set.
+func (k Keeper) GetSenderOutflowCapacity(
+	ctx sdk.Context,
+	sender []byte,
+	token []byte,
+) types.SenderOutflowCapacity {
+	params := k.GetSenderOutflowParams(ctx)
+
+	capacity := types.SenderOutflowCapacity{
+		Sender:         evmtypes.BytesToHexAddress(sender),
+		AmountLimit:    math.ZeroInt(),
+		CurrentAmount:  math.ZeroInt(),
+		AmountCapacity: math.ZeroInt(),
+		CountLimit:     params.MaxCount,
+	}
+
+	if !params.IsEnabled() {
+		return capacity
+	}
+
+	outflow := k.getSenderOutflow(ctx, params, sender)
+
+	capacity.CurrentCount = outflow.Count
+	if outflow.Count < params.MaxCount {
+		capacity.CountCapacity = params.MaxCount - outflow.Count
+	}
+	capacity.ResetHeight = outflow.WindowStart + params.WindowBlocks
+
+	if len(token) > 0 {
+		capacity.Token = evmtypes.BytesToHexAddress(token)
+		capacity.AmountLimit = k.GetSenderOutflowLimit(ctx, token)
+		capacity.CurrentAmount = outflow.AmountOf(capacity.Token)
+
+		if remaining := capacity.AmountLimit.Sub(capacity.CurrentAmount); remaining.IsPositive() {
+			capacity.AmountCapacity = remaining
+		}
+	}
+
+	return capacity
+}
+
+// checkSenderOutflowLimit verifies if bridging out the amount would exceed
+// the per-sender amount or count limits of the sender.
+func (k Keeper) checkSenderOutflowLimit(
+	ctx sdk.Context,
+	sender []byte,
+	token []byte,
+	amount math.Int,
+) error {
+	params := k.GetSenderOutflowParams(ctx)
+	if !params.IsEnabled() {
+		return nil
+	}
+
+	outflow := k.getSenderOutflow(ctx, params, sender)
+
+	if params.MaxCount > 0 && outflow.Count >= params.MaxCount {
+		return types.ErrSenderOutflowCountExceeded
+	}
+
+	limit := k.GetSenderOutflowLimit(ctx, token)
+	if limit.IsPositive() {
+		current := outflow.AmountOf(evmtypes.BytesToHexAddress(token))
+		if current.Add(amount).GT(limit) {
+			return types.ErrSenderOutflowLimitExceeded
+		}
+	}
+
+	return nil
+}
+
+// increaseSenderOutflow records a bridge-out of the given amount in the
+// current window of the sender. It is a no-op if the per-sender limits are
+// disabled.
+func (k Keeper) increaseSenderOutflow(
+	ctx sdk.Context,
+	sender []byte,
+	token []byte,
+	amount math.Int,
+) {
+	params := k.GetSenderOutflowParams(ctx)
+	if !params.IsEnabled() {
+		return
+	}
+
+	outflow := k.getSenderOutflow(ctx, params, sender)
+
+	// Saturate instead of wrapping around. The count can grow that far only
+	// if it is not limited.
+	if outflow.Count < ^uint32(0) {
+		outflow.Count++
+	}
+	outflow.AddAmount(evmtypes.BytesToHexAddress(token), amount)
+
+	k.setSenderOutflow(ctx, sender, outflow)
+}
+
+// decreaseSenderOutflow gives back a bridge-out of the given amount recorded
+// at the given height for the sender. It is a no-op if the per-sender limits
+// are disabled or the bridge-out was recorded in a past window.
+func (k Keeper) decreaseSenderOutflow(
+	ctx sdk.Context,
+	sender []byte,
+	token []byte,
+	height uint64,
+	amount math.Int,
+) {
+	params := k.GetSenderOutflowParams(ctx)
+	if !params.IsEnabled() {
+		return
+	}
+
+	outflow := k.getSenderOutflow(ctx, params, sender)
+	if params.WindowStart(height) != outflow.WindowStart {
+		return
+	}
+
+	if outflow.Count > 0 {
+		outflow.Count--
+	}
+	outflow.SubAmount(evmtypes.BytesToHexAddress(token), amount)
+
+	k.setSenderOutflow(ctx, sender, outflow)
+}
+
+// getSenderOutflow returns the bridge-outs of the sender in the current
+// window. Records of past windows are ignored, so an empty record of the
+// current window is returned if the sender did not bridge out in it yet.
+// It must be called only if the per-sender limits are enabled.
+func (k Keeper) getSenderOutflow(
+	ctx sdk.Context,
+	params types.SenderOutflowParams,
+	sender []byte,
+) types.SenderOutflow {
+	windowStart := params.WindowStart(uint64(ctx.BlockHeight())) //nolint:gosec
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSenderOutflowKey(windowStart, sender))
+	if len(bz) > 0 {
+		var outflow types.SenderOutflow
+		k.cdc.MustUnmarshal(bz, &outflow)
+
+		return outflow
+	}
+
+	return types.SenderOutflow{
+		Sender:      evmtypes.BytesToHexAddress(sender),
+		WindowStart: windowStart,
+	}
+}
+
+// setSenderOutflow stores the bridge-outs tracked for the sender.
+func (k Keeper) setSenderOutflow(
+	ctx sdk.Context,
+	sender []byte,
+	outflow types.SenderOutflow,
+) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetSenderOutflowKey(outflow.WindowStart, sender),
+		k.cdc.MustMarshal(&outflow),
+	)
+}
+
+// pruneSenderOutflows removes the stale bridge-outs tracked for senders in
+// past windows. All records are stale if the per-sender limits are disabled.
+// At most maxSenderOutflowsPrunedPerBlock records are removed per block; the
+// remaining ones are removed in the following blocks.
+func (k Keeper) pruneSenderOutflows(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	end := storetypes.PrefixEndBytes(types.SenderOutflowKeyPrefix)
+	if params := k.GetSenderOutflowParams(ctx); params.IsEnabled() {
+		windowStart := params.WindowStart(uint64(ctx.BlockHeight())) //nolint:gosec
+		end = types.GetSenderOutflowKeyPrefix(windowStart)
+	}
+
+	iterator := store.Iterator(types.SenderOutflowKeyPrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxSenderOutflowsPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	_ = iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSenderOutflowParams(t *testing.T) {
+	ctx, keeper := mockContext()
+
+	// The per-sender limits are disabled by default.
+	require.False(t, keeper.GetSenderOutflowParams(ctx).IsEnabled())
+
+	require.Error(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(0, 5)))
+
+	params := types.NewSenderOutflowParams(100, 5)
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, params))
+	require.Equal(t, params, keeper.GetSenderOutflowParams(ctx))
+
+	events := emittedEvents[*types.EventSenderOutflowParamsSet](t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(100), events[0].WindowBlocks)
+	require.Equal(t, uint32(5), events[0].MaxCount)
+
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.SenderOutflowParams{}))
+	require.False(t, keeper.GetSenderOutflowParams(ctx).IsEnabled())
+}
+
+func TestSenderOutflowLimits(t *testing.T) {
+	ctx, keeper := mockContext()
+	token1 := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+	token2 := common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()
+
+	require.True(t, keeper.GetSenderOutflowLimit(ctx, token1).IsZero())
+
+	keeper.SetSenderOutflowLimit(ctx, token1, math.NewInt(1000))
+	keeper.SetSenderOutflowLimit(ctx, token2, math.NewInt(2000))
+	require.Equal(t, math.NewInt(1000), keeper.GetSenderOutflowLimit(ctx, token1))
+
+	events := emittedEvents[*types.EventSenderOutflowLimitSet](t, ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, evmtypes.BytesToHexAddress(token1), events[0].Token)
+	require.Equal(t, math.NewInt(1000), events[0].Limit)
+
+	require.Equal(
+		t,
+		[]types.SenderOutflowLimit{
+			{Token: evmtypes.BytesToHexAddress(token1), Limit: math.NewInt(1000)},
+			{Token: evmtypes.BytesToHexAddress(token2), Limit: math.NewInt(2000)},
+		},
+		keeper.GetAllSenderOutflowLimits(ctx),
+	)
+
+	// Zero removes the limit.
+	keeper.SetSenderOutflowLimit(ctx, token1, math.ZeroInt())
+	require.True(t, keeper.GetSenderOutflowLimit(ctx, token1).IsZero())
+	require.Len(t, keeper.GetAllSenderOutflowLimits(ctx), 1)
+}
+
+func TestCheckSenderOutflowLimit(t *testing.T) {
+	ctx, keeper := mockContext()
+	ctx = ctx.WithBlockHeight(150)
+
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+	otherSender := common.HexToAddress("0x4444444444444444444444444444444444444444").Bytes()
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+	otherToken := common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes()
+
+	keeper.SetSenderOutflowLimit(ctx, token, math.NewInt(1000))
+
+	// The limits are not enforced nor tracked while disabled.
+	require.NoError(t, keeper.checkSenderOutflowLimit(ctx, sender, token, math.NewInt(5000)))
+	keeper.increaseSenderOutflow(ctx, sender, token, math.NewInt(5000))
+	require.Empty(t, keeper.GetAllSenderOutflows(ctx))
+
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(100, 3)))
+
+	require.NoError(t, keeper.checkSenderOutflowLimit(ctx, sender, token, math.NewInt(1000)))
+	require.ErrorIs(
+		t,
+		keeper.checkSenderOutflowLimit(ctx, sender, token, math.NewInt(1001)),
+		types.ErrSenderOutflowLimitExceeded,
+	)
+
+	keeper.increaseSenderOutflow(ctx, sender, token, math.NewInt(600))
+	require.ErrorIs(
+		t,
+		keeper.checkSenderOutflowLimit(ctx, sender, token, math.NewInt(401)),
+		types.ErrSenderOutflowLimitExceeded,
+	)
+
+	// The amount limit is per sender and per token.
+	require.NoError(t, keeper.checkSenderOutflowLimit(ctx, otherSender, token, math.NewInt(1000)))
+	require.NoError(t, keeper.checkSenderOutflowLimit(ctx, sender, otherToken, math.NewInt(5000)))
+
+	// The count limit covers all tokens.
+	keeper.increaseSenderOutflow(ctx, sender, otherToken, math.NewInt(5000))
+	keeper.increaseSenderOutflow(ctx, sender, token, math.NewInt(100))
+	require.ErrorIs(
+		t,
+		keeper.checkSenderOutflowLimit(ctx, sender, otherToken, math.NewInt(1)),
+		types.ErrSenderOutflowCountExceeded,
+	)
+
+	require.Equal(
+		t,
+		[]types.SenderOutflow{
+			{
+				Sender:      evmtypes.BytesToHexAddress(sender),
+				WindowStart: 100,
+				Count:       3,
+				Amounts: []types.SenderTokenOutflow{
+					{Token: evmtypes.BytesToHexAddress(token), Amount: math.NewInt(700)},
+					{Token: evmtypes.BytesToHexAddress(otherToken), Amount: math.NewInt(5000)},
+				},
+			},
+		},
+		keeper.GetAllSenderOutflows(ctx),
+	)
+
+	// The next window starts empty.
+	ctx = ctx.WithBlockHeight(200)
+	require.NoError(t, keeper.checkSenderOutflowLimit(ctx, sender, token, math.NewInt(1000)))
+}
+
+func TestPruneSenderOutflows(t *testing.T) {
+	ctx, keeper := mockContext()
+	ctx = ctx.WithBlockHeight(150)
+
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(100, 3)))
+
+	senders := make([][]byte, maxSenderOutflowsPrunedPerBlock+1)
+	for i := range senders {
+		senders[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Bytes()
+		keeper.increaseSenderOutflow(ctx, senders[i], token, math.NewInt(10))
+	}
+
+	// Records of the current window are kept.
+	keeper.pruneSenderOutflows(ctx)
+	require.Len(t, keeper.GetAllSenderOutflows(ctx), len(senders))
+
+	ctx = ctx.WithBlockHeight(250)
+	keeper.increaseSenderOutflow(ctx, senders[0], token, math.NewInt(20))
+	require.Len(t, keeper.GetAllSenderOutflows(ctx), len(senders)+1)
+
+	// Records of past windows are pruned gradually.
+	keeper.pruneSenderOutflows(ctx)
+	require.Len(t, keeper.GetAllSenderOutflows(ctx), 2)
+
+	keeper.pruneSenderOutflows(ctx)
+	require.Equal(
+		t,
+		[]types.SenderOutflow{
+			{
+				Sender:      evmtypes.BytesToHexAddress(senders[0]),
+				WindowStart: 200,
+				Count:       1,
+				Amounts: []types.SenderTokenOutflow{
+					{Token: evmtypes.BytesToHexAddress(token), Amount: math.NewInt(20)},
+				},
+			},
+		},
+		keeper.GetAllSenderOutflows(ctx),
+	)
+
+	// All records are stale once the per-sender limits are disabled.
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.SenderOutflowParams{}))
+	keeper.pruneSenderOutflows(ctx)
+	require.Empty(t, keeper.GetAllSenderOutflows(ctx))
+}
+
+func TestGetSenderOutflowCapacity(t *testing.T) {
+	ctx, keeper := mockContext()
+	ctx = ctx.WithBlockHeight(150)
+
+	sender := common.HexToAddress("0x3333333333333333333333333333333333333333").Bytes()
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()
+
+	// Nothing is limited while the per-sender limits are disabled.
+	require.Equal(
+		t,
+		types.SenderOutflowCapacity{
+			Sender:         evmtypes.BytesToHexAddress(sender),
+			AmountLimit:    math.ZeroInt(),
+			CurrentAmount:  math.ZeroInt(),
+			AmountCapacity: math.ZeroInt(),
+		},
+		keeper.GetSenderOutflowCapacity(ctx, sender, token),
+	)
+
+	require.NoError(t, keeper.SetSenderOutflowParams(ctx, types.NewSenderOutflowParams(100, 3)))
+	keeper.SetSenderOutflowLimit(ctx, token, math.NewInt(1000))
+	keeper.increaseSenderOutflow(ctx, sender, token, math.NewInt(400))
+
+	require.Equal(
+		t,
+		types.SenderOutflowCapacity{
+			Sender:         evmtypes.BytesToHexAddress(sender),
+			Token:          evmtypes.BytesToHexAddress(token),
+			AmountLimit:    math.NewInt(1000),
+			CurrentAmount:  math.NewInt(400),
+			AmountCapacity: math.NewInt(600),
+			CountLimit:     3,
+			CurrentCount:   1,
+			CountCapacity:  2,
+			ResetHeight:    200,
+		},
+		keeper.GetSenderOutflowCapacity(ctx, sender, token),
+	)
+
+	// Without a token, only the count is reported.
+	capacity := keeper.GetSenderOutflowCapacity(ctx, sender, nil)
+	require.Empty(t, capacity.Token)
+	require.True(t, capacity.AmountLimit.IsZero())
+	require.Equal(t, uint32(2), capacity.CountCapacity)
+}
@@ -0,0 +1,605 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// RegisterSourceChain registers an additional bridge-in source chain. Once
+// registered, the chain starts with a zero AssetsLocked sequence tip and no
+// ERC20 token mappings.
+//
+// Requirements:
+//   - The source chain must be valid (see SourceChain.Validate),
+//   - The source chain must not be already registered,
+//   - The maximum number of source chains must not be reached.
+func (k Keeper) RegisterSourceChain(
+	ctx sdk.Context,
+	chain types.SourceChain,
+) error {
+	if err := chain.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSourceChain, err.Error())
+	}
+
+	if k.IsSourceChainRegistered(ctx, chain.Id) {
+		return types.ErrSourceChainAlreadyRegistered
+	}
+
+	if len(k.GetSourceChains(ctx)) >= types.MaxSourceChains {
+		return types.ErrMaxSourceChainsReached
+	}
+
+	k.setSourceChain(ctx, chain)
+
+	k.emitEvent(ctx, &types.EventSourceChainRegistered{
+		Chain:          chain.Id,
+		Name:           chain.Name,
+		SourceBtcToken: chain.SourceBtcToken,
+	})
+
+	return nil
+}
+
+// IsSourceChainRegistered checks if the given additional source chain is
+// registered.
+func (k Keeper) IsSourceChainRegistered(ctx sdk.Context, chain uint32) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetSourceChainKey(chain))
+}
+
+// GetSourceChain returns the given additional source chain. The boolean
+// return value indicates if the chain is registered.
+func (k Keeper) GetSourceChain(
+	ctx sdk.Context,
+	chain uint32,
+) (types.SourceChain, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSourceChainKey(chain))
+	if len(bz) == 0 {
+		return types.SourceChain{}, false
+	}
+
+	var sourceChain types.SourceChain
+	k.cdc.MustUnmarshal(bz, &sourceChain)
+
+	return sourceChain, true
+}
+
+// GetSourceChains returns all registered additional source chains, ordered
+// by their identifiers.
+func (k Keeper) GetSourceChains(ctx sdk.Context) []types.SourceChain {
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.SourceChainKeyPrefix,
+	)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var chains []types.SourceChain
+
+	for ; iterator.Valid(); iterator.Next() {
+		var chain types.SourceChain
+		k.cdc.MustUnmarshal(iterator.Value(), &chain)
+		chains = append(chains, chain)
+	}
+
+	return chains
+}
+
+// setSourceChain stores the given additional source chain.
+func (k Keeper) setSourceChain(ctx sdk.Context, chain types.SourceChain) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetSourceChainKey(chain.Id),
+		k.cdc.MustMarshal(&chain),
+	)
+}
+
+// GetSourceChainAssetsLockedSequenceTip returns the current sequence tip for
+// the AssetsLocked events of the given additional source chain. The tip
+// denotes the sequence number of the last event of the chain processed by
+// the x/bridge module.
+func (k Keeper) GetSourceChainAssetsLockedSequenceTip(
+	ctx sdk.Context,
+	chain uint32,
+) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(
+		types.GetSourceChainAssetsLockedSequenceTipKey(chain),
+	)
+	if len(bz) == 0 {
+		return math.ZeroInt()
+	}
+
+	sequenceTip := math.ZeroInt()
+	if err := sequenceTip.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return sequenceTip
+}
+
+// setSourceChainAssetsLockedSequenceTip sets the current sequence tip for
+// the AssetsLocked events of the given additional source chain.
+func (k Keeper) setSourceChainAssetsLockedSequenceTip(
+	ctx sdk.Context,
+	chain uint32,
+	sequenceTip math.Int,
+) {
+	bz, err := sequenceTip.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetSourceChainAssetsLockedSequenceTipKey(chain),
+		bz,
+	)
+}
+
+// GetSourceChainAssetsLockedPrunedSequenceTip returns the sequence number of
+// the last AssetsLocked event of the given additional source chain that is
+// not retained in the store, either because it was pruned or because it was
+// accepted before the module started persisting AssetsLocked events of
+// additional source chains.
+func (k Keeper) GetSourceChainAssetsLockedPrunedSequenceTip(
+	ctx sdk.Context,
+	chain uint32,
+) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(
+		types.GetSourceChainAssetsLockedPrunedSequenceTipKey(chain),
+	)
+	if len(bz) == 0 {
+		// No event has been persisted yet so none of the events accepted
+		// so far is retained.
+		return k.GetSourceChainAssetsLockedSequenceTip(ctx, chain)
+	}
+
+	prunedSequenceTip := math.ZeroInt()
+	if err := prunedSequenceTip.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return prunedSequenceTip
+}
+
+// setSourceChainAssetsLockedPrunedSequenceTip sets the sequence number of the
+// last AssetsLocked event of the given additional source chain that is not
+// retained in the store.
+func (k Keeper) setSourceChainAssetsLockedPrunedSequenceTip(
+	ctx sdk.Context,
+	chain uint32,
+	prunedSequenceTip math.Int,
+) {
+	bz, err := prunedSequenceTip.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetSourceChainAssetsLockedPrunedSequenceTipKey(chain),
+		bz,
+	)
+}
+
+// GetSourceChainAssetsLocked gets the accepted AssetsLocked event of the given
+// additional source chain for the given sequence number. The returned boolean
+// value indicates whether the record was found in the store. Records of
+// pruned events are not found.
+func (k Keeper) GetSourceChainAssetsLocked(
+	ctx sdk.Context,
+	chain uint32,
+	sequence math.Int,
+) (*types.AssetsLockedRecord, bool) {
+	if chain == types.PrimarySourceChain {
+		return nil, false
+	}
+
+	return k.getAssetsLocked(ctx, chain, sequence)
+}
+
+// GetAllSourceChainAssetsLocked returns all accepted AssetsLocked events of
+// the registered additional source chains retained in the store, ordered by
+// source chain and sequence number.
+func (k Keeper) GetAllSourceChainAssetsLocked(
+	ctx sdk.Context,
+) []*types.AssetsLockedRecord {
+	var records []*types.AssetsLockedRecord
+
+	for _, chain := range k.GetSourceChains(ctx) {
+		records = append(records, k.getAllAssetsLocked(ctx, chain.Id)...)
+	}
+
+	return records
+}
+
+// AcceptSourceChainAssetsLocked processes the given AssetsLocked events
+// sequence of the given additional source chain. It follows the same rules as
+// AcceptAssetsLocked, with the source BTC token, ERC20 token mappings and
+// sequence tip of the given chain. Each event is persisted along with the
+// current block height and the source chain.
+//
+// Requirements:
+//  1. The source chain must be registered.
+//  2. The AssetsLocked sequence must not be empty.
+//  3. The AssetsLocked sequence must be valid.
+//  4. The sequence number of the first event in the slice must be exactly one
+//     greater than the current sequence tip of the source chain.
+func (k Keeper) AcceptSourceChainAssetsLocked(
+	ctx sdk.Context,
+	chain uint32,
+	events types.AssetsLockedEvents,
+) error {
+	sourceChain, ok := k.GetSourceChain(ctx, chain)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrSourceChainNotRegistered, "chain %d", chain)
+	}
+
+	if len(events) == 0 {
+		return fmt.Errorf("empty AssetsLocked sequence")
+	}
+
+	if !events.IsValid() {
+		return fmt.Errorf("invalid AssetsLocked sequence")
+	}
+
+	currentSequenceTip := k.GetSourceChainAssetsLockedSequenceTip(ctx, chain)
+	expectedSequenceStart := currentSequenceTip.AddRaw(1)
+	if sequenceStart := events[0].Sequence; !expectedSequenceStart.Equal(sequenceStart) {
+		return fmt.Errorf(
+			"unexpected AssetsLocked sequence start of source chain %d; expected %s, got %s",
+			chain,
+			expectedSequenceStart,
+			sequenceStart,
+		)
+	}
+
+	// Pin the pruned sequence tip before persisting the first event. Events
+	// accepted before the module started persisting them are not retained.
+	if !ctx.KVStore(k.storeKey).Has(
+		types.GetSourceChainAssetsLockedPrunedSequenceTipKey(chain),
+	) {
+		k.setSourceChainAssetsLockedPrunedSequenceTip(ctx, chain, currentSequenceTip)
+	}
+
+	err := k.mintAssetsLocked(
+		ctx,
+		chain,
+		events,
+		sourceChain.SourceBTCTokenBytes(),
+		func(sourceToken []byte) (*types.ERC20TokenMapping, bool) {
+			return k.GetSourceChainERC20TokenMapping(ctx, chain, sourceToken)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	k.setSourceChainAssetsLockedSequenceTip(ctx, chain, events[len(events)-1].Sequence)
+
+	return nil
+}
+
+// GetSourceChainERC20TokensMappings returns all ERC20 token mappings of the
+// given additional source chain.
+func (k Keeper) GetSourceChainERC20TokensMappings(
+	ctx sdk.Context,
+	chain uint32,
+) []*types.ERC20TokenMapping {
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.GetSourceChainERC20TokenMappingKeyPrefix(chain),
+	)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var mappings []*types.ERC20TokenMapping
+
+	for ; iterator.Valid(); iterator.Next() {
+		mapping := types.MustUnmarshalERC20TokenMapping(k.cdc, iterator.Value())
+		mappings = append(mappings, &mapping)
+	}
+
+	return mappings
+}
+
+// GetSourceChainERC20TokenMapping returns an ERC20 token mapping of the given
+// additional source chain by the corresponding source token address. The
+// boolean return value indicates if the mapping was found.
+func (k Keeper) GetSourceChainERC20TokenMapping(
+	ctx sdk.Context,
+	chain uint32,
+	sourceToken []byte,
+) (*types.ERC20TokenMapping, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(
+		types.GetSourceChainERC20TokenMappingKey(chain, sourceToken),
+	)
+	if bz == nil {
+		return nil, false
+	}
+
+	mapping := types.MustUnmarshalERC20TokenMapping(k.cdc, bz)
+
+	return &mapping, true
+}
+
+// GetSourceChainMinted returns the cumulative amount of the given Mezo token
+// minted for AssetsLocked events of the given additional source chain. BTC is
+// tracked under the BTC token precompile address.
+func (k Keeper) GetSourceChainMinted(
+	ctx sdk.Context,
+	chain uint32,
+	mezoToken []byte,
+) math.Int {
+	return k.getERC20Amount(ctx, types.GetSourceChainMintedKey(chain, mezoToken))
+}
+
+// increaseSourceChainMinted increases the cumulative amount of the given Mezo
+// token minted for the given source chain. Amounts minted for the primary
+// source chain are not tracked.
+func (k Keeper) increaseSourceChainMinted(
+	ctx sdk.Context,
+	chain uint32,
+	mezoToken []byte,
+	amount math.Int,
+) {
+	if chain == types.PrimarySourceChain {
+		return
+	}
+
+	key := types.GetSourceChainMintedKey(chain, mezoToken)
+	k.setERC20Amount(ctx, key, k.getERC20Amount(ctx, key).Add(amount))
+}
+
+// getSourceChainMintedAmounts returns the cumulative amounts minted for the
+// given additional source chain, ordered by the Mezo token address.
+func (k Keeper) getSourceChainMintedAmounts(
+	ctx sdk.Context,
+	chain uint32,
+) []types.SourceChainMinted {
+	prefix := types.GetSourceChainMintedKeyPrefix(chain)
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var minted []types.SourceChainMinted
+
+	for ; iterator.Valid(); iterator.Next() {
+		minted = append(minted, types.SourceChainMinted{
+			Token:  evmtypes.BytesToHexAddress(iterator.Key()[len(prefix):]),
+			Amount: unmarshalERC20Amount(iterator.Value()),
+		})
+	}
+
+	return minted
+}
+
+// checkPrimarySourceChainLiquidity ensures a bridge-out of the given amount of
+// the given Mezo token can be covered by the liquidity locked on the primary
+// source chain. Bridge-outs are only released on the primary source chain
+// (Ethereum) so the part of the token supply minted for AssetsLocked events
+// of additional source chains is not backed there and cannot be bridged out.
+func (k Keeper) checkPrimarySourceChainLiquidity(
+	ctx sdk.Context,
+	token []byte,
+	amount math.Int,
+) error {
+	additionalMinted := math.ZeroInt()
+	for _, chain := range k.GetSourceChains(ctx) {
+		additionalMinted = additionalMinted.Add(
+			k.GetSourceChainMinted(ctx, chain.Id, token),
+		)
+	}
+
+	if additionalMinted.IsZero() {
+		return nil
+	}
+
+	var supply math.Int
+	if bytes.Equal(
+		token,
+		evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress),
+	) {
+		supply = k.GetBTCMinted(ctx).Sub(k.GetBTCBurnt(ctx))
+	} else {
+		supply = k.GetERC20Minted(ctx, token).Sub(k.GetERC20Burnt(ctx, token))
+	}
+
+	primaryLiquidity := math.MaxInt(supply.Sub(additionalMinted), math.ZeroInt())
+	if amount.GT(primaryLiquidity) {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientPrimarySourceChainLiquidity,
+			"amount %s exceeds primary source chain liquidity %s",
+			amount,
+			primaryLiquidity,
+		)
+	}
+
+	return nil
+}
+
+// CreateSourceChainERC20TokenMapping creates a new ERC20 token mapping of the
+// given additional source chain. The same Mezo token may be mapped from
+// several source chains. Bridge-outs of the Mezo token remain capped to the
+// amount backed by the primary source chain (see
+// checkPrimarySourceChainLiquidity).
+//
+// Requirements:
+//   - The source chain must be registered,
+//   - The source and Mezo token addresses must be valid, non-zero EVM addresses,
+//   - The source token address must not be already mapped on the source chain,
+//   - The maximum number of mappings of the source chain must not be reached,
+//   - The Mezo token must be a contract.
+func (k Keeper) CreateSourceChainERC20TokenMapping(
+	ctx sdk.Context,
+	chain uint32,
+	sourceToken, mezoToken []byte,
+) error {
+	if !k.IsSourceChainRegistered(ctx, chain) {
+		return sdkerrors.Wrapf(types.ErrSourceChainNotRegistered, "chain %d", chain)
+	}
+
+	mapping := types.NewERC20TokenMapping(sourceToken, mezoToken)
+
+	if !evmtypes.IsHexAddress(mapping.SourceToken) {
+		return sdkerrors.Wrap(types.ErrInvalidEVMAddress, "invalid source token")
+	}
+
+	if evmtypes.IsZeroHexAddress(mapping.SourceToken) {
+		return sdkerrors.Wrap(types.ErrZeroEVMAddress, "zero source token")
+	}
+
+	if !evmtypes.IsHexAddress(mapping.MezoToken) {
+		return sdkerrors.Wrap(types.ErrInvalidEVMAddress, "invalid mezo token")
+	}
+
+	if evmtypes.IsZeroHexAddress(mapping.MezoToken) {
+		return sdkerrors.Wrap(types.ErrZeroEVMAddress, "zero mezo token")
+	}
+
+	if _, exists := k.GetSourceChainERC20TokenMapping(
+		ctx,
+		chain,
+		mapping.SourceTokenBytes(),
+	); exists {
+		return types.ErrAlreadyMapping
+	}
+
+	existingMappingsCount := uint32(len(k.GetSourceChainERC20TokensMappings(ctx, chain))) //nolint:gosec
+	if existingMappingsCount >= k.GetParams(ctx).MaxErc20TokensMappings {
+		return types.ErrMaxMappingsReached
+	}
+
+	if !k.evmKeeper.IsContract(ctx, mapping.MezoTokenBytes()) {
+		return sdkerrors.Wrap(types.ErrTokenNotContract, "mezo token")
+	}
+
+	k.setSourceChainERC20TokenMapping(ctx, chain, mapping)
+
+	k.emitEvent(ctx, &types.EventSourceChainERC20TokenMappingCreated{
+		Chain:       chain,
+		SourceToken: mapping.SourceToken,
+		MezoToken:   mapping.MezoToken,
+	})
+
+	return nil
+}
+
+// DeleteSourceChainERC20TokenMapping deletes an ERC20 token mapping of the
+// given additional source chain.
+//
+// Requirements:
+//   - The mapping must exist.
+func (k Keeper) DeleteSourceChainERC20TokenMapping(
+	ctx sdk.Context,
+	chain uint32,
+	sourceToken []byte,
+) error {
+	mapping, exists := k.GetSourceChainERC20TokenMapping(ctx, chain, sourceToken)
+	if !exists {
+		return types.ErrNotMapping
+	}
+
+	ctx.KVStore(k.storeKey).Delete(
+		types.GetSourceChainERC20TokenMappingKey(chain, sourceToken),
+	)
+
+	k.emitEvent(ctx, &types.EventSourceChainERC20TokenMappingDeleted{
+		Chain:       chain,
+		SourceToken: mapping.SourceToken,
+		MezoToken:   mapping.MezoToken,
+	})
+
+	return nil
+}
+
+// setSourceChainERC20TokenMapping sets an ERC20 token mapping of the given
+// additional source chain.
+func (k Keeper) setSourceChainERC20TokenMapping(
+	ctx sdk.Context,
+	chain uint32,
+	mapping *types.ERC20TokenMapping,
+) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetSourceChainERC20TokenMappingKey(chain, mapping.SourceTokenBytes()),
+		types.MustMarshalERC20TokenMapping(k.cdc, *mapping),
+	)
+}
+
+// GetSourceChainState returns the bridge-in state of the given additional
+// source chain. The boolean return value indicates if the chain is
+// registered.
+func (k Keeper) GetSourceChainState(
+	ctx sdk.Context,
+	chain uint32,
+) (types.SourceChainState, bool) {
+	sourceChain, ok := k.GetSourceChain(ctx, chain)
+	if !ok {
+		return types.SourceChainState{}, false
+	}
+
+	var mappings []types.ERC20TokenMapping
+	for _, mapping := range k.GetSourceChainERC20TokensMappings(ctx, chain) {
+		mappings = append(mappings, *mapping)
+	}
+
+	return types.SourceChainState{
+		Chain:                         sourceChain,
+		AssetsLockedSequenceTip:       k.GetSourceChainAssetsLockedSequenceTip(ctx, chain),
+		Erc20TokensMappings:           mappings,
+		AssetsLockedPrunedSequenceTip: k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, chain),
+		Minted:                        k.getSourceChainMintedAmounts(ctx, chain),
+	}, true
+}
+
+// GetAllSourceChainStates returns the bridge-in state of all registered
+// additional source chains, ordered by their identifiers.
+func (k Keeper) GetAllSourceChainStates(ctx sdk.Context) []types.SourceChainState {
+	var states []types.SourceChainState
+
+	for _, chain := range k.GetSourceChains(ctx) {
+		state, _ := k.GetSourceChainState(ctx, chain.Id)
+		states = append(states, state)
+	}
+
+	return states
+}
+
+// setSourceChainState stores the bridge-in state of an additional source
+// chain. It is used to initialize the module state from genesis.
+func (k Keeper) setSourceChainState(ctx sdk.Context, state types.SourceChainState) {
+	k.setSourceChain(ctx, state.Chain)
+	k.setSourceChainAssetsLockedSequenceTip(ctx, state.Chain.Id, state.AssetsLockedSequenceTip)
+
+	for i := range state.Erc20TokensMappings {
+		k.setSourceChainERC20TokenMapping(ctx, state.Chain.Id, &state.Erc20TokensMappings[i])
+	}
+
+	// A genesis state predating the source chain AssetsLocked event store
+	// has no pruned sequence tip; it is pinned on the next accepted event.
+	if !state.AssetsLockedPrunedSequenceTip.IsNil() {
+		k.setSourceChainAssetsLockedPrunedSequenceTip(
+			ctx,
+			state.Chain.Id,
+			state.AssetsLockedPrunedSequenceTip,
+		)
+	}
+
+	for _, minted := range state.Minted {
+		k.setERC20Amount(
+			ctx,
+			types.GetSourceChainMintedKey(
+				state.Chain.Id,
+				evmtypes.HexAddressToBytes(minted.Token),
+			),
+			minted.Amount,
+		)
+	}
+}
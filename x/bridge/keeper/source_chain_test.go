@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/cmd/config"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:gosec
+const testSourceChainBTCToken = "0x0C5f3C1b2b1eA6f6A1bD0e4D5d7e3b3aF1a2B3c4"
+
+func registerTestSourceChain(t *testing.T, ctx sdk.Context, k Keeper, chain uint32) {
+	t.Helper()
+
+	require.NoError(t, k.RegisterSourceChain(
+		ctx,
+		types.NewSourceChain(
+			chain,
+			"Arbitrum",
+			evmtypes.HexAddressToBytes(testSourceChainBTCToken),
+		),
+	))
+}
+
+func TestRegisterSourceChain(t *testing.T) {
+	ctx, k := mockContext()
+
+	require.False(t, k.IsSourceChainRegistered(ctx, 1))
+	require.Empty(t, k.GetSourceChains(ctx))
+
+	registerTestSourceChain(t, ctx, k, 2)
+	registerTestSourceChain(t, ctx, k, 1)
+
+	require.True(t, k.IsSourceChainRegistered(ctx, 1))
+	require.True(t, k.IsSourceChainRegistered(ctx, 2))
+	require.True(t, k.GetSourceChainAssetsLockedSequenceTip(ctx, 1).IsZero())
+
+	// Source chains are ordered by their identifier.
+	chains := k.GetSourceChains(ctx)
+	require.Len(t, chains, 2)
+	require.EqualValues(t, 1, chains[0].Id)
+	require.EqualValues(t, 2, chains[1].Id)
+
+	events := emittedEvents[*types.EventSourceChainRegistered](t, ctx)
+	require.Len(t, events, 2)
+	require.EqualValues(t, 2, events[0].Chain)
+
+	err := k.RegisterSourceChain(
+		ctx,
+		types.NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(testSourceChainBTCToken)),
+	)
+	require.ErrorIs(t, err, types.ErrSourceChainAlreadyRegistered)
+
+	err = k.RegisterSourceChain(
+		ctx,
+		types.NewSourceChain(
+			types.PrimarySourceChain,
+			"Ethereum",
+			evmtypes.HexAddressToBytes(testSourceChainBTCToken),
+		),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidSourceChain)
+
+	for chain := uint32(3); chain <= types.MaxSourceChains; chain++ {
+		registerTestSourceChain(t, ctx, k, chain)
+	}
+
+	err = k.RegisterSourceChain(
+		ctx,
+		types.NewSourceChain(
+			types.MaxSourceChains+1,
+			"Arbitrum",
+			evmtypes.HexAddressToBytes(testSourceChainBTCToken),
+		),
+	)
+	require.ErrorIs(t, err, types.ErrMaxSourceChainsReached)
+}
+
+func TestSourceChainERC20TokenMappings(t *testing.T) {
+	ctx, k := mockContext()
+
+	sourceToken := evmtypes.HexAddressToBytes(testSourceERC20Token1)
+	mezoToken := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	err := k.CreateSourceChainERC20TokenMapping(ctx, 1, sourceToken, mezoToken)
+	require.ErrorIs(t, err, types.ErrSourceChainNotRegistered)
+
+	registerTestSourceChain(t, ctx, k, 1)
+
+	k.evmKeeper.(*mockEvmKeeper).On("IsContract", ctx, mezoToken).Return(true)
+
+	require.NoError(t, k.CreateSourceChainERC20TokenMapping(ctx, 1, sourceToken, mezoToken))
+
+	err = k.CreateSourceChainERC20TokenMapping(ctx, 1, sourceToken, mezoToken)
+	require.ErrorIs(t, err, types.ErrAlreadyMapping)
+
+	mapping, found := k.GetSourceChainERC20TokenMapping(ctx, 1, sourceToken)
+	require.True(t, found)
+	require.Equal(t, testMezoERC20Token1, mapping.MezoToken)
+
+	// Mappings of a source chain are independent of the Ethereum ones.
+	_, found = k.GetERC20TokenMapping(ctx, sourceToken)
+	require.False(t, found)
+	require.Len(t, k.GetSourceChainERC20TokensMappings(ctx, 1), 1)
+
+	require.Len(t, emittedEvents[*types.EventSourceChainERC20TokenMappingCreated](t, ctx), 1)
+
+	require.NoError(t, k.DeleteSourceChainERC20TokenMapping(ctx, 1, sourceToken))
+	require.Empty(t, k.GetSourceChainERC20TokensMappings(ctx, 1))
+	require.Len(t, emittedEvents[*types.EventSourceChainERC20TokenMappingDeleted](t, ctx), 1)
+
+	err = k.DeleteSourceChainERC20TokenMapping(ctx, 1, sourceToken)
+	require.ErrorIs(t, err, types.ErrNotMapping)
+}
+
+func TestAcceptSourceChainAssetsLocked(t *testing.T) {
+	// Set bech32 prefixes to make the recipient address validation in
+	// AssetsLocked events possible (see AssetsLockedEvent.IsValid).
+	cfg := sdk.GetConfig()
+	config.SetBech32Prefixes(cfg)
+
+	setup := func(t *testing.T) (sdk.Context, Keeper) {
+		ctx, k := mockContext()
+
+		registerTestSourceChain(t, ctx, k, 1)
+		k.setSourceChainERC20TokenMapping(ctx, 1, types.NewERC20TokenMapping(
+			evmtypes.HexAddressToBytes(testSourceERC20Token1),
+			evmtypes.HexAddressToBytes(testMezoERC20Token1),
+		))
+
+		return ctx, k
+	}
+
+	t.Run("mints assets and moves the sequence tip", func(t *testing.T) {
+		ctx, k := setup(t)
+
+		k.bankKeeper.(*mockBankKeeper).
+			On("MintCoins", ctx, types.ModuleName, mock.Anything).
+			Return(nil)
+		k.bankKeeper.(*mockBankKeeper).
+			On("SendCoinsFromModuleToAccount", ctx, types.ModuleName, mock.Anything, mock.Anything).
+			Return(nil)
+		k.evmKeeper.(*mockEvmKeeper).
+			On("ExecuteContractCall", ctx, mock.Anything).
+			Return(&evmtypes.MsgEthereumTxResponse{}, nil)
+
+		err := k.AcceptSourceChainAssetsLocked(ctx, 1, types.AssetsLockedEvents{
+			mockEvent(1, recipient1, 10, testSourceChainBTCToken),
+			mockEvent(2, recipient2, 20, testSourceERC20Token1),
+			// The Ethereum BTC token is not known on the source chain.
+			mockEvent(3, recipient1, 30, testSourceBTCToken),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewInt(3), k.GetSourceChainAssetsLockedSequenceTip(ctx, 1))
+		// The Ethereum sequence tip is not affected.
+		require.True(t, k.GetAssetsLockedSequenceTip(ctx).IsZero())
+
+		k.bankKeeper.(*mockBankKeeper).AssertNumberOfCalls(t, "MintCoins", 1)
+		require.Equal(
+			t,
+			math.NewInt(20),
+			k.GetERC20Minted(ctx, evmtypes.HexAddressToBytes(testMezoERC20Token1)),
+		)
+
+		events := emittedEvents[*types.EventAssetsLocked](t, ctx)
+		require.Len(t, events, 3)
+		for _, event := range events {
+			require.EqualValues(t, 1, event.SourceChain)
+		}
+
+		// Events are persisted along with their source chain, including
+		// the skipped ones.
+		require.True(t, k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, 1).IsZero())
+		records := k.GetAllSourceChainAssetsLocked(ctx)
+		require.Len(t, records, 3)
+		for i, record := range records {
+			require.EqualValues(t, 1, record.SourceChain)
+			require.Equal(t, math.NewInt(int64(i)+1), record.Event.Sequence)
+		}
+		require.True(t, records[2].Skipped)
+		_, found := k.GetAssetsLocked(ctx, math.NewInt(1))
+		require.False(t, found)
+
+		// Minted amounts are tracked per source chain, except the skipped
+		// ones.
+		require.Equal(
+			t,
+			math.NewInt(10),
+			k.GetSourceChainMinted(
+				ctx,
+				1,
+				evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress),
+			),
+		)
+		require.Equal(
+			t,
+			math.NewInt(20),
+			k.GetSourceChainMinted(ctx, 1, evmtypes.HexAddressToBytes(testMezoERC20Token1)),
+		)
+	})
+
+	t.Run("pins the pruned sequence tip on first accept", func(t *testing.T) {
+		ctx, k := setup(t)
+
+		// Events accepted before the module started persisting them.
+		k.setSourceChainAssetsLockedSequenceTip(ctx, 1, math.NewInt(4))
+		require.Equal(t, math.NewInt(4), k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, 1))
+
+		err := k.AcceptSourceChainAssetsLocked(ctx, 1, types.AssetsLockedEvents{
+			// The Ethereum BTC token is not known on the source chain.
+			mockEvent(5, recipient1, 30, testSourceBTCToken),
+		})
+		require.NoError(t, err)
+
+		require.Equal(t, math.NewInt(4), k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, 1))
+		_, found := k.GetSourceChainAssetsLocked(ctx, 1, math.NewInt(5))
+		require.True(t, found)
+	})
+
+	t.Run("rejects unregistered source chain", func(t *testing.T) {
+		ctx, k := setup(t)
+
+		err := k.AcceptSourceChainAssetsLocked(ctx, 2, types.AssetsLockedEvents{
+			mockEvent(1, recipient1, 10, testSourceChainBTCToken),
+		})
+		require.ErrorIs(t, err, types.ErrSourceChainNotRegistered)
+	})
+
+	t.Run("rejects unexpected sequence start", func(t *testing.T) {
+		ctx, k := setup(t)
+
+		err := k.AcceptSourceChainAssetsLocked(ctx, 1, types.AssetsLockedEvents{
+			mockEvent(2, recipient1, 10, testSourceChainBTCToken),
+		})
+		require.ErrorContains(t, err, "unexpected AssetsLocked sequence start of source chain 1")
+	})
+
+	t.Run("rejects empty events", func(t *testing.T) {
+		ctx, k := setup(t)
+
+		require.Error(t, k.AcceptSourceChainAssetsLocked(ctx, 1, types.AssetsLockedEvents{}))
+	})
+}
+
+func TestPruneSourceChainAssetsLockedEvents(t *testing.T) {
+	ctx, k := mockContext()
+
+	params := k.GetParams(ctx)
+	params.AssetsLockedEventsRetentionBlocks = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	registerTestSourceChain(t, ctx, k, 1)
+	k.setSourceChainAssetsLockedSequenceTip(ctx, 1, math.NewInt(3))
+	k.setSourceChainAssetsLockedPrunedSequenceTip(ctx, 1, math.ZeroInt())
+
+	// Events 1 and 2 were included at height 10, event 3 at height 20.
+	for sequence, height := range map[int64]int64{1: 10, 2: 10, 3: 20} {
+		k.saveAssetsLocked(ctx, &types.AssetsLockedRecord{
+			Event:       mockEvent(sequence, recipient1, 1, testSourceChainBTCToken),
+			BlockHeight: height,
+			SourceChain: 1,
+		})
+	}
+
+	k.pruneAssetsLockedEvents(ctx.WithBlockHeight(115))
+	require.Equal(t, math.NewInt(2), k.GetSourceChainAssetsLockedPrunedSequenceTip(ctx, 1))
+
+	_, found := k.GetSourceChainAssetsLocked(ctx, 1, math.NewInt(2))
+	require.False(t, found)
+	_, found = k.GetSourceChainAssetsLocked(ctx, 1, math.NewInt(3))
+	require.True(t, found)
+}
+
+func TestCheckPrimarySourceChainLiquidity(t *testing.T) {
+	btcToken := evmtypes.HexAddressToBytes(evmtypes.BTCTokenPrecompileAddress)
+	erc20Token := evmtypes.HexAddressToBytes(testMezoERC20Token1)
+
+	t.Run("does not cap tokens minted only for the primary chain", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		registerTestSourceChain(t, ctx, k, 1)
+
+		require.NoError(t, k.checkPrimarySourceChainLiquidity(ctx, btcToken, math.NewInt(1000)))
+	})
+
+	t.Run("caps BTC to the amount backed by the primary chain", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		registerTestSourceChain(t, ctx, k, 1)
+		require.NoError(t, k.IncreaseBTCMinted(ctx, math.NewInt(100)))
+		require.NoError(t, k.IncreaseBTCBurnt(ctx, math.NewInt(10)))
+		k.increaseSourceChainMinted(ctx, 1, btcToken, math.NewInt(30))
+
+		require.NoError(t, k.checkPrimarySourceChainLiquidity(ctx, btcToken, math.NewInt(60)))
+		require.ErrorIs(
+			t,
+			k.checkPrimarySourceChainLiquidity(ctx, btcToken, math.NewInt(61)),
+			types.ErrInsufficientPrimarySourceChainLiquidity,
+		)
+	})
+
+	t.Run("caps ERC20 to the amount backed by the primary chain", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		registerTestSourceChain(t, ctx, k, 1)
+		k.increaseERC20Minted(ctx, erc20Token, math.NewInt(50))
+		k.increaseSourceChainMinted(ctx, 1, erc20Token, math.NewInt(50))
+
+		require.ErrorIs(
+			t,
+			k.checkPrimarySourceChainLiquidity(ctx, erc20Token, math.NewInt(1)),
+			types.ErrInsufficientPrimarySourceChainLiquidity,
+		)
+	})
+
+	t.Run("does not track the primary chain", func(t *testing.T) {
+		ctx, k := mockContext()
+
+		k.increaseSourceChainMinted(ctx, types.PrimarySourceChain, btcToken, math.NewInt(30))
+
+		require.True(t, k.GetSourceChainMinted(ctx, types.PrimarySourceChain, btcToken).IsZero())
+	})
+}
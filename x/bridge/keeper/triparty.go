@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
 
@@ -45,6 +46,11 @@ func (k Keeper) AllowTripartyController(ctx sdk.Context, controller []byte, isAl
 	} else {
 		store.Delete(key)
 	}
+
+	k.emitEvent(ctx, &types.EventTripartyControllerAllowedSet{
+		Controller: evmtypes.BytesToHexAddress(controller),
+		Allowed:    isAllowed,
+	})
 }
 
 // getAllAllowedTripartyControllers returns all allowed triparty controllers.
@@ -91,6 +97,9 @@ func (k Keeper) SetTripartyBlockDelay(ctx sdk.Context, delay int64) error {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.TripartyBlockDelayKey, sdk.Uint64ToBigEndian(uint64(delay))) //nolint:gosec
+
+	k.emitEvent(ctx, &types.EventTripartyBlockDelaySet{BlockDelay: delay})
+
 	return nil
 }
 
@@ -122,6 +131,8 @@ func (k Keeper) SetTripartyPerRequestLimit(ctx sdk.Context, limit math.Int) {
 	}
 
 	store.Set(types.TripartyPerRequestLimitKey, bz)
+
+	k.emitEvent(ctx, &types.EventTripartyPerRequestLimitSet{Limit: limit})
 }
 
 // GetTripartyWindowLimit returns the triparty request window limit.
@@ -152,6 +163,8 @@ func (k Keeper) SetTripartyWindowLimit(ctx sdk.Context, limit math.Int) {
 	}
 
 	store.Set(types.TripartyWindowLimitKey, bz)
+
+	k.emitEvent(ctx, &types.EventTripartyWindowLimitSet{Limit: limit})
 }
 
 // GetTripartyRequestSequenceTip returns the last assigned triparty request
@@ -336,6 +349,13 @@ func (k Keeper) CreateTripartyBridgeRequest(
 	k.saveTripartyBridgeRequest(ctx, req)
 	k.increaseTripartyWindowConsumed(ctx, amount)
 
+	k.emitEvent(ctx, &types.EventTripartyBridgeRequestCreated{
+		Sequence:   seq,
+		Recipient:  recipient,
+		Amount:     amount,
+		Controller: controller,
+	})
+
 	return seq, nil
 }
 
@@ -356,7 +376,7 @@ func (k Keeper) saveTripartyBridgeRequest(
 
 // getTripartyBridgeRequest returns a pending triparty bridge request by its
 // sequence number. Returns nil and false if the request does not exist.
-func (k Keeper) getTripartyBridgeRequest(
+func (k Keeper) GetTripartyBridgeRequest(
 	ctx sdk.Context,
 	sequence math.Int,
 ) (*types.TripartyBridgeRequest, bool) {
@@ -374,6 +394,58 @@ func (k Keeper) getTripartyBridgeRequest(
 	return req, true
 }
 
+// CancelTripartyBridgeRequest cancels a pending triparty bridge request that
+// has not matured yet. The cancelled request stays in state, flagged as
+// cancelled, until the processing reaches its sequence number and records
+// its outcome without minting. If the request was created in the current
+// triparty window, its amount is released from the window. Authorization of
+// the canceller is the caller's responsibility.
+func (k Keeper) CancelTripartyBridgeRequest(
+	ctx sdk.Context,
+	sequence math.Int,
+	cancelledBy string,
+) error {
+	req, found := k.GetTripartyBridgeRequest(ctx, sequence)
+	if !found {
+		return types.ErrTripartyRequestNotFound
+	}
+
+	if req.Cancelled {
+		return types.ErrTripartyRequestAlreadyCancelled
+	}
+
+	if ctx.BlockHeight() >= req.BlockHeight+k.GetTripartyBlockDelay(ctx) {
+		return types.ErrTripartyRequestMature
+	}
+
+	req.Cancelled = true
+	k.saveTripartyBridgeRequest(ctx, req)
+
+	// Requests created before the last window reset no longer count
+	// towards the window. The reset happens in the end-blocker, after
+	// requests of the same block are created.
+	//nolint:gosec
+	if uint64(req.BlockHeight) > k.getTripartyWindowLastReset(ctx) {
+		k.decreaseTripartyWindowConsumed(ctx, req.Amount)
+	}
+
+	k.Logger(ctx).Info(
+		"triparty bridge request cancelled",
+		"sequence", req.Sequence,
+		"controller", req.Controller,
+		"cancelledBy", cancelledBy,
+	)
+
+	k.emitEvent(ctx, &types.EventTripartyBridgeRequestCancelled{
+		Sequence:    req.Sequence,
+		Controller:  req.Controller,
+		CancelledBy: cancelledBy,
+		Amount:      req.Amount,
+	})
+
+	return nil
+}
+
 // getAllPendingTripartyBridgeRequests returns all pending triparty requests.
 func (k Keeper) getAllPendingTripartyBridgeRequests(
 	ctx sdk.Context,
@@ -453,6 +525,24 @@ func (k Keeper) increaseTripartyWindowConsumed(ctx sdk.Context, amount math.Int)
 	store.Set(types.TripartyWindowConsumedKey, bz)
 }
 
+// decreaseTripartyWindowConsumed subtracts the given amount from the current
+// triparty window consumed aggregate, flooring it at zero.
+func (k Keeper) decreaseTripartyWindowConsumed(ctx sdk.Context, amount math.Int) {
+	consumed := k.getTripartyWindowConsumed(ctx).Sub(amount)
+	if consumed.IsNegative() {
+		consumed = math.ZeroInt()
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := consumed.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(types.TripartyWindowConsumedKey, bz)
+}
+
 // resetTripartyWindowConsumed clears the current triparty window consumed
 // aggregate to zero and records the current block height as the last
 // reset point.
@@ -608,11 +698,21 @@ func (k Keeper) getAllTripartyControllerBTCMinted(
 // Requests are processed in strictly increasing sequence order. Processing
 // stops at the first immature request to preserve ordering guarantees.
 // Invalid requests (blocked recipient, deauthorized controller, limit
-// exceeded) are skipped and deleted.
+// exceeded) are skipped and deleted. Cancelled requests are deleted without
+// waiting for them to mature.
 //
 // A callback failure is logged but does not prevent the mint from
-// completing or block subsequent requests. A mintBTC failure is fatal
-// and returns an error that will cause a consensus failure.
+// completing or block subsequent requests. The failed callback is queued
+// and retried at later blocks according to the triparty callback retry
+// policy; after the requests are processed, up to
+// TripartyCallbackRetryBatch due retries are issued, in every block where
+// bridge-in is not paused, whether or not any request was processed. A
+// mintBTC failure is fatal and returns an error that will cause a consensus
+// failure.
+//
+// The outcome of each processed request is recorded in the store, so
+// controllers can learn whether it was minted, skipped, or had its
+// callback fail after the request itself is deleted.
 func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 	if k.IsBridgeInPaused(ctx) {
 		k.Logger(ctx).Info("bridge-in is paused; skipping processing")
@@ -624,14 +724,15 @@ func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 	seq := k.GetTripartyProcessedSequenceTip(ctx).AddRaw(1)
 
 	for range TripartyBatch {
-		req, found := k.getTripartyBridgeRequest(ctx, seq)
+		req, found := k.GetTripartyBridgeRequest(ctx, seq)
 		if !found {
 			break
 		}
 
 		// Stop at the first immature request. No request can be processed
-		// ahead of an earlier one that is not yet mature.
-		if ctx.BlockHeight() < req.BlockHeight+blockDelay {
+		// ahead of an earlier one that is not yet mature. Cancelled
+		// requests are never minted so they do not need to mature.
+		if !req.Cancelled && ctx.BlockHeight() < req.BlockHeight+blockDelay {
 			k.Logger(ctx).Info(
 				"triparty request not yet mature; stopping processing",
 				"sequence", req.Sequence,
@@ -643,17 +744,44 @@ func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 			break
 		}
 
-		// Defense in depth: re-validate the request. Conditions may have
+		// Cancelled requests are deleted without minting. Other requests
+		// are re-validated as defense in depth. Conditions may have
 		// changed between request creation and processing (e.g. a
 		// recipient may have become blocked, a controller deauthorized,
 		// or the per-request limit lowered).
-		if err := k.validateTripartyBridgeRequest(ctx, req.Recipient, req.Amount, req.CallbackData, req.Controller); err != nil {
+		if req.Cancelled {
+			k.Logger(ctx).Info(
+				"triparty request was cancelled; request deleted",
+				"sequence", req.Sequence,
+			)
+
+			k.recordTripartyBridgeRequestOutcome(
+				ctx,
+				req,
+				types.TripartyBridgeRequestStatusCancelled,
+				"",
+				0,
+			)
+		} else if err := k.validateTripartyBridgeRequest(ctx, req.Recipient, req.Amount, req.CallbackData, req.Controller); err != nil {
 			k.Logger(ctx).Warn(
 				"triparty request failed validation; "+
 					"request skipped",
 				"sequence", req.Sequence,
 				"error", err,
 			)
+
+			k.emitEvent(ctx, &types.EventTripartyBridgeRequestSkipped{
+				Sequence: req.Sequence,
+				Reason:   err.Error(),
+			})
+
+			k.recordTripartyBridgeRequestOutcome(
+				ctx,
+				req,
+				types.TripartyBridgeRequestStatusSkipped,
+				err.Error(),
+				0,
+			)
 		} else {
 			// Mint BTC. A failure here is a system error (x/bank failure)
 			// and causes a consensus failure, same as the AssetsLocked
@@ -672,8 +800,13 @@ func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 
 			// Issue the EVM callback to the controller. A callback
 			// failure is logged but must not prevent the mint from
-			// completing or block subsequent requests.
-			k.issueTripartyCallback(ctx, req)
+			// completing or block subsequent requests. The failed
+			// callback is queued for retry instead.
+			gasUsed, _, callbackErr := k.issueTripartyCallback(
+				ctx,
+				req,
+				evmtypes.TripartyCallbackGasLimit,
+			)
 
 			k.Logger(ctx).Info(
 				"triparty bridge request processed",
@@ -682,6 +815,28 @@ func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 				"amount", req.Amount,
 				"controller", req.Controller,
 			)
+
+			k.emitEvent(ctx, &types.EventTripartyBridgeRequestProcessed{
+				Sequence:          req.Sequence,
+				Recipient:         req.Recipient,
+				Amount:            req.Amount,
+				Controller:        req.Controller,
+				CallbackSucceeded: callbackErr == nil,
+			})
+
+			status := types.TripartyBridgeRequestStatusProcessed
+			reason := ""
+			if callbackErr != nil {
+				status = types.TripartyBridgeRequestStatusCallbackFailed
+				reason = callbackErr.Error()
+
+				k.recordFailedTripartyCallbackAttempt(
+					ctx,
+					&types.FailedTripartyCallback{Request: *req},
+				)
+			}
+
+			k.recordTripartyBridgeRequestOutcome(ctx, req, status, reason, gasUsed)
 		}
 
 		// Delete the request from state regardless of whether it was
@@ -699,17 +854,22 @@ func (k Keeper) ProcessTripartyBridgeRequests(ctx sdk.Context) error {
 		seq = seq.AddRaw(1)
 	}
 
+	k.retryFailedTripartyCallbacks(ctx)
+
 	return nil
 }
 
 // issueTripartyCallback issues an EVM callback to the controller that
-// submitted a triparty bridge request. Failures are logged but do not
-// cause errors — the BTC has already been minted and cannot be rolled
+// submitted a triparty bridge request, with the given gas limit. It returns
+// the gas used by the callback, the state changes of the callback, and the
+// callback error, if any. Failures are logged but must not be treated as
+// processing errors — the BTC has already been minted and cannot be rolled
 // back without risking a supply invariant violation.
 func (k Keeper) issueTripartyCallback(
 	ctx sdk.Context,
 	req *types.TripartyBridgeRequest,
-) {
+	gasLimit uint64,
+) (uint64, []statedb.StateChange, error) {
 	controllerBytes := evmtypes.HexAddressToBytes(req.Controller)
 
 	recipientBytes := evmtypes.HexAddressToBytes(req.Recipient)
@@ -729,10 +889,14 @@ func (k Keeper) issueTripartyCallback(
 			"error", err,
 		)
 
-		return
+		return 0, nil, err
 	}
 
-	_, _, err = k.evmKeeper.ExecuteContractCall(ctx, call)
+	var gasUsed uint64
+	res, changes, err := k.evmKeeper.ExecuteContractCall(ctx, call.WithGasLimit(gasLimit))
+	if res != nil {
+		gasUsed = res.GasUsed
+	}
 	if err != nil {
 		k.Logger(ctx).Warn(
 			"triparty callback failed; mint completed but callback skipped",
@@ -740,5 +904,9 @@ func (k Keeper) issueTripartyCallback(
 			"controller", req.Controller,
 			"error", err,
 		)
+
+		return gasUsed, nil, err
 	}
+
+	return gasUsed, changes, nil
 }
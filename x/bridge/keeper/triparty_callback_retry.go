@@ -0,0 +1,393 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	"github.com/mezo-org/mezod/x/evm/statedb"
+)
+
+// TripartyCallbackRetryBatch is the maximum number of failed triparty
+// callbacks retried automatically per block. Each retry executes an EVM
+// call with up to the retry policy gas limit, so the bound keeps the
+// per-block cost of retries constant.
+const TripartyCallbackRetryBatch = 5
+
+// maxExhaustedTripartyCallbacksPrunedPerBlock is the maximum number of failed
+// triparty callbacks with exhausted retries pruned from the store in a single
+// block.
+const maxExhaustedTripartyCallbacksPrunedPerBlock = 100
+
+// GetTripartyCallbackRetryPolicy returns the retry policy of failed
+// triparty callbacks. If not set, it returns the default policy.
+func (k Keeper) GetTripartyCallbackRetryPolicy(
+	ctx sdk.Context,
+) types.TripartyCallbackRetryPolicy {
+	bz := ctx.KVStore(k.storeKey).Get(types.TripartyCallbackRetryPolicyKey)
+	if len(bz) == 0 {
+		return types.DefaultTripartyCallbackRetryPolicy()
+	}
+
+	var policy types.TripartyCallbackRetryPolicy
+	if err := policy.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return policy
+}
+
+// SetTripartyCallbackRetryPolicy sets the retry policy of failed triparty
+// callbacks. The new policy applies to attempts made from now on; retries
+// already scheduled keep their retry height.
+func (k Keeper) SetTripartyCallbackRetryPolicy(
+	ctx sdk.Context,
+	policy types.TripartyCallbackRetryPolicy,
+) error {
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("invalid triparty callback retry policy: %w", err)
+	}
+
+	bz, err := policy.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.TripartyCallbackRetryPolicyKey, bz)
+
+	k.emitEvent(ctx, &types.EventTripartyCallbackRetryPolicySet{
+		MaxAttempts:    policy.MaxAttempts,
+		IntervalBlocks: policy.IntervalBlocks,
+		GasLimit:       policy.GasLimit,
+	})
+
+	return nil
+}
+
+// GetFailedTripartyCallback returns the failed callback of a processed
+// triparty bridge request by its sequence number. The returned boolean
+// value indicates whether the callback is queued for retry.
+func (k Keeper) GetFailedTripartyCallback(
+	ctx sdk.Context,
+	sequence math.Int,
+) (*types.FailedTripartyCallback, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFailedTripartyCallbackKey(sequence))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	failed := &types.FailedTripartyCallback{}
+	if err := failed.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return failed, true
+}
+
+// GetAllFailedTripartyCallbacks returns all failed triparty callbacks queued
+// for retry.
+func (k Keeper) GetAllFailedTripartyCallbacks(
+	ctx sdk.Context,
+) []*types.FailedTripartyCallback {
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.FailedTripartyCallbackKeyPrefix,
+	)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	var failedCallbacks []*types.FailedTripartyCallback
+
+	for ; iterator.Valid(); iterator.Next() {
+		failed := &types.FailedTripartyCallback{}
+		if err := failed.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		failedCallbacks = append(failedCallbacks, failed)
+	}
+
+	return failedCallbacks
+}
+
+// saveFailedTripartyCallback stores the failed callback along with its entry
+// in the retry schedule if an automatic retry is scheduled, or its entry in
+// the index of exhausted callbacks otherwise.
+func (k Keeper) saveFailedTripartyCallback(
+	ctx sdk.Context,
+	failed *types.FailedTripartyCallback,
+) {
+	bz, err := failed.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFailedTripartyCallbackKey(failed.Request.Sequence), bz)
+
+	if failed.NextRetryHeight > 0 {
+		store.Set(
+			types.GetTripartyCallbackRetryScheduleKey(
+				failed.NextRetryHeight,
+				failed.Request.Sequence,
+			),
+			[]byte{0x01},
+		)
+	} else {
+		store.Set(
+			types.GetExhaustedTripartyCallbackKey(
+				failed.LastAttemptHeight,
+				failed.Request.Sequence,
+			),
+			[]byte{0x01},
+		)
+	}
+}
+
+// deleteFailedTripartyCallback removes the failed callback along with its
+// entry in the retry schedule or in the index of exhausted callbacks.
+func (k Keeper) deleteFailedTripartyCallback(
+	ctx sdk.Context,
+	failed *types.FailedTripartyCallback,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFailedTripartyCallbackKey(failed.Request.Sequence))
+
+	if failed.NextRetryHeight > 0 {
+		store.Delete(
+			types.GetTripartyCallbackRetryScheduleKey(
+				failed.NextRetryHeight,
+				failed.Request.Sequence,
+			),
+		)
+	} else {
+		store.Delete(
+			types.GetExhaustedTripartyCallbackKey(
+				failed.LastAttemptHeight,
+				failed.Request.Sequence,
+			),
+		)
+	}
+}
+
+// pruneExhaustedTripartyCallbacks removes failed triparty callbacks whose
+// retries are exhausted and whose last delivery attempt was made in a block
+// older than the triparty outcomes retention period. Callbacks are pruned in
+// last attempt order and at most maxExhaustedTripartyCallbacksPrunedPerBlock
+// callbacks are removed in a single call, to keep the per-block work bounded
+// when the retention period is shortened.
+func (k Keeper) pruneExhaustedTripartyCallbacks(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TripartyOutcomesRetentionBlocks
+	if retention == 0 || retention >= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return
+	}
+
+	// Callbacks last attempted at or below this height are pruned.
+	cutoffHeight := ctx.BlockHeight() - int64(retention) //nolint:gosec
+
+	var exhausted []*types.FailedTripartyCallback
+
+	prefixLen := len(types.ExhaustedTripartyCallbackKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.ExhaustedTripartyCallbackKeyPrefix,
+	)
+	for ; iterator.Valid() && len(exhausted) < maxExhaustedTripartyCallbacksPrunedPerBlock; iterator.Next() {
+		key := iterator.Key()[prefixLen:]
+
+		//nolint:gosec
+		if int64(sdk.BigEndianToUint64(key[:8])) > cutoffHeight {
+			break
+		}
+
+		sequence := math.NewIntFromBigInt(new(big.Int).SetBytes(key[8:]))
+
+		failed, found := k.GetFailedTripartyCallback(ctx, sequence)
+		if !found {
+			panic(fmt.Sprintf("missing exhausted failed triparty callback %s", sequence))
+		}
+
+		exhausted = append(exhausted, failed)
+	}
+	_ = iterator.Close()
+
+	for _, failed := range exhausted {
+		k.deleteFailedTripartyCallback(ctx, failed)
+	}
+}
+
+// recordFailedTripartyCallbackAttempt records a failed delivery attempt of
+// the callback made in the current block and queues the callback for retry.
+// The next automatic retry is scheduled according to the retry policy,
+// unless the policy's maximum number of automatic retries is exhausted.
+func (k Keeper) recordFailedTripartyCallbackAttempt(
+	ctx sdk.Context,
+	failed *types.FailedTripartyCallback,
+) {
+	policy := k.GetTripartyCallbackRetryPolicy(ctx)
+
+	failed.Attempts++
+	failed.LastAttemptHeight = ctx.BlockHeight()
+	failed.NextRetryHeight = 0
+
+	// The first attempt is the initial callback, not a retry.
+	if failed.Attempts <= policy.MaxAttempts {
+		//nolint:gosec
+		failed.NextRetryHeight = ctx.BlockHeight() + int64(policy.IntervalBlocks)
+	}
+
+	k.saveFailedTripartyCallback(ctx, failed)
+}
+
+// RetryTripartyCallback retries the failed callback of a processed triparty
+// bridge request ahead of its scheduled automatic retry. It can be triggered
+// by anyone, at most once per block for a given request, and counts against
+// the maximum number of retries of the retry policy. Once the retries are
+// exhausted, the callback can no longer be retried. The given available gas
+// is the gas left to the caller; the retry is refused before the callback
+// is issued if it does not cover the gas limit of the retry policy, so the
+// caller can always pay for the callback. The returned boolean value
+// indicates whether the callback was delivered; a callback that fails again
+// stays queued for retry. The returned gas used by the callback must be
+// charged to the caller and the returned state changes must be propagated
+// to the EVM state of the caller.
+func (k Keeper) RetryTripartyCallback(
+	ctx sdk.Context,
+	sequence math.Int,
+	availableGas uint64,
+) (bool, uint64, []statedb.StateChange, error) {
+	if k.IsBridgeInPaused(ctx) {
+		return false, 0, nil, types.ErrBridgeInPaused
+	}
+
+	failed, found := k.GetFailedTripartyCallback(ctx, sequence)
+	if !found {
+		return false, 0, nil, types.ErrTripartyCallbackNotFound
+	}
+
+	if failed.NextRetryHeight == 0 {
+		return false, 0, nil, types.ErrTripartyCallbackRetriesExhausted
+	}
+
+	if failed.LastAttemptHeight >= ctx.BlockHeight() {
+		return false, 0, nil, types.ErrTripartyCallbackRetryTooEarly
+	}
+
+	if gasLimit := k.GetTripartyCallbackRetryPolicy(ctx).GasLimit; availableGas < gasLimit {
+		return false, 0, nil, sdkerrors.Wrapf(
+			types.ErrInsufficientTripartyCallbackRetryGas,
+			"available gas %d, gas limit %d",
+			availableGas,
+			gasLimit,
+		)
+	}
+
+	delivered, gasUsed, changes := k.retryTripartyCallback(ctx, failed)
+
+	return delivered, gasUsed, changes, nil
+}
+
+// retryFailedTripartyCallbacks retries up to TripartyCallbackRetryBatch
+// failed triparty callbacks whose automatic retry is due, in retry height
+// order.
+func (k Keeper) retryFailedTripartyCallbacks(ctx sdk.Context) {
+	var due []*types.FailedTripartyCallback
+
+	prefixLen := len(types.TripartyCallbackRetryScheduleKeyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(
+		ctx.KVStore(k.storeKey),
+		types.TripartyCallbackRetryScheduleKeyPrefix,
+	)
+	for ; iterator.Valid() && len(due) < TripartyCallbackRetryBatch; iterator.Next() {
+		key := iterator.Key()[prefixLen:]
+
+		//nolint:gosec
+		if int64(sdk.BigEndianToUint64(key[:8])) > ctx.BlockHeight() {
+			break
+		}
+
+		sequence := math.NewIntFromBigInt(new(big.Int).SetBytes(key[8:]))
+
+		failed, found := k.GetFailedTripartyCallback(ctx, sequence)
+		if !found {
+			panic(fmt.Sprintf("missing scheduled failed triparty callback %s", sequence))
+		}
+
+		due = append(due, failed)
+	}
+	_ = iterator.Close()
+
+	for _, failed := range due {
+		// State changes of callbacks issued outside of an EVM transaction
+		// are already committed to the store.
+		_, _, _ = k.retryTripartyCallback(ctx, failed)
+	}
+}
+
+// retryTripartyCallback issues the failed callback again, with the gas limit
+// of the retry policy, and returns whether it was delivered along with the
+// gas it used. A delivered callback is removed from the queue and its
+// request outcome is updated. A callback that fails again is queued for the
+// next retry.
+func (k Keeper) retryTripartyCallback(
+	ctx sdk.Context,
+	failed *types.FailedTripartyCallback,
+) (bool, uint64, []statedb.StateChange) {
+	// Dequeue the callback before issuing it so the controller cannot
+	// re-enter retryTripartyCallback and have it delivered twice.
+	k.deleteFailedTripartyCallback(ctx, failed)
+
+	req := &failed.Request
+
+	gasUsed, changes, callbackErr := k.issueTripartyCallback(
+		ctx,
+		req,
+		k.GetTripartyCallbackRetryPolicy(ctx).GasLimit,
+	)
+
+	reason := ""
+	if callbackErr != nil {
+		reason = callbackErr.Error()
+		k.recordFailedTripartyCallbackAttempt(ctx, failed)
+	} else {
+		k.markTripartyCallbackDelivered(ctx, req.Sequence, gasUsed)
+	}
+
+	k.emitEvent(ctx, &types.EventTripartyCallbackRetried{
+		Sequence:   req.Sequence,
+		Controller: req.Controller,
+		Succeeded:  callbackErr == nil,
+		Attempts:   failed.Attempts,
+		GasUsed:    gasUsed,
+		Reason:     reason,
+	})
+
+	return callbackErr == nil, gasUsed, changes
+}
+
+// markTripartyCallbackDelivered updates the outcome record of a request
+// whose failed callback was delivered by a retry. Nothing is updated if the
+// record was already pruned.
+func (k Keeper) markTripartyCallbackDelivered(
+	ctx sdk.Context,
+	sequence math.Int,
+	gasUsed uint64,
+) {
+	outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, sequence)
+	if !found {
+		return
+	}
+
+	outcome.Status = types.TripartyBridgeRequestStatusProcessed
+	outcome.Reason = ""
+	outcome.GasUsed = gasUsed
+
+	k.saveTripartyBridgeRequestOutcome(ctx, outcome)
+}
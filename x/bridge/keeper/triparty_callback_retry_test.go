@@ -0,0 +1,416 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTripartyCallbackRetryPolicy(t *testing.T) {
+	ctx, k := mockContext()
+
+	require.Equal(
+		t,
+		types.DefaultTripartyCallbackRetryPolicy(),
+		k.GetTripartyCallbackRetryPolicy(ctx),
+	)
+
+	invalidPolicies := []types.TripartyCallbackRetryPolicy{
+		{MaxAttempts: types.MaxTripartyCallbackRetryAttempts + 1, IntervalBlocks: 1, GasLimit: 1},
+		{MaxAttempts: 1, IntervalBlocks: 0, GasLimit: 1},
+		{MaxAttempts: 1, IntervalBlocks: 1, GasLimit: 0},
+		{MaxAttempts: 1, IntervalBlocks: 1, GasLimit: types.MaxTripartyCallbackRetryGasLimit + 1},
+	}
+	for _, policy := range invalidPolicies {
+		require.Error(t, k.SetTripartyCallbackRetryPolicy(ctx, policy))
+	}
+
+	policy := types.TripartyCallbackRetryPolicy{
+		MaxAttempts:    0,
+		IntervalBlocks: 10,
+		GasLimit:       types.MaxTripartyCallbackRetryGasLimit,
+	}
+	require.NoError(t, k.SetTripartyCallbackRetryPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetTripartyCallbackRetryPolicy(ctx))
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyCallbackRetryPolicySet{
+			{
+				MaxAttempts:    0,
+				IntervalBlocks: 10,
+				GasLimit:       types.MaxTripartyCallbackRetryGasLimit,
+			},
+		},
+		emittedEvents[*types.EventTripartyCallbackRetryPolicySet](t, ctx),
+	)
+}
+
+func TestProcessTripartyBridgeRequests_QueuesFailedCallbacks(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+	createTripartyRequest(t, ctx, k, 10, to18Dec(2), []byte{0xab})
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(2))
+
+	// The first callback succeeds, the second one reverts.
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, nil,
+	).Once()
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, fmt.Errorf("execution reverted"),
+	).Once()
+
+	err := k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	_, found := k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.False(t, found)
+
+	failed, found := k.GetFailedTripartyCallback(ctx, math.NewInt(2))
+	require.True(t, found)
+	require.Equal(
+		t,
+		&types.FailedTripartyCallback{
+			Request: types.TripartyBridgeRequest{
+				Sequence:     math.NewInt(2),
+				BlockHeight:  10,
+				Recipient:    testTripartyRecipient,
+				Amount:       to18Dec(2),
+				CallbackData: []byte{0xab},
+				Controller:   testTripartyController,
+			},
+			Attempts:          1,
+			LastAttemptHeight: 20,
+			NextRetryHeight:   20 + int64(types.DefaultTripartyCallbackRetryPolicy().IntervalBlocks),
+		},
+		failed,
+	)
+
+	require.Len(t, k.GetAllFailedTripartyCallbacks(ctx), 1)
+}
+
+func TestProcessTripartyBridgeRequests_RetriesFailedCallbacks(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	err := k.SetTripartyCallbackRetryPolicy(ctx, types.TripartyCallbackRetryPolicy{
+		MaxAttempts:    2,
+		IntervalBlocks: 10,
+		GasLimit:       2_000_000,
+	})
+	require.NoError(t, err)
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+
+	// The initial callback is issued with the default gas limit.
+	ek.On(
+		"ExecuteContractCall",
+		ctx,
+		mock.MatchedBy(func(call evmtypes.ContractCall) bool {
+			return call.GasLimit() == evmtypes.TripartyCallbackGasLimit
+		}),
+	).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: evmtypes.TripartyCallbackGasLimit},
+		fmt.Errorf("out of gas"),
+	).Once()
+
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	// The retry is not due yet.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 29})
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	// The first retry, issued with the policy gas limit, fails again.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 30})
+	ek.On(
+		"ExecuteContractCall",
+		ctx,
+		mock.MatchedBy(func(call evmtypes.ContractCall) bool {
+			return call.GasLimit() == 2_000_000
+		}),
+	).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 2_000_000},
+		fmt.Errorf("out of gas"),
+	).Once()
+
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	failed, found := k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.EqualValues(t, 2, failed.Attempts)
+	require.EqualValues(t, 30, failed.LastAttemptHeight)
+	require.EqualValues(t, 40, failed.NextRetryHeight)
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyCallbackRetried{
+			{
+				Sequence:   math.NewInt(1),
+				Controller: testTripartyController,
+				Succeeded:  false,
+				Attempts:   2,
+				GasUsed:    2_000_000,
+				Reason:     "out of gas",
+			},
+		},
+		emittedEvents[*types.EventTripartyCallbackRetried](t, ctx),
+	)
+
+	// The second retry fails too and exhausts automatic retries.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 40})
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, fmt.Errorf("execution reverted"),
+	).Once()
+
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	failed, found = k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.EqualValues(t, 3, failed.Attempts)
+	require.EqualValues(t, 40, failed.LastAttemptHeight)
+	require.Zero(t, failed.NextRetryHeight)
+
+	// No more automatic retries are issued.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 100})
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	ek.AssertNumberOfCalls(t, "ExecuteContractCall", 3)
+
+	outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.Equal(t, types.TripartyBridgeRequestStatusCallbackFailed, outcome.Status)
+}
+
+func TestProcessTripartyBridgeRequests_DeliversRetriedCallbacks(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, fmt.Errorf("execution reverted"),
+	).Once()
+
+	err := k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	failed, found := k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: failed.NextRetryHeight})
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 60_000}, nil,
+	).Once()
+
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	_, found = k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.False(t, found)
+	require.Empty(t, k.GetAllFailedTripartyCallbacks(ctx))
+
+	outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.Equal(
+		t,
+		&types.TripartyBridgeRequestOutcome{
+			Sequence:         math.NewInt(1),
+			Controller:       testTripartyController,
+			Status:           types.TripartyBridgeRequestStatusProcessed,
+			ProcessingHeight: 20,
+			GasUsed:          60_000,
+		},
+		outcome,
+	)
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyCallbackRetried{
+			{
+				Sequence:   math.NewInt(1),
+				Controller: testTripartyController,
+				Succeeded:  true,
+				Attempts:   1,
+				GasUsed:    60_000,
+			},
+		},
+		emittedEvents[*types.EventTripartyCallbackRetried](t, ctx),
+	)
+}
+
+func TestRetryTripartyCallback(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	err := k.SetTripartyCallbackRetryPolicy(ctx, types.TripartyCallbackRetryPolicy{
+		MaxAttempts:    1,
+		IntervalBlocks: 10,
+		GasLimit:       evmtypes.TripartyCallbackGasLimit,
+	})
+	require.NoError(t, err)
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, fmt.Errorf("execution reverted"),
+	).Twice()
+
+	err = k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	failed, found := k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.EqualValues(t, 30, failed.NextRetryHeight)
+
+	_, _, _, err = k.RetryTripartyCallback(ctx, math.NewInt(3), evmtypes.TripartyCallbackGasLimit)
+	require.ErrorIs(t, err, types.ErrTripartyCallbackNotFound)
+
+	// The callback was already attempted in this block.
+	_, _, _, err = k.RetryTripartyCallback(ctx, math.NewInt(1), evmtypes.TripartyCallbackGasLimit)
+	require.ErrorIs(t, err, types.ErrTripartyCallbackRetryTooEarly)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 21})
+
+	k.SetBridgeInPaused(ctx, true)
+	_, _, _, err = k.RetryTripartyCallback(ctx, math.NewInt(1), evmtypes.TripartyCallbackGasLimit)
+	require.ErrorIs(t, err, types.ErrBridgeInPaused)
+	k.SetBridgeInPaused(ctx, false)
+
+	// The gas left to the caller does not cover the policy gas limit so the
+	// callback is not issued and no attempt is recorded.
+	_, _, _, err = k.RetryTripartyCallback(
+		ctx,
+		math.NewInt(1),
+		evmtypes.TripartyCallbackGasLimit-1,
+	)
+	require.ErrorIs(t, err, types.ErrInsufficientTripartyCallbackRetryGas)
+
+	failed, found = k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.EqualValues(t, 1, failed.Attempts)
+	require.EqualValues(t, 30, failed.NextRetryHeight)
+
+	// The manual retry fails again and uses up the only retry of the policy.
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 40_000}, fmt.Errorf("execution reverted"),
+	).Once()
+
+	delivered, gasUsed, _, err := k.RetryTripartyCallback(ctx, math.NewInt(1), evmtypes.TripartyCallbackGasLimit)
+	require.NoError(t, err)
+	require.False(t, delivered)
+	require.EqualValues(t, 40_000, gasUsed)
+
+	failed, found = k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.EqualValues(t, 2, failed.Attempts)
+	require.EqualValues(t, 21, failed.LastAttemptHeight)
+	require.Zero(t, failed.NextRetryHeight)
+
+	// The retries are exhausted so the callback can no longer be retried.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 22})
+
+	_, _, _, err = k.RetryTripartyCallback(ctx, math.NewInt(1), evmtypes.TripartyCallbackGasLimit)
+	require.ErrorIs(t, err, types.ErrTripartyCallbackRetriesExhausted)
+
+	// The manual retry of the other callback delivers it.
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 60_000}, nil,
+	).Once()
+
+	delivered, gasUsed, _, err = k.RetryTripartyCallback(ctx, math.NewInt(2), evmtypes.TripartyCallbackGasLimit)
+	require.NoError(t, err)
+	require.True(t, delivered)
+	require.EqualValues(t, 60_000, gasUsed)
+
+	_, found = k.GetFailedTripartyCallback(ctx, math.NewInt(2))
+	require.False(t, found)
+
+	_, _, _, err = k.RetryTripartyCallback(ctx, math.NewInt(2), evmtypes.TripartyCallbackGasLimit)
+	require.ErrorIs(t, err, types.ErrTripartyCallbackNotFound)
+}
+
+func TestPruneExhaustedTripartyCallbacks(t *testing.T) {
+	ctx, k := mockContext()
+
+	params := k.GetParams(ctx)
+	params.TripartyOutcomesRetentionBlocks = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	failedCallback := func(sequence, lastAttemptHeight, nextRetryHeight int64) *types.FailedTripartyCallback {
+		return &types.FailedTripartyCallback{
+			Request: types.TripartyBridgeRequest{
+				Sequence:    math.NewInt(sequence),
+				BlockHeight: 1,
+				Recipient:   testTripartyRecipient,
+				Amount:      to18Dec(1),
+				Controller:  testTripartyController,
+			},
+			Attempts:          1,
+			LastAttemptHeight: lastAttemptHeight,
+			NextRetryHeight:   nextRetryHeight,
+		}
+	}
+
+	// Callbacks 1 and 2 were exhausted at heights 10 and 20. Callback 3
+	// was last attempted at height 10 but is still scheduled for retry.
+	k.saveFailedTripartyCallback(ctx, failedCallback(1, 10, 0))
+	k.saveFailedTripartyCallback(ctx, failedCallback(2, 20, 0))
+	k.saveFailedTripartyCallback(ctx, failedCallback(3, 10, 500))
+
+	// Nothing is old enough to be pruned.
+	k.pruneExhaustedTripartyCallbacks(ctx.WithBlockHeight(109))
+	require.Len(t, k.GetAllFailedTripartyCallbacks(ctx), 3)
+
+	// The callback exhausted at height 10 falls out of the retention period.
+	k.pruneExhaustedTripartyCallbacks(ctx.WithBlockHeight(110))
+
+	_, found := k.GetFailedTripartyCallback(ctx, math.NewInt(1))
+	require.False(t, found)
+	_, found = k.GetFailedTripartyCallback(ctx, math.NewInt(2))
+	require.True(t, found)
+	_, found = k.GetFailedTripartyCallback(ctx, math.NewInt(3))
+	require.True(t, found)
+
+	// Callbacks scheduled for retry are never pruned.
+	k.pruneExhaustedTripartyCallbacks(ctx.WithBlockHeight(1000))
+	require.Equal(
+		t,
+		[]*types.FailedTripartyCallback{failedCallback(3, 10, 500)},
+		k.GetAllFailedTripartyCallbacks(ctx),
+	)
+
+	// Disabling the retention period stops pruning.
+	k.saveFailedTripartyCallback(ctx, failedCallback(4, 30, 0))
+
+	params.TripartyOutcomesRetentionBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.pruneExhaustedTripartyCallbacks(ctx.WithBlockHeight(1000))
+	_, found = k.GetFailedTripartyCallback(ctx, math.NewInt(4))
+	require.True(t, found)
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+)
+
+// maxTripartyOutcomesPrunedPerBlock is the maximum number of triparty bridge
+// request outcome records pruned from the store in a single block.
+const maxTripartyOutcomesPrunedPerBlock = 100
+
+// GetTripartyOutcomesPrunedSequenceTip returns the sequence number of the
+// last processed triparty bridge request whose outcome record is not retained
+// in the store, either because it was pruned or because the request was
+// processed before the module started recording outcomes. Outcomes of all
+// requests with greater sequence numbers, up to the triparty processed
+// sequence tip, are retained.
+func (k Keeper) GetTripartyOutcomesPrunedSequenceTip(ctx sdk.Context) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TripartyOutcomesPrunedSequenceTipKey)
+	if len(bz) == 0 {
+		// No outcome has been recorded yet so none of the requests
+		// processed so far has a retained outcome.
+		return k.GetTripartyProcessedSequenceTip(ctx)
+	}
+
+	prunedSequenceTip := math.ZeroInt()
+	if err := prunedSequenceTip.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return prunedSequenceTip
+}
+
+// setTripartyOutcomesPrunedSequenceTip sets the sequence number of the last
+// processed triparty bridge request whose outcome record is not retained in
+// the store.
+func (k Keeper) setTripartyOutcomesPrunedSequenceTip(
+	ctx sdk.Context,
+	prunedSequenceTip math.Int,
+) {
+	bz, err := prunedSequenceTip.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.TripartyOutcomesPrunedSequenceTipKey, bz)
+}
+
+// recordTripartyBridgeRequestOutcome stores the outcome record of a triparty
+// bridge request processed in the current block. It must be called before
+// the triparty processed sequence tip is advanced past the request.
+func (k Keeper) recordTripartyBridgeRequestOutcome(
+	ctx sdk.Context,
+	req *types.TripartyBridgeRequest,
+	status types.TripartyBridgeRequestStatus,
+	reason string,
+	gasUsed uint64,
+) {
+	// Pin the pruned sequence tip before recording the first outcome.
+	// Requests processed before the module started recording outcomes have
+	// no outcome record.
+	if !ctx.KVStore(k.storeKey).Has(types.TripartyOutcomesPrunedSequenceTipKey) {
+		k.setTripartyOutcomesPrunedSequenceTip(
+			ctx,
+			k.GetTripartyProcessedSequenceTip(ctx),
+		)
+	}
+
+	k.saveTripartyBridgeRequestOutcome(ctx, &types.TripartyBridgeRequestOutcome{
+		Sequence:         req.Sequence,
+		Controller:       req.Controller,
+		Status:           status,
+		Reason:           reason,
+		ProcessingHeight: ctx.BlockHeight(),
+		GasUsed:          gasUsed,
+	})
+}
+
+func (k Keeper) saveTripartyBridgeRequestOutcome(
+	ctx sdk.Context,
+	outcome *types.TripartyBridgeRequestOutcome,
+) {
+	bz, err := outcome.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GetTripartyBridgeRequestOutcomeKey(outcome.Sequence),
+		bz,
+	)
+}
+
+// GetTripartyBridgeRequestOutcome returns the outcome record of a processed
+// triparty bridge request by its sequence number. The returned boolean value
+// indicates whether the record was found in the store. Records of pending
+// requests and pruned records are not found.
+func (k Keeper) GetTripartyBridgeRequestOutcome(
+	ctx sdk.Context,
+	sequence math.Int,
+) (*types.TripartyBridgeRequestOutcome, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(
+		types.GetTripartyBridgeRequestOutcomeKey(sequence),
+	)
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	outcome := &types.TripartyBridgeRequestOutcome{}
+	if err := outcome.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return outcome, true
+}
+
+// GetAllTripartyBridgeRequestOutcomes returns all outcome records of
+// processed triparty bridge requests retained in the store, ordered by their
+// sequence numbers.
+func (k Keeper) GetAllTripartyBridgeRequestOutcomes(
+	ctx sdk.Context,
+) []*types.TripartyBridgeRequestOutcome {
+	var outcomes []*types.TripartyBridgeRequestOutcome
+
+	processedSequenceTip := k.GetTripartyProcessedSequenceTip(ctx)
+	start := k.GetTripartyOutcomesPrunedSequenceTip(ctx).AddRaw(1)
+
+	for seq := start; seq.LTE(processedSequenceTip); seq = seq.AddRaw(1) {
+		outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, seq)
+		if !found {
+			panic(fmt.Sprintf("missing retained triparty request outcome %s", seq))
+		}
+
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes
+}
+
+// pruneTripartyBridgeRequestOutcomes removes outcome records of triparty
+// bridge requests processed in blocks older than the configured retention
+// period. Records are pruned in sequence order and at most
+// maxTripartyOutcomesPrunedPerBlock records are removed in a single call, to
+// keep the per-block work bounded when the retention period is shortened.
+func (k Keeper) pruneTripartyBridgeRequestOutcomes(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TripartyOutcomesRetentionBlocks
+	if retention == 0 || retention >= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return
+	}
+
+	// Outcomes of requests processed at or below this height are pruned.
+	cutoffHeight := ctx.BlockHeight() - int64(retention) //nolint:gosec
+
+	processedSequenceTip := k.GetTripartyProcessedSequenceTip(ctx)
+	prunedSequenceTip := k.GetTripartyOutcomesPrunedSequenceTip(ctx)
+	initialPrunedSequenceTip := prunedSequenceTip
+
+	store := ctx.KVStore(k.storeKey)
+
+	for i := 0; i < maxTripartyOutcomesPrunedPerBlock; i++ {
+		if prunedSequenceTip.GTE(processedSequenceTip) {
+			break
+		}
+
+		nextSequence := prunedSequenceTip.AddRaw(1)
+
+		outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, nextSequence)
+		if !found {
+			panic(fmt.Sprintf("missing retained triparty request outcome %s", nextSequence))
+		}
+
+		if outcome.ProcessingHeight > cutoffHeight {
+			break
+		}
+
+		store.Delete(types.GetTripartyBridgeRequestOutcomeKey(nextSequence))
+		prunedSequenceTip = nextSequence
+	}
+
+	if !prunedSequenceTip.Equal(initialPrunedSequenceTip) {
+		k.setTripartyOutcomesPrunedSequenceTip(ctx, prunedSequenceTip)
+	}
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/mezo-org/mezod/x/bridge/types"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessTripartyBridgeRequests_RecordsOutcomes(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	// Allow a second controller that is deauthorized before processing.
+	controller2 := "0x0303030303030303030303030303030303030303"
+	k.AllowTripartyController(ctx, evmtypes.HexAddressToBytes(controller2), true)
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+	createTripartyRequest(t, ctx, k, 10, to18Dec(2), nil)
+	createTripartyRequestFromController(t, ctx, k, 10, to18Dec(3), nil, controller2)
+
+	k.AllowTripartyController(ctx, evmtypes.HexAddressToBytes(controller2), false)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(2))
+
+	// The first callback succeeds, the second one runs out of gas.
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 50_000}, nil,
+	).Once()
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{GasUsed: 300_000},
+		fmt.Errorf("out of gas"),
+	).Once()
+
+	err := k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]*types.TripartyBridgeRequestOutcome{
+			{
+				Sequence:         math.NewInt(1),
+				Controller:       testTripartyController,
+				Status:           types.TripartyBridgeRequestStatusProcessed,
+				ProcessingHeight: 20,
+				GasUsed:          50_000,
+			},
+			{
+				Sequence:         math.NewInt(2),
+				Controller:       testTripartyController,
+				Status:           types.TripartyBridgeRequestStatusCallbackFailed,
+				Reason:           "out of gas",
+				ProcessingHeight: 20,
+				GasUsed:          300_000,
+			},
+			{
+				Sequence:         math.NewInt(3),
+				Controller:       controller2,
+				Status:           types.TripartyBridgeRequestStatusSkipped,
+				Reason:           types.ErrTripartyControllerNotAllowed.Error(),
+				ProcessingHeight: 20,
+			},
+		},
+		k.GetAllTripartyBridgeRequestOutcomes(ctx),
+	)
+
+	require.True(t, k.GetTripartyOutcomesPrunedSequenceTip(ctx).IsZero())
+}
+
+func TestProcessTripartyBridgeRequests_PinsOutcomesPrunedSequenceTip(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	// Two requests were processed before outcomes were recorded.
+	k.setTripartyRequestSequenceTip(ctx, math.NewInt(2))
+	k.setTripartyProcessedSequenceTip(ctx, math.NewInt(2))
+
+	require.Equal(t, math.NewInt(2), k.GetTripartyOutcomesPrunedSequenceTip(ctx))
+	require.Empty(t, k.GetAllTripartyBridgeRequestOutcomes(ctx))
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, nil,
+	)
+
+	err := k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(2), k.GetTripartyOutcomesPrunedSequenceTip(ctx))
+
+	outcomes := k.GetAllTripartyBridgeRequestOutcomes(ctx)
+	require.Len(t, outcomes, 1)
+	require.Equal(t, math.NewInt(3), outcomes[0].Sequence)
+
+	_, found := k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(2))
+	require.False(t, found)
+}
+
+func TestPruneTripartyBridgeRequestOutcomes(t *testing.T) {
+	ctx, k := mockContext()
+
+	params := k.GetParams(ctx)
+	params.TripartyOutcomesRetentionBlocks = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.setTripartyProcessedSequenceTip(ctx, math.NewInt(5))
+	k.setTripartyOutcomesPrunedSequenceTip(ctx, math.NewInt(2))
+
+	// Requests 3 and 4 were processed at height 10, request 5 at height 20.
+	for sequence, height := range map[int64]int64{3: 10, 4: 10, 5: 20} {
+		k.saveTripartyBridgeRequestOutcome(ctx, &types.TripartyBridgeRequestOutcome{
+			Sequence:         math.NewInt(sequence),
+			Controller:       testTripartyController,
+			Status:           types.TripartyBridgeRequestStatusProcessed,
+			ProcessingHeight: height,
+		})
+	}
+
+	// Nothing is old enough to be pruned.
+	k.pruneTripartyBridgeRequestOutcomes(ctx.WithBlockHeight(105))
+	require.Equal(t, math.NewInt(2), k.GetTripartyOutcomesPrunedSequenceTip(ctx))
+
+	// Outcomes recorded at height 10 fall out of the retention period.
+	k.pruneTripartyBridgeRequestOutcomes(ctx.WithBlockHeight(115))
+	require.Equal(t, math.NewInt(4), k.GetTripartyOutcomesPrunedSequenceTip(ctx))
+
+	_, found := k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(3))
+	require.False(t, found)
+	_, found = k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(4))
+	require.False(t, found)
+	_, found = k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(5))
+	require.True(t, found)
+
+	// Disabling the retention period stops pruning.
+	params.TripartyOutcomesRetentionBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.pruneTripartyBridgeRequestOutcomes(ctx.WithBlockHeight(1000))
+	require.Equal(t, math.NewInt(4), k.GetTripartyOutcomesPrunedSequenceTip(ctx))
+}
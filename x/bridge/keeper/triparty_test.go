@@ -131,7 +131,7 @@ func TestCreateTripartyBridgeRequest(t *testing.T) {
 	require.Equal(t, math.NewInt(2), keeper.GetTripartyRequestSequenceTip(ctx))
 
 	// Verify the first stored request.
-	req1, found := keeper.getTripartyBridgeRequest(ctx, reqID1)
+	req1, found := keeper.GetTripartyBridgeRequest(ctx, reqID1)
 	require.True(t, found)
 	require.Equal(t, int64(100), req1.BlockHeight)
 	require.Equal(t, amount, req1.Amount)
@@ -140,13 +140,32 @@ func TestCreateTripartyBridgeRequest(t *testing.T) {
 	require.Equal(t, testTripartyController, req1.Controller)
 
 	// Verify the second stored request (nil callback data).
-	req2, found := keeper.getTripartyBridgeRequest(ctx, reqID2)
+	req2, found := keeper.GetTripartyBridgeRequest(ctx, reqID2)
 	require.True(t, found)
 	require.Equal(t, int64(100), req2.BlockHeight)
 	require.Equal(t, amount, req2.Amount)
 	require.Empty(t, req2.CallbackData)
 	require.Equal(t, testTripartyRecipient, req2.Recipient)
 	require.Equal(t, testTripartyController, req2.Controller)
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyBridgeRequestCreated{
+			{
+				Sequence:   reqID1,
+				Recipient:  testTripartyRecipient,
+				Amount:     amount,
+				Controller: testTripartyController,
+			},
+			{
+				Sequence:   reqID2,
+				Recipient:  testTripartyRecipient,
+				Amount:     amount,
+				Controller: testTripartyController,
+			},
+		},
+		emittedEvents[*types.EventTripartyBridgeRequestCreated](t, ctx),
+	)
 }
 
 func TestCreateTripartyBridgeRequestBridgeInPaused(t *testing.T) {
@@ -347,7 +366,7 @@ func TestGetTripartyBridgeRequest(t *testing.T) {
 	keeper.SetTripartyWindowLimit(ctx, to18Dec(100))
 
 	// Non-existent request returns false.
-	_, found := keeper.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := keeper.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 
 	// Create a request and retrieve it.
@@ -356,7 +375,7 @@ func TestGetTripartyBridgeRequest(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	req, found := keeper.getTripartyBridgeRequest(ctx, reqID)
+	req, found := keeper.GetTripartyBridgeRequest(ctx, reqID)
 	require.True(t, found)
 	require.True(t, reqID.Equal(req.Sequence))
 	require.Equal(t, testTripartyRecipient, req.Recipient)
@@ -381,9 +400,9 @@ func TestDeleteTripartyBridgeRequest(t *testing.T) {
 	require.NoError(t, err)
 
 	// Both requests exist.
-	_, found := keeper.getTripartyBridgeRequest(ctx, reqID1)
+	_, found := keeper.GetTripartyBridgeRequest(ctx, reqID1)
 	require.True(t, found)
-	_, found = keeper.getTripartyBridgeRequest(ctx, reqID2)
+	_, found = keeper.GetTripartyBridgeRequest(ctx, reqID2)
 	require.True(t, found)
 
 	// Deleting the second request while the first exists should fail.
@@ -393,13 +412,13 @@ func TestDeleteTripartyBridgeRequest(t *testing.T) {
 	// Deleting the first (oldest) request should succeed.
 	err = keeper.deleteTripartyBridgeRequest(ctx, reqID1)
 	require.NoError(t, err)
-	_, found = keeper.getTripartyBridgeRequest(ctx, reqID1)
+	_, found = keeper.GetTripartyBridgeRequest(ctx, reqID1)
 	require.False(t, found)
 
 	// Now the second request is the oldest; deleting it should succeed.
 	err = keeper.deleteTripartyBridgeRequest(ctx, reqID2)
 	require.NoError(t, err)
-	_, found = keeper.getTripartyBridgeRequest(ctx, reqID2)
+	_, found = keeper.GetTripartyBridgeRequest(ctx, reqID2)
 	require.False(t, found)
 }
 
@@ -878,7 +897,7 @@ func TestProcessTripartyBridgeRequests_BridgeInPaused(t *testing.T) {
 	ek.AssertNotCalled(t, "ExecuteContractCall")
 
 	// Request should still exist.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.True(t, found)
 }
 
@@ -902,7 +921,7 @@ func TestProcessTripartyBridgeRequests_BridgeOutPaused(t *testing.T) {
 	require.NoError(t, err)
 
 	// Request should have been processed.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 	require.Equal(t, math.NewInt(1), k.GetTripartyProcessedSequenceTip(ctx))
 }
@@ -928,9 +947,9 @@ func TestProcessTripartyBridgeRequests_AllImmature(t *testing.T) {
 	ek.AssertNotCalled(t, "ExecuteContractCall")
 
 	// Both requests should still exist.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.True(t, found)
-	_, found = k.getTripartyBridgeRequest(ctx, math.NewInt(2))
+	_, found = k.GetTripartyBridgeRequest(ctx, math.NewInt(2))
 	require.True(t, found)
 
 	// Processed tip should not have advanced.
@@ -961,11 +980,11 @@ func TestProcessTripartyBridgeRequests_MixedMaturity(t *testing.T) {
 	require.NoError(t, err)
 
 	// First two deleted, third remains.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
-	_, found = k.getTripartyBridgeRequest(ctx, math.NewInt(2))
+	_, found = k.GetTripartyBridgeRequest(ctx, math.NewInt(2))
 	require.False(t, found)
-	_, found = k.getTripartyBridgeRequest(ctx, math.NewInt(3))
+	_, found = k.GetTripartyBridgeRequest(ctx, math.NewInt(3))
 	require.True(t, found)
 
 	// Processed tip advanced to 2 (not 3 because we stopped there).
@@ -1020,9 +1039,9 @@ func TestProcessTripartyBridgeRequests_BlockedRecipient(t *testing.T) {
 	require.NoError(t, err)
 
 	// Both deleted.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
-	_, found = k.getTripartyBridgeRequest(ctx, math.NewInt(2))
+	_, found = k.GetTripartyBridgeRequest(ctx, math.NewInt(2))
 	require.False(t, found)
 
 	// Only the valid request contributed to provenance.
@@ -1030,6 +1049,15 @@ func TestProcessTripartyBridgeRequests_BlockedRecipient(t *testing.T) {
 
 	// Processed tip advanced to 2.
 	require.Equal(t, math.NewInt(2), k.GetTripartyProcessedSequenceTip(ctx))
+
+	skipped := emittedEvents[*types.EventTripartyBridgeRequestSkipped](t, ctx)
+	require.Len(t, skipped, 1)
+	require.Equal(t, math.NewInt(1), skipped[0].Sequence)
+	require.Equal(t, types.ErrTripartyRecipientBlocked.Error(), skipped[0].Reason)
+
+	processed := emittedEvents[*types.EventTripartyBridgeRequestProcessed](t, ctx)
+	require.Len(t, processed, 1)
+	require.Equal(t, math.NewInt(2), processed[0].Sequence)
 }
 
 func TestProcessTripartyBridgeRequests_PrecompileRecipient(t *testing.T) {
@@ -1058,7 +1086,7 @@ func TestProcessTripartyBridgeRequests_PrecompileRecipient(t *testing.T) {
 	bankKeeper.AssertNotCalled(t, "MintCoins")
 
 	// Request deleted.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 
 	// Processed tip advanced.
@@ -1087,7 +1115,7 @@ func TestProcessTripartyBridgeRequests_DeauthorizedController(t *testing.T) {
 	ek.AssertNotCalled(t, "ExecuteContractCall")
 
 	// Request deleted.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 
 	// Processed tip advanced.
@@ -1147,7 +1175,7 @@ func TestProcessTripartyBridgeRequests_PerRequestLimitExceeded(t *testing.T) {
 	ek.AssertNotCalled(t, "ExecuteContractCall")
 
 	// Request deleted.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 }
 
@@ -1168,7 +1196,7 @@ func TestProcessTripartyBridgeRequests_SuccessfulMintAndCallback(t *testing.T) {
 	require.NoError(t, err)
 
 	// Request deleted.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
 
 	// Provenance counters updated.
@@ -1185,6 +1213,20 @@ func TestProcessTripartyBridgeRequests_SuccessfulMintAndCallback(t *testing.T) {
 
 	// Callback was issued.
 	ek.AssertCalled(t, "ExecuteContractCall", ctx, mock.Anything)
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyBridgeRequestProcessed{
+			{
+				Sequence:          math.NewInt(1),
+				Recipient:         testTripartyRecipient,
+				Amount:            to18Dec(5),
+				Controller:        testTripartyController,
+				CallbackSucceeded: true,
+			},
+		},
+		emittedEvents[*types.EventTripartyBridgeRequestProcessed](t, ctx),
+	)
 }
 
 func TestProcessTripartyBridgeRequests_CallbackFailure(t *testing.T) {
@@ -1209,8 +1251,12 @@ func TestProcessTripartyBridgeRequests_CallbackFailure(t *testing.T) {
 	require.Equal(t, to18Dec(1), k.GetTripartyTotalBTCMinted(ctx))
 
 	// Request deleted despite callback failure.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(1))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
 	require.False(t, found)
+
+	processed := emittedEvents[*types.EventTripartyBridgeRequestProcessed](t, ctx)
+	require.Len(t, processed, 1)
+	require.False(t, processed[0].CallbackSucceeded)
 }
 
 func TestProcessTripartyBridgeRequests_MintBTCFailure(t *testing.T) {
@@ -1279,12 +1325,12 @@ func TestProcessTripartyBridgeRequests_BatchCap(t *testing.T) {
 
 	// Requests 1-5 deleted.
 	for i := 1; i <= 5; i++ {
-		_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(int64(i)))
+		_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(int64(i)))
 		require.False(t, found, "request %d should be deleted", i)
 	}
 
 	// Request 6 still exists.
-	_, found := k.getTripartyBridgeRequest(ctx, math.NewInt(6))
+	_, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(6))
 	require.True(t, found, "request 6 should still exist")
 
 	// Processed tip advanced to 5.
@@ -1388,3 +1434,126 @@ func TestProcessTripartyBridgeRequests_MultipleControllers(t *testing.T) {
 		ctx, controller2,
 	))
 }
+
+func TestCancelTripartyBridgeRequest(t *testing.T) {
+	ctx, k, _, _ := setupTripartyProcessing(t)
+
+	require.NoError(t, k.SetTripartyBlockDelay(ctx, 10))
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(3), nil)
+	require.Equal(t, to18Dec(3), k.getTripartyWindowConsumed(ctx))
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 15})
+
+	err := k.CancelTripartyBridgeRequest(ctx, math.NewInt(2), testTripartyController)
+	require.ErrorIs(t, err, types.ErrTripartyRequestNotFound)
+
+	err = k.CancelTripartyBridgeRequest(ctx, math.NewInt(1), testTripartyController)
+	require.NoError(t, err)
+
+	req, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.True(t, req.Cancelled)
+
+	// The window capacity consumed by the request is released.
+	require.True(t, k.getTripartyWindowConsumed(ctx).IsZero())
+
+	require.Equal(
+		t,
+		[]*types.EventTripartyBridgeRequestCancelled{
+			{
+				Sequence:    math.NewInt(1),
+				Controller:  testTripartyController,
+				CancelledBy: testTripartyController,
+				Amount:      to18Dec(3),
+			},
+		},
+		emittedEvents[*types.EventTripartyBridgeRequestCancelled](t, ctx),
+	)
+
+	// A request cannot be cancelled twice.
+	err = k.CancelTripartyBridgeRequest(ctx, math.NewInt(1), testTripartyController)
+	require.ErrorIs(t, err, types.ErrTripartyRequestAlreadyCancelled)
+}
+
+func TestCancelTripartyBridgeRequest_Mature(t *testing.T) {
+	ctx, k, _, _ := setupTripartyProcessing(t)
+
+	require.NoError(t, k.SetTripartyBlockDelay(ctx, 10))
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(3), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	err := k.CancelTripartyBridgeRequest(ctx, math.NewInt(1), testTripartyController)
+	require.ErrorIs(t, err, types.ErrTripartyRequestMature)
+
+	req, found := k.GetTripartyBridgeRequest(ctx, math.NewInt(1))
+	require.True(t, found)
+	require.False(t, req.Cancelled)
+	require.Equal(t, to18Dec(3), k.getTripartyWindowConsumed(ctx))
+}
+
+func TestCancelTripartyBridgeRequest_PreviousWindow(t *testing.T) {
+	ctx, k, _, _ := setupTripartyProcessing(t)
+
+	require.NoError(t, k.SetTripartyBlockDelay(ctx, 10))
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(3), nil)
+
+	// The window is reset in the end-blocker of the request block, so the
+	// request no longer counts towards the new window.
+	k.resetTripartyWindowConsumed(ctx.WithBlockHeight(10))
+	createTripartyRequest(t, ctx, k, 11, to18Dec(2), nil)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 12})
+
+	err := k.CancelTripartyBridgeRequest(ctx, math.NewInt(1), testTripartyController)
+	require.NoError(t, err)
+	require.Equal(t, to18Dec(2), k.getTripartyWindowConsumed(ctx))
+
+	err = k.CancelTripartyBridgeRequest(ctx, math.NewInt(2), testTripartyController)
+	require.NoError(t, err)
+	require.True(t, k.getTripartyWindowConsumed(ctx).IsZero())
+}
+
+func TestProcessTripartyBridgeRequests_CancelledRequest(t *testing.T) {
+	ctx, k, bk, ek := setupTripartyProcessing(t)
+
+	require.NoError(t, k.SetTripartyBlockDelay(ctx, 10))
+
+	createTripartyRequest(t, ctx, k, 10, to18Dec(1), nil)
+	createTripartyRequest(t, ctx, k, 10, to18Dec(2), nil)
+	createTripartyRequest(t, ctx, k, 15, to18Dec(3), nil)
+
+	// Cancel the second and the third request.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 16})
+	require.NoError(t, k.CancelTripartyBridgeRequest(ctx, math.NewInt(2), testTripartyController))
+	require.NoError(t, k.CancelTripartyBridgeRequest(ctx, math.NewInt(3), testTripartyController))
+
+	// The first request matures. The cancelled ones are processed without
+	// waiting for the third one to mature.
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 20})
+
+	expectMintBTC(bk, ctx, testTripartyRecipientAddr, to18Dec(1))
+	ek.On("ExecuteContractCall", ctx, mock.Anything).Return(
+		&evmtypes.MsgEthereumTxResponse{}, nil,
+	).Once()
+
+	err := k.ProcessTripartyBridgeRequests(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(3), k.GetTripartyProcessedSequenceTip(ctx))
+	require.Empty(t, k.getAllPendingTripartyBridgeRequests(ctx))
+
+	// Only the first request was minted.
+	require.Equal(t, to18Dec(1), k.GetTripartyTotalBTCMinted(ctx))
+	ek.AssertNumberOfCalls(t, "ExecuteContractCall", 1)
+
+	for _, sequence := range []int64{2, 3} {
+		outcome, found := k.GetTripartyBridgeRequestOutcome(ctx, math.NewInt(sequence))
+		require.True(t, found)
+		require.Equal(t, types.TripartyBridgeRequestStatusCancelled, outcome.Status)
+		require.Empty(t, outcome.Reason)
+	}
+}
@@ -34,8 +34,10 @@ func (aue AssetsUnlockedEvents) IsStrictlyIncreasingSequence() bool {
 // IsValid returns true if the event is valid. An event is considered valid if
 // its unlock sequence number is positive, its recipient is not an empty byte
 // string, its token is a valid EVM hex address, its sender is a valid EVM hex
-// address, the amount of unlocked assets is positive, the chain is Ethereum or
-// Bitcoin and block time is positive.
+// address, the amount of unlocked assets is positive, the bridge-out fee is
+// not negative, the chain is Ethereum or Bitcoin and block time is positive.
+// A nil fee, held by events recorded before bridge-out fees existed, counts
+// as zero.
 func (aue AssetsUnlockedEvent) IsValid() bool {
 	if aue.UnlockSequence.IsNil() || !aue.UnlockSequence.IsPositive() {
 		return false
@@ -57,6 +59,10 @@ func (aue AssetsUnlockedEvent) IsValid() bool {
 		return false
 	}
 
+	if !aue.Fee.IsNil() && aue.Fee.IsNegative() {
+		return false
+	}
+
 	if aue.Chain != TargetChainEthereum &&
 		aue.Chain != TargetChainBitcoin {
 		return false
@@ -24,6 +24,105 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TripartyBridgeRequestStatus is the outcome of processing a triparty bridge
+// request.
+type TripartyBridgeRequestStatus int32
+
+const (
+	// TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED is the zero value. It is never
+	// recorded for a processed request.
+	TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED TripartyBridgeRequestStatus = 0
+	// TRIPARTY_BRIDGE_REQUEST_STATUS_PROCESSED means BTC was minted to the
+	// recipient and the controller callback succeeded.
+	TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_PROCESSED TripartyBridgeRequestStatus = 1
+	// TRIPARTY_BRIDGE_REQUEST_STATUS_SKIPPED means the request failed
+	// validation at processing time and no BTC was minted.
+	TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_SKIPPED TripartyBridgeRequestStatus = 2
+	// TRIPARTY_BRIDGE_REQUEST_STATUS_CALLBACK_FAILED means BTC was minted to
+	// the recipient but the controller callback failed.
+	TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_CALLBACK_FAILED TripartyBridgeRequestStatus = 3
+	// TRIPARTY_BRIDGE_REQUEST_STATUS_CANCELLED means the request was cancelled
+	// before maturing and no BTC was minted.
+	TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_CANCELLED TripartyBridgeRequestStatus = 4
+)
+
+var TripartyBridgeRequestStatus_name = map[int32]string{
+	0: "TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED",
+	1: "TRIPARTY_BRIDGE_REQUEST_STATUS_PROCESSED",
+	2: "TRIPARTY_BRIDGE_REQUEST_STATUS_SKIPPED",
+	3: "TRIPARTY_BRIDGE_REQUEST_STATUS_CALLBACK_FAILED",
+	4: "TRIPARTY_BRIDGE_REQUEST_STATUS_CANCELLED",
+}
+
+var TripartyBridgeRequestStatus_value = map[string]int32{
+	"TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED":     0,
+	"TRIPARTY_BRIDGE_REQUEST_STATUS_PROCESSED":       1,
+	"TRIPARTY_BRIDGE_REQUEST_STATUS_SKIPPED":         2,
+	"TRIPARTY_BRIDGE_REQUEST_STATUS_CALLBACK_FAILED": 3,
+	"TRIPARTY_BRIDGE_REQUEST_STATUS_CANCELLED":       4,
+}
+
+func (x TripartyBridgeRequestStatus) String() string {
+	return proto.EnumName(TripartyBridgeRequestStatus_name, int32(x))
+}
+
+func (TripartyBridgeRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{0}
+}
+
+// ERC20TokenMappingState defines the lifecycle state of an ERC20 token
+// mapping. The state applies on top of the bridge-wide pause flags.
+type ERC20TokenMappingState int32
+
+const (
+	// ERC20_TOKEN_MAPPING_STATE_ACTIVE means the token can be bridged in and
+	// out. This is the state of mappings created before lifecycle states were
+	// introduced.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_ACTIVE ERC20TokenMappingState = 0
+	// ERC20_TOKEN_MAPPING_STATE_PENDING_ACTIVATION means the mapping is
+	// announced but the token cannot be bridged in nor out until the
+	// activation height.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_PENDING_ACTIVATION ERC20TokenMappingState = 1
+	// ERC20_TOKEN_MAPPING_STATE_BRIDGE_IN_PAUSED means the token can be bridged
+	// out only.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_BRIDGE_IN_PAUSED ERC20TokenMappingState = 2
+	// ERC20_TOKEN_MAPPING_STATE_BRIDGE_OUT_PAUSED means the token can be bridged
+	// in only.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_BRIDGE_OUT_PAUSED ERC20TokenMappingState = 3
+	// ERC20_TOKEN_MAPPING_STATE_PAUSED means the token can be bridged neither
+	// in nor out.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_PAUSED ERC20TokenMappingState = 4
+	// ERC20_TOKEN_MAPPING_STATE_DEPRECATED means the token is being phased out.
+	// It can be bridged out only so that holders can exit.
+	ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_DEPRECATED ERC20TokenMappingState = 5
+)
+
+var ERC20TokenMappingState_name = map[int32]string{
+	0: "ERC20_TOKEN_MAPPING_STATE_ACTIVE",
+	1: "ERC20_TOKEN_MAPPING_STATE_PENDING_ACTIVATION",
+	2: "ERC20_TOKEN_MAPPING_STATE_BRIDGE_IN_PAUSED",
+	3: "ERC20_TOKEN_MAPPING_STATE_BRIDGE_OUT_PAUSED",
+	4: "ERC20_TOKEN_MAPPING_STATE_PAUSED",
+	5: "ERC20_TOKEN_MAPPING_STATE_DEPRECATED",
+}
+
+var ERC20TokenMappingState_value = map[string]int32{
+	"ERC20_TOKEN_MAPPING_STATE_ACTIVE":             0,
+	"ERC20_TOKEN_MAPPING_STATE_PENDING_ACTIVATION": 1,
+	"ERC20_TOKEN_MAPPING_STATE_BRIDGE_IN_PAUSED":   2,
+	"ERC20_TOKEN_MAPPING_STATE_BRIDGE_OUT_PAUSED":  3,
+	"ERC20_TOKEN_MAPPING_STATE_PAUSED":             4,
+	"ERC20_TOKEN_MAPPING_STATE_DEPRECATED":         5,
+}
+
+func (x ERC20TokenMappingState) String() string {
+	return proto.EnumName(ERC20TokenMappingState_name, int32(x))
+}
+
+func (ERC20TokenMappingState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{1}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// max_erc20_tokens_mappings is the maximum number of distinct ERC20 tokens
@@ -34,6 +133,24 @@ type Params struct {
 	// checks if the total BTC supply on the Mezo chain is equal to the difference
 	// between the total BTC minted and burned by the bridge module.
 	BtcSupplyAssertionEnabled bool `protobuf:"varint,2,opt,name=btc_supply_assertion_enabled,json=btcSupplyAssertionEnabled,proto3" json:"btc_supply_assertion_enabled,omitempty"`
+	// assets_locked_events_retention_blocks is the number of blocks for which
+	// accepted AssetsLocked events are retained in the module state. Events
+	// included in older blocks are pruned. Zero disables pruning.
+	AssetsLockedEventsRetentionBlocks uint64 `protobuf:"varint,3,opt,name=assets_locked_events_retention_blocks,json=assetsLockedEventsRetentionBlocks,proto3" json:"assets_locked_events_retention_blocks,omitempty"`
+	// erc20_supply_assertion_enabled is a flag to enable/disable the check
+	// that the total supply of each mapped ERC20 token on the Mezo chain is
+	// equal to the difference between the amount of the token minted and
+	// burned by the bridge module. The check runs periodically and requires
+	// mapped tokens to be minted and burned by the bridge only. Unlike the BTC
+	// supply assertion, a violation does not halt the chain but pauses
+	// bridge-out of the token.
+	Erc20SupplyAssertionEnabled bool `protobuf:"varint,4,opt,name=erc20_supply_assertion_enabled,json=erc20SupplyAssertionEnabled,proto3" json:"erc20_supply_assertion_enabled,omitempty"`
+	// triparty_outcomes_retention_blocks is the number of blocks for which
+	// outcome records of processed triparty bridge requests are retained in the
+	// module state. Records of requests processed in older blocks are pruned.
+	// Failed triparty callbacks whose retries are exhausted are pruned the same
+	// way, counting from their last delivery attempt. Zero disables pruning.
+	TripartyOutcomesRetentionBlocks uint64 `protobuf:"varint,5,opt,name=triparty_outcomes_retention_blocks,json=tripartyOutcomesRetentionBlocks,proto3" json:"triparty_outcomes_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -82,6 +199,27 @@ func (m *Params) GetBtcSupplyAssertionEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAssetsLockedEventsRetentionBlocks() uint64 {
+	if m != nil {
+		return m.AssetsLockedEventsRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetErc20SupplyAssertionEnabled() bool {
+	if m != nil {
+		return m.Erc20SupplyAssertionEnabled
+	}
+	return false
+}
+
+func (m *Params) GetTripartyOutcomesRetentionBlocks() uint64 {
+	if m != nil {
+		return m.TripartyOutcomesRetentionBlocks
+	}
+	return 0
+}
+
 // AssetsLockedEvent represents the event where inbound assets are locked in
 // the Bitcoin bridge.
 type AssetsLockedEvent struct {
@@ -143,6 +281,83 @@ func (m *AssetsLockedEvent) GetToken() string {
 	return ""
 }
 
+// AssetsLockedRecord represents an AssetsLocked event accepted by the bridge,
+// along with the Mezo block that included it.
+type AssetsLockedRecord struct {
+	// event is the accepted AssetsLocked event.
+	Event AssetsLockedEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event"`
+	// block_height is the height of the Mezo block that included the event.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// skipped is true if the event advanced the sequence tip without minting
+	// assets to the recipient, e.g. because the recipient is a blocked address
+	// or the source token has no ERC20 mapping.
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// source_chain is the chain the event was locked on. Zero denotes the
+	// primary source chain (Ethereum).
+	SourceChain uint32 `protobuf:"varint,4,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+}
+
+func (m *AssetsLockedRecord) Reset()         { *m = AssetsLockedRecord{} }
+func (m *AssetsLockedRecord) String() string { return proto.CompactTextString(m) }
+func (*AssetsLockedRecord) ProtoMessage()    {}
+func (*AssetsLockedRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{2}
+}
+func (m *AssetsLockedRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetsLockedRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetsLockedRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetsLockedRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsLockedRecord.Merge(m, src)
+}
+func (m *AssetsLockedRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetsLockedRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsLockedRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsLockedRecord proto.InternalMessageInfo
+
+func (m *AssetsLockedRecord) GetEvent() AssetsLockedEvent {
+	if m != nil {
+		return m.Event
+	}
+	return AssetsLockedEvent{}
+}
+
+func (m *AssetsLockedRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AssetsLockedRecord) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *AssetsLockedRecord) GetSourceChain() uint32 {
+	if m != nil {
+		return m.SourceChain
+	}
+	return 0
+}
+
 // AssetsUnlockedEvent represents the event where inbound assets are released
 // from the bridge.
 type AssetsUnlockedEvent struct {
@@ -155,20 +370,24 @@ type AssetsUnlockedEvent struct {
 	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	// sender is the address bridging out.
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	// amount of assets unlocked, in token-specific precision.
+	// amount of assets unlocked, in token-specific precision. It is the net
+	// amount, after deducting the bridge-out fee.
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// chain is the target chain for this unlock event.
 	Chain uint32 `protobuf:"varint,6,opt,name=chain,proto3" json:"chain,omitempty"`
 	// block_time is the UNIX timestamp of the block at which the event was
 	// emitted. It is in seconds.
 	BlockTime uint32 `protobuf:"varint,7,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// fee is the bridge-out fee deducted from the bridged-out amount and sent
+	// to the bridge-out fee treasury on Mezo, in token-specific precision.
+	Fee cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
 }
 
 func (m *AssetsUnlockedEvent) Reset()         { *m = AssetsUnlockedEvent{} }
 func (m *AssetsUnlockedEvent) String() string { return proto.CompactTextString(m) }
 func (*AssetsUnlockedEvent) ProtoMessage()    {}
 func (*AssetsUnlockedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7905948c23f4425c, []int{2}
+	return fileDescriptor_7905948c23f4425c, []int{3}
 }
 func (m *AssetsUnlockedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,13 +473,17 @@ type TripartyBridgeRequest struct {
 	// controller is the hex-encoded EVM address of the triparty controller that
 	// submitted the request.
 	Controller string `protobuf:"bytes,6,opt,name=controller,proto3" json:"controller,omitempty"`
+	// cancelled indicates whether the request was cancelled before maturing.
+	// A cancelled request is kept until the processing reaches its sequence
+	// number, so pending requests keep forming a gapless range.
+	Cancelled bool `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
 func (m *TripartyBridgeRequest) Reset()         { *m = TripartyBridgeRequest{} }
 func (m *TripartyBridgeRequest) String() string { return proto.CompactTextString(m) }
 func (*TripartyBridgeRequest) ProtoMessage()    {}
 func (*TripartyBridgeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7905948c23f4425c, []int{3}
+	return fileDescriptor_7905948c23f4425c, []int{4}
 }
 func (m *TripartyBridgeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,6 +540,252 @@ func (m *TripartyBridgeRequest) GetController() string {
 	return ""
 }
 
+func (m *TripartyBridgeRequest) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// TripartyBridgeRequestOutcome records what happened to a triparty bridge
+// request once it was processed and removed from the pending requests. If
+// a failed callback is later delivered by a retry, the outcome is updated to
+// the processed status with the reason cleared and the gas used by the retry.
+type TripartyBridgeRequestOutcome struct {
+	// sequence is the sequence number of the processed request.
+	Sequence cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=sequence,proto3,customtype=cosmossdk.io/math.Int" json:"sequence"`
+	// controller is the hex-encoded EVM address of the triparty controller that
+	// submitted the request.
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// status is the outcome of processing the request.
+	Status TripartyBridgeRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=mezo.bridge.v1.TripartyBridgeRequestStatus" json:"status,omitempty"`
+	// reason is the validation error of a skipped request or the error of a
+	// failed callback. Empty for successfully processed and cancelled requests.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// processing_height is the height of the Mezo block that processed the
+	// request.
+	ProcessingHeight int64 `protobuf:"varint,5,opt,name=processing_height,json=processingHeight,proto3" json:"processing_height,omitempty"`
+	// gas_used is the gas used by the controller callback. Zero for skipped
+	// requests.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TripartyBridgeRequestOutcome) Reset()         { *m = TripartyBridgeRequestOutcome{} }
+func (m *TripartyBridgeRequestOutcome) String() string { return proto.CompactTextString(m) }
+func (*TripartyBridgeRequestOutcome) ProtoMessage()    {}
+func (*TripartyBridgeRequestOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{5}
+}
+func (m *TripartyBridgeRequestOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripartyBridgeRequestOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripartyBridgeRequestOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripartyBridgeRequestOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripartyBridgeRequestOutcome.Merge(m, src)
+}
+func (m *TripartyBridgeRequestOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripartyBridgeRequestOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripartyBridgeRequestOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripartyBridgeRequestOutcome proto.InternalMessageInfo
+
+func (m *TripartyBridgeRequestOutcome) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *TripartyBridgeRequestOutcome) GetStatus() TripartyBridgeRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (m *TripartyBridgeRequestOutcome) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TripartyBridgeRequestOutcome) GetProcessingHeight() int64 {
+	if m != nil {
+		return m.ProcessingHeight
+	}
+	return 0
+}
+
+func (m *TripartyBridgeRequestOutcome) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// TripartyCallbackRetryPolicy defines how failed triparty controller
+// callbacks are retried.
+type TripartyCallbackRetryPolicy struct {
+	// max_attempts is the maximum number of automatic retries of a failed
+	// callback. Zero disables automatic retries; failed callbacks can still be
+	// retried with retryTripartyCallback.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// interval_blocks is the number of blocks between consecutive delivery
+	// attempts of a failed callback.
+	IntervalBlocks uint64 `protobuf:"varint,2,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// gas_limit is the gas limit of a retried callback.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *TripartyCallbackRetryPolicy) Reset()         { *m = TripartyCallbackRetryPolicy{} }
+func (m *TripartyCallbackRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*TripartyCallbackRetryPolicy) ProtoMessage()    {}
+func (*TripartyCallbackRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{6}
+}
+func (m *TripartyCallbackRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripartyCallbackRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripartyCallbackRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TripartyCallbackRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripartyCallbackRetryPolicy.Merge(m, src)
+}
+func (m *TripartyCallbackRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripartyCallbackRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripartyCallbackRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripartyCallbackRetryPolicy proto.InternalMessageInfo
+
+func (m *TripartyCallbackRetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TripartyCallbackRetryPolicy) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *TripartyCallbackRetryPolicy) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// FailedTripartyCallback is a controller callback of a processed triparty
+// bridge request that failed and is queued for retry. A callback whose
+// retries are exhausted is kept until pruned according to the triparty
+// outcomes retention period.
+type FailedTripartyCallback struct {
+	// request is the processed triparty bridge request whose callback failed.
+	Request TripartyBridgeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	// attempts is the number of failed delivery attempts, including the
+	// initial callback issued when the request was processed.
+	Attempts uint32 `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// last_attempt_height is the height of the Mezo block of the last failed
+	// delivery attempt.
+	LastAttemptHeight int64 `protobuf:"varint,3,opt,name=last_attempt_height,json=lastAttemptHeight,proto3" json:"last_attempt_height,omitempty"`
+	// next_retry_height is the height of the Mezo block from which the
+	// callback is retried automatically. Zero if automatic retries are
+	// exhausted.
+	NextRetryHeight int64 `protobuf:"varint,4,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+}
+
+func (m *FailedTripartyCallback) Reset()         { *m = FailedTripartyCallback{} }
+func (m *FailedTripartyCallback) String() string { return proto.CompactTextString(m) }
+func (*FailedTripartyCallback) ProtoMessage()    {}
+func (*FailedTripartyCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{7}
+}
+func (m *FailedTripartyCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedTripartyCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedTripartyCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedTripartyCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTripartyCallback.Merge(m, src)
+}
+func (m *FailedTripartyCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedTripartyCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTripartyCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTripartyCallback proto.InternalMessageInfo
+
+func (m *FailedTripartyCallback) GetRequest() TripartyBridgeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return TripartyBridgeRequest{}
+}
+
+func (m *FailedTripartyCallback) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedTripartyCallback) GetLastAttemptHeight() int64 {
+	if m != nil {
+		return m.LastAttemptHeight
+	}
+	return 0
+}
+
+func (m *FailedTripartyCallback) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
 // ERC20TokenMapping defines a mapping between an ERC20 token on the source
 // chain and on the Mezo chain.
 type ERC20TokenMapping struct {
@@ -325,13 +794,18 @@ type ERC20TokenMapping struct {
 	SourceToken string `protobuf:"bytes,1,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"`
 	// mezo_token is the hex-encoded EVM address of the token on the Mezo chain.
 	MezoToken string `protobuf:"bytes,2,opt,name=mezo_token,json=mezoToken,proto3" json:"mezo_token,omitempty"`
+	// state is the lifecycle state of the mapping.
+	State ERC20TokenMappingState `protobuf:"varint,3,opt,name=state,proto3,enum=mezo.bridge.v1.ERC20TokenMappingState" json:"state,omitempty"`
+	// activation_height is the Mezo block height at which a mapping pending
+	// activation becomes active. It is zero for mappings in other states.
+	ActivationHeight uint64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *ERC20TokenMapping) Reset()         { *m = ERC20TokenMapping{} }
 func (m *ERC20TokenMapping) String() string { return proto.CompactTextString(m) }
 func (*ERC20TokenMapping) ProtoMessage()    {}
 func (*ERC20TokenMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7905948c23f4425c, []int{4}
+	return fileDescriptor_7905948c23f4425c, []int{8}
 }
 func (m *ERC20TokenMapping) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,461 +848,4028 @@ func (m *ERC20TokenMapping) GetMezoToken() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "mezo.bridge.v1.Params")
-	proto.RegisterType((*AssetsLockedEvent)(nil), "mezo.bridge.v1.AssetsLockedEvent")
-	proto.RegisterType((*AssetsUnlockedEvent)(nil), "mezo.bridge.v1.AssetsUnlockedEvent")
-	proto.RegisterType((*TripartyBridgeRequest)(nil), "mezo.bridge.v1.TripartyBridgeRequest")
-	proto.RegisterType((*ERC20TokenMapping)(nil), "mezo.bridge.v1.ERC20TokenMapping")
+func (m *ERC20TokenMapping) GetState() ERC20TokenMappingState {
+	if m != nil {
+		return m.State
+	}
+	return ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_ACTIVE
 }
 
-func init() { proto.RegisterFile("mezo/bridge/v1/bridge.proto", fileDescriptor_7905948c23f4425c) }
+func (m *ERC20TokenMapping) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
 
-var fileDescriptor_7905948c23f4425c = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x6f, 0xd3, 0x3e,
-	0x1c, 0x6d, 0xd6, 0xad, 0xff, 0xd5, 0xeb, 0xf6, 0xd7, 0xc2, 0x36, 0x65, 0xb0, 0xa5, 0xa3, 0x5c,
-	0x7a, 0xa1, 0x61, 0x20, 0x0e, 0xe3, 0x82, 0x28, 0x14, 0x81, 0x04, 0x12, 0xca, 0xba, 0x0b, 0x97,
-	0xc8, 0x71, 0x7e, 0x4a, 0xad, 0x26, 0x76, 0xb0, 0x9d, 0xaa, 0xe5, 0x23, 0x70, 0x81, 0x0b, 0x12,
-	0x47, 0xbe, 0x06, 0xdf, 0x60, 0xc7, 0x1d, 0x11, 0x87, 0x09, 0xb5, 0x5f, 0x04, 0xc5, 0x4e, 0x47,
-	0x39, 0x4c, 0xa2, 0xe2, 0x66, 0xbf, 0xf7, 0x9a, 0xbe, 0xf7, 0xec, 0x9f, 0xd1, 0xad, 0x14, 0xde,
-	0x73, 0x2f, 0x14, 0x34, 0x8a, 0xc1, 0x1b, 0x1d, 0x97, 0xab, 0x4e, 0x26, 0xb8, 0xe2, 0xf6, 0x56,
-	0x41, 0x76, 0x4a, 0x68, 0x74, 0x7c, 0x73, 0x27, 0xe6, 0x31, 0xd7, 0x94, 0x57, 0xac, 0x8c, 0xaa,
-	0xf5, 0xc1, 0x42, 0xb5, 0x37, 0x58, 0xe0, 0x54, 0xda, 0x27, 0x68, 0x3f, 0xc5, 0xe3, 0x00, 0x04,
-	0xb9, 0x7f, 0x2f, 0x50, 0x7c, 0x08, 0x4c, 0x06, 0x29, 0xce, 0x32, 0xca, 0x62, 0xe9, 0x58, 0x47,
-	0x56, 0x7b, 0xd3, 0xdf, 0x4b, 0xf1, 0xb8, 0x57, 0xf0, 0x7d, 0x4d, 0xbf, 0x2e, 0x59, 0xfb, 0x31,
-	0x3a, 0x08, 0x15, 0x09, 0x64, 0x9e, 0x65, 0xc9, 0x24, 0xc0, 0x52, 0x82, 0x50, 0x94, 0xb3, 0x00,
-	0x18, 0x0e, 0x13, 0x88, 0x9c, 0x95, 0x23, 0xab, 0xbd, 0xee, 0xef, 0x87, 0x8a, 0x9c, 0x6a, 0xc9,
-	0x93, 0xb9, 0xa2, 0x67, 0x04, 0x8f, 0x56, 0xbf, 0x7c, 0x6d, 0x56, 0x5a, 0xdf, 0x2c, 0xb4, 0x5d,
-	0x50, 0x4a, 0xbe, 0xe2, 0x64, 0x08, 0x51, 0x6f, 0x04, 0x4c, 0xd9, 0x27, 0x68, 0x5d, 0xc2, 0xbb,
-	0x1c, 0x18, 0x01, 0x6d, 0xa3, 0xde, 0x3d, 0x3c, 0xbf, 0x6c, 0x56, 0x7e, 0x5c, 0x36, 0x77, 0x09,
-	0x97, 0x29, 0x97, 0x32, 0x1a, 0x76, 0x28, 0xf7, 0x52, 0xac, 0x06, 0x9d, 0x97, 0x4c, 0xf9, 0x57,
-	0x72, 0xfb, 0x00, 0xd5, 0x05, 0x10, 0x9a, 0x51, 0x60, 0x4a, 0x9b, 0xa8, 0xfb, 0xbf, 0x01, 0xfb,
-	0x21, 0xaa, 0xe1, 0x94, 0xe7, 0x4c, 0x39, 0xd5, 0xbf, 0xf9, 0x6c, 0x29, 0xb6, 0x77, 0xd0, 0x9a,
-	0x6e, 0xc7, 0x59, 0xd5, 0x1f, 0x34, 0x9b, 0xd6, 0xe7, 0x15, 0x74, 0xc3, 0x78, 0x3f, 0x63, 0xc9,
-	0x82, 0xfb, 0xe7, 0xe8, 0xff, 0x5c, 0x03, 0xc1, 0x72, 0x21, 0xb6, 0xcc, 0xaf, 0x4e, 0xaf, 0x8d,
-	0xd2, 0x58, 0x8c, 0x72, 0xe5, 0xa9, 0xba, 0xe0, 0xc9, 0xde, 0x43, 0x35, 0x09, 0x2c, 0x02, 0x51,
-	0x5a, 0x2d, 0x77, 0x0b, 0xc1, 0xd7, 0x96, 0x0c, 0x4e, 0x06, 0x98, 0x32, 0xa7, 0xa6, 0x2f, 0x83,
-	0xd9, 0xd8, 0x87, 0x08, 0x85, 0x3a, 0x9f, 0xa2, 0x29, 0x38, 0xff, 0x69, 0xaa, 0xae, 0x91, 0x3e,
-	0x4d, 0xa1, 0xf5, 0x71, 0x05, 0xed, 0xf6, 0x05, 0xcd, 0xb0, 0x50, 0x93, 0xae, 0xbe, 0x8c, 0x7e,
-	0x11, 0x49, 0xfe, 0xd3, 0xb9, 0xde, 0x46, 0x0d, 0xf3, 0x9f, 0x03, 0xa0, 0xf1, 0xc0, 0xf4, 0x51,
-	0xf5, 0x37, 0x34, 0xf6, 0x42, 0x43, 0x7f, 0xf6, 0x55, 0xbd, 0xfe, 0xe8, 0x57, 0x97, 0x69, 0xe0,
-	0x0e, 0xda, 0x24, 0x38, 0x49, 0x42, 0x4c, 0x86, 0x41, 0x84, 0x15, 0xd6, 0xfd, 0x35, 0xfc, 0xc6,
-	0x1c, 0x7c, 0x86, 0x15, 0xb6, 0x5d, 0x84, 0x08, 0x67, 0x4a, 0xf0, 0x24, 0x01, 0xa1, 0xbb, 0xaa,
-	0xfb, 0x0b, 0x48, 0xeb, 0x0c, 0x6d, 0xf7, 0xfc, 0xa7, 0xe5, 0x0c, 0x95, 0x23, 0x54, 0x24, 0x92,
-	0x3c, 0x17, 0x04, 0xcc, 0xe4, 0x99, 0x42, 0xfc, 0x0d, 0x83, 0x69, 0x65, 0x51, 0x74, 0x31, 0xd2,
-	0xa5, 0xa0, 0xbc, 0xcd, 0x05, 0xa2, 0xe9, 0x6e, 0xf7, 0x7c, 0xea, 0x5a, 0x17, 0x53, 0xd7, 0xfa,
-	0x39, 0x75, 0xad, 0x4f, 0x33, 0xb7, 0x72, 0x31, 0x73, 0x2b, 0xdf, 0x67, 0x6e, 0xe5, 0x6d, 0x3b,
-	0xa6, 0x6a, 0x90, 0x87, 0x1d, 0xc2, 0x53, 0xaf, 0xd0, 0xdf, 0xe5, 0x22, 0xd6, 0x8b, 0xc8, 0x1b,
-	0xcf, 0x5f, 0x0f, 0x35, 0xc9, 0x40, 0x86, 0x35, 0xfd, 0x28, 0x3c, 0xf8, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xed, 0x90, 0x92, 0xc1, 0x59, 0x04, 0x00, 0x00,
+// SourceChain defines an additional bridge-in source chain, i.e. a chain other
+// than Ethereum running its own deployment of the MezoBridge contract. Each
+// source chain has its own AssetsLocked sequence and ERC20 token mappings.
+type SourceChain struct {
+	// id is the identifier of the source chain. Zero is reserved for Ethereum,
+	// the primary source chain.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human-readable name of the source chain.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// source_btc_token is the hex-encoded EVM address of the BTC token on the
+	// source chain. AssetsLocked events carrying this token address are directly
+	// mapped to the Mezo native denomination - BTC.
+	SourceBtcToken string `protobuf:"bytes,3,opt,name=source_btc_token,json=sourceBtcToken,proto3" json:"source_btc_token,omitempty"`
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SourceChain) Reset()         { *m = SourceChain{} }
+func (m *SourceChain) String() string { return proto.CompactTextString(m) }
+func (*SourceChain) ProtoMessage()    {}
+func (*SourceChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{9}
+}
+func (m *SourceChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SourceChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChain.Merge(m, src)
+}
+func (m *SourceChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChain.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcSupplyAssertionEnabled {
-		i--
-		if m.BtcSupplyAssertionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_SourceChain proto.InternalMessageInfo
+
+func (m *SourceChain) GetId() uint32 {
+	if m != nil {
+		return m.Id
 	}
-	if m.MaxErc20TokensMappings != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.MaxErc20TokensMappings))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SourceChain) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AssetsLockedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SourceChain) GetSourceBtcToken() string {
+	if m != nil {
+		return m.SourceBtcToken
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AssetsLockedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutflowWindow defines a rolling outflow window. The window is split into
+// equally sized buckets; each bucket accumulates the outflows of the blocks
+// it covers and leaves the window once it is older than window_blocks. A zero
+// window means the outflow is tracked in fixed-interval periods instead.
+type OutflowWindow struct {
+	// window_blocks is the length of the window, in blocks. It must be
+	// a multiple of buckets.
+	WindowBlocks uint64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// buckets is the number of buckets the window is split into.
+	Buckets uint32 `protobuf:"varint,2,opt,name=buckets,proto3" json:"buckets,omitempty"`
 }
 
-func (m *AssetsLockedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OutflowWindow) Reset()         { *m = OutflowWindow{} }
+func (m *OutflowWindow) String() string { return proto.CompactTextString(m) }
+func (*OutflowWindow) ProtoMessage()    {}
+func (*OutflowWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{10}
+}
+func (m *OutflowWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.Sequence.Size()
-		i -= size
-		if _, err := m.Sequence.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+}
+func (m *OutflowWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowWindow.Merge(m, src)
+}
+func (m *OutflowWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowWindow proto.InternalMessageInfo
+
+func (m *OutflowWindow) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AssetsUnlockedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OutflowWindow) GetBuckets() uint32 {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AssetsUnlockedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OutflowPriceFeed defines the oracle price feed used to value the outflows
+// of a Mezo token against the USD outflow limit.
+type OutflowPriceFeed struct {
+	// token is the hex-encoded EVM address of the token on Mezo.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// currency_pair is the oracle currency pair quoting the token in USD,
+	// e.g. BTC/USD.
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// decimals is the number of decimals of the token on Mezo.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
-func (m *AssetsUnlockedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTime != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.BlockTime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Chain != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.Chain))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OutflowPriceFeed) Reset()         { *m = OutflowPriceFeed{} }
+func (m *OutflowPriceFeed) String() string { return proto.CompactTextString(m) }
+func (*OutflowPriceFeed) ProtoMessage()    {}
+func (*OutflowPriceFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{11}
+}
+func (m *OutflowPriceFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowPriceFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowPriceFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *OutflowPriceFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowPriceFeed.Merge(m, src)
+}
+func (m *OutflowPriceFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowPriceFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowPriceFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowPriceFeed proto.InternalMessageInfo
+
+func (m *OutflowPriceFeed) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *OutflowPriceFeed) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *OutflowPriceFeed) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
 	}
-	{
-		size := m.UnlockSequence.Size()
-		i -= size
-		if _, err := m.UnlockSequence.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// SenderOutflowParams defines the per-sender bridge-out limits. The limits
+// are tracked in fixed windows of window_blocks blocks, starting at heights
+// that are multiples of window_blocks. A zero window disables the per-sender
+// limits.
+type SenderOutflowParams struct {
+	// window_blocks is the length of the per-sender window, in blocks.
+	WindowBlocks uint64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// max_count is the maximum number of bridge-outs a single sender can
+	// make in a window. Zero means the count is not limited.
+	MaxCount uint32 `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+}
+
+func (m *SenderOutflowParams) Reset()         { *m = SenderOutflowParams{} }
+func (m *SenderOutflowParams) String() string { return proto.CompactTextString(m) }
+func (*SenderOutflowParams) ProtoMessage()    {}
+func (*SenderOutflowParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{12}
+}
+func (m *SenderOutflowParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderOutflowParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderOutflowParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SenderOutflowParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderOutflowParams.Merge(m, src)
+}
+func (m *SenderOutflowParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderOutflowParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderOutflowParams.DiscardUnknown(m)
 }
 
-func (m *TripartyBridgeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SenderOutflowParams proto.InternalMessageInfo
+
+func (m *SenderOutflowParams) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TripartyBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SenderOutflowParams) GetMaxCount() uint32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
 }
 
-func (m *TripartyBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CallbackData) > 0 {
-		i -= len(m.CallbackData)
-		copy(dAtA[i:], m.CallbackData)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.CallbackData)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// SenderOutflow tracks the bridge-outs of a single sender in the window
+// the sender bridged out last. A record of a past window is stale and
+// counts as empty.
+type SenderOutflow struct {
+	// sender is the sender's hex-encoded EVM address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// window_start is the height of the first block of the tracked window.
+	WindowStart uint64 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// count is the number of bridge-outs made in the tracked window.
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// amounts are the amounts bridged out in the tracked window, per token.
+	Amounts []SenderTokenOutflow `protobuf:"bytes,4,rep,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *SenderOutflow) Reset()         { *m = SenderOutflow{} }
+func (m *SenderOutflow) String() string { return proto.CompactTextString(m) }
+func (*SenderOutflow) ProtoMessage()    {}
+func (*SenderOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{13}
+}
+func (m *SenderOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *SenderOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderOutflow.Merge(m, src)
+}
+func (m *SenderOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderOutflow proto.InternalMessageInfo
+
+func (m *SenderOutflow) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintBridge(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *SenderOutflow) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
 	}
-	{
-		size := m.Sequence.Size()
-		i -= size
-		if _, err := m.Sequence.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintBridge(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SenderOutflow) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ERC20TokenMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SenderOutflow) GetAmounts() []SenderTokenOutflow {
+	if m != nil {
+		return m.Amounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20TokenMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SenderTokenOutflow tracks the amount of a single token bridged out by
+// a sender in a window.
+type SenderTokenOutflow struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// amount is the amount of the token bridged out in the window.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *ERC20TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MezoToken) > 0 {
-		i -= len(m.MezoToken)
-		copy(dAtA[i:], m.MezoToken)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.MezoToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SourceToken) > 0 {
-		i -= len(m.SourceToken)
-		copy(dAtA[i:], m.SourceToken)
-		i = encodeVarintBridge(dAtA, i, uint64(len(m.SourceToken)))
-		i--
-		dAtA[i] = 0xa
+func (m *SenderTokenOutflow) Reset()         { *m = SenderTokenOutflow{} }
+func (m *SenderTokenOutflow) String() string { return proto.CompactTextString(m) }
+func (*SenderTokenOutflow) ProtoMessage()    {}
+func (*SenderTokenOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{14}
+}
+func (m *SenderTokenOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderTokenOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderTokenOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SenderTokenOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderTokenOutflow.Merge(m, src)
+}
+func (m *SenderTokenOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderTokenOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderTokenOutflow.DiscardUnknown(m)
 }
 
-func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBridge(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_SenderTokenOutflow proto.InternalMessageInfo
+
+func (m *SenderTokenOutflow) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxErc20TokensMappings != 0 {
-		n += 1 + sovBridge(uint64(m.MaxErc20TokensMappings))
-	}
-	if m.BtcSupplyAssertionEnabled {
-		n += 2
-	}
-	return n
+
+// DelayedBridgeOut is a bridge-out held in the delayed bridge-out queue.
+// The assets are burnt from the sender when the bridge-out is queued. The
+// bridge-out becomes an AssetsUnlocked event once release_height is reached,
+// unless it is cancelled before, in which case the assets are minted back to
+// the sender.
+type DelayedBridgeOut struct {
+	// id is the monotonically increasing identifier assigned when the
+	// bridge-out is queued.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the account address to receive the unlocked assets on the
+	// target chain.
+	Recipient []byte `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// token is the hex-encoded EVM address of the bridged-out token on Mezo.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// target_token is the hex-encoded EVM address of the token on the target
+	// chain.
+	TargetToken string `protobuf:"bytes,4,opt,name=target_token,json=targetToken,proto3" json:"target_token,omitempty"`
+	// sender is the hex-encoded EVM address of the account bridging out the
+	// assets.
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount of assets bridged out, in token-specific precision. It is the net
+	// amount, after deducting the bridge-out fee.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// chain is the identifier of the target chain.
+	Chain uint32 `protobuf:"varint,7,opt,name=chain,proto3" json:"chain,omitempty"`
+	// release_height is the block height from which the bridge-out can be
+	// released as an AssetsUnlocked event. A mature bridge-out held in the
+	// queue is rescheduled to the next block, moving its release height.
+	ReleaseHeight uint64 `protobuf:"varint,8,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	// fee is the bridge-out fee, in token-specific precision. It is collected
+	// when the bridge-out is released and refunded along with the amount if
+	// the bridge-out is cancelled.
+	Fee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+	// queued_height is the block height at which the bridge-out was queued and
+	// consumed the outflow limits.
+	QueuedHeight uint64 `protobuf:"varint,10,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty"`
+	// usd_value is the value of the amount added to the USD outflow when the
+	// bridge-out was queued, with 18 decimals. It is zero if the bridge-out was
+	// not covered by the USD outflow limit.
+	UsdValue cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=usd_value,json=usdValue,proto3,customtype=cosmossdk.io/math.Int" json:"usd_value"`
 }
 
-func (m *AssetsLockedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelayedBridgeOut) Reset()         { *m = DelayedBridgeOut{} }
+func (m *DelayedBridgeOut) String() string { return proto.CompactTextString(m) }
+func (*DelayedBridgeOut) ProtoMessage()    {}
+func (*DelayedBridgeOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{15}
+}
+func (m *DelayedBridgeOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedBridgeOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedBridgeOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Sequence.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+}
+func (m *DelayedBridgeOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedBridgeOut.Merge(m, src)
+}
+func (m *DelayedBridgeOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedBridgeOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedBridgeOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedBridgeOut proto.InternalMessageInfo
+
+func (m *DelayedBridgeOut) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return 0
+}
+
+func (m *DelayedBridgeOut) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
 	}
-	return n
+	return nil
 }
 
-func (m *AssetsUnlockedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelayedBridgeOut) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	var l int
-	_ = l
-	l = m.UnlockSequence.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return ""
+}
+
+func (m *DelayedBridgeOut) GetTargetToken() string {
+	if m != nil {
+		return m.TargetToken
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return ""
+}
+
+func (m *DelayedBridgeOut) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return ""
+}
+
+func (m *DelayedBridgeOut) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	if m.Chain != 0 {
-		n += 1 + sovBridge(uint64(m.Chain))
+	return 0
+}
+
+func (m *DelayedBridgeOut) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
 	}
-	if m.BlockTime != 0 {
-		n += 1 + sovBridge(uint64(m.BlockTime))
+	return 0
+}
+
+func (m *DelayedBridgeOut) GetQueuedHeight() uint64 {
+	if m != nil {
+		return m.QueuedHeight
 	}
-	return n
+	return 0
 }
 
-func (m *TripartyBridgeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// BridgeOutFee defines the fee charged for bridging out a specific token to
+// a specific target chain. The fee is flat + amount * basis_points / 10000.
+type BridgeOutFee struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// chain is the identifier of the target chain.
+	Chain uint32 `protobuf:"varint,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// flat is the flat part of the fee, in token-specific precision.
+	Flat cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=flat,proto3,customtype=cosmossdk.io/math.Int" json:"flat"`
+	// basis_points is the part of the fee proportional to the bridged-out
+	// amount, in basis points.
+	BasisPoints uint32 `protobuf:"varint,4,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *BridgeOutFee) Reset()         { *m = BridgeOutFee{} }
+func (m *BridgeOutFee) String() string { return proto.CompactTextString(m) }
+func (*BridgeOutFee) ProtoMessage()    {}
+func (*BridgeOutFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7905948c23f4425c, []int{16}
+}
+func (m *BridgeOutFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeOutFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeOutFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Sequence.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovBridge(uint64(m.BlockHeight))
+}
+func (m *BridgeOutFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeOutFee.Merge(m, src)
+}
+func (m *BridgeOutFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeOutFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeOutFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeOutFee proto.InternalMessageInfo
+
+func (m *BridgeOutFee) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return ""
+}
+
+func (m *BridgeOutFee) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovBridge(uint64(l))
-	l = len(m.CallbackData)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	return 0
+}
+
+func (m *BridgeOutFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
 	}
-	l = len(m.Controller)
-	if l > 0 {
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("mezo.bridge.v1.TripartyBridgeRequestStatus", TripartyBridgeRequestStatus_name, TripartyBridgeRequestStatus_value)
+	proto.RegisterEnum("mezo.bridge.v1.ERC20TokenMappingState", ERC20TokenMappingState_name, ERC20TokenMappingState_value)
+	proto.RegisterType((*Params)(nil), "mezo.bridge.v1.Params")
+	proto.RegisterType((*AssetsLockedEvent)(nil), "mezo.bridge.v1.AssetsLockedEvent")
+	proto.RegisterType((*AssetsLockedRecord)(nil), "mezo.bridge.v1.AssetsLockedRecord")
+	proto.RegisterType((*AssetsUnlockedEvent)(nil), "mezo.bridge.v1.AssetsUnlockedEvent")
+	proto.RegisterType((*TripartyBridgeRequest)(nil), "mezo.bridge.v1.TripartyBridgeRequest")
+	proto.RegisterType((*TripartyBridgeRequestOutcome)(nil), "mezo.bridge.v1.TripartyBridgeRequestOutcome")
+	proto.RegisterType((*TripartyCallbackRetryPolicy)(nil), "mezo.bridge.v1.TripartyCallbackRetryPolicy")
+	proto.RegisterType((*FailedTripartyCallback)(nil), "mezo.bridge.v1.FailedTripartyCallback")
+	proto.RegisterType((*ERC20TokenMapping)(nil), "mezo.bridge.v1.ERC20TokenMapping")
+	proto.RegisterType((*SourceChain)(nil), "mezo.bridge.v1.SourceChain")
+	proto.RegisterType((*OutflowWindow)(nil), "mezo.bridge.v1.OutflowWindow")
+	proto.RegisterType((*OutflowPriceFeed)(nil), "mezo.bridge.v1.OutflowPriceFeed")
+	proto.RegisterType((*SenderOutflowParams)(nil), "mezo.bridge.v1.SenderOutflowParams")
+	proto.RegisterType((*SenderOutflow)(nil), "mezo.bridge.v1.SenderOutflow")
+	proto.RegisterType((*SenderTokenOutflow)(nil), "mezo.bridge.v1.SenderTokenOutflow")
+	proto.RegisterType((*DelayedBridgeOut)(nil), "mezo.bridge.v1.DelayedBridgeOut")
+	proto.RegisterType((*BridgeOutFee)(nil), "mezo.bridge.v1.BridgeOutFee")
+}
+
+func init() { proto.RegisterFile("mezo/bridge/v1/bridge.proto", fileDescriptor_7905948c23f4425c) }
+
+var fileDescriptor_7905948c23f4425c = []byte{
+	// 1623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x6f, 0x23, 0xc7,
+	0x11, 0xd6, 0xf0, 0x21, 0x8a, 0x45, 0x52, 0x4b, 0xf5, 0xda, 0x0b, 0xae, 0xb5, 0xe6, 0x4a, 0x13,
+	0xaf, 0x43, 0x68, 0x1d, 0xd2, 0xab, 0x20, 0x07, 0x1b, 0x09, 0x02, 0x3e, 0x46, 0x0e, 0xb1, 0x32,
+	0x35, 0x19, 0x52, 0x36, 0x92, 0x1c, 0x06, 0xcd, 0x99, 0x5e, 0x6a, 0xa0, 0x79, 0xd0, 0xd3, 0x3d,
+	0x5a, 0x31, 0x3f, 0x20, 0x40, 0x6e, 0xbe, 0x25, 0xa7, 0x20, 0x01, 0x72, 0xcd, 0x29, 0xa7, 0xdc,
+	0x72, 0x0a, 0x7c, 0xf4, 0x2d, 0x41, 0x0e, 0x46, 0xb0, 0x7b, 0xcf, 0x6f, 0x08, 0xfa, 0x31, 0x24,
+	0xa5, 0x15, 0x23, 0xca, 0xbe, 0x4d, 0x57, 0x7d, 0xdd, 0xfc, 0xea, 0xab, 0xea, 0xaa, 0x26, 0xec,
+	0x06, 0xe4, 0xd7, 0x51, 0x6b, 0x1c, 0x7b, 0xee, 0x84, 0xb4, 0x2e, 0x9e, 0xa9, 0xaf, 0xe6, 0x34,
+	0x8e, 0x58, 0x84, 0xb6, 0xb9, 0xb3, 0xa9, 0x4c, 0x17, 0xcf, 0xde, 0x79, 0x6b, 0x12, 0x4d, 0x22,
+	0xe1, 0x6a, 0xf1, 0x2f, 0x89, 0xd2, 0xff, 0x9b, 0x81, 0x4d, 0x13, 0xc7, 0x38, 0xa0, 0xe8, 0x23,
+	0x78, 0x18, 0xe0, 0x4b, 0x9b, 0xc4, 0xce, 0xe1, 0x87, 0x36, 0x8b, 0xce, 0x49, 0x48, 0xed, 0x00,
+	0x4f, 0xa7, 0x5e, 0x38, 0xa1, 0x35, 0x6d, 0x4f, 0x6b, 0x54, 0xac, 0x07, 0x01, 0xbe, 0x34, 0xb8,
+	0x7f, 0x24, 0xdc, 0x9f, 0x2a, 0x2f, 0xfa, 0x29, 0x3c, 0x1a, 0x33, 0xc7, 0xa6, 0xc9, 0x74, 0xea,
+	0xcf, 0x6c, 0x4c, 0x29, 0x89, 0x99, 0x17, 0x85, 0x36, 0x09, 0xf1, 0xd8, 0x27, 0x6e, 0x2d, 0xb3,
+	0xa7, 0x35, 0xb6, 0xac, 0x87, 0x63, 0xe6, 0x0c, 0x05, 0xa4, 0x9d, 0x22, 0x0c, 0x09, 0x40, 0x26,
+	0x3c, 0xe1, 0xbb, 0x18, 0xb5, 0xfd, 0xc8, 0x39, 0x27, 0xae, 0x4d, 0x2e, 0x48, 0xc8, 0xa8, 0x1d,
+	0x13, 0x46, 0x42, 0x71, 0xd4, 0x98, 0x3b, 0x68, 0x2d, 0xbb, 0xa7, 0x35, 0x72, 0xd6, 0xbe, 0x04,
+	0x1f, 0x0b, 0xac, 0x21, 0xa0, 0x56, 0x8a, 0xec, 0x08, 0x20, 0xea, 0x42, 0x5d, 0x46, 0xb2, 0x92,
+	0x54, 0x4e, 0x90, 0xda, 0x15, 0xa8, 0x15, 0xb4, 0x9e, 0x83, 0xce, 0x62, 0x6f, 0x8a, 0x63, 0x36,
+	0xb3, 0xa3, 0x84, 0x39, 0x51, 0x40, 0x6e, 0xe0, 0x94, 0x17, 0x9c, 0x1e, 0xa7, 0xc8, 0x13, 0x05,
+	0xbc, 0xc6, 0xe8, 0xe3, 0xdc, 0xef, 0xff, 0xf8, 0x78, 0x43, 0xff, 0x9b, 0x06, 0x3b, 0xed, 0xeb,
+	0xec, 0xd1, 0x47, 0xb0, 0x45, 0xc9, 0x17, 0x09, 0x09, 0x1d, 0x22, 0xa4, 0x2e, 0x76, 0xde, 0xfd,
+	0xea, 0x9b, 0xc7, 0x1b, 0xff, 0xfe, 0xe6, 0xf1, 0xdb, 0x4e, 0x44, 0x83, 0x88, 0x52, 0xf7, 0xbc,
+	0xe9, 0x45, 0xad, 0x00, 0xb3, 0xb3, 0x66, 0x3f, 0x64, 0xd6, 0x1c, 0x8e, 0x1e, 0x41, 0x31, 0x26,
+	0x8e, 0x37, 0xf5, 0x48, 0xc8, 0x84, 0xd0, 0x45, 0x6b, 0x61, 0x40, 0x3f, 0x82, 0x4d, 0x1c, 0x44,
+	0x49, 0xc8, 0x6a, 0xd9, 0x75, 0x8e, 0x55, 0x60, 0xf4, 0x16, 0xe4, 0x45, 0x05, 0x08, 0x91, 0x8a,
+	0x96, 0x5c, 0xe8, 0x7f, 0xd5, 0x00, 0x2d, 0x73, 0xb7, 0x88, 0x13, 0xc5, 0x2e, 0xfa, 0x09, 0xe4,
+	0x45, 0xba, 0x04, 0xf3, 0xd2, 0xe1, 0x7e, 0xf3, 0x6a, 0xe5, 0x35, 0xdf, 0x08, 0xb7, 0x93, 0xe3,
+	0x2c, 0x2c, 0xb9, 0x0b, 0xed, 0x43, 0x59, 0x08, 0x69, 0x9f, 0x11, 0x6f, 0x72, 0x26, 0x63, 0xc8,
+	0x5a, 0x25, 0x61, 0xfb, 0x99, 0x30, 0xa1, 0x1a, 0x14, 0xe8, 0xb9, 0x37, 0x9d, 0x12, 0x57, 0x84,
+	0xb1, 0x65, 0xa5, 0x4b, 0xbe, 0x99, 0x46, 0x49, 0xec, 0x10, 0xdb, 0x39, 0xc3, 0x9e, 0xe4, 0x5b,
+	0xb1, 0x4a, 0xd2, 0xd6, 0xe5, 0x26, 0xfd, 0x1f, 0x19, 0xb8, 0x2f, 0x29, 0x9c, 0x86, 0xfe, 0x92,
+	0xe6, 0x47, 0x70, 0x2f, 0x11, 0x06, 0xfb, 0x6e, 0xd2, 0x6f, 0xcb, 0x5d, 0xc3, 0x95, 0x09, 0x28,
+	0x2f, 0x27, 0x60, 0xae, 0x64, 0x76, 0x49, 0x49, 0xf4, 0x00, 0x36, 0x29, 0x09, 0x5d, 0x12, 0x2b,
+	0x81, 0xd5, 0x6a, 0x29, 0x5d, 0xf9, 0x3b, 0xa6, 0x4b, 0x86, 0xbf, 0x29, 0xc2, 0x97, 0x0b, 0xf4,
+	0x2e, 0x80, 0x14, 0x96, 0x79, 0x01, 0xa9, 0x15, 0x84, 0xab, 0x28, 0x2c, 0x23, 0x2f, 0x20, 0xa8,
+	0x05, 0xd9, 0x17, 0x84, 0xd4, 0xb6, 0xd6, 0xf9, 0x21, 0x8e, 0xd4, 0xff, 0x92, 0x81, 0xb7, 0x47,
+	0xaa, 0xc8, 0x3b, 0x22, 0xbb, 0x16, 0xd7, 0x80, 0x7e, 0xa7, 0xf2, 0x5d, 0x23, 0xfb, 0x57, 0x04,
+	0xce, 0xae, 0xae, 0xf0, 0xdc, 0x5d, 0x24, 0xfb, 0x1e, 0x54, 0x1c, 0xec, 0xfb, 0x63, 0xec, 0x9c,
+	0xdb, 0x2e, 0x66, 0x58, 0x08, 0x5e, 0xb6, 0xca, 0xa9, 0xb1, 0x87, 0x19, 0x46, 0x75, 0x00, 0x27,
+	0x0a, 0x59, 0x1c, 0xf9, 0x3e, 0x89, 0x85, 0xb8, 0x45, 0x6b, 0xc9, 0xc2, 0x99, 0x39, 0x38, 0x74,
+	0x88, 0xcf, 0xfb, 0x49, 0x41, 0x54, 0xe6, 0xc2, 0xa0, 0xff, 0x21, 0x03, 0x8f, 0x6e, 0xd4, 0x4b,
+	0x75, 0x88, 0xef, 0x22, 0xdb, 0x55, 0x66, 0x99, 0x37, 0x98, 0x75, 0x61, 0x93, 0x32, 0xcc, 0x12,
+	0xd9, 0x31, 0xb7, 0x0f, 0x9f, 0x5e, 0xbf, 0x94, 0x37, 0x12, 0x1b, 0x8a, 0x2d, 0x96, 0xda, 0xca,
+	0xab, 0x34, 0x26, 0x98, 0x46, 0x69, 0x1b, 0x50, 0x2b, 0xf4, 0x14, 0x76, 0xa6, 0x71, 0xe4, 0x10,
+	0x4a, 0xbd, 0x70, 0x92, 0x26, 0x2e, 0x2f, 0x12, 0x57, 0x5d, 0x38, 0x54, 0xf6, 0x1e, 0xc2, 0xd6,
+	0x04, 0x53, 0x3b, 0xa1, 0xc4, 0x15, 0x0a, 0xe6, 0xac, 0xc2, 0x04, 0xd3, 0x53, 0x4a, 0x5c, 0xfd,
+	0x37, 0x1a, 0xec, 0xa6, 0x3c, 0xba, 0x4a, 0x77, 0x8b, 0xb0, 0x78, 0x66, 0x46, 0xbe, 0xe7, 0xcc,
+	0x78, 0x6d, 0xf0, 0x89, 0x84, 0x19, 0x23, 0xc1, 0x94, 0xa5, 0x43, 0xa8, 0x14, 0xe0, 0xcb, 0xb6,
+	0x32, 0xa1, 0xef, 0xc3, 0x3d, 0x2f, 0x64, 0x24, 0xbe, 0xc0, 0x7e, 0xda, 0x8e, 0x33, 0xe2, 0x47,
+	0xb6, 0x53, 0xb3, 0x9a, 0x07, 0xbb, 0x50, 0xe4, 0x34, 0x7c, 0x2f, 0xf0, 0x98, 0x9a, 0x22, 0x9c,
+	0xd7, 0x31, 0x5f, 0xeb, 0xff, 0xd4, 0xe0, 0xc1, 0x11, 0xf6, 0x7c, 0xe2, 0x5e, 0xa7, 0x83, 0x0c,
+	0x28, 0xc4, 0x52, 0x1c, 0xd5, 0xde, 0x9e, 0xac, 0xa5, 0xa4, 0x6a, 0x71, 0xe9, 0x5e, 0xf4, 0x0e,
+	0x6c, 0xcd, 0xc3, 0xc8, 0x88, 0x30, 0xe6, 0x6b, 0xd4, 0x84, 0xfb, 0x3e, 0xa6, 0x2c, 0x8d, 0x33,
+	0x15, 0x34, 0x2b, 0x04, 0xdd, 0xe1, 0x2e, 0x15, 0xae, 0x52, 0xf4, 0x00, 0x76, 0x42, 0x72, 0xc9,
+	0xf8, 0x20, 0x8a, 0x67, 0x29, 0x3a, 0x27, 0xd0, 0xf7, 0xb8, 0x43, 0x48, 0x28, 0xb1, 0xfa, 0xdf,
+	0x35, 0xd8, 0x31, 0xac, 0xae, 0x9a, 0xd8, 0x6a, 0x60, 0x2f, 0x75, 0x4d, 0xd9, 0x9b, 0x44, 0xf1,
+	0xa5, 0x5d, 0x53, 0x20, 0x79, 0xf3, 0xe0, 0x71, 0x2a, 0x80, 0x9a, 0x2b, 0xdc, 0x22, 0xdd, 0x3f,
+	0x86, 0x3c, 0x2f, 0x12, 0xa2, 0xca, 0xeb, 0xfd, 0xeb, 0xa2, 0xbc, 0xf1, 0x9b, 0xbc, 0xb4, 0x88,
+	0x25, 0x37, 0xf1, 0x02, 0xc2, 0x0e, 0xf3, 0x2e, 0xb0, 0x18, 0xa3, 0x4b, 0x11, 0xe4, 0xac, 0xea,
+	0xc2, 0xa1, 0x42, 0xf8, 0x15, 0x94, 0x86, 0x8b, 0x76, 0x8e, 0xb6, 0x21, 0xe3, 0xb9, 0xaa, 0x14,
+	0x32, 0x9e, 0x8b, 0x10, 0xe4, 0x42, 0x1c, 0x10, 0x45, 0x51, 0x7c, 0xa3, 0x06, 0x54, 0x55, 0x7c,
+	0xfc, 0x59, 0xb2, 0xdc, 0x7f, 0xb7, 0xa5, 0xbd, 0xc3, 0x1c, 0x41, 0x4e, 0x1f, 0x40, 0xe5, 0x24,
+	0x61, 0x2f, 0xfc, 0xe8, 0xe5, 0xe7, 0x5e, 0xe8, 0x46, 0x2f, 0x79, 0x5f, 0x78, 0x29, 0xbe, 0xd2,
+	0x72, 0xd2, 0x04, 0xad, 0xb2, 0x34, 0xaa, 0x62, 0xaa, 0x41, 0x61, 0x9c, 0x38, 0xe7, 0x64, 0x9e,
+	0xcc, 0x74, 0xa9, 0x7b, 0x50, 0x55, 0xe7, 0x99, 0xb1, 0xe7, 0x90, 0x23, 0x42, 0xdc, 0xc5, 0x08,
+	0xd0, 0x96, 0x47, 0x00, 0x6f, 0x40, 0x49, 0x1c, 0x93, 0xd0, 0x99, 0xd9, 0x53, 0xec, 0xa5, 0x97,
+	0xb8, 0x9c, 0x1a, 0x4d, 0xec, 0xc5, 0xbc, 0x6c, 0x5c, 0xe2, 0x78, 0x01, 0xf6, 0xe5, 0x45, 0xae,
+	0x58, 0xf3, 0xb5, 0xfe, 0x39, 0xdc, 0x1f, 0x8a, 0xa9, 0x91, 0xfe, 0xa0, 0x7c, 0xc6, 0xad, 0x15,
+	0xc0, 0x2e, 0x14, 0xf9, 0xcd, 0x72, 0x44, 0xdf, 0x54, 0xf5, 0x18, 0xe0, 0xcb, 0x2e, 0x5f, 0xeb,
+	0x7f, 0xd6, 0xa0, 0x72, 0xe5, 0xe4, 0xa5, 0x71, 0xa5, 0x5d, 0x19, 0x57, 0xfb, 0xa0, 0x8e, 0xb5,
+	0x29, 0xc3, 0x31, 0x53, 0x57, 0xaf, 0x24, 0x6d, 0x43, 0x6e, 0x12, 0xa3, 0x69, 0xfe, 0xfe, 0xa8,
+	0x58, 0x72, 0x81, 0x3a, 0x50, 0x90, 0x7d, 0x98, 0xd6, 0x72, 0x7b, 0xd9, 0x46, 0xe9, 0x50, 0xbf,
+	0x5e, 0x40, 0x92, 0x80, 0x48, 0x92, 0x62, 0x91, 0x5e, 0x29, 0xb5, 0x51, 0xc7, 0x80, 0xde, 0x04,
+	0xad, 0x10, 0x7b, 0x31, 0x24, 0x32, 0x77, 0x18, 0x12, 0xfa, 0xef, 0xb2, 0x50, 0xed, 0x11, 0x1f,
+	0xcf, 0x88, 0x2b, 0x6f, 0xf7, 0x49, 0xc2, 0x96, 0x0a, 0x30, 0x27, 0x0a, 0xf0, 0xdb, 0xcc, 0xff,
+	0x7d, 0x28, 0x33, 0x1c, 0x4f, 0x08, 0xb3, 0x97, 0x9f, 0x59, 0x25, 0x69, 0x1b, 0x5d, 0x7b, 0x22,
+	0xe4, 0x57, 0x3c, 0x11, 0x36, 0xbf, 0xd5, 0x13, 0xa1, 0xb0, 0xfc, 0x44, 0x78, 0x02, 0xdb, 0x31,
+	0xf1, 0x09, 0xa6, 0x24, 0xbd, 0x85, 0x5b, 0x22, 0xae, 0x8a, 0xb2, 0xaa, 0x8e, 0xa3, 0x9e, 0x0a,
+	0xc5, 0x75, 0x9f, 0x0a, 0xbc, 0x08, 0xbf, 0x48, 0x48, 0x42, 0xdc, 0xf4, 0x58, 0x90, 0x45, 0x28,
+	0x8d, 0xea, 0xd4, 0x8f, 0xa1, 0x98, 0x50, 0xd7, 0xbe, 0xc0, 0x7e, 0x42, 0x6a, 0xa5, 0xb5, 0xe6,
+	0x5f, 0x42, 0xdd, 0xcf, 0x38, 0x5c, 0xff, 0x52, 0x83, 0xf2, 0x3c, 0x25, 0x47, 0x84, 0xac, 0xc8,
+	0xfb, 0x3c, 0xea, 0xcc, 0x72, 0xd4, 0xcf, 0x20, 0xf7, 0xc2, 0xc7, 0x6b, 0x3e, 0x89, 0x05, 0x54,
+	0x3c, 0x53, 0x30, 0xf5, 0xa8, 0x3d, 0x8d, 0x3c, 0x59, 0xb5, 0x62, 0x14, 0x09, 0x9b, 0x29, 0x4c,
+	0x07, 0xbf, 0xcd, 0x2c, 0xa6, 0xd9, 0x0d, 0x53, 0x15, 0x35, 0xe1, 0x60, 0x64, 0xf5, 0xcd, 0xb6,
+	0x35, 0xfa, 0x85, 0xdd, 0xb1, 0xfa, 0xbd, 0x4f, 0x0c, 0xdb, 0x32, 0x7e, 0x7e, 0x6a, 0x0c, 0x47,
+	0xf6, 0x70, 0xd4, 0x1e, 0x9d, 0x0e, 0xed, 0xd3, 0xc1, 0xd0, 0x34, 0xba, 0xfd, 0xa3, 0xbe, 0xd1,
+	0xab, 0x6e, 0xa0, 0x0f, 0xa0, 0x71, 0x0b, 0xde, 0xb4, 0x4e, 0xba, 0xc6, 0x70, 0x68, 0xf4, 0xaa,
+	0x1a, 0x3a, 0x80, 0xf7, 0x6f, 0x41, 0x0f, 0x9f, 0xf7, 0x4d, 0xd3, 0xe8, 0x55, 0x33, 0xe8, 0x10,
+	0x9a, 0xb7, 0x60, 0xbb, 0xed, 0xe3, 0xe3, 0x4e, 0xbb, 0xfb, 0xdc, 0x3e, 0x6a, 0xf7, 0x8f, 0x8d,
+	0x5e, 0x35, 0xbb, 0x06, 0x9b, 0x6e, 0x7b, 0xd0, 0x35, 0x8e, 0x39, 0x3a, 0x77, 0xf0, 0xa7, 0x0c,
+	0x3c, 0xb8, 0x79, 0x04, 0xa0, 0xf7, 0x60, 0x4f, 0x78, 0xec, 0xd1, 0xc9, 0x73, 0x63, 0x60, 0x7f,
+	0xda, 0x36, 0xcd, 0xfe, 0xe0, 0x13, 0x71, 0x88, 0x61, 0xb7, 0xbb, 0xa3, 0xfe, 0x67, 0x46, 0x75,
+	0x03, 0x7d, 0x08, 0x1f, 0xac, 0x46, 0x99, 0xc6, 0xa0, 0xc7, 0x57, 0x02, 0xdd, 0x1e, 0xf5, 0x4f,
+	0x06, 0x55, 0x8d, 0xcb, 0xbb, 0x7a, 0x87, 0x62, 0xdc, 0x1f, 0xd8, 0x66, 0xfb, 0x74, 0x28, 0x44,
+	0x68, 0xc1, 0xd3, 0x5b, 0xf1, 0x27, 0xa7, 0xa3, 0x74, 0x43, 0xf6, 0xff, 0x13, 0x57, 0xa8, 0x1c,
+	0x6a, 0xc0, 0x7b, 0xab, 0x51, 0x3d, 0xc3, 0xb4, 0x8c, 0x6e, 0x7b, 0x64, 0xf4, 0xaa, 0xf9, 0x4e,
+	0xe7, 0xab, 0x57, 0x75, 0xed, 0xeb, 0x57, 0x75, 0xed, 0x3f, 0xaf, 0xea, 0xda, 0x97, 0xaf, 0xeb,
+	0x1b, 0x5f, 0xbf, 0xae, 0x6f, 0xfc, 0xeb, 0x75, 0x7d, 0xe3, 0x97, 0x8d, 0x89, 0xc7, 0xce, 0x92,
+	0x71, 0xd3, 0x89, 0x82, 0x16, 0x6f, 0x8b, 0x3f, 0x88, 0xe2, 0x89, 0xf8, 0x70, 0x5b, 0x97, 0xe9,
+	0xdf, 0x7d, 0x36, 0x9b, 0x12, 0x3a, 0xde, 0x14, 0xff, 0xe2, 0x7f, 0xf8, 0xbf, 0x01, 0x00, 0x34,
+	0xf1, 0xfd, 0x60, 0x0a, 0x10, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TripartyOutcomesRetentionBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.TripartyOutcomesRetentionBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Erc20SupplyAssertionEnabled {
+		i--
+		if m.Erc20SupplyAssertionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AssetsLockedEventsRetentionBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.AssetsLockedEventsRetentionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BtcSupplyAssertionEnabled {
+		i--
+		if m.BtcSupplyAssertionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxErc20TokensMappings != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.MaxErc20TokensMappings))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetsLockedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetsLockedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetsLockedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Sequence.Size()
+		i -= size
+		if _, err := m.Sequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetsLockedRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetsLockedRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetsLockedRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChain != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.SourceChain))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetsUnlockedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetsUnlockedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetsUnlockedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BlockTime != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Chain != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.UnlockSequence.Size()
+		i -= size
+		if _, err := m.UnlockSequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TripartyBridgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripartyBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripartyBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CallbackData) > 0 {
+		i -= len(m.CallbackData)
+		copy(dAtA[i:], m.CallbackData)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.CallbackData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Sequence.Size()
+		i -= size
+		if _, err := m.Sequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TripartyBridgeRequestOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripartyBridgeRequestOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripartyBridgeRequestOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProcessingHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.ProcessingHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Sequence.Size()
+		i -= size
+		if _, err := m.Sequence.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TripartyCallbackRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripartyCallbackRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripartyCallbackRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedTripartyCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedTripartyCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedTripartyCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastAttemptHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.LastAttemptHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20TokenMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20TokenMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20TokenMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MezoToken) > 0 {
+		i -= len(m.MezoToken)
+		copy(dAtA[i:], m.MezoToken)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.MezoToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceToken) > 0 {
+		i -= len(m.SourceToken)
+		copy(dAtA[i:], m.SourceToken)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.SourceToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceBtcToken) > 0 {
+		i -= len(m.SourceBtcToken)
+		copy(dAtA[i:], m.SourceBtcToken)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.SourceBtcToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Buckets != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Buckets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowPriceFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowPriceFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowPriceFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderOutflowParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderOutflowParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderOutflowParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCount != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBridge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderTokenOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderTokenOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderTokenOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedBridgeOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedBridgeOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedBridgeOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UsdValue.Size()
+		i -= size
+		if _, err := m.UsdValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.QueuedHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Chain != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetToken) > 0 {
+		i -= len(m.TargetToken)
+		copy(dAtA[i:], m.TargetToken)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.TargetToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeOutFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeOutFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeOutFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Flat.Size()
+		i -= size
+		if _, err := m.Flat.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBridge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Chain != 0 {
+		i = encodeVarintBridge(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBridge(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxErc20TokensMappings != 0 {
+		n += 1 + sovBridge(uint64(m.MaxErc20TokensMappings))
+	}
+	if m.BtcSupplyAssertionEnabled {
+		n += 2
+	}
+	if m.AssetsLockedEventsRetentionBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.AssetsLockedEventsRetentionBlocks))
+	}
+	if m.Erc20SupplyAssertionEnabled {
+		n += 2
+	}
+	if m.TripartyOutcomesRetentionBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.TripartyOutcomesRetentionBlocks))
+	}
+	return n
+}
+
+func (m *AssetsLockedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sequence.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetsLockedRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Event.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovBridge(uint64(m.BlockHeight))
+	}
+	if m.Skipped {
+		n += 2
+	}
+	if m.SourceChain != 0 {
+		n += 1 + sovBridge(uint64(m.SourceChain))
+	}
+	return n
+}
+
+func (m *AssetsUnlockedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnlockSequence.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.Chain != 0 {
+		n += 1 + sovBridge(uint64(m.Chain))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovBridge(uint64(m.BlockTime))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *TripartyBridgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sequence.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovBridge(uint64(m.BlockHeight))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.CallbackData)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *TripartyBridgeRequestOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sequence.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBridge(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.ProcessingHeight != 0 {
+		n += 1 + sovBridge(uint64(m.ProcessingHeight))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovBridge(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *TripartyCallbackRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovBridge(uint64(m.MaxAttempts))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.IntervalBlocks))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBridge(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *FailedTripartyCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovBridge(uint64(m.Attempts))
+	}
+	if m.LastAttemptHeight != 0 {
+		n += 1 + sovBridge(uint64(m.LastAttemptHeight))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovBridge(uint64(m.NextRetryHeight))
+	}
+	return n
+}
+
+func (m *ERC20TokenMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceToken)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.MezoToken)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovBridge(uint64(m.State))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovBridge(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *SourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBridge(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.SourceBtcToken)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	return n
+}
+
+func (m *OutflowWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.WindowBlocks))
+	}
+	if m.Buckets != 0 {
+		n += 1 + sovBridge(uint64(m.Buckets))
+	}
+	return n
+}
+
+func (m *OutflowPriceFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovBridge(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *SenderOutflowParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovBridge(uint64(m.WindowBlocks))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovBridge(uint64(m.MaxCount))
+	}
+	return n
+}
+
+func (m *SenderOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovBridge(uint64(m.WindowStart))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBridge(uint64(m.Count))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovBridge(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SenderTokenOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *DelayedBridgeOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBridge(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = len(m.TargetToken)
+	if l > 0 {
 		n += 1 + l + sovBridge(uint64(l))
 	}
-	return n
-}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.Chain != 0 {
+		n += 1 + sovBridge(uint64(m.Chain))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovBridge(uint64(m.ReleaseHeight))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.QueuedHeight != 0 {
+		n += 1 + sovBridge(uint64(m.QueuedHeight))
+	}
+	l = m.UsdValue.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	return n
+}
+
+func (m *BridgeOutFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBridge(uint64(l))
+	}
+	if m.Chain != 0 {
+		n += 1 + sovBridge(uint64(m.Chain))
+	}
+	l = m.Flat.Size()
+	n += 1 + l + sovBridge(uint64(l))
+	if m.BasisPoints != 0 {
+		n += 1 + sovBridge(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func sovBridge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridge(x uint64) (n int) {
+	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxErc20TokensMappings", wireType)
+			}
+			m.MaxErc20TokensMappings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxErc20TokensMappings |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcSupplyAssertionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BtcSupplyAssertionEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedEventsRetentionBlocks", wireType)
+			}
+			m.AssetsLockedEventsRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetsLockedEventsRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20SupplyAssertionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Erc20SupplyAssertionEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyOutcomesRetentionBlocks", wireType)
+			}
+			m.TripartyOutcomesRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripartyOutcomesRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsLockedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsLockedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetsLockedRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsLockedRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsLockedRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			m.SourceChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetsUnlockedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetsUnlockedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockSequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnlockSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripartyBridgeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripartyBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackData == nil {
+				m.CallbackData = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripartyBridgeRequestOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripartyBridgeRequestOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripartyBridgeRequestOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TripartyBridgeRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingHeight", wireType)
+			}
+			m.ProcessingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TripartyCallbackRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TripartyCallbackRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TripartyCallbackRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedTripartyCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedTripartyCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedTripartyCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptHeight", wireType)
+			}
+			m.LastAttemptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAttemptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20TokenMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20TokenMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MezoToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MezoToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ERC20TokenMappingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBtcToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBtcToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			m.Buckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Buckets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ERC20TokenMapping) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SourceToken)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
-	}
-	l = len(m.MezoToken)
-	if l > 0 {
-		n += 1 + l + sovBridge(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *OutflowPriceFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowPriceFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowPriceFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovBridge(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBridge(x uint64) (n int) {
-	return sovBridge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SenderOutflowParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -851,17 +4892,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SenderOutflowParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SenderOutflowParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxErc20TokensMappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
 			}
-			m.MaxErc20TokensMappings = 0
+			m.WindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -871,16 +4912,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxErc20TokensMappings |= uint32(b&0x7F) << shift
+				m.WindowBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcSupplyAssertionEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
 			}
-			var v int
+			m.MaxCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -890,12 +4931,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BtcSupplyAssertionEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
@@ -917,7 +4957,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
+func (m *SenderOutflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -940,15 +4980,15 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsLockedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SenderOutflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsLockedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SenderOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -976,15 +5016,51 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -994,27 +5070,79 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBridge
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBridge
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Amounts = append(m.Amounts, SenderTokenOutflow{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderTokenOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderTokenOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderTokenOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,13 +5170,11 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1076,7 +5202,9 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1099,7 +5227,7 @@ func (m *AssetsLockedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
+func (m *DelayedBridgeOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,17 +5250,17 @@ func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetsUnlockedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedBridgeOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetsUnlockedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedBridgeOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockSequence", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1142,26 +5270,11 @@ func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UnlockSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
@@ -1230,7 +5343,7 @@ func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1258,11 +5371,11 @@ func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TargetToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,101 +5403,11 @@ func (m *AssetsUnlockedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			m.Chain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Chain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-			}
-			m.BlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBridge
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBridge(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBridge
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBridge
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TripartyBridgeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TripartyBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1412,15 +5435,15 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
-			m.BlockHeight = 0
+			m.Chain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1430,16 +5453,16 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				m.Chain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var stringLen uint64
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1449,27 +5472,14 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBridge
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1497,15 +5507,15 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
 			}
-			var byteLen int
+			m.QueuedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBridge
@@ -1515,29 +5525,14 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.QueuedHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBridge
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBridge
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CallbackData == nil {
-				m.CallbackData = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1565,7 +5560,9 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			if err := m.UsdValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1588,7 +5585,7 @@ func (m *TripartyBridgeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20TokenMapping) Unmarshal(dAtA []byte) error {
+func (m *BridgeOutFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,15 +5608,15 @@ func (m *ERC20TokenMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20TokenMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeOutFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20TokenMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeOutFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1647,11 +5644,30 @@ func (m *ERC20TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceToken = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MezoToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,8 +5695,29 @@ func (m *ERC20TokenMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MezoToken = string(dAtA[iNdEx:postIndex])
+			if err := m.Flat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBridge(dAtA[iNdEx:])
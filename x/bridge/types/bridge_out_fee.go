@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// BasisPointsDenominator is the denominator of the proportional part of
+// bridge-out fees. A fee of BasisPointsDenominator basis points equals the
+// whole bridged-out amount.
+const BasisPointsDenominator = 10_000
+
+// NewBridgeOutFee creates a new bridge-out fee of a token and a target chain.
+func NewBridgeOutFee(
+	mezoToken []byte,
+	chain uint8,
+	flat sdkmath.Int,
+	basisPoints uint32,
+) BridgeOutFee {
+	return BridgeOutFee{
+		Token:       evmtypes.BytesToHexAddress(mezoToken),
+		Chain:       uint32(chain),
+		Flat:        flat,
+		BasisPoints: basisPoints,
+	}
+}
+
+// IsZero returns true if the bridge-out fee charges nothing.
+func (f BridgeOutFee) IsZero() bool {
+	return (f.Flat.IsNil() || f.Flat.IsZero()) && f.BasisPoints == 0
+}
+
+// Amount returns the fee charged for bridging out the given amount.
+func (f BridgeOutFee) Amount(amount sdkmath.Int) sdkmath.Int {
+	fee := sdkmath.ZeroInt()
+
+	if !f.Flat.IsNil() {
+		fee = fee.Add(f.Flat)
+	}
+
+	if f.BasisPoints > 0 {
+		fee = fee.Add(
+			amount.MulRaw(int64(f.BasisPoints)).QuoRaw(BasisPointsDenominator),
+		)
+	}
+
+	return fee
+}
+
+// Validate validates the bridge-out fee.
+func (f BridgeOutFee) Validate() error {
+	if !evmtypes.IsHexAddress(f.Token) {
+		return fmt.Errorf("%w: %s", ErrInvalidEVMAddress, f.Token)
+	}
+
+	if f.Chain > math.MaxUint8 {
+		return fmt.Errorf("bridge-out fee chain must fit in uint8: %d", f.Chain)
+	}
+
+	if f.Flat.IsNil() || f.Flat.IsNegative() {
+		return errors.New("bridge-out flat fee must be non-negative")
+	}
+
+	if f.BasisPoints > BasisPointsDenominator {
+		return fmt.Errorf(
+			"bridge-out fee basis points cannot exceed %d: %d",
+			BasisPointsDenominator,
+			f.BasisPoints,
+		)
+	}
+
+	return nil
+}
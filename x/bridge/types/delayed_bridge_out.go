@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+// MaxDelayedBridgeOutBlocks is the maximum number of blocks a bridge-out can
+// be held in the delayed bridge-out queue. The bound guarantees that queued
+// assets cannot be locked indefinitely.
+const MaxDelayedBridgeOutBlocks = 1_000_000
+
+// ValidateDelayedBridgeOutBlocks validates the delay of the delayed
+// bridge-out queue.
+func ValidateDelayedBridgeOutBlocks(blocks uint64) error {
+	if blocks > MaxDelayedBridgeOutBlocks {
+		return fmt.Errorf(
+			"delayed bridge-out blocks cannot exceed %d: %d",
+			MaxDelayedBridgeOutBlocks,
+			blocks,
+		)
+	}
+
+	return nil
+}
+
+// Validate validates the delayed bridge-out.
+func (b DelayedBridgeOut) Validate() error {
+	if b.Id == 0 {
+		return errors.New("delayed bridge-out id must be positive")
+	}
+
+	if len(b.Recipient) == 0 {
+		return errors.New("delayed bridge-out recipient cannot be empty")
+	}
+
+	if !evmtypes.IsHexAddress(b.Token) {
+		return fmt.Errorf("%w: %s", ErrInvalidEVMAddress, b.Token)
+	}
+
+	if !evmtypes.IsHexAddress(b.TargetToken) {
+		return fmt.Errorf("%w: %s", ErrInvalidEVMAddress, b.TargetToken)
+	}
+
+	if !evmtypes.IsHexAddress(b.Sender) {
+		return fmt.Errorf("%w: %s", ErrInvalidEVMAddress, b.Sender)
+	}
+
+	if b.Amount.IsNil() || !b.Amount.IsPositive() {
+		return fmt.Errorf("delayed bridge-out amount must be positive: %s", b.Amount)
+	}
+
+	if b.Fee.IsNil() || b.Fee.IsNegative() {
+		return fmt.Errorf("delayed bridge-out fee must be non-negative: %s", b.Fee)
+	}
+
+	if !b.UsdValue.IsNil() && b.UsdValue.IsNegative() {
+		return fmt.Errorf("delayed bridge-out USD value cannot be negative: %s", b.UsdValue)
+	}
+
+	if b.QueuedHeight == 0 {
+		return errors.New("delayed bridge-out queued height must be positive")
+	}
+
+	if b.QueuedHeight > b.ReleaseHeight {
+		return fmt.Errorf(
+			"delayed bridge-out queued height cannot exceed the release height: %d",
+			b.QueuedHeight,
+		)
+	}
+
+	if b.Chain > math.MaxUint8 {
+		return fmt.Errorf("delayed bridge-out chain must fit in uint8: %d", b.Chain)
+	}
+
+	return nil
+}
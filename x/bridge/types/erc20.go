@@ -1,19 +1,51 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 )
 
+// Short aliases of the ERC20 token mapping lifecycle states.
+const (
+	ERC20TokenMappingStateActive            = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_ACTIVE
+	ERC20TokenMappingStatePendingActivation = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_PENDING_ACTIVATION
+	ERC20TokenMappingStateBridgeInPaused    = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_BRIDGE_IN_PAUSED
+	ERC20TokenMappingStateBridgeOutPaused   = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_BRIDGE_OUT_PAUSED
+	ERC20TokenMappingStatePaused            = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_PAUSED
+	ERC20TokenMappingStateDeprecated        = ERC20TokenMappingState_ERC20_TOKEN_MAPPING_STATE_DEPRECATED
+)
+
+// IsValid returns true if the state is a known lifecycle state.
+func (s ERC20TokenMappingState) IsValid() bool {
+	_, ok := ERC20TokenMappingState_name[int32(s)]
+	return ok
+}
+
 func NewERC20TokenMapping(
 	sourceToken, mezoToken []byte,
 ) *ERC20TokenMapping {
 	return &ERC20TokenMapping{
 		SourceToken: evmtypes.BytesToHexAddress(sourceToken),
 		MezoToken:   evmtypes.BytesToHexAddress(mezoToken),
+		State:       ERC20TokenMappingStateActive,
 	}
 }
 
+// NewPendingERC20TokenMapping creates a new ERC20 token mapping that becomes
+// active at the given Mezo block height.
+func NewPendingERC20TokenMapping(
+	sourceToken, mezoToken []byte,
+	activationHeight uint64,
+) *ERC20TokenMapping {
+	mapping := NewERC20TokenMapping(sourceToken, mezoToken)
+	mapping.State = ERC20TokenMappingStatePendingActivation
+	mapping.ActivationHeight = activationHeight
+
+	return mapping
+}
+
 // SourceTokenBytes returns the source token EVM address as bytes.
 func (m *ERC20TokenMapping) SourceTokenBytes() []byte {
 	return evmtypes.HexAddressToBytes(m.SourceToken)
@@ -24,6 +56,40 @@ func (m *ERC20TokenMapping) MezoTokenBytes() []byte {
 	return evmtypes.HexAddressToBytes(m.MezoToken)
 }
 
+// IsBridgeInEnabled returns true if the token can be bridged in to Mezo
+// according to the mapping state.
+func (m *ERC20TokenMapping) IsBridgeInEnabled() bool {
+	return m.State == ERC20TokenMappingStateActive ||
+		m.State == ERC20TokenMappingStateBridgeOutPaused
+}
+
+// IsBridgeOutEnabled returns true if the token can be bridged out of Mezo
+// according to the mapping state.
+func (m *ERC20TokenMapping) IsBridgeOutEnabled() bool {
+	return m.State == ERC20TokenMappingStateActive ||
+		m.State == ERC20TokenMappingStateBridgeInPaused ||
+		m.State == ERC20TokenMappingStateDeprecated
+}
+
+// ValidateLifecycle validates the mapping state and activation height.
+func (m *ERC20TokenMapping) ValidateLifecycle() error {
+	if !m.State.IsValid() {
+		return fmt.Errorf("%w: %d", ErrInvalidERC20TokenMappingState, m.State)
+	}
+
+	pending := m.State == ERC20TokenMappingStatePendingActivation
+
+	if pending && m.ActivationHeight == 0 {
+		return fmt.Errorf("pending mapping must have an activation height")
+	}
+
+	if !pending && m.ActivationHeight != 0 {
+		return fmt.Errorf("only pending mappings can have an activation height")
+	}
+
+	return nil
+}
+
 // MustMarshalERC20TokenMapping marshals an ERC20TokenMapping to bytes.
 // It panics on error.
 func MustMarshalERC20TokenMapping(
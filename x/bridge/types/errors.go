@@ -7,22 +7,43 @@ import (
 // Code 11 belonged to the removed ErrTripartyPaused. Do not reuse it; clients
 // may still map it to the old message.
 var (
-	ErrInvalidEVMAddress               = sdkerrors.Register(ModuleName, 1, "invalid hex-encoded EVM address")
-	ErrZeroEVMAddress                  = sdkerrors.Register(ModuleName, 2, "zero EVM address")
-	ErrAlreadyMapping                  = sdkerrors.Register(ModuleName, 3, "given ERC20 mapping already exists")
-	ErrNotMapping                      = sdkerrors.Register(ModuleName, 4, "given ERC20 mapping does not exist")
-	ErrMaxMappingsReached              = sdkerrors.Register(ModuleName, 5, "the maximum number of ERC20 mappings has been reached")
-	ErrTokenNotContract                = sdkerrors.Register(ModuleName, 6, "token address is not a contract")
-	ErrOutflowLimitExceeded            = sdkerrors.Register(ModuleName, 7, "outflow limit exceeded")
-	ErrTripartyWindowLimitExceeded     = sdkerrors.Register(ModuleName, 8, "triparty window limit exceeded")
-	ErrTripartyPerRequestLimitExceeded = sdkerrors.Register(ModuleName, 9, "triparty per-request limit exceeded")
-	ErrTripartyControllerNotAllowed    = sdkerrors.Register(ModuleName, 10, "controller is not an allowed triparty controller")
-	ErrTripartyCallbackDataTooLarge    = sdkerrors.Register(ModuleName, 12, "triparty callback data exceeds maximum length")
-	ErrTripartyAmountNotPositive       = sdkerrors.Register(ModuleName, 13, "triparty amount must be positive")
-	ErrTripartyAmountBelowMinimum      = sdkerrors.Register(ModuleName, 14, "triparty amount below minimum")
-	ErrTripartyRecipientBlocked        = sdkerrors.Register(ModuleName, 15, "triparty recipient is a blocked address")
-	ErrTripartyRecipientIsPrecompile   = sdkerrors.Register(ModuleName, 16, "triparty recipient is a custom precompile")
-	ErrBridgeOutPaused                 = sdkerrors.Register(ModuleName, 17, "bridge-out is paused")
-	ErrBridgeInPaused                  = sdkerrors.Register(ModuleName, 18, "bridge-in is paused")
-	ErrBridgeOutChainNotEnabled        = sdkerrors.Register(ModuleName, 19, "target chain is not enabled for bridge-outs")
+	ErrInvalidEVMAddress                       = sdkerrors.Register(ModuleName, 1, "invalid hex-encoded EVM address")
+	ErrZeroEVMAddress                          = sdkerrors.Register(ModuleName, 2, "zero EVM address")
+	ErrAlreadyMapping                          = sdkerrors.Register(ModuleName, 3, "given ERC20 mapping already exists")
+	ErrNotMapping                              = sdkerrors.Register(ModuleName, 4, "given ERC20 mapping does not exist")
+	ErrMaxMappingsReached                      = sdkerrors.Register(ModuleName, 5, "the maximum number of ERC20 mappings has been reached")
+	ErrTokenNotContract                        = sdkerrors.Register(ModuleName, 6, "token address is not a contract")
+	ErrOutflowLimitExceeded                    = sdkerrors.Register(ModuleName, 7, "outflow limit exceeded")
+	ErrTripartyWindowLimitExceeded             = sdkerrors.Register(ModuleName, 8, "triparty window limit exceeded")
+	ErrTripartyPerRequestLimitExceeded         = sdkerrors.Register(ModuleName, 9, "triparty per-request limit exceeded")
+	ErrTripartyControllerNotAllowed            = sdkerrors.Register(ModuleName, 10, "controller is not an allowed triparty controller")
+	ErrTripartyCallbackDataTooLarge            = sdkerrors.Register(ModuleName, 12, "triparty callback data exceeds maximum length")
+	ErrTripartyAmountNotPositive               = sdkerrors.Register(ModuleName, 13, "triparty amount must be positive")
+	ErrTripartyAmountBelowMinimum              = sdkerrors.Register(ModuleName, 14, "triparty amount below minimum")
+	ErrTripartyRecipientBlocked                = sdkerrors.Register(ModuleName, 15, "triparty recipient is a blocked address")
+	ErrTripartyRecipientIsPrecompile           = sdkerrors.Register(ModuleName, 16, "triparty recipient is a custom precompile")
+	ErrBridgeOutPaused                         = sdkerrors.Register(ModuleName, 17, "bridge-out is paused")
+	ErrBridgeInPaused                          = sdkerrors.Register(ModuleName, 18, "bridge-in is paused")
+	ErrBridgeOutChainNotEnabled                = sdkerrors.Register(ModuleName, 19, "target chain is not enabled for bridge-outs")
+	ErrUSDOutflowLimitExceeded                 = sdkerrors.Register(ModuleName, 20, "USD outflow limit exceeded")
+	ErrOutflowPriceUnavailable                 = sdkerrors.Register(ModuleName, 21, "outflow price unavailable")
+	ErrSenderOutflowLimitExceeded              = sdkerrors.Register(ModuleName, 22, "sender outflow limit exceeded")
+	ErrSenderOutflowCountExceeded              = sdkerrors.Register(ModuleName, 23, "sender bridge-out count exceeded")
+	ErrDelayedBridgeOutNotFound                = sdkerrors.Register(ModuleName, 24, "delayed bridge-out not found")
+	ErrInvalidSourceChain                      = sdkerrors.Register(ModuleName, 25, "invalid source chain")
+	ErrSourceChainAlreadyRegistered            = sdkerrors.Register(ModuleName, 26, "source chain is already registered")
+	ErrSourceChainNotRegistered                = sdkerrors.Register(ModuleName, 27, "source chain is not registered")
+	ErrMaxSourceChainsReached                  = sdkerrors.Register(ModuleName, 28, "the maximum number of source chains has been reached")
+	ErrBridgeOutFeeTreasuryNotSet              = sdkerrors.Register(ModuleName, 29, "bridge-out fee treasury is not set")
+	ErrBridgeOutAmountNotAboveFee              = sdkerrors.Register(ModuleName, 30, "bridge-out amount does not exceed the bridge-out fee")
+	ErrInvalidERC20TokenMappingState           = sdkerrors.Register(ModuleName, 31, "invalid ERC20 token mapping state")
+	ErrTokenBridgeOutDisabled                  = sdkerrors.Register(ModuleName, 32, "bridge-out is disabled for the ERC20 token mapping")
+	ErrTripartyCallbackNotFound                = sdkerrors.Register(ModuleName, 33, "failed triparty callback not found")
+	ErrTripartyCallbackRetryTooEarly           = sdkerrors.Register(ModuleName, 34, "triparty callback was already attempted in this block")
+	ErrTripartyRequestNotFound                 = sdkerrors.Register(ModuleName, 35, "pending triparty request not found")
+	ErrTripartyRequestMature                   = sdkerrors.Register(ModuleName, 36, "triparty request is mature and can no longer be cancelled")
+	ErrInsufficientPrimarySourceChainLiquidity = sdkerrors.Register(ModuleName, 37, "insufficient primary source chain liquidity")
+	ErrTripartyCallbackRetriesExhausted        = sdkerrors.Register(ModuleName, 38, "triparty callback retries are exhausted")
+	ErrTripartyRequestAlreadyCancelled         = sdkerrors.Register(ModuleName, 39, "triparty request is already cancelled")
+	ErrInsufficientTripartyCallbackRetryGas    = sdkerrors.Register(ModuleName, 40, "insufficient gas to cover the triparty callback retry gas limit")
 )
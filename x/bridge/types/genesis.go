@@ -15,32 +15,59 @@ import (
 // it is invalid (Validate will fail). A proper BTC token must be set at
 // later stages, before running the network.
 func DefaultGenesis() *GenesisState {
+	tripartyCallbackRetryPolicy := DefaultTripartyCallbackRetryPolicy()
+
 	return &GenesisState{
-		Params:                         DefaultParams(),
-		AssetsLockedSequenceTip:        sdkmath.NewInt(0),
-		AssetsUnlockedSequenceTip:      sdkmath.NewInt(0),
-		SourceBtcToken:                 "",
-		Erc20TokensMappings:            nil,
-		InitialBtcSupply:               sdkmath.NewInt(0),
-		AssetsUnlockedEvents:           nil,
-		BitcoinChainMinBridgeOutAmount: sdkmath.NewInt(0),
-		TokenMinBridgeOutAmounts:       nil,
-		LastOutflowReset:               0,
-		CurrentOutflowLimits:           nil,
-		CurrentOutflowAmounts:          nil,
-		AllowedTripartyControllers:     nil,
-		TripartyBlockDelay:             1,
-		TripartyPerRequestLimit:        sdkmath.NewInt(0),
-		TripartyWindowLimit:            sdkmath.NewInt(0),
-		TripartyRequestSequenceTip:     sdkmath.NewInt(0),
-		TripartyProcessedSequenceTip:   sdkmath.NewInt(0),
-		TripartyPendingRequests:        nil,
-		TripartyWindowConsumed:         sdkmath.NewInt(0),
-		TripartyWindowLastReset:        0,
-		TripartyControllerBtcMinted:    nil,
-		BridgeOutPaused:                false,
-		BridgeInPaused:                 false,
-		BridgeOutChains:                []uint32{TargetChainEthereum, TargetChainBitcoin},
+		Params:                            DefaultParams(),
+		AssetsLockedSequenceTip:           sdkmath.NewInt(0),
+		AssetsUnlockedSequenceTip:         sdkmath.NewInt(0),
+		SourceBtcToken:                    "",
+		Erc20TokensMappings:               nil,
+		InitialBtcSupply:                  sdkmath.NewInt(0),
+		AssetsUnlockedEvents:              nil,
+		BitcoinChainMinBridgeOutAmount:    sdkmath.NewInt(0),
+		TokenMinBridgeOutAmounts:          nil,
+		LastOutflowReset:                  0,
+		CurrentOutflowLimits:              nil,
+		CurrentOutflowAmounts:             nil,
+		AllowedTripartyControllers:        nil,
+		TripartyBlockDelay:                1,
+		TripartyPerRequestLimit:           sdkmath.NewInt(0),
+		TripartyWindowLimit:               sdkmath.NewInt(0),
+		TripartyRequestSequenceTip:        sdkmath.NewInt(0),
+		TripartyProcessedSequenceTip:      sdkmath.NewInt(0),
+		TripartyPendingRequests:           nil,
+		TripartyWindowConsumed:            sdkmath.NewInt(0),
+		TripartyWindowLastReset:           0,
+		TripartyControllerBtcMinted:       nil,
+		BridgeOutPaused:                   false,
+		BridgeInPaused:                    false,
+		BridgeOutChains:                   []uint32{TargetChainEthereum, TargetChainBitcoin},
+		AssetsLockedEvents:                nil,
+		AssetsLockedPrunedSequenceTip:     sdkmath.NewInt(0),
+		OutflowWindows:                    nil,
+		OutflowBuckets:                    nil,
+		UsdOutflowLimit:                   sdkmath.NewInt(0),
+		UsdOutflowWindow:                  OutflowWindow{},
+		CurrentUsdOutflow:                 sdkmath.NewInt(0),
+		UsdOutflowBuckets:                 nil,
+		OutflowPriceFeeds:                 nil,
+		SenderOutflowParams:               SenderOutflowParams{},
+		SenderOutflowLimits:               nil,
+		SenderOutflows:                    nil,
+		DelayedBridgeOutBlocks:            0,
+		DelayedBridgeOutThresholds:        nil,
+		DelayedBridgeOuts:                 nil,
+		DelayedBridgeOutSequenceTip:       0,
+		Erc20Supplies:                     nil,
+		SourceChains:                      nil,
+		BridgeOutFeeTreasury:              "",
+		BridgeOutFees:                     nil,
+		TripartyOutcomes:                  nil,
+		TripartyOutcomesPrunedSequenceTip: sdkmath.NewInt(0),
+		TripartyCallbackRetryPolicy:       &tripartyCallbackRetryPolicy,
+		FailedTripartyCallbacks:           nil,
+		SourceChainAssetsLockedEvents:     nil,
 	}
 }
 
@@ -58,6 +85,10 @@ func (gs GenesisState) Validate() error {
 		)
 	}
 
+	if err := gs.validateAssetsLockedEvents(); err != nil {
+		return err
+	}
+
 	if gs.AssetsUnlockedSequenceTip.IsNegative() {
 		return fmt.Errorf(
 			"genesis assets unlocked sequence tip cannot be negative: %s",
@@ -101,6 +132,10 @@ func (gs GenesisState) Validate() error {
 				i,
 			)
 		}
+
+		if err := mapping.ValidateLifecycle(); err != nil {
+			return fmt.Errorf("invalid lifecycle of ERC20 mapping %d: %w", i, err)
+		}
 	}
 
 	if gs.TripartyBlockDelay < 1 {
@@ -353,5 +388,795 @@ func (gs GenesisState) Validate() error {
 		seenBridgeOutChains[chain] = struct{}{}
 	}
 
+	if err := gs.validateOutflows(); err != nil {
+		return err
+	}
+
+	if err := gs.validateSenderOutflows(); err != nil {
+		return err
+	}
+
+	if err := gs.validateDelayedBridgeOuts(); err != nil {
+		return err
+	}
+
+	if err := gs.validateERC20Supplies(); err != nil {
+		return err
+	}
+
+	if err := gs.validateSourceChains(); err != nil {
+		return err
+	}
+
+	if err := gs.validateSourceChainAssetsLockedEvents(); err != nil {
+		return err
+	}
+
+	if err := gs.validateBridgeOutFees(); err != nil {
+		return err
+	}
+
+	if err := gs.validateTripartyOutcomes(); err != nil {
+		return err
+	}
+
+	return gs.validateFailedTripartyCallbacks()
+}
+
+// validateOutflows validates the rolling outflow windows, the USD outflow
+// limit state and the outflow price feeds.
+func (gs GenesisState) validateOutflows() error {
+	windowTokens := make(map[string]struct{}, len(gs.OutflowWindows))
+	for i, entry := range gs.OutflowWindows {
+		if !evmtypes.IsHexAddress(entry.Token) {
+			return fmt.Errorf(
+				"outflow window %d token must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		if entry.Window.IsZero() {
+			return fmt.Errorf("outflow window %d cannot be zero", i)
+		}
+
+		if err := entry.Window.Validate(); err != nil {
+			return fmt.Errorf("outflow window %d is invalid: %w", i, err)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(entry.Token))
+		if _, ok := windowTokens[normalizedToken]; ok {
+			return fmt.Errorf(
+				"outflow window %d has duplicate token: %s",
+				i,
+				entry.Token,
+			)
+		}
+		windowTokens[normalizedToken] = struct{}{}
+	}
+
+	for i, bucket := range gs.OutflowBuckets {
+		if !evmtypes.IsHexAddress(bucket.Token) {
+			return fmt.Errorf(
+				"outflow bucket %d token must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(bucket.Token))
+		if _, ok := windowTokens[normalizedToken]; !ok {
+			return fmt.Errorf(
+				"outflow bucket %d token has no outflow window: %s",
+				i,
+				bucket.Token,
+			)
+		}
+
+		if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+			return fmt.Errorf(
+				"outflow bucket %d amount must be positive: %s",
+				i,
+				bucket.Amount,
+			)
+		}
+	}
+
+	if !gs.UsdOutflowLimit.IsNil() && gs.UsdOutflowLimit.IsNegative() {
+		return fmt.Errorf(
+			"genesis USD outflow limit cannot be negative: %s",
+			gs.UsdOutflowLimit,
+		)
+	}
+
+	if err := gs.UsdOutflowWindow.Validate(); err != nil {
+		return fmt.Errorf("genesis USD outflow window is invalid: %w", err)
+	}
+
+	if !gs.CurrentUsdOutflow.IsNil() && gs.CurrentUsdOutflow.IsNegative() {
+		return fmt.Errorf(
+			"genesis current USD outflow cannot be negative: %s",
+			gs.CurrentUsdOutflow,
+		)
+	}
+
+	if len(gs.UsdOutflowBuckets) > 0 && gs.UsdOutflowWindow.IsZero() {
+		return fmt.Errorf("USD outflow buckets require a USD outflow window")
+	}
+
+	for i, bucket := range gs.UsdOutflowBuckets {
+		if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+			return fmt.Errorf(
+				"USD outflow bucket %d amount must be positive: %s",
+				i,
+				bucket.Amount,
+			)
+		}
+	}
+
+	feedTokens := make(map[string]struct{}, len(gs.OutflowPriceFeeds))
+	for i, feed := range gs.OutflowPriceFeeds {
+		if err := feed.Validate(); err != nil {
+			return fmt.Errorf("outflow price feed %d is invalid: %w", i, err)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(feed.Token))
+		if _, ok := feedTokens[normalizedToken]; ok {
+			return fmt.Errorf(
+				"outflow price feed %d has duplicate token: %s",
+				i,
+				feed.Token,
+			)
+		}
+		feedTokens[normalizedToken] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateSenderOutflows validates the per-sender bridge-out limits and the
+// bridge-outs tracked per sender.
+func (gs GenesisState) validateSenderOutflows() error {
+	if err := gs.SenderOutflowParams.Validate(); err != nil {
+		return fmt.Errorf("genesis sender outflow params are invalid: %w", err)
+	}
+
+	limitTokens := make(map[string]struct{}, len(gs.SenderOutflowLimits))
+	for i, entry := range gs.SenderOutflowLimits {
+		if !evmtypes.IsHexAddress(entry.Token) {
+			return fmt.Errorf(
+				"sender outflow limit %d token must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		if entry.Limit.IsNil() || !entry.Limit.IsPositive() {
+			return fmt.Errorf(
+				"sender outflow limit %d must be positive: %s",
+				i,
+				entry.Limit,
+			)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(entry.Token))
+		if _, ok := limitTokens[normalizedToken]; ok {
+			return fmt.Errorf(
+				"sender outflow limit %d has duplicate token: %s",
+				i,
+				entry.Token,
+			)
+		}
+		limitTokens[normalizedToken] = struct{}{}
+	}
+
+	// A sender may have records of several windows as stale records of
+	// past windows are pruned gradually.
+	type senderWindow struct {
+		sender      string
+		windowStart uint64
+	}
+
+	senders := make(map[senderWindow]struct{}, len(gs.SenderOutflows))
+	for i, outflow := range gs.SenderOutflows {
+		if !evmtypes.IsHexAddress(outflow.Sender) {
+			return fmt.Errorf(
+				"sender outflow %d sender must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		key := senderWindow{
+			sender:      evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(outflow.Sender)),
+			windowStart: outflow.WindowStart,
+		}
+		if _, ok := senders[key]; ok {
+			return fmt.Errorf(
+				"sender outflow %d has duplicate sender in window starting at %d: %s",
+				i,
+				outflow.WindowStart,
+				outflow.Sender,
+			)
+		}
+		senders[key] = struct{}{}
+
+		for j, amount := range outflow.Amounts {
+			if !evmtypes.IsHexAddress(amount.Token) {
+				return fmt.Errorf(
+					"sender outflow %d amount %d token must be a valid hex-encoded EVM address",
+					i,
+					j,
+				)
+			}
+
+			if amount.Amount.IsNil() || !amount.Amount.IsPositive() {
+				return fmt.Errorf(
+					"sender outflow %d amount %d must be positive: %s",
+					i,
+					j,
+					amount.Amount,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAssetsLockedEvents ensures the retained AssetsLocked events of the
+// primary source chain form a gapless range between the pruned sequence tip
+// (exclusive) and the AssetsLocked sequence tip (inclusive).
+func (gs GenesisState) validateAssetsLockedEvents() error {
+	// A genesis state predating the AssetsLocked event store has no pruned
+	// sequence tip. In that case, none of the accepted events is retained.
+	prunedSequenceTip := gs.AssetsLockedPrunedSequenceTip
+	if prunedSequenceTip.IsNil() {
+		prunedSequenceTip = gs.AssetsLockedSequenceTip
+	}
+
+	return validateAssetsLockedRecords(
+		"assets locked",
+		PrimarySourceChain,
+		gs.AssetsLockedSequenceTip,
+		prunedSequenceTip,
+		gs.AssetsLockedEvents,
+	)
+}
+
+// validateSourceChainAssetsLockedEvents ensures the retained AssetsLocked
+// events of each additional source chain form a gapless range between the
+// pruned sequence tip (exclusive) and the AssetsLocked sequence tip
+// (inclusive) of the chain. It must be called after validateSourceChains.
+func (gs GenesisState) validateSourceChainAssetsLockedEvents() error {
+	records := make(map[uint32][]*AssetsLockedRecord, len(gs.SourceChains))
+	for _, state := range gs.SourceChains {
+		records[state.Chain.Id] = nil
+	}
+
+	for i, record := range gs.SourceChainAssetsLockedEvents {
+		if record == nil {
+			return fmt.Errorf("source chain assets locked event %d is invalid", i)
+		}
+
+		if _, ok := records[record.SourceChain]; !ok {
+			return fmt.Errorf(
+				"source chain assets locked event %d references an unknown source chain: %d",
+				i,
+				record.SourceChain,
+			)
+		}
+
+		records[record.SourceChain] = append(records[record.SourceChain], record)
+	}
+
+	for _, state := range gs.SourceChains {
+		// A genesis state predating the source chain AssetsLocked event
+		// store has no pruned sequence tip. In that case, none of the
+		// accepted events is retained.
+		prunedSequenceTip := state.AssetsLockedPrunedSequenceTip
+		if prunedSequenceTip.IsNil() {
+			prunedSequenceTip = state.AssetsLockedSequenceTip
+		}
+
+		err := validateAssetsLockedRecords(
+			fmt.Sprintf("source chain %d assets locked", state.Chain.Id),
+			state.Chain.Id,
+			state.AssetsLockedSequenceTip,
+			prunedSequenceTip,
+			records[state.Chain.Id],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateAssetsLockedRecords ensures the given retained AssetsLocked events
+// of the given source chain form a gapless range between the given pruned
+// sequence tip (exclusive) and sequence tip (inclusive). The given name
+// prefixes error messages.
+func validateAssetsLockedRecords(
+	name string,
+	sourceChain uint32,
+	sequenceTip sdkmath.Int,
+	prunedSequenceTip sdkmath.Int,
+	records []*AssetsLockedRecord,
+) error {
+	if prunedSequenceTip.IsNegative() {
+		return fmt.Errorf(
+			"genesis %s pruned sequence tip cannot be negative: %s",
+			name,
+			prunedSequenceTip,
+		)
+	}
+
+	if prunedSequenceTip.GT(sequenceTip) {
+		return fmt.Errorf(
+			"genesis %s pruned sequence tip cannot be greater than sequence tip: %s > %s",
+			name,
+			prunedSequenceTip,
+			sequenceTip,
+		)
+	}
+
+	expectedEvents := sequenceTip.Sub(prunedSequenceTip)
+	actualEvents := sdkmath.NewInt(int64(len(records)))
+	if !expectedEvents.Equal(actualEvents) {
+		return fmt.Errorf(
+			"%s events must form a gapless range between pruned sequence tip and sequence tip",
+			name,
+		)
+	}
+
+	for i, record := range records {
+		if record == nil || !record.Event.IsValid() {
+			return fmt.Errorf("%s event %d is invalid", name, i)
+		}
+
+		if record.SourceChain != sourceChain {
+			return fmt.Errorf(
+				"%s event %d has unexpected source chain: %d",
+				name,
+				i,
+				record.SourceChain,
+			)
+		}
+
+		if record.BlockHeight < 0 {
+			return fmt.Errorf(
+				"%s event %d block height cannot be negative: %d",
+				name,
+				i,
+				record.BlockHeight,
+			)
+		}
+
+		expectedSequence := prunedSequenceTip.AddRaw(int64(i) + 1)
+		if !record.Event.Sequence.Equal(expectedSequence) {
+			return fmt.Errorf(
+				"%s event %d has unexpected sequence; expected %s, got %s",
+				name,
+				i,
+				expectedSequence,
+				record.Event.Sequence,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateDelayedBridgeOuts validates the delayed bridge-out queue
+// parameters and the bridge-outs held in the queue.
+func (gs GenesisState) validateDelayedBridgeOuts() error {
+	if err := ValidateDelayedBridgeOutBlocks(gs.DelayedBridgeOutBlocks); err != nil {
+		return fmt.Errorf("genesis delayed bridge-out blocks are invalid: %w", err)
+	}
+
+	thresholdTokens := make(map[string]struct{}, len(gs.DelayedBridgeOutThresholds))
+	for i, entry := range gs.DelayedBridgeOutThresholds {
+		if !evmtypes.IsHexAddress(entry.Token) {
+			return fmt.Errorf(
+				"delayed bridge-out threshold %d token must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		if entry.Threshold.IsNil() || !entry.Threshold.IsPositive() {
+			return fmt.Errorf(
+				"delayed bridge-out threshold %d must be positive: %s",
+				i,
+				entry.Threshold,
+			)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(entry.Token))
+		if _, ok := thresholdTokens[normalizedToken]; ok {
+			return fmt.Errorf(
+				"delayed bridge-out threshold %d has duplicate token: %s",
+				i,
+				entry.Token,
+			)
+		}
+		thresholdTokens[normalizedToken] = struct{}{}
+	}
+
+	// The queue is keyed by identifier, so the bridge-outs must be strictly
+	// increasing to round-trip through the export.
+	previousID := uint64(0)
+	for i, bridgeOut := range gs.DelayedBridgeOuts {
+		if err := bridgeOut.Validate(); err != nil {
+			return fmt.Errorf("delayed bridge-out %d is invalid: %w", i, err)
+		}
+
+		if bridgeOut.Id <= previousID {
+			return fmt.Errorf(
+				"delayed bridge-out %d id must be greater than the previous one: %d",
+				i,
+				bridgeOut.Id,
+			)
+		}
+
+		if bridgeOut.Id > gs.DelayedBridgeOutSequenceTip {
+			return fmt.Errorf(
+				"delayed bridge-out %d id exceeds the sequence tip: %d",
+				i,
+				bridgeOut.Id,
+			)
+		}
+
+		previousID = bridgeOut.Id
+	}
+
+	return nil
+}
+
+// validateERC20Supplies validates the amounts of ERC20 tokens minted and
+// burnt by the bridge.
+func (gs GenesisState) validateERC20Supplies() error {
+	tokens := make(map[string]struct{}, len(gs.Erc20Supplies))
+	for i, supply := range gs.Erc20Supplies {
+		if !evmtypes.IsHexAddress(supply.Token) {
+			return fmt.Errorf(
+				"ERC20 supply %d token must be a valid hex-encoded EVM address",
+				i,
+			)
+		}
+
+		if supply.Minted.IsNil() || supply.Minted.IsNegative() {
+			return fmt.Errorf(
+				"ERC20 supply %d minted amount must be non-negative: %s",
+				i,
+				supply.Minted,
+			)
+		}
+
+		if supply.Burnt.IsNil() || supply.Burnt.IsNegative() {
+			return fmt.Errorf(
+				"ERC20 supply %d burnt amount must be non-negative: %s",
+				i,
+				supply.Burnt,
+			)
+		}
+
+		normalizedToken := evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(supply.Token))
+		if _, ok := tokens[normalizedToken]; ok {
+			return fmt.Errorf(
+				"ERC20 supply %d has duplicate token: %s",
+				i,
+				supply.Token,
+			)
+		}
+		tokens[normalizedToken] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateSourceChains validates the additional bridge-in source chains
+// along with their AssetsLocked sequence tips and ERC20 token mappings.
+func (gs GenesisState) validateSourceChains() error {
+	if len(gs.SourceChains) > MaxSourceChains {
+		return fmt.Errorf(
+			"number of source chains cannot exceed %d: %d",
+			MaxSourceChains,
+			len(gs.SourceChains),
+		)
+	}
+
+	chains := make(map[uint32]struct{}, len(gs.SourceChains))
+	for i, state := range gs.SourceChains {
+		if err := state.Chain.Validate(); err != nil {
+			return fmt.Errorf("source chain %d is invalid: %w", i, err)
+		}
+
+		if _, ok := chains[state.Chain.Id]; ok {
+			return fmt.Errorf(
+				"source chain %d has duplicate identifier: %d",
+				i,
+				state.Chain.Id,
+			)
+		}
+		chains[state.Chain.Id] = struct{}{}
+
+		if state.AssetsLockedSequenceTip.IsNil() ||
+			state.AssetsLockedSequenceTip.IsNegative() {
+			return fmt.Errorf(
+				"source chain %d assets locked sequence tip must be non-negative: %s",
+				i,
+				state.AssetsLockedSequenceTip,
+			)
+		}
+
+		if uint32(len(state.Erc20TokensMappings)) > gs.Params.MaxErc20TokensMappings { //nolint:gosec
+			return fmt.Errorf(
+				"source chain %d number of ERC20 mappings cannot exceed %d",
+				i,
+				gs.Params.MaxErc20TokensMappings,
+			)
+		}
+
+		sourceTokens := make(map[string]struct{}, len(state.Erc20TokensMappings))
+		for j, mapping := range state.Erc20TokensMappings {
+			if !evmtypes.IsHexAddress(mapping.SourceToken) {
+				return fmt.Errorf(
+					"source token of source chain %d ERC20 mapping %d must be a valid hex-encoded EVM address",
+					i,
+					j,
+				)
+			}
+
+			if !evmtypes.IsHexAddress(mapping.MezoToken) {
+				return fmt.Errorf(
+					"mezo token of source chain %d ERC20 mapping %d must be a valid hex-encoded EVM address",
+					i,
+					j,
+				)
+			}
+
+			normalizedToken := evmtypes.BytesToHexAddress(mapping.SourceTokenBytes())
+			if _, ok := sourceTokens[normalizedToken]; ok {
+				return fmt.Errorf(
+					"source chain %d ERC20 mapping %d has duplicate source token: %s",
+					i,
+					j,
+					mapping.SourceToken,
+				)
+			}
+			sourceTokens[normalizedToken] = struct{}{}
+		}
+
+		mintedTokens := make(map[string]struct{}, len(state.Minted))
+		for j, minted := range state.Minted {
+			if !evmtypes.IsHexAddress(minted.Token) {
+				return fmt.Errorf(
+					"token of source chain %d minted amount %d must be a valid hex-encoded EVM address",
+					i,
+					j,
+				)
+			}
+
+			if minted.Amount.IsNil() || !minted.Amount.IsPositive() {
+				return fmt.Errorf(
+					"source chain %d minted amount %d must be positive: %s",
+					i,
+					j,
+					minted.Amount,
+				)
+			}
+
+			normalizedToken := evmtypes.BytesToHexAddress(
+				evmtypes.HexAddressToBytes(minted.Token),
+			)
+			if _, ok := mintedTokens[normalizedToken]; ok {
+				return fmt.Errorf(
+					"source chain %d minted amount %d has duplicate token: %s",
+					i,
+					j,
+					minted.Token,
+				)
+			}
+			mintedTokens[normalizedToken] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// validateBridgeOutFees validates the bridge-out fee treasury and the
+// bridge-out fees of tokens and target chains.
+func (gs GenesisState) validateBridgeOutFees() error {
+	if gs.BridgeOutFeeTreasury != "" {
+		if !evmtypes.IsHexAddress(gs.BridgeOutFeeTreasury) {
+			return fmt.Errorf(
+				"bridge-out fee treasury must be a valid hex-encoded EVM address: %s",
+				gs.BridgeOutFeeTreasury,
+			)
+		}
+
+		if evmtypes.IsZeroHexAddress(gs.BridgeOutFeeTreasury) {
+			return fmt.Errorf("bridge-out fee treasury cannot be the zero address")
+		}
+	} else if len(gs.BridgeOutFees) > 0 {
+		return fmt.Errorf("bridge-out fees require the bridge-out fee treasury")
+	}
+
+	type feeKey struct {
+		token string
+		chain uint32
+	}
+
+	fees := make(map[feeKey]struct{}, len(gs.BridgeOutFees))
+	for i, fee := range gs.BridgeOutFees {
+		if err := fee.Validate(); err != nil {
+			return fmt.Errorf("bridge-out fee %d is invalid: %w", i, err)
+		}
+
+		if fee.IsZero() {
+			return fmt.Errorf("bridge-out fee %d cannot be zero", i)
+		}
+
+		key := feeKey{
+			token: evmtypes.BytesToHexAddress(evmtypes.HexAddressToBytes(fee.Token)),
+			chain: fee.Chain,
+		}
+		if _, ok := fees[key]; ok {
+			return fmt.Errorf(
+				"bridge-out fee %d has duplicate token and chain: %s, %d",
+				i,
+				fee.Token,
+				fee.Chain,
+			)
+		}
+		fees[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateTripartyOutcomes ensures the retained triparty bridge request
+// outcome records form a gapless range between the pruned sequence tip
+// (exclusive) and the triparty processed sequence tip (inclusive).
+func (gs GenesisState) validateTripartyOutcomes() error {
+	// A genesis state predating the triparty outcome store has no pruned
+	// sequence tip. In that case, none of the processed requests has a
+	// retained outcome.
+	prunedSequenceTip := gs.TripartyOutcomesPrunedSequenceTip
+	if prunedSequenceTip.IsNil() {
+		prunedSequenceTip = gs.TripartyProcessedSequenceTip
+	}
+
+	if prunedSequenceTip.IsNegative() {
+		return fmt.Errorf(
+			"genesis triparty outcomes pruned sequence tip cannot be negative: %s",
+			prunedSequenceTip,
+		)
+	}
+
+	if prunedSequenceTip.GT(gs.TripartyProcessedSequenceTip) {
+		return fmt.Errorf(
+			"genesis triparty outcomes pruned sequence tip cannot be greater than processed sequence tip: %s > %s",
+			prunedSequenceTip,
+			gs.TripartyProcessedSequenceTip,
+		)
+	}
+
+	expectedOutcomes := gs.TripartyProcessedSequenceTip.Sub(prunedSequenceTip)
+	actualOutcomes := sdkmath.NewInt(int64(len(gs.TripartyOutcomes)))
+	if !expectedOutcomes.Equal(actualOutcomes) {
+		return fmt.Errorf(
+			"triparty outcomes must form a gapless range between pruned sequence tip and processed sequence tip",
+		)
+	}
+
+	for i, outcome := range gs.TripartyOutcomes {
+		if outcome == nil || outcome.Sequence.IsNil() {
+			return fmt.Errorf("triparty outcome %d is invalid", i)
+		}
+
+		expectedSequence := prunedSequenceTip.AddRaw(int64(i) + 1)
+		if !outcome.Sequence.Equal(expectedSequence) {
+			return fmt.Errorf(
+				"triparty outcome %d has unexpected sequence; expected %s, got %s",
+				i,
+				expectedSequence,
+				outcome.Sequence,
+			)
+		}
+
+		if !evmtypes.IsHexAddress(outcome.Controller) {
+			return fmt.Errorf(
+				"triparty outcome %d controller must be a valid hex-encoded EVM address: %s",
+				i,
+				outcome.Controller,
+			)
+		}
+
+		if !outcome.Status.IsValid() {
+			return fmt.Errorf(
+				"triparty outcome %d has invalid status: %s",
+				i,
+				outcome.Status,
+			)
+		}
+
+		if outcome.ProcessingHeight < 0 {
+			return fmt.Errorf(
+				"triparty outcome %d processing height cannot be negative: %d",
+				i,
+				outcome.ProcessingHeight,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateFailedTripartyCallbacks ensures the triparty callback retry policy
+// is valid and the failed triparty callbacks belong to distinct processed
+// requests.
+func (gs GenesisState) validateFailedTripartyCallbacks() error {
+	// A genesis state predating the triparty callback retry queue has no
+	// retry policy. In that case, the default policy is used.
+	if gs.TripartyCallbackRetryPolicy != nil {
+		if err := gs.TripartyCallbackRetryPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid triparty callback retry policy: %w", err)
+		}
+	}
+
+	seenSequences := make(map[string]struct{}, len(gs.FailedTripartyCallbacks))
+
+	for i, failed := range gs.FailedTripartyCallbacks {
+		if failed == nil || failed.Request.Sequence.IsNil() {
+			return fmt.Errorf("failed triparty callback %d is invalid", i)
+		}
+
+		req := failed.Request
+
+		if !req.Sequence.IsPositive() ||
+			req.Sequence.GT(gs.TripartyProcessedSequenceTip) {
+			return fmt.Errorf(
+				"failed triparty callback %d sequence must be in range [1, %s]: %s",
+				i,
+				gs.TripartyProcessedSequenceTip,
+				req.Sequence,
+			)
+		}
+
+		if _, ok := seenSequences[req.Sequence.String()]; ok {
+			return fmt.Errorf(
+				"duplicate failed triparty callback sequence: %s",
+				req.Sequence,
+			)
+		}
+		seenSequences[req.Sequence.String()] = struct{}{}
+
+		if !evmtypes.IsHexAddress(req.Controller) ||
+			!evmtypes.IsHexAddress(req.Recipient) {
+			return fmt.Errorf(
+				"failed triparty callback %d controller and recipient must be valid hex-encoded EVM addresses",
+				i,
+			)
+		}
+
+		if req.Amount.IsNil() || !req.Amount.IsPositive() {
+			return fmt.Errorf("failed triparty callback %d amount must be positive", i)
+		}
+
+		if failed.Attempts == 0 {
+			return fmt.Errorf("failed triparty callback %d must have at least one attempt", i)
+		}
+
+		if failed.LastAttemptHeight < 0 ||
+			(failed.NextRetryHeight != 0 &&
+				failed.NextRetryHeight <= failed.LastAttemptHeight) {
+			return fmt.Errorf(
+				"failed triparty callback %d has invalid attempt heights; last: %d, next: %d",
+				i,
+				failed.LastAttemptHeight,
+				failed.NextRetryHeight,
+			)
+		}
+	}
+
 	return nil
 }
@@ -97,6 +97,82 @@ type GenesisState struct {
 	// bridge_out_chains is the list of target chains that accept bridge-outs.
 	// Each entry must fit in a uint8. A chain outside the list is disabled.
 	BridgeOutChains []uint32 `protobuf:"varint,28,rep,packed,name=bridge_out_chains,json=bridgeOutChains,proto3" json:"bridge_out_chains,omitempty"`
+	// assets_locked_events are the accepted AssetsLocked events retained in
+	// the module state.
+	AssetsLockedEvents []*AssetsLockedRecord `protobuf:"bytes,29,rep,name=assets_locked_events,json=assetsLockedEvents,proto3" json:"assets_locked_events,omitempty"`
+	// assets_locked_pruned_sequence_tip is the sequence number of the last
+	// AssetsLocked event pruned from the module state.
+	AssetsLockedPrunedSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,30,opt,name=assets_locked_pruned_sequence_tip,json=assetsLockedPrunedSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"assets_locked_pruned_sequence_tip"`
+	// outflow_windows are the rolling outflow windows of tokens using one.
+	// Tokens without a window use the fixed-interval outflow periods.
+	OutflowWindows []*TokenOutflowWindow `protobuf:"bytes,31,rep,name=outflow_windows,json=outflowWindows,proto3" json:"outflow_windows,omitempty"`
+	// outflow_buckets are the non-empty outflow buckets of tokens using
+	// a rolling outflow window.
+	OutflowBuckets []*OutflowBucket `protobuf:"bytes,32,rep,name=outflow_buckets,json=outflowBuckets,proto3" json:"outflow_buckets,omitempty"`
+	// usd_outflow_limit is the global outflow limit across all tokens having
+	// a price feed, in USD with 18 decimals. Zero disables the limit.
+	UsdOutflowLimit cosmossdk_io_math.Int `protobuf:"bytes,33,opt,name=usd_outflow_limit,json=usdOutflowLimit,proto3,customtype=cosmossdk.io/math.Int" json:"usd_outflow_limit"`
+	// usd_outflow_window is the rolling window of the USD outflow limit.
+	// A zero window means the fixed-interval outflow periods are used.
+	UsdOutflowWindow OutflowWindow `protobuf:"bytes,34,opt,name=usd_outflow_window,json=usdOutflowWindow,proto3" json:"usd_outflow_window"`
+	// current_usd_outflow is the USD outflow of the current fixed-interval
+	// period. It is used only if usd_outflow_window is zero.
+	CurrentUsdOutflow cosmossdk_io_math.Int `protobuf:"bytes,35,opt,name=current_usd_outflow,json=currentUsdOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"current_usd_outflow"`
+	// usd_outflow_buckets are the non-empty buckets of the USD outflow
+	// rolling window. Their token field is empty.
+	UsdOutflowBuckets []*OutflowBucket `protobuf:"bytes,36,rep,name=usd_outflow_buckets,json=usdOutflowBuckets,proto3" json:"usd_outflow_buckets,omitempty"`
+	// outflow_price_feeds are the oracle price feeds valuing token outflows
+	// against the USD outflow limit.
+	OutflowPriceFeeds []OutflowPriceFeed `protobuf:"bytes,37,rep,name=outflow_price_feeds,json=outflowPriceFeeds,proto3" json:"outflow_price_feeds"`
+	// sender_outflow_params are the per-sender bridge-out limit parameters.
+	SenderOutflowParams SenderOutflowParams `protobuf:"bytes,38,opt,name=sender_outflow_params,json=senderOutflowParams,proto3" json:"sender_outflow_params"`
+	// sender_outflow_limits are the per-sender outflow limits of tokens
+	// having one.
+	SenderOutflowLimits []SenderOutflowLimit `protobuf:"bytes,39,rep,name=sender_outflow_limits,json=senderOutflowLimits,proto3" json:"sender_outflow_limits"`
+	// sender_outflows are the bridge-outs tracked per sender.
+	SenderOutflows []SenderOutflow `protobuf:"bytes,40,rep,name=sender_outflows,json=senderOutflows,proto3" json:"sender_outflows"`
+	// delayed_bridge_out_blocks is the number of blocks bridge-outs reaching
+	// the delayed bridge-out threshold of their token are held in the queue.
+	// Zero disables the delayed bridge-out queue.
+	DelayedBridgeOutBlocks uint64 `protobuf:"varint,41,opt,name=delayed_bridge_out_blocks,json=delayedBridgeOutBlocks,proto3" json:"delayed_bridge_out_blocks,omitempty"`
+	// delayed_bridge_out_thresholds are the delayed bridge-out thresholds of
+	// tokens having one.
+	DelayedBridgeOutThresholds []DelayedBridgeOutThreshold `protobuf:"bytes,42,rep,name=delayed_bridge_out_thresholds,json=delayedBridgeOutThresholds,proto3" json:"delayed_bridge_out_thresholds"`
+	// delayed_bridge_outs are the bridge-outs held in the delayed bridge-out
+	// queue.
+	DelayedBridgeOuts []DelayedBridgeOut `protobuf:"bytes,43,rep,name=delayed_bridge_outs,json=delayedBridgeOuts,proto3" json:"delayed_bridge_outs"`
+	// delayed_bridge_out_sequence_tip is the identifier of the last queued
+	// delayed bridge-out.
+	DelayedBridgeOutSequenceTip uint64 `protobuf:"varint,44,opt,name=delayed_bridge_out_sequence_tip,json=delayedBridgeOutSequenceTip,proto3" json:"delayed_bridge_out_sequence_tip,omitempty"`
+	// erc20_supplies are the amounts of ERC20 tokens minted and burnt by the
+	// bridge, per Mezo token.
+	Erc20Supplies []ERC20Supply `protobuf:"bytes,45,rep,name=erc20_supplies,json=erc20Supplies,proto3" json:"erc20_supplies"`
+	// source_chains are the additional bridge-in source chains, along with
+	// their AssetsLocked sequence tips and ERC20 token mappings.
+	SourceChains []SourceChainState `protobuf:"bytes,46,rep,name=source_chains,json=sourceChains,proto3" json:"source_chains"`
+	// bridge_out_fee_treasury is the hex-encoded EVM address receiving the
+	// bridge-out fees. Empty if not set.
+	BridgeOutFeeTreasury string `protobuf:"bytes,47,opt,name=bridge_out_fee_treasury,json=bridgeOutFeeTreasury,proto3" json:"bridge_out_fee_treasury,omitempty"`
+	// bridge_out_fees are the bridge-out fees of tokens and target chains
+	// having one.
+	BridgeOutFees []BridgeOutFee `protobuf:"bytes,48,rep,name=bridge_out_fees,json=bridgeOutFees,proto3" json:"bridge_out_fees"`
+	// triparty_outcomes are the outcome records of processed triparty bridge
+	// requests retained in the module state.
+	TripartyOutcomes []*TripartyBridgeRequestOutcome `protobuf:"bytes,49,rep,name=triparty_outcomes,json=tripartyOutcomes,proto3" json:"triparty_outcomes,omitempty"`
+	// triparty_outcomes_pruned_sequence_tip is the sequence number of the last
+	// processed triparty bridge request whose outcome record is not retained in
+	// the module state.
+	TripartyOutcomesPrunedSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,50,opt,name=triparty_outcomes_pruned_sequence_tip,json=tripartyOutcomesPrunedSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"triparty_outcomes_pruned_sequence_tip"`
+	// triparty_callback_retry_policy is the retry policy of failed triparty
+	// controller callbacks.
+	TripartyCallbackRetryPolicy *TripartyCallbackRetryPolicy `protobuf:"bytes,51,opt,name=triparty_callback_retry_policy,json=tripartyCallbackRetryPolicy,proto3" json:"triparty_callback_retry_policy,omitempty"`
+	// failed_triparty_callbacks are the failed triparty controller callbacks
+	// queued for retry.
+	FailedTripartyCallbacks []*FailedTripartyCallback `protobuf:"bytes,52,rep,name=failed_triparty_callbacks,json=failedTripartyCallbacks,proto3" json:"failed_triparty_callbacks,omitempty"`
+	// source_chain_assets_locked_events are the accepted AssetsLocked events of
+	// the additional source chains retained in the module state, ordered by
+	// source chain and sequence number.
+	SourceChainAssetsLockedEvents []*AssetsLockedRecord `protobuf:"bytes,53,rep,name=source_chain_assets_locked_events,json=sourceChainAssetsLockedEvents,proto3" json:"source_chain_assets_locked_events,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -244,26 +320,183 @@ func (m *GenesisState) GetBridgeOutChains() []uint32 {
 	return nil
 }
 
-// CurrentOutflowAmount tracks the current outflow amount for a specific token.
-type CurrentOutflowAmount struct {
-	// token is the token's hex-encoded EVM address.
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// amount is the current outflow amount for this token.
-	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *GenesisState) GetAssetsLockedEvents() []*AssetsLockedRecord {
+	if m != nil {
+		return m.AssetsLockedEvents
+	}
+	return nil
 }
 
-func (m *CurrentOutflowAmount) Reset()         { *m = CurrentOutflowAmount{} }
-func (m *CurrentOutflowAmount) String() string { return proto.CompactTextString(m) }
-func (*CurrentOutflowAmount) ProtoMessage()    {}
-func (*CurrentOutflowAmount) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetOutflowWindows() []*TokenOutflowWindow {
+	if m != nil {
+		return m.OutflowWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutflowBuckets() []*OutflowBucket {
+	if m != nil {
+		return m.OutflowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsdOutflowWindow() OutflowWindow {
+	if m != nil {
+		return m.UsdOutflowWindow
+	}
+	return OutflowWindow{}
+}
+
+func (m *GenesisState) GetUsdOutflowBuckets() []*OutflowBucket {
+	if m != nil {
+		return m.UsdOutflowBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutflowPriceFeeds() []OutflowPriceFeed {
+	if m != nil {
+		return m.OutflowPriceFeeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSenderOutflowParams() SenderOutflowParams {
+	if m != nil {
+		return m.SenderOutflowParams
+	}
+	return SenderOutflowParams{}
+}
+
+func (m *GenesisState) GetSenderOutflowLimits() []SenderOutflowLimit {
+	if m != nil {
+		return m.SenderOutflowLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSenderOutflows() []SenderOutflow {
+	if m != nil {
+		return m.SenderOutflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelayedBridgeOutBlocks() uint64 {
+	if m != nil {
+		return m.DelayedBridgeOutBlocks
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDelayedBridgeOutThresholds() []DelayedBridgeOutThreshold {
+	if m != nil {
+		return m.DelayedBridgeOutThresholds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelayedBridgeOuts() []DelayedBridgeOut {
+	if m != nil {
+		return m.DelayedBridgeOuts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelayedBridgeOutSequenceTip() uint64 {
+	if m != nil {
+		return m.DelayedBridgeOutSequenceTip
+	}
+	return 0
+}
+
+func (m *GenesisState) GetErc20Supplies() []ERC20Supply {
+	if m != nil {
+		return m.Erc20Supplies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceChains() []SourceChainState {
+	if m != nil {
+		return m.SourceChains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgeOutFeeTreasury() string {
+	if m != nil {
+		return m.BridgeOutFeeTreasury
+	}
+	return ""
+}
+
+func (m *GenesisState) GetBridgeOutFees() []BridgeOutFee {
+	if m != nil {
+		return m.BridgeOutFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTripartyOutcomes() []*TripartyBridgeRequestOutcome {
+	if m != nil {
+		return m.TripartyOutcomes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTripartyCallbackRetryPolicy() *TripartyCallbackRetryPolicy {
+	if m != nil {
+		return m.TripartyCallbackRetryPolicy
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedTripartyCallbacks() []*FailedTripartyCallback {
+	if m != nil {
+		return m.FailedTripartyCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSourceChainAssetsLockedEvents() []*AssetsLockedRecord {
+	if m != nil {
+		return m.SourceChainAssetsLockedEvents
+	}
+	return nil
+}
+
+// SourceChainState defines the bridge-in state of an additional source chain.
+type SourceChainState struct {
+	// chain is the source chain.
+	Chain SourceChain `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	// assets_locked_sequence_tip is the sequence number of the last AssetsLocked
+	// event of the source chain processed by the x/bridge module.
+	AssetsLockedSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=assets_locked_sequence_tip,json=assetsLockedSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"assets_locked_sequence_tip"`
+	// erc20_tokens_mappings are the ERC20 token mappings of the source chain.
+	Erc20TokensMappings []ERC20TokenMapping `protobuf:"bytes,3,rep,name=erc20_tokens_mappings,json=erc20TokensMappings,proto3" json:"erc20_tokens_mappings"`
+	// assets_locked_pruned_sequence_tip is the sequence number of the last
+	// AssetsLocked event of the source chain pruned from the module state.
+	AssetsLockedPrunedSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=assets_locked_pruned_sequence_tip,json=assetsLockedPrunedSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"assets_locked_pruned_sequence_tip"`
+	// minted are the cumulative amounts minted by the bridge for AssetsLocked
+	// events of the source chain, per Mezo token. BTC is tracked under the BTC
+	// token precompile address.
+	Minted []SourceChainMinted `protobuf:"bytes,5,rep,name=minted,proto3" json:"minted"`
+}
+
+func (m *SourceChainState) Reset()         { *m = SourceChainState{} }
+func (m *SourceChainState) String() string { return proto.CompactTextString(m) }
+func (*SourceChainState) ProtoMessage()    {}
+func (*SourceChainState) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c6a9d1c622979efc, []int{1}
 }
-func (m *CurrentOutflowAmount) XXX_Unmarshal(b []byte) error {
+func (m *SourceChainState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CurrentOutflowAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SourceChainState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CurrentOutflowAmount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SourceChainState.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -273,45 +506,61 @@ func (m *CurrentOutflowAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CurrentOutflowAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentOutflowAmount.Merge(m, src)
+func (m *SourceChainState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChainState.Merge(m, src)
 }
-func (m *CurrentOutflowAmount) XXX_Size() int {
+func (m *SourceChainState) XXX_Size() int {
 	return m.Size()
 }
-func (m *CurrentOutflowAmount) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentOutflowAmount.DiscardUnknown(m)
+func (m *SourceChainState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChainState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentOutflowAmount proto.InternalMessageInfo
+var xxx_messageInfo_SourceChainState proto.InternalMessageInfo
 
-func (m *CurrentOutflowAmount) GetToken() string {
+func (m *SourceChainState) GetChain() SourceChain {
 	if m != nil {
-		return m.Token
+		return m.Chain
 	}
-	return ""
+	return SourceChain{}
 }
 
-// CurrentOutflowLimit tracks the current outflow limit for a specific token.
-type CurrentOutflowLimit struct {
-	// token is the token's hex-encoded EVM address.
+func (m *SourceChainState) GetErc20TokensMappings() []ERC20TokenMapping {
+	if m != nil {
+		return m.Erc20TokensMappings
+	}
+	return nil
+}
+
+func (m *SourceChainState) GetMinted() []SourceChainMinted {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// SourceChainMinted tracks the cumulative amount of a specific Mezo token
+// minted by the bridge for AssetsLocked events of an additional source chain.
+type SourceChainMinted struct {
+	// token is the Mezo token's hex-encoded EVM address.
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// limit is the current outflow limit for this token.
-	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// amount is the cumulative amount of this token minted for the source
+	// chain.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *CurrentOutflowLimit) Reset()         { *m = CurrentOutflowLimit{} }
-func (m *CurrentOutflowLimit) String() string { return proto.CompactTextString(m) }
-func (*CurrentOutflowLimit) ProtoMessage()    {}
-func (*CurrentOutflowLimit) Descriptor() ([]byte, []int) {
+func (m *SourceChainMinted) Reset()         { *m = SourceChainMinted{} }
+func (m *SourceChainMinted) String() string { return proto.CompactTextString(m) }
+func (*SourceChainMinted) ProtoMessage()    {}
+func (*SourceChainMinted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c6a9d1c622979efc, []int{2}
 }
-func (m *CurrentOutflowLimit) XXX_Unmarshal(b []byte) error {
+func (m *SourceChainMinted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CurrentOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SourceChainMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CurrentOutflowLimit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SourceChainMinted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -321,46 +570,45 @@ func (m *CurrentOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CurrentOutflowLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentOutflowLimit.Merge(m, src)
+func (m *SourceChainMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceChainMinted.Merge(m, src)
 }
-func (m *CurrentOutflowLimit) XXX_Size() int {
+func (m *SourceChainMinted) XXX_Size() int {
 	return m.Size()
 }
-func (m *CurrentOutflowLimit) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentOutflowLimit.DiscardUnknown(m)
+func (m *SourceChainMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceChainMinted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentOutflowLimit proto.InternalMessageInfo
+var xxx_messageInfo_SourceChainMinted proto.InternalMessageInfo
 
-func (m *CurrentOutflowLimit) GetToken() string {
+func (m *SourceChainMinted) GetToken() string {
 	if m != nil {
 		return m.Token
 	}
 	return ""
 }
 
-// TokenMinBridgeOutAmount defines the minimum bridge out amount for a specific
-// token.
-type TokenMinBridgeOutAmount struct {
+// TokenOutflowWindow defines the rolling outflow window of a specific token.
+type TokenOutflowWindow struct {
 	// token is the token's hex-encoded EVM address.
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// amount is the minimum amount required for bridging out this specific token.
-	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// window is the rolling outflow window of this token.
+	Window OutflowWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window"`
 }
 
-func (m *TokenMinBridgeOutAmount) Reset()         { *m = TokenMinBridgeOutAmount{} }
-func (m *TokenMinBridgeOutAmount) String() string { return proto.CompactTextString(m) }
-func (*TokenMinBridgeOutAmount) ProtoMessage()    {}
-func (*TokenMinBridgeOutAmount) Descriptor() ([]byte, []int) {
+func (m *TokenOutflowWindow) Reset()         { *m = TokenOutflowWindow{} }
+func (m *TokenOutflowWindow) String() string { return proto.CompactTextString(m) }
+func (*TokenOutflowWindow) ProtoMessage()    {}
+func (*TokenOutflowWindow) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c6a9d1c622979efc, []int{3}
 }
-func (m *TokenMinBridgeOutAmount) XXX_Unmarshal(b []byte) error {
+func (m *TokenOutflowWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenMinBridgeOutAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenOutflowWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenMinBridgeOutAmount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenOutflowWindow.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -370,46 +618,56 @@ func (m *TokenMinBridgeOutAmount) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *TokenMinBridgeOutAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenMinBridgeOutAmount.Merge(m, src)
+func (m *TokenOutflowWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenOutflowWindow.Merge(m, src)
 }
-func (m *TokenMinBridgeOutAmount) XXX_Size() int {
+func (m *TokenOutflowWindow) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenMinBridgeOutAmount) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenMinBridgeOutAmount.DiscardUnknown(m)
+func (m *TokenOutflowWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenOutflowWindow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenMinBridgeOutAmount proto.InternalMessageInfo
+var xxx_messageInfo_TokenOutflowWindow proto.InternalMessageInfo
 
-func (m *TokenMinBridgeOutAmount) GetToken() string {
+func (m *TokenOutflowWindow) GetToken() string {
 	if m != nil {
 		return m.Token
 	}
 	return ""
 }
 
-// TripartyControllerBTCMinted tracks the BTC minted through the triparty
-// bridge path by a specific controller.
-type TripartyControllerBTCMinted struct {
-	// controller is the controller's hex-encoded EVM address.
-	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
-	// amount is the cumulative BTC minted by this controller through triparty.
-	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+func (m *TokenOutflowWindow) GetWindow() OutflowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return OutflowWindow{}
 }
 
-func (m *TripartyControllerBTCMinted) Reset()         { *m = TripartyControllerBTCMinted{} }
-func (m *TripartyControllerBTCMinted) String() string { return proto.CompactTextString(m) }
-func (*TripartyControllerBTCMinted) ProtoMessage()    {}
-func (*TripartyControllerBTCMinted) Descriptor() ([]byte, []int) {
+// OutflowBucket tracks the outflow accumulated in a single rolling window
+// bucket.
+type OutflowBucket struct {
+	// token is the token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// index is the index of the bucket, i.e. the height of its first block
+	// divided by the bucket length.
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// amount is the outflow accumulated in this bucket.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *OutflowBucket) Reset()         { *m = OutflowBucket{} }
+func (m *OutflowBucket) String() string { return proto.CompactTextString(m) }
+func (*OutflowBucket) ProtoMessage()    {}
+func (*OutflowBucket) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c6a9d1c622979efc, []int{4}
 }
-func (m *TripartyControllerBTCMinted) XXX_Unmarshal(b []byte) error {
+func (m *OutflowBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TripartyControllerBTCMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OutflowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TripartyControllerBTCMinted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OutflowBucket.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -419,284 +677,630 @@ func (m *TripartyControllerBTCMinted) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *TripartyControllerBTCMinted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TripartyControllerBTCMinted.Merge(m, src)
+func (m *OutflowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowBucket.Merge(m, src)
 }
-func (m *TripartyControllerBTCMinted) XXX_Size() int {
+func (m *OutflowBucket) XXX_Size() int {
 	return m.Size()
 }
-func (m *TripartyControllerBTCMinted) XXX_DiscardUnknown() {
-	xxx_messageInfo_TripartyControllerBTCMinted.DiscardUnknown(m)
+func (m *OutflowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowBucket.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TripartyControllerBTCMinted proto.InternalMessageInfo
+var xxx_messageInfo_OutflowBucket proto.InternalMessageInfo
 
-func (m *TripartyControllerBTCMinted) GetController() string {
+func (m *OutflowBucket) GetToken() string {
 	if m != nil {
-		return m.Controller
+		return m.Token
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "mezo.bridge.v1.GenesisState")
-	proto.RegisterType((*CurrentOutflowAmount)(nil), "mezo.bridge.v1.CurrentOutflowAmount")
-	proto.RegisterType((*CurrentOutflowLimit)(nil), "mezo.bridge.v1.CurrentOutflowLimit")
-	proto.RegisterType((*TokenMinBridgeOutAmount)(nil), "mezo.bridge.v1.TokenMinBridgeOutAmount")
-	proto.RegisterType((*TripartyControllerBTCMinted)(nil), "mezo.bridge.v1.TripartyControllerBTCMinted")
+func (m *OutflowBucket) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("mezo/bridge/v1/genesis.proto", fileDescriptor_c6a9d1c622979efc) }
-
-var fileDescriptor_c6a9d1c622979efc = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5b, 0x73, 0xdb, 0x44,
-	0x14, 0x8e, 0xb0, 0x1b, 0x9c, 0x4d, 0x9b, 0xb8, 0x1b, 0x3b, 0xde, 0x38, 0xae, 0x63, 0x52, 0x18,
-	0x34, 0x5c, 0xec, 0x34, 0x85, 0x27, 0x5e, 0xa8, 0x4d, 0x87, 0x69, 0x68, 0xa6, 0x41, 0x49, 0xa7,
-	0x43, 0x87, 0x41, 0x95, 0xa5, 0xad, 0xb3, 0x44, 0xda, 0x15, 0xda, 0x55, 0x42, 0xf8, 0x15, 0xfc,
-	0xac, 0xbe, 0xd1, 0x47, 0x86, 0x87, 0x0e, 0x93, 0xfc, 0x11, 0x66, 0x2f, 0xf2, 0x45, 0x76, 0x18,
-	0x0d, 0xc3, 0x9b, 0x7d, 0xce, 0x77, 0xbe, 0x73, 0x3f, 0x2b, 0xd0, 0x8a, 0xf0, 0x6f, 0xac, 0x37,
-	0x4c, 0x48, 0x30, 0xc2, 0xbd, 0xf3, 0x07, 0xbd, 0x11, 0xa6, 0x98, 0x13, 0xde, 0x8d, 0x13, 0x26,
-	0x18, 0x5c, 0x93, 0xda, 0xae, 0xd6, 0x76, 0xcf, 0x1f, 0x34, 0x6b, 0x23, 0x36, 0x62, 0x4a, 0xd5,
-	0x93, 0xbf, 0x34, 0xaa, 0xb9, 0x9d, 0xe3, 0x30, 0x78, 0xa5, 0xdc, 0xfd, 0x63, 0x1d, 0xdc, 0xfe,
-	0x56, 0x93, 0x1e, 0x0b, 0x4f, 0x60, 0xf8, 0x05, 0x58, 0x8e, 0xbd, 0xc4, 0x8b, 0x38, 0xb2, 0x3a,
-	0x96, 0xbd, 0xba, 0xbf, 0xd9, 0x9d, 0x75, 0xd2, 0x3d, 0x52, 0xda, 0x7e, 0xf9, 0xcd, 0xbb, 0x9d,
-	0x25, 0xc7, 0x60, 0xe1, 0x4b, 0xd0, 0xf4, 0x38, 0xc7, 0x82, 0xbb, 0x21, 0xf3, 0xcf, 0x70, 0xe0,
-	0x72, 0xfc, 0x4b, 0x8a, 0xa9, 0x8f, 0x5d, 0x41, 0x62, 0xf4, 0x5e, 0xc7, 0xb2, 0x57, 0xfa, 0xf7,
-	0xa4, 0xc5, 0x5f, 0xef, 0x76, 0xea, 0x3e, 0xe3, 0x11, 0xe3, 0x3c, 0x38, 0xeb, 0x12, 0xd6, 0x8b,
-	0x3c, 0x71, 0xda, 0x7d, 0x42, 0x85, 0xd3, 0xd0, 0x04, 0x4f, 0x95, 0xfd, 0xb1, 0x31, 0x3f, 0x21,
-	0x31, 0xb4, 0x41, 0x95, 0xb3, 0x34, 0xf1, 0xb1, 0x3b, 0x14, 0xbe, 0x2b, 0xd8, 0x19, 0xa6, 0xa8,
-	0x24, 0x19, 0x9d, 0x35, 0x2d, 0xef, 0x0b, 0xff, 0x44, 0x4a, 0xe1, 0x73, 0x50, 0xc7, 0x89, 0xbf,
-	0xbf, 0xa7, 0x41, 0xdc, 0x8d, 0xbc, 0x38, 0x26, 0x74, 0xc4, 0x51, 0xb9, 0x53, 0xb2, 0x57, 0xf7,
-	0x3f, 0xc8, 0xa7, 0xf2, 0xd8, 0x19, 0xec, 0xef, 0x29, 0xd3, 0x43, 0x8d, 0x74, 0x36, 0x94, 0xbd,
-	0x12, 0x71, 0x23, 0xe3, 0xf0, 0x3b, 0x00, 0x09, 0x25, 0x82, 0x78, 0xa1, 0x8a, 0x80, 0xa7, 0x71,
-	0x1c, 0x5e, 0xa2, 0x5b, 0x45, 0x92, 0xaa, 0x1a, 0xc3, 0xbe, 0xf0, 0x8f, 0x95, 0x19, 0xfc, 0x09,
-	0xb4, 0x4c, 0xa5, 0x52, 0xba, 0xa8, 0x56, 0xcb, 0x45, 0x68, 0xb7, 0x34, 0xc5, 0x73, 0xc3, 0x30,
-	0x5d, 0xad, 0x1f, 0xc0, 0x66, 0x9e, 0x1f, 0x9f, 0x63, 0x2a, 0x38, 0x7a, 0x5f, 0x15, 0xe1, 0x7e,
-	0xbe, 0x08, 0x8f, 0x66, 0xa8, 0x1e, 0x4b, 0xac, 0x53, 0xf3, 0xe6, 0x85, 0x1c, 0xfe, 0x0c, 0xee,
-	0x0f, 0x89, 0xf0, 0x19, 0xa1, 0xae, 0x7f, 0xea, 0x11, 0xea, 0x46, 0x84, 0xba, 0x9a, 0xc8, 0x65,
-	0xa9, 0x70, 0xbd, 0x88, 0xa5, 0x54, 0xa0, 0x4a, 0x91, 0x0c, 0xda, 0x86, 0x69, 0x20, 0x89, 0x0e,
-	0x09, 0xed, 0x2b, 0x9a, 0x67, 0xa9, 0x78, 0xa4, 0x48, 0xe0, 0x08, 0xb4, 0x54, 0x13, 0x17, 0xfb,
-	0xe0, 0x68, 0x45, 0x25, 0xf3, 0x71, 0x3e, 0x19, 0xdd, 0xcc, 0x39, 0x3a, 0x07, 0x89, 0xc5, 0x0a,
-	0x0e, 0x3f, 0x03, 0x30, 0xf4, 0xb8, 0x90, 0xe4, 0xaf, 0x43, 0x76, 0xe1, 0x26, 0x98, 0x63, 0x81,
-	0x56, 0x3b, 0x96, 0x5d, 0x76, 0xaa, 0x52, 0xf3, 0x4c, 0x2b, 0x1c, 0x29, 0x87, 0x3f, 0x82, 0x86,
-	0x9f, 0x26, 0x09, 0xa6, 0x13, 0x83, 0x2c, 0xa2, 0xdb, 0x2a, 0xa2, 0x0f, 0xf3, 0x11, 0x0d, 0x34,
-	0xdc, 0xb0, 0x98, 0x70, 0xea, 0xfe, 0x02, 0x29, 0x97, 0xbd, 0xcb, 0xb3, 0x87, 0x24, 0x22, 0x82,
-	0xa3, 0x3b, 0x8b, 0x7b, 0x37, 0x4b, 0xfe, 0x54, 0x62, 0x9d, 0x9a, 0x3f, 0x2f, 0xe4, 0xf0, 0x6b,
-	0xd0, 0xf2, 0xc2, 0x90, 0x5d, 0xe0, 0xc0, 0x15, 0x09, 0x89, 0xbd, 0x44, 0x5c, 0xba, 0x3e, 0xa3,
-	0x22, 0x61, 0x61, 0x88, 0x13, 0x8e, 0xd6, 0x3a, 0x25, 0x7b, 0xc5, 0x69, 0x1a, 0xcc, 0x89, 0x81,
-	0x0c, 0x26, 0x08, 0xb8, 0x07, 0x6a, 0x63, 0xcb, 0xa1, 0x9c, 0x0b, 0x37, 0xc0, 0xa1, 0x77, 0x89,
-	0xaa, 0x1d, 0xcb, 0x2e, 0x39, 0x30, 0xd3, 0xf5, 0xa5, 0xea, 0x1b, 0xa9, 0x91, 0x47, 0x61, 0x6c,
-	0x11, 0xe3, 0xc4, 0x4d, 0xe4, 0x98, 0x72, 0xa1, 0x73, 0x42, 0x77, 0x0b, 0x1d, 0x85, 0x8c, 0xe0,
-	0x08, 0x27, 0x8e, 0x36, 0x57, 0x09, 0xc1, 0xef, 0x41, 0x7d, 0xcc, 0x7d, 0x41, 0x68, 0x90, 0x95,
-	0x0a, 0xc1, 0x22, 0xb4, 0x1b, 0x99, 0xed, 0x0b, 0x65, 0xaa, 0x29, 0x5f, 0x81, 0x7b, 0x63, 0xca,
-	0x2c, 0xd4, 0x99, 0xd5, 0xdc, 0x28, 0x42, 0x3d, 0x4e, 0xd9, 0x84, 0x3b, 0xbd, 0x9b, 0x01, 0xd8,
-	0x99, 0x14, 0x24, 0x61, 0x3e, 0xe6, 0x3c, 0xbf, 0xfe, 0xb5, 0x22, 0x3e, 0x5a, 0xe3, 0xaa, 0x64,
-	0x24, 0xd3, 0x5e, 0x3c, 0xb0, 0x35, 0x55, 0x76, 0x1a, 0x10, 0x3a, 0xca, 0xf2, 0xe1, 0xa8, 0xae,
-	0x06, 0xe9, 0xa3, 0xb9, 0xbd, 0xc9, 0xba, 0xa7, 0x24, 0x26, 0xf4, 0xe9, 0xea, 0x2b, 0x1a, 0x23,
-	0xe7, 0xf0, 0x05, 0x40, 0xf9, 0xea, 0xfb, 0x8c, 0xf2, 0x34, 0xc2, 0x01, 0xda, 0x2c, 0x92, 0xc1,
-	0xe6, 0x6c, 0x03, 0x06, 0xc6, 0x18, 0x7e, 0x35, 0x35, 0x32, 0x59, 0x5b, 0xe5, 0x76, 0xea, 0xad,
-	0x6c, 0xa8, 0xad, 0x6c, 0xe4, 0x9a, 0xe7, 0x71, 0xa1, 0x97, 0x33, 0x06, 0xed, 0x05, 0xb3, 0xad,
-	0x6e, 0x76, 0x44, 0xa8, 0xc0, 0x01, 0xda, 0x52, 0xd9, 0x7f, 0x7a, 0x53, 0xf6, 0x93, 0x71, 0xef,
-	0x9f, 0x0c, 0x0e, 0x95, 0x89, 0xb3, 0x2d, 0xe6, 0x95, 0xc2, 0xd7, 0x4a, 0xf8, 0x09, 0xb8, 0x3b,
-	0x75, 0x9b, 0x62, 0x2f, 0xe5, 0x38, 0x40, 0xcd, 0x8e, 0x65, 0x57, 0x9c, 0xf5, 0x61, 0x76, 0x69,
-	0x8e, 0x94, 0x58, 0x3e, 0x63, 0x06, 0x4b, 0x68, 0x06, 0xdd, 0x56, 0xd0, 0x35, 0x2d, 0x7f, 0x42,
-	0x0d, 0x72, 0x96, 0x55, 0x9d, 0x5a, 0x8e, 0x5a, 0x9d, 0x92, 0x7d, 0x67, 0x8a, 0x55, 0x1d, 0x4e,
-	0x7e, 0x50, 0xae, 0x80, 0xea, 0xea, 0x41, 0xb9, 0xb2, 0x5e, 0xad, 0x1e, 0x94, 0x2b, 0xa8, 0xba,
-	0xb5, 0xeb, 0x83, 0xda, 0xa2, 0x9b, 0x03, 0x6b, 0xe0, 0x96, 0x7e, 0x3b, 0x2d, 0xf5, 0x76, 0xea,
-	0x3f, 0xf0, 0x4b, 0xb0, 0x6c, 0xce, 0x76, 0xa1, 0x47, 0xda, 0x80, 0x77, 0x5f, 0x81, 0x8d, 0x05,
-	0xb7, 0xe7, 0x06, 0x1f, 0x0f, 0xc1, 0x2d, 0xbd, 0x9b, 0x85, 0x5c, 0x68, 0xec, 0xee, 0x6b, 0xd0,
-	0xb8, 0xe1, 0x98, 0xff, 0xbf, 0x99, 0x08, 0xb0, 0xfd, 0x2f, 0xed, 0x87, 0x6d, 0x00, 0x26, 0xa3,
-	0x64, 0x1c, 0x4e, 0x49, 0xfe, 0xa3, 0xd7, 0x7e, 0xff, 0xcd, 0x55, 0xdb, 0x7a, 0x7b, 0xd5, 0xb6,
-	0xfe, 0xbe, 0x6a, 0x5b, 0xbf, 0x5f, 0xb7, 0x97, 0xde, 0x5e, 0xb7, 0x97, 0xfe, 0xbc, 0x6e, 0x2f,
-	0xbd, 0xb4, 0x47, 0x44, 0x9c, 0xa6, 0xc3, 0xae, 0xcf, 0xa2, 0x9e, 0x1c, 0xd3, 0xcf, 0x59, 0x32,
-	0x52, 0x3f, 0x82, 0xde, 0xaf, 0xd9, 0x47, 0x9c, 0xb8, 0x8c, 0x31, 0x1f, 0x2e, 0xab, 0x2f, 0xb8,
-	0x87, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x6f, 0xff, 0xf9, 0x24, 0x0a, 0x00, 0x00,
+// CurrentOutflowAmount tracks the current outflow amount for a specific token.
+type CurrentOutflowAmount struct {
+	// token is the token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// amount is the current outflow amount for this token.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CurrentOutflowAmount) Reset()         { *m = CurrentOutflowAmount{} }
+func (m *CurrentOutflowAmount) String() string { return proto.CompactTextString(m) }
+func (*CurrentOutflowAmount) ProtoMessage()    {}
+func (*CurrentOutflowAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{5}
+}
+func (m *CurrentOutflowAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentOutflowAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentOutflowAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CurrentOutflowAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentOutflowAmount.Merge(m, src)
 }
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BridgeOutChains) > 0 {
-		dAtA2 := make([]byte, len(m.BridgeOutChains)*10)
-		var j1 int
-		for _, num := range m.BridgeOutChains {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xe2
+func (m *CurrentOutflowAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentOutflowAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentOutflowAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrentOutflowAmount proto.InternalMessageInfo
+
+func (m *CurrentOutflowAmount) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if m.BridgeInPaused {
-		i--
-		if m.BridgeInPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// CurrentOutflowLimit tracks the current outflow limit for a specific token.
+type CurrentOutflowLimit struct {
+	// token is the token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// limit is the current outflow limit for this token.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+}
+
+func (m *CurrentOutflowLimit) Reset()         { *m = CurrentOutflowLimit{} }
+func (m *CurrentOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*CurrentOutflowLimit) ProtoMessage()    {}
+func (*CurrentOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{6}
+}
+func (m *CurrentOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd8
+		return b[:n], nil
 	}
-	if m.BridgeOutPaused {
-		i--
-		if m.BridgeOutPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
+}
+func (m *CurrentOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentOutflowLimit.Merge(m, src)
+}
+func (m *CurrentOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrentOutflowLimit proto.InternalMessageInfo
+
+func (m *CurrentOutflowLimit) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.TripartyControllerBtcMinted) > 0 {
-		for iNdEx := len(m.TripartyControllerBtcMinted) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TripartyControllerBtcMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
+	return ""
+}
+
+// SenderOutflowLimit defines the maximum amount of a specific token a single
+// sender can bridge out in a per-sender window.
+type SenderOutflowLimit struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// limit is the per-sender outflow limit for this token. Zero means the
+	// amount is not limited.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+}
+
+func (m *SenderOutflowLimit) Reset()         { *m = SenderOutflowLimit{} }
+func (m *SenderOutflowLimit) String() string { return proto.CompactTextString(m) }
+func (*SenderOutflowLimit) ProtoMessage()    {}
+func (*SenderOutflowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{7}
+}
+func (m *SenderOutflowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderOutflowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderOutflowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TripartyWindowLastReset != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TripartyWindowLastReset))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+}
+func (m *SenderOutflowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderOutflowLimit.Merge(m, src)
+}
+func (m *SenderOutflowLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderOutflowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderOutflowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderOutflowLimit proto.InternalMessageInfo
+
+func (m *SenderOutflowLimit) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	{
-		size := m.TripartyWindowConsumed.Size()
-		i -= size
-		if _, err := m.TripartyWindowConsumed.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// DelayedBridgeOutThreshold defines the delayed bridge-out threshold of
+// a specific token.
+type DelayedBridgeOutThreshold struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// threshold is the minimum amount of a bridge-out held in the delayed
+	// bridge-out queue.
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+}
+
+func (m *DelayedBridgeOutThreshold) Reset()         { *m = DelayedBridgeOutThreshold{} }
+func (m *DelayedBridgeOutThreshold) String() string { return proto.CompactTextString(m) }
+func (*DelayedBridgeOutThreshold) ProtoMessage()    {}
+func (*DelayedBridgeOutThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{8}
+}
+func (m *DelayedBridgeOutThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedBridgeOutThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedBridgeOutThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xb2
-	if len(m.TripartyPendingRequests) > 0 {
-		for iNdEx := len(m.TripartyPendingRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TripartyPendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
+}
+func (m *DelayedBridgeOutThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedBridgeOutThreshold.Merge(m, src)
+}
+func (m *DelayedBridgeOutThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedBridgeOutThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedBridgeOutThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedBridgeOutThreshold proto.InternalMessageInfo
+
+func (m *DelayedBridgeOutThreshold) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	{
-		size := m.TripartyProcessedSequenceTip.Size()
-		i -= size
-		if _, err := m.TripartyProcessedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// TokenMinBridgeOutAmount defines the minimum bridge out amount for a specific
+// token.
+type TokenMinBridgeOutAmount struct {
+	// token is the token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// amount is the minimum amount required for bridging out this specific token.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *TokenMinBridgeOutAmount) Reset()         { *m = TokenMinBridgeOutAmount{} }
+func (m *TokenMinBridgeOutAmount) String() string { return proto.CompactTextString(m) }
+func (*TokenMinBridgeOutAmount) ProtoMessage()    {}
+func (*TokenMinBridgeOutAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{9}
+}
+func (m *TokenMinBridgeOutAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMinBridgeOutAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMinBridgeOutAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xa2
-	{
-		size := m.TripartyRequestSequenceTip.Size()
-		i -= size
-		if _, err := m.TripartyRequestSequenceTip.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+}
+func (m *TokenMinBridgeOutAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMinBridgeOutAmount.Merge(m, src)
+}
+func (m *TokenMinBridgeOutAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMinBridgeOutAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMinBridgeOutAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMinBridgeOutAmount proto.InternalMessageInfo
+
+func (m *TokenMinBridgeOutAmount) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x9a
-	{
-		size := m.TripartyWindowLimit.Size()
-		i -= size
-		if _, err := m.TripartyWindowLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// TripartyControllerBTCMinted tracks the BTC minted through the triparty
+// bridge path by a specific controller.
+type TripartyControllerBTCMinted struct {
+	// controller is the controller's hex-encoded EVM address.
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// amount is the cumulative BTC minted by this controller through triparty.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *TripartyControllerBTCMinted) Reset()         { *m = TripartyControllerBTCMinted{} }
+func (m *TripartyControllerBTCMinted) String() string { return proto.CompactTextString(m) }
+func (*TripartyControllerBTCMinted) ProtoMessage()    {}
+func (*TripartyControllerBTCMinted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{10}
+}
+func (m *TripartyControllerBTCMinted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TripartyControllerBTCMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TripartyControllerBTCMinted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
+	}
+}
+func (m *TripartyControllerBTCMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripartyControllerBTCMinted.Merge(m, src)
+}
+func (m *TripartyControllerBTCMinted) XXX_Size() int {
+	return m.Size()
+}
+func (m *TripartyControllerBTCMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripartyControllerBTCMinted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripartyControllerBTCMinted proto.InternalMessageInfo
+
+func (m *TripartyControllerBTCMinted) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+// ERC20Supply tracks the amounts of a specific ERC20 token minted and burnt
+// by the bridge.
+type ERC20Supply struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// minted is the cumulative amount of this token minted by the bridge.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// burnt is the cumulative amount of this token burnt by the bridge.
+	Burnt cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=burnt,proto3,customtype=cosmossdk.io/math.Int" json:"burnt"`
+}
+
+func (m *ERC20Supply) Reset()         { *m = ERC20Supply{} }
+func (m *ERC20Supply) String() string { return proto.CompactTextString(m) }
+func (*ERC20Supply) ProtoMessage()    {}
+func (*ERC20Supply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9d1c622979efc, []int{11}
+}
+func (m *ERC20Supply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Supply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Supply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Supply.Merge(m, src)
+}
+func (m *ERC20Supply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Supply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Supply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Supply proto.InternalMessageInfo
+
+func (m *ERC20Supply) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "mezo.bridge.v1.GenesisState")
+	proto.RegisterType((*SourceChainState)(nil), "mezo.bridge.v1.SourceChainState")
+	proto.RegisterType((*SourceChainMinted)(nil), "mezo.bridge.v1.SourceChainMinted")
+	proto.RegisterType((*TokenOutflowWindow)(nil), "mezo.bridge.v1.TokenOutflowWindow")
+	proto.RegisterType((*OutflowBucket)(nil), "mezo.bridge.v1.OutflowBucket")
+	proto.RegisterType((*CurrentOutflowAmount)(nil), "mezo.bridge.v1.CurrentOutflowAmount")
+	proto.RegisterType((*CurrentOutflowLimit)(nil), "mezo.bridge.v1.CurrentOutflowLimit")
+	proto.RegisterType((*SenderOutflowLimit)(nil), "mezo.bridge.v1.SenderOutflowLimit")
+	proto.RegisterType((*DelayedBridgeOutThreshold)(nil), "mezo.bridge.v1.DelayedBridgeOutThreshold")
+	proto.RegisterType((*TokenMinBridgeOutAmount)(nil), "mezo.bridge.v1.TokenMinBridgeOutAmount")
+	proto.RegisterType((*TripartyControllerBTCMinted)(nil), "mezo.bridge.v1.TripartyControllerBTCMinted")
+	proto.RegisterType((*ERC20Supply)(nil), "mezo.bridge.v1.ERC20Supply")
+}
+
+func init() { proto.RegisterFile("mezo/bridge/v1/genesis.proto", fileDescriptor_c6a9d1c622979efc) }
+
+var fileDescriptor_c6a9d1c622979efc = []byte{
+	// 1739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdb, 0x53, 0xdb, 0xca,
+	0x19, 0xc7, 0xb1, 0xa1, 0xb0, 0x04, 0xb0, 0x17, 0x03, 0xcb, 0xcd, 0x18, 0xe7, 0xe6, 0xdc, 0x6c,
+	0x42, 0x92, 0xe9, 0x74, 0xf2, 0xd0, 0xc6, 0x24, 0xb4, 0x21, 0x61, 0x42, 0x04, 0x69, 0xa6, 0x69,
+	0x5a, 0x45, 0x96, 0x16, 0xa3, 0x22, 0x4b, 0xaa, 0xbe, 0x55, 0x12, 0xfa, 0x4f, 0xb4, 0x7f, 0x49,
+	0xdf, 0xfb, 0x1f, 0xe4, 0x31, 0x8f, 0x9d, 0xf3, 0x90, 0x39, 0x93, 0xfc, 0x23, 0x67, 0xb4, 0xbb,
+	0xb2, 0x75, 0x33, 0xa3, 0x73, 0x86, 0xf3, 0x26, 0x7d, 0x97, 0xdf, 0x77, 0xd9, 0x6f, 0xbf, 0xfd,
+	0x76, 0xd1, 0x5a, 0x9f, 0xfe, 0xcb, 0x69, 0x77, 0x3d, 0xd3, 0xe8, 0xd1, 0xf6, 0x87, 0x7b, 0xed,
+	0x1e, 0xb5, 0x29, 0x98, 0xd0, 0x72, 0x3d, 0x87, 0x39, 0x78, 0x36, 0xe0, 0xb6, 0x04, 0xb7, 0xf5,
+	0xe1, 0xde, 0x4a, 0xb5, 0xe7, 0xf4, 0x1c, 0xce, 0x6a, 0x07, 0x5f, 0x42, 0x6a, 0x65, 0x35, 0x81,
+	0x21, 0xe5, 0x39, 0xb3, 0xf1, 0xbf, 0x3a, 0xba, 0xfc, 0x47, 0x01, 0x7a, 0xc8, 0x34, 0x46, 0xf1,
+	0x03, 0x34, 0xe1, 0x6a, 0x9e, 0xd6, 0x07, 0x52, 0xa8, 0x17, 0x9a, 0xd3, 0xdb, 0x8b, 0xad, 0xb8,
+	0x91, 0xd6, 0x01, 0xe7, 0x76, 0x4a, 0x9f, 0xbf, 0x6e, 0x8c, 0x29, 0x52, 0x16, 0xbf, 0x45, 0x2b,
+	0x1a, 0x00, 0x65, 0xa0, 0x5a, 0x8e, 0x7e, 0x4a, 0x0d, 0x15, 0xe8, 0x3f, 0x7d, 0x6a, 0xeb, 0x54,
+	0x65, 0xa6, 0x4b, 0x2e, 0xd5, 0x0b, 0xcd, 0xa9, 0xce, 0x7a, 0xa0, 0xf1, 0xc3, 0xd7, 0x8d, 0x05,
+	0xdd, 0x81, 0xbe, 0x03, 0x60, 0x9c, 0xb6, 0x4c, 0xa7, 0xdd, 0xd7, 0xd8, 0x49, 0xeb, 0x99, 0xcd,
+	0x94, 0x25, 0x01, 0xf0, 0x82, 0xeb, 0x1f, 0x4a, 0xf5, 0x23, 0xd3, 0xc5, 0x4d, 0x54, 0x06, 0xc7,
+	0xf7, 0x74, 0xaa, 0x76, 0x99, 0xae, 0x32, 0xe7, 0x94, 0xda, 0xa4, 0x18, 0x20, 0x2a, 0xb3, 0x82,
+	0xde, 0x61, 0xfa, 0x51, 0x40, 0xc5, 0xaf, 0xd1, 0x02, 0xf5, 0xf4, 0xed, 0x2d, 0x21, 0x04, 0x6a,
+	0x5f, 0x73, 0x5d, 0xd3, 0xee, 0x01, 0x29, 0xd5, 0x8b, 0xcd, 0xe9, 0xed, 0xcd, 0x64, 0x28, 0x4f,
+	0x95, 0x9d, 0xed, 0x2d, 0xae, 0xba, 0x2f, 0x24, 0x95, 0x79, 0xae, 0xcf, 0x49, 0x20, 0x69, 0x80,
+	0x9f, 0x23, 0x6c, 0xda, 0x26, 0x33, 0x35, 0x8b, 0x7b, 0x00, 0xbe, 0xeb, 0x5a, 0x67, 0x64, 0x3c,
+	0x4f, 0x50, 0x65, 0xa9, 0xd8, 0x61, 0xfa, 0x21, 0x57, 0xc3, 0x7f, 0x47, 0x6b, 0x32, 0x53, 0xbe,
+	0x9d, 0x95, 0xab, 0x89, 0x3c, 0xb0, 0xcb, 0x02, 0xe2, 0xb5, 0x6d, 0xa5, 0xb2, 0xf5, 0x17, 0xb4,
+	0x98, 0xc4, 0xa7, 0x1f, 0xa8, 0xcd, 0x80, 0xfc, 0x86, 0x27, 0xe1, 0x4a, 0x32, 0x09, 0x8f, 0x63,
+	0x50, 0x4f, 0x03, 0x59, 0xa5, 0xaa, 0xa5, 0x89, 0x80, 0xff, 0x81, 0xae, 0x74, 0x4d, 0xa6, 0x3b,
+	0xa6, 0xad, 0xea, 0x27, 0x9a, 0x69, 0xab, 0x7d, 0xd3, 0x56, 0x05, 0x90, 0xea, 0xf8, 0x4c, 0xd5,
+	0xfa, 0x8e, 0x6f, 0x33, 0x32, 0x99, 0x27, 0x82, 0x9a, 0x44, 0xda, 0x09, 0x80, 0xf6, 0x4d, 0xbb,
+	0xc3, 0x61, 0x5e, 0xfa, 0xec, 0x31, 0x07, 0xc1, 0x3d, 0xb4, 0xc6, 0x17, 0x31, 0xdb, 0x06, 0x90,
+	0x29, 0x1e, 0xcc, 0x8d, 0x64, 0x30, 0x62, 0x31, 0x53, 0x70, 0x0a, 0x61, 0xd9, 0x0c, 0xc0, 0x77,
+	0x10, 0xb6, 0x34, 0x60, 0x01, 0xf8, 0xb1, 0xe5, 0x7c, 0x54, 0x3d, 0x0a, 0x94, 0x91, 0xe9, 0x7a,
+	0xa1, 0x59, 0x52, 0xca, 0x01, 0xe7, 0xa5, 0x60, 0x28, 0x01, 0x1d, 0xbf, 0x43, 0x4b, 0xba, 0xef,
+	0x79, 0xd4, 0x1e, 0x2a, 0x84, 0x1e, 0x5d, 0xe6, 0x1e, 0x5d, 0x4d, 0x7a, 0xb4, 0x23, 0xc4, 0x25,
+	0x8a, 0x74, 0x67, 0x41, 0xcf, 0xa0, 0x42, 0xb0, 0x76, 0x49, 0x74, 0xcb, 0xec, 0x9b, 0x0c, 0xc8,
+	0x4c, 0xf6, 0xda, 0xc5, 0xc1, 0x5f, 0x04, 0xb2, 0x4a, 0x55, 0x4f, 0x13, 0x01, 0xff, 0x01, 0xad,
+	0x69, 0x96, 0xe5, 0x7c, 0xa4, 0x86, 0xca, 0x3c, 0xd3, 0xd5, 0x3c, 0x76, 0xa6, 0xea, 0x8e, 0xcd,
+	0x3c, 0xc7, 0xb2, 0xa8, 0x07, 0x64, 0xb6, 0x5e, 0x6c, 0x4e, 0x29, 0x2b, 0x52, 0xe6, 0x48, 0x8a,
+	0xec, 0x0c, 0x25, 0xf0, 0x16, 0xaa, 0x0e, 0x34, 0xbb, 0x41, 0x5d, 0xa8, 0x06, 0xb5, 0xb4, 0x33,
+	0x52, 0xae, 0x17, 0x9a, 0x45, 0x05, 0x87, 0xbc, 0x4e, 0xc0, 0x7a, 0x12, 0x70, 0x82, 0xa6, 0x30,
+	0xd0, 0x70, 0xa9, 0xa7, 0x7a, 0x41, 0x99, 0x02, 0x13, 0x31, 0x91, 0x4a, 0xae, 0xa6, 0x10, 0x02,
+	0x1c, 0x50, 0x4f, 0x11, 0xea, 0x3c, 0x20, 0xfc, 0x0a, 0x2d, 0x0c, 0xb0, 0x3f, 0x9a, 0xb6, 0x11,
+	0xa6, 0x8a, 0xe0, 0x3c, 0xb0, 0xf3, 0xa1, 0xee, 0x1b, 0xae, 0x2a, 0x20, 0xdf, 0xa3, 0xf5, 0x01,
+	0x64, 0xe8, 0x6a, 0x6c, 0x6b, 0xce, 0xe7, 0x81, 0x1e, 0x84, 0x2c, 0xdd, 0x8d, 0xee, 0x4d, 0x03,
+	0x6d, 0x0c, 0x13, 0xe2, 0x39, 0x3a, 0x05, 0x48, 0x6e, 0xff, 0x6a, 0x1e, 0x1b, 0x6b, 0x83, 0xac,
+	0x84, 0x20, 0x51, 0x2b, 0x1a, 0x5a, 0x8e, 0xa4, 0xdd, 0x36, 0x4c, 0xbb, 0x17, 0xc6, 0x03, 0x64,
+	0x81, 0x17, 0xd2, 0xb5, 0xd4, 0xbe, 0x09, 0x57, 0x8f, 0x53, 0xa4, 0xeb, 0xd1, 0xec, 0x73, 0x18,
+	0x49, 0x07, 0xfc, 0x06, 0x91, 0x64, 0xf6, 0x75, 0xc7, 0x06, 0xbf, 0x4f, 0x0d, 0xb2, 0x98, 0x27,
+	0x82, 0xc5, 0xf8, 0x02, 0xec, 0x48, 0x65, 0xfc, 0x08, 0xad, 0x24, 0x81, 0xf9, 0xee, 0x14, 0xbb,
+	0x72, 0x89, 0xef, 0xca, 0xa5, 0xc4, 0xe2, 0x69, 0xc0, 0xc4, 0xe6, 0x74, 0x51, 0x2d, 0xa3, 0xb6,
+	0x79, 0xcf, 0xee, 0x9b, 0x36, 0xa3, 0x06, 0x59, 0xe6, 0xd1, 0xdf, 0x1e, 0x15, 0xfd, 0xb0, 0xdc,
+	0x3b, 0x47, 0x3b, 0xfb, 0x5c, 0x45, 0x59, 0x65, 0x69, 0x26, 0xd3, 0x05, 0x13, 0xdf, 0x42, 0x95,
+	0x48, 0x6f, 0x72, 0x35, 0x1f, 0xa8, 0x41, 0x56, 0xea, 0x85, 0xe6, 0xa4, 0x32, 0xd7, 0x0d, 0x3b,
+	0xcd, 0x01, 0x27, 0x07, 0xc7, 0x98, 0x94, 0x35, 0xed, 0x50, 0x74, 0x95, 0x8b, 0xce, 0x0a, 0xfa,
+	0x33, 0x5b, 0x4a, 0xc6, 0x51, 0x79, 0xab, 0x05, 0xb2, 0x56, 0x2f, 0x36, 0x67, 0x22, 0xa8, 0xbc,
+	0x71, 0x02, 0x3e, 0x42, 0xd5, 0xf8, 0xc1, 0x2b, 0x9b, 0xfd, 0x3a, 0x8f, 0xb4, 0x91, 0xdd, 0xec,
+	0xc5, 0x19, 0xab, 0x50, 0xdd, 0xf1, 0x0c, 0x05, 0x47, 0xcf, 0x5d, 0xd9, 0xe9, 0x7b, 0x68, 0x33,
+	0x8e, 0xea, 0x7a, 0xbe, 0x9d, 0x2c, 0xd5, 0x5a, 0x9e, 0x85, 0x5e, 0x8f, 0xa2, 0x1f, 0x70, 0x94,
+	0x68, 0xad, 0x3e, 0x47, 0x73, 0x61, 0xa7, 0x13, 0xcb, 0x0d, 0x64, 0x23, 0xdb, 0x73, 0xde, 0xd9,
+	0x65, 0x4f, 0x13, 0x0b, 0xaf, 0xcc, 0x3a, 0xd1, 0x5f, 0xc0, 0xbb, 0x43, 0xb0, 0xae, 0xaf, 0x9f,
+	0x52, 0x06, 0xa4, 0xce, 0xc1, 0xd6, 0x93, 0x60, 0x12, 0xa7, 0xc3, 0xa5, 0x06, 0x38, 0xe2, 0x17,
+	0xf0, 0x33, 0x54, 0xf1, 0xc1, 0x88, 0xb7, 0x60, 0xb2, 0x99, 0x27, 0xda, 0x39, 0x1f, 0x8c, 0x68,
+	0xdf, 0xc5, 0xaf, 0x10, 0x8e, 0x42, 0x89, 0x18, 0x49, 0xa3, 0x5e, 0x38, 0xc7, 0x2b, 0x11, 0x8e,
+	0x1c, 0xb0, 0xca, 0x43, 0x44, 0x41, 0xc7, 0xfb, 0x68, 0x3e, 0x3c, 0x24, 0x22, 0xd0, 0xe4, 0x4a,
+	0x1e, 0xff, 0x2a, 0x52, 0xf3, 0xf5, 0x00, 0x34, 0x80, 0x8b, 0x7a, 0x18, 0x26, 0xee, 0x6a, 0x9e,
+	0xc4, 0x55, 0x86, 0xce, 0x85, 0xb9, 0xfb, 0x33, 0x9a, 0x0f, 0xa1, 0x5c, 0xcf, 0xd4, 0xa9, 0x7a,
+	0x4c, 0xa9, 0x01, 0xe4, 0x1a, 0x87, 0xab, 0x8f, 0x80, 0x3b, 0x08, 0x24, 0x77, 0x29, 0x35, 0x64,
+	0xd0, 0x15, 0x27, 0x41, 0x07, 0xfc, 0x37, 0xb4, 0x00, 0xd4, 0x36, 0xa8, 0x37, 0xf0, 0x54, 0x4e,
+	0xa9, 0xd7, 0xeb, 0x85, 0xac, 0x93, 0xf1, 0x90, 0x0b, 0x87, 0xf8, 0xd1, 0x91, 0x75, 0x1e, 0xd2,
+	0x2c, 0xfc, 0x2e, 0x05, 0x2f, 0x0f, 0xde, 0x1b, 0xd9, 0xd5, 0x18, 0x83, 0xe7, 0x4b, 0x9d, 0x89,
+	0x2e, 0x0f, 0xdf, 0x17, 0x68, 0x2e, 0x8e, 0x0e, 0xa4, 0x99, 0x9d, 0xdf, 0x18, 0xae, 0x84, 0x9c,
+	0x8d, 0x41, 0x02, 0xfe, 0x1d, 0x5a, 0xe6, 0x27, 0x2f, 0x35, 0xa2, 0x83, 0x11, 0x3f, 0x92, 0x81,
+	0xdc, 0xe4, 0x2d, 0x72, 0x51, 0x0a, 0x0c, 0xa6, 0x1d, 0x7e, 0x2a, 0x03, 0xf6, 0xd0, 0x7a, 0x86,
+	0x2a, 0x3b, 0xf1, 0x28, 0x9c, 0x38, 0x96, 0x01, 0xe4, 0x16, 0x77, 0xeb, 0x66, 0xd2, 0xad, 0x27,
+	0x09, 0xb8, 0xa3, 0x50, 0x43, 0xba, 0xb8, 0x62, 0x8c, 0x12, 0xe0, 0x15, 0x91, 0xb6, 0x09, 0xe4,
+	0x76, 0x76, 0x45, 0x24, 0x2d, 0x85, 0x15, 0x91, 0x34, 0x00, 0xf8, 0x09, 0xda, 0xc8, 0x88, 0x25,
+	0xd6, 0xa1, 0xee, 0xf0, 0x64, 0xac, 0x26, 0x75, 0xa3, 0x0d, 0xe8, 0x4f, 0x68, 0x56, 0x5c, 0x19,
+	0xf8, 0x54, 0x6f, 0x52, 0x20, 0x77, 0xb9, 0x63, 0xab, 0x99, 0x77, 0x05, 0x31, 0xc3, 0x4b, 0x9f,
+	0x66, 0xb8, 0xe2, 0xa1, 0xd4, 0xc3, 0xcf, 0xd1, 0x8c, 0xbc, 0xa6, 0xc8, 0x8e, 0xdd, 0xca, 0x8e,
+	0xf0, 0x90, 0x0b, 0xf1, 0xf6, 0xcd, 0x6f, 0x5c, 0x12, 0xed, 0x32, 0x0c, 0xe9, 0x80, 0x1f, 0xa2,
+	0xa5, 0x48, 0x50, 0xc7, 0x94, 0xaa, 0xcc, 0xa3, 0x1a, 0xf8, 0xde, 0x19, 0x69, 0xf3, 0xab, 0x4f,
+	0x75, 0x70, 0x10, 0xec, 0x52, 0x7a, 0x24, 0x79, 0x78, 0x0f, 0xcd, 0xc5, 0xd5, 0x80, 0x6c, 0x71,
+	0x2f, 0xd6, 0x92, 0x5e, 0x74, 0x22, 0xea, 0x61, 0x3c, 0x51, 0xc8, 0x60, 0x18, 0xad, 0x0c, 0x4e,
+	0x53, 0xc7, 0x67, 0xba, 0xd3, 0xa7, 0x40, 0xee, 0x71, 0xb4, 0x3b, 0xb9, 0xc6, 0x87, 0x97, 0x42,
+	0x49, 0x29, 0x87, 0x30, 0x92, 0x00, 0xd8, 0x41, 0xd7, 0x52, 0xd0, 0x99, 0x47, 0xcc, 0x76, 0x9e,
+	0xa6, 0xb6, 0x99, 0xc4, 0x4f, 0x1f, 0x33, 0xb1, 0xc9, 0x40, 0xb3, 0xac, 0xae, 0xa6, 0x9f, 0xaa,
+	0x1e, 0x65, 0xde, 0x99, 0xea, 0x3a, 0x96, 0xa9, 0x9f, 0x91, 0xfb, 0xf5, 0xc2, 0xb9, 0x93, 0x81,
+	0x54, 0x52, 0x02, 0x9d, 0x03, 0xae, 0x12, 0x99, 0x0c, 0xd2, 0x4c, 0xdc, 0x45, 0xcb, 0xc7, 0x9a,
+	0x69, 0xc5, 0xc6, 0x6d, 0x29, 0x06, 0xe4, 0x01, 0xcf, 0xe2, 0xf5, 0xa4, 0xb1, 0x5d, 0xae, 0x90,
+	0x32, 0xb9, 0x74, 0x9c, 0x49, 0x07, 0x6c, 0xa1, 0xcd, 0x68, 0xc5, 0xa9, 0x99, 0x83, 0xc0, 0xc3,
+	0xdc, 0x83, 0xc0, 0x7a, 0xa4, 0x02, 0x1f, 0xa7, 0x66, 0x82, 0xbd, 0xd2, 0x24, 0x2a, 0x4f, 0xef,
+	0x95, 0x26, 0xe7, 0xca, 0xe5, 0xbd, 0xd2, 0x24, 0x29, 0x2f, 0x37, 0xfe, 0x5b, 0x44, 0xe5, 0x64,
+	0x35, 0xe3, 0xdf, 0xa2, 0x71, 0xee, 0x8d, 0x7c, 0x3e, 0x58, 0x3d, 0xa7, 0xfc, 0x65, 0xdd, 0x09,
+	0xf9, 0x5f, 0xf5, 0x09, 0xe1, 0xaf, 0xa3, 0x1e, 0x06, 0x8a, 0x39, 0x1f, 0x06, 0xc2, 0xee, 0x9e,
+	0xf5, 0x3c, 0x90, 0x6b, 0x58, 0x2a, 0x5d, 0xc0, 0xb0, 0xf4, 0x7b, 0x34, 0x21, 0xe7, 0xd8, 0xf1,
+	0x6c, 0xb7, 0x23, 0xb9, 0x15, 0x03, 0x6a, 0xf8, 0x4a, 0x23, 0xd4, 0x1a, 0xef, 0x51, 0x25, 0x25,
+	0x82, 0xab, 0x68, 0x5c, 0xbc, 0xa9, 0x14, 0x78, 0x63, 0x11, 0x3f, 0xf8, 0x21, 0x9a, 0x90, 0xd7,
+	0xf9, 0x5c, 0x99, 0x97, 0xc2, 0x8d, 0x1e, 0xc2, 0xe9, 0x41, 0x6d, 0x84, 0x89, 0x47, 0x68, 0x42,
+	0xce, 0x43, 0x97, 0xf2, 0xcf, 0x43, 0x52, 0xa5, 0xe1, 0xa1, 0x99, 0xd8, 0xe4, 0x31, 0xc2, 0x46,
+	0x15, 0x8d, 0x9b, 0xb6, 0x41, 0x3f, 0x71, 0x13, 0x25, 0x45, 0xfc, 0x44, 0x82, 0x2b, 0xfe, 0x9c,
+	0xe0, 0x74, 0x54, 0xcd, 0xba, 0xcd, 0x5f, 0x6c, 0x06, 0xdf, 0xa3, 0xf9, 0x8c, 0x5b, 0xfd, 0x08,
+	0x1b, 0xf7, 0xd1, 0xb8, 0x98, 0x4e, 0x73, 0x99, 0x10, 0xb2, 0x0d, 0x15, 0xe1, 0xf4, 0xf8, 0x72,
+	0x91, 0x06, 0x6c, 0xb4, 0x3c, 0x72, 0x60, 0x18, 0x59, 0x0b, 0x53, 0x83, 0x29, 0x24, 0x9f, 0xad,
+	0xa1, 0x7c, 0xe3, 0x18, 0x2d, 0x8d, 0x78, 0xf7, 0xb9, 0xd8, 0xa5, 0x61, 0x68, 0xf5, 0x9c, 0x9b,
+	0x22, 0xae, 0x21, 0x34, 0xbc, 0x75, 0x4a, 0x83, 0x11, 0xca, 0x2f, 0xb5, 0xfa, 0xef, 0x02, 0x9a,
+	0x8e, 0x0c, 0x1f, 0xa3, 0x43, 0x92, 0xbd, 0x21, 0x1f, 0xb8, 0x10, 0x0e, 0xd6, 0xb7, 0xeb, 0x7b,
+	0x79, 0x37, 0x82, 0x90, 0xed, 0x74, 0x3e, 0x7f, 0xab, 0x15, 0xbe, 0x7c, 0xab, 0x15, 0x7e, 0xfc,
+	0x56, 0x2b, 0xfc, 0xe7, 0x7b, 0x6d, 0xec, 0xcb, 0xf7, 0xda, 0xd8, 0xff, 0xbf, 0xd7, 0xc6, 0xde,
+	0x36, 0x7b, 0x26, 0x3b, 0xf1, 0xbb, 0x2d, 0xdd, 0xe9, 0xb7, 0x83, 0xcd, 0x7c, 0xd7, 0xf1, 0x7a,
+	0xfc, 0xc3, 0x68, 0x7f, 0x0a, 0x5f, 0xa0, 0xd9, 0x99, 0x4b, 0xa1, 0x3b, 0xc1, 0x9f, 0x9f, 0xef,
+	0xff, 0x34, 0x00, 0xb6, 0x56, 0x91, 0x10, 0xe1, 0x16, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for iNdEx := len(m.SourceChainAssetsLockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChainAssetsLockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.FailedTripartyCallbacks) > 0 {
+		for iNdEx := len(m.FailedTripartyCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTripartyCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.TripartyCallbackRetryPolicy != nil {
+		{
+			size, err := m.TripartyCallbackRetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x92
 	{
-		size := m.TripartyPerRequestLimit.Size()
+		size := m.TripartyOutcomesPrunedSequenceTip.Size()
 		i -= size
-		if _, err := m.TripartyPerRequestLimit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TripartyOutcomesPrunedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1
+	dAtA[i] = 0x3
 	i--
-	dAtA[i] = 0x8a
-	if m.TripartyBlockDelay != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TripartyBlockDelay))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	dAtA[i] = 0x92
+	if len(m.TripartyOutcomes) > 0 {
+		for iNdEx := len(m.TripartyOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripartyOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	if len(m.AllowedTripartyControllers) > 0 {
-		for iNdEx := len(m.AllowedTripartyControllers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedTripartyControllers[iNdEx])
-			copy(dAtA[i:], m.AllowedTripartyControllers[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedTripartyControllers[iNdEx])))
+	if len(m.BridgeOutFees) > 0 {
+		for iNdEx := len(m.BridgeOutFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeOutFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.CurrentOutflowLimits) > 0 {
-		for iNdEx := len(m.CurrentOutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BridgeOutFeeTreasury) > 0 {
+		i -= len(m.BridgeOutFeeTreasury)
+		copy(dAtA[i:], m.BridgeOutFeeTreasury)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeOutFeeTreasury)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.SourceChains) > 0 {
+		for iNdEx := len(m.SourceChains) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CurrentOutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SourceChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -704,13 +1308,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.CurrentOutflowAmounts) > 0 {
-		for iNdEx := len(m.CurrentOutflowAmounts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Erc20Supplies) > 0 {
+		for iNdEx := len(m.Erc20Supplies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CurrentOutflowAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Erc20Supplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -718,18 +1324,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if m.LastOutflowReset != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutflowReset))
+	if m.DelayedBridgeOutSequenceTip != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelayedBridgeOutSequenceTip))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
 	}
-	if len(m.TokenMinBridgeOutAmounts) > 0 {
-		for iNdEx := len(m.TokenMinBridgeOutAmounts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DelayedBridgeOuts) > 0 {
+		for iNdEx := len(m.DelayedBridgeOuts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TokenMinBridgeOutAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.DelayedBridgeOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -737,23 +1347,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	{
-		size := m.BitcoinChainMinBridgeOutAmount.Size()
-		i -= size
-		if _, err := m.BitcoinChainMinBridgeOutAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DelayedBridgeOutThresholds) > 0 {
+		for iNdEx := len(m.DelayedBridgeOutThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedBridgeOutThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.AssetsUnlockedEvents) > 0 {
-		for iNdEx := len(m.AssetsUnlockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+	if m.DelayedBridgeOutBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DelayedBridgeOutBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.SenderOutflows) > 0 {
+		for iNdEx := len(m.SenderOutflows) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AssetsUnlockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SenderOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -761,33 +1386,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	{
-		size := m.AssetsUnlockedSequenceTip.Size()
-		i -= size
-		if _, err := m.AssetsUnlockedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.SenderOutflowLimits) > 0 {
+		for iNdEx := len(m.SenderOutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SenderOutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
 	{
-		size := m.InitialBtcSupply.Size()
-		i -= size
-		if _, err := m.InitialBtcSupply.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.SenderOutflowParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Erc20TokensMappings) > 0 {
-		for iNdEx := len(m.Erc20TokensMappings) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
+	if len(m.OutflowPriceFeeds) > 0 {
+		for iNdEx := len(m.OutflowPriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Erc20TokensMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutflowPriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -795,28 +1430,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.SourceBtcToken) > 0 {
-		i -= len(m.SourceBtcToken)
-		copy(dAtA[i:], m.SourceBtcToken)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SourceBtcToken)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.UsdOutflowBuckets) > 0 {
+		for iNdEx := len(m.UsdOutflowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdOutflowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
 	{
-		size := m.AssetsLockedSequenceTip.Size()
+		size := m.CurrentUsdOutflow.Size()
 		i -= size
-		if _, err := m.AssetsLockedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.CurrentUsdOutflow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.UsdOutflowWindow.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -824,382 +1472,2849 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrentOutflowAmount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CurrentOutflowAmount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CurrentOutflowAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x92
 	{
-		size := m.Amount.Size()
+		size := m.UsdOutflowLimit.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.UsdOutflowLimit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x8a
+	if len(m.OutflowBuckets) > 0 {
+		for iNdEx := len(m.OutflowBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrentOutflowLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.OutflowWindows) > 0 {
+		for iNdEx := len(m.OutflowWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutflowWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CurrentOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CurrentOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Limit.Size()
+		size := m.AssetsLockedPrunedSequenceTip.Size()
 		i -= size
-		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.AssetsLockedPrunedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if len(m.AssetsLockedEvents) > 0 {
+		for iNdEx := len(m.AssetsLockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetsLockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TokenMinBridgeOutAmount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BridgeOutChains) > 0 {
+		dAtA5 := make([]byte, len(m.BridgeOutChains)*10)
+		var j4 int
+		for _, num := range m.BridgeOutChains {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TokenMinBridgeOutAmount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TokenMinBridgeOutAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.BridgeInPaused {
+		i--
+		if m.BridgeInPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+	if m.BridgeOutPaused {
 		i--
-		dAtA[i] = 0xa
+		if m.BridgeOutPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TripartyControllerBTCMinted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.TripartyControllerBtcMinted) > 0 {
+		for iNdEx := len(m.TripartyControllerBtcMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripartyControllerBtcMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.TripartyWindowLastReset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TripartyWindowLastReset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TripartyControllerBTCMinted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TripartyControllerBTCMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.TripartyWindowConsumed.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TripartyWindowConsumed.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if len(m.TripartyPendingRequests) > 0 {
+		for iNdEx := len(m.TripartyPendingRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TripartyPendingRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.AssetsLockedSequenceTip.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = len(m.SourceBtcToken)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.TripartyProcessedSequenceTip.Size()
+		i -= size
+		if _, err := m.TripartyProcessedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Erc20TokensMappings) > 0 {
-		for _, e := range m.Erc20TokensMappings {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.TripartyRequestSequenceTip.Size()
+		i -= size
+		if _, err := m.TripartyRequestSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.InitialBtcSupply.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.AssetsUnlockedSequenceTip.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.AssetsUnlockedEvents) > 0 {
-		for _, e := range m.AssetsUnlockedEvents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.TripartyWindowLimit.Size()
+		i -= size
+		if _, err := m.TripartyWindowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.BitcoinChainMinBridgeOutAmount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.TokenMinBridgeOutAmounts) > 0 {
-		for _, e := range m.TokenMinBridgeOutAmounts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.TripartyPerRequestLimit.Size()
+		i -= size
+		if _, err := m.TripartyPerRequestLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.LastOutflowReset != 0 {
-		n += 1 + sovGenesis(uint64(m.LastOutflowReset))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.TripartyBlockDelay != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TripartyBlockDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.CurrentOutflowAmounts) > 0 {
-		for _, e := range m.CurrentOutflowAmounts {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AllowedTripartyControllers) > 0 {
+		for iNdEx := len(m.AllowedTripartyControllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTripartyControllers[iNdEx])
+			copy(dAtA[i:], m.AllowedTripartyControllers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedTripartyControllers[iNdEx])))
+			i--
+			dAtA[i] = 0x72
 		}
 	}
 	if len(m.CurrentOutflowLimits) > 0 {
-		for _, e := range m.CurrentOutflowLimits {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		for iNdEx := len(m.CurrentOutflowLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentOutflowLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	if len(m.AllowedTripartyControllers) > 0 {
-		for _, s := range m.AllowedTripartyControllers {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CurrentOutflowAmounts) > 0 {
+		for iNdEx := len(m.CurrentOutflowAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentOutflowAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	if m.TripartyBlockDelay != 0 {
-		n += 2 + sovGenesis(uint64(m.TripartyBlockDelay))
+	if m.LastOutflowReset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastOutflowReset))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = m.TripartyPerRequestLimit.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.TripartyWindowLimit.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.TripartyRequestSequenceTip.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.TripartyProcessedSequenceTip.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.TripartyPendingRequests) > 0 {
-		for _, e := range m.TripartyPendingRequests {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TokenMinBridgeOutAmounts) > 0 {
+		for iNdEx := len(m.TokenMinBridgeOutAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenMinBridgeOutAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
 	}
-	l = m.TripartyWindowConsumed.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.TripartyWindowLastReset != 0 {
-		n += 2 + sovGenesis(uint64(m.TripartyWindowLastReset))
+	{
+		size := m.BitcoinChainMinBridgeOutAmount.Size()
+		i -= size
+		if _, err := m.BitcoinChainMinBridgeOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.TripartyControllerBtcMinted) > 0 {
-		for _, e := range m.TripartyControllerBtcMinted {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if len(m.AssetsUnlockedEvents) > 0 {
+		for iNdEx := len(m.AssetsUnlockedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetsUnlockedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.BridgeOutPaused {
-		n += 3
+	{
+		size := m.AssetsUnlockedSequenceTip.Size()
+		i -= size
+		if _, err := m.AssetsUnlockedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BridgeInPaused {
-		n += 3
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InitialBtcSupply.Size()
+		i -= size
+		if _, err := m.InitialBtcSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.BridgeOutChains) > 0 {
-		l = 0
-		for _, e := range m.BridgeOutChains {
-			l += sovGenesis(uint64(e))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Erc20TokensMappings) > 0 {
+		for iNdEx := len(m.Erc20TokensMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20TokensMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 2 + sovGenesis(uint64(l)) + l
 	}
-	return n
-}
-
-func (m *CurrentOutflowAmount) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SourceBtcToken) > 0 {
+		i -= len(m.SourceBtcToken)
+		copy(dAtA[i:], m.SourceBtcToken)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SourceBtcToken)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.AssetsLockedSequenceTip.Size()
+		i -= size
+		if _, err := m.AssetsLockedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentOutflowLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SourceChainState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChainState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChainState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = m.Limit.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	{
+		size := m.AssetsLockedPrunedSequenceTip.Size()
+		i -= size
+		if _, err := m.AssetsLockedPrunedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Erc20TokensMappings) > 0 {
+		for iNdEx := len(m.Erc20TokensMappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20TokensMappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.AssetsLockedSequenceTip.Size()
+		i -= size
+		if _, err := m.AssetsLockedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TokenMinBridgeOutAmount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SourceChainMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceChainMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceChainMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TripartyControllerBTCMinted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenOutflowWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenOutflowWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenOutflowWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *OutflowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OutflowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *OutflowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		switch fieldNum {
-		case 1:
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentOutflowAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentOutflowAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentOutflowAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderOutflowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderOutflowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderOutflowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedBridgeOutThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedBridgeOutThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedBridgeOutThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenMinBridgeOutAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMinBridgeOutAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMinBridgeOutAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TripartyControllerBTCMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TripartyControllerBTCMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TripartyControllerBTCMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AssetsLockedSequenceTip.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.SourceBtcToken)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Erc20TokensMappings) > 0 {
+		for _, e := range m.Erc20TokensMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.InitialBtcSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AssetsUnlockedSequenceTip.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AssetsUnlockedEvents) > 0 {
+		for _, e := range m.AssetsUnlockedEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BitcoinChainMinBridgeOutAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TokenMinBridgeOutAmounts) > 0 {
+		for _, e := range m.TokenMinBridgeOutAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastOutflowReset != 0 {
+		n += 1 + sovGenesis(uint64(m.LastOutflowReset))
+	}
+	if len(m.CurrentOutflowAmounts) > 0 {
+		for _, e := range m.CurrentOutflowAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CurrentOutflowLimits) > 0 {
+		for _, e := range m.CurrentOutflowLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedTripartyControllers) > 0 {
+		for _, s := range m.AllowedTripartyControllers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TripartyBlockDelay != 0 {
+		n += 2 + sovGenesis(uint64(m.TripartyBlockDelay))
+	}
+	l = m.TripartyPerRequestLimit.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.TripartyWindowLimit.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.TripartyRequestSequenceTip.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.TripartyProcessedSequenceTip.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TripartyPendingRequests) > 0 {
+		for _, e := range m.TripartyPendingRequests {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TripartyWindowConsumed.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.TripartyWindowLastReset != 0 {
+		n += 2 + sovGenesis(uint64(m.TripartyWindowLastReset))
+	}
+	if len(m.TripartyControllerBtcMinted) > 0 {
+		for _, e := range m.TripartyControllerBtcMinted {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BridgeOutPaused {
+		n += 3
+	}
+	if m.BridgeInPaused {
+		n += 3
+	}
+	if len(m.BridgeOutChains) > 0 {
+		l = 0
+		for _, e := range m.BridgeOutChains {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.AssetsLockedEvents) > 0 {
+		for _, e := range m.AssetsLockedEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AssetsLockedPrunedSequenceTip.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.OutflowWindows) > 0 {
+		for _, e := range m.OutflowWindows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutflowBuckets) > 0 {
+		for _, e := range m.OutflowBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.UsdOutflowLimit.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.UsdOutflowWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.CurrentUsdOutflow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.UsdOutflowBuckets) > 0 {
+		for _, e := range m.UsdOutflowBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutflowPriceFeeds) > 0 {
+		for _, e := range m.OutflowPriceFeeds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SenderOutflowParams.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.SenderOutflowLimits) > 0 {
+		for _, e := range m.SenderOutflowLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SenderOutflows) > 0 {
+		for _, e := range m.SenderOutflows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DelayedBridgeOutBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.DelayedBridgeOutBlocks))
+	}
+	if len(m.DelayedBridgeOutThresholds) > 0 {
+		for _, e := range m.DelayedBridgeOutThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelayedBridgeOuts) > 0 {
+		for _, e := range m.DelayedBridgeOuts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DelayedBridgeOutSequenceTip != 0 {
+		n += 2 + sovGenesis(uint64(m.DelayedBridgeOutSequenceTip))
+	}
+	if len(m.Erc20Supplies) > 0 {
+		for _, e := range m.Erc20Supplies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceChains) > 0 {
+		for _, e := range m.SourceChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BridgeOutFeeTreasury)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BridgeOutFees) > 0 {
+		for _, e := range m.BridgeOutFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TripartyOutcomes) > 0 {
+		for _, e := range m.TripartyOutcomes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TripartyOutcomesPrunedSequenceTip.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.TripartyCallbackRetryPolicy != nil {
+		l = m.TripartyCallbackRetryPolicy.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FailedTripartyCallbacks) > 0 {
+		for _, e := range m.FailedTripartyCallbacks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceChainAssetsLockedEvents) > 0 {
+		for _, e := range m.SourceChainAssetsLockedEvents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceChainState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AssetsLockedSequenceTip.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Erc20TokensMappings) > 0 {
+		for _, e := range m.Erc20TokensMappings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AssetsLockedPrunedSequenceTip.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceChainMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TokenOutflowWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutflowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CurrentOutflowAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CurrentOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SenderOutflowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DelayedBridgeOutThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TokenMinBridgeOutAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TripartyControllerBTCMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ERC20Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Burnt.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetsLockedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBtcToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBtcToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TokensMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20TokensMappings = append(m.Erc20TokensMappings, &ERC20TokenMapping{})
+			if err := m.Erc20TokensMappings[len(m.Erc20TokensMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBtcSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBtcSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsUnlockedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetsUnlockedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsUnlockedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetsUnlockedEvents = append(m.AssetsUnlockedEvents, &AssetsUnlockedEvent{})
+			if err := m.AssetsUnlockedEvents[len(m.AssetsUnlockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainMinBridgeOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BitcoinChainMinBridgeOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinBridgeOutAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenMinBridgeOutAmounts = append(m.TokenMinBridgeOutAmounts, &TokenMinBridgeOutAmount{})
+			if err := m.TokenMinBridgeOutAmounts[len(m.TokenMinBridgeOutAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutflowReset", wireType)
+			}
+			m.LastOutflowReset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutflowReset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflowAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentOutflowAmounts = append(m.CurrentOutflowAmounts, &CurrentOutflowAmount{})
+			if err := m.CurrentOutflowAmounts[len(m.CurrentOutflowAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentOutflowLimits = append(m.CurrentOutflowLimits, &CurrentOutflowLimit{})
+			if err := m.CurrentOutflowLimits[len(m.CurrentOutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTripartyControllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTripartyControllers = append(m.AllowedTripartyControllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyBlockDelay", wireType)
+			}
+			m.TripartyBlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripartyBlockDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyPerRequestLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyPerRequestLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyWindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyRequestSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyRequestSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyProcessedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyProcessedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyPendingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripartyPendingRequests = append(m.TripartyPendingRequests, &TripartyBridgeRequest{})
+			if err := m.TripartyPendingRequests[len(m.TripartyPendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowConsumed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyWindowConsumed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowLastReset", wireType)
+			}
+			m.TripartyWindowLastReset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TripartyWindowLastReset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyControllerBtcMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripartyControllerBtcMinted = append(m.TripartyControllerBtcMinted, &TripartyControllerBTCMinted{})
+			if err := m.TripartyControllerBtcMinted[len(m.TripartyControllerBtcMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeOutPaused = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeInPaused = bool(v != 0)
+		case 28:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BridgeOutChains = append(m.BridgeOutChains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BridgeOutChains) == 0 {
+					m.BridgeOutChains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BridgeOutChains = append(m.BridgeOutChains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutChains", wireType)
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetsLockedEvents = append(m.AssetsLockedEvents, &AssetsLockedRecord{})
+			if err := m.AssetsLockedEvents[len(m.AssetsLockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedPrunedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssetsLockedPrunedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowWindows = append(m.OutflowWindows, &TokenOutflowWindow{})
+			if err := m.OutflowWindows[len(m.OutflowWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowBuckets = append(m.OutflowBuckets, &OutflowBucket{})
+			if err := m.OutflowBuckets[len(m.OutflowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdOutflowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsdOutflowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdOutflowWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsdOutflowWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentUsdOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentUsdOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdOutflowBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdOutflowBuckets = append(m.UsdOutflowBuckets, &OutflowBucket{})
+			if err := m.UsdOutflowBuckets[len(m.UsdOutflowBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowPriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutflowPriceFeeds = append(m.OutflowPriceFeeds, OutflowPriceFeed{})
+			if err := m.OutflowPriceFeeds[len(m.OutflowPriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOutflowParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SenderOutflowParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOutflowLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOutflowLimits = append(m.SenderOutflowLimits, SenderOutflowLimit{})
+			if err := m.SenderOutflowLimits[len(m.SenderOutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderOutflows = append(m.SenderOutflows, SenderOutflow{})
+			if err := m.SenderOutflows[len(m.SenderOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBridgeOutBlocks", wireType)
+			}
+			m.DelayedBridgeOutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedBridgeOutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBridgeOutThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedBridgeOutThresholds = append(m.DelayedBridgeOutThresholds, DelayedBridgeOutThreshold{})
+			if err := m.DelayedBridgeOutThresholds[len(m.DelayedBridgeOutThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBridgeOuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedBridgeOuts = append(m.DelayedBridgeOuts, DelayedBridgeOut{})
+			if err := m.DelayedBridgeOuts[len(m.DelayedBridgeOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBridgeOutSequenceTip", wireType)
+			}
+			m.DelayedBridgeOutSequenceTip = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedBridgeOutSequenceTip |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Supplies = append(m.Erc20Supplies, ERC20Supply{})
+			if err := m.Erc20Supplies[len(m.Erc20Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChains = append(m.SourceChains, SourceChainState{})
+			if err := m.SourceChains[len(m.SourceChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutFeeTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeOutFeeTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeOutFees = append(m.BridgeOutFees, BridgeOutFee{})
+			if err := m.BridgeOutFees[len(m.BridgeOutFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TripartyOutcomes = append(m.TripartyOutcomes, &TripartyBridgeRequestOutcome{})
+			if err := m.TripartyOutcomes[len(m.TripartyOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyOutcomesPrunedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TripartyOutcomesPrunedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TripartyCallbackRetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1226,15 +4341,18 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TripartyCallbackRetryPolicy == nil {
+				m.TripartyCallbackRetryPolicy = &TripartyCallbackRetryPolicy{}
+			}
+			if err := m.TripartyCallbackRetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedSequenceTip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTripartyCallbacks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1244,31 +4362,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetsLockedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedTripartyCallbacks = append(m.FailedTripartyCallbacks, &FailedTripartyCallback{})
+			if err := m.FailedTripartyCallbacks[len(m.FailedTripartyCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceBtcToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainAssetsLockedEvents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1278,27 +4396,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceBtcToken = string(dAtA[iNdEx:postIndex])
+			m.SourceChainAssetsLockedEvents = append(m.SourceChainAssetsLockedEvents, &AssetsLockedRecord{})
+			if err := m.SourceChainAssetsLockedEvents[len(m.SourceChainAssetsLockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChainState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChainState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChainState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TokensMappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1325,14 +4495,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20TokensMappings = append(m.Erc20TokensMappings, &ERC20TokenMapping{})
-			if err := m.Erc20TokensMappings[len(m.Erc20TokensMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBtcSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedSequenceTip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1360,13 +4529,47 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBtcSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetsLockedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetsUnlockedSequenceTip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TokensMappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20TokensMappings = append(m.Erc20TokensMappings, ERC20TokenMapping{})
+			if err := m.Erc20TokensMappings[len(m.Erc20TokensMappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetsLockedPrunedSequenceTip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1394,13 +4597,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AssetsUnlockedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AssetsLockedPrunedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetsUnlockedEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1427,14 +4630,64 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetsUnlockedEvents = append(m.AssetsUnlockedEvents, &AssetsUnlockedEvent{})
-			if err := m.AssetsUnlockedEvents[len(m.AssetsUnlockedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Minted = append(m.Minted, SourceChainMinted{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChainMinted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChainMinted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChainMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainMinBridgeOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,15 +4715,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BitcoinChainMinBridgeOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinBridgeOutAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1480,50 +4731,81 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenMinBridgeOutAmounts = append(m.TokenMinBridgeOutAmounts, &TokenMinBridgeOutAmount{})
-			if err := m.TokenMinBridgeOutAmounts[len(m.TokenMinBridgeOutAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOutflowReset", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastOutflowReset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastOutflowReset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenOutflowWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenOutflowWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenOutflowWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflowAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1533,29 +4815,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentOutflowAmounts = append(m.CurrentOutflowAmounts, &CurrentOutflowAmount{})
-			if err := m.CurrentOutflowAmounts[len(m.CurrentOutflowAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflowLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1582,14 +4862,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentOutflowLimits = append(m.CurrentOutflowLimits, &CurrentOutflowLimit{})
-			if err := m.CurrentOutflowLimits[len(m.CurrentOutflowLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTripartyControllers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1617,13 +4946,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedTripartyControllers = append(m.AllowedTripartyControllers, string(dAtA[iNdEx:postIndex]))
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyBlockDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.TripartyBlockDelay = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1633,14 +4962,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TripartyBlockDelay |= int64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyPerRequestLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1668,13 +4997,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TripartyPerRequestLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentOutflowAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentOutflowAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1702,13 +5081,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TripartyWindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyRequestSequenceTip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1736,13 +5113,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TripartyRequestSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentOutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyProcessedSequenceTip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1770,15 +5197,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TripartyProcessedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyPendingRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1788,29 +5213,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderOutflowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TripartyPendingRequests = append(m.TripartyPendingRequests, &TripartyBridgeRequest{})
-			if err := m.TripartyPendingRequests[len(m.TripartyPendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 22:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderOutflowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowConsumed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1838,34 +5313,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TripartyWindowConsumed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyWindowLastReset", wireType)
-			}
-			m.TripartyWindowLastReset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TripartyWindowLastReset |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TripartyControllerBtcMinted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1875,142 +5329,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TripartyControllerBtcMinted = append(m.TripartyControllerBtcMinted, &TripartyControllerBTCMinted{})
-			if err := m.TripartyControllerBtcMinted[len(m.TripartyControllerBtcMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutPaused", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BridgeOutPaused = bool(v != 0)
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInPaused", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BridgeInPaused = bool(v != 0)
-		case 28:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BridgeOutChains = append(m.BridgeOutChains, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BridgeOutChains) == 0 {
-					m.BridgeOutChains = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BridgeOutChains = append(m.BridgeOutChains, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutChains", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2032,7 +5370,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
+func (m *DelayedBridgeOutThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2055,10 +5393,10 @@ func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentOutflowAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelayedBridgeOutThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentOutflowAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelayedBridgeOutThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2095,7 +5433,7 @@ func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2123,7 +5461,7 @@ func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2148,7 +5486,7 @@ func (m *CurrentOutflowAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
+func (m *TokenMinBridgeOutAmount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2171,10 +5509,10 @@ func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentOutflowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenMinBridgeOutAmount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentOutflowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenMinBridgeOutAmount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2211,7 +5549,7 @@ func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2239,7 +5577,7 @@ func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2264,7 +5602,7 @@ func (m *CurrentOutflowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenMinBridgeOutAmount) Unmarshal(dAtA []byte) error {
+func (m *TripartyControllerBTCMinted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,15 +5625,15 @@ func (m *TokenMinBridgeOutAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenMinBridgeOutAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: TripartyControllerBTCMinted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenMinBridgeOutAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TripartyControllerBTCMinted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2323,7 +5661,7 @@ func (m *TokenMinBridgeOutAmount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Controller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2380,7 +5718,7 @@ func (m *TokenMinBridgeOutAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TripartyControllerBTCMinted) Unmarshal(dAtA []byte) error {
+func (m *ERC20Supply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2403,15 +5741,15 @@ func (m *TripartyControllerBTCMinted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TripartyControllerBTCMinted: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Supply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TripartyControllerBTCMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Supply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2439,11 +5777,11 @@ func (m *TripartyControllerBTCMinted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2471,7 +5809,41 @@ func (m *TripartyControllerBTCMinted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,11 +5,54 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/mezo-org/mezod/cmd/config"
 	evmtypes "github.com/mezo-org/mezod/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	// Set bech32 prefixes to make the recipient address validation in
+	// AssetsLocked events possible (see AssetsLockedEvent.IsValid).
+	cfg := sdk.GetConfig()
+	config.SetBech32Prefixes(cfg)
+
+	assetsLockedRecord := func(sequence int64) *AssetsLockedRecord {
+		return &AssetsLockedRecord{
+			Event: AssetsLockedEvent{
+				Sequence:  sdkmath.NewInt(sequence),
+				Recipient: recipient,
+				Amount:    sdkmath.NewInt(1),
+				Token:     token,
+			},
+			BlockHeight: 10,
+		}
+	}
+
+	tripartyOutcome := func(sequence int64) *TripartyBridgeRequestOutcome {
+		return &TripartyBridgeRequestOutcome{
+			Sequence:         sdkmath.NewInt(sequence),
+			Controller:       "0x1111111111111111111111111111111111111111",
+			Status:           TripartyBridgeRequestStatusProcessed,
+			ProcessingHeight: 10,
+		}
+	}
+
+	failedTripartyCallback := func(sequence int64) *FailedTripartyCallback {
+		return &FailedTripartyCallback{
+			Request: TripartyBridgeRequest{
+				Sequence:    sdkmath.NewInt(sequence),
+				BlockHeight: 5,
+				Recipient:   "0x2222222222222222222222222222222222222222",
+				Amount:      sdkmath.NewInt(100),
+				Controller:  "0x1111111111111111111111111111111111111111",
+			},
+			Attempts:          1,
+			LastAttemptHeight: 10,
+			NextRetryHeight:   110,
+		}
+	}
+
 	for _, tc := range []struct {
 		desc        string
 		genState    func() *GenesisState
@@ -17,14 +60,777 @@ func TestGenesisState_Validate(t *testing.T) {
 		errContains string
 	}{
 		{
-			desc: "negative assets locked sequence tip",
+			desc: "negative assets locked sequence tip",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(-1)
+				return genState
+			},
+			valid:       false,
+			errContains: "genesis assets locked sequence tip cannot be negative",
+		},
+		{
+			desc: "assets locked pruned sequence tip above sequence tip",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(2)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.NewInt(3)
+				return genState
+			},
+			valid:       false,
+			errContains: "genesis assets locked pruned sequence tip cannot be greater than sequence tip",
+		},
+		{
+			desc: "missing retained assets locked event",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(3)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.AssetsLockedEvents = []*AssetsLockedRecord{
+					assetsLockedRecord(2),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "assets locked events must form a gapless range",
+		},
+		{
+			desc: "retained assets locked events out of order",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(3)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.AssetsLockedEvents = []*AssetsLockedRecord{
+					assetsLockedRecord(3),
+					assetsLockedRecord(2),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "assets locked event 0 has unexpected sequence",
+		},
+		{
+			desc: "proper genesis with retained assets locked events",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(3)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.AssetsLockedEvents = []*AssetsLockedRecord{
+					assetsLockedRecord(2),
+					assetsLockedRecord(3),
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "proper genesis predating the assets locked event store",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(3)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.Int{}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid outflow window",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.OutflowWindows = []*TokenOutflowWindow{
+					{Token: token, Window: NewOutflowWindow(105, 10)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "outflow window 0 is invalid",
+		},
+		{
+			desc: "outflow bucket without outflow window",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.OutflowBuckets = []*OutflowBucket{
+					{Token: token, Index: 1, Amount: sdkmath.NewInt(1)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "outflow bucket 0 token has no outflow window",
+		},
+		{
+			desc: "negative USD outflow limit",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.UsdOutflowLimit = sdkmath.NewInt(-1)
+				return genState
+			},
+			valid:       false,
+			errContains: "genesis USD outflow limit cannot be negative",
+		},
+		{
+			desc: "USD outflow buckets without USD outflow window",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.UsdOutflowBuckets = []*OutflowBucket{
+					{Index: 1, Amount: sdkmath.NewInt(1)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "USD outflow buckets require a USD outflow window",
+		},
+		{
+			desc: "duplicate outflow price feed",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.OutflowPriceFeeds = []OutflowPriceFeed{
+					{Token: token, CurrencyPair: "ETH/USD", Decimals: 18},
+					{Token: token, CurrencyPair: "BTC/USD", Decimals: 18},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "outflow price feed 1 has duplicate token",
+		},
+		{
+			desc: "proper genesis with outflow policies",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.OutflowWindows = []*TokenOutflowWindow{
+					{Token: token, Window: NewOutflowWindow(100, 10)},
+				}
+				genState.OutflowBuckets = []*OutflowBucket{
+					{Token: token, Index: 1, Amount: sdkmath.NewInt(1)},
+				}
+				genState.UsdOutflowLimit = sdkmath.NewInt(1000)
+				genState.UsdOutflowWindow = NewOutflowWindow(100, 10)
+				genState.UsdOutflowBuckets = []*OutflowBucket{
+					{Index: 1, Amount: sdkmath.NewInt(1)},
+				}
+				genState.OutflowPriceFeeds = []OutflowPriceFeed{
+					{Token: token, CurrencyPair: "ETH/USD", Decimals: 18},
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "proper genesis predating the USD outflow limit",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.UsdOutflowLimit = sdkmath.Int{}
+				genState.CurrentUsdOutflow = sdkmath.Int{}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "sender outflow max count without window",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflowParams = NewSenderOutflowParams(0, 5)
+				return genState
+			},
+			valid:       false,
+			errContains: "genesis sender outflow params are invalid",
+		},
+		{
+			desc: "zero sender outflow limit",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflowLimits = []SenderOutflowLimit{
+					{Token: token, Limit: sdkmath.ZeroInt()},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "sender outflow limit 0 must be positive",
+		},
+		{
+			desc: "duplicate sender outflow limit",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflowLimits = []SenderOutflowLimit{
+					{Token: token, Limit: sdkmath.NewInt(1)},
+					{Token: token, Limit: sdkmath.NewInt(2)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "sender outflow limit 1 has duplicate token",
+		},
+		{
+			desc: "duplicate sender outflow",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflows = []SenderOutflow{
+					{Sender: token, Count: 1},
+					{Sender: token, Count: 2},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "sender outflow 1 has duplicate sender",
+		},
+		{
+			desc: "sender outflows of different windows",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflows = []SenderOutflow{
+					{Sender: token, WindowStart: 100, Count: 1},
+					{Sender: token, WindowStart: 200, Count: 2},
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "sender outflow with zero amount",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflows = []SenderOutflow{
+					{
+						Sender: token,
+						Count:  1,
+						Amounts: []SenderTokenOutflow{
+							{Token: token, Amount: sdkmath.ZeroInt()},
+						},
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "sender outflow 0 amount 0 must be positive",
+		},
+		{
+			desc: "proper genesis with sender outflow limits",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SenderOutflowParams = NewSenderOutflowParams(100, 5)
+				genState.SenderOutflowLimits = []SenderOutflowLimit{
+					{Token: token, Limit: sdkmath.NewInt(10)},
+				}
+				genState.SenderOutflows = []SenderOutflow{
+					{
+						Sender:      token,
+						WindowStart: 100,
+						Count:       1,
+						Amounts: []SenderTokenOutflow{
+							{Token: token, Amount: sdkmath.NewInt(5)},
+						},
+					},
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "delayed bridge-out blocks above maximum",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOutBlocks = MaxDelayedBridgeOutBlocks + 1
+				return genState
+			},
+			valid:       false,
+			errContains: "genesis delayed bridge-out blocks are invalid",
+		},
+		{
+			desc: "duplicate delayed bridge-out threshold",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOutThresholds = []DelayedBridgeOutThreshold{
+					{Token: token, Threshold: sdkmath.NewInt(1)},
+					{Token: token, Threshold: sdkmath.NewInt(2)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out threshold 1 has duplicate token",
+		},
+		{
+			desc: "delayed bridge-out above sequence tip",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:            2,
+						Recipient:     []byte{0x01},
+						Token:         token,
+						TargetToken:   token,
+						Sender:        token,
+						Amount:        sdkmath.NewInt(10),
+						Fee:           sdkmath.ZeroInt(),
+						QueuedHeight:  1,
+						ReleaseHeight: 100,
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 1
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out 0 id exceeds the sequence tip",
+		},
+		{
+			desc: "delayed bridge-outs out of order",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				bridgeOut := DelayedBridgeOut{
+					Id:            2,
+					Recipient:     []byte{0x01},
+					Token:         token,
+					TargetToken:   token,
+					Sender:        token,
+					Amount:        sdkmath.NewInt(10),
+					Fee:           sdkmath.ZeroInt(),
+					QueuedHeight:  1,
+					ReleaseHeight: 100,
+				}
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{bridgeOut, bridgeOut}
+				genState.DelayedBridgeOuts[1].Id = 1
+				genState.DelayedBridgeOutSequenceTip = 2
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out 1 id must be greater than the previous one",
+		},
+		{
+			desc: "delayed bridge-out with zero amount",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:          1,
+						Recipient:   []byte{0x01},
+						Token:       token,
+						TargetToken: token,
+						Sender:      token,
+						Amount:      sdkmath.ZeroInt(),
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 1
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out amount must be positive",
+		},
+		{
+			desc: "delayed bridge-out queued after its release height",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:            1,
+						Recipient:     []byte{0x01},
+						Token:         token,
+						TargetToken:   token,
+						Sender:        token,
+						Amount:        sdkmath.NewInt(10),
+						Fee:           sdkmath.ZeroInt(),
+						ReleaseHeight: 100,
+						QueuedHeight:  101,
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 1
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out queued height cannot exceed the release height",
+		},
+		{
+			desc: "delayed bridge-out without queued height",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:            1,
+						Recipient:     []byte{0x01},
+						Token:         token,
+						TargetToken:   token,
+						Sender:        token,
+						Amount:        sdkmath.NewInt(10),
+						Fee:           sdkmath.ZeroInt(),
+						ReleaseHeight: 100,
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 1
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out queued height must be positive",
+		},
+		{
+			desc: "delayed bridge-out without fee",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:            1,
+						Recipient:     []byte{0x01},
+						Token:         token,
+						TargetToken:   token,
+						Sender:        token,
+						Amount:        sdkmath.NewInt(10),
+						ReleaseHeight: 100,
+						QueuedHeight:  1,
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 1
+				return genState
+			},
+			valid:       false,
+			errContains: "delayed bridge-out fee must be non-negative",
+		},
+		{
+			desc: "ERC20 supply with invalid token",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20Supplies = []ERC20Supply{
+					{Token: "invalid", Minted: sdkmath.NewInt(1), Burnt: sdkmath.ZeroInt()},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "ERC20 supply 0 token must be a valid hex-encoded EVM address",
+		},
+		{
+			desc: "ERC20 supply with negative burnt amount",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20Supplies = []ERC20Supply{
+					{Token: token, Minted: sdkmath.NewInt(1), Burnt: sdkmath.NewInt(-1)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "ERC20 supply 0 burnt amount must be non-negative",
+		},
+		{
+			desc: "duplicate ERC20 supply",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20Supplies = []ERC20Supply{
+					{Token: token, Minted: sdkmath.NewInt(1), Burnt: sdkmath.ZeroInt()},
+					{Token: token, Minted: sdkmath.NewInt(2), Burnt: sdkmath.ZeroInt()},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "ERC20 supply 1 has duplicate token",
+		},
+		{
+			desc: "proper genesis with ERC20 supplies",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20Supplies = []ERC20Supply{
+					{Token: token, Minted: sdkmath.NewInt(10), Burnt: sdkmath.NewInt(4)},
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "proper genesis with delayed bridge-outs",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.DelayedBridgeOutBlocks = 100
+				genState.DelayedBridgeOutThresholds = []DelayedBridgeOutThreshold{
+					{Token: token, Threshold: sdkmath.NewInt(10)},
+				}
+				genState.DelayedBridgeOuts = []DelayedBridgeOut{
+					{
+						Id:            1,
+						Recipient:     []byte{0x01},
+						Token:         token,
+						TargetToken:   token,
+						Sender:        token,
+						Amount:        sdkmath.NewInt(10),
+						Fee:           sdkmath.NewInt(1),
+						QueuedHeight:  1,
+						ReleaseHeight: 100,
+					},
+				}
+				genState.DelayedBridgeOutSequenceTip = 3
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "source chain using the primary source chain",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                   NewSourceChain(PrimarySourceChain, "Ethereum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip: sdkmath.ZeroInt(),
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 0 is invalid",
+		},
+		{
+			desc: "duplicate source chain",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				state := SourceChainState{
+					Chain:                   NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+					AssetsLockedSequenceTip: sdkmath.ZeroInt(),
+				}
+				genState.SourceChains = []SourceChainState{state, state}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 1 has duplicate identifier",
+		},
+		{
+			desc: "negative source chain sequence tip",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                   NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip: sdkmath.NewInt(-1),
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 0 assets locked sequence tip must be non-negative",
+		},
+		{
+			desc: "duplicate source chain ERC20 mapping",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				mapping := ERC20TokenMapping{SourceToken: token, MezoToken: token}
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                   NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip: sdkmath.ZeroInt(),
+						Erc20TokensMappings:     []ERC20TokenMapping{mapping, mapping},
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 0 ERC20 mapping 1 has duplicate source token",
+		},
+		{
+			desc: "non-positive source chain minted amount",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                   NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip: sdkmath.ZeroInt(),
+						Minted: []SourceChainMinted{
+							{Token: token, Amount: sdkmath.ZeroInt()},
+						},
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 0 minted amount 0 must be positive",
+		},
+		{
+			desc: "missing retained source chain assets locked event",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                         NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip:       sdkmath.NewInt(3),
+						AssetsLockedPrunedSequenceTip: sdkmath.NewInt(1),
+					},
+				}
+				record := assetsLockedRecord(2)
+				record.SourceChain = 1
+				genState.SourceChainAssetsLockedEvents = []*AssetsLockedRecord{record}
+				return genState
+			},
+			valid:       false,
+			errContains: "source chain 1 assets locked events must form a gapless range",
+		},
+		{
+			desc: "source chain assets locked event of an unknown source chain",
 			genState: func() *GenesisState {
 				genState := DefaultGenesis()
-				genState.AssetsLockedSequenceTip = sdkmath.NewInt(-1)
+				genState.SourceBtcToken = token
+				record := assetsLockedRecord(1)
+				record.SourceChain = 1
+				genState.SourceChainAssetsLockedEvents = []*AssetsLockedRecord{record}
 				return genState
 			},
 			valid:       false,
-			errContains: "genesis assets locked sequence tip cannot be negative",
+			errContains: "source chain assets locked event 0 references an unknown source chain",
+		},
+		{
+			desc: "primary assets locked event of a source chain",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.AssetsLockedSequenceTip = sdkmath.NewInt(1)
+				genState.AssetsLockedPrunedSequenceTip = sdkmath.ZeroInt()
+				record := assetsLockedRecord(1)
+				record.SourceChain = 1
+				genState.AssetsLockedEvents = []*AssetsLockedRecord{record}
+				return genState
+			},
+			valid:       false,
+			errContains: "assets locked event 0 has unexpected source chain",
+		},
+		{
+			desc: "proper genesis with source chains",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.SourceChains = []SourceChainState{
+					{
+						Chain:                   NewSourceChain(1, "Arbitrum", evmtypes.HexAddressToBytes(token)),
+						AssetsLockedSequenceTip: sdkmath.NewInt(5),
+						Erc20TokensMappings: []ERC20TokenMapping{
+							{SourceToken: token, MezoToken: token},
+						},
+						AssetsLockedPrunedSequenceTip: sdkmath.NewInt(4),
+						Minted: []SourceChainMinted{
+							{Token: token, Amount: sdkmath.NewInt(100)},
+						},
+					},
+				}
+				record := assetsLockedRecord(5)
+				record.SourceChain = 1
+				genState.SourceChainAssetsLockedEvents = []*AssetsLockedRecord{record}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid bridge-out fee treasury",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = "invalid"
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fee treasury must be a valid hex-encoded EVM address",
+		},
+		{
+			desc: "zero bridge-out fee treasury",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = "0x0000000000000000000000000000000000000000"
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fee treasury cannot be the zero address",
+		},
+		{
+			desc: "bridge-out fees without treasury",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFees = []BridgeOutFee{
+					{Token: token, Flat: sdkmath.NewInt(10)},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fees require the bridge-out fee treasury",
+		},
+		{
+			desc: "bridge-out fee with too many basis points",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = token
+				genState.BridgeOutFees = []BridgeOutFee{
+					{Token: token, Flat: sdkmath.ZeroInt(), BasisPoints: BasisPointsDenominator + 1},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fee 0 is invalid",
+		},
+		{
+			desc: "zero bridge-out fee",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = token
+				genState.BridgeOutFees = []BridgeOutFee{
+					{Token: token, Flat: sdkmath.ZeroInt()},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fee 0 cannot be zero",
+		},
+		{
+			desc: "duplicate bridge-out fee",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = token
+				genState.BridgeOutFees = []BridgeOutFee{
+					{Token: token, Flat: sdkmath.NewInt(10)},
+					{Token: token, Flat: sdkmath.ZeroInt(), BasisPoints: 5},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "bridge-out fee 1 has duplicate token and chain",
+		},
+		{
+			desc: "proper genesis with bridge-out fees",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.BridgeOutFeeTreasury = token
+				genState.BridgeOutFees = []BridgeOutFee{
+					{Token: token, Flat: sdkmath.NewInt(10)},
+					{Token: token, Chain: 1, Flat: sdkmath.ZeroInt(), BasisPoints: 5},
+				}
+				return genState
+			},
+			valid: true,
 		},
 		{
 			desc: "negative assets unlocked sequence tip",
@@ -116,6 +922,57 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:       false,
 			errContains: "mezo token of ERC20 mapping 0 must be a valid hex-encoded EVM address",
 		},
+		{
+			desc: "mapping with unknown state",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20TokensMappings = []*ERC20TokenMapping{
+					{
+						SourceToken: "0x4992eeF73616587B3463B0f9dEb92fF1087D39D2",
+						MezoToken:   "0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+						State:       42,
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "invalid lifecycle of ERC20 mapping 0: invalid ERC20 token mapping state",
+		},
+		{
+			desc: "pending mapping without activation height",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20TokensMappings = []*ERC20TokenMapping{
+					{
+						SourceToken: "0x4992eeF73616587B3463B0f9dEb92fF1087D39D2",
+						MezoToken:   "0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+						State:       ERC20TokenMappingStatePendingActivation,
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "pending mapping must have an activation height",
+		},
+		{
+			desc: "active mapping with activation height",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.Erc20TokensMappings = []*ERC20TokenMapping{
+					{
+						SourceToken:      "0x4992eeF73616587B3463B0f9dEb92fF1087D39D2",
+						MezoToken:        "0x517f2982701695D4E52f1ECFBEf3ba31Df470161",
+						ActivationHeight: 100,
+					},
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "only pending mappings can have an activation height",
+		},
 		{
 			desc: "proper genesis without triparty",
 			genState: func() *GenesisState {
@@ -683,10 +1540,205 @@ func TestGenesisState_Validate(t *testing.T) {
 						Amount:     sdkmath.NewInt(200),
 					},
 				}
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
 				return genState
 			},
 			valid: true,
 		},
+		{
+			desc: "proper genesis with triparty outcomes",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomes = []*TripartyBridgeRequestOutcome{
+					tripartyOutcome(2),
+					tripartyOutcome(3),
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "proper genesis predating the triparty outcome store",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.Int{}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "triparty outcomes pruned sequence tip above processed sequence tip",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				return genState
+			},
+			valid:       false,
+			errContains: "triparty outcomes pruned sequence tip cannot be greater than processed sequence tip",
+		},
+		{
+			desc: "missing triparty outcome",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomes = []*TripartyBridgeRequestOutcome{
+					tripartyOutcome(2),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "triparty outcomes must form a gapless range",
+		},
+		{
+			desc: "triparty outcome with unexpected sequence",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(3)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomes = []*TripartyBridgeRequestOutcome{
+					tripartyOutcome(3),
+					tripartyOutcome(2),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "triparty outcome 0 has unexpected sequence",
+		},
+		{
+			desc: "triparty outcome with unspecified status",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(1)
+				outcome := tripartyOutcome(1)
+				outcome.Status = TripartyBridgeRequestStatusUnspecified
+				genState.TripartyOutcomes = []*TripartyBridgeRequestOutcome{outcome}
+				return genState
+			},
+			valid:       false,
+			errContains: "triparty outcome 0 has invalid status",
+		},
+		{
+			desc: "proper genesis with failed triparty callbacks",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(2)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(2)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(2)
+				exhausted := failedTripartyCallback(2)
+				exhausted.NextRetryHeight = 0
+				genState.FailedTripartyCallbacks = []*FailedTripartyCallback{
+					failedTripartyCallback(1),
+					exhausted,
+				}
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "proper genesis predating the triparty callback retry queue",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyCallbackRetryPolicy = nil
+				return genState
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid triparty callback retry policy",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyCallbackRetryPolicy.IntervalBlocks = 0
+				return genState
+			},
+			valid:       false,
+			errContains: "invalid triparty callback retry policy",
+		},
+		{
+			desc: "failed triparty callback of an unprocessed request",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(2)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyPendingRequests = []*TripartyBridgeRequest{
+					&failedTripartyCallback(2).Request,
+				}
+				genState.FailedTripartyCallbacks = []*FailedTripartyCallback{
+					failedTripartyCallback(2),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "failed triparty callback 0 sequence must be in range [1, 1]",
+		},
+		{
+			desc: "duplicate failed triparty callback",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				genState.FailedTripartyCallbacks = []*FailedTripartyCallback{
+					failedTripartyCallback(1),
+					failedTripartyCallback(1),
+				}
+				return genState
+			},
+			valid:       false,
+			errContains: "duplicate failed triparty callback sequence: 1",
+		},
+		{
+			desc: "failed triparty callback without attempts",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				failed := failedTripartyCallback(1)
+				failed.Attempts = 0
+				genState.FailedTripartyCallbacks = []*FailedTripartyCallback{failed}
+				return genState
+			},
+			valid:       false,
+			errContains: "failed triparty callback 0 must have at least one attempt",
+		},
+		{
+			desc: "failed triparty callback retried before its last attempt",
+			genState: func() *GenesisState {
+				genState := DefaultGenesis()
+				genState.SourceBtcToken = token
+				genState.TripartyRequestSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyProcessedSequenceTip = sdkmath.NewInt(1)
+				genState.TripartyOutcomesPrunedSequenceTip = sdkmath.NewInt(1)
+				failed := failedTripartyCallback(1)
+				failed.NextRetryHeight = failed.LastAttemptHeight
+				genState.FailedTripartyCallbacks = []*FailedTripartyCallback{failed}
+				return genState
+			},
+			valid:       false,
+			errContains: "failed triparty callback 0 has invalid attempt heights",
+		},
 		{
 			desc: "proper genesis with the lockdown flags set",
 			genState: func() *GenesisState {
@@ -8,6 +8,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+	oracletypes "github.com/skip-mev/connect/v2/x/oracle/types"
 )
 
 // ValidatorPrivilege represents the privilege of bridge validators that
@@ -81,3 +84,20 @@ type AccountKeeper interface {
 	// exist in the AccountKeeper, then it is created.
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
+
+// OracleKeeper is an interface to the Connect x/oracle module keeper.
+type OracleKeeper interface {
+	// GetPriceForCurrencyPair returns the latest price of the given currency
+	// pair. It errors if no price is known.
+	GetPriceForCurrencyPair(
+		ctx context.Context,
+		cp connecttypes.CurrencyPair,
+	) (oracletypes.QuotePrice, error)
+
+	// GetDecimalsForCurrencyPair returns the number of decimals of the prices
+	// of the given currency pair.
+	GetDecimalsForCurrencyPair(
+		ctx context.Context,
+		cp connecttypes.CurrencyPair,
+	) (uint64, error)
+}
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -19,6 +22,14 @@ var (
 	// AssetsLockedSequenceTipKey is a standalone key for the assets locked sequence tip.
 	AssetsLockedSequenceTipKey = []byte{0x20}
 
+	// AssetsLockedKeyPrefix is the key prefix for accepted AssetsLocked
+	// events, keyed by their sequence number.
+	AssetsLockedKeyPrefix = []byte{0x21}
+
+	// AssetsLockedPrunedSequenceTipKey is a standalone key for the sequence
+	// number of the last AssetsLocked event pruned from the store.
+	AssetsLockedPrunedSequenceTipKey = []byte{0x22}
+
 	// SourceBTCTokenKey is a standalone key for the BTC token address on the
 	// source chain. AssetsLocked events carrying this token address are
 	// directly mapped to the Mezo native denomination - BTC.
@@ -81,6 +92,36 @@ var (
 	// store, the chain accepts bridge-outs.
 	BridgeOutChainKeyPrefix = []byte{0x98}
 
+	// OutflowWindowKeyPrefix is the key prefix for per-token rolling outflow
+	// windows. Tokens without an entry use the fixed-interval outflow periods.
+	OutflowWindowKeyPrefix = []byte{0x99}
+
+	// OutflowBucketKeyPrefix is the key prefix for per-token rolling outflow
+	// window buckets. A key is constructed by taking this prefix and appending
+	// the token address and the big-endian bucket index.
+	OutflowBucketKeyPrefix = []byte{0x9A}
+
+	// USDOutflowLimitKey is a standalone key for the global USD outflow limit.
+	USDOutflowLimitKey = []byte{0x9B}
+
+	// USDOutflowWindowKey is a standalone key for the rolling window of the
+	// USD outflow limit. If the key is absent, the USD outflow uses the
+	// fixed-interval outflow periods.
+	USDOutflowWindowKey = []byte{0x9C}
+
+	// CurrentUSDOutflowKey is a standalone key for the USD outflow of the
+	// current fixed-interval outflow period.
+	CurrentUSDOutflowKey = []byte{0x9D}
+
+	// USDOutflowBucketKeyPrefix is the key prefix for the USD outflow rolling
+	// window buckets. A key is constructed by taking this prefix and appending
+	// the big-endian bucket index.
+	USDOutflowBucketKeyPrefix = []byte{0x9E}
+
+	// OutflowPriceFeedKeyPrefix is the key prefix for per-token price feeds
+	// valuing token outflows against the USD outflow limit.
+	OutflowPriceFeedKeyPrefix = []byte{0x9F}
+
 	// TripartyControllerKeyPrefix is a prefix used to construct a key to a
 	// triparty controller entry. A key is constructed by taking this prefix
 	// and appending the controller address.
@@ -126,6 +167,135 @@ var (
 	// a key to a per-controller triparty BTC minted counter. A key is
 	// constructed by taking this prefix and appending the controller address.
 	TripartyControllerBTCMintedKeyPrefix = []byte{0xAA}
+
+	// SenderOutflowParamsKey is the key for the per-sender bridge-out limit
+	// parameters.
+	SenderOutflowParamsKey = []byte{0xB0}
+
+	// SenderOutflowLimitKeyPrefix is a prefix used to construct a key to
+	// a per-sender outflow limit of a token. A key is constructed by taking
+	// this prefix and appending the token address.
+	SenderOutflowLimitKeyPrefix = []byte{0xB1}
+
+	// SenderOutflowKeyPrefix is a prefix used to construct a key to the
+	// bridge-outs tracked for a sender. A key is constructed by taking this
+	// prefix and appending the big-endian window start height and the sender
+	// address, so records of past windows iterate first and can be pruned.
+	SenderOutflowKeyPrefix = []byte{0xB2}
+
+	// DelayedBridgeOutBlocksKey is a standalone key for the number of blocks
+	// bridge-outs are held in the delayed bridge-out queue.
+	DelayedBridgeOutBlocksKey = []byte{0xB3}
+
+	// DelayedBridgeOutThresholdKeyPrefix is a prefix used to construct a key
+	// to a delayed bridge-out threshold of a token. A key is constructed by
+	// taking this prefix and appending the Mezo token address.
+	DelayedBridgeOutThresholdKeyPrefix = []byte{0xB4}
+
+	// DelayedBridgeOutKeyPrefix is a prefix used to construct a key to
+	// a bridge-out held in the delayed bridge-out queue. A key is constructed
+	// by taking this prefix and appending the big-endian identifier, so the
+	// queue iterates in queueing order.
+	DelayedBridgeOutKeyPrefix = []byte{0xB5}
+
+	// DelayedBridgeOutSequenceTipKey is a standalone key for the identifier
+	// of the last queued delayed bridge-out.
+	DelayedBridgeOutSequenceTipKey = []byte{0xB6}
+
+	// ERC20MintedKeyPrefix is a prefix used to construct a key to the amount
+	// of an ERC20 token minted by the bridge. A key is constructed by taking
+	// this prefix and appending the Mezo token address.
+	ERC20MintedKeyPrefix = []byte{0xB7}
+
+	// ERC20BurntKeyPrefix is a prefix used to construct a key to the amount
+	// of an ERC20 token burnt by the bridge. A key is constructed by taking
+	// this prefix and appending the Mezo token address.
+	ERC20BurntKeyPrefix = []byte{0xB8}
+
+	// SourceChainKeyPrefix is a prefix used to construct a key to an
+	// additional bridge-in source chain. A key is constructed by taking this
+	// prefix and appending the big-endian chain identifier.
+	SourceChainKeyPrefix = []byte{0xB9}
+
+	// SourceChainAssetsLockedSequenceTipKeyPrefix is a prefix used to
+	// construct a key to the AssetsLocked sequence tip of an additional
+	// source chain. A key is constructed by taking this prefix and appending
+	// the big-endian chain identifier.
+	SourceChainAssetsLockedSequenceTipKeyPrefix = []byte{0xBA}
+
+	// SourceChainERC20TokenMappingKeyPrefix is a prefix used to construct
+	// a key to an ERC20 token mapping of an additional source chain. A key is
+	// constructed by taking this prefix and appending the big-endian chain
+	// identifier and the token contract address on the source chain.
+	SourceChainERC20TokenMappingKeyPrefix = []byte{0xBB}
+
+	// BridgeOutFeeTreasuryKey is a standalone key for the address receiving
+	// the bridge-out fees.
+	BridgeOutFeeTreasuryKey = []byte{0xBC}
+
+	// BridgeOutFeeKeyPrefix is a prefix used to construct a key to
+	// the bridge-out fee of a token and a target chain. A key is constructed
+	// by taking this prefix and appending the target chain identifier and
+	// the token contract address on Mezo.
+	BridgeOutFeeKeyPrefix = []byte{0xBD}
+
+	// TripartyOutcomeKeyPrefix is a prefix used to construct a key to the
+	// outcome record of a processed triparty bridge request. A key is
+	// constructed by taking this prefix and appending the request sequence
+	// number.
+	TripartyOutcomeKeyPrefix = []byte{0xBE}
+
+	// TripartyOutcomesPrunedSequenceTipKey is a standalone key for the
+	// sequence number of the last processed triparty bridge request whose
+	// outcome record is not retained in the store.
+	TripartyOutcomesPrunedSequenceTipKey = []byte{0xBF}
+
+	// FailedTripartyCallbackKeyPrefix is a prefix used to construct a key to
+	// a failed triparty controller callback queued for retry. A key is
+	// constructed by taking this prefix and appending the request sequence
+	// number.
+	FailedTripartyCallbackKeyPrefix = []byte{0xC0}
+
+	// TripartyCallbackRetryPolicyKey is a standalone key for the retry
+	// policy of failed triparty controller callbacks.
+	TripartyCallbackRetryPolicyKey = []byte{0xC1}
+
+	// TripartyCallbackRetryScheduleKeyPrefix is a prefix used to construct
+	// a key to an entry of the automatic triparty callback retry schedule.
+	// A key is constructed by taking this prefix and appending the
+	// big-endian retry height and the request sequence number, so the
+	// schedule iterates in retry order.
+	TripartyCallbackRetryScheduleKeyPrefix = []byte{0xC2}
+
+	// DelayedBridgeOutReleaseScheduleKeyPrefix is a prefix used to construct
+	// a key to an entry of the delayed bridge-out release schedule. A key is
+	// constructed by taking this prefix and appending the big-endian release
+	// height and identifier, so the schedule iterates in release order.
+	DelayedBridgeOutReleaseScheduleKeyPrefix = []byte{0xC3}
+
+	// SourceChainAssetsLockedKeyPrefix is the key prefix for accepted
+	// AssetsLocked events of additional source chains. A key is constructed
+	// by taking this prefix and appending the big-endian chain identifier
+	// and the event sequence number.
+	SourceChainAssetsLockedKeyPrefix = []byte{0xC4}
+
+	// SourceChainAssetsLockedPrunedSequenceTipKeyPrefix is the key prefix for
+	// the sequence number of the last AssetsLocked event of an additional
+	// source chain pruned from the store, keyed by the chain identifier.
+	SourceChainAssetsLockedPrunedSequenceTipKeyPrefix = []byte{0xC5}
+
+	// SourceChainMintedKeyPrefix is the key prefix for the cumulative amounts
+	// minted for AssetsLocked events of additional source chains. A key is
+	// constructed by taking this prefix and appending the big-endian chain
+	// identifier and the Mezo token address.
+	SourceChainMintedKeyPrefix = []byte{0xC6}
+
+	// ExhaustedTripartyCallbackKeyPrefix is a prefix used to construct a key
+	// to an entry of the index of failed triparty callbacks whose retries are
+	// exhausted. A key is constructed by taking this prefix and appending the
+	// big-endian last attempt height and the request sequence number, so the
+	// index iterates in pruning order.
+	ExhaustedTripartyCallbackKeyPrefix = []byte{0xC7}
 )
 
 // GetERC20TokenMappingKey gets the key for an ERC20 token mapping by the
@@ -134,6 +304,11 @@ func GetERC20TokenMappingKey(sourceERC20Token []byte) []byte {
 	return append(ERC20TokenMappingKeyPrefix, sourceERC20Token...)
 }
 
+// GetAssetsLockedKey gets the key for an accepted AssetsLocked event.
+func GetAssetsLockedKey(sequence math.Int) []byte {
+	return append(AssetsLockedKeyPrefix, sequence.BigInt().Bytes()...)
+}
+
 // GetAssetsUnlockedKey gets the key for an AssetsUnlocked event.
 func GetAssetsUnlockedKey(unlockSequence math.Int) []byte {
 	return append(AssetsUnlockedKeyPrefix, unlockSequence.BigInt().Bytes()...)
@@ -149,6 +324,36 @@ func GetCurrentOutflowKey(token []byte) []byte {
 	return append(CurrentOutflowKeyPrefix, token...)
 }
 
+// GetOutflowWindowKey gets the key for a rolling outflow window by token
+// address.
+func GetOutflowWindowKey(token []byte) []byte {
+	return append(OutflowWindowKeyPrefix, token...)
+}
+
+// GetOutflowBucketKeyPrefix gets the key prefix for all rolling outflow window
+// buckets of a token.
+func GetOutflowBucketKeyPrefix(token []byte) []byte {
+	return append(OutflowBucketKeyPrefix, token...)
+}
+
+// GetOutflowBucketKey gets the key for a rolling outflow window bucket by
+// token address and bucket index.
+func GetOutflowBucketKey(token []byte, index uint64) []byte {
+	return append(GetOutflowBucketKeyPrefix(token), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetUSDOutflowBucketKey gets the key for a USD outflow rolling window bucket
+// by bucket index.
+func GetUSDOutflowBucketKey(index uint64) []byte {
+	return append(USDOutflowBucketKeyPrefix, sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetOutflowPriceFeedKey gets the key for an outflow price feed by token
+// address.
+func GetOutflowPriceFeedKey(token []byte) []byte {
+	return append(OutflowPriceFeedKeyPrefix, token...)
+}
+
 // GetMinBridgeOutAmountKey gets the key for minimum bridge-out amount by the
 // given Mezo token address.
 func GetMinBridgeOutAmountKey(mezoToken []byte) []byte {
@@ -172,8 +377,153 @@ func GetTripartyBridgeRequestKey(sequence math.Int) []byte {
 	return append(TripartyRequestKeyPrefix, sequence.BigInt().Bytes()...)
 }
 
+// GetTripartyBridgeRequestOutcomeKey gets the key for the outcome record of
+// a processed triparty bridge request by its sequence number.
+func GetTripartyBridgeRequestOutcomeKey(sequence math.Int) []byte {
+	return append(TripartyOutcomeKeyPrefix, sequence.BigInt().Bytes()...)
+}
+
+// GetFailedTripartyCallbackKey gets the key for a failed triparty controller
+// callback by the request sequence number.
+func GetFailedTripartyCallbackKey(sequence math.Int) []byte {
+	return append(FailedTripartyCallbackKeyPrefix, sequence.BigInt().Bytes()...)
+}
+
+// GetTripartyCallbackRetryScheduleKey gets the key for an entry of the
+// automatic triparty callback retry schedule by the retry height and the
+// request sequence number.
+func GetTripartyCallbackRetryScheduleKey(height int64, sequence math.Int) []byte {
+	//nolint:gosec
+	key := append(TripartyCallbackRetryScheduleKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sequence.BigInt().Bytes()...)
+}
+
+// GetExhaustedTripartyCallbackKey gets the key for an entry of the index of
+// exhausted triparty callbacks by the last attempt height and the request
+// sequence number.
+func GetExhaustedTripartyCallbackKey(height int64, sequence math.Int) []byte {
+	//nolint:gosec
+	key := append(ExhaustedTripartyCallbackKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sequence.BigInt().Bytes()...)
+}
+
+// GetDelayedBridgeOutReleaseScheduleKey gets the key for an entry of the
+// delayed bridge-out release schedule by the release height and the
+// identifier.
+func GetDelayedBridgeOutReleaseScheduleKey(releaseHeight uint64, id uint64) []byte {
+	key := append(DelayedBridgeOutReleaseScheduleKeyPrefix, sdk.Uint64ToBigEndian(releaseHeight)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetTripartyControllerBTCMintedKey gets the key for a per-controller
 // triparty BTC minted counter by controller address.
 func GetTripartyControllerBTCMintedKey(controller []byte) []byte {
 	return append(TripartyControllerBTCMintedKeyPrefix, controller...)
 }
+
+// GetSenderOutflowLimitKey gets the key for a per-sender outflow limit by
+// token address.
+func GetSenderOutflowLimitKey(token []byte) []byte {
+	return append(SenderOutflowLimitKeyPrefix, token...)
+}
+
+// GetSenderOutflowKeyPrefix gets the key prefix for the bridge-outs tracked
+// for all senders in the window starting at the given height.
+func GetSenderOutflowKeyPrefix(windowStart uint64) []byte {
+	return append(SenderOutflowKeyPrefix, sdk.Uint64ToBigEndian(windowStart)...)
+}
+
+// GetSenderOutflowKey gets the key for the bridge-outs tracked for a sender
+// by window start height and sender address.
+func GetSenderOutflowKey(windowStart uint64, sender []byte) []byte {
+	return append(GetSenderOutflowKeyPrefix(windowStart), sender...)
+}
+
+// GetDelayedBridgeOutThresholdKey gets the key for a delayed bridge-out
+// threshold by Mezo token address.
+func GetDelayedBridgeOutThresholdKey(token []byte) []byte {
+	return append(DelayedBridgeOutThresholdKeyPrefix, token...)
+}
+
+// GetDelayedBridgeOutKey gets the key for a delayed bridge-out by its
+// identifier.
+func GetDelayedBridgeOutKey(id uint64) []byte {
+	return append(DelayedBridgeOutKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetERC20MintedKey gets the key for the amount of an ERC20 token minted by
+// the bridge by Mezo token address.
+func GetERC20MintedKey(mezoToken []byte) []byte {
+	return append(ERC20MintedKeyPrefix, mezoToken...)
+}
+
+// GetERC20BurntKey gets the key for the amount of an ERC20 token burnt by
+// the bridge by Mezo token address.
+func GetERC20BurntKey(mezoToken []byte) []byte {
+	return append(ERC20BurntKeyPrefix, mezoToken...)
+}
+
+// GetSourceChainKey gets the key for an additional source chain by its
+// identifier.
+func GetSourceChainKey(chain uint32) []byte {
+	return binary.BigEndian.AppendUint32(SourceChainKeyPrefix, chain)
+}
+
+// GetSourceChainAssetsLockedSequenceTipKey gets the key for the AssetsLocked
+// sequence tip of an additional source chain by its identifier.
+func GetSourceChainAssetsLockedSequenceTipKey(chain uint32) []byte {
+	return binary.BigEndian.AppendUint32(
+		SourceChainAssetsLockedSequenceTipKeyPrefix,
+		chain,
+	)
+}
+
+// GetSourceChainERC20TokenMappingKeyPrefix gets the key prefix for all ERC20
+// token mappings of an additional source chain.
+func GetSourceChainERC20TokenMappingKeyPrefix(chain uint32) []byte {
+	return binary.BigEndian.AppendUint32(
+		SourceChainERC20TokenMappingKeyPrefix,
+		chain,
+	)
+}
+
+// GetSourceChainERC20TokenMappingKey gets the key for an ERC20 token mapping
+// of an additional source chain by the chain identifier and the corresponding
+// source token address.
+func GetSourceChainERC20TokenMappingKey(chain uint32, sourceToken []byte) []byte {
+	return append(GetSourceChainERC20TokenMappingKeyPrefix(chain), sourceToken...)
+}
+
+// GetSourceChainAssetsLockedKey gets the key for an accepted AssetsLocked
+// event of an additional source chain.
+func GetSourceChainAssetsLockedKey(chain uint32, sequence math.Int) []byte {
+	key := binary.BigEndian.AppendUint32(SourceChainAssetsLockedKeyPrefix, chain)
+	return append(key, sequence.BigInt().Bytes()...)
+}
+
+// GetSourceChainAssetsLockedPrunedSequenceTipKey gets the key for the
+// AssetsLocked pruned sequence tip of an additional source chain.
+func GetSourceChainAssetsLockedPrunedSequenceTipKey(chain uint32) []byte {
+	return binary.BigEndian.AppendUint32(
+		SourceChainAssetsLockedPrunedSequenceTipKeyPrefix,
+		chain,
+	)
+}
+
+// GetSourceChainMintedKeyPrefix gets the key prefix for the minted amounts
+// of an additional source chain.
+func GetSourceChainMintedKeyPrefix(chain uint32) []byte {
+	return binary.BigEndian.AppendUint32(SourceChainMintedKeyPrefix, chain)
+}
+
+// GetSourceChainMintedKey gets the key for the amount of a Mezo token
+// minted for an additional source chain.
+func GetSourceChainMintedKey(chain uint32, token []byte) []byte {
+	return append(GetSourceChainMintedKeyPrefix(chain), token...)
+}
+
+// GetBridgeOutFeeKey gets the key for the bridge-out fee of a token and
+// a target chain.
+func GetBridgeOutFeeKey(mezoToken []byte, chain uint8) []byte {
+	return append(append(BridgeOutFeeKeyPrefix, chain), mezoToken...)
+}
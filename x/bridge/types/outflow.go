@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	connecttypes "github.com/skip-mev/connect/v2/pkg/types"
+
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+const (
+	// MaxOutflowWindowBuckets is the maximum number of buckets a rolling
+	// outflow window can be split into. The bound keeps the cost of summing
+	// the window outflow constant.
+	MaxOutflowWindowBuckets = 100
+
+	// MaxOutflowPriceFeedDecimals is the maximum number of token decimals an
+	// outflow price feed can declare.
+	MaxOutflowPriceFeedDecimals = 36
+)
+
+// NewOutflowWindow creates a new rolling outflow window.
+func NewOutflowWindow(windowBlocks uint64, buckets uint32) OutflowWindow {
+	return OutflowWindow{
+		WindowBlocks: windowBlocks,
+		Buckets:      buckets,
+	}
+}
+
+// IsZero returns true if the window is not set, i.e. the fixed-interval
+// outflow periods are used.
+func (w OutflowWindow) IsZero() bool {
+	return w.WindowBlocks == 0 && w.Buckets == 0
+}
+
+// BucketBlocks returns the length of a single window bucket, in blocks.
+func (w OutflowWindow) BucketBlocks() uint64 {
+	return w.WindowBlocks / uint64(w.Buckets)
+}
+
+// Validate validates the rolling outflow window. The zero window is valid.
+func (w OutflowWindow) Validate() error {
+	if w.IsZero() {
+		return nil
+	}
+
+	if w.Buckets == 0 || w.Buckets > MaxOutflowWindowBuckets {
+		return fmt.Errorf(
+			"outflow window buckets must be between 1 and %d: %d",
+			MaxOutflowWindowBuckets,
+			w.Buckets,
+		)
+	}
+
+	if w.WindowBlocks == 0 || w.WindowBlocks%uint64(w.Buckets) != 0 {
+		return fmt.Errorf(
+			"outflow window blocks must be a positive multiple of buckets: %d",
+			w.WindowBlocks,
+		)
+	}
+
+	return nil
+}
+
+// ParseCurrencyPair returns the parsed oracle currency pair of the price feed.
+func (f OutflowPriceFeed) ParseCurrencyPair() (connecttypes.CurrencyPair, error) {
+	return connecttypes.CurrencyPairFromString(f.CurrencyPair)
+}
+
+// Validate validates the outflow price feed.
+func (f OutflowPriceFeed) Validate() error {
+	if !evmtypes.IsHexAddress(f.Token) {
+		return fmt.Errorf("%w: %s", ErrInvalidEVMAddress, f.Token)
+	}
+
+	if _, err := f.ParseCurrencyPair(); err != nil {
+		return fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if f.Decimals > MaxOutflowPriceFeedDecimals {
+		return fmt.Errorf(
+			"outflow price feed decimals cannot exceed %d: %d",
+			MaxOutflowPriceFeedDecimals,
+			f.Decimals,
+		)
+	}
+
+	return nil
+}
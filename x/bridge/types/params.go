@@ -10,16 +10,36 @@ const (
 	// DefaultBtcSupplyAssertionEnabled is the default value for the flag
 	// steering the BTC supply assertion.
 	DefaultBtcSupplyAssertionEnabled = true
+
+	// DefaultAssetsLockedEventsRetentionBlocks is the default number of blocks
+	// for which accepted AssetsLocked events are retained. With ~3 second
+	// blocks, this is roughly one week.
+	DefaultAssetsLockedEventsRetentionBlocks = uint64(200_000)
+
+	// DefaultERC20SupplyAssertionEnabled is the default value for the flag
+	// steering the ERC20 supply assertion.
+	DefaultERC20SupplyAssertionEnabled = false
+
+	// DefaultTripartyOutcomesRetentionBlocks is the default number of blocks
+	// for which outcome records of processed triparty bridge requests are
+	// retained. With ~3 second blocks, this is roughly one week.
+	DefaultTripartyOutcomesRetentionBlocks = uint64(200_000)
 )
 
 // NewParams creates a new Params instance.
 func NewParams(
 	maxERC20TokensMappings uint32,
 	btcSupplyAssertionEnabled bool,
+	assetsLockedEventsRetentionBlocks uint64,
+	erc20SupplyAssertionEnabled bool,
+	tripartyOutcomesRetentionBlocks uint64,
 ) Params {
 	return Params{
-		MaxErc20TokensMappings:    maxERC20TokensMappings,
-		BtcSupplyAssertionEnabled: btcSupplyAssertionEnabled,
+		MaxErc20TokensMappings:            maxERC20TokensMappings,
+		BtcSupplyAssertionEnabled:         btcSupplyAssertionEnabled,
+		AssetsLockedEventsRetentionBlocks: assetsLockedEventsRetentionBlocks,
+		Erc20SupplyAssertionEnabled:       erc20SupplyAssertionEnabled,
+		TripartyOutcomesRetentionBlocks:   tripartyOutcomesRetentionBlocks,
 	}
 }
 
@@ -28,6 +48,9 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxERC20TokensMappings,
 		DefaultBtcSupplyAssertionEnabled,
+		DefaultAssetsLockedEventsRetentionBlocks,
+		DefaultERC20SupplyAssertionEnabled,
+		DefaultTripartyOutcomesRetentionBlocks,
 	)
 }
 
@@ -39,7 +62,10 @@ func (p Params) Validate() error {
 // String implements the Stringer interface.
 func (p Params) String() string {
 	return fmt.Sprintf(
-		"Params(MaxErc20TokensMappings: %d)",
+		"Params(MaxErc20TokensMappings: %d, AssetsLockedEventsRetentionBlocks: %d, "+
+			"TripartyOutcomesRetentionBlocks: %d)",
 		p.MaxErc20TokensMappings,
+		p.AssetsLockedEventsRetentionBlocks,
+		p.TripartyOutcomesRetentionBlocks,
 	)
 }
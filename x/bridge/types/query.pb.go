@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -289,489 +290,15930 @@ func (m *QueryAssetsUnlockedEventsResponse) GetEvents() []AssetsUnlockedEvent {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "mezo.bridge.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "mezo.bridge.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAssetsUnlockedSequenceTipRequest)(nil), "mezo.bridge.v1.QueryAssetsUnlockedSequenceTipRequest")
-	proto.RegisterType((*QueryAssetsUnlockedSequenceTipResponse)(nil), "mezo.bridge.v1.QueryAssetsUnlockedSequenceTipResponse")
-	proto.RegisterType((*QueryAssetsUnlockedEventsRequest)(nil), "mezo.bridge.v1.QueryAssetsUnlockedEventsRequest")
-	proto.RegisterType((*QueryAssetsUnlockedEventsResponse)(nil), "mezo.bridge.v1.QueryAssetsUnlockedEventsResponse")
+// QueryAssetsLockedSequenceTipRequest is request type for the
+// Query/AssetsLockedSequenceTip RPC method.
+type QueryAssetsLockedSequenceTipRequest struct {
 }
 
-func init() { proto.RegisterFile("mezo/bridge/v1/query.proto", fileDescriptor_93a3b7fcc57c3f9c) }
-
-var fileDescriptor_93a3b7fcc57c3f9c = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x2d, 0x44, 0xe2, 0x02, 0x1d, 0x8e, 0x50, 0x05, 0x03, 0x6e, 0x70, 0x45, 0xc9,
-	0x00, 0x77, 0x24, 0x14, 0x66, 0x1a, 0xd1, 0x01, 0xb1, 0x40, 0x0a, 0x0b, 0x4b, 0xe4, 0xc4, 0x87,
-	0x6b, 0x5a, 0xdf, 0x73, 0x7c, 0x97, 0x88, 0x32, 0xf2, 0x09, 0x90, 0xf8, 0x16, 0xf0, 0x25, 0x18,
-	0x3b, 0x56, 0x62, 0x41, 0x0c, 0x15, 0x4a, 0xd8, 0xf8, 0x12, 0xc8, 0x77, 0x67, 0x20, 0x26, 0x6d,
-	0x9a, 0xcd, 0x7a, 0xef, 0xfd, 0xff, 0xff, 0x9f, 0xee, 0x3d, 0x19, 0x3b, 0x31, 0x7f, 0x07, 0xac,
-	0x97, 0x46, 0x41, 0xc8, 0xd9, 0xa8, 0xc9, 0x06, 0x43, 0x9e, 0x1e, 0xd0, 0x24, 0x05, 0x05, 0x64,
-	0x25, 0xeb, 0x51, 0xd3, 0xa3, 0xa3, 0xa6, 0x53, 0x0d, 0x21, 0x04, 0xdd, 0x62, 0xd9, 0x97, 0x99,
-	0x72, 0xae, 0x87, 0x00, 0xe1, 0x3e, 0x67, 0x7e, 0x12, 0x31, 0x5f, 0x08, 0x50, 0xbe, 0x8a, 0x40,
-	0x48, 0xdb, 0xbd, 0x56, 0xf0, 0xb7, 0x6e, 0xba, 0xe9, 0x55, 0x31, 0x79, 0x9e, 0xe5, 0x3d, 0xf3,
-	0x53, 0x3f, 0x96, 0x1d, 0x3e, 0x18, 0x72, 0xa9, 0xbc, 0xa7, 0xf8, 0xf2, 0x54, 0x55, 0x26, 0x20,
-	0x24, 0x27, 0x9b, 0xb8, 0x9c, 0xe8, 0x4a, 0x0d, 0xd5, 0x51, 0xa3, 0xd2, 0x5a, 0xa5, 0xd3, 0x78,
-	0xd4, 0xcc, 0xb7, 0xcf, 0x1d, 0x1e, 0xaf, 0x95, 0x3a, 0x76, 0xd6, 0xbb, 0x8d, 0x6f, 0x69, 0xb3,
-	0x2d, 0x29, 0xb9, 0x92, 0x2f, 0xc5, 0x3e, 0xf4, 0xf7, 0x78, 0xb0, 0x93, 0x45, 0x89, 0x3e, 0x7f,
-	0x11, 0x25, 0x79, 0xea, 0x1b, 0xbc, 0x31, 0x6f, 0xd0, 0x82, 0x3c, 0xc2, 0x17, 0xa5, 0x2d, 0x77,
-	0x55, 0x94, 0x68, 0x9c, 0x0b, 0xed, 0x1b, 0x59, 0xec, 0xf7, 0xe3, 0xb5, 0x2b, 0x7d, 0x90, 0x31,
-	0x48, 0x19, 0xec, 0xd1, 0x08, 0x58, 0xec, 0xab, 0x5d, 0xfa, 0x44, 0xa8, 0x4e, 0x45, 0xfe, 0x75,
-	0xf2, 0x3e, 0x21, 0x5c, 0x9f, 0x11, 0xb6, 0x3d, 0xe2, 0x42, 0xe5, 0xcf, 0x40, 0x1e, 0xe3, 0x95,
-	0x3f, 0x31, 0x52, 0xf9, 0xa9, 0x3a, 0x5b, 0xd0, 0xa5, 0x5c, 0xb4, 0x93, 0x69, 0xa6, 0x60, 0xb9,
-	0x08, 0x6a, 0x4b, 0x0b, 0xc1, 0x6e, 0x8b, 0xc0, 0x7b, 0x8d, 0x6f, 0x9e, 0xc2, 0x6a, 0xdf, 0x64,
-	0x0b, 0x97, 0xb9, 0xae, 0xd4, 0x50, 0x7d, 0xb9, 0x51, 0x69, 0xad, 0x17, 0x97, 0x33, 0x43, 0x9d,
-	0x6f, 0xca, 0x08, 0x5b, 0xbf, 0x96, 0xf1, 0x79, 0x1d, 0x44, 0x06, 0xb8, 0x6c, 0x76, 0x49, 0xbc,
-	0xa2, 0xcd, 0xff, 0xe7, 0xe2, 0xac, 0x9f, 0x3a, 0x63, 0xf8, 0x3c, 0xf7, 0xfd, 0xd7, 0x9f, 0x1f,
-	0x97, 0x6a, 0x64, 0x95, 0x15, 0xee, 0xd1, 0x9c, 0x09, 0xf9, 0x82, 0xf0, 0xd5, 0x13, 0x37, 0x4f,
-	0x1e, 0xcc, 0x8c, 0x98, 0x77, 0x52, 0xce, 0xc3, 0x45, 0x65, 0x16, 0x76, 0x53, 0xc3, 0x52, 0x72,
-	0xa7, 0x08, 0xeb, 0x6b, 0x69, 0x77, 0x68, 0xb5, 0xdd, 0x7f, 0xcf, 0x90, 0x7c, 0x46, 0xb8, 0x3a,
-	0x6b, 0x47, 0xe4, 0xde, 0x19, 0x30, 0xa6, 0x4e, 0xcf, 0x69, 0x2e, 0xa0, 0xb0, 0xcc, 0x54, 0x33,
-	0x37, 0xc8, 0xc6, 0x3c, 0x66, 0xb3, 0xed, 0x76, 0xfb, 0x70, 0xec, 0xa2, 0xa3, 0xb1, 0x8b, 0x7e,
-	0x8c, 0x5d, 0xf4, 0x61, 0xe2, 0x96, 0x8e, 0x26, 0x6e, 0xe9, 0xdb, 0xc4, 0x2d, 0xbd, 0x6a, 0x84,
-	0x91, 0xda, 0x1d, 0xf6, 0x68, 0x1f, 0x62, 0xed, 0x75, 0x17, 0xd2, 0x50, 0x7f, 0x04, 0xec, 0x6d,
-	0xee, 0xab, 0x0e, 0x12, 0x2e, 0x7b, 0x65, 0xfd, 0x17, 0xb9, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0xa0, 0xdb, 0x27, 0x6c, 0xc4, 0x04, 0x00, 0x00,
+func (m *QueryAssetsLockedSequenceTipRequest) Reset()         { *m = QueryAssetsLockedSequenceTipRequest{} }
+func (m *QueryAssetsLockedSequenceTipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedSequenceTipRequest) ProtoMessage()    {}
+func (*QueryAssetsLockedSequenceTipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{6}
+}
+func (m *QueryAssetsLockedSequenceTipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetsLockedSequenceTipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedSequenceTipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetsLockedSequenceTipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedSequenceTipRequest.Merge(m, src)
+}
+func (m *QueryAssetsLockedSequenceTipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetsLockedSequenceTipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedSequenceTipRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryAssetsLockedSequenceTipRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AssetsUnlockedSequenceTip queries the assets unlocked sequence tip.
-	AssetsUnlockedSequenceTip(ctx context.Context, in *QueryAssetsUnlockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedSequenceTipResponse, error)
-	// AssetsUnlockedEvents queries the assets unlocked events.
-	AssetsUnlockedEvents(ctx context.Context, in *QueryAssetsUnlockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedEventsResponse, error)
+// QueryAssetsLockedSequenceTipResponse is response type for the
+// Query/AssetsLockedSequenceTip RPC method.
+type QueryAssetsLockedSequenceTipResponse struct {
+	// sequence_tip is the current assets locked sequence tip.
+	SequenceTip cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=sequence_tip,json=sequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"sequence_tip"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAssetsLockedSequenceTipResponse) Reset()         { *m = QueryAssetsLockedSequenceTipResponse{} }
+func (m *QueryAssetsLockedSequenceTipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedSequenceTipResponse) ProtoMessage()    {}
+func (*QueryAssetsLockedSequenceTipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{7}
+}
+func (m *QueryAssetsLockedSequenceTipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetsLockedSequenceTipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedSequenceTipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetsLockedSequenceTipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedSequenceTipResponse.Merge(m, src)
+}
+func (m *QueryAssetsLockedSequenceTipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetsLockedSequenceTipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedSequenceTipResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryAssetsLockedSequenceTipResponse proto.InternalMessageInfo
+
+// QueryAssetsLockedEventRequest is request type for the
+// Query/AssetsLockedEvent RPC method.
+type QueryAssetsLockedEventRequest struct {
+	// sequence is the sequence number of the requested event.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// source_chain is the chain the requested event was locked on. Zero
+	// denotes the primary source chain (Ethereum).
+	SourceChain uint32 `protobuf:"varint,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAssetsLockedEventRequest) Reset()         { *m = QueryAssetsLockedEventRequest{} }
+func (m *QueryAssetsLockedEventRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedEventRequest) ProtoMessage()    {}
+func (*QueryAssetsLockedEventRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{8}
+}
+func (m *QueryAssetsLockedEventRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetsLockedEventRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedEventRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAssetsLockedEventRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedEventRequest.Merge(m, src)
+}
+func (m *QueryAssetsLockedEventRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetsLockedEventRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedEventRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AssetsUnlockedSequenceTip(ctx context.Context, in *QueryAssetsUnlockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedSequenceTipResponse, error) {
-	out := new(QueryAssetsUnlockedSequenceTipResponse)
-	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsUnlockedSequenceTip", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAssetsLockedEventRequest proto.InternalMessageInfo
+
+func (m *QueryAssetsLockedEventRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AssetsUnlockedEvents(ctx context.Context, in *QueryAssetsUnlockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedEventsResponse, error) {
-	out := new(QueryAssetsUnlockedEventsResponse)
-	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsUnlockedEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAssetsLockedEventRequest) GetSourceChain() uint32 {
+	if m != nil {
+		return m.SourceChain
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// AssetsUnlockedSequenceTip queries the assets unlocked sequence tip.
-	AssetsUnlockedSequenceTip(context.Context, *QueryAssetsUnlockedSequenceTipRequest) (*QueryAssetsUnlockedSequenceTipResponse, error)
-	// AssetsUnlockedEvents queries the assets unlocked events.
-	AssetsUnlockedEvents(context.Context, *QueryAssetsUnlockedEventsRequest) (*QueryAssetsUnlockedEventsResponse, error)
+// QueryAssetsLockedEventResponse is response type for the
+// Query/AssetsLockedEvent RPC method.
+type QueryAssetsLockedEventResponse struct {
+	// record is the accepted AssetsLocked event along with its inclusion
+	// height.
+	Record AssetsLockedRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAssetsLockedEventResponse) Reset()         { *m = QueryAssetsLockedEventResponse{} }
+func (m *QueryAssetsLockedEventResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedEventResponse) ProtoMessage()    {}
+func (*QueryAssetsLockedEventResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAssetsLockedEventResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AssetsUnlockedSequenceTip(ctx context.Context, req *QueryAssetsUnlockedSequenceTipRequest) (*QueryAssetsUnlockedSequenceTipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetsUnlockedSequenceTip not implemented")
+func (m *QueryAssetsLockedEventResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedEventResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AssetsUnlockedEvents(ctx context.Context, req *QueryAssetsUnlockedEventsRequest) (*QueryAssetsUnlockedEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetsUnlockedEvents not implemented")
+func (m *QueryAssetsLockedEventResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedEventResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAssetsLockedEventResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mezo.bridge.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAssetsLockedEventResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedEventResponse.DiscardUnknown(m)
 }
 
-func _Query_AssetsUnlockedSequenceTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetsUnlockedSequenceTipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetsUnlockedSequenceTip(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mezo.bridge.v1.Query/AssetsUnlockedSequenceTip",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetsUnlockedSequenceTip(ctx, req.(*QueryAssetsUnlockedSequenceTipRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryAssetsLockedEventResponse proto.InternalMessageInfo
 
-func _Query_AssetsUnlockedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAssetsUnlockedEventsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetsUnlockedEvents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mezo.bridge.v1.Query/AssetsUnlockedEvents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetsUnlockedEvents(ctx, req.(*QueryAssetsUnlockedEventsRequest))
+func (m *QueryAssetsLockedEventResponse) GetRecord() AssetsLockedRecord {
+	if m != nil {
+		return m.Record
 	}
-	return interceptor(ctx, in, info, handler)
+	return AssetsLockedRecord{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mezo.bridge.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AssetsUnlockedSequenceTip",
-			Handler:    _Query_AssetsUnlockedSequenceTip_Handler,
-		},
-		{
-			MethodName: "AssetsUnlockedEvents",
-			Handler:    _Query_AssetsUnlockedEvents_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mezo/bridge/v1/query.proto",
+// QueryAssetsLockedEventsRequest is request type for the
+// Query/AssetsLockedEvents RPC method.
+type QueryAssetsLockedEventsRequest struct {
+	// sequence_start is the start of the sequence range (inclusive). If the
+	// underlying pointer is set to nil, the range starts at the first event
+	// retained in the state.
+	// Notice that it is the underlying pointer that can be set to nil, not the
+	// sequence_start itself.
+	SequenceStart cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=sequence_start,json=sequenceStart,proto3,customtype=cosmossdk.io/math.Int" json:"sequence_start"`
+	// sequence_end is the end of the sequence range (exclusive). If the
+	// underlying pointer is set to nil, the range is unbounded on the upper side.
+	// Notice that it is the underlying pointer that can be set to nil, not the
+	// sequence_end itself.
+	SequenceEnd cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=sequence_end,json=sequenceEnd,proto3,customtype=cosmossdk.io/math.Int" json:"sequence_end"`
+	// source_chain is the chain the requested events were locked on. Zero
+	// denotes the primary source chain (Ethereum).
+	SourceChain uint32 `protobuf:"varint,3,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAssetsLockedEventsRequest) Reset()         { *m = QueryAssetsLockedEventsRequest{} }
+func (m *QueryAssetsLockedEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedEventsRequest) ProtoMessage()    {}
+func (*QueryAssetsLockedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{10}
+}
+func (m *QueryAssetsLockedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetsLockedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAssetsLockedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedEventsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAssetsLockedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetsLockedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedEventsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAssetsLockedEventsRequest proto.InternalMessageInfo
+
+func (m *QueryAssetsLockedEventsRequest) GetSourceChain() uint32 {
+	if m != nil {
+		return m.SourceChain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAssetsLockedEventsResponse is response type for the
+// Query/AssetsLockedEvents RPC method.
+type QueryAssetsLockedEventsResponse struct {
+	// records is a list of accepted AssetsLocked events forming a sequence
+	// strictly increasing by `1`.
+	Records []AssetsLockedRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAssetsLockedEventsResponse) Reset()         { *m = QueryAssetsLockedEventsResponse{} }
+func (m *QueryAssetsLockedEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetsLockedEventsResponse) ProtoMessage()    {}
+func (*QueryAssetsLockedEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{11}
+}
+func (m *QueryAssetsLockedEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetsLockedEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetsLockedEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAssetsLockedEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetsLockedEventsResponse.Merge(m, src)
+}
+func (m *QueryAssetsLockedEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetsLockedEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetsLockedEventsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAssetsUnlockedSequenceTipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAssetsLockedEventsResponse proto.InternalMessageInfo
+
+func (m *QueryAssetsLockedEventsResponse) GetRecords() []AssetsLockedRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAssetsUnlockedSequenceTipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySourceBTCTokenRequest is request type for the Query/SourceBTCToken RPC
+// method.
+type QuerySourceBTCTokenRequest struct {
 }
 
-func (m *QueryAssetsUnlockedSequenceTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySourceBTCTokenRequest) Reset()         { *m = QuerySourceBTCTokenRequest{} }
+func (m *QuerySourceBTCTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceBTCTokenRequest) ProtoMessage()    {}
+func (*QuerySourceBTCTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{12}
 }
-
-func (m *QueryAssetsUnlockedSequenceTipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySourceBTCTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceBTCTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceBTCTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySourceBTCTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceBTCTokenRequest.Merge(m, src)
+}
+func (m *QuerySourceBTCTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceBTCTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceBTCTokenRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAssetsUnlockedSequenceTipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySourceBTCTokenRequest proto.InternalMessageInfo
+
+// QuerySourceBTCTokenResponse is response type for the Query/SourceBTCToken
+// RPC method.
+type QuerySourceBTCTokenResponse struct {
+	// source_btc_token is the hex-encoded EVM address of the BTC token on the
+	// source chain.
+	SourceBtcToken string `protobuf:"bytes,1,opt,name=source_btc_token,json=sourceBtcToken,proto3" json:"source_btc_token,omitempty"`
 }
 
-func (m *QueryAssetsUnlockedSequenceTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SequenceTip.Size()
-		i -= size
-		if _, err := m.SequenceTip.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QuerySourceBTCTokenResponse) Reset()         { *m = QuerySourceBTCTokenResponse{} }
+func (m *QuerySourceBTCTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceBTCTokenResponse) ProtoMessage()    {}
+func (*QuerySourceBTCTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{13}
+}
+func (m *QuerySourceBTCTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceBTCTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceBTCTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySourceBTCTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceBTCTokenResponse.Merge(m, src)
+}
+func (m *QuerySourceBTCTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceBTCTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceBTCTokenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAssetsUnlockedEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySourceBTCTokenResponse proto.InternalMessageInfo
+
+func (m *QuerySourceBTCTokenResponse) GetSourceBtcToken() string {
+	if m != nil {
+		return m.SourceBtcToken
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAssetsUnlockedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryERC20TokenMappingsRequest is request type for the
+// Query/ERC20TokenMappings RPC method.
+type QueryERC20TokenMappingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAssetsUnlockedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SequenceEnd.Size()
-		i -= size
-		if _, err := m.SequenceEnd.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SequenceStart.Size()
-		i -= size
-		if _, err := m.SequenceStart.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryERC20TokenMappingsRequest) Reset()         { *m = QueryERC20TokenMappingsRequest{} }
+func (m *QueryERC20TokenMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TokenMappingsRequest) ProtoMessage()    {}
+func (*QueryERC20TokenMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{14}
+}
+func (m *QueryERC20TokenMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TokenMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TokenMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryERC20TokenMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TokenMappingsRequest.Merge(m, src)
+}
+func (m *QueryERC20TokenMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TokenMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TokenMappingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAssetsUnlockedEventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryERC20TokenMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryERC20TokenMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAssetsUnlockedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryERC20TokenMappingsResponse is response type for the
+// Query/ERC20TokenMappings RPC method.
+type QueryERC20TokenMappingsResponse struct {
+	// mappings is the list of ERC20 token mappings.
+	Mappings []ERC20TokenMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAssetsUnlockedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryERC20TokenMappingsResponse) Reset()         { *m = QueryERC20TokenMappingsResponse{} }
+func (m *QueryERC20TokenMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TokenMappingsResponse) ProtoMessage()    {}
+func (*QueryERC20TokenMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{15}
+}
+func (m *QueryERC20TokenMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TokenMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TokenMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryERC20TokenMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TokenMappingsResponse.Merge(m, src)
+}
+func (m *QueryERC20TokenMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TokenMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TokenMappingsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryERC20TokenMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryERC20TokenMappingsResponse) GetMappings() []ERC20TokenMapping {
+	if m != nil {
+		return m.Mappings
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryERC20TokenMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryERC20TokenMappingRequest is request type for the
+// Query/ERC20TokenMapping RPC method.
+type QueryERC20TokenMappingRequest struct {
+	// source_token is the hex-encoded EVM address of the token on the source
+	// chain.
+	SourceToken string `protobuf:"bytes,1,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"`
 }
 
-func (m *QueryAssetsUnlockedSequenceTipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryERC20TokenMappingRequest) Reset()         { *m = QueryERC20TokenMappingRequest{} }
+func (m *QueryERC20TokenMappingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TokenMappingRequest) ProtoMessage()    {}
+func (*QueryERC20TokenMappingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{16}
+}
+func (m *QueryERC20TokenMappingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TokenMappingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TokenMappingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryERC20TokenMappingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TokenMappingRequest.Merge(m, src)
+}
+func (m *QueryERC20TokenMappingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TokenMappingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TokenMappingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAssetsUnlockedSequenceTipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryERC20TokenMappingRequest proto.InternalMessageInfo
+
+func (m *QueryERC20TokenMappingRequest) GetSourceToken() string {
+	if m != nil {
+		return m.SourceToken
 	}
-	var l int
-	_ = l
-	l = m.SequenceTip.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryAssetsUnlockedEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SequenceStart.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SequenceEnd.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryERC20TokenMappingResponse is response type for the
+// Query/ERC20TokenMapping RPC method.
+type QueryERC20TokenMappingResponse struct {
+	// mapping is the queried ERC20 token mapping.
+	Mapping ERC20TokenMapping `protobuf:"bytes,1,opt,name=mapping,proto3" json:"mapping"`
 }
 
-func (m *QueryAssetsUnlockedEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryERC20TokenMappingResponse) Reset()         { *m = QueryERC20TokenMappingResponse{} }
+func (m *QueryERC20TokenMappingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TokenMappingResponse) ProtoMessage()    {}
+func (*QueryERC20TokenMappingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{17}
+}
+func (m *QueryERC20TokenMappingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TokenMappingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TokenMappingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryERC20TokenMappingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TokenMappingResponse.Merge(m, src)
+}
+func (m *QueryERC20TokenMappingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TokenMappingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TokenMappingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20TokenMappingResponse proto.InternalMessageInfo
+
+func (m *QueryERC20TokenMappingResponse) GetMapping() ERC20TokenMapping {
+	if m != nil {
+		return m.Mapping
+	}
+	return ERC20TokenMapping{}
+}
+
+// QueryBTCSupplyRequest is request type for the Query/BTCSupply RPC method.
+type QueryBTCSupplyRequest struct {
+}
+
+func (m *QueryBTCSupplyRequest) Reset()         { *m = QueryBTCSupplyRequest{} }
+func (m *QueryBTCSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCSupplyRequest) ProtoMessage()    {}
+func (*QueryBTCSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{18}
+}
+func (m *QueryBTCSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBTCSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCSupplyRequest.Merge(m, src)
+}
+func (m *QueryBTCSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCSupplyRequest.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_QueryBTCSupplyRequest proto.InternalMessageInfo
+
+// QueryBTCSupplyResponse is response type for the Query/BTCSupply RPC method.
+type QueryBTCSupplyResponse struct {
+	// minted is the total amount of BTC minted by the bridge.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// burnt is the total amount of BTC burnt by the bridge.
+	Burnt cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=burnt,proto3,customtype=cosmossdk.io/math.Int" json:"burnt"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryBTCSupplyResponse) Reset()         { *m = QueryBTCSupplyResponse{} }
+func (m *QueryBTCSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCSupplyResponse) ProtoMessage()    {}
+func (*QueryBTCSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{19}
+}
+func (m *QueryBTCSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCSupplyResponse.Merge(m, src)
+}
+func (m *QueryBTCSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCSupplyResponse proto.InternalMessageInfo
+
+// ERC20TokenSupply describes the supply accounting of a single mapped ERC20
+// token.
+type ERC20TokenSupply struct {
+	// source_token is the hex-encoded EVM address of the token on the source
+	// chain.
+	SourceToken string `protobuf:"bytes,1,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"`
+	// mezo_token is the hex-encoded EVM address of the token on Mezo.
+	MezoToken string `protobuf:"bytes,2,opt,name=mezo_token,json=mezoToken,proto3" json:"mezo_token,omitempty"`
+	// minted is the total amount of the token minted by the bridge.
+	Minted cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	// burnt is the total amount of the token burnt by the bridge.
+	Burnt cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burnt,proto3,customtype=cosmossdk.io/math.Int" json:"burnt"`
+	// total_supply is the total supply reported by the token contract on Mezo.
+	// It is expected to equal minted - burnt.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+}
+
+func (m *ERC20TokenSupply) Reset()         { *m = ERC20TokenSupply{} }
+func (m *ERC20TokenSupply) String() string { return proto.CompactTextString(m) }
+func (*ERC20TokenSupply) ProtoMessage()    {}
+func (*ERC20TokenSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{20}
+}
+func (m *ERC20TokenSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20TokenSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20TokenSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20TokenSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20TokenSupply.Merge(m, src)
+}
+func (m *ERC20TokenSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20TokenSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20TokenSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20TokenSupply proto.InternalMessageInfo
+
+func (m *ERC20TokenSupply) GetSourceToken() string {
+	if m != nil {
+		return m.SourceToken
+	}
+	return ""
+}
+
+func (m *ERC20TokenSupply) GetMezoToken() string {
+	if m != nil {
+		return m.MezoToken
+	}
+	return ""
+}
+
+// QueryERC20SuppliesRequest is request type for the Query/ERC20Supplies RPC
+// method.
+type QueryERC20SuppliesRequest struct {
+}
+
+func (m *QueryERC20SuppliesRequest) Reset()         { *m = QueryERC20SuppliesRequest{} }
+func (m *QueryERC20SuppliesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20SuppliesRequest) ProtoMessage()    {}
+func (*QueryERC20SuppliesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{21}
+}
+func (m *QueryERC20SuppliesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20SuppliesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20SuppliesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20SuppliesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20SuppliesRequest.Merge(m, src)
+}
+func (m *QueryERC20SuppliesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20SuppliesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20SuppliesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20SuppliesRequest proto.InternalMessageInfo
+
+// QueryERC20SuppliesResponse is response type for the Query/ERC20Supplies RPC
+// method.
+type QueryERC20SuppliesResponse struct {
+	// supplies is the supply accounting of each mapped ERC20 token.
+	Supplies []ERC20TokenSupply `protobuf:"bytes,1,rep,name=supplies,proto3" json:"supplies"`
+}
+
+func (m *QueryERC20SuppliesResponse) Reset()         { *m = QueryERC20SuppliesResponse{} }
+func (m *QueryERC20SuppliesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20SuppliesResponse) ProtoMessage()    {}
+func (*QueryERC20SuppliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{22}
+}
+func (m *QueryERC20SuppliesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20SuppliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20SuppliesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20SuppliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20SuppliesResponse.Merge(m, src)
+}
+func (m *QueryERC20SuppliesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20SuppliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20SuppliesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20SuppliesResponse proto.InternalMessageInfo
+
+func (m *QueryERC20SuppliesResponse) GetSupplies() []ERC20TokenSupply {
+	if m != nil {
+		return m.Supplies
+	}
+	return nil
+}
+
+// QueryERC20SupplyRequest is request type for the Query/ERC20Supply RPC
+// method.
+type QueryERC20SupplyRequest struct {
+	// source_token is the hex-encoded EVM address of the token on the source
+	// chain.
+	SourceToken string `protobuf:"bytes,1,opt,name=source_token,json=sourceToken,proto3" json:"source_token,omitempty"`
+}
+
+func (m *QueryERC20SupplyRequest) Reset()         { *m = QueryERC20SupplyRequest{} }
+func (m *QueryERC20SupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20SupplyRequest) ProtoMessage()    {}
+func (*QueryERC20SupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{23}
+}
+func (m *QueryERC20SupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20SupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20SupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20SupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20SupplyRequest.Merge(m, src)
+}
+func (m *QueryERC20SupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20SupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20SupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20SupplyRequest proto.InternalMessageInfo
+
+func (m *QueryERC20SupplyRequest) GetSourceToken() string {
+	if m != nil {
+		return m.SourceToken
+	}
+	return ""
+}
+
+// QueryERC20SupplyResponse is response type for the Query/ERC20Supply RPC
+// method.
+type QueryERC20SupplyResponse struct {
+	// supply is the supply accounting of the queried ERC20 token.
+	Supply ERC20TokenSupply `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryERC20SupplyResponse) Reset()         { *m = QueryERC20SupplyResponse{} }
+func (m *QueryERC20SupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20SupplyResponse) ProtoMessage()    {}
+func (*QueryERC20SupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{24}
+}
+func (m *QueryERC20SupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20SupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20SupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryERC20SupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20SupplyResponse.Merge(m, src)
+}
+func (m *QueryERC20SupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20SupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20SupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryERC20SupplyResponse proto.InternalMessageInfo
+
+func (m *QueryERC20SupplyResponse) GetSupply() ERC20TokenSupply {
+	if m != nil {
+		return m.Supply
+	}
+	return ERC20TokenSupply{}
+}
+
+// QuerySourceChainsRequest is request type for the Query/SourceChains RPC
+// method.
+type QuerySourceChainsRequest struct {
+}
+
+func (m *QuerySourceChainsRequest) Reset()         { *m = QuerySourceChainsRequest{} }
+func (m *QuerySourceChainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainsRequest) ProtoMessage()    {}
+func (*QuerySourceChainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{25}
+}
+func (m *QuerySourceChainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainsRequest.Merge(m, src)
+}
+func (m *QuerySourceChainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainsRequest proto.InternalMessageInfo
+
+// QuerySourceChainsResponse is response type for the Query/SourceChains RPC
+// method.
+type QuerySourceChainsResponse struct {
+	// source_chains are the additional bridge-in source chains, ordered by
+	// their identifiers.
+	SourceChains []SourceChainState `protobuf:"bytes,1,rep,name=source_chains,json=sourceChains,proto3" json:"source_chains"`
+}
+
+func (m *QuerySourceChainsResponse) Reset()         { *m = QuerySourceChainsResponse{} }
+func (m *QuerySourceChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainsResponse) ProtoMessage()    {}
+func (*QuerySourceChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{26}
+}
+func (m *QuerySourceChainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainsResponse.Merge(m, src)
+}
+func (m *QuerySourceChainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainsResponse proto.InternalMessageInfo
+
+func (m *QuerySourceChainsResponse) GetSourceChains() []SourceChainState {
+	if m != nil {
+		return m.SourceChains
+	}
+	return nil
+}
+
+// QuerySourceChainRequest is request type for the Query/SourceChain RPC
+// method.
+type QuerySourceChainRequest struct {
+	// chain is the identifier of the source chain.
+	Chain uint32 `protobuf:"varint,1,opt,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (m *QuerySourceChainRequest) Reset()         { *m = QuerySourceChainRequest{} }
+func (m *QuerySourceChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainRequest) ProtoMessage()    {}
+func (*QuerySourceChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{27}
+}
+func (m *QuerySourceChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainRequest.Merge(m, src)
+}
+func (m *QuerySourceChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainRequest proto.InternalMessageInfo
+
+func (m *QuerySourceChainRequest) GetChain() uint32 {
+	if m != nil {
+		return m.Chain
+	}
+	return 0
+}
+
+// QuerySourceChainResponse is response type for the Query/SourceChain RPC
+// method.
+type QuerySourceChainResponse struct {
+	// source_chain is the queried source chain.
+	SourceChain SourceChainState `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain"`
+}
+
+func (m *QuerySourceChainResponse) Reset()         { *m = QuerySourceChainResponse{} }
+func (m *QuerySourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainResponse) ProtoMessage()    {}
+func (*QuerySourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{28}
+}
+func (m *QuerySourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainResponse.Merge(m, src)
+}
+func (m *QuerySourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySourceChainResponse proto.InternalMessageInfo
+
+func (m *QuerySourceChainResponse) GetSourceChain() SourceChainState {
+	if m != nil {
+		return m.SourceChain
+	}
+	return SourceChainState{}
+}
+
+// OutflowCapacity describes the outflow state of a single Mezo token.
+type OutflowCapacity struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// limit is the outflow limit for this token.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// current_outflow is the amount already bridged out in the current outflow
+	// period.
+	CurrentOutflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=current_outflow,json=currentOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"current_outflow"`
+	// capacity is the amount that can still be bridged out in the current
+	// outflow period.
+	Capacity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=capacity,proto3,customtype=cosmossdk.io/math.Int" json:"capacity"`
+	// window is the rolling outflow window of this token. A zero window means
+	// the token uses the fixed-interval outflow periods.
+	Window OutflowWindow `protobuf:"bytes,5,opt,name=window,proto3" json:"window"`
+	// reset_height is the block height at which the capacity of this token is
+	// next replenished.
+	ResetHeight uint64 `protobuf:"varint,6,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+}
+
+func (m *OutflowCapacity) Reset()         { *m = OutflowCapacity{} }
+func (m *OutflowCapacity) String() string { return proto.CompactTextString(m) }
+func (*OutflowCapacity) ProtoMessage()    {}
+func (*OutflowCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{29}
+}
+func (m *OutflowCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowCapacity.Merge(m, src)
+}
+func (m *OutflowCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowCapacity proto.InternalMessageInfo
+
+func (m *OutflowCapacity) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *OutflowCapacity) GetWindow() OutflowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return OutflowWindow{}
+}
+
+func (m *OutflowCapacity) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+// QueryOutflowLimitsRequest is request type for the Query/OutflowLimits RPC
+// method.
+type QueryOutflowLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutflowLimitsRequest) Reset()         { *m = QueryOutflowLimitsRequest{} }
+func (m *QueryOutflowLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowLimitsRequest) ProtoMessage()    {}
+func (*QueryOutflowLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{30}
+}
+func (m *QueryOutflowLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowLimitsRequest.Merge(m, src)
+}
+func (m *QueryOutflowLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryOutflowLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOutflowLimitsResponse is response type for the Query/OutflowLimits RPC
+// method.
+type QueryOutflowLimitsResponse struct {
+	// outflows is the outflow state of each token that has an outflow limit.
+	Outflows []OutflowCapacity `protobuf:"bytes,1,rep,name=outflows,proto3" json:"outflows"`
+	// reset_height is the block height at which the fixed-interval outflow
+	// period resets. It is zero if none of the returned tokens uses the
+	// fixed-interval periods. Clients should use the per-token reset_height of
+	// outflows as tokens on rolling windows are replenished independently.
+	ResetHeight uint64 `protobuf:"varint,2,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutflowLimitsResponse) Reset()         { *m = QueryOutflowLimitsResponse{} }
+func (m *QueryOutflowLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowLimitsResponse) ProtoMessage()    {}
+func (*QueryOutflowLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{31}
+}
+func (m *QueryOutflowLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowLimitsResponse.Merge(m, src)
+}
+func (m *QueryOutflowLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryOutflowLimitsResponse) GetOutflows() []OutflowCapacity {
+	if m != nil {
+		return m.Outflows
+	}
+	return nil
+}
+
+func (m *QueryOutflowLimitsResponse) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+func (m *QueryOutflowLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOutflowCapacityRequest is request type for the Query/OutflowCapacity
+// RPC method.
+type QueryOutflowCapacityRequest struct {
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryOutflowCapacityRequest) Reset()         { *m = QueryOutflowCapacityRequest{} }
+func (m *QueryOutflowCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowCapacityRequest) ProtoMessage()    {}
+func (*QueryOutflowCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{32}
+}
+func (m *QueryOutflowCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowCapacityRequest.Merge(m, src)
+}
+func (m *QueryOutflowCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryOutflowCapacityRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryOutflowCapacityResponse is response type for the Query/OutflowCapacity
+// RPC method.
+type QueryOutflowCapacityResponse struct {
+	// outflow is the outflow state of the queried token.
+	Outflow OutflowCapacity `protobuf:"bytes,1,opt,name=outflow,proto3" json:"outflow"`
+	// reset_height is the block height at which the fixed-interval outflow
+	// period resets. It is zero if the token uses a rolling window. Clients
+	// should use the reset_height of outflow instead.
+	ResetHeight uint64 `protobuf:"varint,2,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+}
+
+func (m *QueryOutflowCapacityResponse) Reset()         { *m = QueryOutflowCapacityResponse{} }
+func (m *QueryOutflowCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowCapacityResponse) ProtoMessage()    {}
+func (*QueryOutflowCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{33}
+}
+func (m *QueryOutflowCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowCapacityResponse.Merge(m, src)
+}
+func (m *QueryOutflowCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryOutflowCapacityResponse) GetOutflow() OutflowCapacity {
+	if m != nil {
+		return m.Outflow
+	}
+	return OutflowCapacity{}
+}
+
+func (m *QueryOutflowCapacityResponse) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+// QueryUSDOutflowCapacityRequest is request type for the
+// Query/USDOutflowCapacity RPC method.
+type QueryUSDOutflowCapacityRequest struct {
+}
+
+func (m *QueryUSDOutflowCapacityRequest) Reset()         { *m = QueryUSDOutflowCapacityRequest{} }
+func (m *QueryUSDOutflowCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUSDOutflowCapacityRequest) ProtoMessage()    {}
+func (*QueryUSDOutflowCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{34}
+}
+func (m *QueryUSDOutflowCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUSDOutflowCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUSDOutflowCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUSDOutflowCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUSDOutflowCapacityRequest.Merge(m, src)
+}
+func (m *QueryUSDOutflowCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUSDOutflowCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUSDOutflowCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUSDOutflowCapacityRequest proto.InternalMessageInfo
+
+// QueryUSDOutflowCapacityResponse is response type for the
+// Query/USDOutflowCapacity RPC method. All amounts are in USD with 18
+// decimals.
+type QueryUSDOutflowCapacityResponse struct {
+	// limit is the USD outflow limit. Zero means the limit is disabled.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// current_outflow is the USD value already bridged out in the current
+	// outflow period or window.
+	CurrentOutflow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=current_outflow,json=currentOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"current_outflow"`
+	// capacity is the USD value that can still be bridged out.
+	Capacity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=capacity,proto3,customtype=cosmossdk.io/math.Int" json:"capacity"`
+	// window is the rolling window of the USD outflow limit. A zero window
+	// means the fixed-interval outflow periods are used.
+	Window OutflowWindow `protobuf:"bytes,4,opt,name=window,proto3" json:"window"`
+	// reset_height is the block height at which the USD capacity is next
+	// replenished.
+	ResetHeight uint64 `protobuf:"varint,5,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+}
+
+func (m *QueryUSDOutflowCapacityResponse) Reset()         { *m = QueryUSDOutflowCapacityResponse{} }
+func (m *QueryUSDOutflowCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUSDOutflowCapacityResponse) ProtoMessage()    {}
+func (*QueryUSDOutflowCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{35}
+}
+func (m *QueryUSDOutflowCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUSDOutflowCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUSDOutflowCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUSDOutflowCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUSDOutflowCapacityResponse.Merge(m, src)
+}
+func (m *QueryUSDOutflowCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUSDOutflowCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUSDOutflowCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUSDOutflowCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryUSDOutflowCapacityResponse) GetWindow() OutflowWindow {
+	if m != nil {
+		return m.Window
+	}
+	return OutflowWindow{}
+}
+
+func (m *QueryUSDOutflowCapacityResponse) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+// QueryOutflowPriceFeedsRequest is request type for the
+// Query/OutflowPriceFeeds RPC method.
+type QueryOutflowPriceFeedsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutflowPriceFeedsRequest) Reset()         { *m = QueryOutflowPriceFeedsRequest{} }
+func (m *QueryOutflowPriceFeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowPriceFeedsRequest) ProtoMessage()    {}
+func (*QueryOutflowPriceFeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{36}
+}
+func (m *QueryOutflowPriceFeedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowPriceFeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowPriceFeedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowPriceFeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowPriceFeedsRequest.Merge(m, src)
+}
+func (m *QueryOutflowPriceFeedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowPriceFeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowPriceFeedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowPriceFeedsRequest proto.InternalMessageInfo
+
+func (m *QueryOutflowPriceFeedsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOutflowPriceFeedsResponse is response type for the
+// Query/OutflowPriceFeeds RPC method.
+type QueryOutflowPriceFeedsResponse struct {
+	// price_feeds is the list of per-token outflow price feeds.
+	PriceFeeds []OutflowPriceFeed `protobuf:"bytes,1,rep,name=price_feeds,json=priceFeeds,proto3" json:"price_feeds"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutflowPriceFeedsResponse) Reset()         { *m = QueryOutflowPriceFeedsResponse{} }
+func (m *QueryOutflowPriceFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutflowPriceFeedsResponse) ProtoMessage()    {}
+func (*QueryOutflowPriceFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{37}
+}
+func (m *QueryOutflowPriceFeedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutflowPriceFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutflowPriceFeedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutflowPriceFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutflowPriceFeedsResponse.Merge(m, src)
+}
+func (m *QueryOutflowPriceFeedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutflowPriceFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutflowPriceFeedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutflowPriceFeedsResponse proto.InternalMessageInfo
+
+func (m *QueryOutflowPriceFeedsResponse) GetPriceFeeds() []OutflowPriceFeed {
+	if m != nil {
+		return m.PriceFeeds
+	}
+	return nil
+}
+
+func (m *QueryOutflowPriceFeedsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySenderOutflowLimitsRequest is request type for the
+// Query/SenderOutflowLimits RPC method.
+type QuerySenderOutflowLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySenderOutflowLimitsRequest) Reset()         { *m = QuerySenderOutflowLimitsRequest{} }
+func (m *QuerySenderOutflowLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderOutflowLimitsRequest) ProtoMessage()    {}
+func (*QuerySenderOutflowLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{38}
+}
+func (m *QuerySenderOutflowLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderOutflowLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderOutflowLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderOutflowLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderOutflowLimitsRequest.Merge(m, src)
+}
+func (m *QuerySenderOutflowLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderOutflowLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderOutflowLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderOutflowLimitsRequest proto.InternalMessageInfo
+
+func (m *QuerySenderOutflowLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySenderOutflowLimitsResponse is response type for the
+// Query/SenderOutflowLimits RPC method.
+type QuerySenderOutflowLimitsResponse struct {
+	// params are the per-sender bridge-out limit parameters.
+	Params SenderOutflowParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// limits is the list of per-sender outflow limits of tokens.
+	Limits []SenderOutflowLimit `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySenderOutflowLimitsResponse) Reset()         { *m = QuerySenderOutflowLimitsResponse{} }
+func (m *QuerySenderOutflowLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderOutflowLimitsResponse) ProtoMessage()    {}
+func (*QuerySenderOutflowLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{39}
+}
+func (m *QuerySenderOutflowLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderOutflowLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderOutflowLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderOutflowLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderOutflowLimitsResponse.Merge(m, src)
+}
+func (m *QuerySenderOutflowLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderOutflowLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderOutflowLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderOutflowLimitsResponse proto.InternalMessageInfo
+
+func (m *QuerySenderOutflowLimitsResponse) GetParams() SenderOutflowParams {
+	if m != nil {
+		return m.Params
+	}
+	return SenderOutflowParams{}
+}
+
+func (m *QuerySenderOutflowLimitsResponse) GetLimits() []SenderOutflowLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *QuerySenderOutflowLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySenderOutflowCapacityRequest is request type for the
+// Query/SenderOutflowCapacity RPC method.
+type QuerySenderOutflowCapacityRequest struct {
+	// sender is the sender's hex-encoded EVM address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// token is the Mezo token's hex-encoded EVM address. If empty, only the
+	// count capacity is returned.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QuerySenderOutflowCapacityRequest) Reset()         { *m = QuerySenderOutflowCapacityRequest{} }
+func (m *QuerySenderOutflowCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderOutflowCapacityRequest) ProtoMessage()    {}
+func (*QuerySenderOutflowCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{40}
+}
+func (m *QuerySenderOutflowCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderOutflowCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderOutflowCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderOutflowCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderOutflowCapacityRequest.Merge(m, src)
+}
+func (m *QuerySenderOutflowCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderOutflowCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderOutflowCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderOutflowCapacityRequest proto.InternalMessageInfo
+
+func (m *QuerySenderOutflowCapacityRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QuerySenderOutflowCapacityRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QuerySenderOutflowCapacityResponse is response type for the
+// Query/SenderOutflowCapacity RPC method.
+type QuerySenderOutflowCapacityResponse struct {
+	// capacity is the per-sender bridge-out state of the queried sender.
+	Capacity SenderOutflowCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity"`
+}
+
+func (m *QuerySenderOutflowCapacityResponse) Reset()         { *m = QuerySenderOutflowCapacityResponse{} }
+func (m *QuerySenderOutflowCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySenderOutflowCapacityResponse) ProtoMessage()    {}
+func (*QuerySenderOutflowCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{41}
+}
+func (m *QuerySenderOutflowCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySenderOutflowCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySenderOutflowCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySenderOutflowCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySenderOutflowCapacityResponse.Merge(m, src)
+}
+func (m *QuerySenderOutflowCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySenderOutflowCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySenderOutflowCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySenderOutflowCapacityResponse proto.InternalMessageInfo
+
+func (m *QuerySenderOutflowCapacityResponse) GetCapacity() SenderOutflowCapacity {
+	if m != nil {
+		return m.Capacity
+	}
+	return SenderOutflowCapacity{}
+}
+
+// SenderOutflowCapacity describes the per-sender bridge-out state of a single
+// sender. A zero limit means the corresponding dimension is not limited and
+// the corresponding capacity is meaningless.
+type SenderOutflowCapacity struct {
+	// sender is the sender's hex-encoded EVM address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// token is the Mezo token's hex-encoded EVM address.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// amount_limit is the per-sender outflow limit of the token.
+	AmountLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_limit,json=amountLimit,proto3,customtype=cosmossdk.io/math.Int" json:"amount_limit"`
+	// current_amount is the amount of the token the sender bridged out in the
+	// current window.
+	CurrentAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current_amount,json=currentAmount,proto3,customtype=cosmossdk.io/math.Int" json:"current_amount"`
+	// amount_capacity is the amount of the token the sender can still bridge
+	// out in the current window.
+	AmountCapacity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_capacity,json=amountCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"amount_capacity"`
+	// count_limit is the maximum number of bridge-outs per sender and window.
+	CountLimit uint32 `protobuf:"varint,6,opt,name=count_limit,json=countLimit,proto3" json:"count_limit,omitempty"`
+	// current_count is the number of bridge-outs the sender made in the
+	// current window.
+	CurrentCount uint32 `protobuf:"varint,7,opt,name=current_count,json=currentCount,proto3" json:"current_count,omitempty"`
+	// count_capacity is the number of bridge-outs the sender can still make in
+	// the current window.
+	CountCapacity uint32 `protobuf:"varint,8,opt,name=count_capacity,json=countCapacity,proto3" json:"count_capacity,omitempty"`
+	// reset_height is the block height at which the current window ends.
+	// Zero if the per-sender limits are disabled.
+	ResetHeight uint64 `protobuf:"varint,9,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+}
+
+func (m *SenderOutflowCapacity) Reset()         { *m = SenderOutflowCapacity{} }
+func (m *SenderOutflowCapacity) String() string { return proto.CompactTextString(m) }
+func (*SenderOutflowCapacity) ProtoMessage()    {}
+func (*SenderOutflowCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{42}
+}
+func (m *SenderOutflowCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SenderOutflowCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SenderOutflowCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SenderOutflowCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SenderOutflowCapacity.Merge(m, src)
+}
+func (m *SenderOutflowCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *SenderOutflowCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_SenderOutflowCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SenderOutflowCapacity proto.InternalMessageInfo
+
+func (m *SenderOutflowCapacity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SenderOutflowCapacity) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *SenderOutflowCapacity) GetCountLimit() uint32 {
+	if m != nil {
+		return m.CountLimit
+	}
+	return 0
+}
+
+func (m *SenderOutflowCapacity) GetCurrentCount() uint32 {
+	if m != nil {
+		return m.CurrentCount
+	}
+	return 0
+}
+
+func (m *SenderOutflowCapacity) GetCountCapacity() uint32 {
+	if m != nil {
+		return m.CountCapacity
+	}
+	return 0
+}
+
+func (m *SenderOutflowCapacity) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+// QueryDelayedBridgeOutParamsRequest is request type for the
+// Query/DelayedBridgeOutParams RPC method.
+type QueryDelayedBridgeOutParamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedBridgeOutParamsRequest) Reset()         { *m = QueryDelayedBridgeOutParamsRequest{} }
+func (m *QueryDelayedBridgeOutParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutParamsRequest) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{43}
+}
+func (m *QueryDelayedBridgeOutParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutParamsRequest.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutParamsRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutParamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelayedBridgeOutParamsResponse is response type for the
+// Query/DelayedBridgeOutParams RPC method.
+type QueryDelayedBridgeOutParamsResponse struct {
+	// delay_blocks is the number of blocks bridge-outs reaching the threshold
+	// of their token are held in the queue. Zero if the queue is disabled.
+	DelayBlocks uint64 `protobuf:"varint,1,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	// thresholds are the delayed bridge-out thresholds of tokens having one.
+	Thresholds []DelayedBridgeOutThreshold `protobuf:"bytes,2,rep,name=thresholds,proto3" json:"thresholds"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) Reset()         { *m = QueryDelayedBridgeOutParamsResponse{} }
+func (m *QueryDelayedBridgeOutParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutParamsResponse) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{44}
+}
+func (m *QueryDelayedBridgeOutParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutParamsResponse.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutParamsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutParamsResponse) GetDelayBlocks() uint64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) GetThresholds() []DelayedBridgeOutThreshold {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelayedBridgeOutsRequest is request type for the
+// Query/DelayedBridgeOuts RPC method.
+type QueryDelayedBridgeOutsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedBridgeOutsRequest) Reset()         { *m = QueryDelayedBridgeOutsRequest{} }
+func (m *QueryDelayedBridgeOutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutsRequest) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{45}
+}
+func (m *QueryDelayedBridgeOutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutsRequest.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutsRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelayedBridgeOutsResponse is response type for the
+// Query/DelayedBridgeOuts RPC method.
+type QueryDelayedBridgeOutsResponse struct {
+	// bridge_outs is the list of bridge-outs held in the queue, in release
+	// order.
+	BridgeOuts []DelayedBridgeOut `protobuf:"bytes,1,rep,name=bridge_outs,json=bridgeOuts,proto3" json:"bridge_outs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelayedBridgeOutsResponse) Reset()         { *m = QueryDelayedBridgeOutsResponse{} }
+func (m *QueryDelayedBridgeOutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutsResponse) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{46}
+}
+func (m *QueryDelayedBridgeOutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutsResponse.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutsResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutsResponse) GetBridgeOuts() []DelayedBridgeOut {
+	if m != nil {
+		return m.BridgeOuts
+	}
+	return nil
+}
+
+func (m *QueryDelayedBridgeOutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelayedBridgeOutRequest is request type for the
+// Query/DelayedBridgeOut RPC method.
+type QueryDelayedBridgeOutRequest struct {
+	// id is the identifier of the delayed bridge-out.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDelayedBridgeOutRequest) Reset()         { *m = QueryDelayedBridgeOutRequest{} }
+func (m *QueryDelayedBridgeOutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutRequest) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{47}
+}
+func (m *QueryDelayedBridgeOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutRequest.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutRequest proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryDelayedBridgeOutResponse is response type for the
+// Query/DelayedBridgeOut RPC method.
+type QueryDelayedBridgeOutResponse struct {
+	// bridge_out is the queried delayed bridge-out.
+	BridgeOut DelayedBridgeOut `protobuf:"bytes,1,opt,name=bridge_out,json=bridgeOut,proto3" json:"bridge_out"`
+}
+
+func (m *QueryDelayedBridgeOutResponse) Reset()         { *m = QueryDelayedBridgeOutResponse{} }
+func (m *QueryDelayedBridgeOutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelayedBridgeOutResponse) ProtoMessage()    {}
+func (*QueryDelayedBridgeOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{48}
+}
+func (m *QueryDelayedBridgeOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelayedBridgeOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelayedBridgeOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelayedBridgeOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelayedBridgeOutResponse.Merge(m, src)
+}
+func (m *QueryDelayedBridgeOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelayedBridgeOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelayedBridgeOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelayedBridgeOutResponse proto.InternalMessageInfo
+
+func (m *QueryDelayedBridgeOutResponse) GetBridgeOut() DelayedBridgeOut {
+	if m != nil {
+		return m.BridgeOut
+	}
+	return DelayedBridgeOut{}
+}
+
+// QueryBridgeOutFeesRequest is request type for the Query/BridgeOutFees RPC
+// method.
+type QueryBridgeOutFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBridgeOutFeesRequest) Reset()         { *m = QueryBridgeOutFeesRequest{} }
+func (m *QueryBridgeOutFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeOutFeesRequest) ProtoMessage()    {}
+func (*QueryBridgeOutFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{49}
+}
+func (m *QueryBridgeOutFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeOutFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeOutFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeOutFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeOutFeesRequest.Merge(m, src)
+}
+func (m *QueryBridgeOutFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeOutFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeOutFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeOutFeesRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeOutFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBridgeOutFeesResponse is response type for the Query/BridgeOutFees
+// RPC method.
+type QueryBridgeOutFeesResponse struct {
+	// treasury is the hex-encoded EVM address receiving the bridge-out fees.
+	// Empty if not set.
+	Treasury string `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// fees are the bridge-out fees of tokens and target chains having one.
+	Fees []BridgeOutFee `protobuf:"bytes,2,rep,name=fees,proto3" json:"fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBridgeOutFeesResponse) Reset()         { *m = QueryBridgeOutFeesResponse{} }
+func (m *QueryBridgeOutFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeOutFeesResponse) ProtoMessage()    {}
+func (*QueryBridgeOutFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{50}
+}
+func (m *QueryBridgeOutFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeOutFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeOutFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeOutFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeOutFeesResponse.Merge(m, src)
+}
+func (m *QueryBridgeOutFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeOutFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeOutFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeOutFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeOutFeesResponse) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *QueryBridgeOutFeesResponse) GetFees() []BridgeOutFee {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *QueryBridgeOutFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMinBridgeOutAmountsRequest is request type for the
+// Query/MinBridgeOutAmounts RPC method.
+type QueryMinBridgeOutAmountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMinBridgeOutAmountsRequest) Reset()         { *m = QueryMinBridgeOutAmountsRequest{} }
+func (m *QueryMinBridgeOutAmountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinBridgeOutAmountsRequest) ProtoMessage()    {}
+func (*QueryMinBridgeOutAmountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{51}
+}
+func (m *QueryMinBridgeOutAmountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinBridgeOutAmountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinBridgeOutAmountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinBridgeOutAmountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinBridgeOutAmountsRequest.Merge(m, src)
+}
+func (m *QueryMinBridgeOutAmountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinBridgeOutAmountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinBridgeOutAmountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinBridgeOutAmountsRequest proto.InternalMessageInfo
+
+func (m *QueryMinBridgeOutAmountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMinBridgeOutAmountsResponse is response type for the
+// Query/MinBridgeOutAmounts RPC method.
+type QueryMinBridgeOutAmountsResponse struct {
+	// min_bridge_out_amounts is the list of per-token minimum bridge-out
+	// amounts.
+	MinBridgeOutAmounts []TokenMinBridgeOutAmount `protobuf:"bytes,1,rep,name=min_bridge_out_amounts,json=minBridgeOutAmounts,proto3" json:"min_bridge_out_amounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) Reset()         { *m = QueryMinBridgeOutAmountsResponse{} }
+func (m *QueryMinBridgeOutAmountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinBridgeOutAmountsResponse) ProtoMessage()    {}
+func (*QueryMinBridgeOutAmountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{52}
+}
+func (m *QueryMinBridgeOutAmountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinBridgeOutAmountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinBridgeOutAmountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinBridgeOutAmountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinBridgeOutAmountsResponse.Merge(m, src)
+}
+func (m *QueryMinBridgeOutAmountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinBridgeOutAmountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinBridgeOutAmountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinBridgeOutAmountsResponse proto.InternalMessageInfo
+
+func (m *QueryMinBridgeOutAmountsResponse) GetMinBridgeOutAmounts() []TokenMinBridgeOutAmount {
+	if m != nil {
+		return m.MinBridgeOutAmounts
+	}
+	return nil
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMinBridgeOutAmountForBitcoinChainRequest is request type for the
+// Query/MinBridgeOutAmountForBitcoinChain RPC method.
+type QueryMinBridgeOutAmountForBitcoinChainRequest struct {
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) Reset() {
+	*m = QueryMinBridgeOutAmountForBitcoinChainRequest{}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMinBridgeOutAmountForBitcoinChainRequest) ProtoMessage() {}
+func (*QueryMinBridgeOutAmountForBitcoinChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{53}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainRequest.Merge(m, src)
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainRequest proto.InternalMessageInfo
+
+// QueryMinBridgeOutAmountForBitcoinChainResponse is response type for the
+// Query/MinBridgeOutAmountForBitcoinChain RPC method.
+type QueryMinBridgeOutAmountForBitcoinChainResponse struct {
+	// amount is the minimum amount required for bridging out to the Bitcoin
+	// chain.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) Reset() {
+	*m = QueryMinBridgeOutAmountForBitcoinChainResponse{}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryMinBridgeOutAmountForBitcoinChainResponse) ProtoMessage() {}
+func (*QueryMinBridgeOutAmountForBitcoinChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{54}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainResponse.Merge(m, src)
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinBridgeOutAmountForBitcoinChainResponse proto.InternalMessageInfo
+
+// QueryBridgeOutChainsRequest is request type for the Query/BridgeOutChains
+// RPC method.
+type QueryBridgeOutChainsRequest struct {
+}
+
+func (m *QueryBridgeOutChainsRequest) Reset()         { *m = QueryBridgeOutChainsRequest{} }
+func (m *QueryBridgeOutChainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeOutChainsRequest) ProtoMessage()    {}
+func (*QueryBridgeOutChainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{55}
+}
+func (m *QueryBridgeOutChainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeOutChainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeOutChainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeOutChainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeOutChainsRequest.Merge(m, src)
+}
+func (m *QueryBridgeOutChainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeOutChainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeOutChainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeOutChainsRequest proto.InternalMessageInfo
+
+// QueryBridgeOutChainsResponse is response type for the Query/BridgeOutChains
+// RPC method.
+type QueryBridgeOutChainsResponse struct {
+	// chains is the list of target chains that accept bridge-outs, in
+	// ascending order.
+	Chains []uint32 `protobuf:"varint,1,rep,packed,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (m *QueryBridgeOutChainsResponse) Reset()         { *m = QueryBridgeOutChainsResponse{} }
+func (m *QueryBridgeOutChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeOutChainsResponse) ProtoMessage()    {}
+func (*QueryBridgeOutChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{56}
+}
+func (m *QueryBridgeOutChainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeOutChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeOutChainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeOutChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeOutChainsResponse.Merge(m, src)
+}
+func (m *QueryBridgeOutChainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeOutChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeOutChainsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeOutChainsResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeOutChainsResponse) GetChains() []uint32 {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+// QueryPauseStateRequest is request type for the Query/PauseState RPC method.
+type QueryPauseStateRequest struct {
+}
+
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{57}
+}
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
+}
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+// QueryPauseStateResponse is response type for the Query/PauseState RPC
+// method.
+type QueryPauseStateResponse struct {
+	// bridge_in_paused indicates whether bridging in is paused.
+	BridgeInPaused bool `protobuf:"varint,1,opt,name=bridge_in_paused,json=bridgeInPaused,proto3" json:"bridge_in_paused,omitempty"`
+	// bridge_out_paused indicates whether bridging out is paused.
+	BridgeOutPaused bool `protobuf:"varint,2,opt,name=bridge_out_paused,json=bridgeOutPaused,proto3" json:"bridge_out_paused,omitempty"`
+}
+
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{58}
+}
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
+}
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStateResponse) GetBridgeInPaused() bool {
+	if m != nil {
+		return m.BridgeInPaused
+	}
+	return false
+}
+
+func (m *QueryPauseStateResponse) GetBridgeOutPaused() bool {
+	if m != nil {
+		return m.BridgeOutPaused
+	}
+	return false
+}
+
+// QueryTripartyControllersRequest is request type for the
+// Query/TripartyControllers RPC method.
+type QueryTripartyControllersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyControllersRequest) Reset()         { *m = QueryTripartyControllersRequest{} }
+func (m *QueryTripartyControllersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyControllersRequest) ProtoMessage()    {}
+func (*QueryTripartyControllersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{59}
+}
+func (m *QueryTripartyControllersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyControllersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyControllersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyControllersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyControllersRequest.Merge(m, src)
+}
+func (m *QueryTripartyControllersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyControllersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyControllersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyControllersRequest proto.InternalMessageInfo
+
+func (m *QueryTripartyControllersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyControllersResponse is response type for the
+// Query/TripartyControllers RPC method.
+type QueryTripartyControllersResponse struct {
+	// controllers is the list of allowed triparty controllers, as hex-encoded
+	// EVM addresses.
+	Controllers []string `protobuf:"bytes,1,rep,name=controllers,proto3" json:"controllers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyControllersResponse) Reset()         { *m = QueryTripartyControllersResponse{} }
+func (m *QueryTripartyControllersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyControllersResponse) ProtoMessage()    {}
+func (*QueryTripartyControllersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{60}
+}
+func (m *QueryTripartyControllersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyControllersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyControllersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyControllersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyControllersResponse.Merge(m, src)
+}
+func (m *QueryTripartyControllersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyControllersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyControllersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyControllersResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyControllersResponse) GetControllers() []string {
+	if m != nil {
+		return m.Controllers
+	}
+	return nil
+}
+
+func (m *QueryTripartyControllersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyBlockDelayRequest is request type for the
+// Query/TripartyBlockDelay RPC method.
+type QueryTripartyBlockDelayRequest struct {
+}
+
+func (m *QueryTripartyBlockDelayRequest) Reset()         { *m = QueryTripartyBlockDelayRequest{} }
+func (m *QueryTripartyBlockDelayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyBlockDelayRequest) ProtoMessage()    {}
+func (*QueryTripartyBlockDelayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{61}
+}
+func (m *QueryTripartyBlockDelayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyBlockDelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyBlockDelayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyBlockDelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyBlockDelayRequest.Merge(m, src)
+}
+func (m *QueryTripartyBlockDelayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyBlockDelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyBlockDelayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyBlockDelayRequest proto.InternalMessageInfo
+
+// QueryTripartyBlockDelayResponse is response type for the
+// Query/TripartyBlockDelay RPC method.
+type QueryTripartyBlockDelayResponse struct {
+	// block_delay is the number of blocks that must pass between request
+	// creation and processing.
+	BlockDelay int64 `protobuf:"varint,1,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty"`
+}
+
+func (m *QueryTripartyBlockDelayResponse) Reset()         { *m = QueryTripartyBlockDelayResponse{} }
+func (m *QueryTripartyBlockDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyBlockDelayResponse) ProtoMessage()    {}
+func (*QueryTripartyBlockDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{62}
+}
+func (m *QueryTripartyBlockDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyBlockDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyBlockDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyBlockDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyBlockDelayResponse.Merge(m, src)
+}
+func (m *QueryTripartyBlockDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyBlockDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyBlockDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyBlockDelayResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyBlockDelayResponse) GetBlockDelay() int64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+// QueryTripartyLimitsRequest is request type for the Query/TripartyLimits RPC
+// method.
+type QueryTripartyLimitsRequest struct {
+}
+
+func (m *QueryTripartyLimitsRequest) Reset()         { *m = QueryTripartyLimitsRequest{} }
+func (m *QueryTripartyLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyLimitsRequest) ProtoMessage()    {}
+func (*QueryTripartyLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{63}
+}
+func (m *QueryTripartyLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyLimitsRequest.Merge(m, src)
+}
+func (m *QueryTripartyLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyLimitsRequest proto.InternalMessageInfo
+
+// QueryTripartyLimitsResponse is response type for the Query/TripartyLimits
+// RPC method.
+type QueryTripartyLimitsResponse struct {
+	// per_request_limit is the per-request triparty limit.
+	PerRequestLimit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=per_request_limit,json=perRequestLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_request_limit"`
+	// window_limit is the triparty request window limit.
+	WindowLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=window_limit,json=windowLimit,proto3,customtype=cosmossdk.io/math.Int" json:"window_limit"`
+}
+
+func (m *QueryTripartyLimitsResponse) Reset()         { *m = QueryTripartyLimitsResponse{} }
+func (m *QueryTripartyLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyLimitsResponse) ProtoMessage()    {}
+func (*QueryTripartyLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{64}
+}
+func (m *QueryTripartyLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyLimitsResponse.Merge(m, src)
+}
+func (m *QueryTripartyLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyLimitsResponse proto.InternalMessageInfo
+
+// QueryTripartyCapacityRequest is request type for the Query/TripartyCapacity
+// RPC method.
+type QueryTripartyCapacityRequest struct {
+}
+
+func (m *QueryTripartyCapacityRequest) Reset()         { *m = QueryTripartyCapacityRequest{} }
+func (m *QueryTripartyCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyCapacityRequest) ProtoMessage()    {}
+func (*QueryTripartyCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{65}
+}
+func (m *QueryTripartyCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyCapacityRequest.Merge(m, src)
+}
+func (m *QueryTripartyCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyCapacityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyCapacityRequest proto.InternalMessageInfo
+
+// QueryTripartyCapacityResponse is response type for the
+// Query/TripartyCapacity RPC method.
+type QueryTripartyCapacityResponse struct {
+	// capacity is the remaining triparty request window capacity.
+	Capacity cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=capacity,proto3,customtype=cosmossdk.io/math.Int" json:"capacity"`
+	// reset_height is the block height at which the window resets.
+	ResetHeight uint64 `protobuf:"varint,2,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+}
+
+func (m *QueryTripartyCapacityResponse) Reset()         { *m = QueryTripartyCapacityResponse{} }
+func (m *QueryTripartyCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyCapacityResponse) ProtoMessage()    {}
+func (*QueryTripartyCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{66}
+}
+func (m *QueryTripartyCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyCapacityResponse.Merge(m, src)
+}
+func (m *QueryTripartyCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyCapacityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyCapacityResponse) GetResetHeight() uint64 {
+	if m != nil {
+		return m.ResetHeight
+	}
+	return 0
+}
+
+// QueryTripartySequenceTipsRequest is request type for the
+// Query/TripartySequenceTips RPC method.
+type QueryTripartySequenceTipsRequest struct {
+}
+
+func (m *QueryTripartySequenceTipsRequest) Reset()         { *m = QueryTripartySequenceTipsRequest{} }
+func (m *QueryTripartySequenceTipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartySequenceTipsRequest) ProtoMessage()    {}
+func (*QueryTripartySequenceTipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{67}
+}
+func (m *QueryTripartySequenceTipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartySequenceTipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartySequenceTipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartySequenceTipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartySequenceTipsRequest.Merge(m, src)
+}
+func (m *QueryTripartySequenceTipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartySequenceTipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartySequenceTipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartySequenceTipsRequest proto.InternalMessageInfo
+
+// QueryTripartySequenceTipsResponse is response type for the
+// Query/TripartySequenceTips RPC method.
+type QueryTripartySequenceTipsResponse struct {
+	// request_sequence_tip is the last assigned triparty request sequence
+	// number.
+	RequestSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=request_sequence_tip,json=requestSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"request_sequence_tip"`
+	// processed_sequence_tip is the last processed triparty request sequence
+	// number.
+	ProcessedSequenceTip cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=processed_sequence_tip,json=processedSequenceTip,proto3,customtype=cosmossdk.io/math.Int" json:"processed_sequence_tip"`
+}
+
+func (m *QueryTripartySequenceTipsResponse) Reset()         { *m = QueryTripartySequenceTipsResponse{} }
+func (m *QueryTripartySequenceTipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartySequenceTipsResponse) ProtoMessage()    {}
+func (*QueryTripartySequenceTipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{68}
+}
+func (m *QueryTripartySequenceTipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartySequenceTipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartySequenceTipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartySequenceTipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartySequenceTipsResponse.Merge(m, src)
+}
+func (m *QueryTripartySequenceTipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartySequenceTipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartySequenceTipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartySequenceTipsResponse proto.InternalMessageInfo
+
+// QueryTripartyPendingRequestsRequest is request type for the
+// Query/TripartyPendingRequests RPC method.
+type QueryTripartyPendingRequestsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyPendingRequestsRequest) Reset()         { *m = QueryTripartyPendingRequestsRequest{} }
+func (m *QueryTripartyPendingRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyPendingRequestsRequest) ProtoMessage()    {}
+func (*QueryTripartyPendingRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{69}
+}
+func (m *QueryTripartyPendingRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyPendingRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyPendingRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyPendingRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyPendingRequestsRequest.Merge(m, src)
+}
+func (m *QueryTripartyPendingRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyPendingRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyPendingRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyPendingRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryTripartyPendingRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyPendingRequestsResponse is response type for the
+// Query/TripartyPendingRequests RPC method.
+type QueryTripartyPendingRequestsResponse struct {
+	// requests is the list of pending triparty bridge requests.
+	Requests []TripartyBridgeRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyPendingRequestsResponse) Reset()         { *m = QueryTripartyPendingRequestsResponse{} }
+func (m *QueryTripartyPendingRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyPendingRequestsResponse) ProtoMessage()    {}
+func (*QueryTripartyPendingRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{70}
+}
+func (m *QueryTripartyPendingRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyPendingRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyPendingRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyPendingRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyPendingRequestsResponse.Merge(m, src)
+}
+func (m *QueryTripartyPendingRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyPendingRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyPendingRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyPendingRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyPendingRequestsResponse) GetRequests() []TripartyBridgeRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *QueryTripartyPendingRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyRequestRequest is request type for the Query/TripartyRequest
+// RPC method.
+type QueryTripartyRequestRequest struct {
+	// sequence is the sequence number of the pending triparty bridge request.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryTripartyRequestRequest) Reset()         { *m = QueryTripartyRequestRequest{} }
+func (m *QueryTripartyRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyRequestRequest) ProtoMessage()    {}
+func (*QueryTripartyRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{71}
+}
+func (m *QueryTripartyRequestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyRequestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyRequestRequest.Merge(m, src)
+}
+func (m *QueryTripartyRequestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyRequestRequest proto.InternalMessageInfo
+
+func (m *QueryTripartyRequestRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryTripartyRequestResponse is response type for the Query/TripartyRequest
+// RPC method.
+type QueryTripartyRequestResponse struct {
+	// request is the queried pending triparty bridge request.
+	Request TripartyBridgeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+}
+
+func (m *QueryTripartyRequestResponse) Reset()         { *m = QueryTripartyRequestResponse{} }
+func (m *QueryTripartyRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyRequestResponse) ProtoMessage()    {}
+func (*QueryTripartyRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{72}
+}
+func (m *QueryTripartyRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyRequestResponse.Merge(m, src)
+}
+func (m *QueryTripartyRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyRequestResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyRequestResponse) GetRequest() TripartyBridgeRequest {
+	if m != nil {
+		return m.Request
+	}
+	return TripartyBridgeRequest{}
+}
+
+// QueryTripartyControllersBTCMintedRequest is request type for the
+// Query/TripartyControllersBTCMinted RPC method.
+type QueryTripartyControllersBTCMintedRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyControllersBTCMintedRequest) Reset() {
+	*m = QueryTripartyControllersBTCMintedRequest{}
+}
+func (m *QueryTripartyControllersBTCMintedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyControllersBTCMintedRequest) ProtoMessage()    {}
+func (*QueryTripartyControllersBTCMintedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{73}
+}
+func (m *QueryTripartyControllersBTCMintedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyControllersBTCMintedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyControllersBTCMintedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyControllersBTCMintedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyControllersBTCMintedRequest.Merge(m, src)
+}
+func (m *QueryTripartyControllersBTCMintedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyControllersBTCMintedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyControllersBTCMintedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyControllersBTCMintedRequest proto.InternalMessageInfo
+
+func (m *QueryTripartyControllersBTCMintedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyControllersBTCMintedResponse is response type for the
+// Query/TripartyControllersBTCMinted RPC method.
+type QueryTripartyControllersBTCMintedResponse struct {
+	// controllers_btc_minted is the BTC minted through the triparty bridge path
+	// per controller.
+	ControllersBtcMinted []TripartyControllerBTCMinted `protobuf:"bytes,1,rep,name=controllers_btc_minted,json=controllersBtcMinted,proto3" json:"controllers_btc_minted"`
+	// total is the total BTC minted through the triparty bridge path across all
+	// controllers.
+	Total cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) Reset() {
+	*m = QueryTripartyControllersBTCMintedResponse{}
+}
+func (m *QueryTripartyControllersBTCMintedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryTripartyControllersBTCMintedResponse) ProtoMessage() {}
+func (*QueryTripartyControllersBTCMintedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{74}
+}
+func (m *QueryTripartyControllersBTCMintedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyControllersBTCMintedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyControllersBTCMintedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyControllersBTCMintedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyControllersBTCMintedResponse.Merge(m, src)
+}
+func (m *QueryTripartyControllersBTCMintedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyControllersBTCMintedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyControllersBTCMintedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyControllersBTCMintedResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyControllersBTCMintedResponse) GetControllersBtcMinted() []TripartyControllerBTCMinted {
+	if m != nil {
+		return m.ControllersBtcMinted
+	}
+	return nil
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTripartyRequestOutcomeRequest is request type for the
+// Query/TripartyRequestOutcome RPC method.
+type QueryTripartyRequestOutcomeRequest struct {
+	// sequence is the sequence number of the processed triparty bridge request.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryTripartyRequestOutcomeRequest) Reset()         { *m = QueryTripartyRequestOutcomeRequest{} }
+func (m *QueryTripartyRequestOutcomeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyRequestOutcomeRequest) ProtoMessage()    {}
+func (*QueryTripartyRequestOutcomeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{75}
+}
+func (m *QueryTripartyRequestOutcomeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyRequestOutcomeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyRequestOutcomeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyRequestOutcomeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyRequestOutcomeRequest.Merge(m, src)
+}
+func (m *QueryTripartyRequestOutcomeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyRequestOutcomeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyRequestOutcomeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyRequestOutcomeRequest proto.InternalMessageInfo
+
+func (m *QueryTripartyRequestOutcomeRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryTripartyRequestOutcomeResponse is response type for the
+// Query/TripartyRequestOutcome RPC method.
+type QueryTripartyRequestOutcomeResponse struct {
+	// outcome is the outcome record of the processed triparty bridge request.
+	Outcome TripartyBridgeRequestOutcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome"`
+}
+
+func (m *QueryTripartyRequestOutcomeResponse) Reset()         { *m = QueryTripartyRequestOutcomeResponse{} }
+func (m *QueryTripartyRequestOutcomeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTripartyRequestOutcomeResponse) ProtoMessage()    {}
+func (*QueryTripartyRequestOutcomeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93a3b7fcc57c3f9c, []int{76}
+}
+func (m *QueryTripartyRequestOutcomeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTripartyRequestOutcomeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTripartyRequestOutcomeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTripartyRequestOutcomeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTripartyRequestOutcomeResponse.Merge(m, src)
+}
+func (m *QueryTripartyRequestOutcomeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTripartyRequestOutcomeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTripartyRequestOutcomeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTripartyRequestOutcomeResponse proto.InternalMessageInfo
+
+func (m *QueryTripartyRequestOutcomeResponse) GetOutcome() TripartyBridgeRequestOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return TripartyBridgeRequestOutcome{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "mezo.bridge.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "mezo.bridge.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAssetsUnlockedSequenceTipRequest)(nil), "mezo.bridge.v1.QueryAssetsUnlockedSequenceTipRequest")
+	proto.RegisterType((*QueryAssetsUnlockedSequenceTipResponse)(nil), "mezo.bridge.v1.QueryAssetsUnlockedSequenceTipResponse")
+	proto.RegisterType((*QueryAssetsUnlockedEventsRequest)(nil), "mezo.bridge.v1.QueryAssetsUnlockedEventsRequest")
+	proto.RegisterType((*QueryAssetsUnlockedEventsResponse)(nil), "mezo.bridge.v1.QueryAssetsUnlockedEventsResponse")
+	proto.RegisterType((*QueryAssetsLockedSequenceTipRequest)(nil), "mezo.bridge.v1.QueryAssetsLockedSequenceTipRequest")
+	proto.RegisterType((*QueryAssetsLockedSequenceTipResponse)(nil), "mezo.bridge.v1.QueryAssetsLockedSequenceTipResponse")
+	proto.RegisterType((*QueryAssetsLockedEventRequest)(nil), "mezo.bridge.v1.QueryAssetsLockedEventRequest")
+	proto.RegisterType((*QueryAssetsLockedEventResponse)(nil), "mezo.bridge.v1.QueryAssetsLockedEventResponse")
+	proto.RegisterType((*QueryAssetsLockedEventsRequest)(nil), "mezo.bridge.v1.QueryAssetsLockedEventsRequest")
+	proto.RegisterType((*QueryAssetsLockedEventsResponse)(nil), "mezo.bridge.v1.QueryAssetsLockedEventsResponse")
+	proto.RegisterType((*QuerySourceBTCTokenRequest)(nil), "mezo.bridge.v1.QuerySourceBTCTokenRequest")
+	proto.RegisterType((*QuerySourceBTCTokenResponse)(nil), "mezo.bridge.v1.QuerySourceBTCTokenResponse")
+	proto.RegisterType((*QueryERC20TokenMappingsRequest)(nil), "mezo.bridge.v1.QueryERC20TokenMappingsRequest")
+	proto.RegisterType((*QueryERC20TokenMappingsResponse)(nil), "mezo.bridge.v1.QueryERC20TokenMappingsResponse")
+	proto.RegisterType((*QueryERC20TokenMappingRequest)(nil), "mezo.bridge.v1.QueryERC20TokenMappingRequest")
+	proto.RegisterType((*QueryERC20TokenMappingResponse)(nil), "mezo.bridge.v1.QueryERC20TokenMappingResponse")
+	proto.RegisterType((*QueryBTCSupplyRequest)(nil), "mezo.bridge.v1.QueryBTCSupplyRequest")
+	proto.RegisterType((*QueryBTCSupplyResponse)(nil), "mezo.bridge.v1.QueryBTCSupplyResponse")
+	proto.RegisterType((*ERC20TokenSupply)(nil), "mezo.bridge.v1.ERC20TokenSupply")
+	proto.RegisterType((*QueryERC20SuppliesRequest)(nil), "mezo.bridge.v1.QueryERC20SuppliesRequest")
+	proto.RegisterType((*QueryERC20SuppliesResponse)(nil), "mezo.bridge.v1.QueryERC20SuppliesResponse")
+	proto.RegisterType((*QueryERC20SupplyRequest)(nil), "mezo.bridge.v1.QueryERC20SupplyRequest")
+	proto.RegisterType((*QueryERC20SupplyResponse)(nil), "mezo.bridge.v1.QueryERC20SupplyResponse")
+	proto.RegisterType((*QuerySourceChainsRequest)(nil), "mezo.bridge.v1.QuerySourceChainsRequest")
+	proto.RegisterType((*QuerySourceChainsResponse)(nil), "mezo.bridge.v1.QuerySourceChainsResponse")
+	proto.RegisterType((*QuerySourceChainRequest)(nil), "mezo.bridge.v1.QuerySourceChainRequest")
+	proto.RegisterType((*QuerySourceChainResponse)(nil), "mezo.bridge.v1.QuerySourceChainResponse")
+	proto.RegisterType((*OutflowCapacity)(nil), "mezo.bridge.v1.OutflowCapacity")
+	proto.RegisterType((*QueryOutflowLimitsRequest)(nil), "mezo.bridge.v1.QueryOutflowLimitsRequest")
+	proto.RegisterType((*QueryOutflowLimitsResponse)(nil), "mezo.bridge.v1.QueryOutflowLimitsResponse")
+	proto.RegisterType((*QueryOutflowCapacityRequest)(nil), "mezo.bridge.v1.QueryOutflowCapacityRequest")
+	proto.RegisterType((*QueryOutflowCapacityResponse)(nil), "mezo.bridge.v1.QueryOutflowCapacityResponse")
+	proto.RegisterType((*QueryUSDOutflowCapacityRequest)(nil), "mezo.bridge.v1.QueryUSDOutflowCapacityRequest")
+	proto.RegisterType((*QueryUSDOutflowCapacityResponse)(nil), "mezo.bridge.v1.QueryUSDOutflowCapacityResponse")
+	proto.RegisterType((*QueryOutflowPriceFeedsRequest)(nil), "mezo.bridge.v1.QueryOutflowPriceFeedsRequest")
+	proto.RegisterType((*QueryOutflowPriceFeedsResponse)(nil), "mezo.bridge.v1.QueryOutflowPriceFeedsResponse")
+	proto.RegisterType((*QuerySenderOutflowLimitsRequest)(nil), "mezo.bridge.v1.QuerySenderOutflowLimitsRequest")
+	proto.RegisterType((*QuerySenderOutflowLimitsResponse)(nil), "mezo.bridge.v1.QuerySenderOutflowLimitsResponse")
+	proto.RegisterType((*QuerySenderOutflowCapacityRequest)(nil), "mezo.bridge.v1.QuerySenderOutflowCapacityRequest")
+	proto.RegisterType((*QuerySenderOutflowCapacityResponse)(nil), "mezo.bridge.v1.QuerySenderOutflowCapacityResponse")
+	proto.RegisterType((*SenderOutflowCapacity)(nil), "mezo.bridge.v1.SenderOutflowCapacity")
+	proto.RegisterType((*QueryDelayedBridgeOutParamsRequest)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutParamsRequest")
+	proto.RegisterType((*QueryDelayedBridgeOutParamsResponse)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutParamsResponse")
+	proto.RegisterType((*QueryDelayedBridgeOutsRequest)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutsRequest")
+	proto.RegisterType((*QueryDelayedBridgeOutsResponse)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutsResponse")
+	proto.RegisterType((*QueryDelayedBridgeOutRequest)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutRequest")
+	proto.RegisterType((*QueryDelayedBridgeOutResponse)(nil), "mezo.bridge.v1.QueryDelayedBridgeOutResponse")
+	proto.RegisterType((*QueryBridgeOutFeesRequest)(nil), "mezo.bridge.v1.QueryBridgeOutFeesRequest")
+	proto.RegisterType((*QueryBridgeOutFeesResponse)(nil), "mezo.bridge.v1.QueryBridgeOutFeesResponse")
+	proto.RegisterType((*QueryMinBridgeOutAmountsRequest)(nil), "mezo.bridge.v1.QueryMinBridgeOutAmountsRequest")
+	proto.RegisterType((*QueryMinBridgeOutAmountsResponse)(nil), "mezo.bridge.v1.QueryMinBridgeOutAmountsResponse")
+	proto.RegisterType((*QueryMinBridgeOutAmountForBitcoinChainRequest)(nil), "mezo.bridge.v1.QueryMinBridgeOutAmountForBitcoinChainRequest")
+	proto.RegisterType((*QueryMinBridgeOutAmountForBitcoinChainResponse)(nil), "mezo.bridge.v1.QueryMinBridgeOutAmountForBitcoinChainResponse")
+	proto.RegisterType((*QueryBridgeOutChainsRequest)(nil), "mezo.bridge.v1.QueryBridgeOutChainsRequest")
+	proto.RegisterType((*QueryBridgeOutChainsResponse)(nil), "mezo.bridge.v1.QueryBridgeOutChainsResponse")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "mezo.bridge.v1.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "mezo.bridge.v1.QueryPauseStateResponse")
+	proto.RegisterType((*QueryTripartyControllersRequest)(nil), "mezo.bridge.v1.QueryTripartyControllersRequest")
+	proto.RegisterType((*QueryTripartyControllersResponse)(nil), "mezo.bridge.v1.QueryTripartyControllersResponse")
+	proto.RegisterType((*QueryTripartyBlockDelayRequest)(nil), "mezo.bridge.v1.QueryTripartyBlockDelayRequest")
+	proto.RegisterType((*QueryTripartyBlockDelayResponse)(nil), "mezo.bridge.v1.QueryTripartyBlockDelayResponse")
+	proto.RegisterType((*QueryTripartyLimitsRequest)(nil), "mezo.bridge.v1.QueryTripartyLimitsRequest")
+	proto.RegisterType((*QueryTripartyLimitsResponse)(nil), "mezo.bridge.v1.QueryTripartyLimitsResponse")
+	proto.RegisterType((*QueryTripartyCapacityRequest)(nil), "mezo.bridge.v1.QueryTripartyCapacityRequest")
+	proto.RegisterType((*QueryTripartyCapacityResponse)(nil), "mezo.bridge.v1.QueryTripartyCapacityResponse")
+	proto.RegisterType((*QueryTripartySequenceTipsRequest)(nil), "mezo.bridge.v1.QueryTripartySequenceTipsRequest")
+	proto.RegisterType((*QueryTripartySequenceTipsResponse)(nil), "mezo.bridge.v1.QueryTripartySequenceTipsResponse")
+	proto.RegisterType((*QueryTripartyPendingRequestsRequest)(nil), "mezo.bridge.v1.QueryTripartyPendingRequestsRequest")
+	proto.RegisterType((*QueryTripartyPendingRequestsResponse)(nil), "mezo.bridge.v1.QueryTripartyPendingRequestsResponse")
+	proto.RegisterType((*QueryTripartyRequestRequest)(nil), "mezo.bridge.v1.QueryTripartyRequestRequest")
+	proto.RegisterType((*QueryTripartyRequestResponse)(nil), "mezo.bridge.v1.QueryTripartyRequestResponse")
+	proto.RegisterType((*QueryTripartyControllersBTCMintedRequest)(nil), "mezo.bridge.v1.QueryTripartyControllersBTCMintedRequest")
+	proto.RegisterType((*QueryTripartyControllersBTCMintedResponse)(nil), "mezo.bridge.v1.QueryTripartyControllersBTCMintedResponse")
+	proto.RegisterType((*QueryTripartyRequestOutcomeRequest)(nil), "mezo.bridge.v1.QueryTripartyRequestOutcomeRequest")
+	proto.RegisterType((*QueryTripartyRequestOutcomeResponse)(nil), "mezo.bridge.v1.QueryTripartyRequestOutcomeResponse")
+}
+
+func init() { proto.RegisterFile("mezo/bridge/v1/query.proto", fileDescriptor_93a3b7fcc57c3f9c) }
+
+var fileDescriptor_93a3b7fcc57c3f9c = []byte{
+	// 3183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xc9, 0x6f, 0x1c, 0xc7,
+	0xd5, 0x57, 0x0f, 0x25, 0x8a, 0x7a, 0x43, 0x52, 0x52, 0x89, 0xa6, 0xe8, 0x16, 0xd7, 0xa6, 0xb9,
+	0x88, 0x22, 0x67, 0xb8, 0x48, 0xb2, 0x05, 0xfb, 0xf3, 0x32, 0xb4, 0xe4, 0xcf, 0xb0, 0x05, 0xc9,
+	0x43, 0x1a, 0x01, 0x7c, 0xc8, 0x64, 0x96, 0xd2, 0xb0, 0x63, 0x4e, 0xf7, 0xb8, 0xbb, 0x47, 0x0e,
+	0x23, 0x28, 0x07, 0x21, 0xa7, 0x00, 0x59, 0x60, 0x1f, 0x02, 0xe4, 0x90, 0x20, 0x09, 0xb2, 0x20,
+	0x39, 0x18, 0x08, 0x72, 0xb0, 0x4f, 0xce, 0xd1, 0x81, 0x03, 0xc4, 0x40, 0x2e, 0x41, 0x0e, 0x46,
+	0x20, 0x05, 0xc8, 0x35, 0x7f, 0x42, 0xd0, 0x55, 0xaf, 0x7a, 0x7a, 0xab, 0xe9, 0x1a, 0x69, 0x0c,
+	0xe4, 0x24, 0x4d, 0xf5, 0x7b, 0xf5, 0x7e, 0x6f, 0xa9, 0x57, 0x55, 0xef, 0x15, 0x41, 0x6f, 0xd1,
+	0x6f, 0xdb, 0xc5, 0x9a, 0x63, 0x36, 0x9a, 0xb4, 0x78, 0x77, 0xab, 0xf8, 0x5e, 0x87, 0x3a, 0x47,
+	0x85, 0xb6, 0x63, 0x7b, 0x36, 0x19, 0xf7, 0xbf, 0x15, 0xf8, 0xb7, 0xc2, 0xdd, 0x2d, 0x7d, 0xad,
+	0x6e, 0xbb, 0x2d, 0xdb, 0x2d, 0xd6, 0xaa, 0x2e, 0xe5, 0x84, 0xc5, 0xbb, 0x5b, 0x35, 0xea, 0x55,
+	0xb7, 0x8a, 0xed, 0x6a, 0xd3, 0xb4, 0xaa, 0x9e, 0x69, 0x5b, 0x9c, 0x57, 0x9f, 0x68, 0xda, 0x4d,
+	0x9b, 0xfd, 0xb7, 0xe8, 0xff, 0x0f, 0x47, 0xa7, 0x9b, 0xb6, 0xdd, 0x3c, 0xa4, 0xc5, 0x6a, 0xdb,
+	0x2c, 0x56, 0x2d, 0xcb, 0xf6, 0x18, 0x8b, 0x8b, 0x5f, 0x2f, 0xc4, 0xb0, 0xa0, 0x64, 0x64, 0x8d,
+	0x7d, 0x6c, 0x52, 0x8b, 0xba, 0x26, 0xb2, 0x1a, 0x13, 0x40, 0xde, 0xf2, 0x01, 0xdd, 0xae, 0x3a,
+	0xd5, 0x96, 0x5b, 0xa6, 0xef, 0x75, 0xa8, 0xeb, 0x19, 0x6f, 0xc0, 0xb9, 0xc8, 0xa8, 0xdb, 0xb6,
+	0x2d, 0x97, 0x92, 0xcb, 0x30, 0xdc, 0x66, 0x23, 0x53, 0xda, 0xbc, 0xb6, 0x9a, 0xdf, 0x9e, 0x2c,
+	0x44, 0x15, 0x2d, 0x70, 0xfa, 0xd2, 0xf1, 0xcf, 0xbe, 0x9c, 0x3b, 0x56, 0x46, 0x5a, 0x63, 0x05,
+	0x96, 0xd8, 0x64, 0xaf, 0xb8, 0x2e, 0xf5, 0xdc, 0xb7, 0xad, 0x43, 0xbb, 0xfe, 0x2e, 0x6d, 0xec,
+	0xf9, 0xa2, 0xac, 0x3a, 0xdd, 0x37, 0xdb, 0x42, 0xea, 0x37, 0x61, 0x39, 0x8b, 0x10, 0x81, 0xbc,
+	0x0c, 0xa3, 0x2e, 0x0e, 0x57, 0x3c, 0xb3, 0xcd, 0xe0, 0x9c, 0x2a, 0xcd, 0xf8, 0x62, 0xff, 0xf1,
+	0xe5, 0xdc, 0x53, 0xdc, 0xdc, 0x6e, 0xe3, 0xdd, 0x82, 0x69, 0x17, 0x5b, 0x55, 0xef, 0xa0, 0xf0,
+	0xba, 0xe5, 0x95, 0xf3, 0x6e, 0x77, 0x26, 0xe3, 0x77, 0x1a, 0xcc, 0xa7, 0x08, 0xbb, 0x7e, 0x97,
+	0x5a, 0x9e, 0x30, 0x03, 0x79, 0x15, 0xc6, 0x03, 0x31, 0xae, 0x57, 0x75, 0x3c, 0x35, 0x41, 0x63,
+	0x82, 0x69, 0xcf, 0xe7, 0x89, 0x80, 0xa5, 0x56, 0x63, 0x2a, 0xd7, 0x17, 0xd8, 0xeb, 0x56, 0xc3,
+	0xb8, 0x03, 0x0b, 0x3d, 0xb0, 0xa2, 0x4d, 0x5e, 0x81, 0x61, 0xca, 0x46, 0xa6, 0xb4, 0xf9, 0xa1,
+	0xd5, 0xfc, 0xf6, 0x62, 0xdc, 0x39, 0x29, 0xdc, 0xc2, 0x53, 0x9c, 0xd1, 0x58, 0x82, 0xc5, 0x90,
+	0x9c, 0x37, 0x65, 0x7e, 0x3a, 0x80, 0x67, 0x7a, 0x93, 0x0d, 0xcc, 0x4b, 0x5f, 0x87, 0x99, 0x84,
+	0x24, 0x06, 0x5c, 0x78, 0x48, 0x87, 0x11, 0x41, 0xcf, 0xa6, 0x3f, 0x5e, 0x0e, 0x7e, 0x93, 0x05,
+	0x18, 0x75, 0xed, 0x8e, 0x53, 0xa7, 0x95, 0xfa, 0x41, 0xd5, 0xb4, 0x98, 0xdd, 0xc7, 0xca, 0x79,
+	0x3e, 0xb6, 0xeb, 0x0f, 0x19, 0x35, 0x98, 0x95, 0xcd, 0x1f, 0xe8, 0x30, 0xec, 0xd0, 0xba, 0xed,
+	0x34, 0x30, 0xe4, 0x8d, 0x74, 0xab, 0x72, 0xd6, 0x32, 0xa3, 0x14, 0x46, 0xe5, 0x7c, 0xc6, 0xe7,
+	0x9a, 0x4c, 0xc8, 0xff, 0x5a, 0x9c, 0x25, 0x2c, 0x36, 0x94, 0xb4, 0x18, 0x85, 0x39, 0xa9, 0x32,
+	0x68, 0xb2, 0x12, 0x9c, 0xe4, 0xaa, 0x8b, 0x48, 0x54, 0xb7, 0x99, 0x60, 0x34, 0xa6, 0x41, 0x67,
+	0x62, 0xf6, 0x98, 0xe8, 0xd2, 0xfe, 0xee, 0xbe, 0xfd, 0x2e, 0xb5, 0x44, 0x00, 0xbe, 0x06, 0x17,
+	0x52, 0xbf, 0x22, 0x80, 0x55, 0x38, 0x83, 0x6a, 0xd4, 0xbc, 0x7a, 0xc5, 0xf3, 0xbf, 0x71, 0x83,
+	0x96, 0xc7, 0xf9, 0x78, 0xc9, 0xab, 0x33, 0x0e, 0xe3, 0x00, 0x5d, 0x73, 0xbd, 0xbc, 0xbb, 0xbd,
+	0xc9, 0x86, 0x6e, 0x56, 0xdb, 0x6d, 0xd3, 0x6a, 0x06, 0xae, 0xb9, 0x01, 0xd0, 0x4d, 0xd1, 0x18,
+	0x03, 0xcb, 0x05, 0x6e, 0xcb, 0x82, 0x9f, 0xcf, 0x0b, 0x3c, 0xf1, 0x63, 0x3e, 0x2f, 0xdc, 0xae,
+	0x36, 0x29, 0xf2, 0x96, 0x43, 0x9c, 0xc6, 0x47, 0x1a, 0xcc, 0x49, 0x45, 0x21, 0xee, 0x5d, 0x18,
+	0x69, 0xe1, 0x18, 0x5a, 0x6e, 0x21, 0x6e, 0xb9, 0x04, 0x37, 0x1a, 0x2e, 0x60, 0x24, 0xaf, 0x45,
+	0x00, 0xe7, 0x18, 0xe0, 0x95, 0x4c, 0xc0, 0x1c, 0x41, 0x04, 0x71, 0x09, 0xd7, 0x5e, 0x42, 0xa4,
+	0x30, 0x4d, 0x37, 0x5a, 0xc2, 0x26, 0xc6, 0x68, 0xe1, 0xf6, 0xad, 0xcb, 0xec, 0x1b, 0xca, 0x5a,
+	0x27, 0x11, 0x3a, 0x1a, 0x57, 0x59, 0x65, 0xc1, 0x67, 0x9c, 0x87, 0xa7, 0x98, 0x90, 0xd2, 0xfe,
+	0xee, 0x5e, 0xa7, 0xdd, 0x3e, 0x3c, 0x12, 0x61, 0xf2, 0x5d, 0x0d, 0x26, 0xe3, 0x5f, 0x50, 0xec,
+	0x15, 0x18, 0x6e, 0x99, 0x96, 0x47, 0x1b, 0x6a, 0x2b, 0x0d, 0x89, 0xc9, 0x0e, 0x9c, 0xa8, 0x75,
+	0x1c, 0xcb, 0x53, 0x5b, 0x5b, 0x9c, 0xd6, 0x78, 0x90, 0x83, 0x33, 0x5d, 0x25, 0x38, 0x10, 0x05,
+	0xe3, 0x91, 0x19, 0x00, 0xdf, 0x14, 0x48, 0xc0, 0x24, 0x96, 0x4f, 0xf9, 0x23, 0xfc, 0x73, 0x57,
+	0x85, 0xa1, 0xc7, 0x52, 0xe1, 0xb8, 0xba, 0x0a, 0x7e, 0x6a, 0xf1, 0x6c, 0xaf, 0x7a, 0x58, 0x71,
+	0x19, 0xfa, 0xa9, 0x13, 0x4a, 0xa9, 0x85, 0xb1, 0x70, 0x7d, 0x8d, 0x0b, 0xf0, 0x74, 0x37, 0x12,
+	0xd8, 0x98, 0x49, 0x83, 0xe3, 0xc6, 0x37, 0x40, 0x4f, 0xfb, 0x18, 0xe4, 0x93, 0x11, 0x17, 0xc7,
+	0x70, 0x59, 0xcc, 0xcb, 0x63, 0x84, 0x8b, 0x13, 0xab, 0x42, 0xf0, 0x19, 0x2f, 0xc0, 0xf9, 0x98,
+	0x84, 0xa3, 0x3e, 0xc2, 0xf8, 0x1d, 0x98, 0x4a, 0x72, 0x23, 0xba, 0x17, 0x61, 0x18, 0x8d, 0xc2,
+	0xe3, 0x57, 0x15, 0x1b, 0x72, 0x19, 0x3a, 0x4c, 0x85, 0x72, 0x19, 0x4b, 0xb2, 0x6e, 0x77, 0xa3,
+	0x7d, 0x3a, 0xe5, 0x1b, 0x0a, 0x7e, 0x03, 0xc6, 0xc2, 0xc9, 0x5a, 0x6a, 0x9b, 0x10, 0xf3, 0x9e,
+	0x57, 0xf5, 0x28, 0xca, 0x1f, 0x0d, 0x65, 0x75, 0xd7, 0x28, 0xa2, 0x7d, 0x42, 0xc4, 0xc2, 0x3e,
+	0x13, 0x70, 0x82, 0xef, 0x06, 0x1a, 0xdb, 0x0d, 0xf8, 0x0f, 0x83, 0x26, 0x61, 0x07, 0xc8, 0x5e,
+	0x8f, 0x6d, 0x23, 0x12, 0xc3, 0x48, 0x80, 0x45, 0xb6, 0x9b, 0x4f, 0x73, 0x70, 0xfa, 0x56, 0xc7,
+	0xbb, 0x73, 0x68, 0xbf, 0xbf, 0x5b, 0x6d, 0x57, 0xeb, 0xa6, 0x77, 0xe4, 0x03, 0x0a, 0x7b, 0x8a,
+	0xff, 0xf0, 0xe3, 0xfa, 0xd0, 0x6c, 0x99, 0xaa, 0x4b, 0x93, 0xd1, 0x92, 0x1b, 0x70, 0xba, 0xde,
+	0x71, 0x1c, 0x6a, 0x79, 0x15, 0x9b, 0x4b, 0x51, 0x5b, 0x4c, 0xe3, 0xc8, 0x85, 0xd0, 0xc8, 0x35,
+	0x18, 0xa9, 0x23, 0x3c, 0xb5, 0x75, 0x15, 0x90, 0x93, 0xe7, 0x61, 0xf8, 0x7d, 0xd3, 0x6a, 0xd8,
+	0xef, 0xb3, 0x45, 0x95, 0xdf, 0x9e, 0x89, 0x9b, 0x09, 0x65, 0x7c, 0x8d, 0x11, 0x89, 0xe0, 0xe1,
+	0x2c, 0x7e, 0xec, 0x3a, 0xd4, 0xa5, 0x5e, 0xe5, 0x80, 0x9a, 0xcd, 0x03, 0x6f, 0x6a, 0x98, 0x1d,
+	0x81, 0xf2, 0x6c, 0xec, 0xff, 0xd9, 0x90, 0x51, 0xc7, 0x18, 0xc2, 0x69, 0xde, 0xf4, 0xf5, 0x1e,
+	0xf8, 0xee, 0xf6, 0xb9, 0x06, 0x7a, 0x9a, 0x94, 0x20, 0xc9, 0x8f, 0xa0, 0x79, 0x45, 0x94, 0xce,
+	0x49, 0xb4, 0x14, 0x4e, 0x16, 0x0b, 0x58, 0xb0, 0x25, 0x34, 0xcd, 0x25, 0x34, 0x8d, 0xed, 0x7c,
+	0x43, 0x8f, 0xbf, 0xf3, 0xed, 0xe0, 0xf1, 0x22, 0x86, 0x29, 0xb4, 0x20, 0x92, 0xf1, 0x67, 0x3c,
+	0xd0, 0x60, 0x3a, 0x9d, 0x0b, 0x8d, 0xf0, 0x12, 0x9c, 0x14, 0x31, 0xc6, 0x0d, 0xad, 0x68, 0x03,
+	0xc1, 0xa5, 0x60, 0x02, 0x63, 0x1e, 0xf7, 0xdb, 0xb7, 0xf7, 0x5e, 0x4d, 0x07, 0x6f, 0x7c, 0x92,
+	0x83, 0x39, 0x29, 0x09, 0x22, 0x0d, 0x96, 0x92, 0xf6, 0x64, 0x4b, 0x29, 0xf7, 0xa4, 0x4b, 0x69,
+	0xe8, 0x71, 0x97, 0xd2, 0xf1, 0x27, 0x5f, 0x4a, 0x27, 0x92, 0xd6, 0x6d, 0xe2, 0x89, 0x08, 0xa7,
+	0xb9, 0xed, 0x98, 0x75, 0x7a, 0x83, 0xd2, 0xc6, 0xc0, 0x97, 0xd3, 0x1f, 0xc4, 0x95, 0x21, 0x45,
+	0x12, 0xfa, 0xe8, 0x35, 0xc8, 0xb7, 0xfd, 0xd1, 0xca, 0x1d, 0x7f, 0x58, 0x96, 0xfb, 0xe3, 0xfc,
+	0xa8, 0x33, 0xb4, 0x83, 0x09, 0x07, 0x77, 0x5e, 0x34, 0x31, 0xb0, 0xf6, 0xa8, 0xd5, 0xa0, 0xce,
+	0x57, 0x9a, 0x6e, 0xfe, 0x23, 0x2e, 0xef, 0xa9, 0xb2, 0xba, 0xf7, 0xe1, 0x48, 0xb1, 0x22, 0x71,
+	0x1f, 0x8e, 0x30, 0xa7, 0x55, 0x2e, 0xfc, 0xcb, 0x1f, 0x0b, 0x6e, 0x77, 0x2a, 0x97, 0x7e, 0x91,
+	0x49, 0xca, 0x17, 0x33, 0x70, 0xbe, 0xc1, 0xe5, 0xa4, 0xb7, 0xb0, 0x04, 0x10, 0x91, 0x18, 0xcf,
+	0x4c, 0x93, 0x30, 0xec, 0xb2, 0xef, 0x98, 0x9a, 0xf0, 0x57, 0x37, 0x63, 0xe5, 0xc2, 0x19, 0xab,
+	0x05, 0x46, 0xaf, 0x29, 0x83, 0x40, 0xeb, 0xae, 0x47, 0x6e, 0xc8, 0xa5, 0x9e, 0x56, 0x88, 0x67,
+	0x70, 0xc1, 0x6c, 0xfc, 0x6a, 0x08, 0x9e, 0x4a, 0xa5, 0xec, 0x0f, 0xb6, 0x7f, 0x16, 0xad, 0xb6,
+	0xec, 0x8e, 0xe5, 0x55, 0x78, 0x92, 0x52, 0x4a, 0x12, 0x79, 0xce, 0xc2, 0x5c, 0xe4, 0x5f, 0xb7,
+	0x45, 0xaa, 0xe2, 0xc3, 0x6a, 0x7b, 0xf6, 0x18, 0x32, 0xbd, 0xc2, 0x78, 0xfc, 0x84, 0x87, 0x38,
+	0x02, 0xfb, 0x28, 0x1d, 0x8b, 0xc7, 0x39, 0x57, 0xa0, 0xfd, 0x1c, 0xe4, 0xeb, 0x21, 0x75, 0x86,
+	0xd9, 0x29, 0x0b, 0xea, 0x5d, 0xb8, 0x8b, 0x20, 0x24, 0x57, 0xd8, 0xe8, 0xd4, 0x49, 0x46, 0x32,
+	0x8a, 0x83, 0xbb, 0x0c, 0xcd, 0x12, 0x8c, 0xd7, 0xa3, 0x60, 0x46, 0x18, 0xd5, 0x58, 0x3d, 0x22,
+	0x2c, 0x9e, 0xe5, 0x4e, 0x25, 0xb3, 0xdc, 0x21, 0x86, 0xc5, 0xab, 0xf4, 0xb0, 0x7a, 0x44, 0x1b,
+	0x25, 0xe6, 0xe6, 0x5b, 0x1d, 0x2f, 0x52, 0x21, 0x1c, 0xd8, 0x52, 0x7e, 0xa8, 0xc1, 0x62, 0x4f,
+	0x71, 0x18, 0x86, 0x0b, 0x30, 0xda, 0xf0, 0x29, 0x2a, 0x35, 0xbf, 0x7c, 0xe5, 0x62, 0xb1, 0x27,
+	0xcf, 0xc6, 0x4a, 0x6c, 0x88, 0xdc, 0x02, 0xf0, 0x0e, 0x1c, 0xea, 0x1e, 0xd8, 0x87, 0x0d, 0xb1,
+	0x62, 0x2f, 0xc6, 0x63, 0x35, 0x2e, 0x66, 0x5f, 0x70, 0x88, 0xd4, 0xd8, 0x9d, 0x62, 0x70, 0x8b,
+	0x57, 0x6c, 0x1c, 0x71, 0xe1, 0x5f, 0xdd, 0xc6, 0x91, 0x22, 0xa9, 0xbb, 0x71, 0x70, 0x6b, 0xf8,
+	0xdb, 0xb4, 0x74, 0xe3, 0x88, 0xf3, 0x0b, 0xeb, 0xd4, 0x82, 0x09, 0x07, 0xb7, 0x71, 0x14, 0xf0,
+	0xe0, 0x14, 0x97, 0x29, 0x8c, 0x33, 0x0e, 0x39, 0xb3, 0x81, 0x0e, 0xcf, 0x99, 0x7e, 0x35, 0x74,
+	0x46, 0x42, 0x8f, 0x2a, 0x5e, 0x07, 0xe8, 0xaa, 0x28, 0xbb, 0x7d, 0x48, 0x34, 0x3c, 0x15, 0x68,
+	0x18, 0x9c, 0x9c, 0x03, 0x92, 0x1b, 0x94, 0x0e, 0xdc, 0x63, 0x9f, 0x88, 0x93, 0x73, 0x4c, 0x0a,
+	0xaa, 0xa2, 0xc3, 0x88, 0xe7, 0xd0, 0xaa, 0xdb, 0x71, 0x8e, 0x30, 0x39, 0x06, 0xbf, 0xc9, 0x55,
+	0x38, 0x7e, 0x87, 0x52, 0x11, 0xe9, 0xd3, 0x71, 0x05, 0xc3, 0x13, 0xa2, 0x72, 0x8c, 0x7e, 0x70,
+	0x61, 0x2d, 0x76, 0xfc, 0x9b, 0xa6, 0x15, 0x08, 0xe3, 0xc9, 0x71, 0xe0, 0x66, 0xfa, 0xab, 0xd8,
+	0xf1, 0x53, 0x65, 0xa1, 0xb1, 0x6a, 0x30, 0xd9, 0x32, 0xad, 0x4a, 0xd7, 0xf7, 0x98, 0xde, 0x45,
+	0x94, 0xaf, 0xc4, 0x4d, 0xc4, 0xab, 0x4a, 0x89, 0x19, 0xd1, 0x5a, 0xe7, 0x5a, 0x49, 0x59, 0x83,
+	0x8b, 0xfa, 0x22, 0x6c, 0x48, 0x14, 0xba, 0x61, 0x3b, 0x25, 0xd3, 0xab, 0xdb, 0xa6, 0x15, 0xbe,
+	0x87, 0x1b, 0x4d, 0x28, 0xa8, 0x32, 0x74, 0x8b, 0x5c, 0xb8, 0xbf, 0xa9, 0x15, 0xb9, 0x38, 0xb1,
+	0x31, 0x83, 0xd7, 0x9f, 0x40, 0x4a, 0xb4, 0x28, 0x71, 0x15, 0xa6, 0xd3, 0x3f, 0xa3, 0xd4, 0x49,
+	0x18, 0x0e, 0x15, 0x24, 0xc6, 0xca, 0xf8, 0xcb, 0x98, 0xc2, 0x62, 0xdc, 0xed, 0x6a, 0xc7, 0xa5,
+	0xec, 0xc2, 0x2f, 0x66, 0xb4, 0xe1, 0x7c, 0xe2, 0x4b, 0xb7, 0x94, 0x8b, 0xee, 0x34, 0xad, 0x4a,
+	0xdb, 0xff, 0xce, 0x33, 0xc1, 0x48, 0x79, 0x9c, 0x8f, 0xbf, 0x6e, 0x31, 0xae, 0x06, 0x59, 0x83,
+	0xb3, 0x21, 0xc7, 0x23, 0x69, 0x8e, 0x91, 0x9e, 0xae, 0x75, 0x37, 0x15, 0x7f, 0x38, 0x08, 0xdc,
+	0x7d, 0xc7, 0x6c, 0x57, 0x1d, 0xef, 0x68, 0xd7, 0xb6, 0x3c, 0xc7, 0x3e, 0x3c, 0xa4, 0xce, 0xc0,
+	0x03, 0xf7, 0xfb, 0x22, 0x70, 0x53, 0x65, 0xa1, 0x96, 0xf3, 0xfe, 0x11, 0x20, 0x18, 0x66, 0x76,
+	0x3b, 0x55, 0x0e, 0x0f, 0x0d, 0x2e, 0xec, 0xc4, 0x0d, 0x51, 0xc0, 0x61, 0x7b, 0x27, 0xcb, 0x85,
+	0xc2, 0x1b, 0x25, 0x98, 0x93, 0x52, 0x20, 0xde, 0x39, 0xc8, 0xb3, 0x6d, 0xb8, 0xc2, 0xb6, 0x5f,
+	0x66, 0x9d, 0xa1, 0x32, 0xd4, 0x02, 0xc2, 0xa0, 0x7c, 0x2f, 0xe6, 0x88, 0x5c, 0x03, 0xfc, 0xde,
+	0xdb, 0x85, 0xd4, 0xcf, 0x41, 0xfd, 0xe8, 0x6c, 0x9b, 0x3a, 0x15, 0x87, 0x93, 0x57, 0xfa, 0xb8,
+	0x8b, 0x9e, 0x6e, 0x53, 0x07, 0xa5, 0xf0, 0xb3, 0xd3, 0xcb, 0x30, 0xca, 0xef, 0x77, 0x95, 0x3e,
+	0x8a, 0x43, 0x79, 0xce, 0xc2, 0x66, 0x30, 0x66, 0x61, 0x3a, 0x82, 0x35, 0x7e, 0xa1, 0xbe, 0x0f,
+	0x33, 0x92, 0xef, 0xa8, 0xcd, 0xb5, 0xd8, 0x01, 0xba, 0x8f, 0x0b, 0xad, 0xc2, 0x8d, 0xdf, 0x88,
+	0x85, 0x57, 0xa8, 0x0f, 0x17, 0xd8, 0xfb, 0xcf, 0x1a, 0x2c, 0xf4, 0x20, 0x42, 0x9c, 0xb7, 0x60,
+	0x42, 0x58, 0xbc, 0xff, 0xae, 0x1d, 0x41, 0xd6, 0xd0, 0xcc, 0x64, 0x0f, 0x26, 0xdb, 0x8e, 0x5d,
+	0xa7, 0xae, 0x4b, 0x1b, 0xd1, 0x29, 0x95, 0xbc, 0x30, 0x11, 0x30, 0x87, 0x26, 0x35, 0x5a, 0xb0,
+	0x18, 0x51, 0xe5, 0x36, 0xb5, 0x1a, 0xdd, 0x9e, 0xc4, 0xc0, 0x97, 0xef, 0xc7, 0x1a, 0x3c, 0xd3,
+	0x5b, 0x5e, 0xf7, 0x9a, 0x84, 0x26, 0x10, 0xbb, 0x4d, 0xe2, 0x9a, 0x14, 0x2c, 0x28, 0x36, 0x82,
+	0x33, 0x88, 0x6b, 0x92, 0x60, 0x1e, 0xdc, 0x4a, 0xbf, 0x16, 0x5b, 0x64, 0x42, 0xbd, 0xec, 0xce,
+	0xa9, 0x41, 0x63, 0x31, 0x1f, 0xb0, 0x06, 0x07, 0xac, 0x93, 0x88, 0x57, 0x76, 0x25, 0xec, 0xa5,
+	0xab, 0xe0, 0x35, 0x1c, 0x58, 0x95, 0xa5, 0xc6, 0xd2, 0xfe, 0xee, 0x4d, 0xd6, 0xaf, 0x18, 0xb4,
+	0x43, 0x3f, 0xc8, 0xc1, 0x45, 0x05, 0xa1, 0xa8, 0x68, 0x13, 0x26, 0x43, 0x59, 0x98, 0xb5, 0x13,
+	0x83, 0xb6, 0x91, 0xef, 0xe3, 0x4b, 0x32, 0xbd, 0xbb, 0xb3, 0x06, 0x93, 0xa2, 0xf6, 0x13, 0xa1,
+	0x09, 0x4b, 0x5e, 0xfd, 0x66, 0xd0, 0x95, 0x61, 0xdd, 0x12, 0xc5, 0xea, 0x35, 0xa3, 0x1d, 0xdc,
+	0x41, 0xee, 0x65, 0xbc, 0xf2, 0xc5, 0xfc, 0x7d, 0xab, 0xe3, 0xd5, 0xed, 0x16, 0x55, 0x89, 0x18,
+	0x37, 0xb6, 0x2c, 0xe3, 0x33, 0xa0, 0x3d, 0xdf, 0x64, 0x35, 0x50, 0x7f, 0x08, 0x5d, 0xb8, 0xae,
+	0x14, 0x38, 0x38, 0x4d, 0xa8, 0x20, 0xea, 0xff, 0xdc, 0xfe, 0x77, 0x11, 0x4e, 0x30, 0xa9, 0xe4,
+	0x3d, 0x18, 0xe6, 0xf7, 0x45, 0x92, 0x28, 0xd1, 0x24, 0x5f, 0xb7, 0xe8, 0x8b, 0x3d, 0x69, 0x38,
+	0x54, 0x63, 0xf6, 0xc1, 0xdf, 0xfe, 0xf5, 0x61, 0x6e, 0x8a, 0x4c, 0x16, 0x63, 0xef, 0x67, 0xb0,
+	0x36, 0xf4, 0x27, 0x0d, 0x9e, 0x96, 0x3e, 0x54, 0x21, 0x57, 0x52, 0x45, 0x64, 0xbd, 0x80, 0xd1,
+	0xaf, 0xf6, 0xcb, 0x86, 0x60, 0x2f, 0x33, 0xb0, 0x05, 0xb2, 0x1e, 0x07, 0x5b, 0x65, 0xac, 0x95,
+	0x0e, 0xf2, 0x46, 0xd2, 0x30, 0xf9, 0xbd, 0x06, 0x13, 0x69, 0x4f, 0x4a, 0xc8, 0xa6, 0x02, 0x8c,
+	0xc8, 0x0b, 0x06, 0x7d, 0xab, 0x0f, 0x0e, 0xc4, 0x5c, 0x60, 0x98, 0x57, 0xc9, 0x72, 0x16, 0x66,
+	0xfe, 0x38, 0xc5, 0x47, 0x7b, 0x36, 0xf1, 0xea, 0x80, 0x6c, 0xf4, 0x10, 0x9c, 0x7c, 0x2f, 0xa2,
+	0x17, 0x54, 0xc9, 0x11, 0xe4, 0xb3, 0x0c, 0xe4, 0x16, 0x29, 0x4a, 0x40, 0x46, 0x20, 0x16, 0xef,
+	0x09, 0xf3, 0xde, 0x27, 0xbf, 0xd4, 0x80, 0x24, 0xa6, 0x75, 0x89, 0xa2, 0xfc, 0xc0, 0xae, 0x45,
+	0x65, 0x7a, 0x04, 0xbc, 0xce, 0x00, 0x2f, 0x93, 0x67, 0x54, 0x00, 0x93, 0x8f, 0x35, 0x38, 0x2f,
+	0x79, 0xc5, 0x43, 0x76, 0x32, 0x45, 0xa7, 0x04, 0xf0, 0xe5, 0xfe, 0x98, 0x10, 0xf4, 0x36, 0x03,
+	0xbd, 0x4e, 0xd6, 0x7a, 0x83, 0x8e, 0x04, 0xef, 0x87, 0x1a, 0x8c, 0x47, 0xdf, 0x7f, 0x90, 0xb5,
+	0x54, 0xe1, 0xa9, 0x4f, 0x48, 0xf4, 0x4b, 0x4a, 0xb4, 0x88, 0x6f, 0x95, 0xe1, 0x33, 0xc8, 0x7c,
+	0x1c, 0x5f, 0xfc, 0x99, 0x09, 0x73, 0x7b, 0xf2, 0x85, 0x87, 0xc4, 0xed, 0xd2, 0x57, 0x27, 0x7a,
+	0x51, 0x99, 0x3e, 0xcb, 0xed, 0xd4, 0xa9, 0x6f, 0x6f, 0x72, 0x70, 0x95, 0xe0, 0x8d, 0xc8, 0x47,
+	0x1a, 0x9c, 0x4d, 0x4c, 0x26, 0x59, 0x4a, 0xb2, 0xe7, 0x1f, 0x7a, 0x41, 0x95, 0x1c, 0x21, 0x3e,
+	0xcf, 0x20, 0x5e, 0x21, 0x3b, 0x2a, 0x10, 0x8b, 0xf7, 0xc2, 0x3d, 0xf9, 0xfb, 0xe4, 0x3b, 0x70,
+	0x2a, 0x78, 0xc4, 0x41, 0x96, 0x52, 0x25, 0xc7, 0x9f, 0x7f, 0xe8, 0xcb, 0x59, 0x64, 0x08, 0xcc,
+	0x60, 0xc0, 0xa6, 0x89, 0x1e, 0x07, 0xe6, 0xbb, 0x95, 0x77, 0xe9, 0xc9, 0x0f, 0x34, 0x18, 0x8b,
+	0xbc, 0x4e, 0x20, 0x17, 0xe5, 0xea, 0xc7, 0x9e, 0x37, 0xe8, 0x6b, 0x2a, 0xa4, 0x08, 0x66, 0x99,
+	0x81, 0x99, 0x27, 0xb3, 0xe9, 0x56, 0x12, 0x0f, 0x1a, 0xc8, 0x8f, 0x35, 0xc8, 0x77, 0x67, 0x38,
+	0x22, 0x2b, 0x19, 0x32, 0x02, 0xab, 0xac, 0x66, 0x13, 0x22, 0x94, 0x2b, 0x0c, 0x4a, 0x91, 0x6c,
+	0xf4, 0x86, 0x12, 0x77, 0xd5, 0xf7, 0x34, 0x18, 0x0d, 0x3f, 0x58, 0x20, 0xab, 0x3d, 0x96, 0x5a,
+	0xa4, 0xb4, 0xa0, 0x5f, 0x54, 0xa0, 0x44, 0x70, 0x4b, 0x0c, 0xdc, 0x1c, 0x99, 0x91, 0x2c, 0x49,
+	0x5e, 0x74, 0x20, 0x3f, 0xd4, 0x20, 0x1f, 0xe2, 0x97, 0x98, 0x29, 0xf9, 0xea, 0x41, 0x5f, 0xcd,
+	0x26, 0x44, 0x24, 0x1b, 0x0c, 0xc9, 0x0a, 0x59, 0xea, 0x89, 0xa4, 0x78, 0x8f, 0xfd, 0x7b, 0x9f,
+	0x45, 0x52, 0xa4, 0x61, 0x25, 0x89, 0xa4, 0xb4, 0x06, 0x9a, 0xbe, 0xa6, 0x42, 0x9a, 0x15, 0x49,
+	0xd8, 0x9e, 0xad, 0x60, 0x8b, 0xea, 0xa7, 0x5a, 0xf2, 0x89, 0xc5, 0xa5, 0x5e, 0x72, 0x62, 0x37,
+	0x60, 0x7d, 0x5d, 0x8d, 0x18, 0x61, 0x6d, 0x32, 0x58, 0x6b, 0x64, 0x55, 0x06, 0x4b, 0xdc, 0x7e,
+	0x8b, 0xf7, 0x30, 0xa0, 0xfc, 0x9c, 0x9a, 0xec, 0x56, 0x4b, 0x72, 0xaa, 0xb4, 0xf3, 0xad, 0x17,
+	0x95, 0xe9, 0xb3, 0x72, 0x6a, 0xc7, 0x6d, 0x54, 0xe2, 0x68, 0xc9, 0xcf, 0x34, 0x38, 0x9b, 0x68,
+	0xd7, 0x4a, 0x72, 0xaa, 0xac, 0x81, 0xac, 0x17, 0x54, 0xc9, 0x11, 0xe2, 0x25, 0x06, 0x71, 0x89,
+	0x2c, 0xca, 0x8c, 0x19, 0xea, 0x11, 0x93, 0xdf, 0x68, 0x70, 0x2e, 0xa5, 0x61, 0x4a, 0xd2, 0x0d,
+	0x23, 0x6f, 0xe3, 0xea, 0x9b, 0xea, 0x0c, 0x99, 0x6b, 0x84, 0x31, 0x55, 0x62, 0x21, 0xf9, 0xb1,
+	0x26, 0x6b, 0x15, 0x6e, 0x65, 0x8b, 0x8e, 0xfb, 0x7d, 0xbb, 0x1f, 0x16, 0xc4, 0xfb, 0x1c, 0xc3,
+	0xbb, 0x4d, 0x36, 0x33, 0xf0, 0x76, 0x63, 0x95, 0x7f, 0xb8, 0x4f, 0xfe, 0xa8, 0xc1, 0x64, 0x7a,
+	0x2b, 0x8b, 0xa4, 0x03, 0xe9, 0xd9, 0x66, 0xd3, 0x77, 0xfa, 0xe2, 0x41, 0xf4, 0x5b, 0x0c, 0xfd,
+	0x25, 0x72, 0x31, 0x8e, 0xbe, 0xc1, 0xf9, 0x2a, 0x91, 0x42, 0x29, 0xc3, 0xe6, 0x47, 0x6f, 0x7c,
+	0x56, 0x59, 0xf4, 0xca, 0xba, 0x58, 0x7a, 0x41, 0x95, 0x3c, 0x2b, 0x7a, 0x93, 0x38, 0x5d, 0xf2,
+	0x73, 0x0d, 0xce, 0xc4, 0xa7, 0x22, 0xeb, 0x4a, 0x12, 0x05, 0xbe, 0x0d, 0x45, 0xea, 0xac, 0x4c,
+	0x95, 0x02, 0xaf, 0x78, 0xcf, 0x6c, 0xdc, 0xf7, 0x77, 0x9b, 0xb1, 0x48, 0x1f, 0x47, 0x92, 0xdb,
+	0xd3, 0x3a, 0x4a, 0xfa, 0x9a, 0x0a, 0x29, 0x42, 0x5b, 0x61, 0xd0, 0x16, 0xc8, 0x5c, 0x31, 0xf5,
+	0x2f, 0x3f, 0x98, 0x67, 0x59, 0xaf, 0xe7, 0xb7, 0x1a, 0x9c, 0x4b, 0x69, 0x99, 0x48, 0xd6, 0xbc,
+	0xbc, 0x91, 0xa3, 0x6f, 0xaa, 0x33, 0x64, 0xdd, 0xef, 0xd2, 0x7b, 0x34, 0xe4, 0x91, 0x06, 0x0b,
+	0x99, 0xbd, 0x0d, 0xf2, 0x7f, 0x8a, 0x38, 0xd2, 0x9b, 0x28, 0xfa, 0x8b, 0x8f, 0xcb, 0x8e, 0x4a,
+	0xbd, 0xc4, 0x94, 0xba, 0x46, 0x9e, 0x55, 0x52, 0xaa, 0x72, 0xc7, 0x76, 0x2a, 0x35, 0x3e, 0x0f,
+	0x3f, 0x07, 0xf8, 0xe7, 0xb6, 0xd3, 0xb1, 0xce, 0x89, 0x64, 0xb7, 0x4d, 0x6f, 0xbf, 0xe8, 0xeb,
+	0x6a, 0xc4, 0x88, 0xf7, 0x22, 0xc3, 0xbb, 0x48, 0x16, 0x7a, 0x04, 0x0a, 0x1e, 0x95, 0x1e, 0x68,
+	0x00, 0xdd, 0x0e, 0x0c, 0x59, 0x96, 0x14, 0x49, 0x62, 0xcd, 0x1b, 0x7d, 0x25, 0x93, 0x0e, 0xa1,
+	0x2c, 0x32, 0x28, 0x33, 0xe4, 0x42, 0xb2, 0xa0, 0xd2, 0x71, 0xd9, 0xdf, 0x3d, 0x78, 0x94, 0xfc,
+	0x5a, 0x83, 0x73, 0x29, 0x95, 0x39, 0x49, 0xbc, 0xca, 0xfb, 0x37, 0xfa, 0xa6, 0x3a, 0x43, 0xd6,
+	0x76, 0xef, 0x21, 0x53, 0x25, 0xdc, 0x90, 0xf1, 0x0f, 0x25, 0xc9, 0x0e, 0x89, 0xe4, 0x50, 0x22,
+	0x6d, 0xb6, 0xe8, 0x45, 0x65, 0x7a, 0x65, 0x94, 0xa1, 0xce, 0x0c, 0xf9, 0x40, 0x83, 0xf1, 0x68,
+	0x93, 0x45, 0x72, 0x49, 0x4e, 0x6d, 0xd4, 0xe8, 0x97, 0x94, 0x68, 0xb3, 0x72, 0x52, 0x80, 0x0c,
+	0x77, 0xf7, 0x9f, 0x68, 0x70, 0x26, 0xde, 0x2d, 0x91, 0x64, 0x72, 0x49, 0xd3, 0x45, 0xdf, 0x50,
+	0xa4, 0xce, 0x5a, 0x05, 0x5d, 0xd7, 0x0a, 0x1c, 0x7e, 0x4d, 0x2c, 0xad, 0x4d, 0x42, 0x7a, 0x07,
+	0x54, 0x4a, 0xdb, 0x45, 0xdf, 0xea, 0x83, 0x23, 0x2b, 0x67, 0x06, 0x40, 0xc3, 0x35, 0x10, 0x76,
+	0x91, 0x3f, 0x2f, 0xe9, 0x4c, 0x48, 0xea, 0x37, 0xbd, 0xfb, 0x26, 0xfa, 0xe5, 0xfe, 0x98, 0x94,
+	0xed, 0x1b, 0xb4, 0x37, 0x7e, 0xa1, 0xc1, 0xe9, 0x58, 0x91, 0x98, 0xf4, 0x0e, 0xb3, 0x68, 0xdf,
+	0x42, 0x5f, 0x57, 0x23, 0xce, 0x2a, 0x8c, 0x26, 0x90, 0x85, 0x8b, 0x77, 0x7f, 0xd1, 0x60, 0xba,
+	0x57, 0x7f, 0x80, 0x3c, 0xa7, 0x9a, 0x5d, 0xe2, 0x7d, 0x0c, 0xfd, 0xda, 0x63, 0x70, 0x66, 0xd5,
+	0x22, 0xd3, 0x12, 0x54, 0xa8, 0x57, 0x41, 0x3e, 0xd5, 0x60, 0x32, 0xbd, 0x30, 0x2f, 0x39, 0x93,
+	0xf6, 0xec, 0x03, 0xe8, 0x3b, 0x7d, 0xf1, 0x20, 0xf8, 0x17, 0x18, 0xf8, 0xab, 0xe4, 0x72, 0x96,
+	0x23, 0x2a, 0x58, 0xdd, 0x0f, 0x3b, 0xa4, 0x54, 0xfa, 0xec, 0xe1, 0xac, 0xf6, 0xc5, 0xc3, 0x59,
+	0xed, 0x9f, 0x0f, 0x67, 0xb5, 0x1f, 0x3d, 0x9a, 0x3d, 0xf6, 0xc5, 0xa3, 0xd9, 0x63, 0x7f, 0x7f,
+	0x34, 0x7b, 0xec, 0x9d, 0xd5, 0xa6, 0xe9, 0x1d, 0x74, 0x6a, 0x85, 0xba, 0xdd, 0x62, 0x33, 0x6f,
+	0xd8, 0x4e, 0x93, 0xfd, 0xa7, 0x51, 0xfc, 0x96, 0x90, 0xe2, 0x1d, 0xb5, 0xa9, 0x5b, 0x1b, 0x66,
+	0x7f, 0xf0, 0xba, 0xf3, 0xdf, 0x01, 0x00, 0x66, 0x1e, 0x61, 0x2e, 0xb9, 0x3b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AssetsUnlockedSequenceTip queries the assets unlocked sequence tip.
+	AssetsUnlockedSequenceTip(ctx context.Context, in *QueryAssetsUnlockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedSequenceTipResponse, error)
+	// AssetsUnlockedEvents queries the assets unlocked events.
+	AssetsUnlockedEvents(ctx context.Context, in *QueryAssetsUnlockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedEventsResponse, error)
+	// AssetsLockedEvent queries a single accepted AssetsLocked event by its
+	// sequence number.
+	AssetsLockedEvent(ctx context.Context, in *QueryAssetsLockedEventRequest, opts ...grpc.CallOption) (*QueryAssetsLockedEventResponse, error)
+	// AssetsLockedEvents queries the accepted AssetsLocked events.
+	AssetsLockedEvents(ctx context.Context, in *QueryAssetsLockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsLockedEventsResponse, error)
+	// AssetsLockedSequenceTip queries the assets locked sequence tip.
+	AssetsLockedSequenceTip(ctx context.Context, in *QueryAssetsLockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsLockedSequenceTipResponse, error)
+	// SourceBTCToken queries the BTC token address on the source chain.
+	SourceBTCToken(ctx context.Context, in *QuerySourceBTCTokenRequest, opts ...grpc.CallOption) (*QuerySourceBTCTokenResponse, error)
+	// ERC20TokenMappings queries all ERC20 token mappings supported by the
+	// bridge.
+	ERC20TokenMappings(ctx context.Context, in *QueryERC20TokenMappingsRequest, opts ...grpc.CallOption) (*QueryERC20TokenMappingsResponse, error)
+	// ERC20TokenMapping queries a single ERC20 token mapping by the source
+	// token address.
+	ERC20TokenMapping(ctx context.Context, in *QueryERC20TokenMappingRequest, opts ...grpc.CallOption) (*QueryERC20TokenMappingResponse, error)
+	// BTCSupply queries the total BTC minted and burnt by the bridge.
+	BTCSupply(ctx context.Context, in *QueryBTCSupplyRequest, opts ...grpc.CallOption) (*QueryBTCSupplyResponse, error)
+	// ERC20Supplies queries the amounts of all mapped ERC20 tokens minted and
+	// burnt by the bridge, along with their current total supply on Mezo.
+	ERC20Supplies(ctx context.Context, in *QueryERC20SuppliesRequest, opts ...grpc.CallOption) (*QueryERC20SuppliesResponse, error)
+	// ERC20Supply queries the amounts of a single mapped ERC20 token minted
+	// and burnt by the bridge, along with its current total supply on Mezo.
+	ERC20Supply(ctx context.Context, in *QueryERC20SupplyRequest, opts ...grpc.CallOption) (*QueryERC20SupplyResponse, error)
+	// SourceChains queries the additional bridge-in source chains, along with
+	// their AssetsLocked sequence tips and ERC20 token mappings.
+	SourceChains(ctx context.Context, in *QuerySourceChainsRequest, opts ...grpc.CallOption) (*QuerySourceChainsResponse, error)
+	// SourceChain queries a single additional bridge-in source chain, along
+	// with its AssetsLocked sequence tip and ERC20 token mappings.
+	SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error)
+	// OutflowLimits queries the outflow limits of all tokens that have one,
+	// along with the current outflow and remaining capacity.
+	OutflowLimits(ctx context.Context, in *QueryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryOutflowLimitsResponse, error)
+	// OutflowCapacity queries the outflow limit, current outflow and remaining
+	// capacity of a single Mezo token.
+	OutflowCapacity(ctx context.Context, in *QueryOutflowCapacityRequest, opts ...grpc.CallOption) (*QueryOutflowCapacityResponse, error)
+	// USDOutflowCapacity queries the global USD outflow limit, the current USD
+	// outflow and the remaining USD capacity.
+	USDOutflowCapacity(ctx context.Context, in *QueryUSDOutflowCapacityRequest, opts ...grpc.CallOption) (*QueryUSDOutflowCapacityResponse, error)
+	// OutflowPriceFeeds queries the oracle price feeds valuing token outflows
+	// against the USD outflow limit.
+	OutflowPriceFeeds(ctx context.Context, in *QueryOutflowPriceFeedsRequest, opts ...grpc.CallOption) (*QueryOutflowPriceFeedsResponse, error)
+	// SenderOutflowLimits queries the per-sender bridge-out limit parameters
+	// and the per-sender outflow limits of tokens.
+	SenderOutflowLimits(ctx context.Context, in *QuerySenderOutflowLimitsRequest, opts ...grpc.CallOption) (*QuerySenderOutflowLimitsResponse, error)
+	// SenderOutflowCapacity queries the remaining per-sender bridge-out
+	// capacity of a specific sender.
+	SenderOutflowCapacity(ctx context.Context, in *QuerySenderOutflowCapacityRequest, opts ...grpc.CallOption) (*QuerySenderOutflowCapacityResponse, error)
+	// DelayedBridgeOutParams queries the delay of the delayed bridge-out
+	// queue and the delayed bridge-out thresholds of tokens.
+	DelayedBridgeOutParams(ctx context.Context, in *QueryDelayedBridgeOutParamsRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutParamsResponse, error)
+	// DelayedBridgeOuts queries the bridge-outs held in the delayed bridge-out
+	// queue.
+	DelayedBridgeOuts(ctx context.Context, in *QueryDelayedBridgeOutsRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutsResponse, error)
+	// DelayedBridgeOut queries a single bridge-out held in the delayed
+	// bridge-out queue by its identifier.
+	DelayedBridgeOut(ctx context.Context, in *QueryDelayedBridgeOutRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutResponse, error)
+	// BridgeOutFees queries the bridge-out fee treasury and the bridge-out
+	// fees of tokens and target chains.
+	BridgeOutFees(ctx context.Context, in *QueryBridgeOutFeesRequest, opts ...grpc.CallOption) (*QueryBridgeOutFeesResponse, error)
+	// MinBridgeOutAmounts queries the per-token minimum bridge-out amounts.
+	MinBridgeOutAmounts(ctx context.Context, in *QueryMinBridgeOutAmountsRequest, opts ...grpc.CallOption) (*QueryMinBridgeOutAmountsResponse, error)
+	// MinBridgeOutAmountForBitcoinChain queries the minimum bridge-out amount
+	// that applies specifically to the Bitcoin chain.
+	MinBridgeOutAmountForBitcoinChain(ctx context.Context, in *QueryMinBridgeOutAmountForBitcoinChainRequest, opts ...grpc.CallOption) (*QueryMinBridgeOutAmountForBitcoinChainResponse, error)
+	// BridgeOutChains queries the target chains that accept bridge-outs.
+	BridgeOutChains(ctx context.Context, in *QueryBridgeOutChainsRequest, opts ...grpc.CallOption) (*QueryBridgeOutChainsResponse, error)
+	// PauseState queries the bridge-in and bridge-out paused flags.
+	PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// TripartyControllers queries the allowed triparty controllers.
+	TripartyControllers(ctx context.Context, in *QueryTripartyControllersRequest, opts ...grpc.CallOption) (*QueryTripartyControllersResponse, error)
+	// TripartyBlockDelay queries the triparty block delay.
+	TripartyBlockDelay(ctx context.Context, in *QueryTripartyBlockDelayRequest, opts ...grpc.CallOption) (*QueryTripartyBlockDelayResponse, error)
+	// TripartyLimits queries the triparty per-request and window limits.
+	TripartyLimits(ctx context.Context, in *QueryTripartyLimitsRequest, opts ...grpc.CallOption) (*QueryTripartyLimitsResponse, error)
+	// TripartyCapacity queries the remaining triparty window capacity.
+	TripartyCapacity(ctx context.Context, in *QueryTripartyCapacityRequest, opts ...grpc.CallOption) (*QueryTripartyCapacityResponse, error)
+	// TripartySequenceTips queries the triparty request and processed sequence
+	// tips.
+	TripartySequenceTips(ctx context.Context, in *QueryTripartySequenceTipsRequest, opts ...grpc.CallOption) (*QueryTripartySequenceTipsResponse, error)
+	// TripartyPendingRequests queries the pending triparty bridge requests.
+	TripartyPendingRequests(ctx context.Context, in *QueryTripartyPendingRequestsRequest, opts ...grpc.CallOption) (*QueryTripartyPendingRequestsResponse, error)
+	// TripartyRequest queries a single pending triparty bridge request by its
+	// sequence number.
+	TripartyRequest(ctx context.Context, in *QueryTripartyRequestRequest, opts ...grpc.CallOption) (*QueryTripartyRequestResponse, error)
+	// TripartyControllersBTCMinted queries the BTC minted through the triparty
+	// bridge path per controller.
+	TripartyControllersBTCMinted(ctx context.Context, in *QueryTripartyControllersBTCMintedRequest, opts ...grpc.CallOption) (*QueryTripartyControllersBTCMintedResponse, error)
+	// TripartyRequestOutcome queries the outcome record of a processed triparty
+	// bridge request by its sequence number.
+	TripartyRequestOutcome(ctx context.Context, in *QueryTripartyRequestOutcomeRequest, opts ...grpc.CallOption) (*QueryTripartyRequestOutcomeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetsUnlockedSequenceTip(ctx context.Context, in *QueryAssetsUnlockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedSequenceTipResponse, error) {
+	out := new(QueryAssetsUnlockedSequenceTipResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsUnlockedSequenceTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetsUnlockedEvents(ctx context.Context, in *QueryAssetsUnlockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsUnlockedEventsResponse, error) {
+	out := new(QueryAssetsUnlockedEventsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsUnlockedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetsLockedEvent(ctx context.Context, in *QueryAssetsLockedEventRequest, opts ...grpc.CallOption) (*QueryAssetsLockedEventResponse, error) {
+	out := new(QueryAssetsLockedEventResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsLockedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetsLockedEvents(ctx context.Context, in *QueryAssetsLockedEventsRequest, opts ...grpc.CallOption) (*QueryAssetsLockedEventsResponse, error) {
+	out := new(QueryAssetsLockedEventsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsLockedEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetsLockedSequenceTip(ctx context.Context, in *QueryAssetsLockedSequenceTipRequest, opts ...grpc.CallOption) (*QueryAssetsLockedSequenceTipResponse, error) {
+	out := new(QueryAssetsLockedSequenceTipResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/AssetsLockedSequenceTip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceBTCToken(ctx context.Context, in *QuerySourceBTCTokenRequest, opts ...grpc.CallOption) (*QuerySourceBTCTokenResponse, error) {
+	out := new(QuerySourceBTCTokenResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/SourceBTCToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20TokenMappings(ctx context.Context, in *QueryERC20TokenMappingsRequest, opts ...grpc.CallOption) (*QueryERC20TokenMappingsResponse, error) {
+	out := new(QueryERC20TokenMappingsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/ERC20TokenMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20TokenMapping(ctx context.Context, in *QueryERC20TokenMappingRequest, opts ...grpc.CallOption) (*QueryERC20TokenMappingResponse, error) {
+	out := new(QueryERC20TokenMappingResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/ERC20TokenMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCSupply(ctx context.Context, in *QueryBTCSupplyRequest, opts ...grpc.CallOption) (*QueryBTCSupplyResponse, error) {
+	out := new(QueryBTCSupplyResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/BTCSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20Supplies(ctx context.Context, in *QueryERC20SuppliesRequest, opts ...grpc.CallOption) (*QueryERC20SuppliesResponse, error) {
+	out := new(QueryERC20SuppliesResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/ERC20Supplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20Supply(ctx context.Context, in *QueryERC20SupplyRequest, opts ...grpc.CallOption) (*QueryERC20SupplyResponse, error) {
+	out := new(QueryERC20SupplyResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/ERC20Supply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChains(ctx context.Context, in *QuerySourceChainsRequest, opts ...grpc.CallOption) (*QuerySourceChainsResponse, error) {
+	out := new(QuerySourceChainsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/SourceChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error) {
+	out := new(QuerySourceChainResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/SourceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutflowLimits(ctx context.Context, in *QueryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryOutflowLimitsResponse, error) {
+	out := new(QueryOutflowLimitsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/OutflowLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutflowCapacity(ctx context.Context, in *QueryOutflowCapacityRequest, opts ...grpc.CallOption) (*QueryOutflowCapacityResponse, error) {
+	out := new(QueryOutflowCapacityResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/OutflowCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) USDOutflowCapacity(ctx context.Context, in *QueryUSDOutflowCapacityRequest, opts ...grpc.CallOption) (*QueryUSDOutflowCapacityResponse, error) {
+	out := new(QueryUSDOutflowCapacityResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/USDOutflowCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutflowPriceFeeds(ctx context.Context, in *QueryOutflowPriceFeedsRequest, opts ...grpc.CallOption) (*QueryOutflowPriceFeedsResponse, error) {
+	out := new(QueryOutflowPriceFeedsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/OutflowPriceFeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderOutflowLimits(ctx context.Context, in *QuerySenderOutflowLimitsRequest, opts ...grpc.CallOption) (*QuerySenderOutflowLimitsResponse, error) {
+	out := new(QuerySenderOutflowLimitsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/SenderOutflowLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderOutflowCapacity(ctx context.Context, in *QuerySenderOutflowCapacityRequest, opts ...grpc.CallOption) (*QuerySenderOutflowCapacityResponse, error) {
+	out := new(QuerySenderOutflowCapacityResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/SenderOutflowCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedBridgeOutParams(ctx context.Context, in *QueryDelayedBridgeOutParamsRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutParamsResponse, error) {
+	out := new(QueryDelayedBridgeOutParamsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/DelayedBridgeOutParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedBridgeOuts(ctx context.Context, in *QueryDelayedBridgeOutsRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutsResponse, error) {
+	out := new(QueryDelayedBridgeOutsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/DelayedBridgeOuts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelayedBridgeOut(ctx context.Context, in *QueryDelayedBridgeOutRequest, opts ...grpc.CallOption) (*QueryDelayedBridgeOutResponse, error) {
+	out := new(QueryDelayedBridgeOutResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/DelayedBridgeOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeOutFees(ctx context.Context, in *QueryBridgeOutFeesRequest, opts ...grpc.CallOption) (*QueryBridgeOutFeesResponse, error) {
+	out := new(QueryBridgeOutFeesResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/BridgeOutFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinBridgeOutAmounts(ctx context.Context, in *QueryMinBridgeOutAmountsRequest, opts ...grpc.CallOption) (*QueryMinBridgeOutAmountsResponse, error) {
+	out := new(QueryMinBridgeOutAmountsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/MinBridgeOutAmounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinBridgeOutAmountForBitcoinChain(ctx context.Context, in *QueryMinBridgeOutAmountForBitcoinChainRequest, opts ...grpc.CallOption) (*QueryMinBridgeOutAmountForBitcoinChainResponse, error) {
+	out := new(QueryMinBridgeOutAmountForBitcoinChainResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/MinBridgeOutAmountForBitcoinChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeOutChains(ctx context.Context, in *QueryBridgeOutChainsRequest, opts ...grpc.CallOption) (*QueryBridgeOutChainsResponse, error) {
+	out := new(QueryBridgeOutChainsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/BridgeOutChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/PauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyControllers(ctx context.Context, in *QueryTripartyControllersRequest, opts ...grpc.CallOption) (*QueryTripartyControllersResponse, error) {
+	out := new(QueryTripartyControllersResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyControllers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyBlockDelay(ctx context.Context, in *QueryTripartyBlockDelayRequest, opts ...grpc.CallOption) (*QueryTripartyBlockDelayResponse, error) {
+	out := new(QueryTripartyBlockDelayResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyBlockDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyLimits(ctx context.Context, in *QueryTripartyLimitsRequest, opts ...grpc.CallOption) (*QueryTripartyLimitsResponse, error) {
+	out := new(QueryTripartyLimitsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyCapacity(ctx context.Context, in *QueryTripartyCapacityRequest, opts ...grpc.CallOption) (*QueryTripartyCapacityResponse, error) {
+	out := new(QueryTripartyCapacityResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartySequenceTips(ctx context.Context, in *QueryTripartySequenceTipsRequest, opts ...grpc.CallOption) (*QueryTripartySequenceTipsResponse, error) {
+	out := new(QueryTripartySequenceTipsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartySequenceTips", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyPendingRequests(ctx context.Context, in *QueryTripartyPendingRequestsRequest, opts ...grpc.CallOption) (*QueryTripartyPendingRequestsResponse, error) {
+	out := new(QueryTripartyPendingRequestsResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyPendingRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyRequest(ctx context.Context, in *QueryTripartyRequestRequest, opts ...grpc.CallOption) (*QueryTripartyRequestResponse, error) {
+	out := new(QueryTripartyRequestResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyControllersBTCMinted(ctx context.Context, in *QueryTripartyControllersBTCMintedRequest, opts ...grpc.CallOption) (*QueryTripartyControllersBTCMintedResponse, error) {
+	out := new(QueryTripartyControllersBTCMintedResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyControllersBTCMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripartyRequestOutcome(ctx context.Context, in *QueryTripartyRequestOutcomeRequest, opts ...grpc.CallOption) (*QueryTripartyRequestOutcomeResponse, error) {
+	out := new(QueryTripartyRequestOutcomeResponse)
+	err := c.cc.Invoke(ctx, "/mezo.bridge.v1.Query/TripartyRequestOutcome", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AssetsUnlockedSequenceTip queries the assets unlocked sequence tip.
+	AssetsUnlockedSequenceTip(context.Context, *QueryAssetsUnlockedSequenceTipRequest) (*QueryAssetsUnlockedSequenceTipResponse, error)
+	// AssetsUnlockedEvents queries the assets unlocked events.
+	AssetsUnlockedEvents(context.Context, *QueryAssetsUnlockedEventsRequest) (*QueryAssetsUnlockedEventsResponse, error)
+	// AssetsLockedEvent queries a single accepted AssetsLocked event by its
+	// sequence number.
+	AssetsLockedEvent(context.Context, *QueryAssetsLockedEventRequest) (*QueryAssetsLockedEventResponse, error)
+	// AssetsLockedEvents queries the accepted AssetsLocked events.
+	AssetsLockedEvents(context.Context, *QueryAssetsLockedEventsRequest) (*QueryAssetsLockedEventsResponse, error)
+	// AssetsLockedSequenceTip queries the assets locked sequence tip.
+	AssetsLockedSequenceTip(context.Context, *QueryAssetsLockedSequenceTipRequest) (*QueryAssetsLockedSequenceTipResponse, error)
+	// SourceBTCToken queries the BTC token address on the source chain.
+	SourceBTCToken(context.Context, *QuerySourceBTCTokenRequest) (*QuerySourceBTCTokenResponse, error)
+	// ERC20TokenMappings queries all ERC20 token mappings supported by the
+	// bridge.
+	ERC20TokenMappings(context.Context, *QueryERC20TokenMappingsRequest) (*QueryERC20TokenMappingsResponse, error)
+	// ERC20TokenMapping queries a single ERC20 token mapping by the source
+	// token address.
+	ERC20TokenMapping(context.Context, *QueryERC20TokenMappingRequest) (*QueryERC20TokenMappingResponse, error)
+	// BTCSupply queries the total BTC minted and burnt by the bridge.
+	BTCSupply(context.Context, *QueryBTCSupplyRequest) (*QueryBTCSupplyResponse, error)
+	// ERC20Supplies queries the amounts of all mapped ERC20 tokens minted and
+	// burnt by the bridge, along with their current total supply on Mezo.
+	ERC20Supplies(context.Context, *QueryERC20SuppliesRequest) (*QueryERC20SuppliesResponse, error)
+	// ERC20Supply queries the amounts of a single mapped ERC20 token minted
+	// and burnt by the bridge, along with its current total supply on Mezo.
+	ERC20Supply(context.Context, *QueryERC20SupplyRequest) (*QueryERC20SupplyResponse, error)
+	// SourceChains queries the additional bridge-in source chains, along with
+	// their AssetsLocked sequence tips and ERC20 token mappings.
+	SourceChains(context.Context, *QuerySourceChainsRequest) (*QuerySourceChainsResponse, error)
+	// SourceChain queries a single additional bridge-in source chain, along
+	// with its AssetsLocked sequence tip and ERC20 token mappings.
+	SourceChain(context.Context, *QuerySourceChainRequest) (*QuerySourceChainResponse, error)
+	// OutflowLimits queries the outflow limits of all tokens that have one,
+	// along with the current outflow and remaining capacity.
+	OutflowLimits(context.Context, *QueryOutflowLimitsRequest) (*QueryOutflowLimitsResponse, error)
+	// OutflowCapacity queries the outflow limit, current outflow and remaining
+	// capacity of a single Mezo token.
+	OutflowCapacity(context.Context, *QueryOutflowCapacityRequest) (*QueryOutflowCapacityResponse, error)
+	// USDOutflowCapacity queries the global USD outflow limit, the current USD
+	// outflow and the remaining USD capacity.
+	USDOutflowCapacity(context.Context, *QueryUSDOutflowCapacityRequest) (*QueryUSDOutflowCapacityResponse, error)
+	// OutflowPriceFeeds queries the oracle price feeds valuing token outflows
+	// against the USD outflow limit.
+	OutflowPriceFeeds(context.Context, *QueryOutflowPriceFeedsRequest) (*QueryOutflowPriceFeedsResponse, error)
+	// SenderOutflowLimits queries the per-sender bridge-out limit parameters
+	// and the per-sender outflow limits of tokens.
+	SenderOutflowLimits(context.Context, *QuerySenderOutflowLimitsRequest) (*QuerySenderOutflowLimitsResponse, error)
+	// SenderOutflowCapacity queries the remaining per-sender bridge-out
+	// capacity of a specific sender.
+	SenderOutflowCapacity(context.Context, *QuerySenderOutflowCapacityRequest) (*QuerySenderOutflowCapacityResponse, error)
+	// DelayedBridgeOutParams queries the delay of the delayed bridge-out
+	// queue and the delayed bridge-out thresholds of tokens.
+	DelayedBridgeOutParams(context.Context, *QueryDelayedBridgeOutParamsRequest) (*QueryDelayedBridgeOutParamsResponse, error)
+	// DelayedBridgeOuts queries the bridge-outs held in the delayed bridge-out
+	// queue.
+	DelayedBridgeOuts(context.Context, *QueryDelayedBridgeOutsRequest) (*QueryDelayedBridgeOutsResponse, error)
+	// DelayedBridgeOut queries a single bridge-out held in the delayed
+	// bridge-out queue by its identifier.
+	DelayedBridgeOut(context.Context, *QueryDelayedBridgeOutRequest) (*QueryDelayedBridgeOutResponse, error)
+	// BridgeOutFees queries the bridge-out fee treasury and the bridge-out
+	// fees of tokens and target chains.
+	BridgeOutFees(context.Context, *QueryBridgeOutFeesRequest) (*QueryBridgeOutFeesResponse, error)
+	// MinBridgeOutAmounts queries the per-token minimum bridge-out amounts.
+	MinBridgeOutAmounts(context.Context, *QueryMinBridgeOutAmountsRequest) (*QueryMinBridgeOutAmountsResponse, error)
+	// MinBridgeOutAmountForBitcoinChain queries the minimum bridge-out amount
+	// that applies specifically to the Bitcoin chain.
+	MinBridgeOutAmountForBitcoinChain(context.Context, *QueryMinBridgeOutAmountForBitcoinChainRequest) (*QueryMinBridgeOutAmountForBitcoinChainResponse, error)
+	// BridgeOutChains queries the target chains that accept bridge-outs.
+	BridgeOutChains(context.Context, *QueryBridgeOutChainsRequest) (*QueryBridgeOutChainsResponse, error)
+	// PauseState queries the bridge-in and bridge-out paused flags.
+	PauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// TripartyControllers queries the allowed triparty controllers.
+	TripartyControllers(context.Context, *QueryTripartyControllersRequest) (*QueryTripartyControllersResponse, error)
+	// TripartyBlockDelay queries the triparty block delay.
+	TripartyBlockDelay(context.Context, *QueryTripartyBlockDelayRequest) (*QueryTripartyBlockDelayResponse, error)
+	// TripartyLimits queries the triparty per-request and window limits.
+	TripartyLimits(context.Context, *QueryTripartyLimitsRequest) (*QueryTripartyLimitsResponse, error)
+	// TripartyCapacity queries the remaining triparty window capacity.
+	TripartyCapacity(context.Context, *QueryTripartyCapacityRequest) (*QueryTripartyCapacityResponse, error)
+	// TripartySequenceTips queries the triparty request and processed sequence
+	// tips.
+	TripartySequenceTips(context.Context, *QueryTripartySequenceTipsRequest) (*QueryTripartySequenceTipsResponse, error)
+	// TripartyPendingRequests queries the pending triparty bridge requests.
+	TripartyPendingRequests(context.Context, *QueryTripartyPendingRequestsRequest) (*QueryTripartyPendingRequestsResponse, error)
+	// TripartyRequest queries a single pending triparty bridge request by its
+	// sequence number.
+	TripartyRequest(context.Context, *QueryTripartyRequestRequest) (*QueryTripartyRequestResponse, error)
+	// TripartyControllersBTCMinted queries the BTC minted through the triparty
+	// bridge path per controller.
+	TripartyControllersBTCMinted(context.Context, *QueryTripartyControllersBTCMintedRequest) (*QueryTripartyControllersBTCMintedResponse, error)
+	// TripartyRequestOutcome queries the outcome record of a processed triparty
+	// bridge request by its sequence number.
+	TripartyRequestOutcome(context.Context, *QueryTripartyRequestOutcomeRequest) (*QueryTripartyRequestOutcomeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AssetsUnlockedSequenceTip(ctx context.Context, req *QueryAssetsUnlockedSequenceTipRequest) (*QueryAssetsUnlockedSequenceTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsUnlockedSequenceTip not implemented")
+}
+func (*UnimplementedQueryServer) AssetsUnlockedEvents(ctx context.Context, req *QueryAssetsUnlockedEventsRequest) (*QueryAssetsUnlockedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsUnlockedEvents not implemented")
+}
+func (*UnimplementedQueryServer) AssetsLockedEvent(ctx context.Context, req *QueryAssetsLockedEventRequest) (*QueryAssetsLockedEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsLockedEvent not implemented")
+}
+func (*UnimplementedQueryServer) AssetsLockedEvents(ctx context.Context, req *QueryAssetsLockedEventsRequest) (*QueryAssetsLockedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsLockedEvents not implemented")
+}
+func (*UnimplementedQueryServer) AssetsLockedSequenceTip(ctx context.Context, req *QueryAssetsLockedSequenceTipRequest) (*QueryAssetsLockedSequenceTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetsLockedSequenceTip not implemented")
+}
+func (*UnimplementedQueryServer) SourceBTCToken(ctx context.Context, req *QuerySourceBTCTokenRequest) (*QuerySourceBTCTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceBTCToken not implemented")
+}
+func (*UnimplementedQueryServer) ERC20TokenMappings(ctx context.Context, req *QueryERC20TokenMappingsRequest) (*QueryERC20TokenMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20TokenMappings not implemented")
+}
+func (*UnimplementedQueryServer) ERC20TokenMapping(ctx context.Context, req *QueryERC20TokenMappingRequest) (*QueryERC20TokenMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20TokenMapping not implemented")
+}
+func (*UnimplementedQueryServer) BTCSupply(ctx context.Context, req *QueryBTCSupplyRequest) (*QueryBTCSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCSupply not implemented")
+}
+func (*UnimplementedQueryServer) ERC20Supplies(ctx context.Context, req *QueryERC20SuppliesRequest) (*QueryERC20SuppliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Supplies not implemented")
+}
+func (*UnimplementedQueryServer) ERC20Supply(ctx context.Context, req *QueryERC20SupplyRequest) (*QueryERC20SupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Supply not implemented")
+}
+func (*UnimplementedQueryServer) SourceChains(ctx context.Context, req *QuerySourceChainsRequest) (*QuerySourceChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChains not implemented")
+}
+func (*UnimplementedQueryServer) SourceChain(ctx context.Context, req *QuerySourceChainRequest) (*QuerySourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChain not implemented")
+}
+func (*UnimplementedQueryServer) OutflowLimits(ctx context.Context, req *QueryOutflowLimitsRequest) (*QueryOutflowLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutflowLimits not implemented")
+}
+func (*UnimplementedQueryServer) OutflowCapacity(ctx context.Context, req *QueryOutflowCapacityRequest) (*QueryOutflowCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutflowCapacity not implemented")
+}
+func (*UnimplementedQueryServer) USDOutflowCapacity(ctx context.Context, req *QueryUSDOutflowCapacityRequest) (*QueryUSDOutflowCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method USDOutflowCapacity not implemented")
+}
+func (*UnimplementedQueryServer) OutflowPriceFeeds(ctx context.Context, req *QueryOutflowPriceFeedsRequest) (*QueryOutflowPriceFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutflowPriceFeeds not implemented")
+}
+func (*UnimplementedQueryServer) SenderOutflowLimits(ctx context.Context, req *QuerySenderOutflowLimitsRequest) (*QuerySenderOutflowLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderOutflowLimits not implemented")
+}
+func (*UnimplementedQueryServer) SenderOutflowCapacity(ctx context.Context, req *QuerySenderOutflowCapacityRequest) (*QuerySenderOutflowCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderOutflowCapacity not implemented")
+}
+func (*UnimplementedQueryServer) DelayedBridgeOutParams(ctx context.Context, req *QueryDelayedBridgeOutParamsRequest) (*QueryDelayedBridgeOutParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedBridgeOutParams not implemented")
+}
+func (*UnimplementedQueryServer) DelayedBridgeOuts(ctx context.Context, req *QueryDelayedBridgeOutsRequest) (*QueryDelayedBridgeOutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedBridgeOuts not implemented")
+}
+func (*UnimplementedQueryServer) DelayedBridgeOut(ctx context.Context, req *QueryDelayedBridgeOutRequest) (*QueryDelayedBridgeOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayedBridgeOut not implemented")
+}
+func (*UnimplementedQueryServer) BridgeOutFees(ctx context.Context, req *QueryBridgeOutFeesRequest) (*QueryBridgeOutFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeOutFees not implemented")
+}
+func (*UnimplementedQueryServer) MinBridgeOutAmounts(ctx context.Context, req *QueryMinBridgeOutAmountsRequest) (*QueryMinBridgeOutAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinBridgeOutAmounts not implemented")
+}
+func (*UnimplementedQueryServer) MinBridgeOutAmountForBitcoinChain(ctx context.Context, req *QueryMinBridgeOutAmountForBitcoinChainRequest) (*QueryMinBridgeOutAmountForBitcoinChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinBridgeOutAmountForBitcoinChain not implemented")
+}
+func (*UnimplementedQueryServer) BridgeOutChains(ctx context.Context, req *QueryBridgeOutChainsRequest) (*QueryBridgeOutChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeOutChains not implemented")
+}
+func (*UnimplementedQueryServer) PauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseState not implemented")
+}
+func (*UnimplementedQueryServer) TripartyControllers(ctx context.Context, req *QueryTripartyControllersRequest) (*QueryTripartyControllersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyControllers not implemented")
+}
+func (*UnimplementedQueryServer) TripartyBlockDelay(ctx context.Context, req *QueryTripartyBlockDelayRequest) (*QueryTripartyBlockDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyBlockDelay not implemented")
+}
+func (*UnimplementedQueryServer) TripartyLimits(ctx context.Context, req *QueryTripartyLimitsRequest) (*QueryTripartyLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyLimits not implemented")
+}
+func (*UnimplementedQueryServer) TripartyCapacity(ctx context.Context, req *QueryTripartyCapacityRequest) (*QueryTripartyCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyCapacity not implemented")
+}
+func (*UnimplementedQueryServer) TripartySequenceTips(ctx context.Context, req *QueryTripartySequenceTipsRequest) (*QueryTripartySequenceTipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartySequenceTips not implemented")
+}
+func (*UnimplementedQueryServer) TripartyPendingRequests(ctx context.Context, req *QueryTripartyPendingRequestsRequest) (*QueryTripartyPendingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyPendingRequests not implemented")
+}
+func (*UnimplementedQueryServer) TripartyRequest(ctx context.Context, req *QueryTripartyRequestRequest) (*QueryTripartyRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyRequest not implemented")
+}
+func (*UnimplementedQueryServer) TripartyControllersBTCMinted(ctx context.Context, req *QueryTripartyControllersBTCMintedRequest) (*QueryTripartyControllersBTCMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyControllersBTCMinted not implemented")
+}
+func (*UnimplementedQueryServer) TripartyRequestOutcome(ctx context.Context, req *QueryTripartyRequestOutcomeRequest) (*QueryTripartyRequestOutcomeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripartyRequestOutcome not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetsUnlockedSequenceTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetsUnlockedSequenceTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsUnlockedSequenceTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/AssetsUnlockedSequenceTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsUnlockedSequenceTip(ctx, req.(*QueryAssetsUnlockedSequenceTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetsUnlockedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetsUnlockedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsUnlockedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/AssetsUnlockedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsUnlockedEvents(ctx, req.(*QueryAssetsUnlockedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetsLockedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetsLockedEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsLockedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/AssetsLockedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsLockedEvent(ctx, req.(*QueryAssetsLockedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetsLockedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetsLockedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsLockedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/AssetsLockedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsLockedEvents(ctx, req.(*QueryAssetsLockedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetsLockedSequenceTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetsLockedSequenceTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetsLockedSequenceTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/AssetsLockedSequenceTip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetsLockedSequenceTip(ctx, req.(*QueryAssetsLockedSequenceTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceBTCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceBTCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceBTCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/SourceBTCToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceBTCToken(ctx, req.(*QuerySourceBTCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20TokenMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20TokenMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20TokenMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/ERC20TokenMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20TokenMappings(ctx, req.(*QueryERC20TokenMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20TokenMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20TokenMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20TokenMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/ERC20TokenMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20TokenMapping(ctx, req.(*QueryERC20TokenMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/BTCSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCSupply(ctx, req.(*QueryBTCSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20Supplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20SuppliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20Supplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/ERC20Supplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20Supplies(ctx, req.(*QueryERC20SuppliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20SupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/ERC20Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20Supply(ctx, req.(*QueryERC20SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/SourceChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChains(ctx, req.(*QuerySourceChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/SourceChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChain(ctx, req.(*QuerySourceChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutflowLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutflowLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/OutflowLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutflowLimits(ctx, req.(*QueryOutflowLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutflowCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutflowCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/OutflowCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutflowCapacity(ctx, req.(*QueryOutflowCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_USDOutflowCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUSDOutflowCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).USDOutflowCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/USDOutflowCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).USDOutflowCapacity(ctx, req.(*QueryUSDOutflowCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutflowPriceFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowPriceFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutflowPriceFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/OutflowPriceFeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutflowPriceFeeds(ctx, req.(*QueryOutflowPriceFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderOutflowLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderOutflowLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderOutflowLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/SenderOutflowLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderOutflowLimits(ctx, req.(*QuerySenderOutflowLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderOutflowCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderOutflowCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderOutflowCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/SenderOutflowCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderOutflowCapacity(ctx, req.(*QuerySenderOutflowCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedBridgeOutParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedBridgeOutParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedBridgeOutParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/DelayedBridgeOutParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedBridgeOutParams(ctx, req.(*QueryDelayedBridgeOutParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedBridgeOuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedBridgeOutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedBridgeOuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/DelayedBridgeOuts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedBridgeOuts(ctx, req.(*QueryDelayedBridgeOutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelayedBridgeOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelayedBridgeOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelayedBridgeOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/DelayedBridgeOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelayedBridgeOut(ctx, req.(*QueryDelayedBridgeOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeOutFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeOutFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeOutFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/BridgeOutFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeOutFees(ctx, req.(*QueryBridgeOutFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinBridgeOutAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinBridgeOutAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinBridgeOutAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/MinBridgeOutAmounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinBridgeOutAmounts(ctx, req.(*QueryMinBridgeOutAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinBridgeOutAmountForBitcoinChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinBridgeOutAmountForBitcoinChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinBridgeOutAmountForBitcoinChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/MinBridgeOutAmountForBitcoinChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinBridgeOutAmountForBitcoinChain(ctx, req.(*QueryMinBridgeOutAmountForBitcoinChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeOutChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeOutChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeOutChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/BridgeOutChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeOutChains(ctx, req.(*QueryBridgeOutChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/PauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyControllers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyControllersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyControllers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyControllers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyControllers(ctx, req.(*QueryTripartyControllersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyBlockDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyBlockDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyBlockDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyBlockDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyBlockDelay(ctx, req.(*QueryTripartyBlockDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyLimits(ctx, req.(*QueryTripartyLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyCapacity(ctx, req.(*QueryTripartyCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartySequenceTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartySequenceTipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartySequenceTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartySequenceTips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartySequenceTips(ctx, req.(*QueryTripartySequenceTipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyPendingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyPendingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyPendingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyPendingRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyPendingRequests(ctx, req.(*QueryTripartyPendingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyRequest(ctx, req.(*QueryTripartyRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyControllersBTCMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyControllersBTCMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyControllersBTCMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyControllersBTCMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyControllersBTCMinted(ctx, req.(*QueryTripartyControllersBTCMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripartyRequestOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripartyRequestOutcomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripartyRequestOutcome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mezo.bridge.v1.Query/TripartyRequestOutcome",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripartyRequestOutcome(ctx, req.(*QueryTripartyRequestOutcomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mezo.bridge.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AssetsUnlockedSequenceTip",
+			Handler:    _Query_AssetsUnlockedSequenceTip_Handler,
+		},
+		{
+			MethodName: "AssetsUnlockedEvents",
+			Handler:    _Query_AssetsUnlockedEvents_Handler,
+		},
+		{
+			MethodName: "AssetsLockedEvent",
+			Handler:    _Query_AssetsLockedEvent_Handler,
+		},
+		{
+			MethodName: "AssetsLockedEvents",
+			Handler:    _Query_AssetsLockedEvents_Handler,
+		},
+		{
+			MethodName: "AssetsLockedSequenceTip",
+			Handler:    _Query_AssetsLockedSequenceTip_Handler,
+		},
+		{
+			MethodName: "SourceBTCToken",
+			Handler:    _Query_SourceBTCToken_Handler,
+		},
+		{
+			MethodName: "ERC20TokenMappings",
+			Handler:    _Query_ERC20TokenMappings_Handler,
+		},
+		{
+			MethodName: "ERC20TokenMapping",
+			Handler:    _Query_ERC20TokenMapping_Handler,
+		},
+		{
+			MethodName: "BTCSupply",
+			Handler:    _Query_BTCSupply_Handler,
+		},
+		{
+			MethodName: "ERC20Supplies",
+			Handler:    _Query_ERC20Supplies_Handler,
+		},
+		{
+			MethodName: "ERC20Supply",
+			Handler:    _Query_ERC20Supply_Handler,
+		},
+		{
+			MethodName: "SourceChains",
+			Handler:    _Query_SourceChains_Handler,
+		},
+		{
+			MethodName: "SourceChain",
+			Handler:    _Query_SourceChain_Handler,
+		},
+		{
+			MethodName: "OutflowLimits",
+			Handler:    _Query_OutflowLimits_Handler,
+		},
+		{
+			MethodName: "OutflowCapacity",
+			Handler:    _Query_OutflowCapacity_Handler,
+		},
+		{
+			MethodName: "USDOutflowCapacity",
+			Handler:    _Query_USDOutflowCapacity_Handler,
+		},
+		{
+			MethodName: "OutflowPriceFeeds",
+			Handler:    _Query_OutflowPriceFeeds_Handler,
+		},
+		{
+			MethodName: "SenderOutflowLimits",
+			Handler:    _Query_SenderOutflowLimits_Handler,
+		},
+		{
+			MethodName: "SenderOutflowCapacity",
+			Handler:    _Query_SenderOutflowCapacity_Handler,
+		},
+		{
+			MethodName: "DelayedBridgeOutParams",
+			Handler:    _Query_DelayedBridgeOutParams_Handler,
+		},
+		{
+			MethodName: "DelayedBridgeOuts",
+			Handler:    _Query_DelayedBridgeOuts_Handler,
+		},
+		{
+			MethodName: "DelayedBridgeOut",
+			Handler:    _Query_DelayedBridgeOut_Handler,
+		},
+		{
+			MethodName: "BridgeOutFees",
+			Handler:    _Query_BridgeOutFees_Handler,
+		},
+		{
+			MethodName: "MinBridgeOutAmounts",
+			Handler:    _Query_MinBridgeOutAmounts_Handler,
+		},
+		{
+			MethodName: "MinBridgeOutAmountForBitcoinChain",
+			Handler:    _Query_MinBridgeOutAmountForBitcoinChain_Handler,
+		},
+		{
+			MethodName: "BridgeOutChains",
+			Handler:    _Query_BridgeOutChains_Handler,
+		},
+		{
+			MethodName: "PauseState",
+			Handler:    _Query_PauseState_Handler,
+		},
+		{
+			MethodName: "TripartyControllers",
+			Handler:    _Query_TripartyControllers_Handler,
+		},
+		{
+			MethodName: "TripartyBlockDelay",
+			Handler:    _Query_TripartyBlockDelay_Handler,
+		},
+		{
+			MethodName: "TripartyLimits",
+			Handler:    _Query_TripartyLimits_Handler,
+		},
+		{
+			MethodName: "TripartyCapacity",
+			Handler:    _Query_TripartyCapacity_Handler,
+		},
+		{
+			MethodName: "TripartySequenceTips",
+			Handler:    _Query_TripartySequenceTips_Handler,
+		},
+		{
+			MethodName: "TripartyPendingRequests",
+			Handler:    _Query_TripartyPendingRequests_Handler,
+		},
+		{
+			MethodName: "TripartyRequest",
+			Handler:    _Query_TripartyRequest_Handler,
+		},
+		{
+			MethodName: "TripartyControllersBTCMinted",
+			Handler:    _Query_TripartyControllersBTCMinted_Handler,
+		},
+		{
+			MethodName: "TripartyRequestOutcome",
+			Handler:    _Query_TripartyRequestOutcome_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mezo/bridge/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsUnlockedSequenceTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsUnlockedSequenceTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsUnlockedSequenceTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsUnlockedSequenceTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsUnlockedSequenceTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsUnlockedSequenceTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SequenceTip.Size()
+		i -= size
+		if _, err := m.SequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsUnlockedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsUnlockedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsUnlockedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SequenceEnd.Size()
+		i -= size
+		if _, err := m.SequenceEnd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SequenceStart.Size()
+		i -= size
+		if _, err := m.SequenceStart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsUnlockedEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsUnlockedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsUnlockedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedSequenceTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedSequenceTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedSequenceTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedSequenceTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedSequenceTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedSequenceTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SequenceTip.Size()
+		i -= size
+		if _, err := m.SequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceChain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChain))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SequenceEnd.Size()
+		i -= size
+		if _, err := m.SequenceEnd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SequenceStart.Size()
+		i -= size
+		if _, err := m.SequenceStart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetsLockedEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetsLockedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetsLockedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceBTCTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceBTCTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceBTCTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceBTCTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceBTCTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceBTCTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceBtcToken) > 0 {
+		i -= len(m.SourceBtcToken)
+		copy(dAtA[i:], m.SourceBtcToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceBtcToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TokenMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TokenMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TokenMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TokenMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TokenMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TokenMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TokenMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TokenMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TokenMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceToken) > 0 {
+		i -= len(m.SourceToken)
+		copy(dAtA[i:], m.SourceToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TokenMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TokenMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TokenMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20TokenSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20TokenSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20TokenSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Burnt.Size()
+		i -= size
+		if _, err := m.Burnt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MezoToken) > 0 {
+		i -= len(m.MezoToken)
+		copy(dAtA[i:], m.MezoToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MezoToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceToken) > 0 {
+		i -= len(m.SourceToken)
+		copy(dAtA[i:], m.SourceToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20SuppliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20SuppliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20SuppliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20SuppliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20SuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20SuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20SupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20SupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20SupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceToken) > 0 {
+		i -= len(m.SourceToken)
+		copy(dAtA[i:], m.SourceToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20SupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20SupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20SupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceChains) > 0 {
+		for iNdEx := len(m.SourceChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SourceChain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Capacity.Size()
+		i -= size
+		if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentOutflow.Size()
+		i -= size
+		if _, err := m.CurrentOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Outflows) > 0 {
+		for iNdEx := len(m.Outflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Outflow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUSDOutflowCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUSDOutflowCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUSDOutflowCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUSDOutflowCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUSDOutflowCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUSDOutflowCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Capacity.Size()
+		i -= size
+		if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentOutflow.Size()
+		i -= size
+		if _, err := m.CurrentOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowPriceFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowPriceFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowPriceFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutflowPriceFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutflowPriceFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutflowPriceFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceFeeds) > 0 {
+		for iNdEx := len(m.PriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderOutflowLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderOutflowLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderOutflowLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderOutflowLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderOutflowLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderOutflowLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderOutflowCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderOutflowCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderOutflowCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySenderOutflowCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySenderOutflowCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySenderOutflowCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SenderOutflowCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SenderOutflowCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SenderOutflowCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CountCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CountCapacity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CurrentCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CountLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CountLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AmountCapacity.Size()
+		i -= size
+		if _, err := m.AmountCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AmountLimit.Size()
+		i -= size
+		if _, err := m.AmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeOuts) > 0 {
+		for iNdEx := len(m.BridgeOuts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeOuts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelayedBridgeOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelayedBridgeOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelayedBridgeOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BridgeOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeOutFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeOutFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeOutFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeOutFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeOutFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeOutFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinBridgeOutAmountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinBridgeOutAmountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinBridgeOutAmountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinBridgeOutAmounts) > 0 {
+		for iNdEx := len(m.MinBridgeOutAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBridgeOutAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeOutChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeOutChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeOutChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeOutChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeOutChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeOutChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		dAtA29 := make([]byte, len(m.Chains)*10)
+		var j28 int
+		for _, num := range m.Chains {
+			for num >= 1<<7 {
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j28++
+			}
+			dAtA29[j28] = uint8(num)
+			j28++
+		}
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintQuery(dAtA, i, uint64(j28))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BridgeOutPaused {
+		i--
+		if m.BridgeOutPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BridgeInPaused {
+		i--
+		if m.BridgeInPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyControllersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyControllersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyControllersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyControllersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyControllersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyControllersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controllers) > 0 {
+		for iNdEx := len(m.Controllers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controllers[iNdEx])
+			copy(dAtA[i:], m.Controllers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Controllers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyBlockDelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyBlockDelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyBlockDelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyBlockDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyBlockDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyBlockDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WindowLimit.Size()
+		i -= size
+		if _, err := m.WindowLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PerRequestLimit.Size()
+		i -= size
+		if _, err := m.PerRequestLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Capacity.Size()
+		i -= size
+		if _, err := m.Capacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartySequenceTipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartySequenceTipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartySequenceTipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartySequenceTipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartySequenceTipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartySequenceTipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProcessedSequenceTip.Size()
+		i -= size
+		if _, err := m.ProcessedSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RequestSequenceTip.Size()
+		i -= size
+		if _, err := m.RequestSequenceTip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyPendingRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyPendingRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyPendingRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyPendingRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyPendingRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyPendingRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyRequestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyRequestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyControllersBTCMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyControllersBTCMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyControllersBTCMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ControllersBtcMinted) > 0 {
+		for iNdEx := len(m.ControllersBtcMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControllersBtcMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyRequestOutcomeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyRequestOutcomeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyRequestOutcomeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTripartyRequestOutcomeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTripartyRequestOutcomeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTripartyRequestOutcomeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outcome.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetsUnlockedSequenceTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAssetsUnlockedSequenceTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceTip.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetsUnlockedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceStart.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SequenceEnd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetsUnlockedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAssetsLockedSequenceTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAssetsLockedSequenceTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceTip.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetsLockedEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.SourceChain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChain))
+	}
+	return n
+}
+
+func (m *QueryAssetsLockedEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAssetsLockedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SequenceStart.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SequenceEnd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SourceChain != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChain))
+	}
+	return n
+}
+
+func (m *QueryAssetsLockedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySourceBTCTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySourceBTCTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceBtcToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TokenMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TokenMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TokenMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TokenMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBTCSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBTCSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Burnt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ERC20TokenSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MezoToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Burnt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryERC20SuppliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryERC20SuppliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for _, e := range m.Supplies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryERC20SupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20SupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySourceChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySourceChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SourceChains) > 0 {
+		for _, e := range m.SourceChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySourceChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	return n
+}
+
+func (m *QuerySourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OutflowCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	return n
+}
+
+func (m *QueryOutflowLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutflowLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Outflows) > 0 {
+		for _, e := range m.Outflows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutflowCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutflowCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	return n
+}
+
+func (m *QueryUSDOutflowCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUSDOutflowCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentOutflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Window.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	return n
+}
+
+func (m *QueryOutflowPriceFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutflowPriceFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PriceFeeds) > 0 {
+		for _, e := range m.PriceFeeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderOutflowLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderOutflowLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderOutflowCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySenderOutflowCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SenderOutflowCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.AmountLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CountLimit != 0 {
+		n += 1 + sovQuery(uint64(m.CountLimit))
+	}
+	if m.CurrentCount != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentCount))
+	}
+	if m.CountCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.CountCapacity))
+	}
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.DelayBlocks))
+	}
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeOuts) > 0 {
+		for _, e := range m.BridgeOuts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDelayedBridgeOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BridgeOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeOutFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeOutFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinBridgeOutAmountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinBridgeOutAmountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinBridgeOutAmounts) > 0 {
+		for _, e := range m.MinBridgeOutAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBridgeOutChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBridgeOutChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		l = 0
+		for _, e := range m.Chains {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeInPaused {
+		n += 2
+	}
+	if m.BridgeOutPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTripartyControllersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyControllersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Controllers) > 0 {
+		for _, s := range m.Controllers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyBlockDelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTripartyBlockDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	return n
+}
+
+func (m *QueryTripartyLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTripartyLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PerRequestLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WindowLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTripartyCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTripartyCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	return n
+}
+
+func (m *QueryTripartySequenceTipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTripartySequenceTipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RequestSequenceTip.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProcessedSequenceTip.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTripartyPendingRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyPendingRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryTripartyRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTripartyControllersBTCMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyControllersBTCMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ControllersBtcMinted) > 0 {
+		for _, e := range m.ControllersBtcMinted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTripartyRequestOutcomeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryTripartyRequestOutcomeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Outcome.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsUnlockedSequenceTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsUnlockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsUnlockedEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsUnlockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, AssetsUnlockedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedSequenceTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedSequenceTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedSequenceTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedSequenceTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedSequenceTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			m.SourceChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SequenceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			m.SourceChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetsLockedEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetsLockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, AssetsLockedRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceBTCTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceBTCTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceBTCTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceBTCTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceBTCTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceBTCTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBtcToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceBtcToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TokenMappingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TokenMappingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, ERC20TokenMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TokenMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TokenMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TokenMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20TokenSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20TokenSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20TokenSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MezoToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MezoToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burnt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20SuppliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20SuppliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20SuppliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20SuppliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20SuppliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20SuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, ERC20TokenSupply{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20SupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20SupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20SupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20SupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20SupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20SupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChains = append(m.SourceChains, SourceChainState{})
+			if err := m.SourceChains[len(m.SourceChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutflowCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutflowCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutflowCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outflows = append(m.Outflows, OutflowCapacity{})
+			if err := m.Outflows[len(m.Outflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUSDOutflowCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUSDOutflowCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUSDOutflowCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUSDOutflowCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUSDOutflowCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUSDOutflowCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowPriceFeedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowPriceFeedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowPriceFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutflowPriceFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutflowPriceFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutflowPriceFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeds = append(m.PriceFeeds, OutflowPriceFeed{})
+			if err := m.PriceFeeds[len(m.PriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderOutflowLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderOutflowLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderOutflowLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderOutflowLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderOutflowLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderOutflowLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, SenderOutflowLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderOutflowCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderOutflowCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderOutflowCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySenderOutflowCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySenderOutflowCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySenderOutflowCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SenderOutflowCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SenderOutflowCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SenderOutflowCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountLimit", wireType)
+			}
+			m.CountLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCount", wireType)
+			}
+			m.CurrentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountCapacity", wireType)
+			}
+			m.CountCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CountCapacity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, DelayedBridgeOutThreshold{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOuts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeOuts = append(m.BridgeOuts, DelayedBridgeOut{})
+			if err := m.BridgeOuts[len(m.BridgeOuts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelayedBridgeOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelayedBridgeOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeOutFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeOutFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeOutFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeOutFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeOutFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeOutFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, BridgeOutFee{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinBridgeOutAmountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinBridgeOutAmountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBridgeOutAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBridgeOutAmounts = append(m.MinBridgeOutAmounts, TokenMinBridgeOutAmount{})
+			if err := m.MinBridgeOutAmounts[len(m.MinBridgeOutAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountForBitcoinChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountForBitcoinChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinBridgeOutAmountForBitcoinChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountForBitcoinChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinBridgeOutAmountForBitcoinChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeOutChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeOutChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeOutChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeOutChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeOutChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeOutChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Chains = append(m.Chains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Chains) == 0 {
+					m.Chains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Chains = append(m.Chains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeInPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeInPaused = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeOutPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeOutPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyControllersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyControllersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyControllersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyControllersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyControllersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyControllersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controllers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controllers = append(m.Controllers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyBlockDelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyBlockDelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyBlockDelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyBlockDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyBlockDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyBlockDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerRequestLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerRequestLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
+			}
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartySequenceTipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartySequenceTipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartySequenceTipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartySequenceTipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartySequenceTipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartySequenceTipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedSequenceTip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProcessedSequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyPendingRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyPendingRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyPendingRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyPendingRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -794,12 +16236,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyPendingRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyPendingRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, TripartyBridgeRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -821,7 +16333,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyRequestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -844,17 +16356,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyRequestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -864,25 +16376,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -904,7 +16402,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetsUnlockedSequenceTipRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -927,12 +16425,45 @@ func (m *QueryAssetsUnlockedSequenceTipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -954,7 +16485,7 @@ func (m *QueryAssetsUnlockedSequenceTipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetsUnlockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyControllersBTCMintedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -977,17 +16508,17 @@ func (m *QueryAssetsUnlockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyControllersBTCMintedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedSequenceTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyControllersBTCMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceTip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -997,23 +16528,25 @@ func (m *QueryAssetsUnlockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1038,7 +16571,7 @@ func (m *QueryAssetsUnlockedSequenceTipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyControllersBTCMintedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1061,17 +16594,17 @@ func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyControllersBTCMintedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyControllersBTCMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllersBtcMinted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1081,29 +16614,29 @@ func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ControllersBtcMinted = append(m.ControllersBtcMinted, TripartyControllerBTCMinted{})
+			if err := m.ControllersBtcMinted[len(m.ControllersBtcMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1131,7 +16664,43 @@ func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SequenceEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1156,7 +16725,7 @@ func (m *QueryAssetsUnlockedEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetsUnlockedEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTripartyRequestOutcomeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1179,15 +16748,84 @@ func (m *QueryAssetsUnlockedEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTripartyRequestOutcomeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetsUnlockedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTripartyRequestOutcomeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTripartyRequestOutcomeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTripartyRequestOutcomeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTripartyRequestOutcomeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1214,8 +16852,7 @@ func (m *QueryAssetsUnlockedEventsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, AssetsUnlockedEvent{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outcome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
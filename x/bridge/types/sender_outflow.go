@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+)
+
+// NewSenderOutflowParams creates new per-sender bridge-out limit parameters.
+func NewSenderOutflowParams(windowBlocks uint64, maxCount uint32) SenderOutflowParams {
+	return SenderOutflowParams{
+		WindowBlocks: windowBlocks,
+		MaxCount:     maxCount,
+	}
+}
+
+// IsEnabled returns true if the per-sender limits are enforced.
+func (p SenderOutflowParams) IsEnabled() bool {
+	return p.WindowBlocks > 0
+}
+
+// WindowStart returns the height of the first block of the per-sender window
+// containing the given height. It must be called only if the per-sender
+// limits are enabled.
+func (p SenderOutflowParams) WindowStart(height uint64) uint64 {
+	return height - height%p.WindowBlocks
+}
+
+// Validate validates the per-sender bridge-out limit parameters.
+func (p SenderOutflowParams) Validate() error {
+	if !p.IsEnabled() && p.MaxCount != 0 {
+		return errors.New("sender outflow max count requires a non-zero window")
+	}
+
+	return nil
+}
+
+// AmountOf returns the amount of the given token tracked in the sender
+// outflow. The token must be a hex-encoded EVM address in the normalized
+// form.
+func (o SenderOutflow) AmountOf(token string) math.Int {
+	for _, outflow := range o.Amounts {
+		if outflow.Token == token {
+			return outflow.Amount
+		}
+	}
+
+	return math.ZeroInt()
+}
+
+// AddAmount adds the given amount of the given token to the sender outflow.
+// The token must be a hex-encoded EVM address in the normalized form.
+func (o *SenderOutflow) AddAmount(token string, amount math.Int) {
+	for i, outflow := range o.Amounts {
+		if outflow.Token == token {
+			o.Amounts[i].Amount = outflow.Amount.Add(amount)
+			return
+		}
+	}
+
+	o.Amounts = append(o.Amounts, SenderTokenOutflow{
+		Token:  token,
+		Amount: amount,
+	})
+}
+
+// SubAmount subtracts the given amount of the given token from the sender
+// outflow. The token's outflow does not go below zero. The token must be
+// a hex-encoded EVM address in the normalized form.
+func (o *SenderOutflow) SubAmount(token string, amount math.Int) {
+	for i, outflow := range o.Amounts {
+		if outflow.Token == token {
+			o.Amounts[i].Amount = outflow.Amount.Sub(math.MinInt(outflow.Amount, amount))
+			return
+		}
+	}
+}
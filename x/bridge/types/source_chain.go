@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
+const (
+	// PrimarySourceChain is the identifier of Ethereum, the primary bridge-in
+	// source chain. Its state predates additional source chains and is kept
+	// under the original keys.
+	PrimarySourceChain uint32 = 0
+
+	// MaxSourceChains is the maximum number of additional bridge-in source
+	// chains. Each source chain adds a section to the bridge vote extension,
+	// so the limit bounds the vote extension size.
+	MaxSourceChains = 8
+
+	// MaxSourceChainNameLength is the maximum length of a source chain name.
+	MaxSourceChainNameLength = 64
+)
+
+// NewSourceChain creates a new SourceChain.
+func NewSourceChain(id uint32, name string, sourceBTCToken []byte) SourceChain {
+	return SourceChain{
+		Id:             id,
+		Name:           name,
+		SourceBtcToken: evmtypes.BytesToHexAddress(sourceBTCToken),
+	}
+}
+
+// SourceBTCTokenBytes returns the source BTC token EVM address as bytes.
+func (sc SourceChain) SourceBTCTokenBytes() []byte {
+	return evmtypes.HexAddressToBytes(sc.SourceBtcToken)
+}
+
+// Validate returns an error if the source chain is not valid. A source chain
+// is valid if its identifier is not the primary source chain, its name is not
+// empty and not longer than MaxSourceChainNameLength, and its source BTC token
+// is a valid non-zero EVM address.
+func (sc SourceChain) Validate() error {
+	if sc.Id == PrimarySourceChain {
+		return fmt.Errorf("source chain %d is reserved for Ethereum", PrimarySourceChain)
+	}
+
+	if len(sc.Name) == 0 {
+		return fmt.Errorf("source chain %d name cannot be empty", sc.Id)
+	}
+
+	if len(sc.Name) > MaxSourceChainNameLength {
+		return fmt.Errorf(
+			"source chain %d name cannot be longer than %d",
+			sc.Id,
+			MaxSourceChainNameLength,
+		)
+	}
+
+	if !evmtypes.IsHexAddress(sc.SourceBtcToken) {
+		return fmt.Errorf(
+			"source chain %d BTC token must be a valid hex-encoded EVM address",
+			sc.Id,
+		)
+	}
+
+	if evmtypes.IsZeroHexAddress(sc.SourceBtcToken) {
+		return fmt.Errorf(
+			"source chain %d BTC token cannot be the zero EVM address",
+			sc.Id,
+		)
+	}
+
+	return nil
+}
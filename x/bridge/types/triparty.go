@@ -1,7 +1,72 @@
 package types
 
+import (
+	"fmt"
+
+	evmtypes "github.com/mezo-org/mezod/x/evm/types"
+)
+
 const (
 	// MaxTripartyCallbackDataLength is the maximum allowed length of
 	// callbackData in a triparty bridge request (10 x 32-byte ABI words).
 	MaxTripartyCallbackDataLength = 320
+
+	// MaxTripartyCallbackRetryAttempts is the maximum allowed number of
+	// automatic retries of a failed triparty callback.
+	MaxTripartyCallbackRetryAttempts = 10
+
+	// MaxTripartyCallbackRetryGasLimit is the maximum allowed gas limit of
+	// a retried triparty callback.
+	MaxTripartyCallbackRetryGasLimit = 5_000_000
+)
+
+// Short aliases of the triparty bridge request statuses.
+const (
+	TripartyBridgeRequestStatusUnspecified    = TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_UNSPECIFIED
+	TripartyBridgeRequestStatusProcessed      = TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_PROCESSED
+	TripartyBridgeRequestStatusSkipped        = TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_SKIPPED
+	TripartyBridgeRequestStatusCallbackFailed = TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_CALLBACK_FAILED
+	TripartyBridgeRequestStatusCancelled      = TripartyBridgeRequestStatus_TRIPARTY_BRIDGE_REQUEST_STATUS_CANCELLED
 )
+
+// IsValid returns true if the status is a known status of a processed
+// triparty bridge request.
+func (s TripartyBridgeRequestStatus) IsValid() bool {
+	_, ok := TripartyBridgeRequestStatus_name[int32(s)]
+	return ok && s != TripartyBridgeRequestStatusUnspecified
+}
+
+// DefaultTripartyCallbackRetryPolicy returns the default retry policy of
+// failed triparty callbacks. A failed callback is retried automatically up
+// to 3 times, every 100 blocks (~5 minutes at ~3s blocks), with the gas
+// limit of the initial callback.
+func DefaultTripartyCallbackRetryPolicy() TripartyCallbackRetryPolicy {
+	return TripartyCallbackRetryPolicy{
+		MaxAttempts:    3,
+		IntervalBlocks: 100,
+		GasLimit:       evmtypes.TripartyCallbackGasLimit,
+	}
+}
+
+// Validate validates the triparty callback retry policy.
+func (p TripartyCallbackRetryPolicy) Validate() error {
+	if p.MaxAttempts > MaxTripartyCallbackRetryAttempts {
+		return fmt.Errorf(
+			"max attempts must not exceed %d",
+			MaxTripartyCallbackRetryAttempts,
+		)
+	}
+
+	if p.IntervalBlocks == 0 {
+		return fmt.Errorf("interval blocks must be positive")
+	}
+
+	if p.GasLimit == 0 || p.GasLimit > MaxTripartyCallbackRetryGasLimit {
+		return fmt.Errorf(
+			"gas limit must be in range (0, %d]",
+			MaxTripartyCallbackRetryGasLimit,
+		)
+	}
+
+	return nil
+}
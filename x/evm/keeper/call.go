@@ -16,7 +16,9 @@ import (
 // ExecuteContractCall executes an EVM contract call. Under the hood, it creates
 // an EVM message based on the provided data and applies it to trigger a state
 // transition for the given EVM contract. This call does not create a fully-fledged
-// transaction so no gas is deducted from the sender's account.
+// transaction so no gas is deducted from the sender's account. If the EVM
+// execution fails, the response is returned along with the error so that
+// callers can inspect the gas used by the failed call.
 func (k *Keeper) ExecuteContractCall(
 	ctx sdk.Context,
 	call types.ContractCall,
@@ -64,7 +66,7 @@ func (k *Keeper) ExecuteContractCall(
 	}
 
 	if res.Failed() {
-		return nil, nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+		return res, nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
 	}
 
 	if len(changes) > 0 {
@@ -188,6 +188,7 @@ func setupChain(localMinGasPricesStr string) {
 		5,
 		encoding.MakeConfig(app.ModuleBasics),
 		ethsidecar.NewClientMock(),
+		nil,
 		simutils.NewAppOptionsWithFlagHome(app.DefaultNodeHome),
 		baseapp.SetChainID(chainID),
 		baseapp.SetMinGasPrices(localMinGasPricesStr),
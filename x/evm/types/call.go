@@ -154,6 +154,74 @@ func (c *ERC20BurnFromCall) GasLimit() uint64 {
 	return 0
 }
 
+// ERC20TotalSupplyCall represents a totalSupply() call for an ERC20 contract.
+type ERC20TotalSupplyCall struct {
+	from, to common.Address
+	data     []byte
+}
+
+// NewERC20TotalSupplyCall creates a new ERC20TotalSupplyCall.
+func NewERC20TotalSupplyCall(from, to []byte) (*ERC20TotalSupplyCall, error) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create uint256 type: %w", err)
+	}
+
+	methodAbi := abi.Method{
+		Name:   "totalSupply",
+		ID:     []byte{0x18, 0x16, 0x0d, 0xdd}, // 0x18160ddd is the function selector for totalSupply()
+		Type:   abi.Function,
+		Inputs: []abi.Argument{},
+		Outputs: []abi.Argument{
+			{Name: "", Type: uint256Type},
+		},
+	}
+	contractAbi := abi.ABI{
+		Methods: map[string]abi.Method{
+			"totalSupply": methodAbi,
+		},
+	}
+
+	data, err := contractAbi.Pack("totalSupply")
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack totalSupply data: %w", err)
+	}
+
+	return &ERC20TotalSupplyCall{
+		from: common.BytesToAddress(from),
+		to:   common.BytesToAddress(to),
+		data: data,
+	}, nil
+}
+
+func (c *ERC20TotalSupplyCall) From() common.Address {
+	return c.from
+}
+
+func (c *ERC20TotalSupplyCall) To() *common.Address {
+	return &c.to
+}
+
+func (c *ERC20TotalSupplyCall) Data() []byte {
+	return c.data
+}
+
+func (c *ERC20TotalSupplyCall) GasLimit() uint64 {
+	return 0
+}
+
+// UnpackERC20TotalSupply unpacks the return data of a totalSupply() call.
+func UnpackERC20TotalSupply(ret []byte) (*big.Int, error) {
+	if len(ret) != 32 {
+		return nil, fmt.Errorf(
+			"invalid totalSupply return data length: %d",
+			len(ret),
+		)
+	}
+
+	return new(big.Int).SetBytes(ret), nil
+}
+
 // TripartyCallbackGasLimit is the gas limit for the triparty callback call.
 const TripartyCallbackGasLimit = uint64(1_000_000)
 
@@ -162,6 +230,7 @@ const TripartyCallbackGasLimit = uint64(1_000_000)
 type TripartyCallbackCall struct {
 	from, to common.Address
 	data     []byte
+	gasLimit uint64
 }
 
 // NewTripartyCallbackCall creates a new TripartyCallbackCall.
@@ -223,12 +292,19 @@ func NewTripartyCallbackCall(
 	}
 
 	return &TripartyCallbackCall{
-		from: common.BytesToAddress(from),
-		to:   common.BytesToAddress(to),
-		data: data,
+		from:     common.BytesToAddress(from),
+		to:       common.BytesToAddress(to),
+		data:     data,
+		gasLimit: TripartyCallbackGasLimit,
 	}, nil
 }
 
+// WithGasLimit overrides the default TripartyCallbackGasLimit of the call.
+func (c *TripartyCallbackCall) WithGasLimit(gasLimit uint64) *TripartyCallbackCall {
+	c.gasLimit = gasLimit
+	return c
+}
+
 func (c *TripartyCallbackCall) From() common.Address {
 	return c.from
 }
@@ -242,5 +318,5 @@ func (c *TripartyCallbackCall) Data() []byte {
 }
 
 func (c *TripartyCallbackCall) GasLimit() uint64 {
-	return TripartyCallbackGasLimit
+	return c.gasLimit
 }
@@ -33,6 +33,36 @@ func TestNewERC20MintCall(t *testing.T) {
 	require.Equal(t, expectedDataBytes, call.Data())
 }
 
+func TestNewERC20TotalSupplyCall(t *testing.T) {
+	from := common.HexToAddress("0xbb9A13411Ee01F163B5FeB8cd8Ec4cE5bcc9500c")
+	to := common.HexToAddress("0x9609B36D7feF4D8A641170aC403F16609ffc0EDC")
+
+	call, err := NewERC20TotalSupplyCall(from.Bytes(), to.Bytes())
+	require.NoError(t, err)
+
+	// Expected data is the function selector for totalSupply().
+	expectedDataBytes, err := hex.DecodeString("18160ddd")
+	require.NoError(t, err)
+
+	require.Equal(t, from, call.From())
+	require.Equal(t, &to, call.To())
+	require.Equal(t, expectedDataBytes, call.Data())
+}
+
+func TestUnpackERC20TotalSupply(t *testing.T) {
+	ret, err := hex.DecodeString(
+		"00000000000000000000000000000000000000000000000000000000000003e8",
+	)
+	require.NoError(t, err)
+
+	totalSupply, err := UnpackERC20TotalSupply(ret)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), totalSupply)
+
+	_, err = UnpackERC20TotalSupply(ret[1:])
+	require.ErrorContains(t, err, "invalid totalSupply return data length")
+}
+
 func TestNewTripartyCallbackCall(t *testing.T) {
 	from := common.HexToAddress("0xbb9A13411Ee01F163B5FeB8cd8Ec4cE5bcc9500c")
 	to := common.HexToAddress("0x9609B36D7feF4D8A641170aC403F16609ffc0EDC")
@@ -73,4 +103,8 @@ func TestNewTripartyCallbackCall(t *testing.T) {
 	require.Equal(t, &to, call.To())
 	require.Equal(t, TripartyCallbackGasLimit, call.GasLimit())
 	require.Equal(t, expectedDataBytes, call.Data())
+
+	call = call.WithGasLimit(2_000_000)
+	require.Equal(t, uint64(2_000_000), call.GasLimit())
+	require.Equal(t, expectedDataBytes, call.Data())
 }
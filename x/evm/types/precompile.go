@@ -17,7 +17,7 @@ const (
 
 const (
 	AssetsBridgePrecompileAddress       = "0x7b7c000000000000000000000000000000000012"
-	AssetsBridgePrecompileLatestVersion = 6
+	AssetsBridgePrecompileLatestVersion = 7
 )
 
 const (
@@ -170,6 +170,7 @@ func setupChain(localMinGasPricesStr, chainID string) {
 		5,
 		encoding.MakeConfig(app.ModuleBasics),
 		ethsidecar.NewClientMock(),
+		nil,
 		simutils.NewAppOptionsWithFlagHome(app.DefaultNodeHome),
 		baseapp.SetChainID(chainID),
 		baseapp.SetMinGasPrices(localMinGasPricesStr),